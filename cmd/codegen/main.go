@@ -95,12 +95,28 @@ func main() {
 		"all_glyphs_test.gotmpl":    "glyphs_test.go",
 	}
 
+	classGlyphCounts := make(map[string]int, len(glyphData.Glyphs))
+	for class, glyphs := range glyphData.Glyphs {
+		classGlyphCounts[class] = len(glyphs)
+	}
+
 	for tmpl, destFile := range allGlyphsFiles {
 		generateFile(tmpl, filepath.Join(os.Args[1], "glyphs", "all", destFile), map[string]any{
+			"PackageName":      packageName,
+			"Metadata":         glyphData.Metadata,
+			"Classes":          glyphData.Classes(),
+			"ClassGlyphCounts": classGlyphCounts,
+			"Glyphs":           slices.Collect(glyphData.AllIter()),
+		})
+	}
+
+	// Each class is registered with the "all" package from its own file, so it
+	// can be excluded with build tags.
+	for _, class := range glyphData.Classes() {
+		generateFile("all_glyphs_class.gotmpl", filepath.Join(os.Args[1], "glyphs", "all", "class_"+class+".gen.go"), map[string]any{
 			"PackageName": packageName,
-			"Metadata":    glyphData.Metadata,
+			"Class":       class,
 			"Classes":     glyphData.Classes(),
-			"Glyphs":      slices.Collect(glyphData.AllIter()),
 		})
 	}
 
@@ -122,9 +138,15 @@ func main() {
 	}
 
 	neoFiles := map[string]string{
-		"neo.gotmpl":        "neo.gen.go",
-		"neo_glyphs.gotmpl": "glyphs.gen.go",
-		"neo_test.gotmpl":   "neo_test.go",
+		"neo.gotmpl":              "neo.gen.go",
+		"neo_detect.gotmpl":       "detect.gen.go",
+		"neo_detect_test.gotmpl":  "detect_test.go",
+		"neo_glyphs.gotmpl":       "glyphs.gen.go",
+		"neo_palette.gotmpl":      "palette.gen.go",
+		"neo_palette_test.gotmpl": "palette_test.go",
+		"neo_resolve.gotmpl":      "resolve.gen.go",
+		"neo_resolve_test.gotmpl": "resolve_test.go",
+		"neo_test.gotmpl":         "neo_test.go",
 	}
 
 	for tmpl, destFile := range neoFiles {
@@ -0,0 +1,83 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package nf
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+)
+
+// decodeJSONC decodes JSON with comments ("//" and "/* */") and trailing
+// commas, as used by VS Code and Windows Terminal settings files, into v.
+func decodeJSONC(data []byte, v any) error {
+	data, err := jsoncToJSON(data)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// jsoncToJSON converts JSONC to JSON, replacing comments with whitespace (such
+// that offsets in errors still match), and removing trailing commas.
+func jsoncToJSON(data []byte) ([]byte, error) {
+	out := make([]byte, 0, len(data))
+	var inString bool
+
+	for i := 0; i < len(data); i++ {
+		c := data[i]
+
+		if inString {
+			out = append(out, c)
+			switch c {
+			case '\\':
+				if i+1 < len(data) {
+					i++
+					out = append(out, data[i])
+				}
+			case '"':
+				inString = false
+			}
+			continue
+		}
+
+		switch {
+		case c == '"':
+			inString = true
+		case c == '/' && i+1 < len(data) && data[i+1] == '/':
+			end := bytes.IndexByte(data[i:], '\n')
+			if end < 0 {
+				end = len(data) - i
+			}
+			out = append(out, bytes.Repeat([]byte{' '}, end)...)
+			i += end - 1
+			continue
+		case c == '/' && i+1 < len(data) && data[i+1] == '*':
+			end := bytes.Index(data[i+2:], []byte("*/"))
+			if end < 0 {
+				return nil, errors.New("unterminated block comment")
+			}
+			for _, b := range data[i : i+end+4] {
+				if b != '\n' {
+					b = ' '
+				}
+				out = append(out, b)
+			}
+			i += end + 3
+			continue
+		case c == '}' || c == ']':
+			// Replace a trailing comma before the closing bracket.
+			if j := len(bytes.TrimRight(out, " \t\r\n")) - 1; j >= 0 && out[j] == ',' {
+				out[j] = ' '
+			}
+		}
+		out = append(out, c)
+	}
+
+	if inString {
+		return nil, errors.New("unterminated string")
+	}
+	return out, nil
+}
@@ -0,0 +1,53 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package nf
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDecodeJSONC(t *testing.T) {
+	t.Parallel()
+
+	input := `// comment
+{
+	/* block
+	   comment */
+	"url": "https://example.com/*not-a-comment*/", // trailing comment
+	"escaped": "a \"quoted\" // string\\",
+	"list": [1, 2, 3,],
+	"nested": {
+		"key": "value",
+	},
+}
+`
+
+	want := map[string]any{
+		"url":     "https://example.com/*not-a-comment*/",
+		"escaped": `a "quoted" // string\`,
+		"list":    []any{1.0, 2.0, 3.0},
+		"nested":  map[string]any{"key": "value"},
+	}
+
+	var got map[string]any
+	if err := decodeJSONC([]byte(input), &got); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected:\n%v\ngot:\n%v", want, got)
+	}
+
+	for _, input := range []string{
+		`{"a": 1 /* unterminated`,
+		`{"a": "unterminated}`,
+		`{"a": }`,
+	} {
+		var v any
+		if err := decodeJSONC([]byte(input), &v); err == nil {
+			t.Errorf("expected error for %q, got nil", input)
+		}
+	}
+}
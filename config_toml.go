@@ -0,0 +1,310 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package nf
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// tomlParser is a minimal TOML parser, which flattens a document into
+// [configLine]s, keyed by their dotted path (e.g. "font.normal.family"). Array
+// elements are emitted as separate lines with the key of the array. Only
+// strings are unquoted; other scalar values are returned as-is. It is only
+// intended for reading terminal config files, not for general use.
+type tomlParser struct {
+	data  string
+	pos   int
+	lines []configLine
+}
+
+// parseTOML parses a TOML document into [configLine]s. See [tomlParser].
+func parseTOML(data []byte) ([]configLine, error) {
+	p := &tomlParser{data: string(data)}
+	if err := p.parse(); err != nil {
+		return nil, fmt.Errorf("line %d: %w", p.line(), err)
+	}
+	return p.lines, nil
+}
+
+func (p *tomlParser) line() int {
+	return strings.Count(p.data[:p.pos], "\n") + 1
+}
+
+func (p *tomlParser) eof() bool {
+	return p.pos >= len(p.data)
+}
+
+func (p *tomlParser) peek() byte {
+	if p.eof() {
+		return 0
+	}
+	return p.data[p.pos]
+}
+
+// skipSpace skips spaces and tabs, and if newlines is true, newlines and
+// comments.
+func (p *tomlParser) skipSpace(newlines bool) {
+	for !p.eof() {
+		switch c := p.peek(); {
+		case c == ' ' || c == '\t' || c == '\r':
+			p.pos++
+		case newlines && c == '\n':
+			p.pos++
+		case newlines && c == '#':
+			p.skipComment()
+		default:
+			return
+		}
+	}
+}
+
+func (p *tomlParser) skipComment() {
+	if i := strings.IndexByte(p.data[p.pos:], '\n'); i >= 0 {
+		p.pos += i
+		return
+	}
+	p.pos = len(p.data)
+}
+
+// endOfLine consumes trailing whitespace and comments, and the newline.
+func (p *tomlParser) endOfLine() error {
+	p.skipSpace(false)
+	if p.peek() == '#' {
+		p.skipComment()
+	}
+	if p.eof() {
+		return nil
+	}
+	if p.peek() != '\n' {
+		return fmt.Errorf("unexpected %q after value", p.peek())
+	}
+	p.pos++
+	return nil
+}
+
+func (p *tomlParser) parse() error {
+	var table string
+	for {
+		p.skipSpace(true)
+		if p.eof() {
+			return nil
+		}
+
+		if p.peek() == '[' {
+			p.pos++
+			array := p.peek() == '['
+			if array {
+				p.pos++
+			}
+
+			key, err := p.key()
+			if err != nil {
+				return err
+			}
+			if !strings.HasPrefix(p.data[p.pos:], "]") || (array && !strings.HasPrefix(p.data[p.pos:], "]]")) {
+				return errors.New("unterminated table header")
+			}
+			p.pos++
+			if array {
+				p.pos++
+			}
+			table = key
+
+			if err = p.endOfLine(); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := p.keyValue(table); err != nil {
+			return err
+		}
+		if err := p.endOfLine(); err != nil {
+			return err
+		}
+	}
+}
+
+// key parses a (possibly dotted, and quoted) key.
+func (p *tomlParser) key() (string, error) {
+	var parts []string
+	for {
+		p.skipSpace(false)
+
+		var part string
+		switch c := p.peek(); {
+		case c == '"' || c == '\'':
+			s, err := p.str()
+			if err != nil {
+				return "", err
+			}
+			part = s
+		default:
+			start := p.pos
+			for !p.eof() && isTOMLBareKeyChar(p.peek()) {
+				p.pos++
+			}
+			if start == p.pos {
+				return "", fmt.Errorf("expected key, got %q", p.peek())
+			}
+			part = p.data[start:p.pos]
+		}
+		parts = append(parts, part)
+
+		p.skipSpace(false)
+		if p.peek() != '.' {
+			return strings.Join(parts, "."), nil
+		}
+		p.pos++
+	}
+}
+
+func isTOMLBareKeyChar(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_' || c == '-'
+}
+
+func (p *tomlParser) keyValue(table string) error {
+	key, err := p.key()
+	if err != nil {
+		return err
+	}
+	if table != "" {
+		key = table + "." + key
+	}
+
+	p.skipSpace(false)
+	if p.peek() != '=' {
+		return fmt.Errorf("expected '=' after key %q", key)
+	}
+	p.pos++
+	p.skipSpace(false)
+
+	return p.value(key)
+}
+
+func (p *tomlParser) value(key string) error {
+	switch c := p.peek(); {
+	case c == '"' || c == '\'':
+		s, err := p.str()
+		if err != nil {
+			return err
+		}
+		p.lines = append(p.lines, configLine{key: key, value: s})
+		return nil
+	case c == '[':
+		return p.array(key)
+	case c == '{':
+		return p.inlineTable(key)
+	default:
+		start := p.pos
+		for !p.eof() && !strings.ContainsRune(" \t\r\n,]}#", rune(p.peek())) {
+			p.pos++
+		}
+		if start == p.pos {
+			return fmt.Errorf("expected value for key %q", key)
+		}
+		p.lines = append(p.lines, configLine{key: key, value: p.data[start:p.pos]})
+		return nil
+	}
+}
+
+func (p *tomlParser) array(key string) error {
+	p.pos++ // [
+	for {
+		p.skipSpace(true)
+		if p.eof() {
+			return fmt.Errorf("unterminated array for key %q", key)
+		}
+		if p.peek() == ']' {
+			p.pos++
+			return nil
+		}
+
+		if err := p.value(key); err != nil {
+			return err
+		}
+
+		p.skipSpace(true)
+		switch p.peek() {
+		case ',':
+			p.pos++
+		case ']':
+		default:
+			return fmt.Errorf("expected ',' or ']' in array for key %q", key)
+		}
+	}
+}
+
+func (p *tomlParser) inlineTable(key string) error {
+	p.pos++ // {
+	for {
+		p.skipSpace(true)
+		if p.eof() {
+			return fmt.Errorf("unterminated inline table for key %q", key)
+		}
+		if p.peek() == '}' {
+			p.pos++
+			return nil
+		}
+
+		if err := p.keyValue(key); err != nil {
+			return err
+		}
+
+		p.skipSpace(true)
+		switch p.peek() {
+		case ',':
+			p.pos++
+		case '}':
+		default:
+			return fmt.Errorf("expected ',' or '}' in inline table for key %q", key)
+		}
+	}
+}
+
+// str parses a basic ("..."), literal ('...'), or multi-line string.
+func (p *tomlParser) str() (string, error) {
+	quote := p.data[p.pos : p.pos+1]
+	if strings.HasPrefix(p.data[p.pos:], quote+quote+quote) {
+		delim := quote + quote + quote
+		p.pos += 3
+		end := strings.Index(p.data[p.pos:], delim)
+		if end < 0 {
+			return "", errors.New("unterminated multi-line string")
+		}
+		s := strings.TrimPrefix(p.data[p.pos:p.pos+end], "\n")
+		p.pos += end + 3
+		return s, nil
+	}
+
+	p.pos++
+	start := p.pos
+	for !p.eof() {
+		switch p.peek() {
+		case '\n':
+			return "", errors.New("unterminated string")
+		case '\\':
+			if quote == `"` {
+				p.pos++
+			}
+		case quote[0]:
+			raw := p.data[start:p.pos]
+			p.pos++
+			if quote == "'" {
+				return raw, nil
+			}
+			s, err := strconv.Unquote(`"` + raw + `"`)
+			if err != nil {
+				return "", fmt.Errorf("invalid string %q: %w", raw, err)
+			}
+			return s, nil
+		}
+		p.pos++
+	}
+	return "", errors.New("unterminated string")
+}
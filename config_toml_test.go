@@ -0,0 +1,84 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package nf
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestParseTOML(t *testing.T) {
+	t.Parallel()
+
+	input := `# comment
+title = "a \"quoted\" \u00e9 string" # trailing comment
+literal = 'C:\path'
+multi = """
+line one
+line two"""
+int = 42
+bool = true
+"quoted.key" = "x"
+
+[font]
+size = 11.5
+normal = { family = "Fira Code", style = 'Regular' }
+
+[font . bold]
+family = "Hack"
+
+[[keyboard.bindings]]
+key = "V"
+mods = "Control|Shift"
+
+[general]
+import = [
+    "a.toml", # comment
+    'b.toml',
+]
+empty = []
+`
+
+	want := []configLine{
+		{key: "title", value: `a "quoted" é string`},
+		{key: "literal", value: `C:\path`},
+		{key: "multi", value: "line one\nline two"},
+		{key: "int", value: "42"},
+		{key: "bool", value: "true"},
+		{key: "quoted.key", value: "x"},
+		{key: "font.size", value: "11.5"},
+		{key: "font.normal.family", value: "Fira Code"},
+		{key: "font.normal.style", value: "Regular"},
+		{key: "font.bold.family", value: "Hack"},
+		{key: "keyboard.bindings.key", value: "V"},
+		{key: "keyboard.bindings.mods", value: "Control|Shift"},
+		{key: "general.import", value: "a.toml"},
+		{key: "general.import", value: "b.toml"},
+	}
+
+	got, err := parseTOML([]byte(input))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("expected:\n%q\ngot:\n%q", want, got)
+	}
+
+	for _, input := range []string{
+		"[font.normal\nfamily = \"x\"\n",
+		"family = \"unterminated\n",
+		"family \"x\"\n",
+		"family = \"x\" extra\n",
+		"import = [\"a\", \"b\"\n",
+		"normal = { family = \"x\"\n",
+		"= \"x\"\n",
+		"family =\n",
+		"s = '''unterminated\n",
+	} {
+		if _, err := parseTOML([]byte(input)); err == nil {
+			t.Errorf("expected error for %q, got nil", input)
+		}
+	}
+}
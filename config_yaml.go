@@ -0,0 +1,171 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package nf
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// parseYAML is a minimal YAML parser, which flattens block mappings into
+// [configLine]s, keyed by their dotted path (e.g. "font.normal.family").
+// Sequence items (both block and flow sequences of scalars) are emitted as
+// separate lines with the key of the sequence. Flow mappings, anchors, and
+// multi-line scalars are not supported, and are returned as-is. It is only
+// intended for reading legacy terminal config files, not for general use.
+func parseYAML(data []byte) ([]configLine, error) {
+	type level struct {
+		indent int
+		key    string
+	}
+
+	var lines []configLine
+	var stack []level
+
+	path := func(key string) string {
+		parts := make([]string, 0, len(stack)+1)
+		for _, l := range stack {
+			parts = append(parts, l.key)
+		}
+		if key != "" {
+			parts = append(parts, key)
+		}
+		return strings.Join(parts, ".")
+	}
+
+	for i, raw := range strings.Split(string(data), "\n") {
+		lineno := i + 1
+
+		line := strings.TrimRight(raw, " \r")
+		content := strings.TrimLeft(line, " ")
+		indent := len(line) - len(content)
+
+		if strings.HasPrefix(content, "\t") {
+			return nil, fmt.Errorf("line %d: tabs are not allowed for indentation", lineno)
+		}
+
+		content, err := stripYAMLComment(content)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", lineno, err)
+		}
+		if content == "" || content == "---" || content == "..." {
+			continue
+		}
+
+		if item, ok := strings.CutPrefix(content, "-"); ok && (item == "" || item[0] == ' ') {
+			// Sequence items may be at the same indentation as their parent key.
+			for len(stack) > 0 && stack[len(stack)-1].indent > indent {
+				stack = stack[:len(stack)-1]
+			}
+			item = strings.TrimSpace(item)
+
+			// Items which are mappings (e.g. "- key: value") are flattened
+			// into the sequence key.
+			if key, value, isMap := cutYAMLKey(item); isMap {
+				if value != "" {
+					lines = append(lines, configLine{key: path(key), value: unquoteYAML(value)})
+				}
+				continue
+			}
+
+			lines = append(lines, configLine{key: path(""), value: unquoteYAML(item)})
+			continue
+		}
+
+		key, value, ok := cutYAMLKey(content)
+		if !ok {
+			return nil, fmt.Errorf("line %d: expected key, got %q", lineno, content)
+		}
+
+		for len(stack) > 0 && stack[len(stack)-1].indent >= indent {
+			stack = stack[:len(stack)-1]
+		}
+
+		switch {
+		case value == "":
+			stack = append(stack, level{indent: indent, key: key})
+		case value[0] == '[':
+			if !strings.HasSuffix(value, "]") {
+				return nil, fmt.Errorf("line %d: unterminated flow sequence", lineno)
+			}
+			for item := range strings.SplitSeq(value[1:len(value)-1], ",") {
+				if item = strings.TrimSpace(item); item != "" {
+					lines = append(lines, configLine{key: path(key), value: unquoteYAML(item)})
+				}
+			}
+		default:
+			lines = append(lines, configLine{key: path(key), value: unquoteYAML(value)})
+		}
+	}
+
+	return lines, nil
+}
+
+// cutYAMLKey splits a "key: value" mapping entry. Keys may be quoted.
+func cutYAMLKey(s string) (key, value string, ok bool) {
+	if s != "" && (s[0] == '"' || s[0] == '\'') {
+		end := strings.IndexByte(s[1:], s[0])
+		if end < 0 || !strings.HasPrefix(s[end+2:], ":") {
+			return "", "", false
+		}
+		return s[1 : end+1], strings.TrimSpace(s[end+3:]), true
+	}
+
+	i := strings.Index(s, ": ")
+	if i < 0 {
+		if !strings.HasSuffix(s, ":") {
+			return "", "", false
+		}
+		i = len(s) - 1
+	}
+	key = strings.TrimSpace(s[:i])
+	if key == "" || strings.ContainsAny(key, "{}[]") {
+		return "", "", false
+	}
+	return key, strings.TrimSpace(s[i+1:]), true
+}
+
+// stripYAMLComment removes a trailing comment from s, taking quotes into account.
+func stripYAMLComment(s string) (string, error) {
+	var quote byte
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case quote != 0:
+			if c == '\\' && quote == '"' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			// Quotes only start a quoted scalar at the start of a value.
+			if i == 0 || s[i-1] == ' ' || s[i-1] == '[' || s[i-1] == ',' {
+				quote = c
+			}
+		case c == '#' && (i == 0 || s[i-1] == ' '):
+			return strings.TrimRight(s[:i], " "), nil
+		}
+	}
+	if quote != 0 {
+		return "", errors.New("unterminated quoted scalar")
+	}
+	return s, nil
+}
+
+// unquoteYAML unquotes a double or single quoted scalar. Plain scalars are
+// returned as-is.
+func unquoteYAML(s string) string {
+	switch {
+	case len(s) >= 2 && s[0] == '"' && s[len(s)-1] == '"':
+		if v, err := strconv.Unquote(s); err == nil {
+			return v
+		}
+		return s[1 : len(s)-1]
+	case len(s) >= 2 && s[0] == '\'' && s[len(s)-1] == '\'':
+		return strings.ReplaceAll(s[1:len(s)-1], "''", "'")
+	}
+	return s
+}
@@ -0,0 +1,72 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package nf
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestParseYAML(t *testing.T) {
+	t.Parallel()
+
+	input := `---
+# comment
+import:
+- a.yml
+- "b.yml" # comment
+font:
+  normal:
+    family: "Fira Code # not a comment"
+    style: Regular
+  bold:
+    family: 'It''s Hack'
+  size: 10.0
+colors:
+  primary:
+    background: '#1d1f21'
+key_bindings:
+  - { key: V, mods: Control, action: Paste }
+  - key: C
+    mods: Control
+list: [one, "two"]
+"quoted key": value
+`
+
+	want := []configLine{
+		{key: "import", value: "a.yml"},
+		{key: "import", value: "b.yml"},
+		{key: "font.normal.family", value: "Fira Code # not a comment"},
+		{key: "font.normal.style", value: "Regular"},
+		{key: "font.bold.family", value: "It's Hack"},
+		{key: "font.size", value: "10.0"},
+		{key: "colors.primary.background", value: "#1d1f21"},
+		{key: "key_bindings", value: "{ key: V, mods: Control, action: Paste }"},
+		{key: "key_bindings.key", value: "C"},
+		{key: "key_bindings.mods", value: "Control"},
+		{key: "list", value: "one"},
+		{key: "list", value: "two"},
+		{key: "quoted key", value: "value"},
+	}
+
+	got, err := parseYAML([]byte(input))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("expected:\n%q\ngot:\n%q", want, got)
+	}
+
+	for _, input := range []string{
+		"font:\n\tfamily: x\n",
+		"font:\n  family: \"unterminated\n",
+		"just a scalar line\n",
+		"list: [a, b\n",
+	} {
+		if _, err := parseYAML([]byte(input)); err == nil {
+			t.Errorf("expected error for %q, got nil", input)
+		}
+	}
+}
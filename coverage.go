@@ -0,0 +1,311 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package nf
+
+import (
+	"encoding/binary"
+	"fmt"
+	"os"
+	"slices"
+	"sort"
+)
+
+// ClassCoverage is the number of codepoints of a class which are present in a
+// font, as returned by [FontCoverage].
+type ClassCoverage struct {
+	Class Class
+	// Present is the number of codepoints of the class which the font has a
+	// glyph for.
+	Present int
+	// Total is the number of distinct codepoints in the class. Aliases (multiple
+	// IDs with the same glyph) are only counted once.
+	Total int
+}
+
+// Complete returns true if all codepoints of the class are present in the font.
+func (c ClassCoverage) Complete() bool {
+	return c.Present == c.Total
+}
+
+// Ratio returns the fraction of codepoints of the class which are present in
+// the font, between 0 and 1.
+func (c ClassCoverage) Ratio() float64 {
+	if c.Total == 0 {
+		return 1
+	}
+	return float64(c.Present) / float64(c.Total)
+}
+
+// FontCoverage reads the character map ("cmap" table) of the font file at path,
+// and reports how many codepoints of each registered class (see [Classes]) are
+// present in the font, sorted by class. Import "github.com/lrstanley/go-nf/glyphs/all"
+// to register all classes. This is useful to detect fonts which were only
+// partially patched, or patched by an older version of Nerd Fonts than
+// [Version], which may be missing newer glyphs.
+//
+// Supported formats are the same as [ReadFontInfo]. For collections, a
+// codepoint is present if any of the fonts in the collection has it.
+func FontCoverage(path string) ([]ClassCoverage, error) {
+	cmap, err := readCmap(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var coverage []ClassCoverage
+	for class := range Classes() {
+		glyphs, ok := ClassGlyphs(class)
+		if !ok {
+			continue
+		}
+
+		c := ClassCoverage{Class: class}
+		seen := make(map[Glyph]struct{})
+		for _, g := range glyphs.All() {
+			if _, ok := seen[g]; ok || g.IsZero() {
+				continue
+			}
+			seen[g] = struct{}{}
+			c.Total++
+			if cmap.covers(g) {
+				c.Present++
+			}
+		}
+		coverage = append(coverage, c)
+	}
+	return coverage, nil
+}
+
+// Covers returns true if the font file at path has a glyph for every codepoint
+// of the provided glyphs, i.e. they will render using the font rather than a
+// fallback font. Supported formats are the same as [ReadFontInfo].
+func Covers(path string, glyphs ...Glyph) (bool, error) {
+	cmap, err := readCmap(path)
+	if err != nil {
+		return false, err
+	}
+	for _, g := range glyphs {
+		if !cmap.covers(g) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// cmapRange is an inclusive range of codepoints.
+type cmapRange struct {
+	lo, hi rune
+}
+
+// fontCmap is the set of codepoints mapped to a glyph by a font, as sorted,
+// non-overlapping ranges.
+type fontCmap []cmapRange
+
+// has returns true if the codepoint is mapped to a glyph.
+func (m fontCmap) has(r rune) bool {
+	i := sort.Search(len(m), func(i int) bool { return m[i].hi >= r })
+	return i < len(m) && m[i].lo <= r
+}
+
+// covers returns true if all codepoints of the glyph are mapped to a glyph.
+func (m fontCmap) covers(g Glyph) bool {
+	for _, r := range string(g) {
+		if !m.has(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// add adds the range of codepoints. [fontCmap.normalize] must be called once
+// all ranges have been added.
+func (m *fontCmap) add(lo, hi rune) {
+	if n := len(*m); n > 0 && (*m)[n-1].hi+1 == lo {
+		(*m)[n-1].hi = hi
+		return
+	}
+	*m = append(*m, cmapRange{lo: lo, hi: hi})
+}
+
+// normalize sorts and merges overlapping or adjacent ranges.
+func (m *fontCmap) normalize() {
+	slices.SortFunc(*m, func(a, b cmapRange) int { return int(a.lo - b.lo) })
+
+	var out fontCmap
+	for _, r := range *m {
+		if n := len(out); n > 0 && r.lo <= out[n-1].hi+1 {
+			out[n-1].hi = max(out[n-1].hi, r.hi)
+			continue
+		}
+		out = append(out, r)
+	}
+	*m = out
+}
+
+// readCmap reads the character maps of the fonts in the font file at path.
+func readCmap(path string) (fontCmap, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	fi, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	fonts, err := readSFNT(f, fi.Size())
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %q: %w", path, err)
+	}
+
+	var cmap fontCmap
+	for _, font := range fonts {
+		data, ok, err := font.table("cmap")
+		if err == nil && !ok {
+			err = fmt.Errorf("%w: missing cmap table", errMalformedFont)
+		}
+		if err == nil {
+			err = parseCmapTable(data, &cmap)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %q: %w", path, err)
+		}
+	}
+	cmap.normalize()
+	return cmap, nil
+}
+
+// parseCmapTable parses the Unicode subtables of the "cmap" table, adding the
+// mapped codepoints to cmap. Formats 4 (BMP), 12 and 13 (full Unicode range)
+// are supported, which covers all Unicode subtables in practice. Other
+// subtables are ignored.
+func parseCmapTable(data []byte, cmap *fontCmap) error {
+	if len(data) < 4 {
+		return fmt.Errorf("%w: cmap table too short", errMalformedFont)
+	}
+	count := int(binary.BigEndian.Uint16(data[2:]))
+	if 4+count*8 > len(data) {
+		return fmt.Errorf("%w: cmap records out of bounds", errMalformedFont)
+	}
+
+	var found bool
+	for i := range count {
+		rec := data[4+i*8:]
+		platform := binary.BigEndian.Uint16(rec)
+		encoding := binary.BigEndian.Uint16(rec[2:])
+		offset := int(binary.BigEndian.Uint32(rec[4:]))
+
+		// Unicode, or Windows Unicode BMP (1) and full repertoire (10).
+		if platform != 0 && (platform != 3 || (encoding != 1 && encoding != 10)) {
+			continue
+		}
+		if offset+2 > len(data) {
+			return fmt.Errorf("%w: cmap subtable out of bounds", errMalformedFont)
+		}
+
+		var err error
+		switch binary.BigEndian.Uint16(data[offset:]) {
+		case 4:
+			err = parseCmapFormat4(data[offset:], cmap)
+		case 12, 13:
+			err = parseCmapFormat12(data[offset:], cmap)
+		default:
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		found = true
+	}
+
+	if !found {
+		return fmt.Errorf("%w: no supported unicode cmap subtable", ErrUnsupportedFont)
+	}
+	return nil
+}
+
+// parseCmapFormat4 parses a format 4 (segment mapping to delta values) cmap
+// subtable.
+func parseCmapFormat4(data []byte, cmap *fontCmap) error {
+	if len(data) < 14 {
+		return fmt.Errorf("%w: cmap format 4 subtable too short", errMalformedFont)
+	}
+	segs := int(binary.BigEndian.Uint16(data[6:])) / 2
+	// endCode[segs], reservedPad, startCode[segs], idDelta[segs], idRangeOffset[segs].
+	ends := 14
+	starts := ends + segs*2 + 2
+	deltas := starts + segs*2
+	rangeOffsets := deltas + segs*2
+	if rangeOffsets+segs*2 > len(data) {
+		return fmt.Errorf("%w: cmap format 4 segments out of bounds", errMalformedFont)
+	}
+
+	for i := range segs {
+		end := rune(binary.BigEndian.Uint16(data[ends+i*2:]))
+		start := rune(binary.BigEndian.Uint16(data[starts+i*2:]))
+		delta := binary.BigEndian.Uint16(data[deltas+i*2:])
+		rangeOffset := int(binary.BigEndian.Uint16(data[rangeOffsets+i*2:]))
+		if start > end || start == 0xFFFF {
+			continue
+		}
+
+		for c := start; c <= end; c++ {
+			var glyph uint16
+			if rangeOffset == 0 {
+				glyph = uint16(c) + delta
+			} else {
+				// The offset is relative to the idRangeOffset entry itself.
+				idx := rangeOffsets + i*2 + rangeOffset + int(c-start)*2
+				if idx+2 > len(data) {
+					return fmt.Errorf("%w: cmap format 4 glyph index out of bounds", errMalformedFont)
+				}
+				if glyph = binary.BigEndian.Uint16(data[idx:]); glyph != 0 {
+					glyph += delta
+				}
+			}
+			if glyph != 0 {
+				cmap.add(c, c)
+			}
+		}
+	}
+	return nil
+}
+
+// parseCmapFormat12 parses a format 12 (segmented coverage) or 13 (many-to-one
+// range mappings) cmap subtable, which share the same layout.
+func parseCmapFormat12(data []byte, cmap *fontCmap) error {
+	if len(data) < 16 {
+		return fmt.Errorf("%w: cmap format 12 subtable too short", errMalformedFont)
+	}
+	format := binary.BigEndian.Uint16(data)
+	groups := int64(binary.BigEndian.Uint32(data[12:]))
+	if 16+groups*12 > int64(len(data)) {
+		return fmt.Errorf("%w: cmap format %d groups out of bounds", errMalformedFont, format)
+	}
+
+	for i := range int(groups) {
+		g := data[16+i*12:]
+		start := binary.BigEndian.Uint32(g)
+		end := binary.BigEndian.Uint32(g[4:])
+		glyph := binary.BigEndian.Uint32(g[8:])
+		if start > end || end > 0x10FFFF {
+			continue
+		}
+
+		// Glyph 0 is the missing glyph. For format 12 glyphs are sequential, so
+		// only the first codepoint of the group can map to it.
+		if glyph == 0 {
+			if format == 13 {
+				continue
+			}
+			if start++; start > end {
+				continue
+			}
+		}
+		cmap.add(rune(start), rune(end))
+	}
+	return nil
+}
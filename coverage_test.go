@@ -0,0 +1,254 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package nf
+
+import (
+	"cmp"
+	"encoding/binary"
+	"errors"
+	"slices"
+	"testing"
+)
+
+type testCmapSegment struct {
+	lo, hi rune
+	glyphs []uint16 // Glyph IDs for each codepoint, or sequential if nil.
+}
+
+// testCmap4 builds a format 4 cmap subtable from the BMP segments.
+func testCmap4(segments ...testCmapSegment) []byte {
+	segments = append(segments, testCmapSegment{lo: 0xFFFF, hi: 0xFFFF})
+	n := len(segments)
+
+	var ends, starts, deltas, offsets, glyphArray []byte
+	for i, s := range segments {
+		ends = binary.BigEndian.AppendUint16(ends, uint16(s.hi))
+		starts = binary.BigEndian.AppendUint16(starts, uint16(s.lo))
+		if s.glyphs == nil {
+			deltas = binary.BigEndian.AppendUint16(deltas, uint16(1-s.lo))
+			offsets = binary.BigEndian.AppendUint16(offsets, 0)
+			continue
+		}
+		deltas = binary.BigEndian.AppendUint16(deltas, 0)
+		offsets = binary.BigEndian.AppendUint16(offsets, uint16((n-i)*2+len(glyphArray)))
+		for _, g := range s.glyphs {
+			glyphArray = binary.BigEndian.AppendUint16(glyphArray, g)
+		}
+	}
+
+	b := binary.BigEndian.AppendUint16(nil, 4)
+	b = binary.BigEndian.AppendUint16(b, 0) // Length, unused.
+	b = binary.BigEndian.AppendUint16(b, 0)
+	b = binary.BigEndian.AppendUint16(b, uint16(n*2))
+	b = append(b, make([]byte, 6)...) // Search hints, unused.
+	b = append(b, ends...)
+	b = append(b, 0, 0)
+	b = append(b, starts...)
+	b = append(b, deltas...)
+	b = append(b, offsets...)
+	return append(b, glyphArray...)
+}
+
+// testCmap12 builds a format 12 cmap subtable from the groups.
+func testCmap12(groups ...cmapRange) []byte {
+	b := binary.BigEndian.AppendUint16(nil, 12)
+	b = append(b, make([]byte, 10)...)
+	b = binary.BigEndian.AppendUint32(b, uint32(len(groups)))
+	for i, g := range groups {
+		b = binary.BigEndian.AppendUint32(b, uint32(g.lo))
+		b = binary.BigEndian.AppendUint32(b, uint32(g.hi))
+		b = binary.BigEndian.AppendUint32(b, uint32(i*1000+1))
+	}
+	return b
+}
+
+type testCmapSubtable struct {
+	platform, encoding uint16
+	data               []byte
+}
+
+// testCmapTable builds a "cmap" table from the subtables.
+func testCmapTable(subtables ...testCmapSubtable) []byte {
+	b := binary.BigEndian.AppendUint16(nil, 0)
+	b = binary.BigEndian.AppendUint16(b, uint16(len(subtables)))
+
+	var data []byte
+	offset := 4 + len(subtables)*8
+	for _, s := range subtables {
+		b = binary.BigEndian.AppendUint16(b, s.platform)
+		b = binary.BigEndian.AppendUint16(b, s.encoding)
+		b = binary.BigEndian.AppendUint32(b, uint32(offset+len(data)))
+		data = append(data, s.data...)
+	}
+	return append(b, data...)
+}
+
+// testFontCmap returns the tables of a font with the provided cmap table.
+func testFontCmap(cmap []byte) map[string][]byte {
+	tables := testFontNames("Test Mono", "1.0")
+	tables["cmap"] = cmap
+	return tables
+}
+
+func TestParseCmapTable(t *testing.T) {
+	t.Parallel()
+
+	// The first group maps its first codepoint to glyph 0 (the missing glyph).
+	format12 := testCmap12(
+		cmapRange{lo: 0xf0001, hi: 0xf0010},
+		cmapRange{lo: 'A', hi: 'Z'},
+	)
+	binary.BigEndian.PutUint32(format12[24:], 0)
+
+	table := testCmapTable(
+		// Unsupported platforms and formats are ignored.
+		testCmapSubtable{1, 0, []byte{0, 0, 0, 0}},
+		testCmapSubtable{3, 1, testCmap4(
+			testCmapSegment{lo: 'a', hi: 'z'},
+			// The glyph for 0xe002 is missing.
+			testCmapSegment{lo: 0xe000, hi: 0xe003, glyphs: []uint16{5, 6, 0, 7}},
+		)},
+		testCmapSubtable{3, 10, format12},
+		testCmapSubtable{0, 6, []byte{0, 14, 0, 0}},
+	)
+
+	var cmap fontCmap
+	if err := parseCmapTable(table, &cmap); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	cmap.normalize()
+
+	want := fontCmap{
+		{'A', 'Z'},
+		{'a', 'z'},
+		{0xe000, 0xe001},
+		{0xe003, 0xe003},
+		{0xf0002, 0xf0010},
+	}
+	if !slices.Equal(cmap, want) {
+		t.Errorf("expected:\n%q\ngot:\n%q", want, cmap)
+	}
+
+	for r, want := range map[rune]bool{'a': true, 'Z': true, '0': false, 0xe002: false, 0xe003: true, 0xf0010: true, 0xf0011: false} {
+		if got := cmap.has(r); got != want {
+			t.Errorf("has(%U) = %t, want %t", r, got, want)
+		}
+	}
+}
+
+func TestParseCmapTableErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		table []byte
+		want  error
+	}{
+		{name: "short", table: []byte{0, 0}, want: errMalformedFont},
+		{name: "records", table: []byte{0, 0, 0, 2, 0, 3}, want: errMalformedFont},
+		{name: "no-unicode", table: testCmapTable(testCmapSubtable{1, 0, []byte{0, 0, 0, 0}}), want: ErrUnsupportedFont},
+		{name: "format-4", table: testCmapTable(testCmapSubtable{3, 1, testCmap4()[:16]}), want: errMalformedFont},
+		{name: "format-12", table: testCmapTable(testCmapSubtable{3, 10, testCmap12(cmapRange{1, 2})[:20]}), want: errMalformedFont},
+	}
+
+	for _, tt := range tests {
+		var cmap fontCmap
+		if err := parseCmapTable(tt.table, &cmap); !errors.Is(err, tt.want) {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.want, err)
+		}
+	}
+}
+
+func TestFontCoverage(t *testing.T) {
+	t.Parallel()
+
+	MustRegisterClass("nftestcoverfull", GlyphMap{"a": "\ue0a0", "b": "\ue0a1", "alias": "\ue0a0"})
+	MustRegisterClass("nftestcoverpartial", GlyphMap{"a": "\U000f1000", "b": "\U000f1001", "c": "\U000f2000"})
+
+	font := testFontCmap(testCmapTable(
+		testCmapSubtable{3, 1, testCmap4(testCmapSegment{lo: 0xe0a0, hi: 0xe0a3})},
+		testCmapSubtable{3, 10, testCmap12(cmapRange{lo: 0xe0a0, hi: 0xe0a3}, cmapRange{lo: 0xf0fff, hi: 0xf1001})},
+	))
+	// Collections combine the character maps of all fonts.
+	other := testFontCmap(testCmapTable(testCmapSubtable{0, 4, testCmap12(cmapRange{lo: 0xe000, hi: 0xe0a1})}))
+
+	for name, data := range map[string][]byte{
+		"font.ttf":        testSFNT(font, 0),
+		"font.woff":       testWOFF(font, true),
+		"collection.ttc":  testTTC(testFontCmap(testCmapTable(testCmapSubtable{3, 10, testCmap12(cmapRange{lo: 0xf0fff, hi: 0xf1001})})), other),
+		"collection2.ttc": testTTC(other, font),
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			coverage, err := FontCoverage(writeFont(t, name, data))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			got := make(map[Class]ClassCoverage)
+			for _, c := range coverage {
+				got[c.Class] = c
+			}
+			want := map[Class]ClassCoverage{
+				"nftestcoverfull":    {Class: "nftestcoverfull", Present: 2, Total: 2},
+				"nftestcoverpartial": {Class: "nftestcoverpartial", Present: 2, Total: 3},
+			}
+			for class, w := range want {
+				if got[class] != w {
+					t.Errorf("expected %+v, got %+v", w, got[class])
+				}
+			}
+			if !slices.IsSortedFunc(coverage, func(a, b ClassCoverage) int { return cmp.Compare(a.Class, b.Class) }) {
+				t.Errorf("expected coverage sorted by class, got %+v", coverage)
+			}
+		})
+	}
+
+	c := ClassCoverage{Class: "nftestcoverpartial", Present: 2, Total: 3}
+	if c.Complete() || c.Ratio() != 2.0/3.0 {
+		t.Errorf("expected incomplete coverage, got %t, %v", c.Complete(), c.Ratio())
+	}
+	if c := (ClassCoverage{}); !c.Complete() || c.Ratio() != 1 {
+		t.Errorf("expected empty class to be complete, got %t, %v", c.Complete(), c.Ratio())
+	}
+}
+
+func TestCovers(t *testing.T) {
+	t.Parallel()
+
+	path := writeFont(t, "font.otf", testSFNT(testFontCmap(testCmapTable(
+		testCmapSubtable{3, 10, testCmap12(cmapRange{lo: 'a', hi: 'z'}, cmapRange{lo: 0xe700, hi: 0xe8ef})},
+	)), 0))
+
+	tests := []struct {
+		glyphs []Glyph
+		want   bool
+	}{
+		{glyphs: nil, want: true},
+		{glyphs: []Glyph{"\ue700", "\ue8ef"}, want: true},
+		{glyphs: []Glyph{"\ue700", "\ue8f0"}, want: false},
+		// Every codepoint of a glyph must be present.
+		{glyphs: []Glyph{"\ue700a"}, want: true},
+		{glyphs: []Glyph{"\ue700A"}, want: false},
+	}
+
+	for _, tt := range tests {
+		got, err := Covers(path, tt.glyphs...)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got != tt.want {
+			t.Errorf("Covers(%q) = %t, want %t", tt.glyphs, got, tt.want)
+		}
+	}
+
+	if _, err := Covers(writeFont(t, "font.woff2", []byte("wOF2\x00\x01\x00\x00\x00\x00\x00\x00")), "\ue700"); !errors.Is(err, ErrUnsupportedFont) {
+		t.Errorf("expected unsupported font error, got %v", err)
+	}
+	if _, err := Covers(writeFont(t, "no-cmap.ttf", testSFNT(testFontNames("Hack", "1.0"), 0))); !errors.Is(err, errMalformedFont) {
+		t.Errorf("expected malformed font error, got %v", err)
+	}
+}
@@ -8,8 +8,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"os"
 	"regexp"
+	"slices"
 	"strconv"
 )
 
@@ -19,9 +19,23 @@ var fontNameMatchers = []*regexp.Regexp{
 	regexp.MustCompile(`(?i)\bnf[pm]?\b`),
 }
 
+// IsNerdFontName returns true if the font family (e.g. "JetBrainsMono Nerd Font",
+// "Hack NFM"), or font file name (e.g. "HackNerdFont-Regular.ttf") looks like a
+// Nerd Font.
+func IsNerdFontName(name string) bool {
+	return slices.ContainsFunc(fontNameMatchers, func(m *regexp.Regexp) bool {
+		return m.MatchString(name)
+	})
+}
+
 // InstallStatus represents the detected status of Nerd Fonts being installed.
 type InstallStatus int
 
+// MarshalText encodes the status as its string form (e.g. "not installed").
+func (s InstallStatus) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
 func (s InstallStatus) String() string {
 	switch s {
 	case StatusDisabled:
@@ -73,8 +87,11 @@ func DefaultDetectors() []InstallDetector {
 		DetectorEnvVar("NERD_FONTS"),
 		DetectorEnvVar("NERDFONTS"),
 		DetectorEnvVar("NF_FONTS"),
+		DetectorTerminalConfig(),
 		DetectorWindowsGDI(),
+		DetectorFontConfigFallback(),
 		DetectorFontConfig(),
+		DetectorFontConfigCharset(),
 		DetectorFilesystem(),
 	}
 }
@@ -91,9 +108,15 @@ func DefaultDetectors() []InstallDetector {
 //
 // Default detectors are:
 //   - env vars: NERD_FONTS (preferred), NERDFONTS, NF_FONTS (all platforms)
+//   - terminal config: reads the font from the config of the current terminal
+//     emulator, see [DetectorTerminalConfig] (all platforms)
 //   - Windows GDI: uses the Windows GDI API to enumerate installed fonts (windows only)
-//   - FontConfig: uses the fontconfig CLI to enumerate installed fonts (unix only)
-//   - Filesystem: checks the filesystem for font files in common locations (unix only)
+//   - FontConfig: reads fontconfig fallback rules for the monospace family, see
+//     [DetectorFontConfigFallback], and uses the fontconfig CLI to enumerate
+//     installed fonts, see [DetectorFontConfig], and to find fonts which have
+//     Nerd Font glyphs, see [DetectorFontConfigCharset] (unix only)
+//   - Filesystem: checks the filesystem for font files in the XDG font directories,
+//     and Nix, Flatpak, Homebrew, and macOS font directories (non-windows)
 //
 // Why is it difficult to detect if Nerd Fonts are being used?: See
 // https://github.com/ryanoasis/nerd-fonts/discussions/829
@@ -110,8 +133,7 @@ func DetectInstalled(ctx context.Context, detectors ...InstallDetector) (Install
 			errs = append(errs, err)
 			continue
 		}
-		switch status { //nolint:exhaustive
-		case StatusEnabled, StatusDisabled, StatusInstalled:
+		if isDecisive(status) {
 			return status, nil
 		}
 	}
@@ -122,16 +144,27 @@ func DetectInstalled(ctx context.Context, detectors ...InstallDetector) (Install
 	return StatusNotInstalled, nil
 }
 
+// isDecisive returns true if the status stops detection, i.e. [StatusEnabled],
+// [StatusDisabled], or [StatusInstalled].
+func isDecisive(status InstallStatus) bool {
+	switch status { //nolint:exhaustive
+	case StatusEnabled, StatusDisabled, StatusInstalled:
+		return true
+	}
+	return false
+}
+
 // DetectorEnvVar returns an [InstallDetector] that checks for the presence of
 // the provided environment variable name, and parses it as a boolean, returning
 // [StatusEnabled] if true, [StatusDisabled] if false, and [StatusNotInstalled]
 // if the environment variable is not set.
 func DetectorEnvVar(name string) InstallDetector {
-	return func(_ context.Context) (InstallStatus, error) {
-		v := os.Getenv(name)
+	return Named("env:"+name, func(ctx context.Context) (InstallStatus, error) {
+		v := EnvFrom(ctx).Getenv(name)
 		if v == "" {
 			return StatusNotInstalled, nil
 		}
+		AddEvidence(ctx, Evidence{Kind: EvidenceEnv, Value: name + "=" + v})
 		vv, err := strconv.ParseBool(v)
 		if err != nil {
 			return StatusNotInstalled, fmt.Errorf("failed to parse env var %q: %w", name, err)
@@ -140,5 +173,5 @@ func DetectorEnvVar(name string) InstallDetector {
 			return StatusEnabled, nil
 		}
 		return StatusDisabled, nil
-	}
+	})
 }
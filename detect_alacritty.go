@@ -0,0 +1,90 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package nf
+
+import (
+	"path"
+	"strings"
+)
+
+// alacrittyConfig reads the font from the Alacritty config, which is either TOML
+// (alacritty.toml, v0.13+), or YAML (alacritty.yml, legacy). See
+// https://alacritty.org/config-alacritty.html
+var alacrittyConfig = &terminalConfig{
+	paths:  alacrittyConfigPaths,
+	format: parseAlacrittyConfig,
+	fonts:  alacrittyFonts,
+}
+
+// alacrittyConfigPaths returns the paths Alacritty searches for its config, in
+// order of precedence. TOML configs take precedence over legacy YAML configs.
+func alacrittyConfigPaths(e *configEnv) []string {
+	var bases []string
+	if e.goos == "windows" {
+		if dir := e.getenv("APPDATA"); dir != "" {
+			bases = append(bases, path.Join(e.expand(dir, "/"), "alacritty/alacritty"))
+		}
+	} else {
+		bases = append(
+			bases,
+			path.Join(e.configHome(), "alacritty/alacritty"),
+			path.Join(e.configHome(), "alacritty"),
+			path.Join(e.home, ".config/alacritty/alacritty"),
+			path.Join(e.home, ".alacritty"),
+			"/etc/alacritty/alacritty",
+		)
+	}
+
+	paths := make([]string, 0, len(bases)*3)
+	for _, ext := range []string{".toml", ".yml", ".yaml"} {
+		for _, base := range bases {
+			paths = append(paths, base+ext)
+		}
+	}
+	return paths
+}
+
+// parseAlacrittyConfig parses an Alacritty config, following imports
+// ("general.import", or "import" in older versions). Imports are loaded in
+// order, before the importing file, such that the importing file overrides
+// them.
+func parseAlacrittyConfig(e *configEnv, data []byte, file string, include func(p string) []configLine) ([]configLine, error) {
+	var lines []configLine
+	var err error
+
+	switch path.Ext(file) {
+	case ".yml", ".yaml":
+		lines, err = parseYAML(data)
+	default:
+		lines, err = parseTOML(data)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var imported []configLine
+	for _, l := range lines {
+		if l.key == "import" || l.key == "general.import" {
+			imported = append(imported, include(e.expand(l.value, path.Dir(file)))...)
+		}
+	}
+	return append(imported, lines...), nil
+}
+
+// alacrittyFonts returns the effective font.normal.family. Families can also be
+// set through an inline table (e.g. `normal = { family = "..." }`), which the
+// TOML parser flattens to the same key.
+func alacrittyFonts(lines []configLine) []string {
+	var family string
+	for _, l := range lines {
+		if strings.EqualFold(l.key, "font.normal.family") {
+			family = l.value
+		}
+	}
+	if family == "" {
+		return nil
+	}
+	return []string{family}
+}
@@ -0,0 +1,85 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package nf
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func TestAlacrittyFonts(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		fixture string
+		want    []string
+		status  InstallStatus
+		wantErr bool
+	}{
+		{fixture: "imports", want: []string{"JetBrainsMono Nerd Font"}, status: StatusEnabled},
+		{fixture: "override", want: []string{"Fira Code"}, status: StatusNotInstalled},
+		{fixture: "legacy-yaml", want: []string{"Hack Nerd Font Mono"}, status: StatusEnabled},
+		{fixture: "malformed", want: nil, status: StatusNotInstalled, wantErr: true},
+		// The font is still found, even though an imported file is malformed.
+		{fixture: "malformed-import", want: []string{"Iosevka Nerd Font"}, status: StatusEnabled, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			t.Parallel()
+
+			e := testConfigEnv(
+				map[string]string{"ALACRITTY_WINDOW_ID": "1"},
+				nil,
+			)
+			e.fsys = os.DirFS(filepath.Join("testdata", "alacritty", tt.fixture))
+
+			got, err := terminalFonts(t.Context(), e, alacrittyConfig)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error: %t, got %v", tt.wantErr, err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("expected fonts %q, got %q", tt.want, got)
+			}
+
+			status, err := detectTerminalConfig(t.Context(), e)
+			if status != tt.status {
+				t.Errorf("expected status %q, got %q", tt.status, status)
+			}
+			if status == StatusNotInstalled && (err != nil) != tt.wantErr {
+				t.Errorf("expected detector error: %t, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestAlacrittyConfigPaths(t *testing.T) {
+	t.Parallel()
+
+	e := testConfigEnv(map[string]string{"XDG_CONFIG_HOME": "/xdg"}, nil)
+	paths := alacrittyConfigPaths(e)
+
+	want := []string{
+		"/xdg/alacritty/alacritty.toml",
+		"/xdg/alacritty.toml",
+		"/home/user/.config/alacritty/alacritty.toml",
+		"/home/user/.alacritty.toml",
+		"/etc/alacritty/alacritty.toml",
+	}
+	if !slices.Equal(paths[:len(want)], want) {
+		t.Errorf("expected TOML paths first %q, got %q", want, paths)
+	}
+	if !slices.Contains(paths, "/home/user/.alacritty.yml") {
+		t.Errorf("expected legacy YAML path, got %q", paths)
+	}
+
+	e = testConfigEnv(map[string]string{"APPDATA": `C:\Users\user\AppData\Roaming`}, nil)
+	e.goos = "windows"
+	if paths = alacrittyConfigPaths(e); paths[0] != "C:/Users/user/AppData/Roaming/alacritty/alacritty.toml" {
+		t.Errorf("expected APPDATA path, got %q", paths)
+	}
+}
@@ -0,0 +1,257 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package nf
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// DefaultCacheTTL is the default TTL of cached detection results, see
+// [WithCache].
+const DefaultCacheTTL = 24 * time.Hour
+
+// cacheMaxDepth is the maximum depth of directories within the watched paths,
+// whose modification times invalidate cached results.
+const cacheMaxDepth = 3
+
+// cacheEnvVars are the environment variables which are part of the cache key,
+// as they change the result of the default detectors.
+var cacheEnvVars = []string{
+	"NERD_FONTS",
+	"NERDFONTS",
+	"NF_FONTS",
+	"TERM",
+	"TERM_PROGRAM",
+	"HOME",
+	"XDG_CONFIG_HOME",
+	"XDG_DATA_HOME",
+	"XDG_DATA_DIRS",
+	"FONTCONFIG_FILE",
+	"FONTCONFIG_PATH",
+	"DISPLAY",
+	"WT_PROFILE_ID",
+	"WSL_DISTRO_NAME",
+}
+
+// CacheOptions configures [WithCache].
+type CacheOptions struct {
+	// Key identifies the cached result, and must be unique for each detector
+	// wrapped with [WithCache], including detectors created by the same function
+	// with different options (e.g. [DetectorFilesystemWith]). Required.
+	Key string
+	// TTL is how long the result is cached for. Defaults to [DefaultCacheTTL].
+	TTL time.Duration
+	// Dir is the directory the cache is stored in. Defaults to "go-nf" in
+	// [os.UserCacheDir].
+	Dir string
+}
+
+// WithCache returns a detector which caches the result of detector on disk,
+// such that slow detectors (e.g. [DetectorFilesystem], [DetectorFontConfig])
+// don't need to run on every invocation of short-lived programs, like CLIs.
+//
+// Results are cached per terminal (see [CurrentTerminal]) and environment
+// variables which affect detection (e.g. NERD_FONTS, TERM, XDG_CONFIG_HOME),
+// and are invalidated after the TTL, or when the modification time of font
+// directories, the fontconfig cache and config, or the config file of the
+// current terminal changes. Results with errors aren't cached. Failures to
+// read or write the cache are ignored, and the detector is run instead.
+//
+// Cached results include the name and evidence of the detector, for
+// [DetectReport], along with [EvidenceCache] evidence.
+//
+// WithCache panics if opts.Key is empty, as results of different detectors
+// would otherwise be mixed up.
+func WithCache(detector InstallDetector, opts CacheOptions) InstallDetector {
+	if opts.Key == "" {
+		panic("nf: WithCache requires a cache key")
+	}
+	if opts.TTL <= 0 {
+		opts.TTL = DefaultCacheTTL
+	}
+	if opts.Dir == "" {
+		if dir, err := os.UserCacheDir(); err == nil {
+			opts.Dir = filepath.Join(dir, "go-nf")
+		}
+	}
+
+	return func(ctx context.Context) (InstallStatus, error) {
+		if opts.Dir == "" {
+			return detector(ctx)
+		}
+		return cachedDetect(ctx, envFrom(ctx), detector, opts)
+	}
+}
+
+// cacheEntry is a cached detection result.
+type cacheEntry struct {
+	Created     time.Time  `json:"created"`
+	Fingerprint string     `json:"fingerprint"`
+	Name        string     `json:"name"`
+	Status      int        `json:"status"`
+	Evidence    []Evidence `json:"evidence,omitempty"`
+}
+
+func cachedDetect(ctx context.Context, e *configEnv, detector InstallDetector, opts CacheOptions) (InstallStatus, error) {
+	file := filepath.Join(opts.Dir, cacheFileName(e, opts.Key))
+	fingerprint := cacheFingerprint(e)
+
+	if entry, ok := readCacheEntry(file); ok &&
+		entry.Fingerprint == fingerprint &&
+		time.Since(entry.Created) < opts.TTL {
+		setDetectorName(ctx, entry.Name)
+		AddEvidence(ctx, entry.Evidence...)
+		AddEvidence(ctx, Evidence{Kind: EvidenceCache, Value: entry.Created.Format(time.RFC3339), Path: file})
+		return InstallStatus(entry.Status), nil
+	}
+
+	res := runDetector(ctx, detector)
+	setDetectorName(ctx, res.Name)
+	AddEvidence(ctx, res.Evidence...)
+
+	if res.Err == nil && ctx.Err() == nil {
+		_ = writeCacheEntry(file, &cacheEntry{
+			Created:     time.Now(),
+			Fingerprint: fingerprint,
+			Name:        res.Name,
+			Status:      int(res.Status),
+			Evidence:    res.Evidence,
+		})
+	}
+	return res.Status, res.Err
+}
+
+// cacheFileName returns the file name of the cache entry for key, for the
+// current terminal and environment.
+func cacheFileName(e *configEnv, key string) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%s\x00%s\x00", key, TerminalFrom(e.getenv))
+	for _, name := range cacheEnvVars {
+		fmt.Fprintf(h, "%s=%s\x00", name, e.getenv(name))
+	}
+	return "detect-" + hex.EncodeToString(h.Sum(nil))[:32] + ".json"
+}
+
+// cacheWatchPaths returns the files and directories, which when modified,
+// invalidate cached results.
+func cacheWatchPaths(e *configEnv) []string {
+	cacheHome := e.getenv("XDG_CACHE_HOME")
+	if cacheHome == "" {
+		cacheHome = path.Join(e.home, ".cache")
+	}
+
+	paths := defaultFontDirs(e)
+	paths = append(paths,
+		// fontconfig cache, which is updated when fonts are installed, and config.
+		"/var/cache/fontconfig",
+		path.Join(cacheHome, "fontconfig"),
+		"/etc/fonts",
+		path.Join(e.configHome(), "fontconfig"),
+	)
+	if dir := e.getenv("WINDIR"); dir != "" {
+		paths = append(paths, e.expand(path.Join(dir, "Fonts"), ""))
+	}
+	if dir := e.getenv("LOCALAPPDATA"); dir != "" {
+		paths = append(paths, e.expand(path.Join(dir, "Microsoft/Windows/Fonts"), ""))
+	}
+
+	if cfg, ok := terminalConfigs[TerminalFrom(e.getenv)]; ok && cfg.paths != nil {
+		paths = append(paths, cfg.paths(e)...)
+	}
+	return paths
+}
+
+// cacheFingerprint returns a hash of the modification times of the watched
+// paths (see [cacheWatchPaths]), and the directories within them. Adding or
+// removing a file changes the modification time of its directory.
+func cacheFingerprint(e *configEnv) string {
+	h := sha256.New()
+	for _, p := range cacheWatchPaths(e) {
+		root := fsPath(p)
+		fi, err := fs.Stat(e.fsys, root)
+		if err != nil {
+			fmt.Fprintf(h, "%s -\n", p)
+			continue
+		}
+		if !fi.IsDir() {
+			writeModTime(h, p, fi)
+			continue
+		}
+
+		depth := strings.Count(root, "/")
+		_ = fs.WalkDir(e.fsys, root, func(p string, d fs.DirEntry, err error) error {
+			if err != nil || !d.IsDir() {
+				return nil //nolint:nilerr
+			}
+			if strings.Count(p, "/")-depth > cacheMaxDepth {
+				return fs.SkipDir
+			}
+			if fi, err := d.Info(); err == nil {
+				writeModTime(h, p, fi)
+			}
+			return nil
+		})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func writeModTime(h hash.Hash, p string, fi fs.FileInfo) {
+	fmt.Fprintf(h, "%s %d\n", p, fi.ModTime().UnixNano())
+}
+
+func readCacheEntry(file string) (*cacheEntry, bool) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, false
+	}
+
+	var entry cacheEntry
+	if err = json.Unmarshal(data, &entry); err != nil {
+		return nil, false
+	}
+	if entry.Status < int(StatusDisabled) || entry.Status > int(StatusInstalled) {
+		return nil, false
+	}
+	return &entry, true
+}
+
+// writeCacheEntry writes the entry to a temporary file, which is then renamed,
+// such that concurrent readers never see a partially written entry.
+func writeCacheEntry(file string, entry *cacheEntry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	if err = os.MkdirAll(filepath.Dir(file), 0o750); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(file), filepath.Base(file)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err = tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), file)
+}
@@ -0,0 +1,200 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package nf
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"testing/fstest"
+	"time"
+)
+
+func TestWithCache(t *testing.T) {
+	t.Parallel()
+
+	modTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	files := fstest.MapFS{
+		"usr/share/fonts/TTF":                  &fstest.MapFile{Mode: fs.ModeDir, ModTime: modTime},
+		"usr/share/fonts/TTF/Hack-Regular.ttf": &fstest.MapFile{ModTime: modTime},
+	}
+	env := map[string]string{"TERM": "xterm-256color"}
+	e := testConfigEnv(env, files)
+
+	var calls int
+	var err error
+	detector := Named("counted", func(ctx context.Context) (InstallStatus, error) {
+		calls++
+		AddEvidence(ctx, Evidence{Kind: EvidenceFile, Value: "/usr/share/fonts/TTF/HackNerdFont-Regular.ttf"})
+		return StatusInstalled, err
+	})
+
+	opts := CacheOptions{Key: "test", TTL: time.Hour, Dir: t.TempDir()}
+	detect := func() InstallStatus {
+		t.Helper()
+		var status InstallStatus
+		report := DetectReport(t.Context(), func(ctx context.Context) (InstallStatus, error) {
+			status, _ = cachedDetect(ctx, e, detector, opts)
+			return status, nil
+		})
+		if res := report.Results[0]; res.Name != "counted" || len(res.Evidence) == 0 {
+			t.Errorf("expected name and evidence to be reported, got %+v", res)
+		}
+		return status
+	}
+
+	expectCalls := func(want int) {
+		t.Helper()
+		if status := detect(); status != StatusInstalled {
+			t.Errorf("expected installed, got %q", status)
+		}
+		if calls != want {
+			t.Errorf("expected detector to run %d times, ran %d times", want, calls)
+		}
+	}
+
+	expectCalls(1)
+	expectCalls(1)
+
+	// Cached results include cache evidence.
+	report := DetectReport(t.Context(), func(ctx context.Context) (InstallStatus, error) {
+		return cachedDetect(ctx, e, detector, opts)
+	})
+	if ev := report.Results[0].Evidence; len(ev) != 2 || ev[1].Kind != EvidenceCache {
+		t.Errorf("expected file and cache evidence, got %+v", ev)
+	}
+
+	// Adding a font changes the modification time of its directory.
+	files["usr/share/fonts/TTF"].ModTime = modTime.Add(time.Minute)
+	expectCalls(2)
+	expectCalls(2)
+
+	// New font directories invalidate the cache.
+	files["home/user/.local/share/fonts"] = &fstest.MapFile{Mode: fs.ModeDir, ModTime: modTime}
+	expectCalls(3)
+
+	// Environment variables which affect detection are part of the key.
+	env["NERD_FONTS"] = "1"
+	expectCalls(4)
+	delete(env, "NERD_FONTS")
+	expectCalls(4)
+
+	// Different keys are cached separately.
+	opts.Key = "other"
+	expectCalls(5)
+
+	// Expired results are ignored.
+	opts.TTL = time.Nanosecond
+	expectCalls(6)
+	expectCalls(7)
+	opts.TTL = time.Hour
+
+	// Errors aren't cached.
+	opts.Key = "errors"
+	err = errors.New("failed")
+	expectCalls(8)
+	expectCalls(9)
+	err = nil
+	expectCalls(10)
+	expectCalls(10)
+
+	// Corrupt entries are ignored.
+	entries, _ := filepath.Glob(filepath.Join(opts.Dir, "detect-*.json"))
+	if len(entries) == 0 {
+		t.Fatal("expected cache entries to be written")
+	}
+	for _, file := range entries {
+		if err := os.WriteFile(file, []byte("{"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	expectCalls(11)
+	expectCalls(11)
+}
+
+func TestWithCacheTerminalConfig(t *testing.T) {
+	t.Parallel()
+
+	modTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	files := fstest.MapFS{
+		"home/user/.config/kitty/kitty.conf": &fstest.MapFile{ModTime: modTime},
+	}
+	e := testConfigEnv(map[string]string{"TERM": "xterm-kitty"}, files)
+
+	before := cacheFingerprint(e)
+	if after := cacheFingerprint(e); before != after {
+		t.Error("expected fingerprint to be stable")
+	}
+
+	// Editing the terminal config invalidates the cache.
+	files["home/user/.config/kitty/kitty.conf"].ModTime = modTime.Add(time.Second)
+	if after := cacheFingerprint(e); before == after {
+		t.Error("expected fingerprint to change with the terminal config")
+	}
+}
+
+func TestWithCacheKey(t *testing.T) {
+	t.Parallel()
+
+	ctx := ContextWithEnv(t.Context(), &Env{
+		Getenv: func(key string) string { return map[string]string{"NERD_FONTS": "1"}[key] },
+		FS:     fstest.MapFS{},
+		Home:   "/home/user",
+		GOOS:   "linux",
+	})
+	dir := t.TempDir()
+
+	// Results of detectors with different keys are cached separately.
+	for range 2 {
+		for _, tt := range []struct {
+			detector InstallDetector
+			want     InstallStatus
+		}{
+			{WithCache(DetectorEnvVar("NERD_FONTS"), CacheOptions{Key: "nerd-fonts", Dir: dir}), StatusEnabled},
+			{WithCache(DetectorEnvVar("NF_FONTS"), CacheOptions{Key: "nf-fonts", Dir: dir}), StatusNotInstalled},
+		} {
+			if got, err := tt.detector(ctx); err != nil || got != tt.want {
+				t.Errorf("expected %q, got %q (%v)", tt.want, got, err)
+			}
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("expected panic without a cache key")
+		}
+	}()
+	WithCache(DetectorFilesystem(), CacheOptions{Dir: dir})
+}
+
+func TestWithCacheConcurrent(t *testing.T) {
+	// The default cache dir is resolved from the environment.
+	dir := t.TempDir()
+	t.Setenv("XDG_CACHE_HOME", dir)
+	t.Setenv("HOME", dir)
+	t.Setenv("LocalAppData", dir)
+
+	detector := WithCache(DetectorEnvVar("NERD_FONTS"), CacheOptions{Key: "nerd-fonts"})
+	ctx := ContextWithEnv(t.Context(), &Env{
+		Getenv: func(key string) string { return map[string]string{"NERD_FONTS": "1"}[key] },
+		FS:     fstest.MapFS{},
+		Home:   "/home/user",
+		GOOS:   "linux",
+	})
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Go(func() {
+			if got, err := detector(ctx); err != nil || got != StatusEnabled {
+				t.Errorf("expected enabled, got %q (%v)", got, err)
+			}
+		})
+	}
+	wg.Wait()
+}
@@ -0,0 +1,208 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package nf
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// WithTimeout returns a detector which runs detector with a timeout. If the
+// detector doesn't return in time, [StatusNotInstalled] is returned, with an
+// error wrapping [context.DeadlineExceeded]. The detector keeps running in the
+// background until it returns, though its context is cancelled.
+func WithTimeout(detector InstallDetector, timeout time.Duration) InstallDetector {
+	return func(ctx context.Context) (InstallStatus, error) {
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+
+		type result struct {
+			status InstallStatus
+			err    error
+		}
+		done := make(chan result, 1)
+		go func() {
+			status, err := detector(ctx)
+			done <- result{status, err}
+		}()
+
+		select {
+		case r := <-done:
+			return r.status, r.err
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return StatusNotInstalled, fmt.Errorf("detector timed out after %s: %w", timeout, ctx.Err())
+			}
+			return StatusNotInstalled, ctx.Err()
+		}
+	}
+}
+
+// Parallel returns a detector which runs the detectors concurrently, returning
+// the same result as running them in order with [DetectInstalled] would: the
+// status of the first detector (in the provided order) which returns
+// [StatusEnabled], [StatusDisabled], or [StatusInstalled] without an error,
+// even if a later detector finishes first. Once the result is known, the
+// contexts of the remaining detectors are cancelled. Useful for slow detectors,
+// such as [DetectorFilesystem] and [DetectorFontConfig], e.g.:
+//
+//	nf.DetectInstalled(ctx,
+//		nf.DetectorEnvVar("NERD_FONTS"),
+//		nf.DetectorTerminalConfig(),
+//		nf.Parallel(nf.DetectorFontConfigCharset(), nf.DetectorFilesystem()),
+//	)
+func Parallel(detectors ...InstallDetector) InstallDetector {
+	return func(ctx context.Context) (InstallStatus, error) {
+		setDetectorName(ctx, "parallel")
+
+		runCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		results := make([]chan DetectorResult, len(detectors))
+		for i, detector := range detectors {
+			results[i] = make(chan DetectorResult, 1)
+			go func() {
+				results[i] <- runDetector(runCtx, detector)
+			}()
+		}
+
+		var errs []error
+		for i := range detectors {
+			res := <-results[i]
+			AddEvidence(ctx, res.Evidence...)
+			if res.Err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", res.Name, res.Err))
+				continue
+			}
+			if isDecisive(res.Status) {
+				return res.Status, nil
+			}
+		}
+		return StatusNotInstalled, errors.Join(errs...)
+	}
+}
+
+// FirstOf returns a detector which runs the detectors in order, returning the
+// status of the first one which returns [StatusEnabled], [StatusDisabled], or
+// [StatusInstalled] without an error, like [DetectInstalled]. Useful to group
+// detectors, e.g. to use the group with [WithTimeout], [Memoize], or [Require].
+func FirstOf(detectors ...InstallDetector) InstallDetector {
+	return func(ctx context.Context) (InstallStatus, error) {
+		setDetectorName(ctx, "first-of")
+
+		var errs []error
+		for _, detector := range detectors {
+			res := runDetector(ctx, detector)
+			AddEvidence(ctx, res.Evidence...)
+			if res.Err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", res.Name, res.Err))
+				continue
+			}
+			if isDecisive(res.Status) {
+				return res.Status, nil
+			}
+		}
+		return StatusNotInstalled, errors.Join(errs...)
+	}
+}
+
+// Require returns a detector which runs the detectors in order, and only
+// returns a status other than [StatusNotInstalled] if all of them agree. If
+// all detectors return the same status, that status is returned. [StatusEnabled]
+// and [StatusInstalled] also agree, returning the weaker [StatusInstalled].
+// Detection stops at the first detector which doesn't agree, or returns an
+// error, e.g. to only enable glyphs if the terminal is configured with a Nerd
+// Font, and the font is actually installed:
+//
+//	nf.Require(nf.DetectorTerminalConfig(), nf.DetectorFilesystem())
+func Require(detectors ...InstallDetector) InstallDetector {
+	return func(ctx context.Context) (InstallStatus, error) {
+		setDetectorName(ctx, "require")
+
+		var status InstallStatus
+		for _, detector := range detectors {
+			res := runDetector(ctx, detector)
+			AddEvidence(ctx, res.Evidence...)
+			if res.Err != nil {
+				return StatusNotInstalled, fmt.Errorf("%s: %w", res.Name, res.Err)
+			}
+
+			switch {
+			case !isDecisive(res.Status):
+				return StatusNotInstalled, nil
+			case status == 0 || status == res.Status:
+				status = res.Status
+			case (status == StatusEnabled || status == StatusInstalled) &&
+				(res.Status == StatusEnabled || res.Status == StatusInstalled):
+				status = StatusInstalled
+			default:
+				return StatusNotInstalled, nil
+			}
+		}
+		if status == 0 {
+			return StatusNotInstalled, nil
+		}
+		return status, nil
+	}
+}
+
+// Not returns a detector which negates the status of detector: [StatusEnabled]
+// and [StatusInstalled] become [StatusDisabled], and [StatusDisabled] becomes
+// [StatusEnabled]. [StatusNotInstalled] is returned as is, and errors are
+// returned with [StatusNotInstalled]. Useful for opt-out environment variables,
+// e.g.:
+//
+//	nf.Not(nf.DetectorEnvVar("NO_NERD_FONTS"))
+func Not(detector InstallDetector) InstallDetector {
+	return func(ctx context.Context) (InstallStatus, error) {
+		setDetectorName(ctx, "not")
+
+		res := runDetector(ctx, detector)
+		AddEvidence(ctx, res.Evidence...)
+		if res.Err != nil {
+			return StatusNotInstalled, fmt.Errorf("%s: %w", res.Name, res.Err)
+		}
+
+		switch res.Status { //nolint:exhaustive
+		case StatusEnabled, StatusInstalled:
+			return StatusDisabled, nil
+		case StatusDisabled:
+			return StatusEnabled, nil
+		}
+		return StatusNotInstalled, nil
+	}
+}
+
+// Memoize returns a detector which only runs detector once, returning the same
+// result for subsequent calls (including its evidence, see [DetectReport]).
+// Results where the context was cancelled (or timed out) are not cached, so the
+// detector runs again on the next call. Concurrent calls wait for the first one
+// to finish.
+func Memoize(detector InstallDetector) InstallDetector {
+	var mu sync.Mutex
+	var cached *DetectorResult
+
+	return func(ctx context.Context) (InstallStatus, error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if cached == nil {
+			res := runDetector(ctx, detector)
+			if ctx.Err() != nil || errors.Is(res.Err, context.Canceled) || errors.Is(res.Err, context.DeadlineExceeded) {
+				setDetectorName(ctx, res.Name)
+				AddEvidence(ctx, res.Evidence...)
+				return res.Status, res.Err
+			}
+			cached = &res
+		}
+
+		setDetectorName(ctx, cached.Name)
+		AddEvidence(ctx, cached.Evidence...)
+		return cached.Status, cached.Err
+	}
+}
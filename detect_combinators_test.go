@@ -0,0 +1,317 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package nf
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// testDetector returns a detector which returns status and err after delay, or
+// the context error if it's cancelled first.
+func testDetector(status InstallStatus, err error, delay time.Duration) InstallDetector {
+	return func(ctx context.Context) (InstallStatus, error) {
+		select {
+		case <-time.After(delay):
+			AddEvidence(ctx, Evidence{Kind: EvidenceFont, Value: status.String()})
+			return status, err
+		case <-ctx.Done():
+			return StatusNotInstalled, ctx.Err()
+		}
+	}
+}
+
+func TestWithTimeout(t *testing.T) {
+	t.Parallel()
+
+	status, err := WithTimeout(testDetector(StatusInstalled, nil, 0), time.Second)(t.Context())
+	if status != StatusInstalled || err != nil {
+		t.Errorf("expected installed, got %q, %v", status, err)
+	}
+
+	// Detectors which ignore the context still time out.
+	start := time.Now()
+	status, err = WithTimeout(func(_ context.Context) (InstallStatus, error) {
+		time.Sleep(time.Second)
+		return StatusInstalled, nil
+	}, 10*time.Millisecond)(t.Context())
+	if status != StatusNotInstalled || !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected timeout, got %q, %v", status, err)
+	}
+	if d := time.Since(start); d > 500*time.Millisecond {
+		t.Errorf("expected detector to time out quickly, took %s", d)
+	}
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+	if _, err = WithTimeout(testDetector(StatusInstalled, nil, time.Second), time.Second)(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected cancelled, got %v", err)
+	}
+}
+
+func TestParallel(t *testing.T) {
+	t.Parallel()
+
+	errFailed := errors.New("failed")
+
+	tests := []struct {
+		name      string
+		detectors []InstallDetector
+		want      InstallStatus
+		wantErr   error
+	}{
+		{name: "empty", want: StatusNotInstalled},
+		{
+			// Earlier detectors take priority, even if later ones finish first.
+			name: "priority",
+			detectors: []InstallDetector{
+				testDetector(StatusNotInstalled, nil, 20*time.Millisecond),
+				testDetector(StatusEnabled, nil, 40*time.Millisecond),
+				testDetector(StatusInstalled, nil, 0),
+			},
+			want: StatusEnabled,
+		},
+		{
+			name: "errors",
+			detectors: []InstallDetector{
+				testDetector(StatusEnabled, errFailed, 0),
+				testDetector(StatusNotInstalled, nil, 0),
+			},
+			want:    StatusNotInstalled,
+			wantErr: errFailed,
+		},
+		{
+			// Errors are ignored once a detector decides the status.
+			name: "error-then-installed",
+			detectors: []InstallDetector{
+				testDetector(StatusNotInstalled, errFailed, 0),
+				testDetector(StatusInstalled, nil, 0),
+			},
+			want: StatusInstalled,
+		},
+		{
+			// Slow detectors are cancelled once the result is known.
+			name: "cancel",
+			detectors: []InstallDetector{
+				testDetector(StatusDisabled, nil, 0),
+				testDetector(StatusInstalled, nil, time.Minute),
+			},
+			want: StatusDisabled,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			status, err := Parallel(tt.detectors...)(t.Context())
+			if !errors.Is(err, tt.wantErr) || (tt.wantErr == nil && err != nil) {
+				t.Errorf("expected error %v, got %v", tt.wantErr, err)
+			}
+			if status != tt.want {
+				t.Errorf("expected status %q, got %q", tt.want, status)
+			}
+
+			// Matches running the detectors in order.
+			if want, _ := DetectInstalled(t.Context(), append(tt.detectors, testDetector(StatusNotInstalled, nil, 0))...); status != want {
+				t.Errorf("expected same status as DetectInstalled %q, got %q", want, status)
+			}
+		})
+	}
+
+	// All detectors run concurrently.
+	start := time.Now()
+	if _, err := Parallel(
+		testDetector(StatusNotInstalled, nil, 100*time.Millisecond),
+		testDetector(StatusNotInstalled, nil, 100*time.Millisecond),
+		testDetector(StatusNotInstalled, nil, 100*time.Millisecond),
+	)(t.Context()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if d := time.Since(start); d > 250*time.Millisecond {
+		t.Errorf("expected detectors to run concurrently, took %s", d)
+	}
+}
+
+func TestFirstOf(t *testing.T) {
+	t.Parallel()
+
+	var ran []InstallStatus
+	detector := func(status InstallStatus, err error) InstallDetector {
+		return func(_ context.Context) (InstallStatus, error) {
+			ran = append(ran, status)
+			return status, err
+		}
+	}
+
+	status, err := FirstOf(
+		detector(StatusNotInstalled, nil),
+		detector(StatusEnabled, errors.New("failed")),
+		detector(StatusInstalled, nil),
+		detector(StatusEnabled, nil),
+	)(t.Context())
+	if status != StatusInstalled || err != nil {
+		t.Errorf("expected installed, got %q, %v", status, err)
+	}
+	if want := []InstallStatus{StatusNotInstalled, StatusEnabled, StatusInstalled}; !slices.Equal(ran, want) {
+		t.Errorf("expected detectors %q to run, got %q", want, ran)
+	}
+
+	status, err = FirstOf(detector(StatusNotInstalled, errors.New("failed")))(t.Context())
+	if status != StatusNotInstalled || err == nil {
+		t.Errorf("expected not installed with error, got %q, %v", status, err)
+	}
+}
+
+func TestRequire(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		statuses []InstallStatus
+		err      error
+		want     InstallStatus
+		wantErr  bool
+	}{
+		{name: "empty", want: StatusNotInstalled},
+		{name: "same", statuses: []InstallStatus{StatusEnabled, StatusEnabled}, want: StatusEnabled},
+		{name: "disabled", statuses: []InstallStatus{StatusDisabled, StatusDisabled}, want: StatusDisabled},
+		{name: "weaker", statuses: []InstallStatus{StatusEnabled, StatusInstalled, StatusEnabled}, want: StatusInstalled},
+		{name: "not-installed", statuses: []InstallStatus{StatusEnabled, StatusNotInstalled}, want: StatusNotInstalled},
+		{name: "disagree", statuses: []InstallStatus{StatusEnabled, StatusDisabled}, want: StatusNotInstalled},
+		{name: "error", statuses: []InstallStatus{StatusEnabled}, err: errors.New("failed"), want: StatusNotInstalled, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			detectors := make([]InstallDetector, len(tt.statuses))
+			for i, s := range tt.statuses {
+				detectors[i] = testDetector(s, tt.err, 0)
+			}
+
+			status, err := Require(detectors...)(t.Context())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error: %t, got %v", tt.wantErr, err)
+			}
+			if status != tt.want {
+				t.Errorf("expected status %q, got %q", tt.want, status)
+			}
+		})
+	}
+}
+
+func TestNot(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		status  InstallStatus
+		err     error
+		want    InstallStatus
+		wantErr bool
+	}{
+		{name: "enabled", status: StatusEnabled, want: StatusDisabled},
+		{name: "installed", status: StatusInstalled, want: StatusDisabled},
+		{name: "disabled", status: StatusDisabled, want: StatusEnabled},
+		{name: "not-installed", status: StatusNotInstalled, want: StatusNotInstalled},
+		{name: "error", status: StatusEnabled, err: errors.New("failed"), want: StatusNotInstalled, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			status, err := Not(testDetector(tt.status, tt.err, 0))(t.Context())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error: %t, got %v", tt.wantErr, err)
+			}
+			if status != tt.want {
+				t.Errorf("expected status %q, got %q", tt.want, status)
+			}
+		})
+	}
+}
+
+func TestMemoize(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	detector := Memoize(Named("counted", func(ctx context.Context) (InstallStatus, error) {
+		calls.Add(1)
+		if err := ctx.Err(); err != nil {
+			return StatusNotInstalled, err
+		}
+		AddEvidence(ctx, Evidence{Kind: EvidenceFile, Value: "/usr/share/fonts/HackNerdFont-Regular.ttf"})
+		return StatusInstalled, nil
+	}))
+
+	// Cancelled results aren't cached.
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+	if _, err := detector(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected cancelled, got %v", err)
+	}
+
+	for range 3 {
+		if status, err := detector(t.Context()); status != StatusInstalled || err != nil {
+			t.Errorf("expected installed, got %q, %v", status, err)
+		}
+	}
+	if n := calls.Load(); n != 2 {
+		t.Errorf("expected detector to run twice, ran %d times", n)
+	}
+
+	// Cached results still report their name and evidence.
+	report := DetectReport(t.Context(), detector)
+	if res := report.Results[0]; res.Name != "counted" || len(res.Evidence) != 1 || res.Status != StatusInstalled {
+		t.Errorf("unexpected result: %+v", res)
+	}
+}
+
+func TestCombinatorsReport(t *testing.T) {
+	t.Parallel()
+
+	report := DetectReport(t.Context(),
+		Parallel(
+			Named("a", testDetector(StatusNotInstalled, nil, 0)),
+			Named("b", testDetector(StatusInstalled, nil, 0)),
+		),
+		Named("grouped", FirstOf(
+			testDetector(StatusNotInstalled, errors.New("failed"), 0),
+		)),
+		WithTimeout(Named("slow", testDetector(StatusInstalled, nil, time.Minute)), time.Millisecond),
+		Require(testDetector(StatusEnabled, nil, 0)),
+		Not(testDetector(StatusNotInstalled, nil, 0)),
+	)
+
+	var names []string
+	for _, res := range report.Results {
+		names = append(names, res.Name)
+	}
+	if want := []string{"parallel", "grouped", "slow", "require", "not"}; !slices.Equal(names, want) {
+		t.Errorf("expected names %q, got %q", want, names)
+	}
+
+	// Evidence of the inner detectors is included.
+	want := []Evidence{
+		{Kind: EvidenceFont, Value: "not installed"},
+		{Kind: EvidenceFont, Value: "installed"},
+	}
+	if !slices.Equal(report.Results[0].Evidence, want) {
+		t.Errorf("expected evidence %+v, got %+v", want, report.Results[0].Evidence)
+	}
+	if err := report.Results[1].Err; err == nil || err.Error() != "go-nf.testDetector: failed" {
+		t.Errorf("expected error with inner detector name, got %v", err)
+	}
+	if report.DecidedBy != "parallel" || report.Status != StatusInstalled {
+		t.Errorf("expected installed by parallel, got %q by %q", report.Status, report.DecidedBy)
+	}
+}
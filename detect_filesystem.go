@@ -0,0 +1,213 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package nf
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"path"
+	"slices"
+	"strings"
+)
+
+// DefaultFilesystemMaxDepth is the default maximum depth of directories within
+// the roots which are searched for font files, see [FilesystemOptions].
+const DefaultFilesystemMaxDepth = 4
+
+// DefaultFontExtensions are the font file extensions which are checked by
+// default, see [FilesystemOptions].
+var DefaultFontExtensions = []string{
+	".ttf",
+	".otf",
+	".ttc",
+	".otc",
+	".woff",
+	".woff2",
+}
+
+// FontFileMatcher reports whether the font file at path is a Nerd Font. fonts
+// are the fonts in the file (see [ReadFontInfo]), or nil if the file couldn't
+// be read (e.g. WOFF2, or a malformed file).
+type FontFileMatcher func(path string, fonts []FontInfo) bool
+
+// FilesystemOptions configures [DetectorFilesystemWith].
+type FilesystemOptions struct {
+	// Roots are the directories which are searched for font files, recursively.
+	// "~" and environment variables are expanded. Defaults to the font
+	// directories of the XDG base directory spec ($XDG_DATA_HOME/fonts, and
+	// fonts in each of $XDG_DATA_DIRS), along with Nix, Flatpak, Homebrew,
+	// Snap, and macOS font directories.
+	Roots []string
+	// MaxDepth is the maximum depth of directories within the roots which are
+	// searched, where directories directly within a root have a depth of 0
+	// (e.g. with a depth of 1, "/usr/share/fonts/a/b/x.ttf" is found, but
+	// "/usr/share/fonts/a/b/c/x.ttf" isn't). Defaults to
+	// [DefaultFilesystemMaxDepth].
+	MaxDepth int
+	// Extensions are the font file extensions which are checked (e.g. ".ttf"),
+	// case-insensitive. Defaults to [DefaultFontExtensions].
+	Extensions []string
+	// Matchers report whether a font file is a Nerd Font. A file matches if any
+	// matcher returns true. Defaults to [MatchNerdFontFile].
+	Matchers []FontFileMatcher
+	// FS is the filesystem the roots are read from, rooted at "/" (i.e. roots
+	// are opened without the leading "/"), e.g. a [testing/fstest.MapFS] for
+	// tests. Defaults to [Env.FS] (see [EnvFrom]).
+	FS fs.FS
+}
+
+// DetectorFilesystem is a detector that checks the filesystem for font files,
+// in common locations (see [FilesystemOptions.Roots]). Returns [StatusInstalled]
+// if any Nerd Font files are found, [StatusNotInstalled] otherwise. Permissions
+// errors are ignored. Use [DetectorFilesystemWith] for custom locations.
+//
+// Font files are matched using the names from the font itself (see
+// [ReadFontInfo]), falling back to the file name for files which can't be
+// read (e.g. WOFF2).
+//
+// This is a no-op on Windows, see [DetectorWindowsGDI] instead.
+func DetectorFilesystem() InstallDetector {
+	return Named("filesystem", func(ctx context.Context) (InstallStatus, error) {
+		e := envFrom(ctx)
+		if e.goos == "windows" {
+			return StatusNotInstalled, nil
+		}
+		return detectFilesystem(ctx, e, &FilesystemOptions{})
+	})
+}
+
+// DetectorFilesystemWith is like [DetectorFilesystem], but with custom roots,
+// depth, extensions, and matchers. Unlike [DetectorFilesystem], it also runs on
+// Windows, e.g. with Windows font directories as roots.
+func DetectorFilesystemWith(opts FilesystemOptions) InstallDetector {
+	return Named("filesystem", func(ctx context.Context) (InstallStatus, error) {
+		e := envFrom(ctx)
+		if opts.FS != nil {
+			e.fsys = opts.FS
+		}
+		return detectFilesystem(ctx, e, &opts)
+	})
+}
+
+// defaultFontDirs returns the default directories which are searched for font
+// files, in order of precedence, without duplicates.
+func defaultFontDirs(e *configEnv) []string {
+	dirs := []string{path.Join(e.home, ".fonts")}
+	dirs = append(dirs, e.xdgDataPaths("fonts")...)
+	dirs = append(dirs,
+		// Snap.
+		"/var/lib/snapd/desktop/fonts",
+		// Nix (home-manager and nix-env profiles, and NixOS).
+		path.Join(e.home, ".nix-profile/share/fonts"),
+		"/nix/var/nix/profiles/default/share/fonts",
+		"/run/current-system/sw/share/X11/fonts",
+		// Flatpak (exported fonts, and host fonts from inside the sandbox).
+		path.Join(e.dataHome(), "flatpak/exports/share/fonts"),
+		"/var/lib/flatpak/exports/share/fonts",
+		"/run/host/fonts",
+		"/run/host/local-fonts",
+		"/run/host/user-fonts",
+		// Homebrew.
+		"/opt/homebrew/share/fonts",
+		"/home/linuxbrew/.linuxbrew/share/fonts",
+		// macOS.
+		path.Join(e.home, "Library/Fonts"),
+		"/Library/Fonts",
+	)
+
+	var out []string
+	for _, dir := range dirs {
+		if dir = path.Clean(dir); !slices.Contains(out, dir) {
+			out = append(out, dir)
+		}
+	}
+	return out
+}
+
+func detectFilesystem(ctx context.Context, e *configEnv, opts *FilesystemOptions) (InstallStatus, error) { //nolint:gocognit
+	roots := defaultFontDirs(e)
+	if opts.Roots != nil {
+		roots = make([]string, len(opts.Roots))
+		for i, root := range opts.Roots {
+			roots[i] = e.expand(root, "/")
+		}
+	}
+
+	maxDepth := opts.MaxDepth
+	if maxDepth <= 0 {
+		maxDepth = DefaultFilesystemMaxDepth
+	}
+	extensions := opts.Extensions
+	if extensions == nil {
+		extensions = DefaultFontExtensions
+	}
+	matchers := opts.Matchers
+	if matchers == nil {
+		matchers = []FontFileMatcher{MatchNerdFontFile}
+	}
+
+	var errs []error
+	for _, root := range roots {
+		root = fsPath(root)
+		if _, err := fs.Stat(e.fsys, root); err != nil {
+			continue
+		}
+
+		var found bool
+		err := fs.WalkDir(e.fsys, root, func(p string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return fs.SkipDir
+			}
+			if err = ctx.Err(); err != nil {
+				return err
+			}
+
+			if d.IsDir() {
+				// Skip directories that are too deep, where directories directly
+				// within the root have a depth of 0.
+				if p != root && strings.Count(strings.TrimPrefix(p, root+"/"), "/") > maxDepth {
+					return fs.SkipDir
+				}
+				return nil
+			}
+
+			// Skip non-font files.
+			if !slices.ContainsFunc(extensions, func(ext string) bool {
+				return strings.EqualFold(ext, path.Ext(p))
+			}) {
+				return nil
+			}
+
+			file := p
+			if !isAbsPath(file) {
+				file = "/" + file
+			}
+
+			fonts, _ := readFontInfoFS(e.fsys, p)
+			for _, match := range matchers {
+				if match(file, fonts) {
+					AddEvidence(ctx, Evidence{Kind: EvidenceFile, Value: file})
+					found = true
+					return fs.SkipAll
+				}
+			}
+			return nil
+		})
+		if found {
+			return StatusInstalled, nil
+		}
+		if ctx.Err() != nil {
+			return StatusNotInstalled, ctx.Err()
+		}
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return StatusNotInstalled, errors.Join(errs...)
+	}
+	return StatusNotInstalled, nil
+}
@@ -0,0 +1,202 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package nf
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func TestDefaultFontDirs(t *testing.T) {
+	t.Parallel()
+
+	dirs := defaultFontDirs(testConfigEnv(map[string]string{
+		"XDG_DATA_DIRS": "/usr/share:/var/lib/flatpak/exports/share:/usr/share/",
+	}, nil))
+
+	for _, want := range []string{
+		"/home/user/.fonts",
+		"/home/user/.local/share/fonts",
+		"/usr/share/fonts",
+		"/home/user/.nix-profile/share/fonts",
+		"/run/current-system/sw/share/X11/fonts",
+		"/run/host/user-fonts",
+		"/opt/homebrew/share/fonts",
+		"/home/user/Library/Fonts",
+		"/Library/Fonts",
+	} {
+		if !slices.Contains(dirs, want) {
+			t.Errorf("expected %q in default font dirs, got %q", want, dirs)
+		}
+	}
+
+	// $XDG_DATA_DIRS replaces the default, and duplicates are removed.
+	if slices.Contains(dirs, "/usr/local/share/fonts") {
+		t.Errorf("expected $XDG_DATA_DIRS to replace the default, got %q", dirs)
+	}
+	if i := slices.Index(dirs, "/usr/share/fonts"); slices.Contains(dirs[i+1:], "/usr/share/fonts") {
+		t.Errorf("expected no duplicates, got %q", dirs)
+	}
+}
+
+func TestDetectorFilesystemWith(t *testing.T) {
+	t.Parallel()
+
+	nerdFont := &fstest.MapFile{Data: testSFNT(testFontNames("JetBrainsMono Nerd Font", "2.304"), 0)}
+	font := &fstest.MapFile{Data: testSFNT(testFontNames("JetBrains Mono", "2.304"), 0)}
+
+	tests := []struct {
+		name         string
+		env          map[string]string
+		files        fstest.MapFS
+		opts         FilesystemOptions
+		want         InstallStatus
+		wantEvidence string
+	}{
+		{name: "empty", want: StatusNotInstalled},
+		{
+			name:         "xdg-data-dirs",
+			env:          map[string]string{"XDG_DATA_DIRS": "/custom/share"},
+			files:        fstest.MapFS{"custom/share/fonts/JBM-Regular.ttf": nerdFont},
+			want:         StatusInstalled,
+			wantEvidence: "/custom/share/fonts/JBM-Regular.ttf",
+		},
+		{
+			name:         "nix",
+			files:        fstest.MapFS{"home/user/.nix-profile/share/fonts/truetype/NerdFonts/JBM.ttf": nerdFont},
+			want:         StatusInstalled,
+			wantEvidence: "/home/user/.nix-profile/share/fonts/truetype/NerdFonts/JBM.ttf",
+		},
+		{
+			name:         "macos",
+			files:        fstest.MapFS{"home/user/Library/Fonts/JBM.TTF": nerdFont},
+			want:         StatusInstalled,
+			wantEvidence: "/home/user/Library/Fonts/JBM.TTF",
+		},
+		{
+			name: "not-nerd-font",
+			files: fstest.MapFS{
+				"usr/share/fonts/JetBrainsMono-Regular.ttf": font,
+				"usr/share/fonts/README.txt":                &fstest.MapFile{Data: []byte("JetBrainsMono Nerd Font")},
+			},
+			want: StatusNotInstalled,
+		},
+		{
+			name:         "custom-roots",
+			files:        fstest.MapFS{"opt/fonts/JBM.ttf": nerdFont, "usr/share/fonts/other.ttf": nerdFont},
+			opts:         FilesystemOptions{Roots: []string{"~/missing", "$FONTS_DIR"}},
+			env:          map[string]string{"FONTS_DIR": "/opt/fonts"},
+			want:         StatusInstalled,
+			wantEvidence: "/opt/fonts/JBM.ttf",
+		},
+		{
+			name:  "custom-roots-not-found",
+			files: fstest.MapFS{"usr/share/fonts/JBM.ttf": nerdFont},
+			opts:  FilesystemOptions{Roots: []string{"/opt/fonts"}},
+			want:  StatusNotInstalled,
+		},
+		{
+			name:  "max-depth",
+			files: fstest.MapFS{"usr/share/fonts/a/b/c/d/JBM.ttf": nerdFont},
+			opts:  FilesystemOptions{MaxDepth: 2},
+			want:  StatusNotInstalled,
+		},
+		{
+			name:         "max-depth-within",
+			files:        fstest.MapFS{"usr/share/fonts/a/b/c/JBM.ttf": nerdFont},
+			opts:         FilesystemOptions{MaxDepth: 2},
+			want:         StatusInstalled,
+			wantEvidence: "/usr/share/fonts/a/b/c/JBM.ttf",
+		},
+		{
+			name:  "default-max-depth",
+			files: fstest.MapFS{"usr/share/fonts/a/b/c/d/e/f/JBM.ttf": nerdFont},
+			want:  StatusNotInstalled,
+		},
+		{
+			name:         "default-max-depth-within",
+			files:        fstest.MapFS{"usr/share/fonts/a/b/c/d/e/JBM.ttf": nerdFont},
+			want:         StatusInstalled,
+			wantEvidence: "/usr/share/fonts/a/b/c/d/e/JBM.ttf",
+		},
+		{
+			name:  "extensions",
+			files: fstest.MapFS{"usr/share/fonts/JBM.ttf": nerdFont},
+			opts:  FilesystemOptions{Extensions: []string{".otf"}},
+			want:  StatusNotInstalled,
+		},
+		{
+			name:  "matchers",
+			files: fstest.MapFS{"usr/share/fonts/JetBrainsMono-Regular.ttf": font},
+			opts: FilesystemOptions{Matchers: []FontFileMatcher{
+				MatchNerdFontFile,
+				func(_ string, fonts []FontInfo) bool {
+					return len(fonts) == 1 && strings.HasPrefix(fonts[0].Family, "JetBrains")
+				},
+			}},
+			want:         StatusInstalled,
+			wantEvidence: "/usr/share/fonts/JetBrainsMono-Regular.ttf",
+		},
+		{
+			// Unreadable fonts fall back to the file name.
+			name:         "file-name",
+			files:        fstest.MapFS{"usr/share/fonts/HackNerdFont-Regular.woff2": &fstest.MapFile{Data: []byte("wOF2")}},
+			want:         StatusInstalled,
+			wantEvidence: "/usr/share/fonts/HackNerdFont-Regular.woff2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			e := testConfigEnv(tt.env, tt.files)
+			report := DetectReport(t.Context(), func(ctx context.Context) (InstallStatus, error) {
+				return detectFilesystem(ctx, e, &tt.opts)
+			})
+
+			res := report.Results[0]
+			if res.Err != nil {
+				t.Fatalf("unexpected error: %v", res.Err)
+			}
+			if res.Status != tt.want {
+				t.Errorf("expected status %q, got %q", tt.want, res.Status)
+			}
+
+			var want []Evidence
+			if tt.wantEvidence != "" {
+				want = []Evidence{{Kind: EvidenceFile, Value: tt.wantEvidence}}
+			}
+			if !slices.Equal(res.Evidence, want) {
+				t.Errorf("expected evidence %+v, got %+v", want, res.Evidence)
+			}
+		})
+	}
+}
+
+func TestDetectorFilesystemWithFS(t *testing.T) {
+	t.Parallel()
+
+	detector := DetectorFilesystemWith(FilesystemOptions{
+		Roots: []string{"/fonts"},
+		FS: fstest.MapFS{
+			"fonts/JBM.ttf": &fstest.MapFile{Data: testSFNT(testFontNames("JetBrainsMono Nerd Font", "2.304"), 0)},
+		},
+	})
+
+	if status, err := detector(t.Context()); status != StatusInstalled || err != nil {
+		t.Errorf("expected installed, got %q, %v", status, err)
+	}
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+	if _, err := detector(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected cancelled, got %v", err)
+	}
+}
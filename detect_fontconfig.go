@@ -0,0 +1,321 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+//go:build unix
+
+package nf
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os/exec"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// fontConfigGlyphs is the default list of glyphs checked by
+// [DetectorFontConfigCharset]. These are from different classes, and have had
+// the same codepoints since Nerd Fonts v2, so fonts patched by older versions
+// are still detected.
+var fontConfigGlyphs = []Glyph{
+	"\ue0b0", // pl-left_hard_divider
+	"\ue5fa", // custom-folder_npm
+	"\ue700", // dev-aarch64
+	"\uf015", // fa-home
+}
+
+// fontConfigCharset checks if the fontconfig "monospace" font (usually the
+// default font of terminals), or any installed font, has all codepoints of the
+// provided glyphs. If fontconfig isn't installed, [StatusNotInstalled] is
+// returned without an error.
+func fontConfigCharset(ctx context.Context, e *configEnv, glyphs []Glyph) (InstallStatus, error) {
+	var codepoints []rune
+	for _, g := range glyphs {
+		for _, r := range string(g) {
+			if !slices.Contains(codepoints, r) {
+				codepoints = append(codepoints, r)
+			}
+		}
+	}
+	if len(codepoints) == 0 {
+		return StatusNotInstalled, errors.New("no glyphs to check")
+	}
+
+	out, err := e.run(ctx, "fc-match", "--format=%{charset}", "monospace")
+	if errors.Is(err, exec.ErrNotFound) {
+		return StatusNotInstalled, nil
+	}
+	if err != nil {
+		return StatusNotInstalled, fmt.Errorf("fc-match: %w", err)
+	}
+
+	charset, err := parseFontConfigCharset(string(out))
+	if err != nil {
+		return StatusNotInstalled, err
+	}
+	if !slices.ContainsFunc(codepoints, func(r rune) bool { return !charset.has(r) }) {
+		AddEvidence(ctx, Evidence{Kind: EvidenceCommand, Value: "fc-match monospace"})
+		return StatusInstalled, nil
+	}
+
+	// Fonts which embed the glyphs under any name, including fonts only used as
+	// a fallback (e.g. "Symbols Nerd Font").
+	hex := make([]string, len(codepoints))
+	for i, r := range codepoints {
+		hex[i] = strconv.FormatInt(int64(r), 16)
+	}
+	out, err = e.run(ctx, "fc-list", ":charset="+strings.Join(hex, " "), "family", "file")
+	if err != nil {
+		return StatusNotInstalled, fmt.Errorf("fc-list: %w", err)
+	}
+	var found bool
+	for line := range strings.Lines(string(out)) {
+		// "file: family[,family...]".
+		file, families, ok := strings.Cut(strings.TrimSpace(line), ": ")
+		if !ok {
+			continue
+		}
+		AddEvidence(ctx, Evidence{Kind: EvidenceFont, Value: families, Path: file})
+		found = true
+	}
+	if found {
+		return StatusInstalled, nil
+	}
+	return StatusNotInstalled, nil
+}
+
+// parseFontConfigCharset parses a fontconfig charset, as printed by
+// "%{charset}", which is a space separated list of hex codepoints and ranges
+// (e.g. "20-7e a0-17f 192 e0a0-e0a3").
+func parseFontConfigCharset(s string) (fontCmap, error) {
+	var charset fontCmap
+	for field := range strings.FieldsSeq(s) {
+		lo, hi, isRange := strings.Cut(field, "-")
+		if !isRange {
+			hi = lo
+		}
+
+		start, err := strconv.ParseUint(lo, 16, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid fontconfig charset %q: %w", field, err)
+		}
+		end, err := strconv.ParseUint(hi, 16, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid fontconfig charset %q: %w", field, err)
+		}
+		if start <= end {
+			charset = append(charset, cmapRange{lo: rune(start), hi: rune(end)})
+		}
+	}
+	charset.normalize()
+	return charset, nil
+}
+
+// fontConfigFiles are the system fontconfig config files, of which the first
+// which exists is read.
+var fontConfigFiles = []string{
+	"/etc/fonts/fonts.conf",
+	"/usr/local/etc/fonts/fonts.conf",    // FreeBSD, Homebrew (Intel).
+	"/opt/homebrew/etc/fonts/fonts.conf", // Homebrew (Apple Silicon).
+}
+
+// fontConfigRules returns the family substitution rules from the fontconfig
+// configs, as lines where the section is the (lowercase) family the rule
+// applies to, or "*" for rules which apply to all families, the key is the kind
+// of rule ("prefer", "accept", "default" for aliases, or "match"), and the
+// value is the substituted family.
+//
+// The system config (or $FONTCONFIG_FILE) is read first, following includes.
+// User configs are usually included by the system config (conf.d/50-user.conf),
+// but are also read explicitly, in case the system config doesn't include them.
+func fontConfigRules(ctx context.Context, e *configEnv) ([]configLine, error) {
+	files := []string{e.getenv("FONTCONFIG_FILE")}
+	if files[0] == "" {
+		files[0] = fontConfigFiles[0]
+		for _, p := range fontConfigFiles {
+			if e.exists(p) {
+				files[0] = p
+				break
+			}
+		}
+	}
+	// Includes without a prefix are relative to the config directory, which is
+	// the directory of the system config (e.g. "/etc/fonts"), unless overridden.
+	configDir := path.Dir(files[0])
+	if p := e.getenv("FONTCONFIG_PATH"); p != "" {
+		configDir, _, _ = strings.Cut(p, ":")
+	}
+	format := func(e *configEnv, data []byte, file string, include func(p string) []configLine) ([]configLine, error) {
+		return parseFontConfig(e, data, file, configDir, include)
+	}
+
+	files = append(files, path.Join(e.configHome(), "fontconfig", "fonts.conf"))
+	files = append(files, e.glob(path.Join(e.configHome(), "fontconfig", "conf.d", "[0-9]*.conf"))...)
+	files = append(files, path.Join(e.home, ".fonts.conf"))
+	files = append(files, e.glob(path.Join(e.home, ".fonts.conf.d", "[0-9]*.conf"))...)
+
+	var lines []configLine
+	var errs []error
+	for _, file := range files {
+		l, err := e.readConfig(ctx, file, format)
+		lines = append(lines, l...)
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return lines, errors.Join(errs...)
+}
+
+// fcConfig is a fontconfig XML config file (fonts.conf(5)). Only the elements
+// needed to find family substitutions are decoded.
+type fcConfig struct {
+	Includes []struct {
+		Path   string `xml:",chardata"`
+		Prefix string `xml:"prefix,attr"`
+	} `xml:"include"`
+	Aliases []struct {
+		Families []string `xml:"family"`
+		Prefer   fcExpr   `xml:"prefer"`
+		Accept   fcExpr   `xml:"accept"`
+		Default  fcExpr   `xml:"default"`
+	} `xml:"alias"`
+	Matches []struct {
+		Target string `xml:"target,attr"`
+		Tests  []struct {
+			fcExpr
+			Name    string `xml:"name,attr"`
+			Compare string `xml:"compare,attr"`
+		} `xml:"test"`
+		Edits []struct {
+			fcExpr
+			Name string `xml:"name,attr"`
+		} `xml:"edit"`
+	} `xml:"match"`
+}
+
+// fcExpr is a fontconfig expression, of which only the (possibly nested)
+// string and family values are used.
+type fcExpr struct {
+	Values []string `xml:"string"`
+	Family []string `xml:"family"`
+	Nested []fcExpr `xml:",any"`
+}
+
+// strings returns all string and family values in the expression.
+func (x *fcExpr) strings() []string {
+	values := slices.Concat(x.Values, x.Family)
+	for i := range x.Nested {
+		values = append(values, x.Nested[i].strings()...)
+	}
+	for i := range values {
+		values[i] = strings.TrimSpace(values[i])
+	}
+	return values
+}
+
+// parseFontConfig parses a fontconfig XML config file, returning its family
+// substitution rules (see [fontConfigRules]). Includes without a prefix are
+// relative to configDir. Includes of directories include all files in the
+// directory starting with a digit and ending in ".conf", in order, like
+// fontconfig.
+func parseFontConfig(e *configEnv, data []byte, file, configDir string, include func(p string) []configLine) ([]configLine, error) {
+	var cfg fcConfig
+	if err := xml.Unmarshal(data, &cfg); err != nil {
+		return nil, err
+	}
+
+	var lines []configLine
+	for _, inc := range cfg.Includes {
+		var dir string
+		switch inc.Prefix {
+		case "xdg":
+			dir = e.configHome()
+		case "relative":
+			dir = path.Dir(file)
+		default:
+			dir = configDir
+		}
+
+		p := e.expand(strings.TrimSpace(inc.Path), dir)
+		if fi, err := fs.Stat(e.fsys, fsPath(p)); err == nil && fi.IsDir() {
+			for _, f := range e.glob(path.Join(p, "[0-9]*.conf")) {
+				lines = append(lines, include(f)...)
+			}
+			continue
+		}
+		lines = append(lines, include(p)...)
+	}
+
+	for _, a := range cfg.Aliases {
+		for _, family := range a.Families {
+			family = strings.ToLower(strings.TrimSpace(family))
+			for _, v := range a.Prefer.strings() {
+				lines = append(lines, configLine{section: family, key: "prefer", value: v})
+			}
+			for _, v := range a.Accept.strings() {
+				lines = append(lines, configLine{section: family, key: "accept", value: v})
+			}
+			for _, v := range a.Default.strings() {
+				lines = append(lines, configLine{section: family, key: "default", value: v})
+			}
+		}
+	}
+
+	for _, m := range cfg.Matches {
+		if m.Target != "" && m.Target != "pattern" {
+			continue
+		}
+
+		// Matches without a family test apply to all families. Negated tests
+		// are ignored, along with the rest of the match.
+		families := []string{"*"}
+		for _, t := range m.Tests {
+			switch {
+			case t.Name != "family":
+				continue
+			case t.Compare == "not_eq" || t.Compare == "not_contains":
+				families = nil
+			case families != nil:
+				families = families[:0]
+				for _, v := range t.strings() {
+					families = append(families, strings.ToLower(v))
+				}
+			}
+		}
+
+		for _, edit := range m.Edits {
+			if edit.Name != "family" {
+				continue
+			}
+			for _, family := range families {
+				for _, v := range edit.strings() {
+					lines = append(lines, configLine{section: family, key: "match", value: v})
+				}
+			}
+		}
+	}
+	return lines, nil
+}
+
+// fontConfigFallback checks the fontconfig configs for rules which substitute
+// a Nerd Font for the "monospace" family (or for all families), e.g. an alias
+// which prefers "Symbols Nerd Font Mono" as a fallback.
+func fontConfigFallback(ctx context.Context, e *configEnv) (InstallStatus, error) {
+	lines, err := fontConfigRules(ctx, e)
+	for _, l := range lines {
+		if (l.section == "monospace" || l.section == "*") && IsNerdFontName(l.value) {
+			AddEvidence(ctx, Evidence{Kind: EvidenceConfig, Value: l.section + " " + l.key + " " + l.value, Path: l.file})
+			return StatusInstalled, nil
+		}
+	}
+	if err != nil {
+		return StatusNotInstalled, fmt.Errorf("failed to read fontconfig config: %w", err)
+	}
+	return StatusNotInstalled, nil
+}
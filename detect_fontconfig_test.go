@@ -0,0 +1,332 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+//go:build unix
+
+package nf
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func TestFontConfigCharset(t *testing.T) {
+	t.Parallel()
+
+	const (
+		latin = "20-7e a0-17f 192 1a0-1a1 2c6-2c7"
+		// Charset of a Nerd Font (or a font which embeds the same glyphs).
+		nerd = latin + " e000-e00a e0a0-e0a3 e0b0-e0d7 e200-e2a9 e300-e3e3 e5fa-e6b7 e700-e8ef ea60-ec1e ed00-f2ff f400-f533 f0001-f1af0"
+	)
+
+	tests := []struct {
+		name     string
+		glyphs   []Glyph
+		match    string
+		list     string
+		err      error
+		want     InstallStatus
+		wantErr  bool
+		wantList bool
+	}{
+		{name: "monospace", match: nerd, want: StatusInstalled},
+		{
+			name:     "fallback",
+			match:    latin,
+			list:     "/usr/share/fonts/TTF/SymbolsNerdFontMono-Regular.ttf: Symbols Nerd Font Mono\n",
+			want:     StatusInstalled,
+			wantList: true,
+		},
+		{name: "none", match: latin, want: StatusNotInstalled, wantList: true},
+		// md glyphs were moved in v3, so fonts patched by v2 don't have them.
+		{name: "md", glyphs: []Glyph{"\U000f0001", "\ue0b0"}, match: nerd, want: StatusInstalled},
+		{name: "md-missing", glyphs: []Glyph{"\U000f1af1"}, match: nerd, want: StatusNotInstalled, wantList: true},
+		{name: "not-installed", err: &exec.Error{Name: "fc-match", Err: exec.ErrNotFound}, want: StatusNotInstalled},
+		{name: "error", err: errors.New("exit status 1"), want: StatusNotInstalled, wantErr: true},
+		{name: "malformed", match: "20-7e zz", want: StatusNotInstalled, wantErr: true},
+		{name: "no-glyphs", glyphs: []Glyph{""}, want: StatusNotInstalled, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			glyphs := tt.glyphs
+			if glyphs == nil {
+				glyphs = fontConfigGlyphs
+			}
+
+			var listed bool
+			e := testConfigEnv(nil, nil)
+			e.run = func(_ context.Context, name string, args ...string) ([]byte, error) {
+				switch name {
+				case "fc-match":
+					if !slices.Equal(args, []string{"--format=%{charset}", "monospace"}) {
+						t.Errorf("unexpected fc-match args: %q", args)
+					}
+					return []byte(tt.match), tt.err
+				case "fc-list":
+					listed = true
+					if len(args) != 3 || !strings.HasPrefix(args[0], ":charset=") {
+						t.Errorf("unexpected fc-list args: %q", args)
+					}
+					return []byte(tt.list), nil
+				}
+				t.Errorf("unexpected command: %s %q", name, args)
+				return nil, nil
+			}
+
+			got, err := fontConfigCharset(t.Context(), e, glyphs)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error: %t, got %v", tt.wantErr, err)
+			}
+			if got != tt.want {
+				t.Errorf("expected status %q, got %q", tt.want, got)
+			}
+			if listed != tt.wantList {
+				t.Errorf("expected fc-list to run: %t", tt.wantList)
+			}
+		})
+	}
+}
+
+func TestFontConfigList(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		out     string
+		err     error
+		want    InstallStatus
+		wantErr bool
+	}{
+		{name: "installed", out: "/usr/share/fonts/TTF/HackNerdFont-Regular.ttf: Hack Nerd Font:style=Regular\n", want: StatusInstalled},
+		{name: "none", out: "/usr/share/fonts/TTF/DejaVuSans.ttf: DejaVu Sans:style=Book\n", want: StatusNotInstalled},
+		{name: "not-installed", err: &exec.Error{Name: "fc-list", Err: exec.ErrNotFound}, want: StatusNotInstalled},
+		{name: "error", err: errors.New("exit status 1"), want: StatusNotInstalled, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := ContextWithEnv(t.Context(), &Env{
+				Run: func(_ context.Context, name string, _ ...string) ([]byte, error) {
+					if name != "fc-list" {
+						t.Errorf("unexpected command: %s", name)
+					}
+					return []byte(tt.out), tt.err
+				},
+			})
+
+			got, err := DetectorFontConfig()(ctx)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error: %t, got %v", tt.wantErr, err)
+			}
+			if got != tt.want {
+				t.Errorf("expected status %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestFontConfigCharsetQuery(t *testing.T) {
+	t.Parallel()
+
+	var query string
+	e := testConfigEnv(nil, nil)
+	e.run = func(_ context.Context, name string, args ...string) ([]byte, error) {
+		if name == "fc-list" {
+			query = args[0]
+		}
+		return nil, nil
+	}
+
+	// Duplicate codepoints are only queried once.
+	if _, err := fontConfigCharset(t.Context(), e, []Glyph{"\ue0b0", "\U000f0001\ue0b0"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := ":charset=e0b0 f0001"; query != want {
+		t.Errorf("expected query %q, got %q", want, query)
+	}
+}
+
+func TestFontConfigFallback(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		fixture string
+		env     map[string]string
+		want    InstallStatus
+		wantErr bool
+	}{
+		// User config, included from the system config through conf.d/50-user.conf.
+		{fixture: "alias", want: StatusInstalled},
+		{fixture: "match", want: StatusInstalled},
+		// User config, without a system config.
+		{fixture: "all-families", want: StatusInstalled},
+		// Rules for other families, or which don't edit the family.
+		{fixture: "other-family", want: StatusNotInstalled},
+		// Files which fail to parse don't prevent other files from being read.
+		{fixture: "malformed", want: StatusInstalled},
+		{fixture: "match", env: map[string]string{"FONTCONFIG_FILE": "/opt/fonts.conf"}, want: StatusNotInstalled},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			t.Parallel()
+
+			e := testConfigEnv(tt.env, nil)
+			e.fsys = os.DirFS(filepath.Join("testdata", "fontconfig", tt.fixture))
+
+			got, err := fontConfigFallback(t.Context(), e)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error: %t, got %v", tt.wantErr, err)
+			}
+			if got != tt.want {
+				t.Errorf("expected status %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestFontConfigRules(t *testing.T) {
+	t.Parallel()
+
+	e := testConfigEnv(nil, nil)
+	e.fsys = os.DirFS(filepath.Join("testdata", "fontconfig", "alias"))
+
+	lines, err := fontConfigRules(t.Context(), e)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var got []string
+	for _, l := range lines {
+		if l.section == "monospace" && l.key == "prefer" {
+			got = append(got, l.value)
+		}
+	}
+	// conf.d files are included in order, and the user config is read again
+	// explicitly.
+	want := []string{
+		"JetBrains Mono", "Symbols Nerd Font Mono",
+		"DejaVu Sans Mono", "Noto Sans Mono",
+		"JetBrains Mono", "Symbols Nerd Font Mono",
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+
+	e.fsys = os.DirFS(filepath.Join("testdata", "fontconfig", "malformed"))
+	if _, err := fontConfigRules(t.Context(), e); err == nil {
+		t.Error("expected error for malformed config")
+	}
+}
+
+func TestFontConfigIncludes(t *testing.T) {
+	t.Parallel()
+
+	const (
+		root  = `<fontconfig><include ignore_missing="yes">conf.d</include></fontconfig>`
+		alias = `<fontconfig><alias><family>monospace</family><prefer><family>%s</family></prefer></alias></fontconfig>`
+	)
+
+	tests := []struct {
+		name  string
+		env   map[string]string
+		files fstest.MapFS
+		want  []string
+	}{
+		{
+			name: "homebrew",
+			files: fstest.MapFS{
+				"opt/homebrew/etc/fonts/fonts.conf":           {Data: []byte(root)},
+				"opt/homebrew/etc/fonts/conf.d/60-latin.conf": {Data: []byte(fmt.Sprintf(alias, "Hack Nerd Font Mono"))},
+				"usr/local/etc/fonts/conf.d/60-latin.conf":    {Data: []byte(fmt.Sprintf(alias, "DejaVu Sans Mono"))},
+			},
+			want: []string{"Hack Nerd Font Mono"},
+		},
+		{
+			name: "freebsd",
+			files: fstest.MapFS{
+				"usr/local/etc/fonts/fonts.conf":           {Data: []byte(root)},
+				"usr/local/etc/fonts/conf.d/60-latin.conf": {Data: []byte(fmt.Sprintf(alias, "Hack Nerd Font Mono"))},
+			},
+			want: []string{"Hack Nerd Font Mono"},
+		},
+		{
+			// conf.d/51-local.conf includes local.conf from the config directory.
+			name: "local",
+			files: fstest.MapFS{
+				"etc/fonts/fonts.conf":           {Data: []byte(root)},
+				"etc/fonts/conf.d/51-local.conf": {Data: []byte(`<fontconfig><include ignore_missing="yes">local.conf</include></fontconfig>`)},
+				"etc/fonts/local.conf":           {Data: []byte(fmt.Sprintf(alias, "Hack Nerd Font Mono"))},
+				"etc/fonts/conf.d/local.conf":    {Data: []byte(fmt.Sprintf(alias, "DejaVu Sans Mono"))},
+				"etc/fonts/conf.d/60-latin.conf": {Data: []byte(fmt.Sprintf(alias, "Noto Sans Mono"))},
+			},
+			want: []string{"Hack Nerd Font Mono", "Noto Sans Mono"},
+		},
+		{
+			name: "user",
+			files: fstest.MapFS{
+				"etc/fonts/fonts.conf":           {Data: []byte(`<fontconfig/>`)},
+				"etc/fonts/conf.d/60-latin.conf": {Data: []byte(fmt.Sprintf(alias, "Noto Sans Mono"))},
+				"home/user/.config/fontconfig/fonts.conf": {Data: []byte(
+					`<fontconfig><include ignore_missing="yes">conf.d</include><include prefix="relative">nerd.conf</include></fontconfig>`,
+				)},
+				"home/user/.config/fontconfig/nerd.conf": {Data: []byte(fmt.Sprintf(alias, "Hack Nerd Font Mono"))},
+			},
+			want: []string{"Noto Sans Mono", "Hack Nerd Font Mono"},
+		},
+		{
+			name: "fontconfig-file",
+			env:  map[string]string{"FONTCONFIG_FILE": "/srv/fonts/fonts.conf"},
+			files: fstest.MapFS{
+				"srv/fonts/fonts.conf":           {Data: []byte(root)},
+				"srv/fonts/conf.d/60-latin.conf": {Data: []byte(fmt.Sprintf(alias, "Hack Nerd Font Mono"))},
+			},
+			want: []string{"Hack Nerd Font Mono"},
+		},
+		{
+			name: "fontconfig-path",
+			env:  map[string]string{"FONTCONFIG_FILE": "/srv/fonts/fonts.conf", "FONTCONFIG_PATH": "/srv/conf:/etc/fonts"},
+			files: fstest.MapFS{
+				"srv/fonts/fonts.conf":           {Data: []byte(root)},
+				"srv/fonts/conf.d/60-latin.conf": {Data: []byte(fmt.Sprintf(alias, "DejaVu Sans Mono"))},
+				"srv/conf/conf.d/60-latin.conf":  {Data: []byte(fmt.Sprintf(alias, "Hack Nerd Font Mono"))},
+			},
+			want: []string{"Hack Nerd Font Mono"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			lines, err := fontConfigRules(t.Context(), testConfigEnv(tt.env, tt.files))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var got []string
+			for _, l := range lines {
+				if l.section == "monospace" && l.key == "prefer" {
+					got = append(got, l.value)
+				}
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package nf
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"path"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+const (
+	// gnomeTerminalProfiles is the dconf path (as a keyfile section) of the
+	// GNOME Terminal profile list.
+	gnomeTerminalProfiles = "org/gnome/terminal/legacy/profiles:"
+
+	// gnomeTerminalDefaultProfile is the UUID of the profile GNOME Terminal
+	// creates by default, which is used if no default profile is set.
+	gnomeTerminalDefaultProfile = "b1dcc9dd-5262-4d8d-a863-c897e6d979b9"
+
+	// gnomeInterface is the dconf path (as a keyfile section) of the GNOME
+	// desktop interface settings, which include the system monospace font.
+	gnomeInterface = "org/gnome/desktop/interface"
+)
+
+// gnomeTerminalFonts returns the font of the default GNOME Terminal profile.
+// Profiles use the system monospace font by default, unless "use-system-font"
+// is disabled.
+func gnomeTerminalFonts(ctx context.Context, e *configEnv) ([]string, error) {
+	lines, err := gnomeSettings(ctx, e)
+
+	profile := iniValue(lines, gnomeTerminalProfiles, "default")
+	if profile == "" {
+		profile = gnomeTerminalDefaultProfile
+	}
+	section := gnomeTerminalProfiles + "/:" + profile
+
+	font := iniValue(lines, gnomeInterface, "monospace-font-name")
+	if iniValue(lines, section, "use-system-font") == "false" {
+		font = iniValue(lines, section, "font")
+	}
+	return pangoFamilies(font), err
+}
+
+// gnomeSettings returns the GNOME settings from "dconf dump", with sections
+// relative to the root (e.g. "org/gnome/desktop/interface"), which matches the
+// format of the GSettings keyfile backend. If dconf isn't available, the
+// keyfile is read instead. Only settings which differ from the defaults are
+// included.
+func gnomeSettings(ctx context.Context, e *configEnv) ([]configLine, error) {
+	const root = "org/gnome/"
+
+	out, err := e.run(ctx, "dconf", "dump", "/"+root)
+	if err == nil {
+		AddEvidence(ctx, Evidence{Kind: EvidenceCommand, Value: "dconf dump /" + root})
+		lines, _ := parseINIConfig(e, out, "", func(string) []configLine { return nil })
+		for i := range lines {
+			lines[i].section = root + lines[i].section
+		}
+		return lines, nil
+	}
+
+	keyfile := path.Join(e.configHome(), "glib-2.0/settings/keyfile")
+	if e.exists(keyfile) {
+		return e.readConfig(ctx, keyfile, parseINIConfig)
+	}
+	if errors.Is(err, exec.ErrNotFound) {
+		return nil, nil
+	}
+	return nil, fmt.Errorf("failed to run dconf: %w", err)
+}
+
+// pangoStyles are the style, weight, and stretch words which may follow the
+// family list in a Pango font description.
+var pangoStyles = []string{
+	"normal", "roman", "oblique", "italic", "small-caps", "thin", "ultra-light",
+	"extra-light", "light", "semi-light", "demi-light", "book", "regular",
+	"medium", "semi-bold", "demi-bold", "bold", "ultra-bold", "extra-bold",
+	"heavy", "black", "ultra-heavy", "extra-heavy", "ultra-condensed",
+	"extra-condensed", "condensed", "semi-condensed", "semi-expanded",
+	"expanded", "extra-expanded", "ultra-expanded",
+}
+
+// pangoFamilies returns the families from a Pango font description (e.g.
+// "JetBrainsMono Nerd Font, Noto Sans Mono Bold 11"), which is a
+// comma-separated family list, followed by optional style words, and a size.
+func pangoFamilies(desc string) []string {
+	fields := strings.Fields(desc)
+	for len(fields) > 1 {
+		last := strings.TrimSuffix(fields[len(fields)-1], "px")
+		isStyle := slices.ContainsFunc(pangoStyles, func(style string) bool {
+			return strings.EqualFold(style, last)
+		})
+		if !isStyle && !isPangoSize(last) {
+			break
+		}
+		fields = fields[:len(fields)-1]
+	}
+
+	var families []string
+	for family := range strings.SplitSeq(strings.Join(fields, " "), ",") {
+		if family = strings.TrimSpace(family); family != "" {
+			families = append(families, family)
+		}
+	}
+	return families
+}
+
+func isPangoSize(s string) bool {
+	return s != "" && strings.IndexFunc(s, func(r rune) bool {
+		return !unicode.IsDigit(r) && r != '.'
+	}) < 0
+}
@@ -0,0 +1,120 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package nf
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"testing/fstest"
+)
+
+func TestGnomeTerminalFonts(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		dump   string
+		want   []string
+		status InstallStatus
+	}{
+		// The default profile is selected, rather than the built-in profile.
+		{dump: "custom-profile", want: []string{"JetBrainsMono Nerd Font Mono"}, status: StatusEnabled},
+		// Profiles use the system font, unless use-system-font is false.
+		{dump: "system-font", want: []string{"Hack Nerd Font Mono"}, status: StatusEnabled},
+		{dump: "defaults", want: nil, status: StatusNotInstalled},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.dump, func(t *testing.T) {
+			t.Parallel()
+
+			dump, err := os.ReadFile(filepath.Join("testdata", "gnome-terminal", tt.dump+".dump"))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			e := testConfigEnv(map[string]string{"GNOME_TERMINAL_SCREEN": "/org/gnome/Terminal/screen/1"}, nil)
+			e.run = func(_ context.Context, name string, args ...string) ([]byte, error) {
+				if name != "dconf" || !slices.Equal(args, []string{"dump", "/org/gnome/"}) {
+					t.Errorf("unexpected command: %s %q", name, args)
+				}
+				return dump, nil
+			}
+
+			got, err := terminalFonts(t.Context(), e, terminalConfigs[TerminalGnomeTerminal])
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("expected fonts %q, got %q", tt.want, got)
+			}
+
+			status, err := detectTerminalConfig(t.Context(), e)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if status != tt.status {
+				t.Errorf("expected status %q, got %q", tt.status, status)
+			}
+		})
+	}
+}
+
+func TestGnomeTerminalKeyfile(t *testing.T) {
+	t.Parallel()
+
+	files := fstest.MapFS{
+		"home/user/.config/glib-2.0/settings/keyfile": {Data: []byte(
+			"[org/gnome/terminal/legacy/profiles:/:b1dcc9dd-5262-4d8d-a863-c897e6d979b9]\n" +
+				"use-system-font=false\nfont='Iosevka NFM 13'\n",
+		)},
+	}
+
+	got, err := gnomeTerminalFonts(t.Context(), testConfigEnv(nil, files))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := []string{"Iosevka NFM"}; !slices.Equal(got, want) {
+		t.Errorf("expected fonts %q, got %q", want, got)
+	}
+
+	// Without dconf or the keyfile, there is nothing to read.
+	got, err = gnomeTerminalFonts(t.Context(), testConfigEnv(nil, nil))
+	if err != nil || got != nil {
+		t.Errorf("expected no fonts or error, got %q, %v", got, err)
+	}
+
+	e := testConfigEnv(nil, nil)
+	e.run = func(context.Context, string, ...string) ([]byte, error) {
+		return nil, errors.New("exit status 1")
+	}
+	if _, err = gnomeTerminalFonts(t.Context(), e); err == nil {
+		t.Error("expected error when dconf fails, got nil")
+	}
+}
+
+func TestPangoFamilies(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc string
+		want []string
+	}{
+		{"", nil},
+		{"Monospace", []string{"Monospace"}},
+		{"Fira Code 11", []string{"Fira Code"}},
+		{"Hack Nerd Font Mono Bold Italic 10.5", []string{"Hack Nerd Font Mono"}},
+		{"Fira Code, Symbols Nerd Font 12px", []string{"Fira Code", "Symbols Nerd Font"}},
+	}
+
+	for _, tt := range tests {
+		if got := pangoFamilies(tt.desc); !slices.Equal(got, tt.want) {
+			t.Errorf("pangoFamilies(%q) = %q, want %q", tt.desc, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package nf
+
+import (
+	"context"
+	"errors"
+	"path"
+	"slices"
+	"strings"
+)
+
+// konsoleFonts returns the font of the default Konsole profile, which is set in
+// konsolerc, with profiles stored in the "konsole" data directory. Profiles
+// which don't set a font (including the built-in profile, which is used if no
+// default profile is set) use the system fixed width font from kdeglobals.
+func konsoleFonts(ctx context.Context, e *configEnv) ([]string, error) {
+	var errs []error
+
+	rc, err := e.readKDEConfig(ctx, e.xdgConfigPaths("konsolerc"))
+	errs = append(errs, err)
+
+	var font string
+	if profile := iniValue(rc, "Desktop Entry", "DefaultProfile"); profile != "" {
+		for _, p := range e.xdgDataPaths(path.Join("konsole", profile)) {
+			if !e.exists(p) {
+				continue
+			}
+			lines, err := e.readConfig(ctx, p, parseINIConfig)
+			errs = append(errs, err)
+			font = iniValue(lines, "Appearance", "Font")
+			break
+		}
+	}
+
+	if font == "" {
+		globals, err := e.readKDEConfig(ctx, e.xdgConfigPaths("kdeglobals"))
+		errs = append(errs, err)
+		font = iniValue(globals, "General", "fixed")
+	}
+
+	// Fonts are serialized by Qt (QFont::toString), as "family,size,...".
+	family, _, _ := strings.Cut(font, ",")
+	if family = strings.TrimSpace(family); family == "" {
+		return nil, errors.Join(errs...)
+	}
+	return []string{family}, errors.Join(errs...)
+}
+
+// readKDEConfig reads a KDE config file, which is cascaded across the paths
+// (in order of precedence), such that values in earlier paths override later
+// ones.
+func (e *configEnv) readKDEConfig(ctx context.Context, paths []string) ([]configLine, error) {
+	var lines []configLine
+	var errs []error
+	for _, p := range slices.Backward(paths) {
+		l, err := e.readConfig(ctx, p, parseINIConfig)
+		lines = append(lines, l...)
+		errs = append(errs, err)
+	}
+	return lines, errors.Join(errs...)
+}
@@ -0,0 +1,55 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package nf
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func TestKonsoleFonts(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		fixture string
+		want    []string
+		status  InstallStatus
+	}{
+		{fixture: "default-profile", want: []string{"Hack Nerd Font Mono"}, status: StatusEnabled},
+		// The profile doesn't set a font, so the system fixed width font is used.
+		{fixture: "system-font", want: []string{"JetBrainsMono Nerd Font"}, status: StatusEnabled},
+		// No default profile, and the user kdeglobals doesn't override the
+		// system fixed width font.
+		{fixture: "builtin-profile", want: []string{"Hack"}, status: StatusNotInstalled},
+		{fixture: "data-dirs", want: []string{"Iosevka NF"}, status: StatusEnabled},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			t.Parallel()
+
+			e := testConfigEnv(map[string]string{"KONSOLE_VERSION": "240802"}, nil)
+			e.fsys = os.DirFS(filepath.Join("testdata", "konsole", tt.fixture))
+
+			got, err := terminalFonts(t.Context(), e, terminalConfigs[TerminalKonsole])
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("expected fonts %q, got %q", tt.want, got)
+			}
+
+			status, err := detectTerminalConfig(t.Context(), e)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if status != tt.status {
+				t.Errorf("expected status %q, got %q", tt.status, status)
+			}
+		})
+	}
+}
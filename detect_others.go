@@ -8,23 +8,12 @@ package nf
 
 import "context"
 
-// DetectorFilesystem is a detector that checks the filesystem for font files,
-// in common locations. Returns [StatusInstalled] if any font files are found,
-// [StatusNotInstalled] otherwise. Permissions errors are ignored.
-//
-// This is a no-op on non-Unix systems.
-func DetectorFilesystem() InstallDetector {
-	return func(_ context.Context) (InstallStatus, error) {
-		return StatusNotInstalled, nil // No-op.
-	}
-}
-
 // DetectorWindowsGDI is a detector that uses the Windows GDI EnumFontFamiliesEx
 // API to enumerate installed fonts. This is a no-op on non-Windows systems.
 func DetectorWindowsGDI() InstallDetector {
-	return func(_ context.Context) (InstallStatus, error) {
+	return Named("windows-gdi", func(_ context.Context) (InstallStatus, error) {
 		return StatusNotInstalled, nil
-	}
+	})
 }
 
 // DetectorFontConfig is a detector that runs fc-list (fontconfig) and checks its
@@ -33,7 +22,30 @@ func DetectorWindowsGDI() InstallDetector {
 //
 // This is a no-op on non-Unix systems.
 func DetectorFontConfig() InstallDetector {
-	return func(_ context.Context) (InstallStatus, error) {
+	return Named("fontconfig", func(_ context.Context) (InstallStatus, error) {
+		return StatusNotInstalled, nil // No-op.
+	})
+}
+
+// DetectorFontConfigCharset is a detector that asks fontconfig which fonts have
+// glyphs for specific Nerd Font codepoints. Returns [StatusInstalled] if any
+// font has all of the glyphs, [StatusNotInstalled] otherwise.
+//
+// This is a no-op on non-Unix systems.
+func DetectorFontConfigCharset(_ ...Glyph) InstallDetector {
+	return Named("fontconfig-charset", func(_ context.Context) (InstallStatus, error) {
+		return StatusNotInstalled, nil // No-op.
+	})
+}
+
+// DetectorFontConfigFallback is a detector that reads the fontconfig XML configs,
+// and looks for family substitution rules which add a Nerd Font to the
+// "monospace" family. Returns [StatusInstalled] if such a rule is found,
+// [StatusNotInstalled] otherwise.
+//
+// This is a no-op on non-Unix systems.
+func DetectorFontConfigFallback() InstallDetector {
+	return Named("fontconfig-fallback", func(_ context.Context) (InstallStatus, error) {
 		return StatusNotInstalled, nil // No-op.
-	}
+	})
 }
@@ -0,0 +1,689 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package nf
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// maxIncludeDepth is the maximum depth of nested includes when reading terminal
+// config files.
+const maxIncludeDepth = 8
+
+// Terminal is a terminal emulator, as identified by [CurrentTerminal].
+type Terminal string
+
+// Known terminals, which can be identified by [CurrentTerminal].
+const (
+	TerminalUnknown         Terminal = ""
+	TerminalAlacritty       Terminal = "alacritty"
+	TerminalAppleTerminal   Terminal = "apple-terminal"
+	TerminalFoot            Terminal = "foot"
+	TerminalGhostty         Terminal = "ghostty"
+	TerminalGnomeTerminal   Terminal = "gnome-terminal"
+	TerminalITerm2          Terminal = "iterm2"
+	TerminalKitty           Terminal = "kitty"
+	TerminalKonsole         Terminal = "konsole"
+	TerminalSt              Terminal = "st"
+	TerminalURxvt           Terminal = "urxvt"
+	TerminalVSCode          Terminal = "vscode"
+	TerminalWezTerm         Terminal = "wezterm"
+	TerminalWindowsTerminal Terminal = "windows-terminal"
+	TerminalXTerm           Terminal = "xterm"
+)
+
+// String returns the name of the terminal.
+func (t Terminal) String() string {
+	return string(t)
+}
+
+// CurrentTerminal identifies the terminal emulator the current process is
+// running in, using environment variables. Returns [TerminalUnknown] if the
+// terminal cannot be identified.
+func CurrentTerminal() Terminal {
+	return TerminalFrom(os.Getenv)
+}
+
+// TerminalFrom is like [CurrentTerminal], but uses the provided getenv function
+// to lookup environment variables.
+func TerminalFrom(getenv func(string) string) Terminal {
+	// TERM_PROGRAM is set by the innermost terminal in most cases, so it takes
+	// precedence over terminal-specific variables, which may be inherited (e.g.
+	// when launching one terminal from another).
+	switch strings.ToLower(getenv("TERM_PROGRAM")) {
+	case "ghostty":
+		return TerminalGhostty
+	case "kitty":
+		return TerminalKitty
+	case "wezterm":
+		return TerminalWezTerm
+	case "vscode":
+		return TerminalVSCode
+	case "iterm.app":
+		return TerminalITerm2
+	case "apple_terminal":
+		return TerminalAppleTerminal
+	}
+
+	term := getenv("TERM")
+	switch {
+	case getenv("KITTY_WINDOW_ID") != "" || term == "xterm-kitty":
+		return TerminalKitty
+	case getenv("GHOSTTY_RESOURCES_DIR") != "" || term == "xterm-ghostty":
+		return TerminalGhostty
+	case term == "foot" || strings.HasPrefix(term, "foot-"):
+		return TerminalFoot
+	case getenv("WEZTERM_PANE") != "" || getenv("WEZTERM_EXECUTABLE") != "":
+		return TerminalWezTerm
+	case getenv("ALACRITTY_WINDOW_ID") != "" || getenv("ALACRITTY_SOCKET") != "" || term == "alacritty":
+		return TerminalAlacritty
+	case getenv("KONSOLE_VERSION") != "":
+		return TerminalKonsole
+	case getenv("GNOME_TERMINAL_SCREEN") != "" || getenv("GNOME_TERMINAL_SERVICE") != "":
+		return TerminalGnomeTerminal
+	case getenv("WT_SESSION") != "":
+		return TerminalWindowsTerminal
+	case getenv("XTERM_VERSION") != "":
+		return TerminalXTerm
+	case strings.HasPrefix(term, "rxvt-unicode"):
+		return TerminalURxvt
+	case term == "st" || strings.HasPrefix(term, "st-"):
+		return TerminalSt
+	}
+	return TerminalUnknown
+}
+
+// configEnv is the environment detectors read from, see [Env].
+type configEnv struct {
+	getenv func(string) string
+	fsys   fs.FS // Rooted at "/".
+	home   string
+	goos   string
+
+	// run runs a command, returning its stdout.
+	run func(ctx context.Context, name string, args ...string) ([]byte, error)
+}
+
+// configHome returns $XDG_CONFIG_HOME, or "~/.config" if unset.
+func (e *configEnv) configHome() string {
+	if dir := e.getenv("XDG_CONFIG_HOME"); dir != "" {
+		return dir
+	}
+	return path.Join(e.home, ".config")
+}
+
+// xdgConfigPaths returns the paths of rel within $XDG_CONFIG_HOME and
+// $XDG_CONFIG_DIRS, in order of precedence.
+func (e *configEnv) xdgConfigPaths(rel string) []string {
+	paths := []string{path.Join(e.configHome(), rel)}
+
+	dirs := e.getenv("XDG_CONFIG_DIRS")
+	if dirs == "" {
+		dirs = "/etc/xdg"
+	}
+	for dir := range strings.SplitSeq(dirs, ":") {
+		if dir != "" {
+			paths = append(paths, path.Join(dir, rel))
+		}
+	}
+	return paths
+}
+
+// dataHome returns $XDG_DATA_HOME, or "~/.local/share" if unset.
+func (e *configEnv) dataHome() string {
+	if dir := e.getenv("XDG_DATA_HOME"); dir != "" {
+		return dir
+	}
+	return path.Join(e.home, ".local/share")
+}
+
+// xdgDataPaths returns the paths of rel within $XDG_DATA_HOME and
+// $XDG_DATA_DIRS, in order of precedence.
+func (e *configEnv) xdgDataPaths(rel string) []string {
+	paths := []string{path.Join(e.dataHome(), rel)}
+
+	dirs := e.getenv("XDG_DATA_DIRS")
+	if dirs == "" {
+		dirs = "/usr/local/share:/usr/share"
+	}
+	for dir := range strings.SplitSeq(dirs, ":") {
+		if dir != "" {
+			paths = append(paths, path.Join(dir, rel))
+		}
+	}
+	return paths
+}
+
+// isWSL returns true if running in WSL (Windows Subsystem for Linux).
+func (e *configEnv) isWSL() bool {
+	return e.goos == "linux" && (e.getenv("WSL_DISTRO_NAME") != "" || e.getenv("WSL_INTEROP") != "")
+}
+
+// wslUserDirs returns the user profile directories on the Windows host when
+// running in WSL (e.g. "/mnt/c/Users/user"), with the directory matching the
+// current user first. Returns nil when not running in WSL.
+func (e *configEnv) wslUserDirs() []string {
+	if !e.isWSL() {
+		return nil
+	}
+
+	var dirs []string
+	for _, dir := range e.glob("/mnt/c/Users/*") {
+		switch name := path.Base(dir); {
+		case slices.Contains([]string{"All Users", "Default", "Default User", "Public", "desktop.ini"}, name):
+			continue
+		case strings.EqualFold(name, e.getenv("USER")):
+			dirs = slices.Insert(dirs, 0, dir)
+		default:
+			dirs = append(dirs, dir)
+		}
+	}
+	return dirs
+}
+
+// appDataDirs returns the Windows AppData directory for the provided
+// environment variable ("APPDATA" or "LOCALAPPDATA") on Windows, or the
+// equivalent directories on the Windows host when running in WSL.
+func (e *configEnv) appDataDirs(name string) []string {
+	if e.goos == "windows" {
+		if dir := e.getenv(name); dir != "" {
+			return []string{e.expand(dir, "/")}
+		}
+		return nil
+	}
+
+	rel := "AppData/Roaming"
+	if name == "LOCALAPPDATA" {
+		rel = "AppData/Local"
+	}
+
+	var dirs []string
+	for _, dir := range e.wslUserDirs() {
+		dirs = append(dirs, path.Join(dir, rel))
+	}
+	return dirs
+}
+
+// expand expands "~" and environment variables in p, resolving it relative to
+// dir if it isn't absolute. The returned path is always slash-separated.
+func (e *configEnv) expand(p, dir string) string {
+	if p == "~" || strings.HasPrefix(p, "~/") {
+		p = e.home + p[1:]
+	}
+	p = os.Expand(p, e.getenv)
+	if e.goos == "windows" {
+		p = strings.ReplaceAll(p, `\`, "/")
+	}
+	if !isAbsPath(p) {
+		p = path.Join(dir, p)
+	}
+	return path.Clean(p)
+}
+
+// isAbsPath returns true if the slash-separated path p is absolute, including
+// Windows paths which start with a volume name (e.g. "C:/Users").
+func isAbsPath(p string) bool {
+	return path.IsAbs(p) || len(p) >= 3 && p[1] == ':' && p[2] == '/'
+}
+
+// fsPath converts an absolute path to one which can be used with [configEnv.fsys].
+func fsPath(p string) string {
+	return strings.TrimPrefix(path.Clean(filepath.ToSlash(p)), "/")
+}
+
+func (e *configEnv) exists(p string) bool {
+	_, err := fs.Stat(e.fsys, fsPath(p))
+	return err == nil
+}
+
+// glob returns the absolute paths matching pattern.
+func (e *configEnv) glob(pattern string) []string {
+	matches, _ := fs.Glob(e.fsys, fsPath(pattern))
+	for i, m := range matches {
+		if !isAbsPath(m) {
+			matches[i] = "/" + m
+		}
+	}
+	return matches
+}
+
+// configLine is a single key/value pair from a terminal config file.
+type configLine struct {
+	section string // INI section, if the format has sections.
+	key     string
+	value   string
+	file    string // File the line was read from.
+}
+
+// configFormat parses a single config file. Include directives should be
+// resolved to absolute paths, and passed to include, which returns the lines of
+// the included file, such that they can be inlined.
+type configFormat func(e *configEnv, data []byte, file string, include func(p string) []configLine) ([]configLine, error)
+
+// readConfig reads a config file using the provided format, following includes.
+// Missing files (including included files) are ignored. Files which fail to
+// parse are skipped, and the error is returned alongside the lines of all other
+// files. The config file is recorded as evidence (see [AddEvidence]).
+func (e *configEnv) readConfig(ctx context.Context, file string, format configFormat) ([]configLine, error) {
+	var errs []error
+	seen := make(map[string]bool)
+
+	var read func(file string, depth int) []configLine
+	read = func(file string, depth int) []configLine {
+		if depth > maxIncludeDepth || seen[file] {
+			return nil
+		}
+		seen[file] = true
+
+		data, err := fs.ReadFile(e.fsys, fsPath(file))
+		if err != nil {
+			if !errors.Is(err, fs.ErrNotExist) {
+				errs = append(errs, fmt.Errorf("failed to read %q: %w", file, err))
+			}
+			return nil
+		}
+
+		lines, err := format(e, data, file, func(p string) []configLine {
+			return read(p, depth+1)
+		})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to parse %q: %w", file, err))
+			return nil
+		}
+		if depth == 0 {
+			AddEvidence(ctx, Evidence{Kind: EvidenceFile, Value: file})
+		}
+		for i := range lines {
+			if lines[i].file == "" {
+				lines[i].file = file
+			}
+		}
+		return lines
+	}
+
+	lines := read(file, 0)
+	return lines, errors.Join(errs...)
+}
+
+// terminalConfig describes how to read the fonts configured for a terminal.
+type terminalConfig struct {
+	// paths returns the candidate config file paths, in order of precedence. The
+	// first path which exists is used. If nil, the config is not read.
+	paths func(e *configEnv) []string
+
+	// format parses the config file.
+	format configFormat
+
+	// fonts returns the effective fonts (including fallback fonts) from the
+	// parsed config.
+	fonts func(lines []configLine) []string
+
+	// load returns the effective fonts, for terminals where the config isn't
+	// a single file (e.g. stored in dconf, or split across multiple files). If
+	// set, paths, format, and fonts are unused.
+	load func(ctx context.Context, e *configEnv) ([]string, error)
+
+	// builtinSymbols is true if the terminal bundles the Nerd Font symbols as a
+	// fallback font, such that glyphs render regardless of the configured font.
+	builtinSymbols bool
+}
+
+// terminalConfigs are the terminals which [DetectorTerminalConfig] supports.
+var terminalConfigs = map[Terminal]*terminalConfig{
+	TerminalAlacritty: alacrittyConfig,
+	TerminalKitty: {
+		paths: func(e *configEnv) []string {
+			var paths []string
+			if dir := e.getenv("KITTY_CONFIG_DIRECTORY"); dir != "" {
+				paths = append(paths, path.Join(dir, "kitty.conf"))
+			}
+			paths = append(paths, e.xdgConfigPaths("kitty/kitty.conf")...)
+			if e.goos == "darwin" {
+				paths = append(paths, path.Join(e.home, "Library/Preferences/kitty/kitty.conf"))
+			}
+			return paths
+		},
+		format: parseKittyConfig,
+		fonts:  kittyFonts,
+	},
+	TerminalGhostty: {
+		paths: func(e *configEnv) []string {
+			paths := []string{
+				path.Join(e.configHome(), "ghostty/config.ghostty"),
+				path.Join(e.configHome(), "ghostty/config"),
+			}
+			if e.goos == "darwin" {
+				paths = append(
+					paths,
+					path.Join(e.home, "Library/Application Support/com.mitchellh.ghostty/config.ghostty"),
+					path.Join(e.home, "Library/Application Support/com.mitchellh.ghostty/config"),
+				)
+			}
+			return paths
+		},
+		format:         parseGhosttyConfig,
+		fonts:          ghosttyFonts,
+		builtinSymbols: true,
+	},
+	TerminalFoot: {
+		paths: func(e *configEnv) []string {
+			return e.xdgConfigPaths("foot/foot.ini")
+		},
+		format: parseINIConfig,
+		fonts:  footFonts,
+	},
+	TerminalGnomeTerminal: {
+		load: gnomeTerminalFonts,
+	},
+	TerminalKonsole: {
+		load: konsoleFonts,
+	},
+	TerminalVSCode: {
+		paths:  vscodeSettingsPaths,
+		format: parseVSCodeSettings,
+		fonts:  vscodeFonts,
+	},
+	TerminalWindowsTerminal: {
+		load: windowsTerminalFonts,
+	},
+	TerminalXTerm: {
+		load: xresourcesFonts("faceName", "XTerm", "xterm"),
+	},
+	TerminalURxvt: {
+		load: xresourcesFonts("font", "URxvt", "urxvt", "Rxvt"),
+	},
+	TerminalSt: {
+		load: xresourcesFonts("font", "St", "st"),
+	},
+	// WezTerm configs are Lua, which requires an interpreter to evaluate. See the
+	// "github.com/lrstanley/go-nf/wezterm" module, which evaluates the config.
+	TerminalWezTerm: {
+		builtinSymbols: true,
+	},
+}
+
+// terminalFonts returns the fonts configured for a terminal, from the first
+// config file which exists.
+func terminalFonts(ctx context.Context, e *configEnv, cfg *terminalConfig) ([]string, error) {
+	if cfg.load != nil {
+		return cfg.load(ctx, e)
+	}
+	if cfg.paths == nil {
+		return nil, nil
+	}
+	for _, p := range cfg.paths(e) {
+		if !e.exists(p) {
+			continue
+		}
+		lines, err := e.readConfig(ctx, p, cfg.format)
+		return cfg.fonts(lines), err
+	}
+	return nil, nil
+}
+
+// DetectorTerminalConfig returns an [InstallDetector] which identifies the
+// terminal emulator the current process is running in (see [CurrentTerminal]),
+// and reads the fonts from its config file, returning [StatusEnabled] if the
+// configured font (or one of its fallback fonts) is a Nerd Font. Includes in
+// config files are followed.
+//
+// Supported terminals:
+//   - kitty: font_family and symbol_map, from kitty.conf.
+//   - Ghostty: font-family, from the Ghostty config. Ghostty bundles the Nerd
+//     Font symbols, so [StatusEnabled] is always returned.
+//   - foot: font, from foot.ini.
+//   - Alacritty: font.normal.family, from alacritty.toml (or the legacy
+//     alacritty.yml), following imports.
+//   - GNOME Terminal: font of the default profile, from dconf (or the GSettings
+//     keyfile), or the system monospace font if the profile uses it.
+//   - Konsole: Font of the default profile, from konsolerc and the profile, or
+//     the system fixed width font if the profile doesn't set one.
+//   - VS Code: terminal.integrated.fontFamily, or editor.fontFamily if unset,
+//     from the user settings.json.
+//   - Windows Terminal: font.face of the current (or default) profile, or
+//     profiles.defaults, from settings.json.
+//   - xterm, urxvt, st: XTerm*faceName, URxvt.font, and st.font respectively,
+//     from X resources ("xrdb -query", or ~/.Xresources if there is no display).
+//   - WezTerm: WezTerm bundles the Nerd Font symbols, so [StatusEnabled] is
+//     always returned, without reading the (Lua) config. See the
+//     "github.com/lrstanley/go-nf/wezterm" module, which evaluates the config.
+//
+// When running in WSL, the VS Code and Windows Terminal settings are read from
+// the Windows host (e.g. "/mnt/c/Users/<user>/AppData").
+//
+// If the terminal is not supported, or the configured font is not a Nerd Font,
+// [StatusNotInstalled] is returned.
+func DetectorTerminalConfig() InstallDetector {
+	return Named("terminal-config", func(ctx context.Context) (InstallStatus, error) {
+		return detectTerminalConfig(ctx, envFrom(ctx))
+	})
+}
+
+func detectTerminalConfig(ctx context.Context, e *configEnv) (InstallStatus, error) {
+	term := TerminalFrom(e.getenv)
+	cfg, ok := terminalConfigs[term]
+	if !ok {
+		return StatusNotInstalled, nil
+	}
+
+	AddEvidence(ctx, Evidence{Kind: EvidenceTerminal, Value: string(term)})
+
+	fonts, err := terminalFonts(ctx, e, cfg)
+	for _, font := range fonts {
+		AddEvidence(ctx, Evidence{Kind: EvidenceFont, Value: font})
+	}
+	for _, font := range fonts {
+		if IsNerdFontName(font) {
+			return StatusEnabled, nil
+		}
+	}
+	if cfg.builtinSymbols {
+		return StatusEnabled, nil
+	}
+	if err != nil {
+		return StatusNotInstalled, fmt.Errorf("failed to read %s config: %w", term, err)
+	}
+	return StatusNotInstalled, nil
+}
+
+// unquote removes matching single or double quotes surrounding s.
+func unquote(s string) string {
+	if len(s) >= 2 && (s[0] == '"' || s[0] == '\'') && s[len(s)-1] == s[0] {
+		return s[1 : len(s)-1]
+	}
+	return s
+}
+
+// parseKittyConfig parses a kitty.conf file, which is in the form of
+// "key value" lines. See https://sw.kovidgoyal.net/kitty/conf/
+func parseKittyConfig(e *configEnv, data []byte, file string, include func(p string) []configLine) ([]configLine, error) {
+	dir := path.Dir(file)
+	var lines []configLine
+	for line := range strings.Lines(string(data)) {
+		line = strings.TrimSpace(line)
+		if line == "" || line[0] == '#' {
+			continue
+		}
+
+		key, value := line, ""
+		if i := strings.IndexAny(line, " \t"); i >= 0 {
+			key, value = line[:i], line[i+1:]
+		}
+		value = strings.TrimSpace(value)
+
+		switch key {
+		case "include":
+			lines = append(lines, include(e.expand(value, dir))...)
+		case "globinclude":
+			for _, p := range e.glob(e.expand(value, dir)) {
+				lines = append(lines, include(p)...)
+			}
+		default:
+			lines = append(lines, configLine{key: key, value: value})
+		}
+	}
+	return lines, nil
+}
+
+// kittyFonts returns the effective font_family, and all symbol_map fonts.
+func kittyFonts(lines []configLine) []string {
+	var family string
+	var symbols []string
+
+	for _, l := range lines {
+		switch l.key {
+		case "font_family":
+			family = kittyFontFamily(l.value)
+		case "symbol_map":
+			// symbol_map <codepoints> <font family>
+			if i := strings.IndexAny(l.value, " \t"); i >= 0 {
+				symbols = append(symbols, strings.TrimSpace(l.value[i+1:]))
+			}
+		}
+	}
+
+	if family != "" {
+		return append([]string{family}, symbols...)
+	}
+	return symbols
+}
+
+// kittyFontFamily returns the family name from a font_family value, which is
+// either the family name, or a set of key=value pairs (e.g. `family="Fira Code"
+// style=Medium`).
+func kittyFontFamily(value string) string {
+	_, rest, ok := strings.Cut(value, "family=")
+	if !ok {
+		return unquote(value)
+	}
+	if rest != "" && (rest[0] == '"' || rest[0] == '\'') {
+		if end := strings.IndexByte(rest[1:], rest[0]); end >= 0 {
+			return rest[1 : end+1]
+		}
+	}
+	if i := strings.IndexAny(rest, " \t"); i >= 0 {
+		rest = rest[:i]
+	}
+	return rest
+}
+
+// parseGhosttyConfig parses a Ghostty config file, which is in the form of
+// "key = value" lines. Included config files (config-file) are loaded after the
+// current file. See https://ghostty.org/docs/config
+func parseGhosttyConfig(e *configEnv, data []byte, file string, include func(p string) []configLine) ([]configLine, error) {
+	dir := path.Dir(file)
+	var lines []configLine
+	var includes []string
+
+	for line := range strings.Lines(string(data)) {
+		line = strings.TrimSpace(line)
+		if line == "" || line[0] == '#' {
+			continue
+		}
+
+		key, value, _ := strings.Cut(line, "=")
+		key, value = strings.TrimSpace(key), unquote(strings.TrimSpace(value))
+
+		if key == "config-file" {
+			// A "?" prefix marks the file as optional, which we treat all
+			// includes as.
+			if value = strings.TrimPrefix(value, "?"); value != "" {
+				includes = append(includes, e.expand(unquote(value), dir))
+			}
+			continue
+		}
+		lines = append(lines, configLine{key: key, value: value})
+	}
+
+	for _, p := range includes {
+		lines = append(lines, include(p)...)
+	}
+	return lines, nil
+}
+
+// ghosttyFonts returns the font-family list. font-family can be repeated to
+// specify fallback fonts, and an empty value resets the list.
+func ghosttyFonts(lines []configLine) []string {
+	var fonts []string
+	for _, l := range lines {
+		if l.key != "font-family" {
+			continue
+		}
+		if l.value == "" {
+			fonts = nil
+			continue
+		}
+		fonts = append(fonts, l.value)
+	}
+	return fonts
+}
+
+// parseINIConfig parses an INI-style config file (e.g. foot.ini), with
+// "[section]" headers, and "key=value" lines. Lines before the first section
+// header are in the "main" section. "include=<path>" directives are inlined.
+func parseINIConfig(e *configEnv, data []byte, file string, include func(p string) []configLine) ([]configLine, error) {
+	dir := path.Dir(file)
+	var lines []configLine
+	section := "main"
+
+	for line := range strings.Lines(string(data)) {
+		line = strings.TrimSpace(line)
+		if line == "" || line[0] == '#' || line[0] == ';' {
+			continue
+		}
+
+		if line[0] == '[' && line[len(line)-1] == ']' {
+			section = strings.TrimSpace(line[1 : len(line)-1])
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			continue
+		}
+		key, value = strings.TrimSpace(key), unquote(strings.TrimSpace(value))
+
+		if key == "include" {
+			lines = append(lines, include(e.expand(value, dir))...)
+			continue
+		}
+		lines = append(lines, configLine{section: section, key: key, value: value})
+	}
+	return lines, nil
+}
+
+// iniValue returns the effective (last) value of key in section, or an empty
+// string if unset.
+func iniValue(lines []configLine, section, key string) string {
+	var value string
+	for _, l := range lines {
+		if l.section == section && l.key == key {
+			value = l.value
+		}
+	}
+	return value
+}
+
+// footFonts returns the fonts from the effective "font" key in the main section,
+// which is a comma-separated list of fontconfig patterns (e.g.
+// "Fira Code:size=11,Symbols Nerd Font:size=11").
+func footFonts(lines []configLine) []string {
+	var fonts []string
+	for pattern := range strings.SplitSeq(iniValue(lines, "main", "font"), ",") {
+		name, _, _ := strings.Cut(pattern, ":")
+		if name = strings.TrimSpace(name); name != "" {
+			fonts = append(fonts, name)
+		}
+	}
+	return fonts
+}
@@ -0,0 +1,262 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package nf
+
+import (
+	"context"
+	"os/exec"
+	"slices"
+	"testing"
+	"testing/fstest"
+)
+
+// testConfigEnv returns a [configEnv] with the given environment variables and
+// files, and a home directory of "/home/user". No commands are available.
+func testConfigEnv(env map[string]string, files fstest.MapFS) *configEnv {
+	return &configEnv{
+		getenv: func(key string) string { return env[key] },
+		fsys:   files,
+		home:   "/home/user",
+		goos:   "linux",
+		run: func(_ context.Context, name string, _ ...string) ([]byte, error) {
+			return nil, &exec.Error{Name: name, Err: exec.ErrNotFound}
+		},
+	}
+}
+
+func TestTerminalFrom(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		env  map[string]string
+		want Terminal
+	}{
+		{map[string]string{}, TerminalUnknown},
+		{map[string]string{"TERM": "xterm-256color"}, TerminalUnknown},
+		{map[string]string{"KITTY_WINDOW_ID": "1", "TERM": "xterm-kitty"}, TerminalKitty},
+		{map[string]string{"TERM": "xterm-kitty"}, TerminalKitty},
+		{map[string]string{"GHOSTTY_RESOURCES_DIR": "/usr/share/ghostty"}, TerminalGhostty},
+		{map[string]string{"TERM_PROGRAM": "ghostty"}, TerminalGhostty},
+		{map[string]string{"TERM": "foot"}, TerminalFoot},
+		{map[string]string{"TERM": "foot-extra"}, TerminalFoot},
+		{map[string]string{"TERM_PROGRAM": "WezTerm"}, TerminalWezTerm},
+		{map[string]string{"ALACRITTY_WINDOW_ID": "1"}, TerminalAlacritty},
+		{map[string]string{"KONSOLE_VERSION": "230805"}, TerminalKonsole},
+		{map[string]string{"GNOME_TERMINAL_SCREEN": "/org/gnome/Terminal/screen/1"}, TerminalGnomeTerminal},
+		{map[string]string{"WT_SESSION": "abc"}, TerminalWindowsTerminal},
+		{map[string]string{"XTERM_VERSION": "XTerm(390)", "TERM": "xterm-256color"}, TerminalXTerm},
+		{map[string]string{"TERM": "rxvt-unicode-256color"}, TerminalURxvt},
+		{map[string]string{"TERM": "st-256color"}, TerminalSt},
+		{map[string]string{"TERM_PROGRAM": "iTerm.app"}, TerminalITerm2},
+		{map[string]string{"TERM_PROGRAM": "Apple_Terminal"}, TerminalAppleTerminal},
+		// VS Code launched from kitty inherits KITTY_WINDOW_ID.
+		{map[string]string{"TERM_PROGRAM": "vscode", "KITTY_WINDOW_ID": "1"}, TerminalVSCode},
+		// tmux hides the terminal in TERM_PROGRAM, but not in other variables.
+		{map[string]string{"TERM_PROGRAM": "tmux", "TERM": "tmux-256color", "KITTY_WINDOW_ID": "1"}, TerminalKitty},
+	}
+
+	for _, tt := range tests {
+		if got := TerminalFrom(func(key string) string { return tt.env[key] }); got != tt.want {
+			t.Errorf("TerminalFrom(%v) = %q, want %q", tt.env, got, tt.want)
+		}
+	}
+}
+
+func TestTerminalFonts(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		term  Terminal
+		env   map[string]string
+		files fstest.MapFS
+		want  []string
+	}{
+		{
+			name: "kitty",
+			term: TerminalKitty,
+			files: fstest.MapFS{
+				"home/user/.config/kitty/kitty.conf": {Data: []byte(
+					"# comment\nfont_family      JetBrainsMono Nerd Font\nfont_size 11.0\n",
+				)},
+			},
+			want: []string{"JetBrainsMono Nerd Font"},
+		},
+		{
+			name: "kitty-include-override",
+			term: TerminalKitty,
+			files: fstest.MapFS{
+				"home/user/.config/kitty/kitty.conf": {Data: []byte(
+					"font_family JetBrainsMono Nerd Font\ninclude fonts.conf\n",
+				)},
+				"home/user/.config/kitty/fonts.conf": {Data: []byte("font_family\tFira Code\n")},
+			},
+			want: []string{"Fira Code"},
+		},
+		{
+			name: "kitty-symbol-map",
+			term: TerminalKitty,
+			files: fstest.MapFS{
+				"home/user/.config/kitty/kitty.conf": {Data: []byte(
+					"font_family family=\"Fira Code\" style=Medium\n" +
+						"symbol_map U+E000-U+E00A,U+EA60-U+EBEB Symbols Nerd Font Mono\n",
+				)},
+			},
+			want: []string{"Fira Code", "Symbols Nerd Font Mono"},
+		},
+		{
+			name: "kitty-config-directory-globinclude",
+			term: TerminalKitty,
+			env:  map[string]string{"KITTY_CONFIG_DIRECTORY": "/opt/kitty"},
+			files: fstest.MapFS{
+				"opt/kitty/kitty.conf":          {Data: []byte("globinclude conf.d/*.conf\n")},
+				"opt/kitty/conf.d/10-font.conf": {Data: []byte("font_family Hack Nerd Font\n")},
+				"home/user/.config/kitty/kitty.conf": {Data: []byte(
+					"font_family Fira Code\n",
+				)},
+			},
+			want: []string{"Hack Nerd Font"},
+		},
+		{
+			name: "kitty-include-cycle",
+			term: TerminalKitty,
+			files: fstest.MapFS{
+				"home/user/.config/kitty/kitty.conf": {Data: []byte("include a.conf\nfont_family Fira Code\n")},
+				"home/user/.config/kitty/a.conf":     {Data: []byte("include kitty.conf\n")},
+			},
+			want: []string{"Fira Code"},
+		},
+		{
+			name: "ghostty",
+			term: TerminalGhostty,
+			env:  map[string]string{"XDG_CONFIG_HOME": "/xdg"},
+			files: fstest.MapFS{
+				"xdg/ghostty/config": {Data: []byte(
+					"font-family = \"Comic Sans\"\nfont-family =\nfont-family = Fira Code\n" +
+						"config-file = ?~/ghostty-fonts\nfont-size = 12\n",
+				)},
+				"home/user/ghostty-fonts": {Data: []byte("font-family = \"Symbols Nerd Font\"\n")},
+			},
+			want: []string{"Fira Code", "Symbols Nerd Font"},
+		},
+		{
+			name: "foot",
+			term: TerminalFoot,
+			files: fstest.MapFS{
+				"etc/xdg/foot/foot.ini": {Data: []byte(
+					"font=Fira Code:size=10\n[colors]\nfont=ignored\n",
+				)},
+			},
+			want: []string{"Fira Code"},
+		},
+		{
+			name: "foot-include",
+			term: TerminalFoot,
+			files: fstest.MapFS{
+				"home/user/.config/foot/foot.ini": {Data: []byte(
+					"[main]\nfont=monospace\ninclude=~/.config/foot/fonts.ini\n",
+				)},
+				"home/user/.config/foot/fonts.ini": {Data: []byte(
+					"font=Fira Code:size=11, Symbols Nerd Font Mono:size=11\n",
+				)},
+			},
+			want: []string{"Fira Code", "Symbols Nerd Font Mono"},
+		},
+		{
+			name:  "missing",
+			term:  TerminalFoot,
+			files: fstest.MapFS{},
+			want:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := terminalFonts(t.Context(), testConfigEnv(tt.env, tt.files), terminalConfigs[tt.term])
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("expected fonts %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestDetectTerminalConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		env   map[string]string
+		files fstest.MapFS
+		want  InstallStatus
+	}{
+		{
+			name: "unknown-terminal",
+			env:  map[string]string{"TERM": "xterm-256color"},
+			want: StatusNotInstalled,
+		},
+		{
+			name: "kitty-nerd-font",
+			env:  map[string]string{"KITTY_WINDOW_ID": "1"},
+			files: fstest.MapFS{
+				"home/user/.config/kitty/kitty.conf": {Data: []byte("font_family JetBrainsMono NFM\n")},
+			},
+			want: StatusEnabled,
+		},
+		{
+			name: "kitty-other-font",
+			env:  map[string]string{"KITTY_WINDOW_ID": "1"},
+			files: fstest.MapFS{
+				"home/user/.config/kitty/kitty.conf": {Data: []byte("font_family Fira Code\n")},
+			},
+			want: StatusNotInstalled,
+		},
+		{
+			name: "ghostty-builtin-symbols",
+			env:  map[string]string{"TERM_PROGRAM": "ghostty"},
+			files: fstest.MapFS{
+				"home/user/.config/ghostty/config": {Data: []byte("font-family = Fira Code\n")},
+			},
+			want: StatusEnabled,
+		},
+		{
+			name: "foot-nerd-font",
+			env:  map[string]string{"TERM": "foot"},
+			files: fstest.MapFS{
+				"home/user/.config/foot/foot.ini": {Data: []byte("[main]\nfont=FiraCode Nerd Font:size=10\n")},
+			},
+			want: StatusEnabled,
+		},
+		{
+			name:  "foot-no-config",
+			env:   map[string]string{"TERM": "foot"},
+			files: fstest.MapFS{},
+			want:  StatusNotInstalled,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			files := tt.files
+			if files == nil {
+				files = fstest.MapFS{}
+			}
+
+			got, err := detectTerminalConfig(t.Context(), testConfigEnv(tt.env, files))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("expected status %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
@@ -7,108 +7,18 @@
 package nf
 
 import (
-	"bufio"
 	"context"
 	"errors"
-	"io/fs"
-	"os"
 	"os/exec"
-	"path/filepath"
-	"slices"
 	"strings"
-	"time"
 )
 
-// fsMaxDepth is the maximum depth to traverse when checking for font files in the filesystem.
-const fsMaxDepth = 4
-
-// fontExtensions is the list of font file extensions to check for.
-var fontExtensions = []string{
-	".ttf",
-	".otf",
-	".woff",
-	".woff2",
-}
-
-// filesystemPaths is the list of paths to check for font files in the filesystem.
-// Root directory trees only, they will be traversed recursively (ignoring errors).
-var filesystemPaths = []string{
-	"/usr/share/fonts/",
-	"/usr/local/share/fonts/",
-	"/var/lib/snapd/desktop/fonts/",
-	"~/.fonts/",
-	"~/.local/share/fonts/",
-}
-
-// DetectorFilesystem is a detector that checks the filesystem for font files,
-// in common locations. Returns [StatusInstalled] if any font files are found,
-// [StatusNotInstalled] otherwise. Permissions errors are ignored.
-//
-// This is a no-op on non-Unix systems.
-func DetectorFilesystem() InstallDetector { //nolint:gocognit
-	home, _ := os.UserHomeDir()
-	return func(_ context.Context) (InstallStatus, error) {
-		var errs []error
-		for _, path := range filesystemPaths {
-			if strings.HasPrefix(path, "~/") {
-				path = filepath.Join(home, path[2:])
-			}
-
-			if _, err := os.Stat(path); err != nil {
-				continue
-			}
-
-			sepCount := strings.Count(path, string(filepath.Separator))
-
-			var found bool
-			err := filepath.WalkDir(path, func(p string, d fs.DirEntry, err error) error {
-				if err != nil {
-					return filepath.SkipDir
-				}
-
-				if d.IsDir() {
-					// Skip directories that are too deep.
-					if c := strings.Count(p, string(filepath.Separator)) - sepCount; c > fsMaxDepth {
-						return filepath.SkipDir
-					}
-					return nil
-				}
-
-				// Skip non-font files.
-				if !slices.Contains(fontExtensions, filepath.Ext(d.Name())) {
-					return nil
-				}
-
-				// Check if the font name matches any of the matchers.
-				for _, matcher := range fontNameMatchers {
-					if matcher.MatchString(d.Name()) {
-						found = true
-						return filepath.SkipAll
-					}
-				}
-				return nil
-			})
-			if found {
-				return StatusInstalled, nil
-			}
-			if err != nil {
-				errs = append(errs, err)
-				continue
-			}
-		}
-		if len(errs) > 0 {
-			return StatusNotInstalled, errors.Join(errs...)
-		}
-		return StatusNotInstalled, nil
-	}
-}
-
 // DetectorWindowsGDI is a detector that uses the Windows GDI EnumFontFamiliesEx
 // API to enumerate installed fonts. This is a no-op on non-Windows systems.
 func DetectorWindowsGDI() InstallDetector {
-	return func(_ context.Context) (InstallStatus, error) {
+	return Named("windows-gdi", func(_ context.Context) (InstallStatus, error) {
 		return StatusNotInstalled, nil
-	}
+	})
 }
 
 // DetectorFontConfig is a detector that runs fc-list (fontconfig) and checks its
@@ -118,36 +28,64 @@ func DetectorWindowsGDI() InstallDetector {
 //
 // This is a no-op on non-Unix systems.
 func DetectorFontConfig() InstallDetector {
-	return func(ctx context.Context) (InstallStatus, error) {
-		if _, err := exec.LookPath("fc-list"); err != nil {
-			return StatusNotInstalled, err
-		}
-
-		cmd := exec.CommandContext(ctx, "fc-list")
-		cmd.WaitDelay = 10 * time.Millisecond
-
-		stdout, err := cmd.StdoutPipe()
-		if err != nil {
-			return StatusNotInstalled, err
+	return Named("fontconfig", func(ctx context.Context) (InstallStatus, error) {
+		out, err := envFrom(ctx).run(ctx, "fc-list")
+		if errors.Is(err, exec.ErrNotFound) {
+			return StatusNotInstalled, nil
 		}
-		err = cmd.Start()
 		if err != nil {
 			return StatusNotInstalled, err
 		}
 
-		scanner := bufio.NewScanner(stdout)
-		var line string
-		for scanner.Scan() {
-			line = scanner.Text()
+		for line := range strings.Lines(string(out)) {
+			line = strings.TrimRight(line, "\r\n")
 			for _, matcher := range fontNameMatchers {
 				if matcher.MatchString(line) {
-					_ = cmd.Wait()
+					AddEvidence(ctx, Evidence{Kind: EvidenceFont, Value: line})
 					return StatusInstalled, nil
 				}
 			}
 		}
-
-		_ = cmd.Wait()
 		return StatusNotInstalled, nil
+	})
+}
+
+// DetectorFontConfigCharset is a detector that asks fontconfig which fonts have
+// glyphs for specific Nerd Font codepoints, rather than matching font names
+// like [DetectorFontConfig]. This also finds fonts which embed the glyphs
+// without "Nerd Font" in their name, and fallback fonts (e.g. "Symbols Nerd
+// Font"). Returns [StatusInstalled] if the fontconfig "monospace" font
+// (fc-match), or any installed font (fc-list), has all of the glyphs,
+// [StatusNotInstalled] otherwise. Skips gracefully (no error) if fontconfig is
+// not installed.
+//
+// If no glyphs are provided, a few glyphs from different classes, which are
+// present in all Nerd Fonts since v2, are used. Provide the glyphs your
+// application uses to ensure they are available, e.g. "md" glyphs, which were
+// moved in v3.
+//
+// This is a no-op on non-Unix systems.
+func DetectorFontConfigCharset(glyphs ...Glyph) InstallDetector {
+	if len(glyphs) == 0 {
+		glyphs = fontConfigGlyphs
 	}
+	return Named("fontconfig-charset", func(ctx context.Context) (InstallStatus, error) {
+		return fontConfigCharset(ctx, envFrom(ctx), glyphs)
+	})
+}
+
+// DetectorFontConfigFallback is a detector that reads the fontconfig XML configs
+// (e.g. /etc/fonts/fonts.conf and ~/.config/fontconfig/fonts.conf, following
+// includes), and looks for family substitution rules which add a Nerd Font to
+// the "monospace" family, such as an <alias> preferring "Symbols Nerd Font
+// Mono", or a <match> appending it. This is a common setup, where a regular
+// monospace font is used, with Nerd Font glyphs rendered from the fallback
+// font. Returns [StatusInstalled] if such a rule is found, [StatusNotInstalled]
+// otherwise.
+//
+// This is a no-op on non-Unix systems.
+func DetectorFontConfigFallback() InstallDetector {
+	return Named("fontconfig-fallback", func(ctx context.Context) (InstallStatus, error) {
+		return fontConfigFallback(ctx, envFrom(ctx))
+	})
 }
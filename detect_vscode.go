@@ -0,0 +1,75 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package nf
+
+import (
+	"maps"
+	"path"
+	"slices"
+	"strings"
+)
+
+// vscodeProducts are the names of the VS Code builds (and forks) with the same
+// settings layout, in order of precedence.
+var vscodeProducts = []string{"Code", "Code - Insiders", "VSCodium", "Code - OSS"}
+
+// vscodeSettingsPaths returns the paths of the VS Code user settings, in order
+// of precedence. When running in WSL, the integrated terminal is rendered by
+// VS Code on the Windows host, so the Windows settings take precedence.
+func vscodeSettingsPaths(e *configEnv) []string {
+	var dirs []string
+	switch e.goos {
+	case "windows":
+		dirs = e.appDataDirs("APPDATA")
+	case "darwin":
+		dirs = []string{path.Join(e.home, "Library/Application Support")}
+	default:
+		dirs = append(e.appDataDirs("APPDATA"), e.configHome())
+	}
+
+	var paths []string
+	for _, dir := range dirs {
+		for _, product := range vscodeProducts {
+			paths = append(paths, path.Join(dir, product, "User/settings.json"))
+		}
+	}
+	return paths
+}
+
+// parseVSCodeSettings parses a VS Code settings.json file (JSONC), returning the
+// top-level settings with string values. Setting keys are already dotted (e.g.
+// "terminal.integrated.fontFamily").
+func parseVSCodeSettings(_ *configEnv, data []byte, _ string, _ func(p string) []configLine) ([]configLine, error) {
+	var settings map[string]any
+	if err := decodeJSONC(data, &settings); err != nil {
+		return nil, err
+	}
+
+	var lines []configLine
+	for _, key := range slices.Sorted(maps.Keys(settings)) {
+		if value, ok := settings[key].(string); ok {
+			lines = append(lines, configLine{key: key, value: value})
+		}
+	}
+	return lines, nil
+}
+
+// vscodeFonts returns the fonts from terminal.integrated.fontFamily, falling back
+// to editor.fontFamily, which are CSS font-family lists (e.g.
+// "'JetBrainsMono Nerd Font', Consolas, monospace").
+func vscodeFonts(lines []configLine) []string {
+	value := iniValue(lines, "", "terminal.integrated.fontFamily")
+	if strings.TrimSpace(value) == "" {
+		value = iniValue(lines, "", "editor.fontFamily")
+	}
+
+	var fonts []string
+	for family := range strings.SplitSeq(value, ",") {
+		if family = unquote(strings.TrimSpace(family)); family != "" {
+			fonts = append(fonts, family)
+		}
+	}
+	return fonts
+}
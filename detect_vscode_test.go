@@ -0,0 +1,93 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package nf
+
+import (
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"testing/fstest"
+)
+
+func TestVSCodeFonts(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		fixture string
+		env     map[string]string
+		want    []string
+		status  InstallStatus
+		wantErr bool
+	}{
+		{fixture: "linux", want: []string{"JetBrainsMono Nerd Font", "monospace"}, status: StatusEnabled},
+		{fixture: "editor-fallback", want: []string{"Hack Nerd Font Mono", "Consolas", "Courier New", "monospace"}, status: StatusEnabled},
+		{fixture: "defaults", want: nil, status: StatusNotInstalled},
+		{fixture: "malformed", want: nil, status: StatusNotInstalled, wantErr: true},
+		{
+			fixture: "wsl",
+			env:     map[string]string{"WSL_DISTRO_NAME": "Ubuntu", "USER": "user"},
+			want:    []string{"MesloLGS NF"},
+			status:  StatusEnabled,
+		},
+		// Without WSL, the Windows host isn't checked.
+		{fixture: "wsl", want: []string{"Fira Code"}, status: StatusNotInstalled},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			t.Parallel()
+
+			env := map[string]string{"TERM_PROGRAM": "vscode"}
+			maps.Copy(env, tt.env)
+			e := testConfigEnv(env, nil)
+			e.fsys = os.DirFS(filepath.Join("testdata", "vscode", tt.fixture))
+
+			got, err := terminalFonts(t.Context(), e, terminalConfigs[TerminalVSCode])
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error: %t, got %v", tt.wantErr, err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("expected fonts %q, got %q", tt.want, got)
+			}
+
+			status, _ := detectTerminalConfig(t.Context(), e)
+			if status != tt.status {
+				t.Errorf("expected status %q, got %q", tt.status, status)
+			}
+		})
+	}
+}
+
+func TestVSCodeSettingsPaths(t *testing.T) {
+	t.Parallel()
+
+	e := testConfigEnv(map[string]string{"APPDATA": `C:\Users\user\AppData\Roaming`}, nil)
+	e.goos = "windows"
+	if paths := vscodeSettingsPaths(e); paths[0] != "C:/Users/user/AppData/Roaming/Code/User/settings.json" {
+		t.Errorf("expected APPDATA path, got %q", paths)
+	}
+
+	e = testConfigEnv(nil, nil)
+	e.goos = "darwin"
+	if paths := vscodeSettingsPaths(e); paths[0] != "/home/user/Library/Application Support/Code/User/settings.json" {
+		t.Errorf("expected Application Support path, got %q", paths)
+	}
+
+	// The directory of the current user is checked first.
+	e = testConfigEnv(map[string]string{"WSL_INTEROP": "/run/WSL/1_interop", "USER": "zed"}, fstest.MapFS{
+		"mnt/c/Users/alice/NTUSER.DAT": {},
+		"mnt/c/Users/Zed/NTUSER.DAT":   {},
+		"mnt/c/Users/Public/desktop":   {},
+	})
+	want := []string{
+		"/mnt/c/Users/Zed/AppData/Roaming",
+		"/mnt/c/Users/alice/AppData/Roaming",
+	}
+	if got := e.appDataDirs("APPDATA"); !slices.Equal(got, want) {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
@@ -45,9 +45,15 @@ type enumLogFontExW struct {
 // API to enumerate installed fonts and match them against Nerd Font patterns.
 // Returns [StatusInstalled] if any font name matches, [StatusNotInstalled] otherwise.
 //
-// This is a no-op on non-Windows systems.
+// This is a no-op on non-Windows systems, and in fake environments (see
+// [ContextWithEnv]).
 func DetectorWindowsGDI() InstallDetector {
-	return func(_ context.Context) (InstallStatus, error) {
+	return Named("windows-gdi", func(ctx context.Context) (InstallStatus, error) {
+		// Fonts can't be enumerated in a fake environment, see [ContextWithEnv].
+		if _, ok := ctx.Value(envKey{}).(*Env); ok {
+			return StatusNotInstalled, nil
+		}
+
 		var usr, gdi *syscall.DLL
 		var err error
 
@@ -89,7 +95,7 @@ func DetectorWindowsGDI() InstallDetector {
 		}
 		defer releaseDC.Call(0, hdc)
 
-		var found bool
+		var found string
 		callback := syscall.NewCallback(func(lpElfe *enumLogFontExW, _ uintptr, _ uintptr, _ uintptr) uintptr {
 			fontName := strings.TrimSpace(syscall.UTF16ToString(lpElfe.ElfLogFont.LfFaceName[:]))
 			if fontName == "" || strings.HasPrefix(fontName, "@") {
@@ -97,7 +103,7 @@ func DetectorWindowsGDI() InstallDetector {
 			}
 			for _, matcher := range fontNameMatchers {
 				if matcher.MatchString(fontName) {
-					found = true
+					found = fontName
 					return 0
 				}
 			}
@@ -113,31 +119,44 @@ func DetectorWindowsGDI() InstallDetector {
 		// callback function for each font family found.
 		_, _, _ = enumFonts.Call(hdc, uintptr(unsafe.Pointer(&lf)), callback, 0, 0)
 
-		if found {
+		if found != "" {
+			AddEvidence(ctx, Evidence{Kind: EvidenceFont, Value: found})
 			return StatusInstalled, nil
 		}
 		return StatusNotInstalled, nil
-	}
+	})
 }
 
-// DetectorFilesystem is a detector that checks the filesystem for font files,
-// in common locations. Returns [StatusInstalled] if any font files are found,
-// [StatusNotInstalled] otherwise. Permissions errors are ignored.
+// DetectorFontConfig is a detector that runs fc-list (fontconfig) and checks its
+// output for Nerd Fonts. Returns [StatusInstalled] if any font name matches,
+// [StatusNotInstalled] otherwise.
 //
 // This is a no-op on non-Unix systems.
-func DetectorFilesystem() InstallDetector {
-	return func(_ context.Context) (InstallStatus, error) {
+func DetectorFontConfig() InstallDetector {
+	return Named("fontconfig", func(_ context.Context) (InstallStatus, error) {
 		return StatusNotInstalled, nil // No-op.
-	}
+	})
 }
 
-// DetectorFontConfig is a detector that runs fc-list (fontconfig) and checks its
-// output for Nerd Fonts. Returns [StatusInstalled] if any font name matches,
+// DetectorFontConfigCharset is a detector that asks fontconfig which fonts have
+// glyphs for specific Nerd Font codepoints. Returns [StatusInstalled] if any
+// font has all of the glyphs, [StatusNotInstalled] otherwise.
+//
+// This is a no-op on non-Unix systems.
+func DetectorFontConfigCharset(_ ...Glyph) InstallDetector {
+	return Named("fontconfig-charset", func(_ context.Context) (InstallStatus, error) {
+		return StatusNotInstalled, nil // No-op.
+	})
+}
+
+// DetectorFontConfigFallback is a detector that reads the fontconfig XML configs,
+// and looks for family substitution rules which add a Nerd Font to the
+// "monospace" family. Returns [StatusInstalled] if such a rule is found,
 // [StatusNotInstalled] otherwise.
 //
 // This is a no-op on non-Unix systems.
-func DetectorFontConfig() InstallDetector {
-	return func(_ context.Context) (InstallStatus, error) {
+func DetectorFontConfigFallback() InstallDetector {
+	return Named("fontconfig-fallback", func(_ context.Context) (InstallStatus, error) {
 		return StatusNotInstalled, nil // No-op.
-	}
+	})
 }
@@ -0,0 +1,122 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package nf
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"path"
+	"strings"
+)
+
+// windowsTerminalSettingsPaths returns the paths of the Windows Terminal
+// settings, for the stable, preview, and unpackaged (e.g. scoop) builds, in
+// order of precedence.
+func windowsTerminalSettingsPaths(e *configEnv) []string {
+	var paths []string
+	for _, dir := range e.appDataDirs("LOCALAPPDATA") {
+		paths = append(
+			paths,
+			path.Join(dir, "Packages/Microsoft.WindowsTerminal_8wekyb3d8bbwe/LocalState/settings.json"),
+			path.Join(dir, "Packages/Microsoft.WindowsTerminalPreview_8wekyb3d8bbwe/LocalState/settings.json"),
+			path.Join(dir, "Microsoft/Windows Terminal/settings.json"),
+		)
+	}
+	return paths
+}
+
+// windowsTerminalProfile is a Windows Terminal profile, or the profile defaults.
+type windowsTerminalProfile struct {
+	GUID string `json:"guid"`
+	Name string `json:"name"`
+	Font struct {
+		Face string `json:"face"`
+	} `json:"font"`
+	FontFace string `json:"fontFace"` // Prior to font.face.
+}
+
+// face returns the font face of the profile, if set.
+func (p *windowsTerminalProfile) face() string {
+	if p.Font.Face != "" {
+		return p.Font.Face
+	}
+	return p.FontFace
+}
+
+// windowsTerminalProfiles is the "profiles" setting, which is either an object
+// with "defaults" and "list", or (in older versions) just the list.
+type windowsTerminalProfiles struct {
+	Defaults windowsTerminalProfile   `json:"defaults"`
+	List     []windowsTerminalProfile `json:"list"`
+}
+
+func (p *windowsTerminalProfiles) UnmarshalJSON(data []byte) error {
+	if data = bytes.TrimSpace(data); len(data) > 0 && data[0] == '[' {
+		return json.Unmarshal(data, &p.List)
+	}
+	type plain windowsTerminalProfiles
+	return json.Unmarshal(data, (*plain)(p))
+}
+
+type windowsTerminalSettings struct {
+	DefaultProfile string                  `json:"defaultProfile"`
+	Profiles       windowsTerminalProfiles `json:"profiles"`
+}
+
+// fonts returns the fonts of the profile with the provided GUID (or name), or
+// the default profile if empty. Font faces may be a comma-separated list of
+// fallback fonts.
+func (s *windowsTerminalSettings) fonts(profile string) []string {
+	if profile == "" {
+		profile = s.DefaultProfile
+	}
+
+	face := s.Profiles.Defaults.face()
+	for i := range s.Profiles.List {
+		p := &s.Profiles.List[i]
+		if strings.EqualFold(p.GUID, profile) || p.Name == profile {
+			if f := p.face(); f != "" {
+				face = f
+			}
+			break
+		}
+	}
+
+	var fonts []string
+	for family := range strings.SplitSeq(face, ",") {
+		if family = strings.TrimSpace(family); family != "" {
+			fonts = append(fonts, family)
+		}
+	}
+	return fonts
+}
+
+// windowsTerminalFonts returns the fonts of the current Windows Terminal profile
+// (from $WT_PROFILE_ID, which is also shared with WSL), or the default profile.
+// Profiles inherit from profiles.defaults. Windows Terminal defaults to Cascadia
+// Mono if no font is set.
+func windowsTerminalFonts(ctx context.Context, e *configEnv) ([]string, error) {
+	for _, p := range windowsTerminalSettingsPaths(e) {
+		if !e.exists(p) {
+			continue
+		}
+
+		data, err := fs.ReadFile(e.fsys, fsPath(p))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %q: %w", p, err)
+		}
+
+		var settings windowsTerminalSettings
+		if err = decodeJSONC(data, &settings); err != nil {
+			return nil, fmt.Errorf("failed to parse %q: %w", p, err)
+		}
+		AddEvidence(ctx, Evidence{Kind: EvidenceFile, Value: p})
+		return settings.fonts(e.getenv("WT_PROFILE_ID")), nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,108 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package nf
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"testing/fstest"
+)
+
+func TestWindowsTerminalFonts(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		fixture string
+		profile string
+		want    []string
+		status  InstallStatus
+	}{
+		// The default profile doesn't set a font, so it inherits the defaults.
+		{
+			name:    "default-profile",
+			fixture: "wsl",
+			want:    []string{"CaskaydiaCove Nerd Font"},
+			status:  StatusEnabled,
+		},
+		{
+			name:    "current-profile",
+			fixture: "wsl",
+			profile: "{2C4DE342-38B7-51CF-B940-2309A097F518}",
+			want:    []string{"Fira Code", "Symbols Nerd Font Mono"},
+			status:  StatusEnabled,
+		},
+		{
+			name:    "unknown-profile",
+			fixture: "wsl",
+			profile: "{00000000-0000-0000-0000-000000000000}",
+			want:    []string{"CaskaydiaCove Nerd Font"},
+			status:  StatusEnabled,
+		},
+		{
+			name:    "legacy",
+			fixture: "legacy",
+			want:    []string{"UbuntuMono NF"},
+			status:  StatusEnabled,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			e := testConfigEnv(map[string]string{
+				"WT_SESSION":      "a4a7b1f0-5c8e-4a3e-9d8a-6f0c3c8e9b1d",
+				"WT_PROFILE_ID":   tt.profile,
+				"WSL_DISTRO_NAME": "Ubuntu",
+			}, nil)
+			e.fsys = os.DirFS(filepath.Join("testdata", "windows-terminal", tt.fixture))
+
+			got, err := terminalFonts(t.Context(), e, terminalConfigs[TerminalWindowsTerminal])
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("expected fonts %q, got %q", tt.want, got)
+			}
+
+			status, err := detectTerminalConfig(t.Context(), e)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if status != tt.status {
+				t.Errorf("expected status %q, got %q", tt.status, status)
+			}
+		})
+	}
+}
+
+func TestWindowsTerminalFontsNative(t *testing.T) {
+	t.Parallel()
+
+	e := testConfigEnv(map[string]string{"LOCALAPPDATA": `C:\Users\user\AppData\Local`}, fstest.MapFS{
+		"C:/Users/user/AppData/Local/Packages/Microsoft.WindowsTerminalPreview_8wekyb3d8bbwe/LocalState/settings.json": {
+			Data: []byte(`{"profiles": {"defaults": {"font": {"face": "Hack Nerd Font"}}, "list": []}}`),
+		},
+	})
+	e.goos = "windows"
+
+	got, err := windowsTerminalFonts(t.Context(), e)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := []string{"Hack Nerd Font"}; !slices.Equal(got, want) {
+		t.Errorf("expected fonts %q, got %q", want, got)
+	}
+
+	e.fsys = fstest.MapFS{
+		"C:/Users/user/AppData/Local/Microsoft/Windows Terminal/settings.json": {Data: []byte(`{"profiles": `)},
+	}
+	if _, err = windowsTerminalFonts(t.Context(), e); err == nil {
+		t.Error("expected error for malformed settings, got nil")
+	}
+}
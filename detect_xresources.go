@@ -0,0 +1,139 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package nf
+
+import (
+	"context"
+	"path"
+	"slices"
+	"strings"
+)
+
+// xresourcesFonts returns a function which reads the fonts from the X resource
+// of an application (e.g. "faceName" for xterm), bound to one of the provided
+// class or instance names (e.g. "XTerm*faceName"), or to any application (e.g.
+// "*faceName").
+//
+// Resources are read from "xrdb -query" when there is an X display, which
+// returns the resources loaded into the X server. Otherwise (or if xrdb fails),
+// ~/.Xresources (or ~/.Xdefaults) is read instead.
+func xresourcesFonts(resource string, names ...string) func(ctx context.Context, e *configEnv) ([]string, error) {
+	return func(ctx context.Context, e *configEnv) ([]string, error) {
+		lines, err := xresources(ctx, e)
+		return xftFamilies(xresourceValue(lines, resource, names)), err
+	}
+}
+
+// xresources returns the X resources, from "xrdb -query", or the resource files
+// in the home directory.
+func xresources(ctx context.Context, e *configEnv) ([]configLine, error) {
+	if e.getenv("DISPLAY") != "" {
+		out, err := e.run(ctx, "xrdb", "-query")
+		if err == nil {
+			AddEvidence(ctx, Evidence{Kind: EvidenceCommand, Value: "xrdb -query"})
+			return parseXResources(e, out, "", func(string) []configLine { return nil })
+		}
+	}
+
+	for _, p := range []string{
+		path.Join(e.home, ".Xresources"),
+		path.Join(e.home, ".Xdefaults"),
+	} {
+		if e.exists(p) {
+			return e.readConfig(ctx, p, parseXResources)
+		}
+	}
+	return nil, nil
+}
+
+// parseXResources parses an X resources file, with "name: value" lines. Lines
+// starting with "!" are comments, and lines ending with "\" are continued on
+// the next line. As xrdb runs resource files through the C preprocessor,
+// "#include" directives are inlined, and other directives are ignored.
+func parseXResources(e *configEnv, data []byte, file string, include func(p string) []configLine) ([]configLine, error) {
+	dir := path.Dir(file)
+	var lines []configLine
+	var continued string
+
+	for line := range strings.Lines(string(data)) {
+		line = strings.TrimRight(line, "\r\n")
+		if cut, ok := strings.CutSuffix(line, `\`); ok {
+			continued += cut
+			continue
+		}
+		line = strings.TrimSpace(continued + line)
+		continued = ""
+
+		if line == "" || line[0] == '!' {
+			continue
+		}
+
+		if directive, ok := strings.CutPrefix(line, "#"); ok {
+			if p, ok := strings.CutPrefix(strings.TrimSpace(directive), "include"); ok {
+				p = strings.Trim(strings.TrimSpace(p), `"<>`)
+				lines = append(lines, include(e.expand(p, dir))...)
+			}
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		lines = append(lines, configLine{key: strings.TrimSpace(key), value: strings.TrimSpace(value)})
+	}
+	return lines, nil
+}
+
+// xresourceValue returns the value of resource, bound to one of the provided
+// application names (e.g. "XTerm*faceName", or "XTerm.vt100.faceName"), falling
+// back to resources bound to any application (e.g. "*faceName"). This is a
+// simplification of the X resource precedence rules, which is sufficient for
+// terminal fonts.
+func xresourceValue(lines []configLine, resource string, names []string) string {
+	var bound, wildcard string
+	for _, l := range lines {
+		i := strings.LastIndexAny(l.key, ".*")
+		if i < 0 || l.key[i+1:] != resource {
+			continue
+		}
+
+		if l.key[0] == '*' {
+			wildcard = l.value
+			continue
+		}
+		if app := l.key[:strings.IndexAny(l.key, ".*")]; slices.Contains(names, app) {
+			bound = l.value
+		}
+	}
+	if bound != "" {
+		return bound
+	}
+	return wildcard
+}
+
+// xftFamilies returns the families from a comma-separated list of font specs,
+// which are either Xft fontconfig patterns (e.g. "xft:Hack Nerd Font:size=11"),
+// or core X fonts (XLFD, e.g. "-misc-fixed-medium-r-normal--13-*").
+func xftFamilies(value string) []string {
+	var families []string
+	for spec := range strings.SplitSeq(value, ",") {
+		spec = strings.TrimSpace(spec)
+
+		var family string
+		if xlfd, ok := strings.CutPrefix(spec, "-"); ok {
+			// -foundry-family-weight-slant-...
+			_, rest, _ := strings.Cut(xlfd, "-")
+			family, _, _ = strings.Cut(rest, "-")
+		} else {
+			family, _, _ = strings.Cut(strings.TrimPrefix(spec, "xft:"), ":")
+		}
+
+		if family = strings.TrimSpace(family); family != "" && family != "*" {
+			families = append(families, family)
+		}
+	}
+	return families
+}
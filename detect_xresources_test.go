@@ -0,0 +1,98 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package nf
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func TestXResourcesFonts(t *testing.T) {
+	t.Parallel()
+
+	query, err := os.ReadFile(filepath.Join("testdata", "xresources", "query.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		term    Terminal
+		display bool
+		xrdbErr error
+		want    []string
+		status  InstallStatus
+	}{
+		{name: "query-xterm", term: TerminalXTerm, display: true, want: []string{"JetBrainsMono Nerd Font"}, status: StatusEnabled},
+		{name: "query-urxvt", term: TerminalURxvt, display: true, want: []string{"Hack Nerd Font Mono", "Noto Color Emoji"}, status: StatusEnabled},
+		{name: "query-st", term: TerminalSt, display: true, want: []string{"fixed"}, status: StatusNotInstalled},
+		// Without a display, ~/.Xresources (and its includes) are read instead.
+		{name: "file-xterm", term: TerminalXTerm, want: []string{"MesloLGS NF"}, status: StatusEnabled},
+		{name: "file-urxvt", term: TerminalURxvt, want: []string{"Iosevka Term", "Symbols Nerd Font Mono"}, status: StatusEnabled},
+		{name: "file-st", term: TerminalSt, want: []string{"Fira Code"}, status: StatusNotInstalled},
+		// xrdb failing (e.g. the display can't be opened) falls back quietly.
+		{
+			name:    "xrdb-error",
+			term:    TerminalXTerm,
+			display: true,
+			xrdbErr: errors.New("xrdb: Can't open display ':0'"),
+			want:    []string{"MesloLGS NF"},
+			status:  StatusEnabled,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			env := map[Terminal]map[string]string{
+				TerminalXTerm: {"XTERM_VERSION": "XTerm(390)"},
+				TerminalURxvt: {"TERM": "rxvt-unicode-256color"},
+				TerminalSt:    {"TERM": "st-256color"},
+			}[tt.term]
+			if tt.display {
+				env["DISPLAY"] = ":0"
+			}
+
+			e := testConfigEnv(env, nil)
+			e.fsys = os.DirFS(filepath.Join("testdata", "xresources"))
+			e.run = func(_ context.Context, name string, args ...string) ([]byte, error) {
+				if !tt.display {
+					t.Errorf("unexpected command without display: %s %q", name, args)
+				}
+				return query, tt.xrdbErr
+			}
+
+			got, err := terminalFonts(t.Context(), e, terminalConfigs[tt.term])
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("expected fonts %q, got %q", tt.want, got)
+			}
+
+			status, err := detectTerminalConfig(t.Context(), e)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if status != tt.status {
+				t.Errorf("expected status %q, got %q", tt.status, status)
+			}
+		})
+	}
+}
+
+func TestXResourcesMissing(t *testing.T) {
+	t.Parallel()
+
+	got, err := xresourcesFonts("faceName", "XTerm")(t.Context(), testConfigEnv(nil, nil))
+	if err != nil || got != nil {
+		t.Errorf("expected no fonts or error, got %q, %v", got, err)
+	}
+}
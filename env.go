@@ -0,0 +1,131 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package nf
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"time"
+)
+
+// Env is the environment detectors read from: environment variables, the home
+// directory, the filesystem, commands, and the operating system. By default,
+// detectors read from the host. Use [ContextWithEnv] to run the built-in
+// detectors against a fake environment instead, e.g. to test how an
+// application behaves with and without Nerd Fonts. See the
+// "github.com/lrstanley/go-nf/nftest" package for an easy way to build one.
+type Env struct {
+	// Getenv returns the value of an environment variable, or an empty string
+	// if it's unset. Defaults to [os.Getenv].
+	Getenv func(key string) string
+	// Home is the (slash-separated) home directory of the user. Defaults to
+	// [os.UserHomeDir].
+	Home string
+	// FS is the filesystem, rooted at "/", i.e. absolute paths are opened
+	// without the leading "/" (e.g. "home/user/.config"). On Windows, paths
+	// start with the volume name (e.g. "C:/Users"). Defaults to the host
+	// filesystem.
+	FS fs.FS
+	// Run runs a command, returning its stdout. Commands which aren't installed
+	// should return an [exec.Error] wrapping [exec.ErrNotFound]. Defaults to
+	// running the command on the host.
+	Run func(ctx context.Context, name string, args ...string) ([]byte, error)
+	// GOOS is the operating system, e.g. "linux". Defaults to [runtime.GOOS].
+	GOOS string
+}
+
+// ReadFile reads the file at the absolute path p from [Env.FS].
+func (e *Env) ReadFile(p string) ([]byte, error) {
+	return fs.ReadFile(e.fsys(), fsPath(p))
+}
+
+// Stat returns the [fs.FileInfo] of the file at the absolute path p from
+// [Env.FS].
+func (e *Env) Stat(p string) (fs.FileInfo, error) {
+	return fs.Stat(e.fsys(), fsPath(p))
+}
+
+func (e *Env) fsys() fs.FS {
+	if e.FS == nil {
+		return hostFS{}
+	}
+	return e.FS
+}
+
+type envKey struct{}
+
+// ContextWithEnv returns a context which makes the built-in detectors read from
+// env, rather than the host. Unset fields of env default to the host (see
+// [Env]). Detectors which use OS APIs, rather than files or commands (e.g.
+// [DetectorWindowsGDI]), return [StatusNotInstalled].
+//
+// Custom detectors can use [EnvFrom] to support fake environments.
+func ContextWithEnv(ctx context.Context, env *Env) context.Context {
+	return context.WithValue(ctx, envKey{}, env)
+}
+
+// EnvFrom returns the environment set with [ContextWithEnv], with unset fields
+// defaulting to the host, or the host environment if none was set.
+func EnvFrom(ctx context.Context) *Env {
+	env := &Env{}
+	if v, ok := ctx.Value(envKey{}).(*Env); ok && v != nil {
+		*env = *v
+	}
+
+	if env.Getenv == nil {
+		env.Getenv = os.Getenv
+	}
+	if env.Home == "" {
+		home, _ := os.UserHomeDir()
+		env.Home = filepath.ToSlash(home)
+	}
+	if env.FS == nil {
+		env.FS = hostFS{}
+	}
+	if env.Run == nil {
+		env.Run = runCommand
+	}
+	if env.GOOS == "" {
+		env.GOOS = runtime.GOOS
+	}
+	return env
+}
+
+// envFrom returns the [configEnv] for the environment from [EnvFrom].
+func envFrom(ctx context.Context) *configEnv {
+	env := EnvFrom(ctx)
+	return &configEnv{
+		getenv: env.Getenv,
+		fsys:   env.FS,
+		home:   env.Home,
+		goos:   env.GOOS,
+		run:    env.Run,
+	}
+}
+
+// hostFS is an [fs.FS] which opens absolute paths on the host, without the
+// leading "/". On Windows, paths start with the volume name (e.g. "C:/Users"),
+// which [os.DirFS] doesn't support.
+type hostFS struct{}
+
+func (hostFS) Open(name string) (fs.File, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrInvalid}
+	}
+	if runtime.GOOS != "windows" {
+		name = "/" + name
+	}
+	return os.Open(filepath.FromSlash(name))
+}
+
+func runCommand(ctx context.Context, name string, args ...string) ([]byte, error) {
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.WaitDelay = 10 * time.Millisecond
+	return cmd.Output()
+}
@@ -7,79 +7,141 @@
 package all
 
 import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"runtime/debug"
 	"slices"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/lrstanley/go-nf"
 )
 
-const (
-	glyphCount = 10764
-	classCount = 17
-)
-
-func TestClasses(t *testing.T) {
-	t.Parallel()
-
-	results := slices.Collect(Classes())
+// classGlyphCounts is the number of glyphs in each class, from codegen.
+var classGlyphCounts = map[nf.Class]int{
+	"cod":         438,
+	"custom":      42,
+	"dev":         508,
+	"extra":       12,
+	"fa":          1817,
+	"fae":         170,
+	"iec":         5,
+	"indent":      2,
+	"indentation": 1,
+	"linux":       130,
+	"md":          6880,
+	"oct":         310,
+	"pl":          9,
+	"ple":         34,
+	"pom":         11,
+	"seti":        167,
+	"weather":     228,
+}
 
-	if len(results) != classCount {
-		t.Errorf("expected %d classes (from classCount), got %d", classCount, len(results))
-	}
+// buildTags returns the build tags the test binary was built with.
+func buildTags(tb testing.TB) []string {
+	tb.Helper()
 
-	if len(results) == 0 {
-		t.Errorf("expected at least one class, got %d", len(results))
-	}
-	if !slices.Contains(results, "cod") {
-		t.Errorf("expected class %q, got %q", "cod", results)
-	}
-	if !slices.Contains(results, "custom") {
-		t.Errorf("expected class %q, got %q", "custom", results)
-	}
-	if !slices.Contains(results, "dev") {
-		t.Errorf("expected class %q, got %q", "dev", results)
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		tb.Fatal("expected build info to be available")
 	}
-	if !slices.Contains(results, "extra") {
-		t.Errorf("expected class %q, got %q", "extra", results)
-	}
-	if !slices.Contains(results, "fa") {
-		t.Errorf("expected class %q, got %q", "fa", results)
-	}
-	if !slices.Contains(results, "fae") {
-		t.Errorf("expected class %q, got %q", "fae", results)
-	}
-	if !slices.Contains(results, "iec") {
-		t.Errorf("expected class %q, got %q", "iec", results)
-	}
-	if !slices.Contains(results, "indent") {
-		t.Errorf("expected class %q, got %q", "indent", results)
-	}
-	if !slices.Contains(results, "indentation") {
-		t.Errorf("expected class %q, got %q", "indentation", results)
+	for _, s := range info.Settings {
+		if s.Key == "-tags" && s.Value != "" {
+			return strings.Split(s.Value, ",")
+		}
 	}
-	if !slices.Contains(results, "linux") {
-		t.Errorf("expected class %q, got %q", "linux", results)
+	return nil
+}
+
+// expectedClasses returns the sorted classes which should be included in a build
+// with the given build tags.
+func expectedClasses(tags []string) []nf.Class {
+	var only []nf.Class
+	for _, tag := range tags {
+		if class, ok := strings.CutPrefix(tag, "nf_only_"); ok {
+			only = append(only, nf.Class(class))
+		}
 	}
-	if !slices.Contains(results, "md") {
-		t.Errorf("expected class %q, got %q", "md", results)
+
+	var results []nf.Class
+	for class := range classGlyphCounts {
+		if slices.Contains(tags, "nf_no_"+string(class)) {
+			continue
+		}
+		if len(only) > 0 && !slices.Contains(only, class) {
+			continue
+		}
+		results = append(results, class)
 	}
-	if !slices.Contains(results, "oct") {
-		t.Errorf("expected class %q, got %q", "oct", results)
+	slices.Sort(results)
+	return results
+}
+
+// expectedGlyphCount returns the number of glyphs which should be included in a
+// build with the given build tags.
+func expectedGlyphCount(tags []string) int {
+	var n int
+	for _, class := range expectedClasses(tags) {
+		n += classGlyphCounts[class]
 	}
-	if !slices.Contains(results, "pl") {
-		t.Errorf("expected class %q, got %q", "pl", results)
+	return n
+}
+
+func TestClasses(t *testing.T) {
+	t.Parallel()
+
+	results := slices.Collect(Classes())
+	expected := expectedClasses(buildTags(t))
+
+	if !slices.Equal(results, expected) {
+		t.Errorf("expected classes %q, got %q", expected, results)
 	}
-	if !slices.Contains(results, "ple") {
-		t.Errorf("expected class %q, got %q", "ple", results)
+}
+
+// TestBuildTags re-runs the tests of this package, with various combinations of
+// build tags which exclude classes.
+func TestBuildTags(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping build tag tests in short mode")
 	}
-	if !slices.Contains(results, "pom") {
-		t.Errorf("expected class %q, got %q", "pom", results)
+	if len(buildTags(t)) > 0 {
+		t.Skip("skipping nested build tag tests")
 	}
-	if !slices.Contains(results, "seti") {
-		t.Errorf("expected class %q, got %q", "seti", results)
+
+	gobin, err := exec.LookPath("go")
+	if err != nil {
+		t.Skipf("go toolchain not found: %v", err)
 	}
-	if !slices.Contains(results, "weather") {
-		t.Errorf("expected class %q, got %q", "weather", results)
+
+	t.Parallel()
+
+	for _, tags := range [][]string{
+		{"nf_no_md"},
+		{"nf_no_md", "nf_no_fa", "nf_no_cod"},
+		{"nf_only_dev"},
+		{"nf_only_dev", "nf_only_weather"},
+		{"nf_only_dev", "nf_no_dev"},
+	} {
+		t.Run(strings.Join(tags, ","), func(t *testing.T) {
+			t.Parallel()
+
+			cmd := exec.CommandContext(
+				t.Context(), gobin, "test", "-count=1",
+				"-tags", strings.Join(tags, ","),
+				"-run", "^(TestClasses|TestAllGlyphs|TestByClass|TestByID|TestGlyphIDs|TestGlyphFullIDs)$",
+				".",
+			)
+			cmd.Env = os.Environ()
+
+			out, err := cmd.CombinedOutput()
+			if err != nil {
+				t.Fatalf("go test with tags %q failed: %v\n%s", tags, err, out)
+			}
+		})
 	}
 }
 
@@ -88,14 +150,10 @@ func TestAllGlyphs(t *testing.T) {
 
 	results := slices.Collect(Glyphs())
 
-	if len(results) != glyphCount {
+	if glyphCount := expectedGlyphCount(buildTags(t)); len(results) != glyphCount {
 		t.Errorf("expected %d glyphs (from codegen), got %d", glyphCount, len(results))
 	}
 
-	if len(results) == 0 {
-		t.Errorf("expected at least one glyph, got %d", len(results))
-	}
-
 	for _, glyph := range results {
 		if glyph == "" {
 			t.Errorf("expected non-empty glyph, got %q", glyph)
@@ -141,7 +199,7 @@ func TestGlyphIDs(t *testing.T) {
 	t.Parallel()
 
 	results := slices.Collect(GlyphIDs())
-	if len(results) != glyphCount {
+	if glyphCount := expectedGlyphCount(buildTags(t)); len(results) != glyphCount {
 		t.Errorf("expected %d glyph IDs (from codegen), got %d", glyphCount, len(results))
 	}
 }
@@ -150,7 +208,7 @@ func TestGlyphFullIDs(t *testing.T) {
 	t.Parallel()
 
 	results := slices.Collect(GlyphFullIDs())
-	if len(results) != glyphCount {
+	if glyphCount := expectedGlyphCount(buildTags(t)); len(results) != glyphCount {
 		t.Errorf("expected %d glyph full IDs (from codegen), got %d", glyphCount, len(results))
 	}
 
@@ -166,3 +224,129 @@ func TestGlyphFullIDs(t *testing.T) {
 		t.Errorf("expected empty iterator for nonexistent class, got %d", c)
 	}
 }
+
+func BenchmarkByID(b *testing.B) {
+	b.ReportAllocs()
+	for b.Loop() {
+		_ = ByID("md-account")
+		_ = ByID("weather-day_sunny")
+		_ = ByID("nonexistent")
+	}
+}
+
+func BenchmarkGlyphs(b *testing.B) {
+	glyphCount := expectedGlyphCount(buildTags(b))
+
+	b.ReportAllocs()
+	for b.Loop() {
+		n := 0
+		for range Glyphs() {
+			n++
+		}
+		if n != glyphCount {
+			b.Fatalf("expected %d glyphs, got %d", glyphCount, n)
+		}
+	}
+}
+
+// initProgram is a program which imports this package, to measure the cost of
+// package initialization, and binary size.
+const initProgram = `package main
+
+import "github.com/lrstanley/go-nf/glyphs/all"
+
+func main() { _ = all.ByID("md-account") }
+`
+
+// initModule is the go.mod of [initProgram], which uses this repository through
+// a replace directive (see [BenchmarkInit]).
+const initModule = `module nfinit
+
+go 1.25.0
+
+require github.com/lrstanley/go-nf v0.0.0
+`
+
+// initHeapBudget is the maximum heap allocated while initializing the go-nf
+// packages, see [BenchmarkInit].
+const initHeapBudget = 64 << 10
+
+// reInitTrace matches the GODEBUG=inittrace=1 output of go-nf packages.
+var reInitTrace = regexp.MustCompile(`(?m)^init github\.com/lrstanley/go-nf\S* @\S+ ms, (\S+) ms clock, (\d+) bytes, (\d+) allocs$`)
+
+// BenchmarkInit measures the cost of importing all classes: the time, heap, and
+// allocations of initializing the go-nf packages (see GODEBUG=inittrace=1), and
+// the size of a binary which imports them. Class tables are laid out
+// statically, so initialization should barely allocate, which is checked
+// against [initHeapBudget]. Run with "make bench".
+func BenchmarkInit(b *testing.B) {
+	gobin, err := exec.LookPath("go")
+	if err != nil {
+		b.Skipf("go toolchain not found: %v", err)
+	}
+
+	root, err := filepath.Abs(filepath.Join("..", ".."))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	// The program is built in its own module, outside of the source tree.
+	dir := b.TempDir()
+	files := map[string]string{
+		"go.mod":  initModule + "\nreplace github.com/lrstanley/go-nf => " + strconv.Quote(root) + "\n",
+		"main.go": initProgram,
+	}
+	for name, data := range files {
+		if err = os.WriteFile(filepath.Join(dir, name), []byte(data), 0o600); err != nil {
+			b.Fatal(err)
+		}
+	}
+
+	bin := filepath.Join(dir, "init")
+	build := exec.CommandContext(b.Context(), gobin, "build", "-tags", strings.Join(buildTags(b), ","), "-o", bin, ".")
+	build.Dir = dir
+	build.Env = append(os.Environ(), "GOWORK=off", "GOFLAGS=-mod=mod")
+	out, err := build.CombinedOutput()
+	if err != nil {
+		b.Fatalf("failed to build init program: %v\n%s", err, out)
+	}
+
+	fi, err := os.Stat(bin)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	var n, heap, allocs int
+	var clock float64
+	for b.Loop() {
+		cmd := exec.CommandContext(b.Context(), bin)
+		cmd.Env = append(os.Environ(), "GODEBUG=inittrace=1")
+		out, err := cmd.CombinedOutput()
+		if err != nil {
+			b.Fatalf("failed to run init program: %v\n%s", err, out)
+		}
+
+		matches := reInitTrace.FindAllStringSubmatch(string(out), -1)
+		if len(matches) == 0 {
+			b.Fatalf("expected init trace of go-nf packages, got:\n%s", out)
+		}
+		for _, m := range matches {
+			ms, _ := strconv.ParseFloat(m[1], 64)
+			bytes, _ := strconv.Atoi(m[2])
+			count, _ := strconv.Atoi(m[3])
+			clock += ms
+			heap += bytes
+			allocs += count
+		}
+		n++
+	}
+
+	if heap/n > initHeapBudget {
+		b.Errorf("expected init heap of at most %d bytes, got %d", initHeapBudget, heap/n)
+	}
+
+	b.ReportMetric(clock*1e6/float64(n), "init-ns/op")
+	b.ReportMetric(float64(heap)/float64(n), "init-B/op")
+	b.ReportMetric(float64(allocs)/float64(n), "init-allocs/op")
+	b.ReportMetric(float64(fi.Size()), "binary-bytes")
+}
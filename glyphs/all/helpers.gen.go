@@ -4,31 +4,24 @@
 //
 // Code generated by cmd/codegen. DO NOT EDIT.
 
-// Package all contains helpers which are applicable to all glyphs.
+// Package all contains helpers which are applicable to all glyphs. Importing
+// this package registers all built-in classes with the nf class registry (see
+// [nf.RegisterClass]), except those excluded with build tags. The helpers in
+// this package operate on all registered classes, which includes any custom
+// classes registered by the application.
+//
+// Classes can be excluded with the "nf_no_<class>" build tag, or by building with
+// one or more "nf_only_<class>" build tags, which only include the given classes.
+// For example:
+//
+//	go build -tags nf_no_md,nf_no_fa         # all classes, except md and fa.
+//	go build -tags nf_only_cod,nf_only_dev   # only the cod and dev classes.
 package all
 
 import (
 	"iter"
-	"slices"
 
 	"github.com/lrstanley/go-nf"
-	"github.com/lrstanley/go-nf/glyphs/cod"
-	"github.com/lrstanley/go-nf/glyphs/custom"
-	"github.com/lrstanley/go-nf/glyphs/dev"
-	"github.com/lrstanley/go-nf/glyphs/extra"
-	"github.com/lrstanley/go-nf/glyphs/fa"
-	"github.com/lrstanley/go-nf/glyphs/fae"
-	"github.com/lrstanley/go-nf/glyphs/iec"
-	"github.com/lrstanley/go-nf/glyphs/indent"
-	"github.com/lrstanley/go-nf/glyphs/indentation"
-	"github.com/lrstanley/go-nf/glyphs/linux"
-	"github.com/lrstanley/go-nf/glyphs/md"
-	"github.com/lrstanley/go-nf/glyphs/oct"
-	"github.com/lrstanley/go-nf/glyphs/pl"
-	"github.com/lrstanley/go-nf/glyphs/ple"
-	"github.com/lrstanley/go-nf/glyphs/pom"
-	"github.com/lrstanley/go-nf/glyphs/seti"
-	"github.com/lrstanley/go-nf/glyphs/weather"
 )
 
 const (
@@ -36,115 +29,15 @@ const (
 	Version = "3.4.0"
 )
 
-var classes = []nf.Class{
-	cod.Class,
-	custom.Class,
-	dev.Class,
-	extra.Class,
-	fa.Class,
-	fae.Class,
-	iec.Class,
-	indent.Class,
-	indentation.Class,
-	linux.Class,
-	md.Class,
-	oct.Class,
-	pl.Class,
-	ple.Class,
-	pom.Class,
-	seti.Class,
-	weather.Class,
-}
-
-// Classes returns an iterator over all the classes.
+// Classes returns an iterator over all the registered classes.
 func Classes() iter.Seq[nf.Class] {
-	return slices.Values(classes)
+	return nf.Classes()
 }
 
 // Glyphs returns an iterator over all the glyphs.
 func Glyphs() iter.Seq[nf.Glyph] {
 	return func(yield func(nf.Glyph) bool) {
-		for glyph := range cod.AllGlyphs() {
-			if !yield(glyph) {
-				return
-			}
-		}
-		for glyph := range custom.AllGlyphs() {
-			if !yield(glyph) {
-				return
-			}
-		}
-		for glyph := range dev.AllGlyphs() {
-			if !yield(glyph) {
-				return
-			}
-		}
-		for glyph := range extra.AllGlyphs() {
-			if !yield(glyph) {
-				return
-			}
-		}
-		for glyph := range fa.AllGlyphs() {
-			if !yield(glyph) {
-				return
-			}
-		}
-		for glyph := range fae.AllGlyphs() {
-			if !yield(glyph) {
-				return
-			}
-		}
-		for glyph := range iec.AllGlyphs() {
-			if !yield(glyph) {
-				return
-			}
-		}
-		for glyph := range indent.AllGlyphs() {
-			if !yield(glyph) {
-				return
-			}
-		}
-		for glyph := range indentation.AllGlyphs() {
-			if !yield(glyph) {
-				return
-			}
-		}
-		for glyph := range linux.AllGlyphs() {
-			if !yield(glyph) {
-				return
-			}
-		}
-		for glyph := range md.AllGlyphs() {
-			if !yield(glyph) {
-				return
-			}
-		}
-		for glyph := range oct.AllGlyphs() {
-			if !yield(glyph) {
-				return
-			}
-		}
-		for glyph := range pl.AllGlyphs() {
-			if !yield(glyph) {
-				return
-			}
-		}
-		for glyph := range ple.AllGlyphs() {
-			if !yield(glyph) {
-				return
-			}
-		}
-		for glyph := range pom.AllGlyphs() {
-			if !yield(glyph) {
-				return
-			}
-		}
-		for glyph := range seti.AllGlyphs() {
-			if !yield(glyph) {
-				return
-			}
-		}
-		for glyph := range weather.AllGlyphs() {
+		for _, glyph := range nf.Glyphs() {
 			if !yield(glyph) {
 				return
 			}
@@ -155,191 +48,33 @@ func Glyphs() iter.Seq[nf.Glyph] {
 // ByClass returns an iterator over all the glyphs in the given class. If the
 // class does not exist, an empty iterator is returned.
 func ByClass(class nf.Class) iter.Seq[nf.Glyph] {
-	switch class {
-	case cod.Class:
-		return cod.AllGlyphs()
-	case custom.Class:
-		return custom.AllGlyphs()
-	case dev.Class:
-		return dev.AllGlyphs()
-	case extra.Class:
-		return extra.AllGlyphs()
-	case fa.Class:
-		return fa.AllGlyphs()
-	case fae.Class:
-		return fae.AllGlyphs()
-	case iec.Class:
-		return iec.AllGlyphs()
-	case indent.Class:
-		return indent.AllGlyphs()
-	case indentation.Class:
-		return indentation.AllGlyphs()
-	case linux.Class:
-		return linux.AllGlyphs()
-	case md.Class:
-		return md.AllGlyphs()
-	case oct.Class:
-		return oct.AllGlyphs()
-	case pl.Class:
-		return pl.AllGlyphs()
-	case ple.Class:
-		return ple.AllGlyphs()
-	case pom.Class:
-		return pom.AllGlyphs()
-	case seti.Class:
-		return seti.AllGlyphs()
-	case weather.Class:
-		return weather.AllGlyphs()
-	default:
-		return func(yield func(nf.Glyph) bool) {
+	return func(yield func(nf.Glyph) bool) {
+		glyphs, ok := nf.ClassGlyphs(class)
+		if !ok {
 			return
 		}
+		for _, glyph := range glyphs.All() {
+			if !yield(glyph) {
+				return
+			}
+		}
 	}
 }
 
 // ByID finds a glyph by its short or full ID across all classes, or an empty string
 // if the glyph is not found.
 func ByID(id string) nf.Glyph {
-	if glyph := cod.ByID(id); glyph != "" {
-		return glyph
-	}
-	if glyph := custom.ByID(id); glyph != "" {
-		return glyph
-	}
-	if glyph := dev.ByID(id); glyph != "" {
-		return glyph
-	}
-	if glyph := extra.ByID(id); glyph != "" {
-		return glyph
-	}
-	if glyph := fa.ByID(id); glyph != "" {
-		return glyph
-	}
-	if glyph := fae.ByID(id); glyph != "" {
-		return glyph
-	}
-	if glyph := iec.ByID(id); glyph != "" {
-		return glyph
-	}
-	if glyph := indent.ByID(id); glyph != "" {
-		return glyph
-	}
-	if glyph := indentation.ByID(id); glyph != "" {
-		return glyph
-	}
-	if glyph := linux.ByID(id); glyph != "" {
-		return glyph
-	}
-	if glyph := md.ByID(id); glyph != "" {
-		return glyph
-	}
-	if glyph := oct.ByID(id); glyph != "" {
-		return glyph
-	}
-	if glyph := pl.ByID(id); glyph != "" {
-		return glyph
-	}
-	if glyph := ple.ByID(id); glyph != "" {
-		return glyph
-	}
-	if glyph := pom.ByID(id); glyph != "" {
-		return glyph
-	}
-	if glyph := seti.ByID(id); glyph != "" {
-		return glyph
-	}
-	if glyph := weather.ByID(id); glyph != "" {
-		return glyph
-	}
-	return ""
+	return nf.ByID(id)
 }
 
 // GlyphIDs returns an iterator over all the IDs of the glyphs across all classes.
 func GlyphIDs() iter.Seq[string] {
 	return func(yield func(string) bool) {
-		for id := range cod.AllGlyphIDs() {
-			if !yield(id) {
-				return
-			}
-		}
-		for id := range custom.AllGlyphIDs() {
-			if !yield(id) {
-				return
-			}
-		}
-		for id := range dev.AllGlyphIDs() {
-			if !yield(id) {
-				return
-			}
-		}
-		for id := range extra.AllGlyphIDs() {
-			if !yield(id) {
-				return
-			}
-		}
-		for id := range fa.AllGlyphIDs() {
-			if !yield(id) {
-				return
-			}
-		}
-		for id := range fae.AllGlyphIDs() {
-			if !yield(id) {
-				return
-			}
-		}
-		for id := range iec.AllGlyphIDs() {
-			if !yield(id) {
-				return
-			}
-		}
-		for id := range indent.AllGlyphIDs() {
-			if !yield(id) {
-				return
-			}
-		}
-		for id := range indentation.AllGlyphIDs() {
-			if !yield(id) {
-				return
-			}
-		}
-		for id := range linux.AllGlyphIDs() {
-			if !yield(id) {
-				return
-			}
-		}
-		for id := range md.AllGlyphIDs() {
-			if !yield(id) {
-				return
-			}
-		}
-		for id := range oct.AllGlyphIDs() {
-			if !yield(id) {
-				return
-			}
-		}
-		for id := range pl.AllGlyphIDs() {
-			if !yield(id) {
-				return
-			}
-		}
-		for id := range ple.AllGlyphIDs() {
-			if !yield(id) {
-				return
-			}
-		}
-		for id := range pom.AllGlyphIDs() {
-			if !yield(id) {
-				return
-			}
-		}
-		for id := range seti.AllGlyphIDs() {
-			if !yield(id) {
-				return
-			}
-		}
-		for id := range weather.AllGlyphIDs() {
-			if !yield(id) {
-				return
+		for class := range nf.Classes() {
+			for id := range GlyphIDsByClass(class) {
+				if !yield(id) {
+					return
+				}
 			}
 		}
 	}
@@ -348,132 +83,23 @@ func GlyphIDs() iter.Seq[string] {
 // GlyphIDsByClass returns an iterator over all the IDs of the glyphs in the given
 // class. If the class does not exist, an empty iterator is returned.
 func GlyphIDsByClass(class nf.Class) iter.Seq[string] {
-	switch class {
-	case cod.Class:
-		return cod.AllGlyphIDs()
-	case custom.Class:
-		return custom.AllGlyphIDs()
-	case dev.Class:
-		return dev.AllGlyphIDs()
-	case extra.Class:
-		return extra.AllGlyphIDs()
-	case fa.Class:
-		return fa.AllGlyphIDs()
-	case fae.Class:
-		return fae.AllGlyphIDs()
-	case iec.Class:
-		return iec.AllGlyphIDs()
-	case indent.Class:
-		return indent.AllGlyphIDs()
-	case indentation.Class:
-		return indentation.AllGlyphIDs()
-	case linux.Class:
-		return linux.AllGlyphIDs()
-	case md.Class:
-		return md.AllGlyphIDs()
-	case oct.Class:
-		return oct.AllGlyphIDs()
-	case pl.Class:
-		return pl.AllGlyphIDs()
-	case ple.Class:
-		return ple.AllGlyphIDs()
-	case pom.Class:
-		return pom.AllGlyphIDs()
-	case seti.Class:
-		return seti.AllGlyphIDs()
-	case weather.Class:
-		return weather.AllGlyphIDs()
-	default:
-		return func(yield func(string) bool) {
+	return func(yield func(string) bool) {
+		glyphs, ok := nf.ClassGlyphs(class)
+		if !ok {
 			return
 		}
+		for id := range glyphs.All() {
+			if !yield(id) {
+				return
+			}
+		}
 	}
 }
 
 // GlyphFullIDs returns an iterator over all the full IDs of the glyphs across all classes.
 func GlyphFullIDs() iter.Seq[string] {
 	return func(yield func(string) bool) {
-		for id := range cod.AllGlyphFullIDs() {
-			if !yield(id) {
-				return
-			}
-		}
-		for id := range custom.AllGlyphFullIDs() {
-			if !yield(id) {
-				return
-			}
-		}
-		for id := range dev.AllGlyphFullIDs() {
-			if !yield(id) {
-				return
-			}
-		}
-		for id := range extra.AllGlyphFullIDs() {
-			if !yield(id) {
-				return
-			}
-		}
-		for id := range fa.AllGlyphFullIDs() {
-			if !yield(id) {
-				return
-			}
-		}
-		for id := range fae.AllGlyphFullIDs() {
-			if !yield(id) {
-				return
-			}
-		}
-		for id := range iec.AllGlyphFullIDs() {
-			if !yield(id) {
-				return
-			}
-		}
-		for id := range indent.AllGlyphFullIDs() {
-			if !yield(id) {
-				return
-			}
-		}
-		for id := range indentation.AllGlyphFullIDs() {
-			if !yield(id) {
-				return
-			}
-		}
-		for id := range linux.AllGlyphFullIDs() {
-			if !yield(id) {
-				return
-			}
-		}
-		for id := range md.AllGlyphFullIDs() {
-			if !yield(id) {
-				return
-			}
-		}
-		for id := range oct.AllGlyphFullIDs() {
-			if !yield(id) {
-				return
-			}
-		}
-		for id := range pl.AllGlyphFullIDs() {
-			if !yield(id) {
-				return
-			}
-		}
-		for id := range ple.AllGlyphFullIDs() {
-			if !yield(id) {
-				return
-			}
-		}
-		for id := range pom.AllGlyphFullIDs() {
-			if !yield(id) {
-				return
-			}
-		}
-		for id := range seti.AllGlyphFullIDs() {
-			if !yield(id) {
-				return
-			}
-		}
-		for id := range weather.AllGlyphFullIDs() {
+		for id := range nf.Glyphs() {
 			if !yield(id) {
 				return
 			}
@@ -484,44 +110,11 @@ func GlyphFullIDs() iter.Seq[string] {
 // GlyphFullIDsByClass returns an iterator over all the full IDs of the glyphs in the given
 // class. If the class does not exist, an empty iterator is returned.
 func GlyphFullIDsByClass(class nf.Class) iter.Seq[string] {
-	switch class {
-	case cod.Class:
-		return cod.AllGlyphFullIDs()
-	case custom.Class:
-		return custom.AllGlyphFullIDs()
-	case dev.Class:
-		return dev.AllGlyphFullIDs()
-	case extra.Class:
-		return extra.AllGlyphFullIDs()
-	case fa.Class:
-		return fa.AllGlyphFullIDs()
-	case fae.Class:
-		return fae.AllGlyphFullIDs()
-	case iec.Class:
-		return iec.AllGlyphFullIDs()
-	case indent.Class:
-		return indent.AllGlyphFullIDs()
-	case indentation.Class:
-		return indentation.AllGlyphFullIDs()
-	case linux.Class:
-		return linux.AllGlyphFullIDs()
-	case md.Class:
-		return md.AllGlyphFullIDs()
-	case oct.Class:
-		return oct.AllGlyphFullIDs()
-	case pl.Class:
-		return pl.AllGlyphFullIDs()
-	case ple.Class:
-		return ple.AllGlyphFullIDs()
-	case pom.Class:
-		return pom.AllGlyphFullIDs()
-	case seti.Class:
-		return seti.AllGlyphFullIDs()
-	case weather.Class:
-		return weather.AllGlyphFullIDs()
-	default:
-		return func(yield func(string) bool) {
-			return
+	return func(yield func(string) bool) {
+		for id := range GlyphIDsByClass(class) {
+			if !yield(string(class) + "-" + id) {
+				return
+			}
 		}
 	}
 }
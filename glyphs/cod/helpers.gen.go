@@ -8,489 +8,964 @@ package cod
 
 import (
 	"iter"
-	"maps"
+	"slices"
 	"strings"
 
 	"github.com/lrstanley/go-nf"
 )
 
-var (
-	allGlyphs = map[string]nf.Glyph{
-		"account":                      Account,
-		"activate_breakpoints":         ActivateBreakpoints,
-		"add":                          Add,
-		"archive":                      Archive,
-		"arrow_both":                   ArrowBoth,
-		"arrow_circle_down":            ArrowCircleDown,
-		"arrow_circle_left":            ArrowCircleLeft,
-		"arrow_circle_right":           ArrowCircleRight,
-		"arrow_circle_up":              ArrowCircleUp,
-		"arrow_down":                   ArrowDown,
-		"arrow_left":                   ArrowLeft,
-		"arrow_right":                  ArrowRight,
-		"arrow_small_down":             ArrowSmallDown,
-		"arrow_small_left":             ArrowSmallLeft,
-		"arrow_small_right":            ArrowSmallRight,
-		"arrow_small_up":               ArrowSmallUp,
-		"arrow_swap":                   ArrowSwap,
-		"arrow_up":                     ArrowUp,
-		"azure":                        Azure,
-		"azure_devops":                 AzureDevops,
-		"beaker":                       Beaker,
-		"beaker_stop":                  BeakerStop,
-		"bell":                         Bell,
-		"bell_dot":                     BellDot,
-		"bell_slash":                   BellSlash,
-		"bell_slash_dot":               BellSlashDot,
-		"blank":                        Blank,
-		"bold":                         Bold,
-		"book":                         Book,
-		"bookmark":                     Bookmark,
-		"bracket_dot":                  BracketDot,
-		"bracket_error":                BracketError,
-		"briefcase":                    Briefcase,
-		"broadcast":                    Broadcast,
-		"browser":                      Browser,
-		"bug":                          Bug,
-		"calendar":                     Calendar,
-		"call_incoming":                CallIncoming,
-		"call_outgoing":                CallOutgoing,
-		"case_sensitive":               CaseSensitive,
-		"check":                        Check,
-		"check_all":                    CheckAll,
-		"checklist":                    Checklist,
-		"chevron_down":                 ChevronDown,
-		"chevron_left":                 ChevronLeft,
-		"chevron_right":                ChevronRight,
-		"chevron_up":                   ChevronUp,
-		"chip":                         Chip,
-		"chrome_close":                 ChromeClose,
-		"chrome_maximize":              ChromeMaximize,
-		"chrome_minimize":              ChromeMinimize,
-		"chrome_restore":               ChromeRestore,
-		"circle":                       Circle,
-		"circle_filled":                CircleFilled,
-		"circle_large":                 CircleLarge,
-		"circle_large_filled":          CircleLargeFilled,
-		"circle_slash":                 CircleSlash,
-		"circle_small":                 CircleSmall,
-		"circle_small_filled":          CircleSmallFilled,
-		"circuit_board":                CircuitBoard,
-		"clear_all":                    ClearAll,
-		"clippy":                       Clippy,
-		"close":                        Close,
-		"close_all":                    CloseAll,
-		"cloud":                        Cloud,
-		"cloud_download":               CloudDownload,
-		"cloud_upload":                 CloudUpload,
-		"code":                         Code,
-		"coffee":                       Coffee,
-		"collapse_all":                 CollapseAll,
-		"color_mode":                   ColorMode,
-		"combine":                      Combine,
-		"comment":                      Comment,
-		"comment_discussion":           CommentDiscussion,
-		"comment_draft":                CommentDraft,
-		"comment_unresolved":           CommentUnresolved,
-		"compass":                      Compass,
-		"compass_active":               CompassActive,
-		"compass_dot":                  CompassDot,
-		"copilot":                      Copilot,
-		"copy":                         Copy,
-		"credit_card":                  CreditCard,
-		"dash":                         Dash,
-		"dashboard":                    Dashboard,
-		"database":                     Database,
-		"debug":                        Debug,
-		"debug_all":                    DebugAll,
-		"debug_alt":                    DebugAlt,
-		"debug_alt_small":              DebugAltSmall,
-		"debug_breakpoint_conditional": DebugBreakpointConditional,
-		"debug_breakpoint_conditional_unverified": DebugBreakpointConditionalUnverified,
-		"debug_breakpoint_data":                   DebugBreakpointData,
-		"debug_breakpoint_data_unverified":        DebugBreakpointDataUnverified,
-		"debug_breakpoint_function":               DebugBreakpointFunction,
-		"debug_breakpoint_function_unverified":    DebugBreakpointFunctionUnverified,
-		"debug_breakpoint_log":                    DebugBreakpointLog,
-		"debug_breakpoint_log_unverified":         DebugBreakpointLogUnverified,
-		"debug_breakpoint_unsupported":            DebugBreakpointUnsupported,
-		"debug_console":                           DebugConsole,
-		"debug_continue":                          DebugContinue,
-		"debug_continue_small":                    DebugContinueSmall,
-		"debug_coverage":                          DebugCoverage,
-		"debug_disconnect":                        DebugDisconnect,
-		"debug_line_by_line":                      DebugLineByLine,
-		"debug_pause":                             DebugPause,
-		"debug_rerun":                             DebugRerun,
-		"debug_restart":                           DebugRestart,
-		"debug_restart_frame":                     DebugRestartFrame,
-		"debug_reverse_continue":                  DebugReverseContinue,
-		"debug_stackframe":                        DebugStackframe,
-		"debug_stackframe_active":                 DebugStackframeActive,
-		"debug_start":                             DebugStart,
-		"debug_step_back":                         DebugStepBack,
-		"debug_step_into":                         DebugStepInto,
-		"debug_step_out":                          DebugStepOut,
-		"debug_step_over":                         DebugStepOver,
-		"debug_stop":                              DebugStop,
-		"desktop_download":                        DesktopDownload,
-		"device_camera":                           DeviceCamera,
-		"device_camera_video":                     DeviceCameraVideo,
-		"device_mobile":                           DeviceMobile,
-		"diff":                                    Diff,
-		"diff_added":                              DiffAdded,
-		"diff_ignored":                            DiffIgnored,
-		"diff_modified":                           DiffModified,
-		"diff_removed":                            DiffRemoved,
-		"diff_renamed":                            DiffRenamed,
-		"discard":                                 Discard,
-		"edit":                                    Edit,
-		"editor_layout":                           EditorLayout,
-		"ellipsis":                                Ellipsis,
-		"empty_window":                            EmptyWindow,
-		"error":                                   Error,
-		"error_small":                             ErrorSmall,
-		"exclude":                                 Exclude,
-		"expand_all":                              ExpandAll,
-		"export":                                  Export,
-		"extensions":                              Extensions,
-		"eye":                                     Eye,
-		"eye_closed":                              EyeClosed,
-		"feedback":                                Feedback,
-		"file":                                    File,
-		"file_binary":                             FileBinary,
-		"file_code":                               FileCode,
-		"file_media":                              FileMedia,
-		"file_pdf":                                FilePdf,
-		"file_submodule":                          FileSubmodule,
-		"file_symlink_directory":                  FileSymlinkDirectory,
-		"file_symlink_file":                       FileSymlinkFile,
-		"file_zip":                                FileZip,
-		"files":                                   Files,
-		"filter":                                  Filter,
-		"filter_filled":                           FilterFilled,
-		"flame":                                   Flame,
-		"fold":                                    Fold,
-		"fold_down":                               FoldDown,
-		"fold_up":                                 FoldUp,
-		"folder":                                  Folder,
-		"folder_active":                           FolderActive,
-		"folder_library":                          FolderLibrary,
-		"folder_opened":                           FolderOpened,
-		"game":                                    Game,
-		"gear":                                    Gear,
-		"gift":                                    Gift,
-		"gist_secret":                             GistSecret,
-		"git_commit":                              GitCommit,
-		"git_compare":                             GitCompare,
-		"git_fetch":                               GitFetch,
-		"git_merge":                               GitMerge,
-		"git_pull_request":                        GitPullRequest,
-		"git_pull_request_closed":                 GitPullRequestClosed,
-		"git_pull_request_create":                 GitPullRequestCreate,
-		"git_pull_request_draft":                  GitPullRequestDraft,
-		"git_pull_request_go_to_changes":          GitPullRequestGoToChanges,
-		"git_pull_request_new_changes":            GitPullRequestNewChanges,
-		"github":                                  Github,
-		"github_action":                           GithubAction,
-		"github_alt":                              GithubAlt,
-		"github_inverted":                         GithubInverted,
-		"globe":                                   Globe,
-		"go_to_file":                              GoToFile,
-		"grabber":                                 Grabber,
-		"graph":                                   Graph,
-		"graph_left":                              GraphLeft,
-		"graph_line":                              GraphLine,
-		"graph_scatter":                           GraphScatter,
-		"gripper":                                 Gripper,
-		"group_by_ref_type":                       GroupByRefType,
-		"heart":                                   Heart,
-		"heart_filled":                            HeartFilled,
-		"history":                                 History,
-		"home":                                    Home,
-		"horizontal_rule":                         HorizontalRule,
-		"hubot":                                   Hubot,
-		"inbox":                                   Inbox,
-		"indent":                                  Indent,
-		"info":                                    Info,
-		"insert":                                  Insert,
-		"inspect":                                 Inspect,
-		"issue_draft":                             IssueDraft,
-		"issue_reopened":                          IssueReopened,
-		"issues":                                  Issues,
-		"italic":                                  Italic,
-		"jersey":                                  Jersey,
-		"json":                                    Json,
-		"kebab_vertical":                          KebabVertical,
-		"key":                                     Key,
-		"law":                                     Law,
-		"layers":                                  Layers,
-		"layers_active":                           LayersActive,
-		"layers_dot":                              LayersDot,
-		"layout":                                  Layout,
-		"layout_activitybar_left":                 LayoutActivitybarLeft,
-		"layout_activitybar_right":                LayoutActivitybarRight,
-		"layout_centered":                         LayoutCentered,
-		"layout_menubar":                          LayoutMenubar,
-		"layout_panel":                            LayoutPanel,
-		"layout_panel_center":                     LayoutPanelCenter,
-		"layout_panel_justify":                    LayoutPanelJustify,
-		"layout_panel_left":                       LayoutPanelLeft,
-		"layout_panel_off":                        LayoutPanelOff,
-		"layout_panel_right":                      LayoutPanelRight,
-		"layout_sidebar_left":                     LayoutSidebarLeft,
-		"layout_sidebar_left_off":                 LayoutSidebarLeftOff,
-		"layout_sidebar_right":                    LayoutSidebarRight,
-		"layout_sidebar_right_off":                LayoutSidebarRightOff,
-		"layout_statusbar":                        LayoutStatusbar,
-		"library":                                 Library,
-		"lightbulb":                               Lightbulb,
-		"lightbulb_autofix":                       LightbulbAutofix,
-		"link":                                    Link,
-		"link_external":                           LinkExternal,
-		"list_filter":                             ListFilter,
-		"list_flat":                               ListFlat,
-		"list_ordered":                            ListOrdered,
-		"list_selection":                          ListSelection,
-		"list_tree":                               ListTree,
-		"list_unordered":                          ListUnordered,
-		"live_share":                              LiveShare,
-		"loading":                                 Loading,
-		"location":                                Location,
-		"lock":                                    Lock,
-		"lock_small":                              LockSmall,
-		"magnet":                                  Magnet,
-		"mail":                                    Mail,
-		"mail_read":                               MailRead,
-		"map":                                     Map,
-		"map_filled":                              MapFilled,
-		"markdown":                                Markdown,
-		"megaphone":                               Megaphone,
-		"mention":                                 Mention,
-		"menu":                                    Menu,
-		"merge":                                   Merge,
-		"mic":                                     Mic,
-		"mic_filled":                              MicFilled,
-		"milestone":                               Milestone,
-		"mirror":                                  Mirror,
-		"mortar_board":                            MortarBoard,
-		"move":                                    Move,
-		"multiple_windows":                        MultipleWindows,
-		"music":                                   Music,
-		"mute":                                    Mute,
-		"new_file":                                NewFile,
-		"new_folder":                              NewFolder,
-		"newline":                                 Newline,
-		"no_newline":                              NoNewline,
-		"note":                                    Note,
-		"notebook":                                Notebook,
-		"notebook_template":                       NotebookTemplate,
-		"octoface":                                Octoface,
-		"open_preview":                            OpenPreview,
-		"organization":                            Organization,
-		"output":                                  Output,
-		"package":                                 Package,
-		"paintcan":                                Paintcan,
-		"pass":                                    Pass,
-		"pass_filled":                             PassFilled,
-		"person":                                  Person,
-		"person_add":                              PersonAdd,
-		"piano":                                   Piano,
-		"pie_chart":                               PieChart,
-		"pin":                                     Pin,
-		"pinned":                                  Pinned,
-		"pinned_dirty":                            PinnedDirty,
-		"play":                                    Play,
-		"play_circle":                             PlayCircle,
-		"plug":                                    Plug,
-		"preserve_case":                           PreserveCase,
-		"preview":                                 Preview,
-		"primitive_square":                        PrimitiveSquare,
-		"project":                                 Project,
-		"pulse":                                   Pulse,
-		"question":                                Question,
-		"quote":                                   Quote,
-		"radio_tower":                             RadioTower,
-		"reactions":                               Reactions,
-		"record":                                  Record,
-		"record_keys":                             RecordKeys,
-		"record_small":                            RecordSmall,
-		"redo":                                    Redo,
-		"references":                              References,
-		"refresh":                                 Refresh,
-		"regex":                                   Regex,
-		"remote":                                  Remote,
-		"remote_explorer":                         RemoteExplorer,
-		"remove":                                  Remove,
-		"replace":                                 Replace,
-		"replace_all":                             ReplaceAll,
-		"reply":                                   Reply,
-		"repo":                                    Repo,
-		"repo_clone":                              RepoClone,
-		"repo_force_push":                         RepoForcePush,
-		"repo_forked":                             RepoForked,
-		"repo_pull":                               RepoPull,
-		"repo_push":                               RepoPush,
-		"report":                                  Report,
-		"request_changes":                         RequestChanges,
-		"rocket":                                  Rocket,
-		"root_folder":                             RootFolder,
-		"root_folder_opened":                      RootFolderOpened,
-		"rss":                                     Rss,
-		"ruby":                                    Ruby,
-		"run_above":                               RunAbove,
-		"run_all":                                 RunAll,
-		"run_below":                               RunBelow,
-		"run_errors":                              RunErrors,
-		"save":                                    Save,
-		"save_all":                                SaveAll,
-		"save_as":                                 SaveAs,
-		"screen_full":                             ScreenFull,
-		"screen_normal":                           ScreenNormal,
-		"search":                                  Search,
-		"search_fuzzy":                            SearchFuzzy,
-		"search_stop":                             SearchStop,
-		"send":                                    Send,
-		"server":                                  Server,
-		"server_environment":                      ServerEnvironment,
-		"server_process":                          ServerProcess,
-		"settings":                                Settings,
-		"settings_gear":                           SettingsGear,
-		"shield":                                  Shield,
-		"sign_in":                                 SignIn,
-		"sign_out":                                SignOut,
-		"smiley":                                  Smiley,
-		"snake":                                   Snake,
-		"sort_precedence":                         SortPrecedence,
-		"source_control":                          SourceControl,
-		"sparkle":                                 Sparkle,
-		"split_horizontal":                        SplitHorizontal,
-		"split_vertical":                          SplitVertical,
-		"squirrel":                                Squirrel,
-		"star_empty":                              StarEmpty,
-		"star_full":                               StarFull,
-		"star_half":                               StarHalf,
-		"stop_circle":                             StopCircle,
-		"symbol_array":                            SymbolArray,
-		"symbol_boolean":                          SymbolBoolean,
-		"symbol_class":                            SymbolClass,
-		"symbol_color":                            SymbolColor,
-		"symbol_constant":                         SymbolConstant,
-		"symbol_enum":                             SymbolEnum,
-		"symbol_enum_member":                      SymbolEnumMember,
-		"symbol_event":                            SymbolEvent,
-		"symbol_field":                            SymbolField,
-		"symbol_file":                             SymbolFile,
-		"symbol_interface":                        SymbolInterface,
-		"symbol_key":                              SymbolKey,
-		"symbol_keyword":                          SymbolKeyword,
-		"symbol_method":                           SymbolMethod,
-		"symbol_misc":                             SymbolMisc,
-		"symbol_namespace":                        SymbolNamespace,
-		"symbol_numeric":                          SymbolNumeric,
-		"symbol_operator":                         SymbolOperator,
-		"symbol_parameter":                        SymbolParameter,
-		"symbol_property":                         SymbolProperty,
-		"symbol_ruler":                            SymbolRuler,
-		"symbol_snippet":                          SymbolSnippet,
-		"symbol_string":                           SymbolString,
-		"symbol_structure":                        SymbolStructure,
-		"symbol_variable":                         SymbolVariable,
-		"sync":                                    Sync,
-		"sync_ignored":                            SyncIgnored,
-		"table":                                   Table,
-		"tag":                                     Tag,
-		"target":                                  Target,
-		"tasklist":                                Tasklist,
-		"telescope":                               Telescope,
-		"terminal":                                Terminal,
-		"terminal_bash":                           TerminalBash,
-		"terminal_cmd":                            TerminalCmd,
-		"terminal_debian":                         TerminalDebian,
-		"terminal_linux":                          TerminalLinux,
-		"terminal_powershell":                     TerminalPowershell,
-		"terminal_tmux":                           TerminalTmux,
-		"terminal_ubuntu":                         TerminalUbuntu,
-		"text_size":                               TextSize,
-		"three_bars":                              ThreeBars,
-		"thumbsdown":                              Thumbsdown,
-		"thumbsdown_filled":                       ThumbsdownFilled,
-		"thumbsup":                                Thumbsup,
-		"thumbsup_filled":                         ThumbsupFilled,
-		"tools":                                   Tools,
-		"trash":                                   Trash,
-		"triangle_down":                           TriangleDown,
-		"triangle_left":                           TriangleLeft,
-		"triangle_right":                          TriangleRight,
-		"triangle_up":                             TriangleUp,
-		"twitter":                                 Twitter,
-		"type_hierarchy":                          TypeHierarchy,
-		"type_hierarchy_sub":                      TypeHierarchySub,
-		"type_hierarchy_super":                    TypeHierarchySuper,
-		"unfold":                                  Unfold,
-		"ungroup_by_ref_type":                     UngroupByRefType,
-		"unlock":                                  Unlock,
-		"unmute":                                  Unmute,
-		"unverified":                              Unverified,
-		"variable_group":                          VariableGroup,
-		"verified":                                Verified,
-		"verified_filled":                         VerifiedFilled,
-		"versions":                                Versions,
-		"vm":                                      Vm,
-		"vm_active":                               VmActive,
-		"vm_connect":                              VmConnect,
-		"vm_outline":                              VmOutline,
-		"vm_running":                              VmRunning,
-		"vr":                                      Vr,
-		"wand":                                    Wand,
-		"warning":                                 Warning,
-		"watch":                                   Watch,
-		"whitespace":                              Whitespace,
-		"whole_word":                              WholeWord,
-		"window":                                  Window,
-		"word_wrap":                               WordWrap,
-		"workspace_trusted":                       WorkspaceTrusted,
-		"workspace_unknown":                       WorkspaceUnknown,
-		"workspace_untrusted":                     WorkspaceUntrusted,
-		"zoom_in":                                 ZoomIn,
-		"zoom_out":                                ZoomOut,
-	}
-)
+// glyphIDs are the IDs of all glyphs in the class, sorted, such that they can be
+// binary searched. The glyph for each ID is at the same index in glyphValues.
+//
+// Arrays of constants are laid out statically by the compiler, unlike maps,
+// which are built at init time.
+var glyphIDs = [...]string{
+	"account",
+	"activate_breakpoints",
+	"add",
+	"archive",
+	"arrow_both",
+	"arrow_circle_down",
+	"arrow_circle_left",
+	"arrow_circle_right",
+	"arrow_circle_up",
+	"arrow_down",
+	"arrow_left",
+	"arrow_right",
+	"arrow_small_down",
+	"arrow_small_left",
+	"arrow_small_right",
+	"arrow_small_up",
+	"arrow_swap",
+	"arrow_up",
+	"azure",
+	"azure_devops",
+	"beaker",
+	"beaker_stop",
+	"bell",
+	"bell_dot",
+	"bell_slash",
+	"bell_slash_dot",
+	"blank",
+	"bold",
+	"book",
+	"bookmark",
+	"bracket_dot",
+	"bracket_error",
+	"briefcase",
+	"broadcast",
+	"browser",
+	"bug",
+	"calendar",
+	"call_incoming",
+	"call_outgoing",
+	"case_sensitive",
+	"check",
+	"check_all",
+	"checklist",
+	"chevron_down",
+	"chevron_left",
+	"chevron_right",
+	"chevron_up",
+	"chip",
+	"chrome_close",
+	"chrome_maximize",
+	"chrome_minimize",
+	"chrome_restore",
+	"circle",
+	"circle_filled",
+	"circle_large",
+	"circle_large_filled",
+	"circle_slash",
+	"circle_small",
+	"circle_small_filled",
+	"circuit_board",
+	"clear_all",
+	"clippy",
+	"close",
+	"close_all",
+	"cloud",
+	"cloud_download",
+	"cloud_upload",
+	"code",
+	"coffee",
+	"collapse_all",
+	"color_mode",
+	"combine",
+	"comment",
+	"comment_discussion",
+	"comment_draft",
+	"comment_unresolved",
+	"compass",
+	"compass_active",
+	"compass_dot",
+	"copilot",
+	"copy",
+	"credit_card",
+	"dash",
+	"dashboard",
+	"database",
+	"debug",
+	"debug_all",
+	"debug_alt",
+	"debug_alt_small",
+	"debug_breakpoint_conditional",
+	"debug_breakpoint_conditional_unverified",
+	"debug_breakpoint_data",
+	"debug_breakpoint_data_unverified",
+	"debug_breakpoint_function",
+	"debug_breakpoint_function_unverified",
+	"debug_breakpoint_log",
+	"debug_breakpoint_log_unverified",
+	"debug_breakpoint_unsupported",
+	"debug_console",
+	"debug_continue",
+	"debug_continue_small",
+	"debug_coverage",
+	"debug_disconnect",
+	"debug_line_by_line",
+	"debug_pause",
+	"debug_rerun",
+	"debug_restart",
+	"debug_restart_frame",
+	"debug_reverse_continue",
+	"debug_stackframe",
+	"debug_stackframe_active",
+	"debug_start",
+	"debug_step_back",
+	"debug_step_into",
+	"debug_step_out",
+	"debug_step_over",
+	"debug_stop",
+	"desktop_download",
+	"device_camera",
+	"device_camera_video",
+	"device_mobile",
+	"diff",
+	"diff_added",
+	"diff_ignored",
+	"diff_modified",
+	"diff_removed",
+	"diff_renamed",
+	"discard",
+	"edit",
+	"editor_layout",
+	"ellipsis",
+	"empty_window",
+	"error",
+	"error_small",
+	"exclude",
+	"expand_all",
+	"export",
+	"extensions",
+	"eye",
+	"eye_closed",
+	"feedback",
+	"file",
+	"file_binary",
+	"file_code",
+	"file_media",
+	"file_pdf",
+	"file_submodule",
+	"file_symlink_directory",
+	"file_symlink_file",
+	"file_zip",
+	"files",
+	"filter",
+	"filter_filled",
+	"flame",
+	"fold",
+	"fold_down",
+	"fold_up",
+	"folder",
+	"folder_active",
+	"folder_library",
+	"folder_opened",
+	"game",
+	"gear",
+	"gift",
+	"gist_secret",
+	"git_commit",
+	"git_compare",
+	"git_fetch",
+	"git_merge",
+	"git_pull_request",
+	"git_pull_request_closed",
+	"git_pull_request_create",
+	"git_pull_request_draft",
+	"git_pull_request_go_to_changes",
+	"git_pull_request_new_changes",
+	"github",
+	"github_action",
+	"github_alt",
+	"github_inverted",
+	"globe",
+	"go_to_file",
+	"grabber",
+	"graph",
+	"graph_left",
+	"graph_line",
+	"graph_scatter",
+	"gripper",
+	"group_by_ref_type",
+	"heart",
+	"heart_filled",
+	"history",
+	"home",
+	"horizontal_rule",
+	"hubot",
+	"inbox",
+	"indent",
+	"info",
+	"insert",
+	"inspect",
+	"issue_draft",
+	"issue_reopened",
+	"issues",
+	"italic",
+	"jersey",
+	"json",
+	"kebab_vertical",
+	"key",
+	"law",
+	"layers",
+	"layers_active",
+	"layers_dot",
+	"layout",
+	"layout_activitybar_left",
+	"layout_activitybar_right",
+	"layout_centered",
+	"layout_menubar",
+	"layout_panel",
+	"layout_panel_center",
+	"layout_panel_justify",
+	"layout_panel_left",
+	"layout_panel_off",
+	"layout_panel_right",
+	"layout_sidebar_left",
+	"layout_sidebar_left_off",
+	"layout_sidebar_right",
+	"layout_sidebar_right_off",
+	"layout_statusbar",
+	"library",
+	"lightbulb",
+	"lightbulb_autofix",
+	"link",
+	"link_external",
+	"list_filter",
+	"list_flat",
+	"list_ordered",
+	"list_selection",
+	"list_tree",
+	"list_unordered",
+	"live_share",
+	"loading",
+	"location",
+	"lock",
+	"lock_small",
+	"magnet",
+	"mail",
+	"mail_read",
+	"map",
+	"map_filled",
+	"markdown",
+	"megaphone",
+	"mention",
+	"menu",
+	"merge",
+	"mic",
+	"mic_filled",
+	"milestone",
+	"mirror",
+	"mortar_board",
+	"move",
+	"multiple_windows",
+	"music",
+	"mute",
+	"new_file",
+	"new_folder",
+	"newline",
+	"no_newline",
+	"note",
+	"notebook",
+	"notebook_template",
+	"octoface",
+	"open_preview",
+	"organization",
+	"output",
+	"package",
+	"paintcan",
+	"pass",
+	"pass_filled",
+	"person",
+	"person_add",
+	"piano",
+	"pie_chart",
+	"pin",
+	"pinned",
+	"pinned_dirty",
+	"play",
+	"play_circle",
+	"plug",
+	"preserve_case",
+	"preview",
+	"primitive_square",
+	"project",
+	"pulse",
+	"question",
+	"quote",
+	"radio_tower",
+	"reactions",
+	"record",
+	"record_keys",
+	"record_small",
+	"redo",
+	"references",
+	"refresh",
+	"regex",
+	"remote",
+	"remote_explorer",
+	"remove",
+	"replace",
+	"replace_all",
+	"reply",
+	"repo",
+	"repo_clone",
+	"repo_force_push",
+	"repo_forked",
+	"repo_pull",
+	"repo_push",
+	"report",
+	"request_changes",
+	"rocket",
+	"root_folder",
+	"root_folder_opened",
+	"rss",
+	"ruby",
+	"run_above",
+	"run_all",
+	"run_below",
+	"run_errors",
+	"save",
+	"save_all",
+	"save_as",
+	"screen_full",
+	"screen_normal",
+	"search",
+	"search_fuzzy",
+	"search_stop",
+	"send",
+	"server",
+	"server_environment",
+	"server_process",
+	"settings",
+	"settings_gear",
+	"shield",
+	"sign_in",
+	"sign_out",
+	"smiley",
+	"snake",
+	"sort_precedence",
+	"source_control",
+	"sparkle",
+	"split_horizontal",
+	"split_vertical",
+	"squirrel",
+	"star_empty",
+	"star_full",
+	"star_half",
+	"stop_circle",
+	"symbol_array",
+	"symbol_boolean",
+	"symbol_class",
+	"symbol_color",
+	"symbol_constant",
+	"symbol_enum",
+	"symbol_enum_member",
+	"symbol_event",
+	"symbol_field",
+	"symbol_file",
+	"symbol_interface",
+	"symbol_key",
+	"symbol_keyword",
+	"symbol_method",
+	"symbol_misc",
+	"symbol_namespace",
+	"symbol_numeric",
+	"symbol_operator",
+	"symbol_parameter",
+	"symbol_property",
+	"symbol_ruler",
+	"symbol_snippet",
+	"symbol_string",
+	"symbol_structure",
+	"symbol_variable",
+	"sync",
+	"sync_ignored",
+	"table",
+	"tag",
+	"target",
+	"tasklist",
+	"telescope",
+	"terminal",
+	"terminal_bash",
+	"terminal_cmd",
+	"terminal_debian",
+	"terminal_linux",
+	"terminal_powershell",
+	"terminal_tmux",
+	"terminal_ubuntu",
+	"text_size",
+	"three_bars",
+	"thumbsdown",
+	"thumbsdown_filled",
+	"thumbsup",
+	"thumbsup_filled",
+	"tools",
+	"trash",
+	"triangle_down",
+	"triangle_left",
+	"triangle_right",
+	"triangle_up",
+	"twitter",
+	"type_hierarchy",
+	"type_hierarchy_sub",
+	"type_hierarchy_super",
+	"unfold",
+	"ungroup_by_ref_type",
+	"unlock",
+	"unmute",
+	"unverified",
+	"variable_group",
+	"verified",
+	"verified_filled",
+	"versions",
+	"vm",
+	"vm_active",
+	"vm_connect",
+	"vm_outline",
+	"vm_running",
+	"vr",
+	"wand",
+	"warning",
+	"watch",
+	"whitespace",
+	"whole_word",
+	"window",
+	"word_wrap",
+	"workspace_trusted",
+	"workspace_unknown",
+	"workspace_untrusted",
+	"zoom_in",
+	"zoom_out",
+}
+
+// glyphValues are the glyphs in the class, in the same order as glyphIDs.
+var glyphValues = [len(glyphIDs)]nf.Glyph{
+	Account,
+	ActivateBreakpoints,
+	Add,
+	Archive,
+	ArrowBoth,
+	ArrowCircleDown,
+	ArrowCircleLeft,
+	ArrowCircleRight,
+	ArrowCircleUp,
+	ArrowDown,
+	ArrowLeft,
+	ArrowRight,
+	ArrowSmallDown,
+	ArrowSmallLeft,
+	ArrowSmallRight,
+	ArrowSmallUp,
+	ArrowSwap,
+	ArrowUp,
+	Azure,
+	AzureDevops,
+	Beaker,
+	BeakerStop,
+	Bell,
+	BellDot,
+	BellSlash,
+	BellSlashDot,
+	Blank,
+	Bold,
+	Book,
+	Bookmark,
+	BracketDot,
+	BracketError,
+	Briefcase,
+	Broadcast,
+	Browser,
+	Bug,
+	Calendar,
+	CallIncoming,
+	CallOutgoing,
+	CaseSensitive,
+	Check,
+	CheckAll,
+	Checklist,
+	ChevronDown,
+	ChevronLeft,
+	ChevronRight,
+	ChevronUp,
+	Chip,
+	ChromeClose,
+	ChromeMaximize,
+	ChromeMinimize,
+	ChromeRestore,
+	Circle,
+	CircleFilled,
+	CircleLarge,
+	CircleLargeFilled,
+	CircleSlash,
+	CircleSmall,
+	CircleSmallFilled,
+	CircuitBoard,
+	ClearAll,
+	Clippy,
+	Close,
+	CloseAll,
+	Cloud,
+	CloudDownload,
+	CloudUpload,
+	Code,
+	Coffee,
+	CollapseAll,
+	ColorMode,
+	Combine,
+	Comment,
+	CommentDiscussion,
+	CommentDraft,
+	CommentUnresolved,
+	Compass,
+	CompassActive,
+	CompassDot,
+	Copilot,
+	Copy,
+	CreditCard,
+	Dash,
+	Dashboard,
+	Database,
+	Debug,
+	DebugAll,
+	DebugAlt,
+	DebugAltSmall,
+	DebugBreakpointConditional,
+	DebugBreakpointConditionalUnverified,
+	DebugBreakpointData,
+	DebugBreakpointDataUnverified,
+	DebugBreakpointFunction,
+	DebugBreakpointFunctionUnverified,
+	DebugBreakpointLog,
+	DebugBreakpointLogUnverified,
+	DebugBreakpointUnsupported,
+	DebugConsole,
+	DebugContinue,
+	DebugContinueSmall,
+	DebugCoverage,
+	DebugDisconnect,
+	DebugLineByLine,
+	DebugPause,
+	DebugRerun,
+	DebugRestart,
+	DebugRestartFrame,
+	DebugReverseContinue,
+	DebugStackframe,
+	DebugStackframeActive,
+	DebugStart,
+	DebugStepBack,
+	DebugStepInto,
+	DebugStepOut,
+	DebugStepOver,
+	DebugStop,
+	DesktopDownload,
+	DeviceCamera,
+	DeviceCameraVideo,
+	DeviceMobile,
+	Diff,
+	DiffAdded,
+	DiffIgnored,
+	DiffModified,
+	DiffRemoved,
+	DiffRenamed,
+	Discard,
+	Edit,
+	EditorLayout,
+	Ellipsis,
+	EmptyWindow,
+	Error,
+	ErrorSmall,
+	Exclude,
+	ExpandAll,
+	Export,
+	Extensions,
+	Eye,
+	EyeClosed,
+	Feedback,
+	File,
+	FileBinary,
+	FileCode,
+	FileMedia,
+	FilePdf,
+	FileSubmodule,
+	FileSymlinkDirectory,
+	FileSymlinkFile,
+	FileZip,
+	Files,
+	Filter,
+	FilterFilled,
+	Flame,
+	Fold,
+	FoldDown,
+	FoldUp,
+	Folder,
+	FolderActive,
+	FolderLibrary,
+	FolderOpened,
+	Game,
+	Gear,
+	Gift,
+	GistSecret,
+	GitCommit,
+	GitCompare,
+	GitFetch,
+	GitMerge,
+	GitPullRequest,
+	GitPullRequestClosed,
+	GitPullRequestCreate,
+	GitPullRequestDraft,
+	GitPullRequestGoToChanges,
+	GitPullRequestNewChanges,
+	Github,
+	GithubAction,
+	GithubAlt,
+	GithubInverted,
+	Globe,
+	GoToFile,
+	Grabber,
+	Graph,
+	GraphLeft,
+	GraphLine,
+	GraphScatter,
+	Gripper,
+	GroupByRefType,
+	Heart,
+	HeartFilled,
+	History,
+	Home,
+	HorizontalRule,
+	Hubot,
+	Inbox,
+	Indent,
+	Info,
+	Insert,
+	Inspect,
+	IssueDraft,
+	IssueReopened,
+	Issues,
+	Italic,
+	Jersey,
+	Json,
+	KebabVertical,
+	Key,
+	Law,
+	Layers,
+	LayersActive,
+	LayersDot,
+	Layout,
+	LayoutActivitybarLeft,
+	LayoutActivitybarRight,
+	LayoutCentered,
+	LayoutMenubar,
+	LayoutPanel,
+	LayoutPanelCenter,
+	LayoutPanelJustify,
+	LayoutPanelLeft,
+	LayoutPanelOff,
+	LayoutPanelRight,
+	LayoutSidebarLeft,
+	LayoutSidebarLeftOff,
+	LayoutSidebarRight,
+	LayoutSidebarRightOff,
+	LayoutStatusbar,
+	Library,
+	Lightbulb,
+	LightbulbAutofix,
+	Link,
+	LinkExternal,
+	ListFilter,
+	ListFlat,
+	ListOrdered,
+	ListSelection,
+	ListTree,
+	ListUnordered,
+	LiveShare,
+	Loading,
+	Location,
+	Lock,
+	LockSmall,
+	Magnet,
+	Mail,
+	MailRead,
+	Map,
+	MapFilled,
+	Markdown,
+	Megaphone,
+	Mention,
+	Menu,
+	Merge,
+	Mic,
+	MicFilled,
+	Milestone,
+	Mirror,
+	MortarBoard,
+	Move,
+	MultipleWindows,
+	Music,
+	Mute,
+	NewFile,
+	NewFolder,
+	Newline,
+	NoNewline,
+	Note,
+	Notebook,
+	NotebookTemplate,
+	Octoface,
+	OpenPreview,
+	Organization,
+	Output,
+	Package,
+	Paintcan,
+	Pass,
+	PassFilled,
+	Person,
+	PersonAdd,
+	Piano,
+	PieChart,
+	Pin,
+	Pinned,
+	PinnedDirty,
+	Play,
+	PlayCircle,
+	Plug,
+	PreserveCase,
+	Preview,
+	PrimitiveSquare,
+	Project,
+	Pulse,
+	Question,
+	Quote,
+	RadioTower,
+	Reactions,
+	Record,
+	RecordKeys,
+	RecordSmall,
+	Redo,
+	References,
+	Refresh,
+	Regex,
+	Remote,
+	RemoteExplorer,
+	Remove,
+	Replace,
+	ReplaceAll,
+	Reply,
+	Repo,
+	RepoClone,
+	RepoForcePush,
+	RepoForked,
+	RepoPull,
+	RepoPush,
+	Report,
+	RequestChanges,
+	Rocket,
+	RootFolder,
+	RootFolderOpened,
+	Rss,
+	Ruby,
+	RunAbove,
+	RunAll,
+	RunBelow,
+	RunErrors,
+	Save,
+	SaveAll,
+	SaveAs,
+	ScreenFull,
+	ScreenNormal,
+	Search,
+	SearchFuzzy,
+	SearchStop,
+	Send,
+	Server,
+	ServerEnvironment,
+	ServerProcess,
+	Settings,
+	SettingsGear,
+	Shield,
+	SignIn,
+	SignOut,
+	Smiley,
+	Snake,
+	SortPrecedence,
+	SourceControl,
+	Sparkle,
+	SplitHorizontal,
+	SplitVertical,
+	Squirrel,
+	StarEmpty,
+	StarFull,
+	StarHalf,
+	StopCircle,
+	SymbolArray,
+	SymbolBoolean,
+	SymbolClass,
+	SymbolColor,
+	SymbolConstant,
+	SymbolEnum,
+	SymbolEnumMember,
+	SymbolEvent,
+	SymbolField,
+	SymbolFile,
+	SymbolInterface,
+	SymbolKey,
+	SymbolKeyword,
+	SymbolMethod,
+	SymbolMisc,
+	SymbolNamespace,
+	SymbolNumeric,
+	SymbolOperator,
+	SymbolParameter,
+	SymbolProperty,
+	SymbolRuler,
+	SymbolSnippet,
+	SymbolString,
+	SymbolStructure,
+	SymbolVariable,
+	Sync,
+	SyncIgnored,
+	Table,
+	Tag,
+	Target,
+	Tasklist,
+	Telescope,
+	Terminal,
+	TerminalBash,
+	TerminalCmd,
+	TerminalDebian,
+	TerminalLinux,
+	TerminalPowershell,
+	TerminalTmux,
+	TerminalUbuntu,
+	TextSize,
+	ThreeBars,
+	Thumbsdown,
+	ThumbsdownFilled,
+	Thumbsup,
+	ThumbsupFilled,
+	Tools,
+	Trash,
+	TriangleDown,
+	TriangleLeft,
+	TriangleRight,
+	TriangleUp,
+	Twitter,
+	TypeHierarchy,
+	TypeHierarchySub,
+	TypeHierarchySuper,
+	Unfold,
+	UngroupByRefType,
+	Unlock,
+	Unmute,
+	Unverified,
+	VariableGroup,
+	Verified,
+	VerifiedFilled,
+	Versions,
+	Vm,
+	VmActive,
+	VmConnect,
+	VmOutline,
+	VmRunning,
+	Vr,
+	Wand,
+	Warning,
+	Watch,
+	Whitespace,
+	WholeWord,
+	Window,
+	WordWrap,
+	WorkspaceTrusted,
+	WorkspaceUnknown,
+	WorkspaceUntrusted,
+	ZoomIn,
+	ZoomOut,
+}
 
 // AllGlyphs returns an iterator over all the glyphs in the cod class,
-// returned in no particular order.
+// sorted by ID.
 func AllGlyphs() iter.Seq[nf.Glyph] {
-	return maps.Values(allGlyphs)
+	return slices.Values(glyphValues[:])
 }
 
 // ByID finds a glyph by its short or full ID within the class, or an empty string
-// if the glyph is not found.
+// if the glyph is not found. Lookups do not allocate.
 func ByID(id string) nf.Glyph {
-	if glyph, ok := allGlyphs[id]; ok {
+	if glyph, ok := lookup(id); ok {
 		return glyph
 	}
 	if _, stripped, ok := strings.Cut(id, string(Class)+"-"); ok {
-		if glyph, gok := allGlyphs[stripped]; gok {
+		if glyph, gok := lookup(stripped); gok {
 			return glyph
 		}
 	}
 	return ""
 }
 
+// lookup binary searches for a glyph by its short ID.
+func lookup(id string) (nf.Glyph, bool) {
+	if i, ok := slices.BinarySearch(glyphIDs[:], id); ok {
+		return glyphValues[i], true
+	}
+	return "", false
+}
+
 // AllGlyphIDs returns an iterator over all the IDs of the glyphs in the class,
-// returned in no particular order.
+// sorted.
 func AllGlyphIDs() iter.Seq[string] {
-	return maps.Keys(allGlyphs)
+	return slices.Values(glyphIDs[:])
 }
 
 // AllGlyphFullIDs returns an iterator over all the full IDs of the glyphs in
-// the class, returned in no particular order.
+// the class, sorted.
 func AllGlyphFullIDs() iter.Seq[string] {
 	return func(yield func(string) bool) {
-		for id := range allGlyphs {
+		for _, id := range glyphIDs {
 			if !yield(string(Class) + "-" + id) {
 				return
 			}
 		}
 	}
 }
+
+// glyphSet implements [nf.GlyphSet] for the class.
+type glyphSet struct{}
+
+// Lookup returns the glyph with the given short ID, and whether it was found.
+func (glyphSet) Lookup(id string) (nf.Glyph, bool) {
+	return lookup(id)
+}
+
+// All returns an iterator over all short IDs and their glyphs, sorted by ID.
+func (glyphSet) All() iter.Seq2[string, nf.Glyph] {
+	return func(yield func(string, nf.Glyph) bool) {
+		for i, id := range glyphIDs {
+			if !yield(id, glyphValues[i]) {
+				return
+			}
+		}
+	}
+}
+
+// The class is registered with [nf.RegisterClass] on import.
+var _ = nf.MustRegisterClass(Class, glyphSet{})
@@ -8,93 +8,172 @@ package custom
 
 import (
 	"iter"
-	"maps"
+	"slices"
 	"strings"
 
 	"github.com/lrstanley/go-nf"
 )
 
-var (
-	allGlyphs = map[string]nf.Glyph{
-		"ada":               Ada,
-		"asm":               Asm,
-		"astro":             Astro,
-		"bazel":             Bazel,
-		"c":                 C,
-		"chuck":             Chuck,
-		"common_lisp":       CommonLisp,
-		"cpp":               Cpp,
-		"crystal":           Crystal,
-		"css":               Css,
-		"default":           Default,
-		"electron":          Electron,
-		"elixir":            Elixir,
-		"elm":               Elm,
-		"emacs":             Emacs,
-		"fennel":            Fennel,
-		"firebase":          Firebase,
-		"folder":            Folder,
-		"folder_config":     FolderConfig,
-		"folder_git":        FolderGit,
-		"folder_git_branch": FolderGitBranch,
-		"folder_github":     FolderGithub,
-		"folder_npm":        FolderNpm,
-		"folder_oct":        FolderOct,
-		"folder_open":       FolderOpen,
-		"go":                Go,
-		"home":              Home,
-		"kotlin":            Kotlin,
-		"msdos":             Msdos,
-		"neovim":            Neovim,
-		"orgmode":           Orgmode,
-		"play_arrow":        PlayArrow,
-		"prettier":          Prettier,
-		"puppet":            Puppet,
-		"purescript":        Purescript,
-		"ruby":              Ruby,
-		"scheme":            Scheme,
-		"toml":              Toml,
-		"v_lang":            VLang,
-		"vim":               Vim,
-		"vitruvian":         Vitruvian,
-		"windows":           Windows,
-	}
-)
+// glyphIDs are the IDs of all glyphs in the class, sorted, such that they can be
+// binary searched. The glyph for each ID is at the same index in glyphValues.
+//
+// Arrays of constants are laid out statically by the compiler, unlike maps,
+// which are built at init time.
+var glyphIDs = [...]string{
+	"ada",
+	"asm",
+	"astro",
+	"bazel",
+	"c",
+	"chuck",
+	"common_lisp",
+	"cpp",
+	"crystal",
+	"css",
+	"default",
+	"electron",
+	"elixir",
+	"elm",
+	"emacs",
+	"fennel",
+	"firebase",
+	"folder",
+	"folder_config",
+	"folder_git",
+	"folder_git_branch",
+	"folder_github",
+	"folder_npm",
+	"folder_oct",
+	"folder_open",
+	"go",
+	"home",
+	"kotlin",
+	"msdos",
+	"neovim",
+	"orgmode",
+	"play_arrow",
+	"prettier",
+	"puppet",
+	"purescript",
+	"ruby",
+	"scheme",
+	"toml",
+	"v_lang",
+	"vim",
+	"vitruvian",
+	"windows",
+}
+
+// glyphValues are the glyphs in the class, in the same order as glyphIDs.
+var glyphValues = [len(glyphIDs)]nf.Glyph{
+	Ada,
+	Asm,
+	Astro,
+	Bazel,
+	C,
+	Chuck,
+	CommonLisp,
+	Cpp,
+	Crystal,
+	Css,
+	Default,
+	Electron,
+	Elixir,
+	Elm,
+	Emacs,
+	Fennel,
+	Firebase,
+	Folder,
+	FolderConfig,
+	FolderGit,
+	FolderGitBranch,
+	FolderGithub,
+	FolderNpm,
+	FolderOct,
+	FolderOpen,
+	Go,
+	Home,
+	Kotlin,
+	Msdos,
+	Neovim,
+	Orgmode,
+	PlayArrow,
+	Prettier,
+	Puppet,
+	Purescript,
+	Ruby,
+	Scheme,
+	Toml,
+	VLang,
+	Vim,
+	Vitruvian,
+	Windows,
+}
 
 // AllGlyphs returns an iterator over all the glyphs in the custom class,
-// returned in no particular order.
+// sorted by ID.
 func AllGlyphs() iter.Seq[nf.Glyph] {
-	return maps.Values(allGlyphs)
+	return slices.Values(glyphValues[:])
 }
 
 // ByID finds a glyph by its short or full ID within the class, or an empty string
-// if the glyph is not found.
+// if the glyph is not found. Lookups do not allocate.
 func ByID(id string) nf.Glyph {
-	if glyph, ok := allGlyphs[id]; ok {
+	if glyph, ok := lookup(id); ok {
 		return glyph
 	}
 	if _, stripped, ok := strings.Cut(id, string(Class)+"-"); ok {
-		if glyph, gok := allGlyphs[stripped]; gok {
+		if glyph, gok := lookup(stripped); gok {
 			return glyph
 		}
 	}
 	return ""
 }
 
+// lookup binary searches for a glyph by its short ID.
+func lookup(id string) (nf.Glyph, bool) {
+	if i, ok := slices.BinarySearch(glyphIDs[:], id); ok {
+		return glyphValues[i], true
+	}
+	return "", false
+}
+
 // AllGlyphIDs returns an iterator over all the IDs of the glyphs in the class,
-// returned in no particular order.
+// sorted.
 func AllGlyphIDs() iter.Seq[string] {
-	return maps.Keys(allGlyphs)
+	return slices.Values(glyphIDs[:])
 }
 
 // AllGlyphFullIDs returns an iterator over all the full IDs of the glyphs in
-// the class, returned in no particular order.
+// the class, sorted.
 func AllGlyphFullIDs() iter.Seq[string] {
 	return func(yield func(string) bool) {
-		for id := range allGlyphs {
+		for _, id := range glyphIDs {
 			if !yield(string(Class) + "-" + id) {
 				return
 			}
 		}
 	}
 }
+
+// glyphSet implements [nf.GlyphSet] for the class.
+type glyphSet struct{}
+
+// Lookup returns the glyph with the given short ID, and whether it was found.
+func (glyphSet) Lookup(id string) (nf.Glyph, bool) {
+	return lookup(id)
+}
+
+// All returns an iterator over all short IDs and their glyphs, sorted by ID.
+func (glyphSet) All() iter.Seq2[string, nf.Glyph] {
+	return func(yield func(string, nf.Glyph) bool) {
+		for i, id := range glyphIDs {
+			if !yield(id, glyphValues[i]) {
+				return
+			}
+		}
+	}
+}
+
+// The class is registered with [nf.RegisterClass] on import.
+var _ = nf.MustRegisterClass(Class, glyphSet{})
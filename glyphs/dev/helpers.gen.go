@@ -8,559 +8,1104 @@ package dev
 
 import (
 	"iter"
-	"maps"
+	"slices"
 	"strings"
 
 	"github.com/lrstanley/go-nf"
 )
 
-var (
-	allGlyphs = map[string]nf.Glyph{
-		"aarch64":                 Aarch64,
-		"adonisjs":                Adonisjs,
-		"aftereffects":            Aftereffects,
-		"akka":                    Akka,
-		"algolia":                 Algolia,
-		"alpinejs":                Alpinejs,
-		"amazonwebservices":       Amazonwebservices,
-		"anaconda":                Anaconda,
-		"android":                 Android,
-		"androidstudio":           Androidstudio,
-		"angular":                 Angular,
-		"angularjs":               Angularjs,
-		"angularmaterial":         Angularmaterial,
-		"ansible":                 Ansible,
-		"antdesign":               Antdesign,
-		"apache":                  Apache,
-		"apacheairflow":           Apacheairflow,
-		"apachekafka":             Apachekafka,
-		"apachespark":             Apachespark,
-		"apl":                     Apl,
-		"appcelerator":            Appcelerator,
-		"apple":                   Apple,
-		"appwrite":                Appwrite,
-		"archlinux":               Archlinux,
-		"arduino":                 Arduino,
-		"argocd":                  Argocd,
-		"astro":                   Astro,
-		"atom":                    Atom,
-		"awk":                     Awk,
-		"aws":                     Aws,
-		"axios":                   Axios,
-		"azure":                   Azure,
-		"azuredevops":             Azuredevops,
-		"azuresqldatabase":        Azuresqldatabase,
-		"babel":                   Babel,
-		"backbone":                Backbone,
-		"backbonejs":              Backbonejs,
-		"ballerina":               Ballerina,
-		"bamboo":                  Bamboo,
-		"bash":                    Bash,
-		"beats":                   Beats,
-		"behance":                 Behance,
-		"bitbucket":               Bitbucket,
-		"blazor":                  Blazor,
-		"blender":                 Blender,
-		"bootstrap":               Bootstrap,
-		"bower":                   Bower,
-		"browserstack":            Browserstack,
-		"bulma":                   Bulma,
-		"bun":                     Bun,
-		"c":                       C,
-		"c_lang":                  CLang,
-		"cairo":                   Cairo,
-		"cakephp":                 Cakephp,
-		"canva":                   Canva,
-		"capacitor":               Capacitor,
-		"carbon":                  Carbon,
-		"cassandra":               Cassandra,
-		"centos":                  Centos,
-		"ceylon":                  Ceylon,
-		"chrome":                  Chrome,
-		"circleci":                Circleci,
-		"clarity":                 Clarity,
-		"clion":                   Clion,
-		"clojure":                 Clojure,
-		"clojure_alt":             ClojureAlt,
-		"clojurescript":           Clojurescript,
-		"cloudflare":              Cloudflare,
-		"cloudflareworkers":       Cloudflareworkers,
-		"cmake":                   Cmake,
-		"codeac":                  Codeac,
-		"codecov":                 Codecov,
-		"codeigniter":             Codeigniter,
-		"codepen":                 Codepen,
-		"coffeescript":            Coffeescript,
-		"composer":                Composer,
-		"confluence":              Confluence,
-		"consul":                  Consul,
-		"contao":                  Contao,
-		"corejs":                  Corejs,
-		"cosmosdb":                Cosmosdb,
-		"couchbase":               Couchbase,
-		"couchdb":                 Couchdb,
-		"cplusplus":               Cplusplus,
-		"crystal":                 Crystal,
-		"csharp":                  Csharp,
-		"css3":                    Css3,
-		"css3_full":               Css3Full,
-		"cucumber":                Cucumber,
-		"cypressio":               Cypressio,
-		"d3js":                    D3Js,
-		"dart":                    Dart,
-		"database":                Database,
-		"datagrip":                Datagrip,
-		"dataspell":               Dataspell,
-		"dbeaver":                 Dbeaver,
-		"debian":                  Debian,
-		"denojs":                  Denojs,
-		"devicon":                 Devicon,
-		"digital_ocean":           DigitalOcean,
-		"digitalocean":            Digitalocean,
-		"discordjs":               Discordjs,
-		"django":                  Django,
-		"djangorest":              Djangorest,
-		"dlang":                   Dlang,
-		"docker":                  Docker,
-		"doctrine":                Doctrine,
-		"dotnet":                  Dotnet,
-		"dotnetcore":              Dotnetcore,
-		"dreamweaver":             Dreamweaver,
-		"dropbox":                 Dropbox,
-		"dropwizard":              Dropwizard,
-		"drupal":                  Drupal,
-		"dynamodb":                Dynamodb,
-		"eclipse":                 Eclipse,
-		"ecto":                    Ecto,
-		"elasticsearch":           Elasticsearch,
-		"electron":                Electron,
-		"eleventy":                Eleventy,
-		"elixir":                  Elixir,
-		"elm":                     Elm,
-		"emacs":                   Emacs,
-		"embeddedc":               Embeddedc,
-		"ember":                   Ember,
-		"envoy":                   Envoy,
-		"erlang":                  Erlang,
-		"eslint":                  Eslint,
-		"express":                 Express,
-		"facebook":                Facebook,
-		"fastapi":                 Fastapi,
-		"fastify":                 Fastify,
-		"faunadb":                 Faunadb,
-		"feathersjs":              Feathersjs,
-		"fedora":                  Fedora,
-		"figma":                   Figma,
-		"filezilla":               Filezilla,
-		"firebase":                Firebase,
-		"firefox":                 Firefox,
-		"flask":                   Flask,
-		"flutter":                 Flutter,
-		"fortran":                 Fortran,
-		"foundation":              Foundation,
-		"framermotion":            Framermotion,
-		"framework7":              Framework7,
-		"fsharp":                  Fsharp,
-		"gatling":                 Gatling,
-		"gatsby":                  Gatsby,
-		"gazebo":                  Gazebo,
-		"gcc":                     Gcc,
-		"gentoo":                  Gentoo,
-		"ghost":                   Ghost,
-		"ghost_small":             GhostSmall,
-		"gimp":                    Gimp,
-		"git":                     Git,
-		"git_branch":              GitBranch,
-		"git_commit":              GitCommit,
-		"git_compare":             GitCompare,
-		"git_merge":               GitMerge,
-		"git_pull_request":        GitPullRequest,
-		"gitbook":                 Gitbook,
-		"github":                  Github,
-		"github_badge":            GithubBadge,
-		"github_full":             GithubFull,
-		"githubactions":           Githubactions,
-		"githubcodespaces":        Githubcodespaces,
-		"gitlab":                  Gitlab,
-		"gitpod":                  Gitpod,
-		"gitter":                  Gitter,
-		"gnu":                     Gnu,
-		"go":                      Go,
-		"godot":                   Godot,
-		"goland":                  Goland,
-		"google":                  Google,
-		"googlecloud":             Googlecloud,
-		"gradle":                  Gradle,
-		"grafana":                 Grafana,
-		"grails":                  Grails,
-		"graphql":                 Graphql,
-		"groovy":                  Groovy,
-		"grpc":                    Grpc,
-		"grunt":                   Grunt,
-		"gulp":                    Gulp,
-		"hadoop":                  Hadoop,
-		"handlebars":              Handlebars,
-		"hardhat":                 Hardhat,
-		"harvester":               Harvester,
-		"haskell":                 Haskell,
-		"haxe":                    Haxe,
-		"helm":                    Helm,
-		"heroku":                  Heroku,
-		"hibernate":               Hibernate,
-		"homebrew":                Homebrew,
-		"html5":                   Html5,
-		"hugo":                    Hugo,
-		"ie":                      Ie,
-		"ifttt":                   Ifttt,
-		"illustrator":             Illustrator,
-		"influxdb":                Influxdb,
-		"inkscape":                Inkscape,
-		"insomnia":                Insomnia,
-		"intellij":                Intellij,
-		"ionic":                   Ionic,
-		"jaegertracing":           Jaegertracing,
-		"jamstack":                Jamstack,
-		"jasmine":                 Jasmine,
-		"java":                    Java,
-		"javascript":              Javascript,
-		"javascript_alt":          JavascriptAlt,
-		"javascript_badge":        JavascriptBadge,
-		"jeet":                    Jeet,
-		"jekyll":                  Jekyll,
-		"jekyll_small":            JekyllSmall,
-		"jenkins":                 Jenkins,
-		"jest":                    Jest,
-		"jetbrains":               Jetbrains,
-		"jetpackcompose":          Jetpackcompose,
-		"jira":                    Jira,
-		"jiraalign":               Jiraalign,
-		"jquery":                  Jquery,
-		"json":                    Json,
-		"jule":                    Jule,
-		"julia":                   Julia,
-		"junit":                   Junit,
-		"jupyter":                 Jupyter,
-		"k3os":                    K3Os,
-		"k3s":                     K3S,
-		"k6":                      K6,
-		"kaggle":                  Kaggle,
-		"karatelabs":              Karatelabs,
-		"karma":                   Karma,
-		"kdeneon":                 Kdeneon,
-		"keras":                   Keras,
-		"kibana":                  Kibana,
-		"knexjs":                  Knexjs,
-		"knockout":                Knockout,
-		"kotlin":                  Kotlin,
-		"krakenjs":                Krakenjs,
-		"krakenjs_badge":          KrakenjsBadge,
-		"ktor":                    Ktor,
-		"kubernetes":              Kubernetes,
-		"labview":                 Labview,
-		"laravel":                 Laravel,
-		"latex":                   Latex,
-		"less":                    Less,
-		"linkedin":                Linkedin,
-		"linux":                   Linux,
-		"liquibase":               Liquibase,
-		"livewire":                Livewire,
-		"llvm":                    Llvm,
-		"lodash":                  Lodash,
-		"logstash":                Logstash,
-		"lua":                     Lua,
-		"lumen":                   Lumen,
-		"magento":                 Magento,
-		"mariadb":                 Mariadb,
-		"markdown":                Markdown,
-		"materializecss":          Materializecss,
-		"materialui":              Materialui,
-		"matlab":                  Matlab,
-		"matplotlib":              Matplotlib,
-		"maven":                   Maven,
-		"maya":                    Maya,
-		"meteor":                  Meteor,
-		"meteorfull":              Meteorfull,
-		"microsoftsqlserver":      Microsoftsqlserver,
-		"minitab":                 Minitab,
-		"mithril":                 Mithril,
-		"mobx":                    Mobx,
-		"mocha":                   Mocha,
-		"modx":                    Modx,
-		"moleculer":               Moleculer,
-		"mongodb":                 Mongodb,
-		"mongoose":                Mongoose,
-		"moodle":                  Moodle,
-		"mootools_badge":          MootoolsBadge,
-		"mozilla":                 Mozilla,
-		"msdos":                   Msdos,
-		"mysql":                   Mysql,
-		"nano":                    Nano,
-		"neo4j":                   Neo4J,
-		"neovim":                  Neovim,
-		"nestjs":                  Nestjs,
-		"netlify":                 Netlify,
-		"networkx":                Networkx,
-		"nextjs":                  Nextjs,
-		"nginx":                   Nginx,
-		"ngrx":                    Ngrx,
-		"nhibernate":              Nhibernate,
-		"nim":                     Nim,
-		"nimble":                  Nimble,
-		"nixos":                   Nixos,
-		"nodejs":                  Nodejs,
-		"nodejs_small":            NodejsSmall,
-		"nodemon":                 Nodemon,
-		"nodewebkit":              Nodewebkit,
-		"nomad":                   Nomad,
-		"norg":                    Norg,
-		"notion":                  Notion,
-		"npm":                     Npm,
-		"nuget":                   Nuget,
-		"numpy":                   Numpy,
-		"nuxtjs":                  Nuxtjs,
-		"oauth":                   Oauth,
-		"objectivec":              Objectivec,
-		"ocaml":                   Ocaml,
-		"ohmyzsh":                 Ohmyzsh,
-		"okta":                    Okta,
-		"openal":                  Openal,
-		"openapi":                 Openapi,
-		"opencl":                  Opencl,
-		"opencv":                  Opencv,
-		"opengl":                  Opengl,
-		"openstack":               Openstack,
-		"opensuse":                Opensuse,
-		"opentelemetry":           Opentelemetry,
-		"opera":                   Opera,
-		"oracle":                  Oracle,
-		"ory":                     Ory,
-		"p5js":                    P5Js,
-		"packer":                  Packer,
-		"pandas":                  Pandas,
-		"perl":                    Perl,
-		"pfsense":                 Pfsense,
-		"phalcon":                 Phalcon,
-		"phoenix":                 Phoenix,
-		"photonengine":            Photonengine,
-		"photoshop":               Photoshop,
-		"php":                     Php,
-		"phpstorm":                Phpstorm,
-		"playwright":              Playwright,
-		"plotly":                  Plotly,
-		"pnpm":                    Pnpm,
-		"podman":                  Podman,
-		"poetry":                  Poetry,
-		"polygon":                 Polygon,
-		"portainer":               Portainer,
-		"postcss":                 Postcss,
-		"postgresql":              Postgresql,
-		"postman":                 Postman,
-		"powershell":              Powershell,
-		"premierepro":             Premierepro,
-		"prisma":                  Prisma,
-		"processing":              Processing,
-		"prolog":                  Prolog,
-		"prometheus":              Prometheus,
-		"protractor":              Protractor,
-		"pulsar":                  Pulsar,
-		"pulumi":                  Pulumi,
-		"puppeteer":               Puppeteer,
-		"purescript":              Purescript,
-		"putty":                   Putty,
-		"pycharm":                 Pycharm,
-		"pypi":                    Pypi,
-		"pyscript":                Pyscript,
-		"pytest":                  Pytest,
-		"python":                  Python,
-		"pytorch":                 Pytorch,
-		"qodana":                  Qodana,
-		"qt":                      Qt,
-		"quarkus":                 Quarkus,
-		"quasar":                  Quasar,
-		"qwik":                    Qwik,
-		"r":                       R,
-		"rabbitmq":                Rabbitmq,
-		"rails":                   Rails,
-		"railway":                 Railway,
-		"rancher":                 Rancher,
-		"raspberry_pi":            RaspberryPi,
-		"reach":                   Reach,
-		"react":                   React,
-		"reactbootstrap":          Reactbootstrap,
-		"reactnavigation":         Reactnavigation,
-		"reactrouter":             Reactrouter,
-		"readthedocs":             Readthedocs,
-		"realm":                   Realm,
-		"rect":                    Rect,
-		"redhat":                  Redhat,
-		"redis":                   Redis,
-		"redux":                   Redux,
-		"renpy":                   Renpy,
-		"replit":                  Replit,
-		"requirejs":               Requirejs,
-		"rider":                   Rider,
-		"rocksdb":                 Rocksdb,
-		"rockylinux":              Rockylinux,
-		"rollup":                  Rollup,
-		"ros":                     Ros,
-		"rspec":                   Rspec,
-		"rstudio":                 Rstudio,
-		"ruby":                    Ruby,
-		"ruby_on_rails":           RubyOnRails,
-		"ruby_rough":              RubyRough,
-		"rubymine":                Rubymine,
-		"rust":                    Rust,
-		"rxjs":                    Rxjs,
-		"safari":                  Safari,
-		"salesforce":              Salesforce,
-		"sanity":                  Sanity,
-		"sass":                    Sass,
-		"scala":                   Scala,
-		"scalingo":                Scalingo,
-		"scikitlearn":             Scikitlearn,
-		"sdl":                     Sdl,
-		"selenium":                Selenium,
-		"sema":                    Sema,
-		"sentry":                  Sentry,
-		"sequelize":               Sequelize,
-		"shopware":                Shopware,
-		"shotgrid":                Shotgrid,
-		"sketch":                  Sketch,
-		"slack":                   Slack,
-		"smashing_magazine":       SmashingMagazine,
-		"socketio":                Socketio,
-		"solidity":                Solidity,
-		"solidjs":                 Solidjs,
-		"sonarqube":               Sonarqube,
-		"sourcetree":              Sourcetree,
-		"spack":                   Spack,
-		"splunk":                  Splunk,
-		"spring":                  Spring,
-		"spss":                    Spss,
-		"spyder":                  Spyder,
-		"sqlalchemy":              Sqlalchemy,
-		"sqldeveloper":            Sqldeveloper,
-		"sqlite":                  Sqlite,
-		"ssh":                     Ssh,
-		"stackoverflow":           Stackoverflow,
-		"stata":                   Stata,
-		"storybook":               Storybook,
-		"streamlit":               Streamlit,
-		"stylus":                  Stylus,
-		"sublime":                 Sublime,
-		"subversion":              Subversion,
-		"supabase":                Supabase,
-		"svelte":                  Svelte,
-		"swagger":                 Swagger,
-		"swift":                   Swift,
-		"swiper":                  Swiper,
-		"symfony":                 Symfony,
-		"symfony_badge":           SymfonyBadge,
-		"tailwindcss":             Tailwindcss,
-		"tauri":                   Tauri,
-		"tensorflow":              Tensorflow,
-		"terminal":                Terminal,
-		"terraform":               Terraform,
-		"tex":                     Tex,
-		"thealgorithms":           Thealgorithms,
-		"threedsmax":              Threedsmax,
-		"threejs":                 Threejs,
-		"titaniumsdk":             Titaniumsdk,
-		"tomcat":                  Tomcat,
-		"tortoisegit":             Tortoisegit,
-		"towergit":                Towergit,
-		"traefikmesh":             Traefikmesh,
-		"traefikproxy":            Traefikproxy,
-		"travis":                  Travis,
-		"trello":                  Trello,
-		"trpc":                    Trpc,
-		"twitter":                 Twitter,
-		"typescript":              Typescript,
-		"typo3":                   Typo3,
-		"ubuntu":                  Ubuntu,
-		"uml":                     Uml,
-		"unifiedmodelinglanguage": Unifiedmodelinglanguage,
-		"unity":                   Unity,
-		"unity_small":             UnitySmall,
-		"unix":                    Unix,
-		"unrealengine":            Unrealengine,
-		"uwsgi":                   Uwsgi,
-		"v8":                      V8,
-		"vagrant":                 Vagrant,
-		"vala":                    Vala,
-		"vault":                   Vault,
-		"vercel":                  Vercel,
-		"vertx":                   Vertx,
-		"vim":                     Vim,
-		"visualbasic":             Visualbasic,
-		"visualstudio":            Visualstudio,
-		"vite":                    Vite,
-		"vitejs":                  Vitejs,
-		"vitess":                  Vitess,
-		"vitest":                  Vitest,
-		"vscode":                  Vscode,
-		"vsphere":                 Vsphere,
-		"vuejs":                   Vuejs,
-		"vuestorefront":           Vuestorefront,
-		"vuetify":                 Vuetify,
-		"vyper":                   Vyper,
-		"wasm":                    Wasm,
-		"webflow":                 Webflow,
-		"weblate":                 Weblate,
-		"webpack":                 Webpack,
-		"webstorm":                Webstorm,
-		"windows":                 Windows,
-		"windows11":               Windows11,
-		"woocommerce":             Woocommerce,
-		"wordpress":               Wordpress,
-		"xamarin":                 Xamarin,
-		"xcode":                   Xcode,
-		"xd":                      Xd,
-		"xml":                     Xml,
-		"yaml":                    Yaml,
-		"yarn":                    Yarn,
-		"yii":                     Yii,
-		"yugabytedb":              Yugabytedb,
-		"yunohost":                Yunohost,
-		"zend":                    Zend,
-		"zig":                     Zig,
-	}
-)
+// glyphIDs are the IDs of all glyphs in the class, sorted, such that they can be
+// binary searched. The glyph for each ID is at the same index in glyphValues.
+//
+// Arrays of constants are laid out statically by the compiler, unlike maps,
+// which are built at init time.
+var glyphIDs = [...]string{
+	"aarch64",
+	"adonisjs",
+	"aftereffects",
+	"akka",
+	"algolia",
+	"alpinejs",
+	"amazonwebservices",
+	"anaconda",
+	"android",
+	"androidstudio",
+	"angular",
+	"angularjs",
+	"angularmaterial",
+	"ansible",
+	"antdesign",
+	"apache",
+	"apacheairflow",
+	"apachekafka",
+	"apachespark",
+	"apl",
+	"appcelerator",
+	"apple",
+	"appwrite",
+	"archlinux",
+	"arduino",
+	"argocd",
+	"astro",
+	"atom",
+	"awk",
+	"aws",
+	"axios",
+	"azure",
+	"azuredevops",
+	"azuresqldatabase",
+	"babel",
+	"backbone",
+	"backbonejs",
+	"ballerina",
+	"bamboo",
+	"bash",
+	"beats",
+	"behance",
+	"bitbucket",
+	"blazor",
+	"blender",
+	"bootstrap",
+	"bower",
+	"browserstack",
+	"bulma",
+	"bun",
+	"c",
+	"c_lang",
+	"cairo",
+	"cakephp",
+	"canva",
+	"capacitor",
+	"carbon",
+	"cassandra",
+	"centos",
+	"ceylon",
+	"chrome",
+	"circleci",
+	"clarity",
+	"clion",
+	"clojure",
+	"clojure_alt",
+	"clojurescript",
+	"cloudflare",
+	"cloudflareworkers",
+	"cmake",
+	"codeac",
+	"codecov",
+	"codeigniter",
+	"codepen",
+	"coffeescript",
+	"composer",
+	"confluence",
+	"consul",
+	"contao",
+	"corejs",
+	"cosmosdb",
+	"couchbase",
+	"couchdb",
+	"cplusplus",
+	"crystal",
+	"csharp",
+	"css3",
+	"css3_full",
+	"cucumber",
+	"cypressio",
+	"d3js",
+	"dart",
+	"database",
+	"datagrip",
+	"dataspell",
+	"dbeaver",
+	"debian",
+	"denojs",
+	"devicon",
+	"digital_ocean",
+	"digitalocean",
+	"discordjs",
+	"django",
+	"djangorest",
+	"dlang",
+	"docker",
+	"doctrine",
+	"dotnet",
+	"dotnetcore",
+	"dreamweaver",
+	"dropbox",
+	"dropwizard",
+	"drupal",
+	"dynamodb",
+	"eclipse",
+	"ecto",
+	"elasticsearch",
+	"electron",
+	"eleventy",
+	"elixir",
+	"elm",
+	"emacs",
+	"embeddedc",
+	"ember",
+	"envoy",
+	"erlang",
+	"eslint",
+	"express",
+	"facebook",
+	"fastapi",
+	"fastify",
+	"faunadb",
+	"feathersjs",
+	"fedora",
+	"figma",
+	"filezilla",
+	"firebase",
+	"firefox",
+	"flask",
+	"flutter",
+	"fortran",
+	"foundation",
+	"framermotion",
+	"framework7",
+	"fsharp",
+	"gatling",
+	"gatsby",
+	"gazebo",
+	"gcc",
+	"gentoo",
+	"ghost",
+	"ghost_small",
+	"gimp",
+	"git",
+	"git_branch",
+	"git_commit",
+	"git_compare",
+	"git_merge",
+	"git_pull_request",
+	"gitbook",
+	"github",
+	"github_badge",
+	"github_full",
+	"githubactions",
+	"githubcodespaces",
+	"gitlab",
+	"gitpod",
+	"gitter",
+	"gnu",
+	"go",
+	"godot",
+	"goland",
+	"google",
+	"googlecloud",
+	"gradle",
+	"grafana",
+	"grails",
+	"graphql",
+	"groovy",
+	"grpc",
+	"grunt",
+	"gulp",
+	"hadoop",
+	"handlebars",
+	"hardhat",
+	"harvester",
+	"haskell",
+	"haxe",
+	"helm",
+	"heroku",
+	"hibernate",
+	"homebrew",
+	"html5",
+	"hugo",
+	"ie",
+	"ifttt",
+	"illustrator",
+	"influxdb",
+	"inkscape",
+	"insomnia",
+	"intellij",
+	"ionic",
+	"jaegertracing",
+	"jamstack",
+	"jasmine",
+	"java",
+	"javascript",
+	"javascript_alt",
+	"javascript_badge",
+	"jeet",
+	"jekyll",
+	"jekyll_small",
+	"jenkins",
+	"jest",
+	"jetbrains",
+	"jetpackcompose",
+	"jira",
+	"jiraalign",
+	"jquery",
+	"json",
+	"jule",
+	"julia",
+	"junit",
+	"jupyter",
+	"k3os",
+	"k3s",
+	"k6",
+	"kaggle",
+	"karatelabs",
+	"karma",
+	"kdeneon",
+	"keras",
+	"kibana",
+	"knexjs",
+	"knockout",
+	"kotlin",
+	"krakenjs",
+	"krakenjs_badge",
+	"ktor",
+	"kubernetes",
+	"labview",
+	"laravel",
+	"latex",
+	"less",
+	"linkedin",
+	"linux",
+	"liquibase",
+	"livewire",
+	"llvm",
+	"lodash",
+	"logstash",
+	"lua",
+	"lumen",
+	"magento",
+	"mariadb",
+	"markdown",
+	"materializecss",
+	"materialui",
+	"matlab",
+	"matplotlib",
+	"maven",
+	"maya",
+	"meteor",
+	"meteorfull",
+	"microsoftsqlserver",
+	"minitab",
+	"mithril",
+	"mobx",
+	"mocha",
+	"modx",
+	"moleculer",
+	"mongodb",
+	"mongoose",
+	"moodle",
+	"mootools_badge",
+	"mozilla",
+	"msdos",
+	"mysql",
+	"nano",
+	"neo4j",
+	"neovim",
+	"nestjs",
+	"netlify",
+	"networkx",
+	"nextjs",
+	"nginx",
+	"ngrx",
+	"nhibernate",
+	"nim",
+	"nimble",
+	"nixos",
+	"nodejs",
+	"nodejs_small",
+	"nodemon",
+	"nodewebkit",
+	"nomad",
+	"norg",
+	"notion",
+	"npm",
+	"nuget",
+	"numpy",
+	"nuxtjs",
+	"oauth",
+	"objectivec",
+	"ocaml",
+	"ohmyzsh",
+	"okta",
+	"openal",
+	"openapi",
+	"opencl",
+	"opencv",
+	"opengl",
+	"openstack",
+	"opensuse",
+	"opentelemetry",
+	"opera",
+	"oracle",
+	"ory",
+	"p5js",
+	"packer",
+	"pandas",
+	"perl",
+	"pfsense",
+	"phalcon",
+	"phoenix",
+	"photonengine",
+	"photoshop",
+	"php",
+	"phpstorm",
+	"playwright",
+	"plotly",
+	"pnpm",
+	"podman",
+	"poetry",
+	"polygon",
+	"portainer",
+	"postcss",
+	"postgresql",
+	"postman",
+	"powershell",
+	"premierepro",
+	"prisma",
+	"processing",
+	"prolog",
+	"prometheus",
+	"protractor",
+	"pulsar",
+	"pulumi",
+	"puppeteer",
+	"purescript",
+	"putty",
+	"pycharm",
+	"pypi",
+	"pyscript",
+	"pytest",
+	"python",
+	"pytorch",
+	"qodana",
+	"qt",
+	"quarkus",
+	"quasar",
+	"qwik",
+	"r",
+	"rabbitmq",
+	"rails",
+	"railway",
+	"rancher",
+	"raspberry_pi",
+	"reach",
+	"react",
+	"reactbootstrap",
+	"reactnavigation",
+	"reactrouter",
+	"readthedocs",
+	"realm",
+	"rect",
+	"redhat",
+	"redis",
+	"redux",
+	"renpy",
+	"replit",
+	"requirejs",
+	"rider",
+	"rocksdb",
+	"rockylinux",
+	"rollup",
+	"ros",
+	"rspec",
+	"rstudio",
+	"ruby",
+	"ruby_on_rails",
+	"ruby_rough",
+	"rubymine",
+	"rust",
+	"rxjs",
+	"safari",
+	"salesforce",
+	"sanity",
+	"sass",
+	"scala",
+	"scalingo",
+	"scikitlearn",
+	"sdl",
+	"selenium",
+	"sema",
+	"sentry",
+	"sequelize",
+	"shopware",
+	"shotgrid",
+	"sketch",
+	"slack",
+	"smashing_magazine",
+	"socketio",
+	"solidity",
+	"solidjs",
+	"sonarqube",
+	"sourcetree",
+	"spack",
+	"splunk",
+	"spring",
+	"spss",
+	"spyder",
+	"sqlalchemy",
+	"sqldeveloper",
+	"sqlite",
+	"ssh",
+	"stackoverflow",
+	"stata",
+	"storybook",
+	"streamlit",
+	"stylus",
+	"sublime",
+	"subversion",
+	"supabase",
+	"svelte",
+	"swagger",
+	"swift",
+	"swiper",
+	"symfony",
+	"symfony_badge",
+	"tailwindcss",
+	"tauri",
+	"tensorflow",
+	"terminal",
+	"terraform",
+	"tex",
+	"thealgorithms",
+	"threedsmax",
+	"threejs",
+	"titaniumsdk",
+	"tomcat",
+	"tortoisegit",
+	"towergit",
+	"traefikmesh",
+	"traefikproxy",
+	"travis",
+	"trello",
+	"trpc",
+	"twitter",
+	"typescript",
+	"typo3",
+	"ubuntu",
+	"uml",
+	"unifiedmodelinglanguage",
+	"unity",
+	"unity_small",
+	"unix",
+	"unrealengine",
+	"uwsgi",
+	"v8",
+	"vagrant",
+	"vala",
+	"vault",
+	"vercel",
+	"vertx",
+	"vim",
+	"visualbasic",
+	"visualstudio",
+	"vite",
+	"vitejs",
+	"vitess",
+	"vitest",
+	"vscode",
+	"vsphere",
+	"vuejs",
+	"vuestorefront",
+	"vuetify",
+	"vyper",
+	"wasm",
+	"webflow",
+	"weblate",
+	"webpack",
+	"webstorm",
+	"windows",
+	"windows11",
+	"woocommerce",
+	"wordpress",
+	"xamarin",
+	"xcode",
+	"xd",
+	"xml",
+	"yaml",
+	"yarn",
+	"yii",
+	"yugabytedb",
+	"yunohost",
+	"zend",
+	"zig",
+}
+
+// glyphValues are the glyphs in the class, in the same order as glyphIDs.
+var glyphValues = [len(glyphIDs)]nf.Glyph{
+	Aarch64,
+	Adonisjs,
+	Aftereffects,
+	Akka,
+	Algolia,
+	Alpinejs,
+	Amazonwebservices,
+	Anaconda,
+	Android,
+	Androidstudio,
+	Angular,
+	Angularjs,
+	Angularmaterial,
+	Ansible,
+	Antdesign,
+	Apache,
+	Apacheairflow,
+	Apachekafka,
+	Apachespark,
+	Apl,
+	Appcelerator,
+	Apple,
+	Appwrite,
+	Archlinux,
+	Arduino,
+	Argocd,
+	Astro,
+	Atom,
+	Awk,
+	Aws,
+	Axios,
+	Azure,
+	Azuredevops,
+	Azuresqldatabase,
+	Babel,
+	Backbone,
+	Backbonejs,
+	Ballerina,
+	Bamboo,
+	Bash,
+	Beats,
+	Behance,
+	Bitbucket,
+	Blazor,
+	Blender,
+	Bootstrap,
+	Bower,
+	Browserstack,
+	Bulma,
+	Bun,
+	C,
+	CLang,
+	Cairo,
+	Cakephp,
+	Canva,
+	Capacitor,
+	Carbon,
+	Cassandra,
+	Centos,
+	Ceylon,
+	Chrome,
+	Circleci,
+	Clarity,
+	Clion,
+	Clojure,
+	ClojureAlt,
+	Clojurescript,
+	Cloudflare,
+	Cloudflareworkers,
+	Cmake,
+	Codeac,
+	Codecov,
+	Codeigniter,
+	Codepen,
+	Coffeescript,
+	Composer,
+	Confluence,
+	Consul,
+	Contao,
+	Corejs,
+	Cosmosdb,
+	Couchbase,
+	Couchdb,
+	Cplusplus,
+	Crystal,
+	Csharp,
+	Css3,
+	Css3Full,
+	Cucumber,
+	Cypressio,
+	D3Js,
+	Dart,
+	Database,
+	Datagrip,
+	Dataspell,
+	Dbeaver,
+	Debian,
+	Denojs,
+	Devicon,
+	DigitalOcean,
+	Digitalocean,
+	Discordjs,
+	Django,
+	Djangorest,
+	Dlang,
+	Docker,
+	Doctrine,
+	Dotnet,
+	Dotnetcore,
+	Dreamweaver,
+	Dropbox,
+	Dropwizard,
+	Drupal,
+	Dynamodb,
+	Eclipse,
+	Ecto,
+	Elasticsearch,
+	Electron,
+	Eleventy,
+	Elixir,
+	Elm,
+	Emacs,
+	Embeddedc,
+	Ember,
+	Envoy,
+	Erlang,
+	Eslint,
+	Express,
+	Facebook,
+	Fastapi,
+	Fastify,
+	Faunadb,
+	Feathersjs,
+	Fedora,
+	Figma,
+	Filezilla,
+	Firebase,
+	Firefox,
+	Flask,
+	Flutter,
+	Fortran,
+	Foundation,
+	Framermotion,
+	Framework7,
+	Fsharp,
+	Gatling,
+	Gatsby,
+	Gazebo,
+	Gcc,
+	Gentoo,
+	Ghost,
+	GhostSmall,
+	Gimp,
+	Git,
+	GitBranch,
+	GitCommit,
+	GitCompare,
+	GitMerge,
+	GitPullRequest,
+	Gitbook,
+	Github,
+	GithubBadge,
+	GithubFull,
+	Githubactions,
+	Githubcodespaces,
+	Gitlab,
+	Gitpod,
+	Gitter,
+	Gnu,
+	Go,
+	Godot,
+	Goland,
+	Google,
+	Googlecloud,
+	Gradle,
+	Grafana,
+	Grails,
+	Graphql,
+	Groovy,
+	Grpc,
+	Grunt,
+	Gulp,
+	Hadoop,
+	Handlebars,
+	Hardhat,
+	Harvester,
+	Haskell,
+	Haxe,
+	Helm,
+	Heroku,
+	Hibernate,
+	Homebrew,
+	Html5,
+	Hugo,
+	Ie,
+	Ifttt,
+	Illustrator,
+	Influxdb,
+	Inkscape,
+	Insomnia,
+	Intellij,
+	Ionic,
+	Jaegertracing,
+	Jamstack,
+	Jasmine,
+	Java,
+	Javascript,
+	JavascriptAlt,
+	JavascriptBadge,
+	Jeet,
+	Jekyll,
+	JekyllSmall,
+	Jenkins,
+	Jest,
+	Jetbrains,
+	Jetpackcompose,
+	Jira,
+	Jiraalign,
+	Jquery,
+	Json,
+	Jule,
+	Julia,
+	Junit,
+	Jupyter,
+	K3Os,
+	K3S,
+	K6,
+	Kaggle,
+	Karatelabs,
+	Karma,
+	Kdeneon,
+	Keras,
+	Kibana,
+	Knexjs,
+	Knockout,
+	Kotlin,
+	Krakenjs,
+	KrakenjsBadge,
+	Ktor,
+	Kubernetes,
+	Labview,
+	Laravel,
+	Latex,
+	Less,
+	Linkedin,
+	Linux,
+	Liquibase,
+	Livewire,
+	Llvm,
+	Lodash,
+	Logstash,
+	Lua,
+	Lumen,
+	Magento,
+	Mariadb,
+	Markdown,
+	Materializecss,
+	Materialui,
+	Matlab,
+	Matplotlib,
+	Maven,
+	Maya,
+	Meteor,
+	Meteorfull,
+	Microsoftsqlserver,
+	Minitab,
+	Mithril,
+	Mobx,
+	Mocha,
+	Modx,
+	Moleculer,
+	Mongodb,
+	Mongoose,
+	Moodle,
+	MootoolsBadge,
+	Mozilla,
+	Msdos,
+	Mysql,
+	Nano,
+	Neo4J,
+	Neovim,
+	Nestjs,
+	Netlify,
+	Networkx,
+	Nextjs,
+	Nginx,
+	Ngrx,
+	Nhibernate,
+	Nim,
+	Nimble,
+	Nixos,
+	Nodejs,
+	NodejsSmall,
+	Nodemon,
+	Nodewebkit,
+	Nomad,
+	Norg,
+	Notion,
+	Npm,
+	Nuget,
+	Numpy,
+	Nuxtjs,
+	Oauth,
+	Objectivec,
+	Ocaml,
+	Ohmyzsh,
+	Okta,
+	Openal,
+	Openapi,
+	Opencl,
+	Opencv,
+	Opengl,
+	Openstack,
+	Opensuse,
+	Opentelemetry,
+	Opera,
+	Oracle,
+	Ory,
+	P5Js,
+	Packer,
+	Pandas,
+	Perl,
+	Pfsense,
+	Phalcon,
+	Phoenix,
+	Photonengine,
+	Photoshop,
+	Php,
+	Phpstorm,
+	Playwright,
+	Plotly,
+	Pnpm,
+	Podman,
+	Poetry,
+	Polygon,
+	Portainer,
+	Postcss,
+	Postgresql,
+	Postman,
+	Powershell,
+	Premierepro,
+	Prisma,
+	Processing,
+	Prolog,
+	Prometheus,
+	Protractor,
+	Pulsar,
+	Pulumi,
+	Puppeteer,
+	Purescript,
+	Putty,
+	Pycharm,
+	Pypi,
+	Pyscript,
+	Pytest,
+	Python,
+	Pytorch,
+	Qodana,
+	Qt,
+	Quarkus,
+	Quasar,
+	Qwik,
+	R,
+	Rabbitmq,
+	Rails,
+	Railway,
+	Rancher,
+	RaspberryPi,
+	Reach,
+	React,
+	Reactbootstrap,
+	Reactnavigation,
+	Reactrouter,
+	Readthedocs,
+	Realm,
+	Rect,
+	Redhat,
+	Redis,
+	Redux,
+	Renpy,
+	Replit,
+	Requirejs,
+	Rider,
+	Rocksdb,
+	Rockylinux,
+	Rollup,
+	Ros,
+	Rspec,
+	Rstudio,
+	Ruby,
+	RubyOnRails,
+	RubyRough,
+	Rubymine,
+	Rust,
+	Rxjs,
+	Safari,
+	Salesforce,
+	Sanity,
+	Sass,
+	Scala,
+	Scalingo,
+	Scikitlearn,
+	Sdl,
+	Selenium,
+	Sema,
+	Sentry,
+	Sequelize,
+	Shopware,
+	Shotgrid,
+	Sketch,
+	Slack,
+	SmashingMagazine,
+	Socketio,
+	Solidity,
+	Solidjs,
+	Sonarqube,
+	Sourcetree,
+	Spack,
+	Splunk,
+	Spring,
+	Spss,
+	Spyder,
+	Sqlalchemy,
+	Sqldeveloper,
+	Sqlite,
+	Ssh,
+	Stackoverflow,
+	Stata,
+	Storybook,
+	Streamlit,
+	Stylus,
+	Sublime,
+	Subversion,
+	Supabase,
+	Svelte,
+	Swagger,
+	Swift,
+	Swiper,
+	Symfony,
+	SymfonyBadge,
+	Tailwindcss,
+	Tauri,
+	Tensorflow,
+	Terminal,
+	Terraform,
+	Tex,
+	Thealgorithms,
+	Threedsmax,
+	Threejs,
+	Titaniumsdk,
+	Tomcat,
+	Tortoisegit,
+	Towergit,
+	Traefikmesh,
+	Traefikproxy,
+	Travis,
+	Trello,
+	Trpc,
+	Twitter,
+	Typescript,
+	Typo3,
+	Ubuntu,
+	Uml,
+	Unifiedmodelinglanguage,
+	Unity,
+	UnitySmall,
+	Unix,
+	Unrealengine,
+	Uwsgi,
+	V8,
+	Vagrant,
+	Vala,
+	Vault,
+	Vercel,
+	Vertx,
+	Vim,
+	Visualbasic,
+	Visualstudio,
+	Vite,
+	Vitejs,
+	Vitess,
+	Vitest,
+	Vscode,
+	Vsphere,
+	Vuejs,
+	Vuestorefront,
+	Vuetify,
+	Vyper,
+	Wasm,
+	Webflow,
+	Weblate,
+	Webpack,
+	Webstorm,
+	Windows,
+	Windows11,
+	Woocommerce,
+	Wordpress,
+	Xamarin,
+	Xcode,
+	Xd,
+	Xml,
+	Yaml,
+	Yarn,
+	Yii,
+	Yugabytedb,
+	Yunohost,
+	Zend,
+	Zig,
+}
 
 // AllGlyphs returns an iterator over all the glyphs in the dev class,
-// returned in no particular order.
+// sorted by ID.
 func AllGlyphs() iter.Seq[nf.Glyph] {
-	return maps.Values(allGlyphs)
+	return slices.Values(glyphValues[:])
 }
 
 // ByID finds a glyph by its short or full ID within the class, or an empty string
-// if the glyph is not found.
+// if the glyph is not found. Lookups do not allocate.
 func ByID(id string) nf.Glyph {
-	if glyph, ok := allGlyphs[id]; ok {
+	if glyph, ok := lookup(id); ok {
 		return glyph
 	}
 	if _, stripped, ok := strings.Cut(id, string(Class)+"-"); ok {
-		if glyph, gok := allGlyphs[stripped]; gok {
+		if glyph, gok := lookup(stripped); gok {
 			return glyph
 		}
 	}
 	return ""
 }
 
+// lookup binary searches for a glyph by its short ID.
+func lookup(id string) (nf.Glyph, bool) {
+	if i, ok := slices.BinarySearch(glyphIDs[:], id); ok {
+		return glyphValues[i], true
+	}
+	return "", false
+}
+
 // AllGlyphIDs returns an iterator over all the IDs of the glyphs in the class,
-// returned in no particular order.
+// sorted.
 func AllGlyphIDs() iter.Seq[string] {
-	return maps.Keys(allGlyphs)
+	return slices.Values(glyphIDs[:])
 }
 
 // AllGlyphFullIDs returns an iterator over all the full IDs of the glyphs in
-// the class, returned in no particular order.
+// the class, sorted.
 func AllGlyphFullIDs() iter.Seq[string] {
 	return func(yield func(string) bool) {
-		for id := range allGlyphs {
+		for _, id := range glyphIDs {
 			if !yield(string(Class) + "-" + id) {
 				return
 			}
 		}
 	}
 }
+
+// glyphSet implements [nf.GlyphSet] for the class.
+type glyphSet struct{}
+
+// Lookup returns the glyph with the given short ID, and whether it was found.
+func (glyphSet) Lookup(id string) (nf.Glyph, bool) {
+	return lookup(id)
+}
+
+// All returns an iterator over all short IDs and their glyphs, sorted by ID.
+func (glyphSet) All() iter.Seq2[string, nf.Glyph] {
+	return func(yield func(string, nf.Glyph) bool) {
+		for i, id := range glyphIDs {
+			if !yield(id, glyphValues[i]) {
+				return
+			}
+		}
+	}
+}
+
+// The class is registered with [nf.RegisterClass] on import.
+var _ = nf.MustRegisterClass(Class, glyphSet{})
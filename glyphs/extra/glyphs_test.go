@@ -9,6 +9,8 @@ package extra
 import (
 	"slices"
 	"testing"
+
+	"github.com/lrstanley/go-nf"
 )
 
 const glyphCount = 12
@@ -18,8 +20,8 @@ func TestAllGlyphs(t *testing.T) {
 
 	results := slices.Collect(AllGlyphs())
 
-	if len(results) != len(allGlyphs) {
-		t.Errorf("expected %d glyphs (from allGlyphs), got %d", len(allGlyphs), len(results))
+	if len(results) != len(glyphIDs) {
+		t.Errorf("expected %d glyphs (from glyphIDs), got %d", len(glyphIDs), len(results))
 	}
 
 	if len(results) != glyphCount {
@@ -42,8 +44,8 @@ func TestAllGlyphIDs(t *testing.T) {
 
 	results := slices.Collect(AllGlyphIDs())
 
-	if len(results) != len(allGlyphs) {
-		t.Errorf("expected %d glyph IDs (from allGlyphs), got %d", len(allGlyphs), len(results))
+	if len(results) != len(glyphIDs) {
+		t.Errorf("expected %d glyph IDs (from glyphIDs), got %d", len(glyphIDs), len(results))
 	}
 }
 
@@ -52,8 +54,8 @@ func TestAllGlyphFullIDs(t *testing.T) {
 
 	results := slices.Collect(AllGlyphFullIDs())
 
-	if len(results) != len(allGlyphs) {
-		t.Errorf("expected %d glyph full IDs (from allGlyphs), got %d", len(allGlyphs), len(results))
+	if len(results) != len(glyphIDs) {
+		t.Errorf("expected %d glyph full IDs (from glyphIDs), got %d", len(glyphIDs), len(results))
 	}
 }
 
@@ -76,3 +78,65 @@ func TestByID(t *testing.T) {
 		t.Errorf("expected glyph for full ID %q, got empty string", string(Class)+"-"+id)
 	}
 }
+
+func TestGlyphIDsSorted(t *testing.T) {
+	t.Parallel()
+
+	if !slices.IsSorted(glyphIDs[:]) {
+		t.Fatal("expected glyph IDs to be sorted, which is required for binary search")
+	}
+
+	for i, id := range glyphIDs {
+		if glyph := ByID(id); glyph != glyphValues[i] {
+			t.Errorf("expected glyph %q for ID %q, got %q", glyphValues[i], id, glyph)
+		}
+	}
+
+	if glyph := ByID("nonexistent-glyph-id"); glyph != "" {
+		t.Errorf("expected empty string for nonexistent ID, got %q", glyph)
+	}
+}
+
+func TestRegistered(t *testing.T) {
+	t.Parallel()
+
+	glyphs, ok := nf.ClassGlyphs(Class)
+	if !ok {
+		t.Fatalf("expected class %q to be registered", Class)
+	}
+
+	var n int
+	for id, glyph := range glyphs.All() {
+		if glyph != glyphValues[n] || id != glyphIDs[n] {
+			t.Errorf("expected %q (%q) at index %d, got %q (%q)", glyphIDs[n], glyphValues[n], n, id, glyph)
+		}
+		n++
+	}
+	if n != glyphCount {
+		t.Errorf("expected %d registered glyphs, got %d", glyphCount, n)
+	}
+
+	if glyph := nf.ByID(string(Class) + "-" + glyphIDs[0]); glyph != glyphValues[0] {
+		t.Errorf("expected %q from registry, got %q", glyphValues[0], glyph)
+	}
+
+	if !slices.Contains(nf.IDsByGlyph(glyphValues[0]), string(Class)+"-"+glyphIDs[0]) {
+		t.Errorf("expected reverse lookup of %q to contain %q", glyphValues[0], string(Class)+"-"+glyphIDs[0])
+	}
+
+	if err := nf.RegisterClass(Class, nf.GlyphMap{}); err == nil {
+		t.Errorf("expected error when registering class %q twice", Class)
+	}
+}
+
+func BenchmarkByID(b *testing.B) {
+	id := glyphIDs[len(glyphIDs)/2]
+	fullID := string(Class) + "-" + id
+
+	b.ReportAllocs()
+	for b.Loop() {
+		_ = ByID(id)
+		_ = ByID(fullID)
+		_ = ByID("nonexistent")
+	}
+}
@@ -8,63 +8,112 @@ package extra
 
 import (
 	"iter"
-	"maps"
+	"slices"
 	"strings"
 
 	"github.com/lrstanley/go-nf"
 )
 
-var (
-	allGlyphs = map[string]nf.Glyph{
-		"progress_empty_left":  ProgressEmptyLeft,
-		"progress_empty_mid":   ProgressEmptyMid,
-		"progress_empty_right": ProgressEmptyRight,
-		"progress_full_left":   ProgressFullLeft,
-		"progress_full_mid":    ProgressFullMid,
-		"progress_full_right":  ProgressFullRight,
-		"progress_spinner_1":   ProgressSpinner1,
-		"progress_spinner_2":   ProgressSpinner2,
-		"progress_spinner_3":   ProgressSpinner3,
-		"progress_spinner_4":   ProgressSpinner4,
-		"progress_spinner_5":   ProgressSpinner5,
-		"progress_spinner_6":   ProgressSpinner6,
-	}
-)
+// glyphIDs are the IDs of all glyphs in the class, sorted, such that they can be
+// binary searched. The glyph for each ID is at the same index in glyphValues.
+//
+// Arrays of constants are laid out statically by the compiler, unlike maps,
+// which are built at init time.
+var glyphIDs = [...]string{
+	"progress_empty_left",
+	"progress_empty_mid",
+	"progress_empty_right",
+	"progress_full_left",
+	"progress_full_mid",
+	"progress_full_right",
+	"progress_spinner_1",
+	"progress_spinner_2",
+	"progress_spinner_3",
+	"progress_spinner_4",
+	"progress_spinner_5",
+	"progress_spinner_6",
+}
+
+// glyphValues are the glyphs in the class, in the same order as glyphIDs.
+var glyphValues = [len(glyphIDs)]nf.Glyph{
+	ProgressEmptyLeft,
+	ProgressEmptyMid,
+	ProgressEmptyRight,
+	ProgressFullLeft,
+	ProgressFullMid,
+	ProgressFullRight,
+	ProgressSpinner1,
+	ProgressSpinner2,
+	ProgressSpinner3,
+	ProgressSpinner4,
+	ProgressSpinner5,
+	ProgressSpinner6,
+}
 
 // AllGlyphs returns an iterator over all the glyphs in the extra class,
-// returned in no particular order.
+// sorted by ID.
 func AllGlyphs() iter.Seq[nf.Glyph] {
-	return maps.Values(allGlyphs)
+	return slices.Values(glyphValues[:])
 }
 
 // ByID finds a glyph by its short or full ID within the class, or an empty string
-// if the glyph is not found.
+// if the glyph is not found. Lookups do not allocate.
 func ByID(id string) nf.Glyph {
-	if glyph, ok := allGlyphs[id]; ok {
+	if glyph, ok := lookup(id); ok {
 		return glyph
 	}
 	if _, stripped, ok := strings.Cut(id, string(Class)+"-"); ok {
-		if glyph, gok := allGlyphs[stripped]; gok {
+		if glyph, gok := lookup(stripped); gok {
 			return glyph
 		}
 	}
 	return ""
 }
 
+// lookup binary searches for a glyph by its short ID.
+func lookup(id string) (nf.Glyph, bool) {
+	if i, ok := slices.BinarySearch(glyphIDs[:], id); ok {
+		return glyphValues[i], true
+	}
+	return "", false
+}
+
 // AllGlyphIDs returns an iterator over all the IDs of the glyphs in the class,
-// returned in no particular order.
+// sorted.
 func AllGlyphIDs() iter.Seq[string] {
-	return maps.Keys(allGlyphs)
+	return slices.Values(glyphIDs[:])
 }
 
 // AllGlyphFullIDs returns an iterator over all the full IDs of the glyphs in
-// the class, returned in no particular order.
+// the class, sorted.
 func AllGlyphFullIDs() iter.Seq[string] {
 	return func(yield func(string) bool) {
-		for id := range allGlyphs {
+		for _, id := range glyphIDs {
 			if !yield(string(Class) + "-" + id) {
 				return
 			}
 		}
 	}
 }
+
+// glyphSet implements [nf.GlyphSet] for the class.
+type glyphSet struct{}
+
+// Lookup returns the glyph with the given short ID, and whether it was found.
+func (glyphSet) Lookup(id string) (nf.Glyph, bool) {
+	return lookup(id)
+}
+
+// All returns an iterator over all short IDs and their glyphs, sorted by ID.
+func (glyphSet) All() iter.Seq2[string, nf.Glyph] {
+	return func(yield func(string, nf.Glyph) bool) {
+		for i, id := range glyphIDs {
+			if !yield(id, glyphValues[i]) {
+				return
+			}
+		}
+	}
+}
+
+// The class is registered with [nf.RegisterClass] on import.
+var _ = nf.MustRegisterClass(Class, glyphSet{})
@@ -8,1868 +8,3722 @@ package fa
 
 import (
 	"iter"
-	"maps"
+	"slices"
 	"strings"
 
 	"github.com/lrstanley/go-nf"
 )
 
-var (
-	allGlyphs = map[string]nf.Glyph{
-		"500px":                               Glyph500Px,
-		"accessible_icon":                     AccessibleIcon,
-		"accusoft":                            Accusoft,
-		"address_book":                        AddressBook,
-		"address_book_o":                      AddressBookO,
-		"address_card":                        AddressCard,
-		"address_card_o":                      AddressCardO,
-		"adjust":                              Adjust,
-		"adn":                                 Adn,
-		"adversal":                            Adversal,
-		"affiliatetheme":                      Affiliatetheme,
-		"airbnb":                              Airbnb,
-		"algolia":                             Algolia,
-		"align_center":                        AlignCenter,
-		"align_justify":                       AlignJustify,
-		"align_left":                          AlignLeft,
-		"align_right":                         AlignRight,
-		"alipay":                              Alipay,
-		"amazon":                              Amazon,
-		"amazon_pay":                          AmazonPay,
-		"ambulance":                           Ambulance,
-		"american_sign_language_interpreting": AmericanSignLanguageInterpreting,
-		"amilia":                              Amilia,
-		"anchor":                              Anchor,
-		"android":                             Android,
-		"angellist":                           Angellist,
-		"angle_double_down":                   AngleDoubleDown,
-		"angle_double_left":                   AngleDoubleLeft,
-		"angle_double_right":                  AngleDoubleRight,
-		"angle_double_up":                     AngleDoubleUp,
-		"angle_down":                          AngleDown,
-		"angle_left":                          AngleLeft,
-		"angle_right":                         AngleRight,
-		"angle_up":                            AngleUp,
-		"angles_down":                         AnglesDown,
-		"angles_left":                         AnglesLeft,
-		"angles_right":                        AnglesRight,
-		"angles_up":                           AnglesUp,
-		"angrycreative":                       Angrycreative,
-		"angular":                             Angular,
-		"ankh":                                Ankh,
-		"app_store":                           AppStore,
-		"app_store_ios":                       AppStoreIos,
-		"apper":                               Apper,
-		"apple":                               Apple,
-		"apple_pay":                           ApplePay,
-		"apple_whole":                         AppleWhole,
-		"archive":                             Archive,
-		"archway":                             Archway,
-		"area_chart":                          AreaChart,
-		"arrow_circle_down":                   ArrowCircleDown,
-		"arrow_circle_left":                   ArrowCircleLeft,
-		"arrow_circle_o_down":                 ArrowCircleODown,
-		"arrow_circle_o_left":                 ArrowCircleOLeft,
-		"arrow_circle_o_right":                ArrowCircleORight,
-		"arrow_circle_o_up":                   ArrowCircleOUp,
-		"arrow_circle_right":                  ArrowCircleRight,
-		"arrow_circle_up":                     ArrowCircleUp,
-		"arrow_down":                          ArrowDown,
-		"arrow_down_1_9":                      ArrowDown19,
-		"arrow_down_9_1":                      ArrowDown91,
-		"arrow_down_a_z":                      ArrowDownAZ,
-		"arrow_down_long":                     ArrowDownLong,
-		"arrow_down_short_wide":               ArrowDownShortWide,
-		"arrow_down_wide_short":               ArrowDownWideShort,
-		"arrow_down_z_a":                      ArrowDownZA,
-		"arrow_left":                          ArrowLeft,
-		"arrow_left_long":                     ArrowLeftLong,
-		"arrow_pointer":                       ArrowPointer,
-		"arrow_right":                         ArrowRight,
-		"arrow_right_arrow_left":              ArrowRightArrowLeft,
-		"arrow_right_from_bracket":            ArrowRightFromBracket,
-		"arrow_right_long":                    ArrowRightLong,
-		"arrow_right_to_bracket":              ArrowRightToBracket,
-		"arrow_rotate_left":                   ArrowRotateLeft,
-		"arrow_rotate_right":                  ArrowRotateRight,
-		"arrow_turn_down":                     ArrowTurnDown,
-		"arrow_turn_up":                       ArrowTurnUp,
-		"arrow_up":                            ArrowUp,
-		"arrow_up_1_9":                        ArrowUp19,
-		"arrow_up_9_1":                        ArrowUp91,
-		"arrow_up_a_z":                        ArrowUpAZ,
-		"arrow_up_long":                       ArrowUpLong,
-		"arrow_up_right_from_square":          ArrowUpRightFromSquare,
-		"arrow_up_short_wide":                 ArrowUpShortWide,
-		"arrow_up_wide_short":                 ArrowUpWideShort,
-		"arrow_up_z_a":                        ArrowUpZA,
-		"arrows":                              Arrows,
-		"arrows_alt":                          ArrowsAlt,
-		"arrows_h":                            ArrowsH,
-		"arrows_left_right":                   ArrowsLeftRight,
-		"arrows_rotate":                       ArrowsRotate,
-		"arrows_up_down":                      ArrowsUpDown,
-		"arrows_up_down_left_right":           ArrowsUpDownLeftRight,
-		"arrows_v":                            ArrowsV,
-		"artstation":                          Artstation,
-		"asl_interpreting":                    AslInterpreting,
-		"assistive_listening_systems":         AssistiveListeningSystems,
-		"asterisk":                            Asterisk,
-		"asymmetrik":                          Asymmetrik,
-		"at":                                  At,
-		"atlassian":                           Atlassian,
-		"atom":                                Atom,
-		"audible":                             Audible,
-		"audio_description":                   AudioDescription,
-		"automobile":                          Automobile,
-		"autoprefixer":                        Autoprefixer,
-		"avianex":                             Avianex,
-		"aviato":                              Aviato,
-		"award":                               Award,
-		"aws":                                 Aws,
-		"baby":                                Baby,
-		"baby_carriage":                       BabyCarriage,
-		"backward":                            Backward,
-		"backward_fast":                       BackwardFast,
-		"backward_step":                       BackwardStep,
-		"bacon":                               Bacon,
-		"bag_shopping":                        BagShopping,
-		"bahai":                               Bahai,
-		"balance_scale":                       BalanceScale,
-		"ban":                                 Ban,
-		"ban_smoking":                         BanSmoking,
-		"bandage":                             Bandage,
-		"bandcamp":                            Bandcamp,
-		"bank":                                Bank,
-		"bar_chart":                           BarChart,
-		"bar_chart_o":                         BarChartO,
-		"barcode":                             Barcode,
-		"bars":                                Bars,
-		"bars_progress":                       BarsProgress,
-		"bars_staggered":                      BarsStaggered,
-		"baseball":                            Baseball,
-		"baseball_bat_ball":                   BaseballBatBall,
-		"basket_shopping":                     BasketShopping,
-		"basketball":                          Basketball,
-		"bath":                                Bath,
-		"bathtub":                             Bathtub,
-		"battery":                             Battery,
-		"battery_0":                           Battery0,
-		"battery_1":                           Battery1,
-		"battery_2":                           Battery2,
-		"battery_3":                           Battery3,
-		"battery_4":                           Battery4,
-		"battery_empty":                       BatteryEmpty,
-		"battery_full":                        BatteryFull,
-		"battery_half":                        BatteryHalf,
-		"battery_quarter":                     BatteryQuarter,
-		"battery_three_quarters":              BatteryThreeQuarters,
-		"battle_net":                          BattleNet,
-		"bed":                                 Bed,
-		"bed_pulse":                           BedPulse,
-		"beer":                                Beer,
-		"beer_mug_empty":                      BeerMugEmpty,
-		"behance":                             Behance,
-		"behance_square":                      BehanceSquare,
-		"bell":                                Bell,
-		"bell_concierge":                      BellConcierge,
-		"bell_o":                              BellO,
-		"bell_slash":                          BellSlash,
-		"bell_slash_o":                        BellSlashO,
-		"bezier_curve":                        BezierCurve,
-		"bicycle":                             Bicycle,
-		"bimobject":                           Bimobject,
-		"binoculars":                          Binoculars,
-		"biohazard":                           Biohazard,
-		"birthday_cake":                       BirthdayCake,
-		"bitbucket":                           Bitbucket,
-		"bitbucket_square":                    BitbucketSquare,
-		"bitcoin":                             Bitcoin,
-		"bity":                                Bity,
-		"black_tie":                           BlackTie,
-		"blackberry":                          Blackberry,
-		"blender":                             Blender,
-		"blender_phone":                       BlenderPhone,
-		"blind":                               Blind,
-		"blog":                                Blog,
-		"blogger":                             Blogger,
-		"blogger_b":                           BloggerB,
-		"bluetooth":                           Bluetooth,
-		"bluetooth_b":                         BluetoothB,
-		"bold":                                Bold,
-		"bolt":                                Bolt,
-		"bomb":                                Bomb,
-		"bone":                                Bone,
-		"bong":                                Bong,
-		"book":                                Book,
-		"book_atlas":                          BookAtlas,
-		"book_bible":                          BookBible,
-		"book_journal_whills":                 BookJournalWhills,
-		"book_medical":                        BookMedical,
-		"book_open":                           BookOpen,
-		"book_open_reader":                    BookOpenReader,
-		"book_quran":                          BookQuran,
-		"book_skull":                          BookSkull,
-		"book_tanakh":                         BookTanakh,
-		"bookmark":                            Bookmark,
-		"bookmark_o":                          BookmarkO,
-		"bootstrap":                           Bootstrap,
-		"border_all":                          BorderAll,
-		"border_none":                         BorderNone,
-		"border_top_left":                     BorderTopLeft,
-		"bowling_ball":                        BowlingBall,
-		"box":                                 Box,
-		"box_archive":                         BoxArchive,
-		"box_open":                            BoxOpen,
-		"boxes_stacked":                       BoxesStacked,
-		"braille":                             Braille,
-		"brain":                               Brain,
-		"bread_slice":                         BreadSlice,
-		"briefcase":                           Briefcase,
-		"briefcase_medical":                   BriefcaseMedical,
-		"broom":                               Broom,
-		"broom_ball":                          BroomBall,
-		"brush":                               Brush,
-		"btc":                                 Btc,
-		"buffer":                              Buffer,
-		"bug":                                 Bug,
-		"building":                            Building,
-		"building_columns":                    BuildingColumns,
-		"building_o":                          BuildingO,
-		"bullhorn":                            Bullhorn,
-		"bullseye":                            Bullseye,
-		"burger":                              Burger,
-		"buromobelexperte":                    Buromobelexperte,
-		"bus":                                 Bus,
-		"bus_simple":                          BusSimple,
-		"business_time":                       BusinessTime,
-		"buy_n_large":                         BuyNLarge,
-		"buysellads":                          Buysellads,
-		"cab":                                 Cab,
-		"cable_car":                           CableCar,
-		"cake_candles":                        CakeCandles,
-		"calculator":                          Calculator,
-		"calendar":                            Calendar,
-		"calendar_check":                      CalendarCheck,
-		"calendar_check_o":                    CalendarCheckO,
-		"calendar_day":                        CalendarDay,
-		"calendar_days":                       CalendarDays,
-		"calendar_minus":                      CalendarMinus,
-		"calendar_minus_o":                    CalendarMinusO,
-		"calendar_o":                          CalendarO,
-		"calendar_plus":                       CalendarPlus,
-		"calendar_plus_o":                     CalendarPlusO,
-		"calendar_times_o":                    CalendarTimesO,
-		"calendar_week":                       CalendarWeek,
-		"calendar_xmark":                      CalendarXmark,
-		"camera":                              Camera,
-		"camera_retro":                        CameraRetro,
-		"campground":                          Campground,
-		"canadian_maple_leaf":                 CanadianMapleLeaf,
-		"candy_cane":                          CandyCane,
-		"cannabis":                            Cannabis,
-		"capsules":                            Capsules,
-		"car":                                 Car,
-		"car_battery":                         CarBattery,
-		"car_burst":                           CarBurst,
-		"car_rear":                            CarRear,
-		"car_side":                            CarSide,
-		"caravan":                             Caravan,
-		"caret_down":                          CaretDown,
-		"caret_left":                          CaretLeft,
-		"caret_right":                         CaretRight,
-		"caret_square_o_down":                 CaretSquareODown,
-		"caret_square_o_left":                 CaretSquareOLeft,
-		"caret_square_o_right":                CaretSquareORight,
-		"caret_square_o_up":                   CaretSquareOUp,
-		"caret_up":                            CaretUp,
-		"carrot":                              Carrot,
-		"cart_arrow_down":                     CartArrowDown,
-		"cart_flatbed":                        CartFlatbed,
-		"cart_flatbed_suitcase":               CartFlatbedSuitcase,
-		"cart_plus":                           CartPlus,
-		"cart_shopping":                       CartShopping,
-		"cash_register":                       CashRegister,
-		"cat":                                 Cat,
-		"cc":                                  Cc,
-		"cc_amazon_pay":                       CcAmazonPay,
-		"cc_amex":                             CcAmex,
-		"cc_apple_pay":                        CcApplePay,
-		"cc_diners_club":                      CcDinersClub,
-		"cc_discover":                         CcDiscover,
-		"cc_jcb":                              CcJcb,
-		"cc_mastercard":                       CcMastercard,
-		"cc_paypal":                           CcPaypal,
-		"cc_stripe":                           CcStripe,
-		"cc_visa":                             CcVisa,
-		"centercode":                          Centercode,
-		"centos":                              Centos,
-		"certificate":                         Certificate,
-		"chain":                               Chain,
-		"chain_broken":                        ChainBroken,
-		"chair":                               Chair,
-		"chalkboard":                          Chalkboard,
-		"chalkboard_user":                     ChalkboardUser,
-		"champagne_glasses":                   ChampagneGlasses,
-		"charging_station":                    ChargingStation,
-		"chart_area":                          ChartArea,
-		"chart_bar":                           ChartBar,
-		"chart_line":                          ChartLine,
-		"chart_pie":                           ChartPie,
-		"check":                               Check,
-		"check_circle":                        CheckCircle,
-		"check_circle_o":                      CheckCircleO,
-		"check_double":                        CheckDouble,
-		"check_square":                        CheckSquare,
-		"check_square_o":                      CheckSquareO,
-		"check_to_slot":                       CheckToSlot,
-		"cheese":                              Cheese,
-		"chess":                               Chess,
-		"chess_bishop":                        ChessBishop,
-		"chess_board":                         ChessBoard,
-		"chess_king":                          ChessKing,
-		"chess_knight":                        ChessKnight,
-		"chess_pawn":                          ChessPawn,
-		"chess_queen":                         ChessQueen,
-		"chess_rook":                          ChessRook,
-		"chevron_circle_down":                 ChevronCircleDown,
-		"chevron_circle_left":                 ChevronCircleLeft,
-		"chevron_circle_right":                ChevronCircleRight,
-		"chevron_circle_up":                   ChevronCircleUp,
-		"chevron_down":                        ChevronDown,
-		"chevron_left":                        ChevronLeft,
-		"chevron_right":                       ChevronRight,
-		"chevron_up":                          ChevronUp,
-		"child":                               Child,
-		"chrome":                              Chrome,
-		"chromecast":                          Chromecast,
-		"church":                              Church,
-		"circle":                              Circle,
-		"circle_arrow_down":                   CircleArrowDown,
-		"circle_arrow_left":                   CircleArrowLeft,
-		"circle_arrow_right":                  CircleArrowRight,
-		"circle_arrow_up":                     CircleArrowUp,
-		"circle_check":                        CircleCheck,
-		"circle_chevron_down":                 CircleChevronDown,
-		"circle_chevron_left":                 CircleChevronLeft,
-		"circle_chevron_right":                CircleChevronRight,
-		"circle_chevron_up":                   CircleChevronUp,
-		"circle_dollar_to_slot":               CircleDollarToSlot,
-		"circle_dot":                          CircleDot,
-		"circle_down":                         CircleDown,
-		"circle_exclamation":                  CircleExclamation,
-		"circle_h":                            CircleH,
-		"circle_half_stroke":                  CircleHalfStroke,
-		"circle_info":                         CircleInfo,
-		"circle_left":                         CircleLeft,
-		"circle_minus":                        CircleMinus,
-		"circle_notch":                        CircleNotch,
-		"circle_o":                            CircleO,
-		"circle_o_notch":                      CircleONotch,
-		"circle_pause":                        CirclePause,
-		"circle_play":                         CirclePlay,
-		"circle_plus":                         CirclePlus,
-		"circle_question":                     CircleQuestion,
-		"circle_radiation":                    CircleRadiation,
-		"circle_right":                        CircleRight,
-		"circle_stop":                         CircleStop,
-		"circle_thin":                         CircleThin,
-		"circle_up":                           CircleUp,
-		"circle_user":                         CircleUser,
-		"circle_xmark":                        CircleXmark,
-		"city":                                City,
-		"clipboard":                           Clipboard,
-		"clipboard_alt":                       ClipboardAlt,
-		"clipboard_check":                     ClipboardCheck,
-		"clipboard_list":                      ClipboardList,
-		"clipboard_user":                      ClipboardUser,
-		"clock":                               Clock,
-		"clock_o":                             ClockO,
-		"clock_rotate_left":                   ClockRotateLeft,
-		"clone":                               Clone,
-		"close":                               Close,
-		"closed_captioning":                   ClosedCaptioning,
-		"cloud":                               Cloud,
-		"cloud_arrow_down":                    CloudArrowDown,
-		"cloud_arrow_up":                      CloudArrowUp,
-		"cloud_bolt":                          CloudBolt,
-		"cloud_download":                      CloudDownload,
-		"cloud_meatball":                      CloudMeatball,
-		"cloud_moon":                          CloudMoon,
-		"cloud_moon_rain":                     CloudMoonRain,
-		"cloud_rain":                          CloudRain,
-		"cloud_showers_heavy":                 CloudShowersHeavy,
-		"cloud_sun":                           CloudSun,
-		"cloud_sun_rain":                      CloudSunRain,
-		"cloud_upload":                        CloudUpload,
-		"cloudscale":                          Cloudscale,
-		"cloudsmith":                          Cloudsmith,
-		"cloudversify":                        Cloudversify,
-		"cny":                                 Cny,
-		"code":                                Code,
-		"code_branch":                         CodeBranch,
-		"code_commit":                         CodeCommit,
-		"code_fork":                           CodeFork,
-		"code_merge":                          CodeMerge,
-		"codepen":                             Codepen,
-		"codiepie":                            Codiepie,
-		"coffee":                              Coffee,
-		"cog":                                 Cog,
-		"cogs":                                Cogs,
-		"coins":                               Coins,
-		"columns":                             Columns,
-		"comment":                             Comment,
-		"comment_dollar":                      CommentDollar,
-		"comment_dots":                        CommentDots,
-		"comment_medical":                     CommentMedical,
-		"comment_o":                           CommentO,
-		"comment_slash":                       CommentSlash,
-		"comment_sms":                         CommentSms,
-		"commenting":                          Commenting,
-		"commenting_o":                        CommentingO,
-		"comments":                            Comments,
-		"comments_dollar":                     CommentsDollar,
-		"comments_o":                          CommentsO,
-		"compact_disc":                        CompactDisc,
-		"compass":                             Compass,
-		"compass_drafting":                    CompassDrafting,
-		"compress":                            Compress,
-		"computer_mouse":                      ComputerMouse,
-		"confluence":                          Confluence,
-		"connectdevelop":                      Connectdevelop,
-		"contao":                              Contao,
-		"cookie":                              Cookie,
-		"cookie_bite":                         CookieBite,
-		"copy":                                Copy,
-		"copyright":                           Copyright,
-		"cotton_bureau":                       CottonBureau,
-		"couch":                               Couch,
-		"cow":                                 Cow,
-		"cpanel":                              Cpanel,
-		"creative_commons":                    CreativeCommons,
-		"creative_commons_by":                 CreativeCommonsBy,
-		"creative_commons_nc":                 CreativeCommonsNc,
-		"creative_commons_nc_eu":              CreativeCommonsNcEu,
-		"creative_commons_nc_jp":              CreativeCommonsNcJp,
-		"creative_commons_nd":                 CreativeCommonsNd,
-		"creative_commons_pd":                 CreativeCommonsPd,
-		"creative_commons_pd_alt":             CreativeCommonsPdAlt,
-		"creative_commons_remix":              CreativeCommonsRemix,
-		"creative_commons_sa":                 CreativeCommonsSa,
-		"creative_commons_sampling":           CreativeCommonsSampling,
-		"creative_commons_sampling_plus":      CreativeCommonsSamplingPlus,
-		"creative_commons_share":              CreativeCommonsShare,
-		"creative_commons_zero":               CreativeCommonsZero,
-		"credit_card":                         CreditCard,
-		"credit_card_alt":                     CreditCardAlt,
-		"critical_role":                       CriticalRole,
-		"crop":                                Crop,
-		"crop_simple":                         CropSimple,
-		"cross":                               Cross,
-		"crosshairs":                          Crosshairs,
-		"crow":                                Crow,
-		"crown":                               Crown,
-		"crutch":                              Crutch,
-		"css3":                                Css3,
-		"css3_alt":                            Css3Alt,
-		"cube":                                Cube,
-		"cubes":                               Cubes,
-		"cut":                                 Cut,
-		"cutlery":                             Cutlery,
-		"cuttlefish":                          Cuttlefish,
-		"d_and_d":                             DAndD,
-		"d_and_d_beyond":                      DAndDBeyond,
-		"dashboard":                           Dashboard,
-		"dashcube":                            Dashcube,
-		"database":                            Database,
-		"deaf":                                Deaf,
-		"deafness":                            Deafness,
-		"dedent":                              Dedent,
-		"delete_left":                         DeleteLeft,
-		"delicious":                           Delicious,
-		"democrat":                            Democrat,
-		"deploydog":                           Deploydog,
-		"deskpro":                             Deskpro,
-		"desktop":                             Desktop,
-		"dev":                                 Dev,
-		"deviantart":                          Deviantart,
-		"dharmachakra":                        Dharmachakra,
-		"dhl":                                 Dhl,
-		"diagram_project":                     DiagramProject,
-		"diamond":                             Diamond,
-		"diamond_turn_right":                  DiamondTurnRight,
-		"diaspora":                            Diaspora,
-		"dice":                                Dice,
-		"dice_d20":                            DiceD20,
-		"dice_d6":                             DiceD6,
-		"dice_five":                           DiceFive,
-		"dice_four":                           DiceFour,
-		"dice_one":                            DiceOne,
-		"dice_six":                            DiceSix,
-		"dice_three":                          DiceThree,
-		"dice_two":                            DiceTwo,
-		"digg":                                Digg,
-		"digital_ocean":                       DigitalOcean,
-		"discord":                             Discord,
-		"discourse":                           Discourse,
-		"disease":                             Disease,
-		"divide":                              Divide,
-		"dna":                                 Dna,
-		"dochub":                              Dochub,
-		"docker":                              Docker,
-		"dog":                                 Dog,
-		"dollar":                              Dollar,
-		"dollar_sign":                         DollarSign,
-		"dolly":                               Dolly,
-		"door_closed":                         DoorClosed,
-		"door_open":                           DoorOpen,
-		"dot_circle_o":                        DotCircleO,
-		"dove":                                Dove,
-		"down_left_and_up_right_to_center":    DownLeftAndUpRightToCenter,
-		"down_long":                           DownLong,
-		"download":                            Download,
-		"draft2digital":                       Draft2Digital,
-		"dragon":                              Dragon,
-		"draw_polygon":                        DrawPolygon,
-		"dribbble":                            Dribbble,
-		"drivers_license":                     DriversLicense,
-		"drivers_license_o":                   DriversLicenseO,
-		"dropbox":                             Dropbox,
-		"droplet":                             Droplet,
-		"droplet_slash":                       DropletSlash,
-		"drum":                                Drum,
-		"drum_steelpan":                       DrumSteelpan,
-		"drumstick_bite":                      DrumstickBite,
-		"drupal":                              Drupal,
-		"dumbbell":                            Dumbbell,
-		"dumpster":                            Dumpster,
-		"dumpster_fire":                       DumpsterFire,
-		"dungeon":                             Dungeon,
-		"dyalog":                              Dyalog,
-		"ear_deaf":                            EarDeaf,
-		"ear_listen":                          EarListen,
-		"earlybirds":                          Earlybirds,
-		"earth_africa":                        EarthAfrica,
-		"earth_americas":                      EarthAmericas,
-		"earth_asia":                          EarthAsia,
-		"earth_europe":                        EarthEurope,
-		"ebay":                                Ebay,
-		"edge":                                Edge,
-		"edit":                                Edit,
-		"eercast":                             Eercast,
-		"egg":                                 Egg,
-		"eject":                               Eject,
-		"elementor":                           Elementor,
-		"ellipsis":                            Ellipsis,
-		"ellipsis_h":                          EllipsisH,
-		"ellipsis_v":                          EllipsisV,
-		"ellipsis_vertical":                   EllipsisVertical,
-		"ello":                                Ello,
-		"ember":                               Ember,
-		"empire":                              Empire,
-		"envelope":                            Envelope,
-		"envelope_o":                          EnvelopeO,
-		"envelope_open":                       EnvelopeOpen,
-		"envelope_open_o":                     EnvelopeOpenO,
-		"envelope_open_text":                  EnvelopeOpenText,
-		"envelope_square":                     EnvelopeSquare,
-		"envelopes_bulk":                      EnvelopesBulk,
-		"envira":                              Envira,
-		"equals":                              Equals,
-		"eraser":                              Eraser,
-		"erlang":                              Erlang,
-		"ethereum":                            Ethereum,
-		"ethernet":                            Ethernet,
-		"etsy":                                Etsy,
-		"eur":                                 Eur,
-		"euro":                                Euro,
-		"euro_sign":                           EuroSign,
-		"evernote":                            Evernote,
-		"exchange":                            Exchange,
-		"exclamation":                         Exclamation,
-		"exclamation_circle":                  ExclamationCircle,
-		"exclamation_triangle":                ExclamationTriangle,
-		"expand":                              Expand,
-		"expeditedssl":                        Expeditedssl,
-		"external_link":                       ExternalLink,
-		"external_link_square":                ExternalLinkSquare,
-		"eye":                                 Eye,
-		"eye_dropper":                         EyeDropper,
-		"eye_low_vision":                      EyeLowVision,
-		"eye_slash":                           EyeSlash,
-		"eyedropper":                          Eyedropper,
-		"fa":                                  Fa,
-		"face_angry":                          FaceAngry,
-		"face_dizzy":                          FaceDizzy,
-		"face_flushed":                        FaceFlushed,
-		"face_frown":                          FaceFrown,
-		"face_frown_open":                     FaceFrownOpen,
-		"face_grimace":                        FaceGrimace,
-		"face_grin":                           FaceGrin,
-		"face_grin_beam":                      FaceGrinBeam,
-		"face_grin_beam_sweat":                FaceGrinBeamSweat,
-		"face_grin_hearts":                    FaceGrinHearts,
-		"face_grin_squint":                    FaceGrinSquint,
-		"face_grin_squint_tears":              FaceGrinSquintTears,
-		"face_grin_stars":                     FaceGrinStars,
-		"face_grin_tears":                     FaceGrinTears,
-		"face_grin_tongue":                    FaceGrinTongue,
-		"face_grin_tongue_squint":             FaceGrinTongueSquint,
-		"face_grin_tongue_wink":               FaceGrinTongueWink,
-		"face_grin_wide":                      FaceGrinWide,
-		"face_grin_wink":                      FaceGrinWink,
-		"face_kiss":                           FaceKiss,
-		"face_kiss_beam":                      FaceKissBeam,
-		"face_kiss_wink_heart":                FaceKissWinkHeart,
-		"face_laugh":                          FaceLaugh,
-		"face_laugh_beam":                     FaceLaughBeam,
-		"face_laugh_squint":                   FaceLaughSquint,
-		"face_laugh_wink":                     FaceLaughWink,
-		"face_meh":                            FaceMeh,
-		"face_meh_blank":                      FaceMehBlank,
-		"face_rolling_eyes":                   FaceRollingEyes,
-		"face_sad_cry":                        FaceSadCry,
-		"face_sad_tear":                       FaceSadTear,
-		"face_smile":                          FaceSmile,
-		"face_smile_beam":                     FaceSmileBeam,
-		"face_smile_wink":                     FaceSmileWink,
-		"face_surprise":                       FaceSurprise,
-		"face_tired":                          FaceTired,
-		"facebook":                            Facebook,
-		"facebook_f":                          FacebookF,
-		"facebook_messenger":                  FacebookMessenger,
-		"facebook_official":                   FacebookOfficial,
-		"facebook_square":                     FacebookSquare,
-		"fan":                                 Fan,
-		"fantasy_flight_games":                FantasyFlightGames,
-		"fast_backward":                       FastBackward,
-		"fast_forward":                        FastForward,
-		"fax":                                 Fax,
-		"feather":                             Feather,
-		"feather_pointed":                     FeatherPointed,
-		"fedex":                               Fedex,
-		"fedora":                              Fedora,
-		"feed":                                Feed,
-		"female":                              Female,
-		"fighter_jet":                         FighterJet,
-		"figma":                               Figma,
-		"file":                                File,
-		"file_archive_o":                      FileArchiveO,
-		"file_arrow_down":                     FileArrowDown,
-		"file_arrow_up":                       FileArrowUp,
-		"file_audio":                          FileAudio,
-		"file_audio_o":                        FileAudioO,
-		"file_code":                           FileCode,
-		"file_code_o":                         FileCodeO,
-		"file_contract":                       FileContract,
-		"file_csv":                            FileCsv,
-		"file_excel":                          FileExcel,
-		"file_excel_o":                        FileExcelO,
-		"file_export":                         FileExport,
-		"file_image":                          FileImage,
-		"file_image_o":                        FileImageO,
-		"file_import":                         FileImport,
-		"file_invoice":                        FileInvoice,
-		"file_invoice_dollar":                 FileInvoiceDollar,
-		"file_lines":                          FileLines,
-		"file_medical":                        FileMedical,
-		"file_movie_o":                        FileMovieO,
-		"file_o":                              FileO,
-		"file_pdf":                            FilePdf,
-		"file_pdf_o":                          FilePdfO,
-		"file_pen":                            FilePen,
-		"file_photo_o":                        FilePhotoO,
-		"file_picture_o":                      FilePictureO,
-		"file_powerpoint":                     FilePowerpoint,
-		"file_powerpoint_o":                   FilePowerpointO,
-		"file_prescription":                   FilePrescription,
-		"file_signature":                      FileSignature,
-		"file_sound_o":                        FileSoundO,
-		"file_text":                           FileText,
-		"file_text_o":                         FileTextO,
-		"file_video":                          FileVideo,
-		"file_video_o":                        FileVideoO,
-		"file_waveform":                       FileWaveform,
-		"file_word":                           FileWord,
-		"file_word_o":                         FileWordO,
-		"file_zip_o":                          FileZipO,
-		"file_zipper":                         FileZipper,
-		"files_o":                             FilesO,
-		"fill":                                Fill,
-		"fill_drip":                           FillDrip,
-		"film":                                Film,
-		"filter":                              Filter,
-		"filter_circle_dollar":                FilterCircleDollar,
-		"fingerprint":                         Fingerprint,
-		"fire":                                Fire,
-		"fire_extinguisher":                   FireExtinguisher,
-		"fire_flame_curved":                   FireFlameCurved,
-		"fire_flame_simple":                   FireFlameSimple,
-		"firefox":                             Firefox,
-		"first_order":                         FirstOrder,
-		"first_order_alt":                     FirstOrderAlt,
-		"firstdraft":                          Firstdraft,
-		"fish":                                Fish,
-		"flag":                                Flag,
-		"flag_checkered":                      FlagCheckered,
-		"flag_o":                              FlagO,
-		"flag_usa":                            FlagUsa,
-		"flash":                               Flash,
-		"flask":                               Flask,
-		"flickr":                              Flickr,
-		"flipboard":                           Flipboard,
-		"floppy_disk":                         FloppyDisk,
-		"floppy_o":                            FloppyO,
-		"fly":                                 Fly,
-		"folder":                              Folder,
-		"folder_minus":                        FolderMinus,
-		"folder_o":                            FolderO,
-		"folder_open":                         FolderOpen,
-		"folder_open_o":                       FolderOpenO,
-		"folder_plus":                         FolderPlus,
-		"folder_tree":                         FolderTree,
-		"font":                                Font,
-		"font_awesome":                        FontAwesome,
-		"fonticons":                           Fonticons,
-		"fonticons_fi":                        FonticonsFi,
-		"football":                            Football,
-		"fort_awesome":                        FortAwesome,
-		"fort_awesome_alt":                    FortAwesomeAlt,
-		"forumbee":                            Forumbee,
-		"forward":                             Forward,
-		"forward_fast":                        ForwardFast,
-		"forward_step":                        ForwardStep,
-		"foursquare":                          Foursquare,
-		"free_code_camp":                      FreeCodeCamp,
-		"freebsd":                             Freebsd,
-		"frog":                                Frog,
-		"frown_o":                             FrownO,
-		"fulcrum":                             Fulcrum,
-		"futbol":                              Futbol,
-		"futbol_o":                            FutbolO,
-		"galactic_republic":                   GalacticRepublic,
-		"galactic_senate":                     GalacticSenate,
-		"gamepad":                             Gamepad,
-		"gas_pump":                            GasPump,
-		"gauge":                               Gauge,
-		"gauge_high":                          GaugeHigh,
-		"gauge_simple":                        GaugeSimple,
-		"gauge_simple_high":                   GaugeSimpleHigh,
-		"gavel":                               Gavel,
-		"gbp":                                 Gbp,
-		"ge":                                  Ge,
-		"gear":                                Gear,
-		"gears":                               Gears,
-		"gem":                                 Gem,
-		"genderless":                          Genderless,
-		"get_pocket":                          GetPocket,
-		"gg":                                  Gg,
-		"gg_circle":                           GgCircle,
-		"ghost":                               Ghost,
-		"gift":                                Gift,
-		"gifts":                               Gifts,
-		"git":                                 Git,
-		"git_alt":                             GitAlt,
-		"git_square":                          GitSquare,
-		"github":                              Github,
-		"github_alt":                          GithubAlt,
-		"github_square":                       GithubSquare,
-		"gitkraken":                           Gitkraken,
-		"gitlab":                              Gitlab,
-		"gitter":                              Gitter,
-		"gittip":                              Gittip,
-		"glass":                               Glass,
-		"glasses":                             Glasses,
-		"glide":                               Glide,
-		"glide_g":                             GlideG,
-		"globe":                               Globe,
-		"gofore":                              Gofore,
-		"golf_ball_tee":                       GolfBallTee,
-		"goodreads":                           Goodreads,
-		"goodreads_g":                         GoodreadsG,
-		"google":                              Google,
-		"google_drive":                        GoogleDrive,
-		"google_play":                         GooglePlay,
-		"google_plus":                         GooglePlus,
-		"google_plus_circle":                  GooglePlusCircle,
-		"google_plus_official":                GooglePlusOfficial,
-		"google_plus_square":                  GooglePlusSquare,
-		"google_wallet":                       GoogleWallet,
-		"gopuram":                             Gopuram,
-		"graduation_cap":                      GraduationCap,
-		"gratipay":                            Gratipay,
-		"grav":                                Grav,
-		"greater_than":                        GreaterThan,
-		"greater_than_equal":                  GreaterThanEqual,
-		"grip":                                Grip,
-		"grip_lines":                          GripLines,
-		"grip_lines_vertical":                 GripLinesVertical,
-		"grip_vertical":                       GripVertical,
-		"gripfire":                            Gripfire,
-		"group":                               Group,
-		"grunt":                               Grunt,
-		"guitar":                              Guitar,
-		"gulp":                                Gulp,
-		"h_square":                            HSquare,
-		"hacker_news":                         HackerNews,
-		"hackerrank":                          Hackerrank,
-		"hammer":                              Hammer,
-		"hamsa":                               Hamsa,
-		"hand":                                Hand,
-		"hand_back_fist":                      HandBackFist,
-		"hand_dots":                           HandDots,
-		"hand_fist":                           HandFist,
-		"hand_grab_o":                         HandGrabO,
-		"hand_holding":                        HandHolding,
-		"hand_holding_dollar":                 HandHoldingDollar,
-		"hand_holding_droplet":                HandHoldingDroplet,
-		"hand_holding_heart":                  HandHoldingHeart,
-		"hand_lizard":                         HandLizard,
-		"hand_lizard_o":                       HandLizardO,
-		"hand_middle_finger":                  HandMiddleFinger,
-		"hand_o_down":                         HandODown,
-		"hand_o_left":                         HandOLeft,
-		"hand_o_right":                        HandORight,
-		"hand_o_up":                           HandOUp,
-		"hand_paper_o":                        HandPaperO,
-		"hand_peace":                          HandPeace,
-		"hand_peace_o":                        HandPeaceO,
-		"hand_point_down":                     HandPointDown,
-		"hand_point_left":                     HandPointLeft,
-		"hand_point_right":                    HandPointRight,
-		"hand_point_up":                       HandPointUp,
-		"hand_pointer":                        HandPointer,
-		"hand_pointer_o":                      HandPointerO,
-		"hand_rock_o":                         HandRockO,
-		"hand_scissors":                       HandScissors,
-		"hand_scissors_o":                     HandScissorsO,
-		"hand_spock":                          HandSpock,
-		"hand_spock_o":                        HandSpockO,
-		"hand_stop_o":                         HandStopO,
-		"hands":                               Hands,
-		"hands_asl_interpreting":              HandsAslInterpreting,
-		"hands_holding":                       HandsHolding,
-		"hands_praying":                       HandsPraying,
-		"handshake":                           Handshake,
-		"handshake_angle":                     HandshakeAngle,
-		"handshake_o":                         HandshakeO,
-		"handshake_simple":                    HandshakeSimple,
-		"hanukiah":                            Hanukiah,
-		"hard_drive":                          HardDrive,
-		"hard_of_hearing":                     HardOfHearing,
-		"hashtag":                             Hashtag,
-		"hat_cowboy":                          HatCowboy,
-		"hat_cowboy_side":                     HatCowboySide,
-		"hat_wizard":                          HatWizard,
-		"hdd_o":                               HddO,
-		"header":                              Header,
-		"heading":                             Heading,
-		"headphones":                          Headphones,
-		"headphones_simple":                   HeadphonesSimple,
-		"headset":                             Headset,
-		"heard_o":                             HeardO,
-		"heart":                               Heart,
-		"heart_crack":                         HeartCrack,
-		"heart_o":                             HeartO,
-		"heart_pulse":                         HeartPulse,
-		"heartbeat":                           Heartbeat,
-		"helicopter":                          Helicopter,
-		"helmet_safety":                       HelmetSafety,
-		"highlighter":                         Highlighter,
-		"hippo":                               Hippo,
-		"hips":                                Hips,
-		"hire_a_helper":                       HireAHelper,
-		"history":                             History,
-		"hockey_puck":                         HockeyPuck,
-		"holly_berry":                         HollyBerry,
-		"home":                                Home,
-		"hooli":                               Hooli,
-		"hornbill":                            Hornbill,
-		"horse":                               Horse,
-		"horse_head":                          HorseHead,
-		"hospital":                            Hospital,
-		"hospital_o":                          HospitalO,
-		"hospital_user":                       HospitalUser,
-		"hot_tub_person":                      HotTubPerson,
-		"hotdog":                              Hotdog,
-		"hotel":                               Hotel,
-		"hotel_building":                      HotelBuilding,
-		"hotjar":                              Hotjar,
-		"hourglass":                           Hourglass,
-		"hourglass_1":                         Hourglass1,
-		"hourglass_2":                         Hourglass2,
-		"hourglass_3":                         Hourglass3,
-		"hourglass_end":                       HourglassEnd,
-		"hourglass_half":                      HourglassHalf,
-		"hourglass_o":                         HourglassO,
-		"hourglass_start":                     HourglassStart,
-		"house":                               House,
-		"house_chimney":                       HouseChimney,
-		"house_chimney_crack":                 HouseChimneyCrack,
-		"house_chimney_medical":               HouseChimneyMedical,
-		"houzz":                               Houzz,
-		"hryvnia_sign":                        HryvniaSign,
-		"html5":                               Html5,
-		"hubspot":                             Hubspot,
-		"hurricane":                           Hurricane,
-		"i_cursor":                            ICursor,
-		"ice_cream":                           IceCream,
-		"icicles":                             Icicles,
-		"icons":                               Icons,
-		"id_badge":                            IdBadge,
-		"id_card":                             IdCard,
-		"id_card_clip":                        IdCardClip,
-		"id_card_o":                           IdCardO,
-		"igloo":                               Igloo,
-		"ils":                                 Ils,
-		"image":                               Image,
-		"image_portrait":                      ImagePortrait,
-		"images":                              Images,
-		"imdb":                                Imdb,
-		"inbox":                               Inbox,
-		"indent":                              Indent,
-		"industry":                            Industry,
-		"infinity":                            Infinity,
-		"info":                                Info,
-		"info_circle":                         InfoCircle,
-		"inr":                                 Inr,
-		"instagram":                           Instagram,
-		"institution":                         Institution,
-		"intercom":                            Intercom,
-		"internet_explorer":                   InternetExplorer,
-		"intersex":                            Intersex,
-		"invision":                            Invision,
-		"ioxhost":                             Ioxhost,
-		"italic":                              Italic,
-		"itch_io":                             ItchIo,
-		"itunes":                              Itunes,
-		"itunes_note":                         ItunesNote,
-		"java":                                Java,
-		"jedi":                                Jedi,
-		"jedi_order":                          JediOrder,
-		"jenkins":                             Jenkins,
-		"jet_fighter":                         JetFighter,
-		"jira":                                Jira,
-		"joget":                               Joget,
-		"joint":                               Joint,
-		"joomla":                              Joomla,
-		"jpy":                                 Jpy,
-		"js":                                  Js,
-		"jsfiddle":                            Jsfiddle,
-		"kaaba":                               Kaaba,
-		"kaggle":                              Kaggle,
-		"key":                                 Key,
-		"keybase":                             Keybase,
-		"keyboard":                            Keyboard,
-		"keyboard_o":                          KeyboardO,
-		"keycdn":                              Keycdn,
-		"khanda":                              Khanda,
-		"kickstarter":                         Kickstarter,
-		"kickstarter_k":                       KickstarterK,
-		"kit_medical":                         KitMedical,
-		"kiwi_bird":                           KiwiBird,
-		"korvue":                              Korvue,
-		"krw":                                 Krw,
-		"landmark":                            Landmark,
-		"landmark_dome":                       LandmarkDome,
-		"language":                            Language,
-		"laptop":                              Laptop,
-		"laptop_code":                         LaptopCode,
-		"laptop_medical":                      LaptopMedical,
-		"laravel":                             Laravel,
-		"lastfm":                              Lastfm,
-		"lastfm_square":                       LastfmSquare,
-		"layer_group":                         LayerGroup,
-		"leaf":                                Leaf,
-		"leanpub":                             Leanpub,
-		"left_long":                           LeftLong,
-		"left_right":                          LeftRight,
-		"legal":                               Legal,
-		"lemon":                               Lemon,
-		"lemon_o":                             LemonO,
-		"less":                                Less,
-		"less_than":                           LessThan,
-		"less_than_equal":                     LessThanEqual,
-		"level_down":                          LevelDown,
-		"level_up":                            LevelUp,
-		"life_bouy":                           LifeBouy,
-		"life_buoy":                           LifeBuoy,
-		"life_ring":                           LifeRing,
-		"life_saver":                          LifeSaver,
-		"lightbulb":                           Lightbulb,
-		"lightbulb_o":                         LightbulbO,
-		"line":                                Line,
-		"line_chart":                          LineChart,
-		"link":                                Link,
-		"link_slash":                          LinkSlash,
-		"linkedin":                            Linkedin,
-		"linkedin_in":                         LinkedinIn,
-		"linkedin_square":                     LinkedinSquare,
-		"linode":                              Linode,
-		"linux":                               Linux,
-		"lira_sign":                           LiraSign,
-		"list":                                List,
-		"list_alt":                            ListAlt,
-		"list_check":                          ListCheck,
-		"list_ol":                             ListOl,
-		"list_ul":                             ListUl,
-		"location_arrow":                      LocationArrow,
-		"location_crosshairs":                 LocationCrosshairs,
-		"location_dot":                        LocationDot,
-		"location_pin":                        LocationPin,
-		"lock":                                Lock,
-		"lock_open":                           LockOpen,
-		"long_arrow_down":                     LongArrowDown,
-		"long_arrow_left":                     LongArrowLeft,
-		"long_arrow_right":                    LongArrowRight,
-		"long_arrow_up":                       LongArrowUp,
-		"low_vision":                          LowVision,
-		"lungs":                               Lungs,
-		"lyft":                                Lyft,
-		"magento":                             Magento,
-		"magic":                               Magic,
-		"magnet":                              Magnet,
-		"magnifying_glass":                    MagnifyingGlass,
-		"magnifying_glass_dollar":             MagnifyingGlassDollar,
-		"magnifying_glass_location":           MagnifyingGlassLocation,
-		"magnifying_glass_minus":              MagnifyingGlassMinus,
-		"magnifying_glass_plus":               MagnifyingGlassPlus,
-		"mail_forward":                        MailForward,
-		"mail_reply":                          MailReply,
-		"mail_reply_all":                      MailReplyAll,
-		"mailchimp":                           Mailchimp,
-		"male":                                Male,
-		"mandalorian":                         Mandalorian,
-		"map":                                 Map,
-		"map_location":                        MapLocation,
-		"map_location_dot":                    MapLocationDot,
-		"map_marker":                          MapMarker,
-		"map_o":                               MapO,
-		"map_pin":                             MapPin,
-		"map_signs":                           MapSigns,
-		"markdown":                            Markdown,
-		"marker":                              Marker,
-		"mars":                                Mars,
-		"mars_double":                         MarsDouble,
-		"mars_stroke":                         MarsStroke,
-		"mars_stroke_h":                       MarsStrokeH,
-		"mars_stroke_right":                   MarsStrokeRight,
-		"mars_stroke_up":                      MarsStrokeUp,
-		"mars_stroke_v":                       MarsStrokeV,
-		"martini_glass":                       MartiniGlass,
-		"martini_glass_citrus":                MartiniGlassCitrus,
-		"martini_glass_empty":                 MartiniGlassEmpty,
-		"mask":                                Mask,
-		"masks_theater":                       MasksTheater,
-		"mastodon":                            Mastodon,
-		"maxcdn":                              Maxcdn,
-		"maximize":                            Maximize,
-		"mdb":                                 Mdb,
-		"meanpath":                            Meanpath,
-		"medal":                               Medal,
-		"medapps":                             Medapps,
-		"medium":                              Medium,
-		"medkit":                              Medkit,
-		"medrt":                               Medrt,
-		"meetup":                              Meetup,
-		"megaport":                            Megaport,
-		"meh_o":                               MehO,
-		"memory":                              Memory,
-		"mendeley":                            Mendeley,
-		"menorah":                             Menorah,
-		"mercury":                             Mercury,
-		"message":                             Message,
-		"meteor":                              Meteor,
-		"microchip":                           Microchip,
-		"microphone":                          Microphone,
-		"microphone_lines":                    MicrophoneLines,
-		"microphone_lines_slash":              MicrophoneLinesSlash,
-		"microphone_slash":                    MicrophoneSlash,
-		"microscope":                          Microscope,
-		"microsoft":                           Microsoft,
-		"minimize":                            Minimize,
-		"minus":                               Minus,
-		"minus_circle":                        MinusCircle,
-		"minus_square":                        MinusSquare,
-		"minus_square_o":                      MinusSquareO,
-		"mitten":                              Mitten,
-		"mix":                                 Mix,
-		"mixcloud":                            Mixcloud,
-		"mizuni":                              Mizuni,
-		"mobile":                              Mobile,
-		"mobile_button":                       MobileButton,
-		"mobile_phone":                        MobilePhone,
-		"mobile_screen":                       MobileScreen,
-		"mobile_screen_button":                MobileScreenButton,
-		"modx":                                Modx,
-		"monero":                              Monero,
-		"money":                               Money,
-		"money_bill":                          MoneyBill,
-		"money_bill_1":                        MoneyBill1,
-		"money_bill_1_wave":                   MoneyBill1Wave,
-		"money_bill_wave":                     MoneyBillWave,
-		"money_check":                         MoneyCheck,
-		"money_check_dollar":                  MoneyCheckDollar,
-		"monument":                            Monument,
-		"moon":                                Moon,
-		"moon_o":                              MoonO,
-		"mortar_board":                        MortarBoard,
-		"mortar_pestle":                       MortarPestle,
-		"mosque":                              Mosque,
-		"motorcycle":                          Motorcycle,
-		"mountain":                            Mountain,
-		"mouse_pointer":                       MousePointer,
-		"mug_hot":                             MugHot,
-		"mug_saucer":                          MugSaucer,
-		"music":                               Music,
-		"napster":                             Napster,
-		"navicon":                             Navicon,
-		"neos":                                Neos,
-		"network_wired":                       NetworkWired,
-		"neuter":                              Neuter,
-		"newspaper":                           Newspaper,
-		"newspaper_o":                         NewspaperO,
-		"nimblr":                              Nimblr,
-		"node":                                Node,
-		"node_js":                             NodeJs,
-		"not_equal":                           NotEqual,
-		"note_sticky":                         NoteSticky,
-		"notes_medical":                       NotesMedical,
-		"npm":                                 Npm,
-		"ns8":                                 Ns8,
-		"nutritionix":                         Nutritionix,
-		"object_group":                        ObjectGroup,
-		"object_ungroup":                      ObjectUngroup,
-		"odnoklassniki":                       Odnoklassniki,
-		"odnoklassniki_square":                OdnoklassnikiSquare,
-		"oil_can":                             OilCan,
-		"ok_sign":                             OkSign,
-		"old_republic":                        OldRepublic,
-		"om":                                  Om,
-		"opencart":                            Opencart,
-		"openid":                              Openid,
-		"opera":                               Opera,
-		"optin_monster":                       OptinMonster,
-		"orcid":                               Orcid,
-		"osi":                                 Osi,
-		"otter":                               Otter,
-		"outdent":                             Outdent,
-		"page4":                               Page4,
-		"pagelines":                           Pagelines,
-		"pager":                               Pager,
-		"paint_brush":                         PaintBrush,
-		"paint_roller":                        PaintRoller,
-		"paintbrush":                          Paintbrush,
-		"palette":                             Palette,
-		"palfed":                              Palfed,
-		"pallet":                              Pallet,
-		"paper_plane":                         PaperPlane,
-		"paper_plane_o":                       PaperPlaneO,
-		"paperclip":                           Paperclip,
-		"parachute_box":                       ParachuteBox,
-		"paragraph":                           Paragraph,
-		"passport":                            Passport,
-		"paste":                               Paste,
-		"patreon":                             Patreon,
-		"pause":                               Pause,
-		"pause_circle":                        PauseCircle,
-		"pause_circle_o":                      PauseCircleO,
-		"paw":                                 Paw,
-		"paypal":                              Paypal,
-		"peace":                               Peace,
-		"pen":                                 Pen,
-		"pen_clip":                            PenClip,
-		"pen_fancy":                           PenFancy,
-		"pen_nib":                             PenNib,
-		"pen_ruler":                           PenRuler,
-		"pen_to_square":                       PenToSquare,
-		"pencil":                              Pencil,
-		"pencil_square":                       PencilSquare,
-		"pencil_square_o":                     PencilSquareO,
-		"people_carry_box":                    PeopleCarryBox,
-		"pepper_hot":                          PepperHot,
-		"percent":                             Percent,
-		"periscope":                           Periscope,
-		"person":                              Person,
-		"person_biking":                       PersonBiking,
-		"person_booth":                        PersonBooth,
-		"person_digging":                      PersonDigging,
-		"person_dots_from_line":               PersonDotsFromLine,
-		"person_dress":                        PersonDress,
-		"person_hiking":                       PersonHiking,
-		"person_praying":                      PersonPraying,
-		"person_running":                      PersonRunning,
-		"person_skating":                      PersonSkating,
-		"person_skiing":                       PersonSkiing,
-		"person_skiing_nordic":                PersonSkiingNordic,
-		"person_snowboarding":                 PersonSnowboarding,
-		"person_swimming":                     PersonSwimming,
-		"person_walking":                      PersonWalking,
-		"person_walking_with_cane":            PersonWalkingWithCane,
-		"phabricator":                         Phabricator,
-		"phoenix_framework":                   PhoenixFramework,
-		"phoenix_squadron":                    PhoenixSquadron,
-		"phone":                               Phone,
-		"phone_flip":                          PhoneFlip,
-		"phone_slash":                         PhoneSlash,
-		"phone_square":                        PhoneSquare,
-		"phone_volume":                        PhoneVolume,
-		"photo":                               Photo,
-		"photo_film":                          PhotoFilm,
-		"php":                                 Php,
-		"picture_o":                           PictureO,
-		"pie_chart":                           PieChart,
-		"pied_piper":                          PiedPiper,
-		"pied_piper_alt":                      PiedPiperAlt,
-		"pied_piper_hat":                      PiedPiperHat,
-		"pied_piper_pp":                       PiedPiperPp,
-		"piggy_bank":                          PiggyBank,
-		"pills":                               Pills,
-		"pinterest":                           Pinterest,
-		"pinterest_p":                         PinterestP,
-		"pinterest_square":                    PinterestSquare,
-		"pizza_slice":                         PizzaSlice,
-		"place_of_worship":                    PlaceOfWorship,
-		"plane":                               Plane,
-		"plane_arrival":                       PlaneArrival,
-		"plane_departure":                     PlaneDeparture,
-		"play":                                Play,
-		"play_circle":                         PlayCircle,
-		"play_circle_o":                       PlayCircleO,
-		"playstation":                         Playstation,
-		"plug":                                Plug,
-		"plus":                                Plus,
-		"plus_circle":                         PlusCircle,
-		"plus_square":                         PlusSquare,
-		"plus_square_o":                       PlusSquareO,
-		"podcast":                             Podcast,
-		"poo":                                 Poo,
-		"poo_storm":                           PooStorm,
-		"poop":                                Poop,
-		"power_off":                           PowerOff,
-		"prescription":                        Prescription,
-		"prescription_bottle":                 PrescriptionBottle,
-		"prescription_bottle_medical":         PrescriptionBottleMedical,
-		"print":                               Print,
-		"product_hunt":                        ProductHunt,
-		"pushed":                              Pushed,
-		"puzzle_piece":                        PuzzlePiece,
-		"python":                              Python,
-		"qq":                                  Qq,
-		"qrcode":                              Qrcode,
-		"question":                            Question,
-		"question_circle":                     QuestionCircle,
-		"question_circle_o":                   QuestionCircleO,
-		"quinscape":                           Quinscape,
-		"quora":                               Quora,
-		"quote_left":                          QuoteLeft,
-		"quote_right":                         QuoteRight,
-		"r_project":                           RProject,
-		"ra":                                  Ra,
-		"radiation":                           Radiation,
-		"radio":                               Radio,
-		"rainbow":                             Rainbow,
-		"random":                              Random,
-		"raspberry_pi":                        RaspberryPi,
-		"ravelry":                             Ravelry,
-		"react":                               React,
-		"reacteurope":                         Reacteurope,
-		"readme":                              Readme,
-		"rebel":                               Rebel,
-		"receipt":                             Receipt,
-		"record_vinyl":                        RecordVinyl,
-		"rectangle_ad":                        RectangleAd,
-		"rectangle_list":                      RectangleList,
-		"rectangle_xmark":                     RectangleXmark,
-		"recycle":                             Recycle,
-		"red_river":                           RedRiver,
-		"reddit":                              Reddit,
-		"reddit_alien":                        RedditAlien,
-		"reddit_square":                       RedditSquare,
-		"redhat":                              Redhat,
-		"refresh":                             Refresh,
-		"registered":                          Registered,
-		"remove":                              Remove,
-		"remove_sign":                         RemoveSign,
-		"renren":                              Renren,
-		"reorder":                             Reorder,
-		"repeat":                              Repeat,
-		"repeat_alt":                          RepeatAlt,
-		"reply":                               Reply,
-		"reply_all":                           ReplyAll,
-		"replyd":                              Replyd,
-		"republican":                          Republican,
-		"researchgate":                        Researchgate,
-		"resistance":                          Resistance,
-		"resolving":                           Resolving,
-		"restroom":                            Restroom,
-		"retweet":                             Retweet,
-		"rev":                                 Rev,
-		"ribbon":                              Ribbon,
-		"right_from_bracket":                  RightFromBracket,
-		"right_left":                          RightLeft,
-		"right_to_bracket":                    RightToBracket,
-		"ring":                                Ring,
-		"rmb":                                 Rmb,
-		"road":                                Road,
-		"robot":                               Robot,
-		"rocket":                              Rocket,
-		"rocketchat":                          Rocketchat,
-		"rockrms":                             Rockrms,
-		"rotate":                              Rotate,
-		"rotate_left":                         RotateLeft,
-		"rotate_right":                        RotateRight,
-		"rouble":                              Rouble,
-		"route":                               Route,
-		"rss":                                 Rss,
-		"rss_square":                          RssSquare,
-		"rub":                                 Rub,
-		"ruble":                               Ruble,
-		"ruble_sign":                          RubleSign,
-		"ruler":                               Ruler,
-		"ruler_combined":                      RulerCombined,
-		"ruler_horizontal":                    RulerHorizontal,
-		"ruler_vertical":                      RulerVertical,
-		"rupee":                               Rupee,
-		"rupee_sign":                          RupeeSign,
-		"s15":                                 S15,
-		"sack_dollar":                         SackDollar,
-		"safari":                              Safari,
-		"salesforce":                          Salesforce,
-		"sass":                                Sass,
-		"satellite":                           Satellite,
-		"satellite_dish":                      SatelliteDish,
-		"save":                                Save,
-		"scale_balanced":                      ScaleBalanced,
-		"scale_unbalanced":                    ScaleUnbalanced,
-		"scale_unbalanced_flip":               ScaleUnbalancedFlip,
-		"schlix":                              Schlix,
-		"school":                              School,
-		"scissors":                            Scissors,
-		"screwdriver":                         Screwdriver,
-		"screwdriver_wrench":                  ScrewdriverWrench,
-		"scribd":                              Scribd,
-		"scroll":                              Scroll,
-		"scroll_torah":                        ScrollTorah,
-		"sd_card":                             SdCard,
-		"search":                              Search,
-		"search_minus":                        SearchMinus,
-		"search_plus":                         SearchPlus,
-		"searchengin":                         Searchengin,
-		"seedling":                            Seedling,
-		"sellcast":                            Sellcast,
-		"sellsy":                              Sellsy,
-		"send":                                Send,
-		"send_o":                              SendO,
-		"server":                              Server,
-		"servicestack":                        Servicestack,
-		"shapes":                              Shapes,
-		"share":                               Share,
-		"share_alt":                           ShareAlt,
-		"share_alt_square":                    ShareAltSquare,
-		"share_from_square":                   ShareFromSquare,
-		"share_nodes":                         ShareNodes,
-		"share_square":                        ShareSquare,
-		"share_square_o":                      ShareSquareO,
-		"shekel":                              Shekel,
-		"shekel_sign":                         ShekelSign,
-		"sheqel":                              Sheqel,
-		"shield":                              Shield,
-		"shield_halved":                       ShieldHalved,
-		"ship":                                Ship,
-		"shirt":                               Shirt,
-		"shirtsinbulk":                        Shirtsinbulk,
-		"shoe_prints":                         ShoePrints,
-		"shop":                                Shop,
-		"shopping_bag":                        ShoppingBag,
-		"shopping_basket":                     ShoppingBasket,
-		"shopping_cart":                       ShoppingCart,
-		"shopware":                            Shopware,
-		"shower":                              Shower,
-		"shuffle":                             Shuffle,
-		"shuttle_space":                       ShuttleSpace,
-		"sign_hanging":                        SignHanging,
-		"sign_in":                             SignIn,
-		"sign_language":                       SignLanguage,
-		"sign_out":                            SignOut,
-		"signal":                              Signal,
-		"signature":                           Signature,
-		"signing":                             Signing,
-		"signs_post":                          SignsPost,
-		"sim_card":                            SimCard,
-		"simplybuilt":                         Simplybuilt,
-		"sistrix":                             Sistrix,
-		"sitemap":                             Sitemap,
-		"sith":                                Sith,
-		"sketch":                              Sketch,
-		"skull":                               Skull,
-		"skull_crossbones":                    SkullCrossbones,
-		"skyatlas":                            Skyatlas,
-		"skype":                               Skype,
-		"slack":                               Slack,
-		"slash":                               Slash,
-		"sleigh":                              Sleigh,
-		"sliders":                             Sliders,
-		"slideshare":                          Slideshare,
-		"smile_o":                             SmileO,
-		"smog":                                Smog,
-		"smoking":                             Smoking,
-		"snapchat":                            Snapchat,
-		"snapchat_ghost":                      SnapchatGhost,
-		"snapchat_square":                     SnapchatSquare,
-		"snowflake":                           Snowflake,
-		"snowflake_o":                         SnowflakeO,
-		"snowman":                             Snowman,
-		"snowplow":                            Snowplow,
-		"soccer_ball_o":                       SoccerBallO,
-		"socks":                               Socks,
-		"solar_panel":                         SolarPanel,
-		"sort":                                Sort,
-		"sort_alpha_asc":                      SortAlphaAsc,
-		"sort_alpha_desc":                     SortAlphaDesc,
-		"sort_amount_asc":                     SortAmountAsc,
-		"sort_amount_desc":                    SortAmountDesc,
-		"sort_asc":                            SortAsc,
-		"sort_desc":                           SortDesc,
-		"sort_down":                           SortDown,
-		"sort_numeric_asc":                    SortNumericAsc,
-		"sort_numeric_desc":                   SortNumericDesc,
-		"sort_up":                             SortUp,
-		"soundcloud":                          Soundcloud,
-		"sourcetree":                          Sourcetree,
-		"spa":                                 Spa,
-		"space_shuttle":                       SpaceShuttle,
-		"spaghetti_monster_flying":            SpaghettiMonsterFlying,
-		"speakap":                             Speakap,
-		"speaker_deck":                        SpeakerDeck,
-		"spell_check":                         SpellCheck,
-		"spider":                              Spider,
-		"spinner":                             Spinner,
-		"splotch":                             Splotch,
-		"spoon":                               Spoon,
-		"spotify":                             Spotify,
-		"spray_can":                           SprayCan,
-		"spray_can_sparkles":                  SprayCanSparkles,
-		"square":                              Square,
-		"square_arrow_up_right":               SquareArrowUpRight,
-		"square_behance":                      SquareBehance,
-		"square_caret_down":                   SquareCaretDown,
-		"square_caret_left":                   SquareCaretLeft,
-		"square_caret_right":                  SquareCaretRight,
-		"square_caret_up":                     SquareCaretUp,
-		"square_check":                        SquareCheck,
-		"square_dribbble":                     SquareDribbble,
-		"square_envelope":                     SquareEnvelope,
-		"square_facebook":                     SquareFacebook,
-		"square_font_awesome_stroke":          SquareFontAwesomeStroke,
-		"square_full":                         SquareFull,
-		"square_git":                          SquareGit,
-		"square_github":                       SquareGithub,
-		"square_google_plus":                  SquareGooglePlus,
-		"square_h":                            SquareH,
-		"square_hacker_news":                  SquareHackerNews,
-		"square_js":                           SquareJs,
-		"square_lastfm":                       SquareLastfm,
-		"square_minus":                        SquareMinus,
-		"square_o":                            SquareO,
-		"square_odnoklassniki":                SquareOdnoklassniki,
-		"square_parking":                      SquareParking,
-		"square_pen":                          SquarePen,
-		"square_phone":                        SquarePhone,
-		"square_phone_flip":                   SquarePhoneFlip,
-		"square_pinterest":                    SquarePinterest,
-		"square_plus":                         SquarePlus,
-		"square_poll_horizontal":              SquarePollHorizontal,
-		"square_poll_vertical":                SquarePollVertical,
-		"square_reddit":                       SquareReddit,
-		"square_root_variable":                SquareRootVariable,
-		"square_rss":                          SquareRss,
-		"square_share_nodes":                  SquareShareNodes,
-		"square_snapchat":                     SquareSnapchat,
-		"square_steam":                        SquareSteam,
-		"square_tumblr":                       SquareTumblr,
-		"square_twitter":                      SquareTwitter,
-		"square_up_right":                     SquareUpRight,
-		"square_viadeo":                       SquareViadeo,
-		"square_vimeo":                        SquareVimeo,
-		"square_whatsapp":                     SquareWhatsapp,
-		"square_xing":                         SquareXing,
-		"square_xmark":                        SquareXmark,
-		"square_youtube":                      SquareYoutube,
-		"squarespace":                         Squarespace,
-		"stack_exchange":                      StackExchange,
-		"stack_overflow":                      StackOverflow,
-		"stackpath":                           Stackpath,
-		"stamp":                               Stamp,
-		"star":                                Star,
-		"star_and_crescent":                   StarAndCrescent,
-		"star_half":                           StarHalf,
-		"star_half_empty":                     StarHalfEmpty,
-		"star_half_full":                      StarHalfFull,
-		"star_half_o":                         StarHalfO,
-		"star_half_stroke":                    StarHalfStroke,
-		"star_o":                              StarO,
-		"star_of_david":                       StarOfDavid,
-		"star_of_life":                        StarOfLife,
-		"staylinked":                          Staylinked,
-		"steam":                               Steam,
-		"steam_square":                        SteamSquare,
-		"steam_symbol":                        SteamSymbol,
-		"step_backward":                       StepBackward,
-		"step_forward":                        StepForward,
-		"sterling_sign":                       SterlingSign,
-		"stethoscope":                         Stethoscope,
-		"sticker_mule":                        StickerMule,
-		"sticky_note":                         StickyNote,
-		"sticky_note_o":                       StickyNoteO,
-		"stop":                                Stop,
-		"stop_circle":                         StopCircle,
-		"stop_circle_o":                       StopCircleO,
-		"stopwatch":                           Stopwatch,
-		"store":                               Store,
-		"strava":                              Strava,
-		"street_view":                         StreetView,
-		"strikethrough":                       Strikethrough,
-		"stripe":                              Stripe,
-		"stripe_s":                            StripeS,
-		"stroopwafel":                         Stroopwafel,
-		"studiovinari":                        Studiovinari,
-		"stumbleupon":                         Stumbleupon,
-		"stumbleupon_circle":                  StumbleuponCircle,
-		"subscript":                           Subscript,
-		"subway":                              Subway,
-		"suitcase":                            Suitcase,
-		"suitcase_medical":                    SuitcaseMedical,
-		"suitcase_rolling":                    SuitcaseRolling,
-		"sun":                                 Sun,
-		"sun_o":                               SunO,
-		"superpowers":                         Superpowers,
-		"superscript":                         Superscript,
-		"supple":                              Supple,
-		"support":                             Support,
-		"suse":                                Suse,
-		"swatchbook":                          Swatchbook,
-		"swift":                               Swift,
-		"symfony":                             Symfony,
-		"synagogue":                           Synagogue,
-		"syringe":                             Syringe,
-		"table":                               Table,
-		"table_cells":                         TableCells,
-		"table_cells_large":                   TableCellsLarge,
-		"table_columns":                       TableColumns,
-		"table_list":                          TableList,
-		"table_tennis_paddle_ball":            TableTennisPaddleBall,
-		"tablet":                              Tablet,
-		"tablet_button":                       TabletButton,
-		"tablet_screen_button":                TabletScreenButton,
-		"tablets":                             Tablets,
-		"tachograph_digital":                  TachographDigital,
-		"tachometer":                          Tachometer,
-		"tag":                                 Tag,
-		"tags":                                Tags,
-		"tape":                                Tape,
-		"tasks":                               Tasks,
-		"taxi":                                Taxi,
-		"teamspeak":                           Teamspeak,
-		"teeth":                               Teeth,
-		"teeth_open":                          TeethOpen,
-		"telegram":                            Telegram,
-		"television":                          Television,
-		"temperature_empty":                   TemperatureEmpty,
-		"temperature_full":                    TemperatureFull,
-		"temperature_half":                    TemperatureHalf,
-		"temperature_high":                    TemperatureHigh,
-		"temperature_low":                     TemperatureLow,
-		"temperature_quarter":                 TemperatureQuarter,
-		"temperature_three_quarters":          TemperatureThreeQuarters,
-		"tencent_weibo":                       TencentWeibo,
-		"tenge_sign":                          TengeSign,
-		"terminal":                            Terminal,
-		"text_height":                         TextHeight,
-		"text_slash":                          TextSlash,
-		"text_width":                          TextWidth,
-		"th":                                  Th,
-		"th_large":                            ThLarge,
-		"th_list":                             ThList,
-		"the_red_yeti":                        TheRedYeti,
-		"themeco":                             Themeco,
-		"themeisle":                           Themeisle,
-		"thermometer":                         Thermometer,
-		"thermometer_0":                       Thermometer0,
-		"thermometer_1":                       Thermometer1,
-		"thermometer_2":                       Thermometer2,
-		"thermometer_3":                       Thermometer3,
-		"thermometer_4":                       Thermometer4,
-		"thermometer_alt":                     ThermometerAlt,
-		"thermometer_empty":                   ThermometerEmpty,
-		"thermometer_full":                    ThermometerFull,
-		"thermometer_half":                    ThermometerHalf,
-		"thermometer_quarter":                 ThermometerQuarter,
-		"thermometer_three_quarters":          ThermometerThreeQuarters,
-		"think_peaks":                         ThinkPeaks,
-		"thumb_tack":                          ThumbTack,
-		"thumbs_down":                         ThumbsDown,
-		"thumbs_o_down":                       ThumbsODown,
-		"thumbs_o_up":                         ThumbsOUp,
-		"thumbs_up":                           ThumbsUp,
-		"thumbtack":                           Thumbtack,
-		"ticket":                              Ticket,
-		"ticket_simple":                       TicketSimple,
-		"times":                               Times,
-		"times_circle":                        TimesCircle,
-		"times_circle_o":                      TimesCircleO,
-		"times_rectangle":                     TimesRectangle,
-		"times_rectangle_o":                   TimesRectangleO,
-		"tint":                                Tint,
-		"toggle_down":                         ToggleDown,
-		"toggle_left":                         ToggleLeft,
-		"toggle_off":                          ToggleOff,
-		"toggle_on":                           ToggleOn,
-		"toggle_right":                        ToggleRight,
-		"toggle_up":                           ToggleUp,
-		"toilet":                              Toilet,
-		"toilet_paper":                        ToiletPaper,
-		"toolbox":                             Toolbox,
-		"tooth":                               Tooth,
-		"torii_gate":                          ToriiGate,
-		"tornado":                             Tornado,
-		"tower_broadcast":                     TowerBroadcast,
-		"tractor":                             Tractor,
-		"trade_federation":                    TradeFederation,
-		"trademark":                           Trademark,
-		"traffic_light":                       TrafficLight,
-		"train":                               Train,
-		"train_subway":                        TrainSubway,
-		"transgender":                         Transgender,
-		"transgender_alt":                     TransgenderAlt,
-		"trash":                               Trash,
-		"trash_arrow_up":                      TrashArrowUp,
-		"trash_can":                           TrashCan,
-		"trash_can_arrow_up":                  TrashCanArrowUp,
-		"trash_o":                             TrashO,
-		"tree":                                Tree,
-		"trello":                              Trello,
-		"triangle_exclamation":                TriangleExclamation,
-		"tripadvisor":                         Tripadvisor,
-		"trophy":                              Trophy,
-		"truck":                               Truck,
-		"truck_fast":                          TruckFast,
-		"truck_medical":                       TruckMedical,
-		"truck_monster":                       TruckMonster,
-		"truck_moving":                        TruckMoving,
-		"truck_pickup":                        TruckPickup,
-		"truck_ramp_box":                      TruckRampBox,
-		"try":                                 Try,
-		"tty":                                 Tty,
-		"tumblr":                              Tumblr,
-		"tumblr_square":                       TumblrSquare,
-		"turkish_lira":                        TurkishLira,
-		"turn_down":                           TurnDown,
-		"turn_up":                             TurnUp,
-		"tv":                                  Tv,
-		"twitch":                              Twitch,
-		"twitter":                             Twitter,
-		"twitter_square":                      TwitterSquare,
-		"typo3":                               Typo3,
-		"uber":                                Uber,
-		"ubuntu":                              Ubuntu,
-		"uikit":                               Uikit,
-		"umbraco":                             Umbraco,
-		"umbrella":                            Umbrella,
-		"umbrella_beach":                      UmbrellaBeach,
-		"underline":                           Underline,
-		"undo":                                Undo,
-		"uniregistry":                         Uniregistry,
-		"universal_access":                    UniversalAccess,
-		"university":                          University,
-		"unlink":                              Unlink,
-		"unlock":                              Unlock,
-		"unlock_alt":                          UnlockAlt,
-		"unlock_keyhole":                      UnlockKeyhole,
-		"unsorted":                            Unsorted,
-		"untappd":                             Untappd,
-		"up_down":                             UpDown,
-		"up_down_left_right":                  UpDownLeftRight,
-		"up_right_and_down_left_from_center":  UpRightAndDownLeftFromCenter,
-		"up_right_from_square":                UpRightFromSquare,
-		"upload":                              Upload,
-		"ups":                                 Ups,
-		"usb":                                 Usb,
-		"usd":                                 Usd,
-		"user":                                User,
-		"user_astronaut":                      UserAstronaut,
-		"user_check":                          UserCheck,
-		"user_circle":                         UserCircle,
-		"user_circle_o":                       UserCircleO,
-		"user_clock":                          UserClock,
-		"user_doctor":                         UserDoctor,
-		"user_gear":                           UserGear,
-		"user_graduate":                       UserGraduate,
-		"user_group":                          UserGroup,
-		"user_injured":                        UserInjured,
-		"user_large":                          UserLarge,
-		"user_large_slash":                    UserLargeSlash,
-		"user_lock":                           UserLock,
-		"user_md":                             UserMd,
-		"user_minus":                          UserMinus,
-		"user_ninja":                          UserNinja,
-		"user_nurse":                          UserNurse,
-		"user_o":                              UserO,
-		"user_pen":                            UserPen,
-		"user_plus":                           UserPlus,
-		"user_secret":                         UserSecret,
-		"user_shield":                         UserShield,
-		"user_slash":                          UserSlash,
-		"user_tag":                            UserTag,
-		"user_tie":                            UserTie,
-		"user_times":                          UserTimes,
-		"user_xmark":                          UserXmark,
-		"users":                               Users,
-		"users_gear":                          UsersGear,
-		"usps":                                Usps,
-		"ussunnah":                            Ussunnah,
-		"utensils":                            Utensils,
-		"vaadin":                              Vaadin,
-		"van_shuttle":                         VanShuttle,
-		"vcard":                               Vcard,
-		"vcard_o":                             VcardO,
-		"vector_square":                       VectorSquare,
-		"venus":                               Venus,
-		"venus_double":                        VenusDouble,
-		"venus_mars":                          VenusMars,
-		"viacoin":                             Viacoin,
-		"viadeo":                              Viadeo,
-		"viadeo_square":                       ViadeoSquare,
-		"vial":                                Vial,
-		"vials":                               Vials,
-		"viber":                               Viber,
-		"video":                               Video,
-		"video_camera":                        VideoCamera,
-		"video_slash":                         VideoSlash,
-		"vihara":                              Vihara,
-		"vimeo":                               Vimeo,
-		"vimeo_square":                        VimeoSquare,
-		"vimeo_v":                             VimeoV,
-		"vine":                                Vine,
-		"vk":                                  Vk,
-		"vnv":                                 Vnv,
-		"voicemail":                           Voicemail,
-		"volcano":                             Volcano,
-		"volleyball":                          Volleyball,
-		"volume_control_phone":                VolumeControlPhone,
-		"volume_down":                         VolumeDown,
-		"volume_high":                         VolumeHigh,
-		"volume_low":                          VolumeLow,
-		"volume_off":                          VolumeOff,
-		"volume_up":                           VolumeUp,
-		"volume_xmark":                        VolumeXmark,
-		"vr_cardboard":                        VrCardboard,
-		"vuejs":                               Vuejs,
-		"walkie_talkie":                       WalkieTalkie,
-		"wallet":                              Wallet,
-		"wand_magic":                          WandMagic,
-		"wand_sparkles":                       WandSparkles,
-		"warehouse":                           Warehouse,
-		"warning":                             Warning,
-		"water":                               Water,
-		"water_ladder":                        WaterLadder,
-		"wave_square":                         WaveSquare,
-		"waze":                                Waze,
-		"wechat":                              Wechat,
-		"weebly":                              Weebly,
-		"weibo":                               Weibo,
-		"weight_hanging":                      WeightHanging,
-		"weight_scale":                        WeightScale,
-		"weixin":                              Weixin,
-		"whatsapp":                            Whatsapp,
-		"wheelchair":                          Wheelchair,
-		"wheelchair_alt":                      WheelchairAlt,
-		"whiskey_glass":                       WhiskeyGlass,
-		"whmcs":                               Whmcs,
-		"wifi":                                Wifi,
-		"wikipedia_w":                         WikipediaW,
-		"wind":                                Wind,
-		"window_close":                        WindowClose,
-		"window_close_o":                      WindowCloseO,
-		"window_maximize":                     WindowMaximize,
-		"window_minimize":                     WindowMinimize,
-		"window_restore":                      WindowRestore,
-		"windows":                             Windows,
-		"wine_bottle":                         WineBottle,
-		"wine_glass":                          WineGlass,
-		"wine_glass_empty":                    WineGlassEmpty,
-		"wix":                                 Wix,
-		"wizards_of_the_coast":                WizardsOfTheCoast,
-		"wolf_pack_battalion":                 WolfPackBattalion,
-		"won":                                 Won,
-		"won_sign":                            WonSign,
-		"wordpress":                           Wordpress,
-		"wordpress_simple":                    WordpressSimple,
-		"wpbeginner":                          Wpbeginner,
-		"wpexplorer":                          Wpexplorer,
-		"wpforms":                             Wpforms,
-		"wpressr":                             Wpressr,
-		"wrench":                              Wrench,
-		"x_ray":                               XRay,
-		"xbox":                                Xbox,
-		"xing":                                Xing,
-		"xing_square":                         XingSquare,
-		"xmark":                               Xmark,
-		"y_combinator":                        YCombinator,
-		"y_combinator_square":                 YCombinatorSquare,
-		"yahoo":                               Yahoo,
-		"yammer":                              Yammer,
-		"yandex":                              Yandex,
-		"yandex_international":                YandexInternational,
-		"yarn":                                Yarn,
-		"yc":                                  Yc,
-		"yc_square":                           YcSquare,
-		"yelp":                                Yelp,
-		"yen":                                 Yen,
-		"yen_sign":                            YenSign,
-		"yin_yang":                            YinYang,
-		"yoast":                               Yoast,
-		"youtube":                             Youtube,
-		"youtube_play":                        YoutubePlay,
-		"youtube_square":                      YoutubeSquare,
-		"zhihu":                               Zhihu,
-	}
-)
+// glyphIDs are the IDs of all glyphs in the class, sorted, such that they can be
+// binary searched. The glyph for each ID is at the same index in glyphValues.
+//
+// Arrays of constants are laid out statically by the compiler, unlike maps,
+// which are built at init time.
+var glyphIDs = [...]string{
+	"500px",
+	"accessible_icon",
+	"accusoft",
+	"address_book",
+	"address_book_o",
+	"address_card",
+	"address_card_o",
+	"adjust",
+	"adn",
+	"adversal",
+	"affiliatetheme",
+	"airbnb",
+	"algolia",
+	"align_center",
+	"align_justify",
+	"align_left",
+	"align_right",
+	"alipay",
+	"amazon",
+	"amazon_pay",
+	"ambulance",
+	"american_sign_language_interpreting",
+	"amilia",
+	"anchor",
+	"android",
+	"angellist",
+	"angle_double_down",
+	"angle_double_left",
+	"angle_double_right",
+	"angle_double_up",
+	"angle_down",
+	"angle_left",
+	"angle_right",
+	"angle_up",
+	"angles_down",
+	"angles_left",
+	"angles_right",
+	"angles_up",
+	"angrycreative",
+	"angular",
+	"ankh",
+	"app_store",
+	"app_store_ios",
+	"apper",
+	"apple",
+	"apple_pay",
+	"apple_whole",
+	"archive",
+	"archway",
+	"area_chart",
+	"arrow_circle_down",
+	"arrow_circle_left",
+	"arrow_circle_o_down",
+	"arrow_circle_o_left",
+	"arrow_circle_o_right",
+	"arrow_circle_o_up",
+	"arrow_circle_right",
+	"arrow_circle_up",
+	"arrow_down",
+	"arrow_down_1_9",
+	"arrow_down_9_1",
+	"arrow_down_a_z",
+	"arrow_down_long",
+	"arrow_down_short_wide",
+	"arrow_down_wide_short",
+	"arrow_down_z_a",
+	"arrow_left",
+	"arrow_left_long",
+	"arrow_pointer",
+	"arrow_right",
+	"arrow_right_arrow_left",
+	"arrow_right_from_bracket",
+	"arrow_right_long",
+	"arrow_right_to_bracket",
+	"arrow_rotate_left",
+	"arrow_rotate_right",
+	"arrow_turn_down",
+	"arrow_turn_up",
+	"arrow_up",
+	"arrow_up_1_9",
+	"arrow_up_9_1",
+	"arrow_up_a_z",
+	"arrow_up_long",
+	"arrow_up_right_from_square",
+	"arrow_up_short_wide",
+	"arrow_up_wide_short",
+	"arrow_up_z_a",
+	"arrows",
+	"arrows_alt",
+	"arrows_h",
+	"arrows_left_right",
+	"arrows_rotate",
+	"arrows_up_down",
+	"arrows_up_down_left_right",
+	"arrows_v",
+	"artstation",
+	"asl_interpreting",
+	"assistive_listening_systems",
+	"asterisk",
+	"asymmetrik",
+	"at",
+	"atlassian",
+	"atom",
+	"audible",
+	"audio_description",
+	"automobile",
+	"autoprefixer",
+	"avianex",
+	"aviato",
+	"award",
+	"aws",
+	"baby",
+	"baby_carriage",
+	"backward",
+	"backward_fast",
+	"backward_step",
+	"bacon",
+	"bag_shopping",
+	"bahai",
+	"balance_scale",
+	"ban",
+	"ban_smoking",
+	"bandage",
+	"bandcamp",
+	"bank",
+	"bar_chart",
+	"bar_chart_o",
+	"barcode",
+	"bars",
+	"bars_progress",
+	"bars_staggered",
+	"baseball",
+	"baseball_bat_ball",
+	"basket_shopping",
+	"basketball",
+	"bath",
+	"bathtub",
+	"battery",
+	"battery_0",
+	"battery_1",
+	"battery_2",
+	"battery_3",
+	"battery_4",
+	"battery_empty",
+	"battery_full",
+	"battery_half",
+	"battery_quarter",
+	"battery_three_quarters",
+	"battle_net",
+	"bed",
+	"bed_pulse",
+	"beer",
+	"beer_mug_empty",
+	"behance",
+	"behance_square",
+	"bell",
+	"bell_concierge",
+	"bell_o",
+	"bell_slash",
+	"bell_slash_o",
+	"bezier_curve",
+	"bicycle",
+	"bimobject",
+	"binoculars",
+	"biohazard",
+	"birthday_cake",
+	"bitbucket",
+	"bitbucket_square",
+	"bitcoin",
+	"bity",
+	"black_tie",
+	"blackberry",
+	"blender",
+	"blender_phone",
+	"blind",
+	"blog",
+	"blogger",
+	"blogger_b",
+	"bluetooth",
+	"bluetooth_b",
+	"bold",
+	"bolt",
+	"bomb",
+	"bone",
+	"bong",
+	"book",
+	"book_atlas",
+	"book_bible",
+	"book_journal_whills",
+	"book_medical",
+	"book_open",
+	"book_open_reader",
+	"book_quran",
+	"book_skull",
+	"book_tanakh",
+	"bookmark",
+	"bookmark_o",
+	"bootstrap",
+	"border_all",
+	"border_none",
+	"border_top_left",
+	"bowling_ball",
+	"box",
+	"box_archive",
+	"box_open",
+	"boxes_stacked",
+	"braille",
+	"brain",
+	"bread_slice",
+	"briefcase",
+	"briefcase_medical",
+	"broom",
+	"broom_ball",
+	"brush",
+	"btc",
+	"buffer",
+	"bug",
+	"building",
+	"building_columns",
+	"building_o",
+	"bullhorn",
+	"bullseye",
+	"burger",
+	"buromobelexperte",
+	"bus",
+	"bus_simple",
+	"business_time",
+	"buy_n_large",
+	"buysellads",
+	"cab",
+	"cable_car",
+	"cake_candles",
+	"calculator",
+	"calendar",
+	"calendar_check",
+	"calendar_check_o",
+	"calendar_day",
+	"calendar_days",
+	"calendar_minus",
+	"calendar_minus_o",
+	"calendar_o",
+	"calendar_plus",
+	"calendar_plus_o",
+	"calendar_times_o",
+	"calendar_week",
+	"calendar_xmark",
+	"camera",
+	"camera_retro",
+	"campground",
+	"canadian_maple_leaf",
+	"candy_cane",
+	"cannabis",
+	"capsules",
+	"car",
+	"car_battery",
+	"car_burst",
+	"car_rear",
+	"car_side",
+	"caravan",
+	"caret_down",
+	"caret_left",
+	"caret_right",
+	"caret_square_o_down",
+	"caret_square_o_left",
+	"caret_square_o_right",
+	"caret_square_o_up",
+	"caret_up",
+	"carrot",
+	"cart_arrow_down",
+	"cart_flatbed",
+	"cart_flatbed_suitcase",
+	"cart_plus",
+	"cart_shopping",
+	"cash_register",
+	"cat",
+	"cc",
+	"cc_amazon_pay",
+	"cc_amex",
+	"cc_apple_pay",
+	"cc_diners_club",
+	"cc_discover",
+	"cc_jcb",
+	"cc_mastercard",
+	"cc_paypal",
+	"cc_stripe",
+	"cc_visa",
+	"centercode",
+	"centos",
+	"certificate",
+	"chain",
+	"chain_broken",
+	"chair",
+	"chalkboard",
+	"chalkboard_user",
+	"champagne_glasses",
+	"charging_station",
+	"chart_area",
+	"chart_bar",
+	"chart_line",
+	"chart_pie",
+	"check",
+	"check_circle",
+	"check_circle_o",
+	"check_double",
+	"check_square",
+	"check_square_o",
+	"check_to_slot",
+	"cheese",
+	"chess",
+	"chess_bishop",
+	"chess_board",
+	"chess_king",
+	"chess_knight",
+	"chess_pawn",
+	"chess_queen",
+	"chess_rook",
+	"chevron_circle_down",
+	"chevron_circle_left",
+	"chevron_circle_right",
+	"chevron_circle_up",
+	"chevron_down",
+	"chevron_left",
+	"chevron_right",
+	"chevron_up",
+	"child",
+	"chrome",
+	"chromecast",
+	"church",
+	"circle",
+	"circle_arrow_down",
+	"circle_arrow_left",
+	"circle_arrow_right",
+	"circle_arrow_up",
+	"circle_check",
+	"circle_chevron_down",
+	"circle_chevron_left",
+	"circle_chevron_right",
+	"circle_chevron_up",
+	"circle_dollar_to_slot",
+	"circle_dot",
+	"circle_down",
+	"circle_exclamation",
+	"circle_h",
+	"circle_half_stroke",
+	"circle_info",
+	"circle_left",
+	"circle_minus",
+	"circle_notch",
+	"circle_o",
+	"circle_o_notch",
+	"circle_pause",
+	"circle_play",
+	"circle_plus",
+	"circle_question",
+	"circle_radiation",
+	"circle_right",
+	"circle_stop",
+	"circle_thin",
+	"circle_up",
+	"circle_user",
+	"circle_xmark",
+	"city",
+	"clipboard",
+	"clipboard_alt",
+	"clipboard_check",
+	"clipboard_list",
+	"clipboard_user",
+	"clock",
+	"clock_o",
+	"clock_rotate_left",
+	"clone",
+	"close",
+	"closed_captioning",
+	"cloud",
+	"cloud_arrow_down",
+	"cloud_arrow_up",
+	"cloud_bolt",
+	"cloud_download",
+	"cloud_meatball",
+	"cloud_moon",
+	"cloud_moon_rain",
+	"cloud_rain",
+	"cloud_showers_heavy",
+	"cloud_sun",
+	"cloud_sun_rain",
+	"cloud_upload",
+	"cloudscale",
+	"cloudsmith",
+	"cloudversify",
+	"cny",
+	"code",
+	"code_branch",
+	"code_commit",
+	"code_fork",
+	"code_merge",
+	"codepen",
+	"codiepie",
+	"coffee",
+	"cog",
+	"cogs",
+	"coins",
+	"columns",
+	"comment",
+	"comment_dollar",
+	"comment_dots",
+	"comment_medical",
+	"comment_o",
+	"comment_slash",
+	"comment_sms",
+	"commenting",
+	"commenting_o",
+	"comments",
+	"comments_dollar",
+	"comments_o",
+	"compact_disc",
+	"compass",
+	"compass_drafting",
+	"compress",
+	"computer_mouse",
+	"confluence",
+	"connectdevelop",
+	"contao",
+	"cookie",
+	"cookie_bite",
+	"copy",
+	"copyright",
+	"cotton_bureau",
+	"couch",
+	"cow",
+	"cpanel",
+	"creative_commons",
+	"creative_commons_by",
+	"creative_commons_nc",
+	"creative_commons_nc_eu",
+	"creative_commons_nc_jp",
+	"creative_commons_nd",
+	"creative_commons_pd",
+	"creative_commons_pd_alt",
+	"creative_commons_remix",
+	"creative_commons_sa",
+	"creative_commons_sampling",
+	"creative_commons_sampling_plus",
+	"creative_commons_share",
+	"creative_commons_zero",
+	"credit_card",
+	"credit_card_alt",
+	"critical_role",
+	"crop",
+	"crop_simple",
+	"cross",
+	"crosshairs",
+	"crow",
+	"crown",
+	"crutch",
+	"css3",
+	"css3_alt",
+	"cube",
+	"cubes",
+	"cut",
+	"cutlery",
+	"cuttlefish",
+	"d_and_d",
+	"d_and_d_beyond",
+	"dashboard",
+	"dashcube",
+	"database",
+	"deaf",
+	"deafness",
+	"dedent",
+	"delete_left",
+	"delicious",
+	"democrat",
+	"deploydog",
+	"deskpro",
+	"desktop",
+	"dev",
+	"deviantart",
+	"dharmachakra",
+	"dhl",
+	"diagram_project",
+	"diamond",
+	"diamond_turn_right",
+	"diaspora",
+	"dice",
+	"dice_d20",
+	"dice_d6",
+	"dice_five",
+	"dice_four",
+	"dice_one",
+	"dice_six",
+	"dice_three",
+	"dice_two",
+	"digg",
+	"digital_ocean",
+	"discord",
+	"discourse",
+	"disease",
+	"divide",
+	"dna",
+	"dochub",
+	"docker",
+	"dog",
+	"dollar",
+	"dollar_sign",
+	"dolly",
+	"door_closed",
+	"door_open",
+	"dot_circle_o",
+	"dove",
+	"down_left_and_up_right_to_center",
+	"down_long",
+	"download",
+	"draft2digital",
+	"dragon",
+	"draw_polygon",
+	"dribbble",
+	"drivers_license",
+	"drivers_license_o",
+	"dropbox",
+	"droplet",
+	"droplet_slash",
+	"drum",
+	"drum_steelpan",
+	"drumstick_bite",
+	"drupal",
+	"dumbbell",
+	"dumpster",
+	"dumpster_fire",
+	"dungeon",
+	"dyalog",
+	"ear_deaf",
+	"ear_listen",
+	"earlybirds",
+	"earth_africa",
+	"earth_americas",
+	"earth_asia",
+	"earth_europe",
+	"ebay",
+	"edge",
+	"edit",
+	"eercast",
+	"egg",
+	"eject",
+	"elementor",
+	"ellipsis",
+	"ellipsis_h",
+	"ellipsis_v",
+	"ellipsis_vertical",
+	"ello",
+	"ember",
+	"empire",
+	"envelope",
+	"envelope_o",
+	"envelope_open",
+	"envelope_open_o",
+	"envelope_open_text",
+	"envelope_square",
+	"envelopes_bulk",
+	"envira",
+	"equals",
+	"eraser",
+	"erlang",
+	"ethereum",
+	"ethernet",
+	"etsy",
+	"eur",
+	"euro",
+	"euro_sign",
+	"evernote",
+	"exchange",
+	"exclamation",
+	"exclamation_circle",
+	"exclamation_triangle",
+	"expand",
+	"expeditedssl",
+	"external_link",
+	"external_link_square",
+	"eye",
+	"eye_dropper",
+	"eye_low_vision",
+	"eye_slash",
+	"eyedropper",
+	"fa",
+	"face_angry",
+	"face_dizzy",
+	"face_flushed",
+	"face_frown",
+	"face_frown_open",
+	"face_grimace",
+	"face_grin",
+	"face_grin_beam",
+	"face_grin_beam_sweat",
+	"face_grin_hearts",
+	"face_grin_squint",
+	"face_grin_squint_tears",
+	"face_grin_stars",
+	"face_grin_tears",
+	"face_grin_tongue",
+	"face_grin_tongue_squint",
+	"face_grin_tongue_wink",
+	"face_grin_wide",
+	"face_grin_wink",
+	"face_kiss",
+	"face_kiss_beam",
+	"face_kiss_wink_heart",
+	"face_laugh",
+	"face_laugh_beam",
+	"face_laugh_squint",
+	"face_laugh_wink",
+	"face_meh",
+	"face_meh_blank",
+	"face_rolling_eyes",
+	"face_sad_cry",
+	"face_sad_tear",
+	"face_smile",
+	"face_smile_beam",
+	"face_smile_wink",
+	"face_surprise",
+	"face_tired",
+	"facebook",
+	"facebook_f",
+	"facebook_messenger",
+	"facebook_official",
+	"facebook_square",
+	"fan",
+	"fantasy_flight_games",
+	"fast_backward",
+	"fast_forward",
+	"fax",
+	"feather",
+	"feather_pointed",
+	"fedex",
+	"fedora",
+	"feed",
+	"female",
+	"fighter_jet",
+	"figma",
+	"file",
+	"file_archive_o",
+	"file_arrow_down",
+	"file_arrow_up",
+	"file_audio",
+	"file_audio_o",
+	"file_code",
+	"file_code_o",
+	"file_contract",
+	"file_csv",
+	"file_excel",
+	"file_excel_o",
+	"file_export",
+	"file_image",
+	"file_image_o",
+	"file_import",
+	"file_invoice",
+	"file_invoice_dollar",
+	"file_lines",
+	"file_medical",
+	"file_movie_o",
+	"file_o",
+	"file_pdf",
+	"file_pdf_o",
+	"file_pen",
+	"file_photo_o",
+	"file_picture_o",
+	"file_powerpoint",
+	"file_powerpoint_o",
+	"file_prescription",
+	"file_signature",
+	"file_sound_o",
+	"file_text",
+	"file_text_o",
+	"file_video",
+	"file_video_o",
+	"file_waveform",
+	"file_word",
+	"file_word_o",
+	"file_zip_o",
+	"file_zipper",
+	"files_o",
+	"fill",
+	"fill_drip",
+	"film",
+	"filter",
+	"filter_circle_dollar",
+	"fingerprint",
+	"fire",
+	"fire_extinguisher",
+	"fire_flame_curved",
+	"fire_flame_simple",
+	"firefox",
+	"first_order",
+	"first_order_alt",
+	"firstdraft",
+	"fish",
+	"flag",
+	"flag_checkered",
+	"flag_o",
+	"flag_usa",
+	"flash",
+	"flask",
+	"flickr",
+	"flipboard",
+	"floppy_disk",
+	"floppy_o",
+	"fly",
+	"folder",
+	"folder_minus",
+	"folder_o",
+	"folder_open",
+	"folder_open_o",
+	"folder_plus",
+	"folder_tree",
+	"font",
+	"font_awesome",
+	"fonticons",
+	"fonticons_fi",
+	"football",
+	"fort_awesome",
+	"fort_awesome_alt",
+	"forumbee",
+	"forward",
+	"forward_fast",
+	"forward_step",
+	"foursquare",
+	"free_code_camp",
+	"freebsd",
+	"frog",
+	"frown_o",
+	"fulcrum",
+	"futbol",
+	"futbol_o",
+	"galactic_republic",
+	"galactic_senate",
+	"gamepad",
+	"gas_pump",
+	"gauge",
+	"gauge_high",
+	"gauge_simple",
+	"gauge_simple_high",
+	"gavel",
+	"gbp",
+	"ge",
+	"gear",
+	"gears",
+	"gem",
+	"genderless",
+	"get_pocket",
+	"gg",
+	"gg_circle",
+	"ghost",
+	"gift",
+	"gifts",
+	"git",
+	"git_alt",
+	"git_square",
+	"github",
+	"github_alt",
+	"github_square",
+	"gitkraken",
+	"gitlab",
+	"gitter",
+	"gittip",
+	"glass",
+	"glasses",
+	"glide",
+	"glide_g",
+	"globe",
+	"gofore",
+	"golf_ball_tee",
+	"goodreads",
+	"goodreads_g",
+	"google",
+	"google_drive",
+	"google_play",
+	"google_plus",
+	"google_plus_circle",
+	"google_plus_official",
+	"google_plus_square",
+	"google_wallet",
+	"gopuram",
+	"graduation_cap",
+	"gratipay",
+	"grav",
+	"greater_than",
+	"greater_than_equal",
+	"grip",
+	"grip_lines",
+	"grip_lines_vertical",
+	"grip_vertical",
+	"gripfire",
+	"group",
+	"grunt",
+	"guitar",
+	"gulp",
+	"h_square",
+	"hacker_news",
+	"hackerrank",
+	"hammer",
+	"hamsa",
+	"hand",
+	"hand_back_fist",
+	"hand_dots",
+	"hand_fist",
+	"hand_grab_o",
+	"hand_holding",
+	"hand_holding_dollar",
+	"hand_holding_droplet",
+	"hand_holding_heart",
+	"hand_lizard",
+	"hand_lizard_o",
+	"hand_middle_finger",
+	"hand_o_down",
+	"hand_o_left",
+	"hand_o_right",
+	"hand_o_up",
+	"hand_paper_o",
+	"hand_peace",
+	"hand_peace_o",
+	"hand_point_down",
+	"hand_point_left",
+	"hand_point_right",
+	"hand_point_up",
+	"hand_pointer",
+	"hand_pointer_o",
+	"hand_rock_o",
+	"hand_scissors",
+	"hand_scissors_o",
+	"hand_spock",
+	"hand_spock_o",
+	"hand_stop_o",
+	"hands",
+	"hands_asl_interpreting",
+	"hands_holding",
+	"hands_praying",
+	"handshake",
+	"handshake_angle",
+	"handshake_o",
+	"handshake_simple",
+	"hanukiah",
+	"hard_drive",
+	"hard_of_hearing",
+	"hashtag",
+	"hat_cowboy",
+	"hat_cowboy_side",
+	"hat_wizard",
+	"hdd_o",
+	"header",
+	"heading",
+	"headphones",
+	"headphones_simple",
+	"headset",
+	"heard_o",
+	"heart",
+	"heart_crack",
+	"heart_o",
+	"heart_pulse",
+	"heartbeat",
+	"helicopter",
+	"helmet_safety",
+	"highlighter",
+	"hippo",
+	"hips",
+	"hire_a_helper",
+	"history",
+	"hockey_puck",
+	"holly_berry",
+	"home",
+	"hooli",
+	"hornbill",
+	"horse",
+	"horse_head",
+	"hospital",
+	"hospital_o",
+	"hospital_user",
+	"hot_tub_person",
+	"hotdog",
+	"hotel",
+	"hotel_building",
+	"hotjar",
+	"hourglass",
+	"hourglass_1",
+	"hourglass_2",
+	"hourglass_3",
+	"hourglass_end",
+	"hourglass_half",
+	"hourglass_o",
+	"hourglass_start",
+	"house",
+	"house_chimney",
+	"house_chimney_crack",
+	"house_chimney_medical",
+	"houzz",
+	"hryvnia_sign",
+	"html5",
+	"hubspot",
+	"hurricane",
+	"i_cursor",
+	"ice_cream",
+	"icicles",
+	"icons",
+	"id_badge",
+	"id_card",
+	"id_card_clip",
+	"id_card_o",
+	"igloo",
+	"ils",
+	"image",
+	"image_portrait",
+	"images",
+	"imdb",
+	"inbox",
+	"indent",
+	"industry",
+	"infinity",
+	"info",
+	"info_circle",
+	"inr",
+	"instagram",
+	"institution",
+	"intercom",
+	"internet_explorer",
+	"intersex",
+	"invision",
+	"ioxhost",
+	"italic",
+	"itch_io",
+	"itunes",
+	"itunes_note",
+	"java",
+	"jedi",
+	"jedi_order",
+	"jenkins",
+	"jet_fighter",
+	"jira",
+	"joget",
+	"joint",
+	"joomla",
+	"jpy",
+	"js",
+	"jsfiddle",
+	"kaaba",
+	"kaggle",
+	"key",
+	"keybase",
+	"keyboard",
+	"keyboard_o",
+	"keycdn",
+	"khanda",
+	"kickstarter",
+	"kickstarter_k",
+	"kit_medical",
+	"kiwi_bird",
+	"korvue",
+	"krw",
+	"landmark",
+	"landmark_dome",
+	"language",
+	"laptop",
+	"laptop_code",
+	"laptop_medical",
+	"laravel",
+	"lastfm",
+	"lastfm_square",
+	"layer_group",
+	"leaf",
+	"leanpub",
+	"left_long",
+	"left_right",
+	"legal",
+	"lemon",
+	"lemon_o",
+	"less",
+	"less_than",
+	"less_than_equal",
+	"level_down",
+	"level_up",
+	"life_bouy",
+	"life_buoy",
+	"life_ring",
+	"life_saver",
+	"lightbulb",
+	"lightbulb_o",
+	"line",
+	"line_chart",
+	"link",
+	"link_slash",
+	"linkedin",
+	"linkedin_in",
+	"linkedin_square",
+	"linode",
+	"linux",
+	"lira_sign",
+	"list",
+	"list_alt",
+	"list_check",
+	"list_ol",
+	"list_ul",
+	"location_arrow",
+	"location_crosshairs",
+	"location_dot",
+	"location_pin",
+	"lock",
+	"lock_open",
+	"long_arrow_down",
+	"long_arrow_left",
+	"long_arrow_right",
+	"long_arrow_up",
+	"low_vision",
+	"lungs",
+	"lyft",
+	"magento",
+	"magic",
+	"magnet",
+	"magnifying_glass",
+	"magnifying_glass_dollar",
+	"magnifying_glass_location",
+	"magnifying_glass_minus",
+	"magnifying_glass_plus",
+	"mail_forward",
+	"mail_reply",
+	"mail_reply_all",
+	"mailchimp",
+	"male",
+	"mandalorian",
+	"map",
+	"map_location",
+	"map_location_dot",
+	"map_marker",
+	"map_o",
+	"map_pin",
+	"map_signs",
+	"markdown",
+	"marker",
+	"mars",
+	"mars_double",
+	"mars_stroke",
+	"mars_stroke_h",
+	"mars_stroke_right",
+	"mars_stroke_up",
+	"mars_stroke_v",
+	"martini_glass",
+	"martini_glass_citrus",
+	"martini_glass_empty",
+	"mask",
+	"masks_theater",
+	"mastodon",
+	"maxcdn",
+	"maximize",
+	"mdb",
+	"meanpath",
+	"medal",
+	"medapps",
+	"medium",
+	"medkit",
+	"medrt",
+	"meetup",
+	"megaport",
+	"meh_o",
+	"memory",
+	"mendeley",
+	"menorah",
+	"mercury",
+	"message",
+	"meteor",
+	"microchip",
+	"microphone",
+	"microphone_lines",
+	"microphone_lines_slash",
+	"microphone_slash",
+	"microscope",
+	"microsoft",
+	"minimize",
+	"minus",
+	"minus_circle",
+	"minus_square",
+	"minus_square_o",
+	"mitten",
+	"mix",
+	"mixcloud",
+	"mizuni",
+	"mobile",
+	"mobile_button",
+	"mobile_phone",
+	"mobile_screen",
+	"mobile_screen_button",
+	"modx",
+	"monero",
+	"money",
+	"money_bill",
+	"money_bill_1",
+	"money_bill_1_wave",
+	"money_bill_wave",
+	"money_check",
+	"money_check_dollar",
+	"monument",
+	"moon",
+	"moon_o",
+	"mortar_board",
+	"mortar_pestle",
+	"mosque",
+	"motorcycle",
+	"mountain",
+	"mouse_pointer",
+	"mug_hot",
+	"mug_saucer",
+	"music",
+	"napster",
+	"navicon",
+	"neos",
+	"network_wired",
+	"neuter",
+	"newspaper",
+	"newspaper_o",
+	"nimblr",
+	"node",
+	"node_js",
+	"not_equal",
+	"note_sticky",
+	"notes_medical",
+	"npm",
+	"ns8",
+	"nutritionix",
+	"object_group",
+	"object_ungroup",
+	"odnoklassniki",
+	"odnoklassniki_square",
+	"oil_can",
+	"ok_sign",
+	"old_republic",
+	"om",
+	"opencart",
+	"openid",
+	"opera",
+	"optin_monster",
+	"orcid",
+	"osi",
+	"otter",
+	"outdent",
+	"page4",
+	"pagelines",
+	"pager",
+	"paint_brush",
+	"paint_roller",
+	"paintbrush",
+	"palette",
+	"palfed",
+	"pallet",
+	"paper_plane",
+	"paper_plane_o",
+	"paperclip",
+	"parachute_box",
+	"paragraph",
+	"passport",
+	"paste",
+	"patreon",
+	"pause",
+	"pause_circle",
+	"pause_circle_o",
+	"paw",
+	"paypal",
+	"peace",
+	"pen",
+	"pen_clip",
+	"pen_fancy",
+	"pen_nib",
+	"pen_ruler",
+	"pen_to_square",
+	"pencil",
+	"pencil_square",
+	"pencil_square_o",
+	"people_carry_box",
+	"pepper_hot",
+	"percent",
+	"periscope",
+	"person",
+	"person_biking",
+	"person_booth",
+	"person_digging",
+	"person_dots_from_line",
+	"person_dress",
+	"person_hiking",
+	"person_praying",
+	"person_running",
+	"person_skating",
+	"person_skiing",
+	"person_skiing_nordic",
+	"person_snowboarding",
+	"person_swimming",
+	"person_walking",
+	"person_walking_with_cane",
+	"phabricator",
+	"phoenix_framework",
+	"phoenix_squadron",
+	"phone",
+	"phone_flip",
+	"phone_slash",
+	"phone_square",
+	"phone_volume",
+	"photo",
+	"photo_film",
+	"php",
+	"picture_o",
+	"pie_chart",
+	"pied_piper",
+	"pied_piper_alt",
+	"pied_piper_hat",
+	"pied_piper_pp",
+	"piggy_bank",
+	"pills",
+	"pinterest",
+	"pinterest_p",
+	"pinterest_square",
+	"pizza_slice",
+	"place_of_worship",
+	"plane",
+	"plane_arrival",
+	"plane_departure",
+	"play",
+	"play_circle",
+	"play_circle_o",
+	"playstation",
+	"plug",
+	"plus",
+	"plus_circle",
+	"plus_square",
+	"plus_square_o",
+	"podcast",
+	"poo",
+	"poo_storm",
+	"poop",
+	"power_off",
+	"prescription",
+	"prescription_bottle",
+	"prescription_bottle_medical",
+	"print",
+	"product_hunt",
+	"pushed",
+	"puzzle_piece",
+	"python",
+	"qq",
+	"qrcode",
+	"question",
+	"question_circle",
+	"question_circle_o",
+	"quinscape",
+	"quora",
+	"quote_left",
+	"quote_right",
+	"r_project",
+	"ra",
+	"radiation",
+	"radio",
+	"rainbow",
+	"random",
+	"raspberry_pi",
+	"ravelry",
+	"react",
+	"reacteurope",
+	"readme",
+	"rebel",
+	"receipt",
+	"record_vinyl",
+	"rectangle_ad",
+	"rectangle_list",
+	"rectangle_xmark",
+	"recycle",
+	"red_river",
+	"reddit",
+	"reddit_alien",
+	"reddit_square",
+	"redhat",
+	"refresh",
+	"registered",
+	"remove",
+	"remove_sign",
+	"renren",
+	"reorder",
+	"repeat",
+	"repeat_alt",
+	"reply",
+	"reply_all",
+	"replyd",
+	"republican",
+	"researchgate",
+	"resistance",
+	"resolving",
+	"restroom",
+	"retweet",
+	"rev",
+	"ribbon",
+	"right_from_bracket",
+	"right_left",
+	"right_to_bracket",
+	"ring",
+	"rmb",
+	"road",
+	"robot",
+	"rocket",
+	"rocketchat",
+	"rockrms",
+	"rotate",
+	"rotate_left",
+	"rotate_right",
+	"rouble",
+	"route",
+	"rss",
+	"rss_square",
+	"rub",
+	"ruble",
+	"ruble_sign",
+	"ruler",
+	"ruler_combined",
+	"ruler_horizontal",
+	"ruler_vertical",
+	"rupee",
+	"rupee_sign",
+	"s15",
+	"sack_dollar",
+	"safari",
+	"salesforce",
+	"sass",
+	"satellite",
+	"satellite_dish",
+	"save",
+	"scale_balanced",
+	"scale_unbalanced",
+	"scale_unbalanced_flip",
+	"schlix",
+	"school",
+	"scissors",
+	"screwdriver",
+	"screwdriver_wrench",
+	"scribd",
+	"scroll",
+	"scroll_torah",
+	"sd_card",
+	"search",
+	"search_minus",
+	"search_plus",
+	"searchengin",
+	"seedling",
+	"sellcast",
+	"sellsy",
+	"send",
+	"send_o",
+	"server",
+	"servicestack",
+	"shapes",
+	"share",
+	"share_alt",
+	"share_alt_square",
+	"share_from_square",
+	"share_nodes",
+	"share_square",
+	"share_square_o",
+	"shekel",
+	"shekel_sign",
+	"sheqel",
+	"shield",
+	"shield_halved",
+	"ship",
+	"shirt",
+	"shirtsinbulk",
+	"shoe_prints",
+	"shop",
+	"shopping_bag",
+	"shopping_basket",
+	"shopping_cart",
+	"shopware",
+	"shower",
+	"shuffle",
+	"shuttle_space",
+	"sign_hanging",
+	"sign_in",
+	"sign_language",
+	"sign_out",
+	"signal",
+	"signature",
+	"signing",
+	"signs_post",
+	"sim_card",
+	"simplybuilt",
+	"sistrix",
+	"sitemap",
+	"sith",
+	"sketch",
+	"skull",
+	"skull_crossbones",
+	"skyatlas",
+	"skype",
+	"slack",
+	"slash",
+	"sleigh",
+	"sliders",
+	"slideshare",
+	"smile_o",
+	"smog",
+	"smoking",
+	"snapchat",
+	"snapchat_ghost",
+	"snapchat_square",
+	"snowflake",
+	"snowflake_o",
+	"snowman",
+	"snowplow",
+	"soccer_ball_o",
+	"socks",
+	"solar_panel",
+	"sort",
+	"sort_alpha_asc",
+	"sort_alpha_desc",
+	"sort_amount_asc",
+	"sort_amount_desc",
+	"sort_asc",
+	"sort_desc",
+	"sort_down",
+	"sort_numeric_asc",
+	"sort_numeric_desc",
+	"sort_up",
+	"soundcloud",
+	"sourcetree",
+	"spa",
+	"space_shuttle",
+	"spaghetti_monster_flying",
+	"speakap",
+	"speaker_deck",
+	"spell_check",
+	"spider",
+	"spinner",
+	"splotch",
+	"spoon",
+	"spotify",
+	"spray_can",
+	"spray_can_sparkles",
+	"square",
+	"square_arrow_up_right",
+	"square_behance",
+	"square_caret_down",
+	"square_caret_left",
+	"square_caret_right",
+	"square_caret_up",
+	"square_check",
+	"square_dribbble",
+	"square_envelope",
+	"square_facebook",
+	"square_font_awesome_stroke",
+	"square_full",
+	"square_git",
+	"square_github",
+	"square_google_plus",
+	"square_h",
+	"square_hacker_news",
+	"square_js",
+	"square_lastfm",
+	"square_minus",
+	"square_o",
+	"square_odnoklassniki",
+	"square_parking",
+	"square_pen",
+	"square_phone",
+	"square_phone_flip",
+	"square_pinterest",
+	"square_plus",
+	"square_poll_horizontal",
+	"square_poll_vertical",
+	"square_reddit",
+	"square_root_variable",
+	"square_rss",
+	"square_share_nodes",
+	"square_snapchat",
+	"square_steam",
+	"square_tumblr",
+	"square_twitter",
+	"square_up_right",
+	"square_viadeo",
+	"square_vimeo",
+	"square_whatsapp",
+	"square_xing",
+	"square_xmark",
+	"square_youtube",
+	"squarespace",
+	"stack_exchange",
+	"stack_overflow",
+	"stackpath",
+	"stamp",
+	"star",
+	"star_and_crescent",
+	"star_half",
+	"star_half_empty",
+	"star_half_full",
+	"star_half_o",
+	"star_half_stroke",
+	"star_o",
+	"star_of_david",
+	"star_of_life",
+	"staylinked",
+	"steam",
+	"steam_square",
+	"steam_symbol",
+	"step_backward",
+	"step_forward",
+	"sterling_sign",
+	"stethoscope",
+	"sticker_mule",
+	"sticky_note",
+	"sticky_note_o",
+	"stop",
+	"stop_circle",
+	"stop_circle_o",
+	"stopwatch",
+	"store",
+	"strava",
+	"street_view",
+	"strikethrough",
+	"stripe",
+	"stripe_s",
+	"stroopwafel",
+	"studiovinari",
+	"stumbleupon",
+	"stumbleupon_circle",
+	"subscript",
+	"subway",
+	"suitcase",
+	"suitcase_medical",
+	"suitcase_rolling",
+	"sun",
+	"sun_o",
+	"superpowers",
+	"superscript",
+	"supple",
+	"support",
+	"suse",
+	"swatchbook",
+	"swift",
+	"symfony",
+	"synagogue",
+	"syringe",
+	"table",
+	"table_cells",
+	"table_cells_large",
+	"table_columns",
+	"table_list",
+	"table_tennis_paddle_ball",
+	"tablet",
+	"tablet_button",
+	"tablet_screen_button",
+	"tablets",
+	"tachograph_digital",
+	"tachometer",
+	"tag",
+	"tags",
+	"tape",
+	"tasks",
+	"taxi",
+	"teamspeak",
+	"teeth",
+	"teeth_open",
+	"telegram",
+	"television",
+	"temperature_empty",
+	"temperature_full",
+	"temperature_half",
+	"temperature_high",
+	"temperature_low",
+	"temperature_quarter",
+	"temperature_three_quarters",
+	"tencent_weibo",
+	"tenge_sign",
+	"terminal",
+	"text_height",
+	"text_slash",
+	"text_width",
+	"th",
+	"th_large",
+	"th_list",
+	"the_red_yeti",
+	"themeco",
+	"themeisle",
+	"thermometer",
+	"thermometer_0",
+	"thermometer_1",
+	"thermometer_2",
+	"thermometer_3",
+	"thermometer_4",
+	"thermometer_alt",
+	"thermometer_empty",
+	"thermometer_full",
+	"thermometer_half",
+	"thermometer_quarter",
+	"thermometer_three_quarters",
+	"think_peaks",
+	"thumb_tack",
+	"thumbs_down",
+	"thumbs_o_down",
+	"thumbs_o_up",
+	"thumbs_up",
+	"thumbtack",
+	"ticket",
+	"ticket_simple",
+	"times",
+	"times_circle",
+	"times_circle_o",
+	"times_rectangle",
+	"times_rectangle_o",
+	"tint",
+	"toggle_down",
+	"toggle_left",
+	"toggle_off",
+	"toggle_on",
+	"toggle_right",
+	"toggle_up",
+	"toilet",
+	"toilet_paper",
+	"toolbox",
+	"tooth",
+	"torii_gate",
+	"tornado",
+	"tower_broadcast",
+	"tractor",
+	"trade_federation",
+	"trademark",
+	"traffic_light",
+	"train",
+	"train_subway",
+	"transgender",
+	"transgender_alt",
+	"trash",
+	"trash_arrow_up",
+	"trash_can",
+	"trash_can_arrow_up",
+	"trash_o",
+	"tree",
+	"trello",
+	"triangle_exclamation",
+	"tripadvisor",
+	"trophy",
+	"truck",
+	"truck_fast",
+	"truck_medical",
+	"truck_monster",
+	"truck_moving",
+	"truck_pickup",
+	"truck_ramp_box",
+	"try",
+	"tty",
+	"tumblr",
+	"tumblr_square",
+	"turkish_lira",
+	"turn_down",
+	"turn_up",
+	"tv",
+	"twitch",
+	"twitter",
+	"twitter_square",
+	"typo3",
+	"uber",
+	"ubuntu",
+	"uikit",
+	"umbraco",
+	"umbrella",
+	"umbrella_beach",
+	"underline",
+	"undo",
+	"uniregistry",
+	"universal_access",
+	"university",
+	"unlink",
+	"unlock",
+	"unlock_alt",
+	"unlock_keyhole",
+	"unsorted",
+	"untappd",
+	"up_down",
+	"up_down_left_right",
+	"up_right_and_down_left_from_center",
+	"up_right_from_square",
+	"upload",
+	"ups",
+	"usb",
+	"usd",
+	"user",
+	"user_astronaut",
+	"user_check",
+	"user_circle",
+	"user_circle_o",
+	"user_clock",
+	"user_doctor",
+	"user_gear",
+	"user_graduate",
+	"user_group",
+	"user_injured",
+	"user_large",
+	"user_large_slash",
+	"user_lock",
+	"user_md",
+	"user_minus",
+	"user_ninja",
+	"user_nurse",
+	"user_o",
+	"user_pen",
+	"user_plus",
+	"user_secret",
+	"user_shield",
+	"user_slash",
+	"user_tag",
+	"user_tie",
+	"user_times",
+	"user_xmark",
+	"users",
+	"users_gear",
+	"usps",
+	"ussunnah",
+	"utensils",
+	"vaadin",
+	"van_shuttle",
+	"vcard",
+	"vcard_o",
+	"vector_square",
+	"venus",
+	"venus_double",
+	"venus_mars",
+	"viacoin",
+	"viadeo",
+	"viadeo_square",
+	"vial",
+	"vials",
+	"viber",
+	"video",
+	"video_camera",
+	"video_slash",
+	"vihara",
+	"vimeo",
+	"vimeo_square",
+	"vimeo_v",
+	"vine",
+	"vk",
+	"vnv",
+	"voicemail",
+	"volcano",
+	"volleyball",
+	"volume_control_phone",
+	"volume_down",
+	"volume_high",
+	"volume_low",
+	"volume_off",
+	"volume_up",
+	"volume_xmark",
+	"vr_cardboard",
+	"vuejs",
+	"walkie_talkie",
+	"wallet",
+	"wand_magic",
+	"wand_sparkles",
+	"warehouse",
+	"warning",
+	"water",
+	"water_ladder",
+	"wave_square",
+	"waze",
+	"wechat",
+	"weebly",
+	"weibo",
+	"weight_hanging",
+	"weight_scale",
+	"weixin",
+	"whatsapp",
+	"wheelchair",
+	"wheelchair_alt",
+	"whiskey_glass",
+	"whmcs",
+	"wifi",
+	"wikipedia_w",
+	"wind",
+	"window_close",
+	"window_close_o",
+	"window_maximize",
+	"window_minimize",
+	"window_restore",
+	"windows",
+	"wine_bottle",
+	"wine_glass",
+	"wine_glass_empty",
+	"wix",
+	"wizards_of_the_coast",
+	"wolf_pack_battalion",
+	"won",
+	"won_sign",
+	"wordpress",
+	"wordpress_simple",
+	"wpbeginner",
+	"wpexplorer",
+	"wpforms",
+	"wpressr",
+	"wrench",
+	"x_ray",
+	"xbox",
+	"xing",
+	"xing_square",
+	"xmark",
+	"y_combinator",
+	"y_combinator_square",
+	"yahoo",
+	"yammer",
+	"yandex",
+	"yandex_international",
+	"yarn",
+	"yc",
+	"yc_square",
+	"yelp",
+	"yen",
+	"yen_sign",
+	"yin_yang",
+	"yoast",
+	"youtube",
+	"youtube_play",
+	"youtube_square",
+	"zhihu",
+}
+
+// glyphValues are the glyphs in the class, in the same order as glyphIDs.
+var glyphValues = [len(glyphIDs)]nf.Glyph{
+	Glyph500Px,
+	AccessibleIcon,
+	Accusoft,
+	AddressBook,
+	AddressBookO,
+	AddressCard,
+	AddressCardO,
+	Adjust,
+	Adn,
+	Adversal,
+	Affiliatetheme,
+	Airbnb,
+	Algolia,
+	AlignCenter,
+	AlignJustify,
+	AlignLeft,
+	AlignRight,
+	Alipay,
+	Amazon,
+	AmazonPay,
+	Ambulance,
+	AmericanSignLanguageInterpreting,
+	Amilia,
+	Anchor,
+	Android,
+	Angellist,
+	AngleDoubleDown,
+	AngleDoubleLeft,
+	AngleDoubleRight,
+	AngleDoubleUp,
+	AngleDown,
+	AngleLeft,
+	AngleRight,
+	AngleUp,
+	AnglesDown,
+	AnglesLeft,
+	AnglesRight,
+	AnglesUp,
+	Angrycreative,
+	Angular,
+	Ankh,
+	AppStore,
+	AppStoreIos,
+	Apper,
+	Apple,
+	ApplePay,
+	AppleWhole,
+	Archive,
+	Archway,
+	AreaChart,
+	ArrowCircleDown,
+	ArrowCircleLeft,
+	ArrowCircleODown,
+	ArrowCircleOLeft,
+	ArrowCircleORight,
+	ArrowCircleOUp,
+	ArrowCircleRight,
+	ArrowCircleUp,
+	ArrowDown,
+	ArrowDown19,
+	ArrowDown91,
+	ArrowDownAZ,
+	ArrowDownLong,
+	ArrowDownShortWide,
+	ArrowDownWideShort,
+	ArrowDownZA,
+	ArrowLeft,
+	ArrowLeftLong,
+	ArrowPointer,
+	ArrowRight,
+	ArrowRightArrowLeft,
+	ArrowRightFromBracket,
+	ArrowRightLong,
+	ArrowRightToBracket,
+	ArrowRotateLeft,
+	ArrowRotateRight,
+	ArrowTurnDown,
+	ArrowTurnUp,
+	ArrowUp,
+	ArrowUp19,
+	ArrowUp91,
+	ArrowUpAZ,
+	ArrowUpLong,
+	ArrowUpRightFromSquare,
+	ArrowUpShortWide,
+	ArrowUpWideShort,
+	ArrowUpZA,
+	Arrows,
+	ArrowsAlt,
+	ArrowsH,
+	ArrowsLeftRight,
+	ArrowsRotate,
+	ArrowsUpDown,
+	ArrowsUpDownLeftRight,
+	ArrowsV,
+	Artstation,
+	AslInterpreting,
+	AssistiveListeningSystems,
+	Asterisk,
+	Asymmetrik,
+	At,
+	Atlassian,
+	Atom,
+	Audible,
+	AudioDescription,
+	Automobile,
+	Autoprefixer,
+	Avianex,
+	Aviato,
+	Award,
+	Aws,
+	Baby,
+	BabyCarriage,
+	Backward,
+	BackwardFast,
+	BackwardStep,
+	Bacon,
+	BagShopping,
+	Bahai,
+	BalanceScale,
+	Ban,
+	BanSmoking,
+	Bandage,
+	Bandcamp,
+	Bank,
+	BarChart,
+	BarChartO,
+	Barcode,
+	Bars,
+	BarsProgress,
+	BarsStaggered,
+	Baseball,
+	BaseballBatBall,
+	BasketShopping,
+	Basketball,
+	Bath,
+	Bathtub,
+	Battery,
+	Battery0,
+	Battery1,
+	Battery2,
+	Battery3,
+	Battery4,
+	BatteryEmpty,
+	BatteryFull,
+	BatteryHalf,
+	BatteryQuarter,
+	BatteryThreeQuarters,
+	BattleNet,
+	Bed,
+	BedPulse,
+	Beer,
+	BeerMugEmpty,
+	Behance,
+	BehanceSquare,
+	Bell,
+	BellConcierge,
+	BellO,
+	BellSlash,
+	BellSlashO,
+	BezierCurve,
+	Bicycle,
+	Bimobject,
+	Binoculars,
+	Biohazard,
+	BirthdayCake,
+	Bitbucket,
+	BitbucketSquare,
+	Bitcoin,
+	Bity,
+	BlackTie,
+	Blackberry,
+	Blender,
+	BlenderPhone,
+	Blind,
+	Blog,
+	Blogger,
+	BloggerB,
+	Bluetooth,
+	BluetoothB,
+	Bold,
+	Bolt,
+	Bomb,
+	Bone,
+	Bong,
+	Book,
+	BookAtlas,
+	BookBible,
+	BookJournalWhills,
+	BookMedical,
+	BookOpen,
+	BookOpenReader,
+	BookQuran,
+	BookSkull,
+	BookTanakh,
+	Bookmark,
+	BookmarkO,
+	Bootstrap,
+	BorderAll,
+	BorderNone,
+	BorderTopLeft,
+	BowlingBall,
+	Box,
+	BoxArchive,
+	BoxOpen,
+	BoxesStacked,
+	Braille,
+	Brain,
+	BreadSlice,
+	Briefcase,
+	BriefcaseMedical,
+	Broom,
+	BroomBall,
+	Brush,
+	Btc,
+	Buffer,
+	Bug,
+	Building,
+	BuildingColumns,
+	BuildingO,
+	Bullhorn,
+	Bullseye,
+	Burger,
+	Buromobelexperte,
+	Bus,
+	BusSimple,
+	BusinessTime,
+	BuyNLarge,
+	Buysellads,
+	Cab,
+	CableCar,
+	CakeCandles,
+	Calculator,
+	Calendar,
+	CalendarCheck,
+	CalendarCheckO,
+	CalendarDay,
+	CalendarDays,
+	CalendarMinus,
+	CalendarMinusO,
+	CalendarO,
+	CalendarPlus,
+	CalendarPlusO,
+	CalendarTimesO,
+	CalendarWeek,
+	CalendarXmark,
+	Camera,
+	CameraRetro,
+	Campground,
+	CanadianMapleLeaf,
+	CandyCane,
+	Cannabis,
+	Capsules,
+	Car,
+	CarBattery,
+	CarBurst,
+	CarRear,
+	CarSide,
+	Caravan,
+	CaretDown,
+	CaretLeft,
+	CaretRight,
+	CaretSquareODown,
+	CaretSquareOLeft,
+	CaretSquareORight,
+	CaretSquareOUp,
+	CaretUp,
+	Carrot,
+	CartArrowDown,
+	CartFlatbed,
+	CartFlatbedSuitcase,
+	CartPlus,
+	CartShopping,
+	CashRegister,
+	Cat,
+	Cc,
+	CcAmazonPay,
+	CcAmex,
+	CcApplePay,
+	CcDinersClub,
+	CcDiscover,
+	CcJcb,
+	CcMastercard,
+	CcPaypal,
+	CcStripe,
+	CcVisa,
+	Centercode,
+	Centos,
+	Certificate,
+	Chain,
+	ChainBroken,
+	Chair,
+	Chalkboard,
+	ChalkboardUser,
+	ChampagneGlasses,
+	ChargingStation,
+	ChartArea,
+	ChartBar,
+	ChartLine,
+	ChartPie,
+	Check,
+	CheckCircle,
+	CheckCircleO,
+	CheckDouble,
+	CheckSquare,
+	CheckSquareO,
+	CheckToSlot,
+	Cheese,
+	Chess,
+	ChessBishop,
+	ChessBoard,
+	ChessKing,
+	ChessKnight,
+	ChessPawn,
+	ChessQueen,
+	ChessRook,
+	ChevronCircleDown,
+	ChevronCircleLeft,
+	ChevronCircleRight,
+	ChevronCircleUp,
+	ChevronDown,
+	ChevronLeft,
+	ChevronRight,
+	ChevronUp,
+	Child,
+	Chrome,
+	Chromecast,
+	Church,
+	Circle,
+	CircleArrowDown,
+	CircleArrowLeft,
+	CircleArrowRight,
+	CircleArrowUp,
+	CircleCheck,
+	CircleChevronDown,
+	CircleChevronLeft,
+	CircleChevronRight,
+	CircleChevronUp,
+	CircleDollarToSlot,
+	CircleDot,
+	CircleDown,
+	CircleExclamation,
+	CircleH,
+	CircleHalfStroke,
+	CircleInfo,
+	CircleLeft,
+	CircleMinus,
+	CircleNotch,
+	CircleO,
+	CircleONotch,
+	CirclePause,
+	CirclePlay,
+	CirclePlus,
+	CircleQuestion,
+	CircleRadiation,
+	CircleRight,
+	CircleStop,
+	CircleThin,
+	CircleUp,
+	CircleUser,
+	CircleXmark,
+	City,
+	Clipboard,
+	ClipboardAlt,
+	ClipboardCheck,
+	ClipboardList,
+	ClipboardUser,
+	Clock,
+	ClockO,
+	ClockRotateLeft,
+	Clone,
+	Close,
+	ClosedCaptioning,
+	Cloud,
+	CloudArrowDown,
+	CloudArrowUp,
+	CloudBolt,
+	CloudDownload,
+	CloudMeatball,
+	CloudMoon,
+	CloudMoonRain,
+	CloudRain,
+	CloudShowersHeavy,
+	CloudSun,
+	CloudSunRain,
+	CloudUpload,
+	Cloudscale,
+	Cloudsmith,
+	Cloudversify,
+	Cny,
+	Code,
+	CodeBranch,
+	CodeCommit,
+	CodeFork,
+	CodeMerge,
+	Codepen,
+	Codiepie,
+	Coffee,
+	Cog,
+	Cogs,
+	Coins,
+	Columns,
+	Comment,
+	CommentDollar,
+	CommentDots,
+	CommentMedical,
+	CommentO,
+	CommentSlash,
+	CommentSms,
+	Commenting,
+	CommentingO,
+	Comments,
+	CommentsDollar,
+	CommentsO,
+	CompactDisc,
+	Compass,
+	CompassDrafting,
+	Compress,
+	ComputerMouse,
+	Confluence,
+	Connectdevelop,
+	Contao,
+	Cookie,
+	CookieBite,
+	Copy,
+	Copyright,
+	CottonBureau,
+	Couch,
+	Cow,
+	Cpanel,
+	CreativeCommons,
+	CreativeCommonsBy,
+	CreativeCommonsNc,
+	CreativeCommonsNcEu,
+	CreativeCommonsNcJp,
+	CreativeCommonsNd,
+	CreativeCommonsPd,
+	CreativeCommonsPdAlt,
+	CreativeCommonsRemix,
+	CreativeCommonsSa,
+	CreativeCommonsSampling,
+	CreativeCommonsSamplingPlus,
+	CreativeCommonsShare,
+	CreativeCommonsZero,
+	CreditCard,
+	CreditCardAlt,
+	CriticalRole,
+	Crop,
+	CropSimple,
+	Cross,
+	Crosshairs,
+	Crow,
+	Crown,
+	Crutch,
+	Css3,
+	Css3Alt,
+	Cube,
+	Cubes,
+	Cut,
+	Cutlery,
+	Cuttlefish,
+	DAndD,
+	DAndDBeyond,
+	Dashboard,
+	Dashcube,
+	Database,
+	Deaf,
+	Deafness,
+	Dedent,
+	DeleteLeft,
+	Delicious,
+	Democrat,
+	Deploydog,
+	Deskpro,
+	Desktop,
+	Dev,
+	Deviantart,
+	Dharmachakra,
+	Dhl,
+	DiagramProject,
+	Diamond,
+	DiamondTurnRight,
+	Diaspora,
+	Dice,
+	DiceD20,
+	DiceD6,
+	DiceFive,
+	DiceFour,
+	DiceOne,
+	DiceSix,
+	DiceThree,
+	DiceTwo,
+	Digg,
+	DigitalOcean,
+	Discord,
+	Discourse,
+	Disease,
+	Divide,
+	Dna,
+	Dochub,
+	Docker,
+	Dog,
+	Dollar,
+	DollarSign,
+	Dolly,
+	DoorClosed,
+	DoorOpen,
+	DotCircleO,
+	Dove,
+	DownLeftAndUpRightToCenter,
+	DownLong,
+	Download,
+	Draft2Digital,
+	Dragon,
+	DrawPolygon,
+	Dribbble,
+	DriversLicense,
+	DriversLicenseO,
+	Dropbox,
+	Droplet,
+	DropletSlash,
+	Drum,
+	DrumSteelpan,
+	DrumstickBite,
+	Drupal,
+	Dumbbell,
+	Dumpster,
+	DumpsterFire,
+	Dungeon,
+	Dyalog,
+	EarDeaf,
+	EarListen,
+	Earlybirds,
+	EarthAfrica,
+	EarthAmericas,
+	EarthAsia,
+	EarthEurope,
+	Ebay,
+	Edge,
+	Edit,
+	Eercast,
+	Egg,
+	Eject,
+	Elementor,
+	Ellipsis,
+	EllipsisH,
+	EllipsisV,
+	EllipsisVertical,
+	Ello,
+	Ember,
+	Empire,
+	Envelope,
+	EnvelopeO,
+	EnvelopeOpen,
+	EnvelopeOpenO,
+	EnvelopeOpenText,
+	EnvelopeSquare,
+	EnvelopesBulk,
+	Envira,
+	Equals,
+	Eraser,
+	Erlang,
+	Ethereum,
+	Ethernet,
+	Etsy,
+	Eur,
+	Euro,
+	EuroSign,
+	Evernote,
+	Exchange,
+	Exclamation,
+	ExclamationCircle,
+	ExclamationTriangle,
+	Expand,
+	Expeditedssl,
+	ExternalLink,
+	ExternalLinkSquare,
+	Eye,
+	EyeDropper,
+	EyeLowVision,
+	EyeSlash,
+	Eyedropper,
+	Fa,
+	FaceAngry,
+	FaceDizzy,
+	FaceFlushed,
+	FaceFrown,
+	FaceFrownOpen,
+	FaceGrimace,
+	FaceGrin,
+	FaceGrinBeam,
+	FaceGrinBeamSweat,
+	FaceGrinHearts,
+	FaceGrinSquint,
+	FaceGrinSquintTears,
+	FaceGrinStars,
+	FaceGrinTears,
+	FaceGrinTongue,
+	FaceGrinTongueSquint,
+	FaceGrinTongueWink,
+	FaceGrinWide,
+	FaceGrinWink,
+	FaceKiss,
+	FaceKissBeam,
+	FaceKissWinkHeart,
+	FaceLaugh,
+	FaceLaughBeam,
+	FaceLaughSquint,
+	FaceLaughWink,
+	FaceMeh,
+	FaceMehBlank,
+	FaceRollingEyes,
+	FaceSadCry,
+	FaceSadTear,
+	FaceSmile,
+	FaceSmileBeam,
+	FaceSmileWink,
+	FaceSurprise,
+	FaceTired,
+	Facebook,
+	FacebookF,
+	FacebookMessenger,
+	FacebookOfficial,
+	FacebookSquare,
+	Fan,
+	FantasyFlightGames,
+	FastBackward,
+	FastForward,
+	Fax,
+	Feather,
+	FeatherPointed,
+	Fedex,
+	Fedora,
+	Feed,
+	Female,
+	FighterJet,
+	Figma,
+	File,
+	FileArchiveO,
+	FileArrowDown,
+	FileArrowUp,
+	FileAudio,
+	FileAudioO,
+	FileCode,
+	FileCodeO,
+	FileContract,
+	FileCsv,
+	FileExcel,
+	FileExcelO,
+	FileExport,
+	FileImage,
+	FileImageO,
+	FileImport,
+	FileInvoice,
+	FileInvoiceDollar,
+	FileLines,
+	FileMedical,
+	FileMovieO,
+	FileO,
+	FilePdf,
+	FilePdfO,
+	FilePen,
+	FilePhotoO,
+	FilePictureO,
+	FilePowerpoint,
+	FilePowerpointO,
+	FilePrescription,
+	FileSignature,
+	FileSoundO,
+	FileText,
+	FileTextO,
+	FileVideo,
+	FileVideoO,
+	FileWaveform,
+	FileWord,
+	FileWordO,
+	FileZipO,
+	FileZipper,
+	FilesO,
+	Fill,
+	FillDrip,
+	Film,
+	Filter,
+	FilterCircleDollar,
+	Fingerprint,
+	Fire,
+	FireExtinguisher,
+	FireFlameCurved,
+	FireFlameSimple,
+	Firefox,
+	FirstOrder,
+	FirstOrderAlt,
+	Firstdraft,
+	Fish,
+	Flag,
+	FlagCheckered,
+	FlagO,
+	FlagUsa,
+	Flash,
+	Flask,
+	Flickr,
+	Flipboard,
+	FloppyDisk,
+	FloppyO,
+	Fly,
+	Folder,
+	FolderMinus,
+	FolderO,
+	FolderOpen,
+	FolderOpenO,
+	FolderPlus,
+	FolderTree,
+	Font,
+	FontAwesome,
+	Fonticons,
+	FonticonsFi,
+	Football,
+	FortAwesome,
+	FortAwesomeAlt,
+	Forumbee,
+	Forward,
+	ForwardFast,
+	ForwardStep,
+	Foursquare,
+	FreeCodeCamp,
+	Freebsd,
+	Frog,
+	FrownO,
+	Fulcrum,
+	Futbol,
+	FutbolO,
+	GalacticRepublic,
+	GalacticSenate,
+	Gamepad,
+	GasPump,
+	Gauge,
+	GaugeHigh,
+	GaugeSimple,
+	GaugeSimpleHigh,
+	Gavel,
+	Gbp,
+	Ge,
+	Gear,
+	Gears,
+	Gem,
+	Genderless,
+	GetPocket,
+	Gg,
+	GgCircle,
+	Ghost,
+	Gift,
+	Gifts,
+	Git,
+	GitAlt,
+	GitSquare,
+	Github,
+	GithubAlt,
+	GithubSquare,
+	Gitkraken,
+	Gitlab,
+	Gitter,
+	Gittip,
+	Glass,
+	Glasses,
+	Glide,
+	GlideG,
+	Globe,
+	Gofore,
+	GolfBallTee,
+	Goodreads,
+	GoodreadsG,
+	Google,
+	GoogleDrive,
+	GooglePlay,
+	GooglePlus,
+	GooglePlusCircle,
+	GooglePlusOfficial,
+	GooglePlusSquare,
+	GoogleWallet,
+	Gopuram,
+	GraduationCap,
+	Gratipay,
+	Grav,
+	GreaterThan,
+	GreaterThanEqual,
+	Grip,
+	GripLines,
+	GripLinesVertical,
+	GripVertical,
+	Gripfire,
+	Group,
+	Grunt,
+	Guitar,
+	Gulp,
+	HSquare,
+	HackerNews,
+	Hackerrank,
+	Hammer,
+	Hamsa,
+	Hand,
+	HandBackFist,
+	HandDots,
+	HandFist,
+	HandGrabO,
+	HandHolding,
+	HandHoldingDollar,
+	HandHoldingDroplet,
+	HandHoldingHeart,
+	HandLizard,
+	HandLizardO,
+	HandMiddleFinger,
+	HandODown,
+	HandOLeft,
+	HandORight,
+	HandOUp,
+	HandPaperO,
+	HandPeace,
+	HandPeaceO,
+	HandPointDown,
+	HandPointLeft,
+	HandPointRight,
+	HandPointUp,
+	HandPointer,
+	HandPointerO,
+	HandRockO,
+	HandScissors,
+	HandScissorsO,
+	HandSpock,
+	HandSpockO,
+	HandStopO,
+	Hands,
+	HandsAslInterpreting,
+	HandsHolding,
+	HandsPraying,
+	Handshake,
+	HandshakeAngle,
+	HandshakeO,
+	HandshakeSimple,
+	Hanukiah,
+	HardDrive,
+	HardOfHearing,
+	Hashtag,
+	HatCowboy,
+	HatCowboySide,
+	HatWizard,
+	HddO,
+	Header,
+	Heading,
+	Headphones,
+	HeadphonesSimple,
+	Headset,
+	HeardO,
+	Heart,
+	HeartCrack,
+	HeartO,
+	HeartPulse,
+	Heartbeat,
+	Helicopter,
+	HelmetSafety,
+	Highlighter,
+	Hippo,
+	Hips,
+	HireAHelper,
+	History,
+	HockeyPuck,
+	HollyBerry,
+	Home,
+	Hooli,
+	Hornbill,
+	Horse,
+	HorseHead,
+	Hospital,
+	HospitalO,
+	HospitalUser,
+	HotTubPerson,
+	Hotdog,
+	Hotel,
+	HotelBuilding,
+	Hotjar,
+	Hourglass,
+	Hourglass1,
+	Hourglass2,
+	Hourglass3,
+	HourglassEnd,
+	HourglassHalf,
+	HourglassO,
+	HourglassStart,
+	House,
+	HouseChimney,
+	HouseChimneyCrack,
+	HouseChimneyMedical,
+	Houzz,
+	HryvniaSign,
+	Html5,
+	Hubspot,
+	Hurricane,
+	ICursor,
+	IceCream,
+	Icicles,
+	Icons,
+	IdBadge,
+	IdCard,
+	IdCardClip,
+	IdCardO,
+	Igloo,
+	Ils,
+	Image,
+	ImagePortrait,
+	Images,
+	Imdb,
+	Inbox,
+	Indent,
+	Industry,
+	Infinity,
+	Info,
+	InfoCircle,
+	Inr,
+	Instagram,
+	Institution,
+	Intercom,
+	InternetExplorer,
+	Intersex,
+	Invision,
+	Ioxhost,
+	Italic,
+	ItchIo,
+	Itunes,
+	ItunesNote,
+	Java,
+	Jedi,
+	JediOrder,
+	Jenkins,
+	JetFighter,
+	Jira,
+	Joget,
+	Joint,
+	Joomla,
+	Jpy,
+	Js,
+	Jsfiddle,
+	Kaaba,
+	Kaggle,
+	Key,
+	Keybase,
+	Keyboard,
+	KeyboardO,
+	Keycdn,
+	Khanda,
+	Kickstarter,
+	KickstarterK,
+	KitMedical,
+	KiwiBird,
+	Korvue,
+	Krw,
+	Landmark,
+	LandmarkDome,
+	Language,
+	Laptop,
+	LaptopCode,
+	LaptopMedical,
+	Laravel,
+	Lastfm,
+	LastfmSquare,
+	LayerGroup,
+	Leaf,
+	Leanpub,
+	LeftLong,
+	LeftRight,
+	Legal,
+	Lemon,
+	LemonO,
+	Less,
+	LessThan,
+	LessThanEqual,
+	LevelDown,
+	LevelUp,
+	LifeBouy,
+	LifeBuoy,
+	LifeRing,
+	LifeSaver,
+	Lightbulb,
+	LightbulbO,
+	Line,
+	LineChart,
+	Link,
+	LinkSlash,
+	Linkedin,
+	LinkedinIn,
+	LinkedinSquare,
+	Linode,
+	Linux,
+	LiraSign,
+	List,
+	ListAlt,
+	ListCheck,
+	ListOl,
+	ListUl,
+	LocationArrow,
+	LocationCrosshairs,
+	LocationDot,
+	LocationPin,
+	Lock,
+	LockOpen,
+	LongArrowDown,
+	LongArrowLeft,
+	LongArrowRight,
+	LongArrowUp,
+	LowVision,
+	Lungs,
+	Lyft,
+	Magento,
+	Magic,
+	Magnet,
+	MagnifyingGlass,
+	MagnifyingGlassDollar,
+	MagnifyingGlassLocation,
+	MagnifyingGlassMinus,
+	MagnifyingGlassPlus,
+	MailForward,
+	MailReply,
+	MailReplyAll,
+	Mailchimp,
+	Male,
+	Mandalorian,
+	Map,
+	MapLocation,
+	MapLocationDot,
+	MapMarker,
+	MapO,
+	MapPin,
+	MapSigns,
+	Markdown,
+	Marker,
+	Mars,
+	MarsDouble,
+	MarsStroke,
+	MarsStrokeH,
+	MarsStrokeRight,
+	MarsStrokeUp,
+	MarsStrokeV,
+	MartiniGlass,
+	MartiniGlassCitrus,
+	MartiniGlassEmpty,
+	Mask,
+	MasksTheater,
+	Mastodon,
+	Maxcdn,
+	Maximize,
+	Mdb,
+	Meanpath,
+	Medal,
+	Medapps,
+	Medium,
+	Medkit,
+	Medrt,
+	Meetup,
+	Megaport,
+	MehO,
+	Memory,
+	Mendeley,
+	Menorah,
+	Mercury,
+	Message,
+	Meteor,
+	Microchip,
+	Microphone,
+	MicrophoneLines,
+	MicrophoneLinesSlash,
+	MicrophoneSlash,
+	Microscope,
+	Microsoft,
+	Minimize,
+	Minus,
+	MinusCircle,
+	MinusSquare,
+	MinusSquareO,
+	Mitten,
+	Mix,
+	Mixcloud,
+	Mizuni,
+	Mobile,
+	MobileButton,
+	MobilePhone,
+	MobileScreen,
+	MobileScreenButton,
+	Modx,
+	Monero,
+	Money,
+	MoneyBill,
+	MoneyBill1,
+	MoneyBill1Wave,
+	MoneyBillWave,
+	MoneyCheck,
+	MoneyCheckDollar,
+	Monument,
+	Moon,
+	MoonO,
+	MortarBoard,
+	MortarPestle,
+	Mosque,
+	Motorcycle,
+	Mountain,
+	MousePointer,
+	MugHot,
+	MugSaucer,
+	Music,
+	Napster,
+	Navicon,
+	Neos,
+	NetworkWired,
+	Neuter,
+	Newspaper,
+	NewspaperO,
+	Nimblr,
+	Node,
+	NodeJs,
+	NotEqual,
+	NoteSticky,
+	NotesMedical,
+	Npm,
+	Ns8,
+	Nutritionix,
+	ObjectGroup,
+	ObjectUngroup,
+	Odnoklassniki,
+	OdnoklassnikiSquare,
+	OilCan,
+	OkSign,
+	OldRepublic,
+	Om,
+	Opencart,
+	Openid,
+	Opera,
+	OptinMonster,
+	Orcid,
+	Osi,
+	Otter,
+	Outdent,
+	Page4,
+	Pagelines,
+	Pager,
+	PaintBrush,
+	PaintRoller,
+	Paintbrush,
+	Palette,
+	Palfed,
+	Pallet,
+	PaperPlane,
+	PaperPlaneO,
+	Paperclip,
+	ParachuteBox,
+	Paragraph,
+	Passport,
+	Paste,
+	Patreon,
+	Pause,
+	PauseCircle,
+	PauseCircleO,
+	Paw,
+	Paypal,
+	Peace,
+	Pen,
+	PenClip,
+	PenFancy,
+	PenNib,
+	PenRuler,
+	PenToSquare,
+	Pencil,
+	PencilSquare,
+	PencilSquareO,
+	PeopleCarryBox,
+	PepperHot,
+	Percent,
+	Periscope,
+	Person,
+	PersonBiking,
+	PersonBooth,
+	PersonDigging,
+	PersonDotsFromLine,
+	PersonDress,
+	PersonHiking,
+	PersonPraying,
+	PersonRunning,
+	PersonSkating,
+	PersonSkiing,
+	PersonSkiingNordic,
+	PersonSnowboarding,
+	PersonSwimming,
+	PersonWalking,
+	PersonWalkingWithCane,
+	Phabricator,
+	PhoenixFramework,
+	PhoenixSquadron,
+	Phone,
+	PhoneFlip,
+	PhoneSlash,
+	PhoneSquare,
+	PhoneVolume,
+	Photo,
+	PhotoFilm,
+	Php,
+	PictureO,
+	PieChart,
+	PiedPiper,
+	PiedPiperAlt,
+	PiedPiperHat,
+	PiedPiperPp,
+	PiggyBank,
+	Pills,
+	Pinterest,
+	PinterestP,
+	PinterestSquare,
+	PizzaSlice,
+	PlaceOfWorship,
+	Plane,
+	PlaneArrival,
+	PlaneDeparture,
+	Play,
+	PlayCircle,
+	PlayCircleO,
+	Playstation,
+	Plug,
+	Plus,
+	PlusCircle,
+	PlusSquare,
+	PlusSquareO,
+	Podcast,
+	Poo,
+	PooStorm,
+	Poop,
+	PowerOff,
+	Prescription,
+	PrescriptionBottle,
+	PrescriptionBottleMedical,
+	Print,
+	ProductHunt,
+	Pushed,
+	PuzzlePiece,
+	Python,
+	Qq,
+	Qrcode,
+	Question,
+	QuestionCircle,
+	QuestionCircleO,
+	Quinscape,
+	Quora,
+	QuoteLeft,
+	QuoteRight,
+	RProject,
+	Ra,
+	Radiation,
+	Radio,
+	Rainbow,
+	Random,
+	RaspberryPi,
+	Ravelry,
+	React,
+	Reacteurope,
+	Readme,
+	Rebel,
+	Receipt,
+	RecordVinyl,
+	RectangleAd,
+	RectangleList,
+	RectangleXmark,
+	Recycle,
+	RedRiver,
+	Reddit,
+	RedditAlien,
+	RedditSquare,
+	Redhat,
+	Refresh,
+	Registered,
+	Remove,
+	RemoveSign,
+	Renren,
+	Reorder,
+	Repeat,
+	RepeatAlt,
+	Reply,
+	ReplyAll,
+	Replyd,
+	Republican,
+	Researchgate,
+	Resistance,
+	Resolving,
+	Restroom,
+	Retweet,
+	Rev,
+	Ribbon,
+	RightFromBracket,
+	RightLeft,
+	RightToBracket,
+	Ring,
+	Rmb,
+	Road,
+	Robot,
+	Rocket,
+	Rocketchat,
+	Rockrms,
+	Rotate,
+	RotateLeft,
+	RotateRight,
+	Rouble,
+	Route,
+	Rss,
+	RssSquare,
+	Rub,
+	Ruble,
+	RubleSign,
+	Ruler,
+	RulerCombined,
+	RulerHorizontal,
+	RulerVertical,
+	Rupee,
+	RupeeSign,
+	S15,
+	SackDollar,
+	Safari,
+	Salesforce,
+	Sass,
+	Satellite,
+	SatelliteDish,
+	Save,
+	ScaleBalanced,
+	ScaleUnbalanced,
+	ScaleUnbalancedFlip,
+	Schlix,
+	School,
+	Scissors,
+	Screwdriver,
+	ScrewdriverWrench,
+	Scribd,
+	Scroll,
+	ScrollTorah,
+	SdCard,
+	Search,
+	SearchMinus,
+	SearchPlus,
+	Searchengin,
+	Seedling,
+	Sellcast,
+	Sellsy,
+	Send,
+	SendO,
+	Server,
+	Servicestack,
+	Shapes,
+	Share,
+	ShareAlt,
+	ShareAltSquare,
+	ShareFromSquare,
+	ShareNodes,
+	ShareSquare,
+	ShareSquareO,
+	Shekel,
+	ShekelSign,
+	Sheqel,
+	Shield,
+	ShieldHalved,
+	Ship,
+	Shirt,
+	Shirtsinbulk,
+	ShoePrints,
+	Shop,
+	ShoppingBag,
+	ShoppingBasket,
+	ShoppingCart,
+	Shopware,
+	Shower,
+	Shuffle,
+	ShuttleSpace,
+	SignHanging,
+	SignIn,
+	SignLanguage,
+	SignOut,
+	Signal,
+	Signature,
+	Signing,
+	SignsPost,
+	SimCard,
+	Simplybuilt,
+	Sistrix,
+	Sitemap,
+	Sith,
+	Sketch,
+	Skull,
+	SkullCrossbones,
+	Skyatlas,
+	Skype,
+	Slack,
+	Slash,
+	Sleigh,
+	Sliders,
+	Slideshare,
+	SmileO,
+	Smog,
+	Smoking,
+	Snapchat,
+	SnapchatGhost,
+	SnapchatSquare,
+	Snowflake,
+	SnowflakeO,
+	Snowman,
+	Snowplow,
+	SoccerBallO,
+	Socks,
+	SolarPanel,
+	Sort,
+	SortAlphaAsc,
+	SortAlphaDesc,
+	SortAmountAsc,
+	SortAmountDesc,
+	SortAsc,
+	SortDesc,
+	SortDown,
+	SortNumericAsc,
+	SortNumericDesc,
+	SortUp,
+	Soundcloud,
+	Sourcetree,
+	Spa,
+	SpaceShuttle,
+	SpaghettiMonsterFlying,
+	Speakap,
+	SpeakerDeck,
+	SpellCheck,
+	Spider,
+	Spinner,
+	Splotch,
+	Spoon,
+	Spotify,
+	SprayCan,
+	SprayCanSparkles,
+	Square,
+	SquareArrowUpRight,
+	SquareBehance,
+	SquareCaretDown,
+	SquareCaretLeft,
+	SquareCaretRight,
+	SquareCaretUp,
+	SquareCheck,
+	SquareDribbble,
+	SquareEnvelope,
+	SquareFacebook,
+	SquareFontAwesomeStroke,
+	SquareFull,
+	SquareGit,
+	SquareGithub,
+	SquareGooglePlus,
+	SquareH,
+	SquareHackerNews,
+	SquareJs,
+	SquareLastfm,
+	SquareMinus,
+	SquareO,
+	SquareOdnoklassniki,
+	SquareParking,
+	SquarePen,
+	SquarePhone,
+	SquarePhoneFlip,
+	SquarePinterest,
+	SquarePlus,
+	SquarePollHorizontal,
+	SquarePollVertical,
+	SquareReddit,
+	SquareRootVariable,
+	SquareRss,
+	SquareShareNodes,
+	SquareSnapchat,
+	SquareSteam,
+	SquareTumblr,
+	SquareTwitter,
+	SquareUpRight,
+	SquareViadeo,
+	SquareVimeo,
+	SquareWhatsapp,
+	SquareXing,
+	SquareXmark,
+	SquareYoutube,
+	Squarespace,
+	StackExchange,
+	StackOverflow,
+	Stackpath,
+	Stamp,
+	Star,
+	StarAndCrescent,
+	StarHalf,
+	StarHalfEmpty,
+	StarHalfFull,
+	StarHalfO,
+	StarHalfStroke,
+	StarO,
+	StarOfDavid,
+	StarOfLife,
+	Staylinked,
+	Steam,
+	SteamSquare,
+	SteamSymbol,
+	StepBackward,
+	StepForward,
+	SterlingSign,
+	Stethoscope,
+	StickerMule,
+	StickyNote,
+	StickyNoteO,
+	Stop,
+	StopCircle,
+	StopCircleO,
+	Stopwatch,
+	Store,
+	Strava,
+	StreetView,
+	Strikethrough,
+	Stripe,
+	StripeS,
+	Stroopwafel,
+	Studiovinari,
+	Stumbleupon,
+	StumbleuponCircle,
+	Subscript,
+	Subway,
+	Suitcase,
+	SuitcaseMedical,
+	SuitcaseRolling,
+	Sun,
+	SunO,
+	Superpowers,
+	Superscript,
+	Supple,
+	Support,
+	Suse,
+	Swatchbook,
+	Swift,
+	Symfony,
+	Synagogue,
+	Syringe,
+	Table,
+	TableCells,
+	TableCellsLarge,
+	TableColumns,
+	TableList,
+	TableTennisPaddleBall,
+	Tablet,
+	TabletButton,
+	TabletScreenButton,
+	Tablets,
+	TachographDigital,
+	Tachometer,
+	Tag,
+	Tags,
+	Tape,
+	Tasks,
+	Taxi,
+	Teamspeak,
+	Teeth,
+	TeethOpen,
+	Telegram,
+	Television,
+	TemperatureEmpty,
+	TemperatureFull,
+	TemperatureHalf,
+	TemperatureHigh,
+	TemperatureLow,
+	TemperatureQuarter,
+	TemperatureThreeQuarters,
+	TencentWeibo,
+	TengeSign,
+	Terminal,
+	TextHeight,
+	TextSlash,
+	TextWidth,
+	Th,
+	ThLarge,
+	ThList,
+	TheRedYeti,
+	Themeco,
+	Themeisle,
+	Thermometer,
+	Thermometer0,
+	Thermometer1,
+	Thermometer2,
+	Thermometer3,
+	Thermometer4,
+	ThermometerAlt,
+	ThermometerEmpty,
+	ThermometerFull,
+	ThermometerHalf,
+	ThermometerQuarter,
+	ThermometerThreeQuarters,
+	ThinkPeaks,
+	ThumbTack,
+	ThumbsDown,
+	ThumbsODown,
+	ThumbsOUp,
+	ThumbsUp,
+	Thumbtack,
+	Ticket,
+	TicketSimple,
+	Times,
+	TimesCircle,
+	TimesCircleO,
+	TimesRectangle,
+	TimesRectangleO,
+	Tint,
+	ToggleDown,
+	ToggleLeft,
+	ToggleOff,
+	ToggleOn,
+	ToggleRight,
+	ToggleUp,
+	Toilet,
+	ToiletPaper,
+	Toolbox,
+	Tooth,
+	ToriiGate,
+	Tornado,
+	TowerBroadcast,
+	Tractor,
+	TradeFederation,
+	Trademark,
+	TrafficLight,
+	Train,
+	TrainSubway,
+	Transgender,
+	TransgenderAlt,
+	Trash,
+	TrashArrowUp,
+	TrashCan,
+	TrashCanArrowUp,
+	TrashO,
+	Tree,
+	Trello,
+	TriangleExclamation,
+	Tripadvisor,
+	Trophy,
+	Truck,
+	TruckFast,
+	TruckMedical,
+	TruckMonster,
+	TruckMoving,
+	TruckPickup,
+	TruckRampBox,
+	Try,
+	Tty,
+	Tumblr,
+	TumblrSquare,
+	TurkishLira,
+	TurnDown,
+	TurnUp,
+	Tv,
+	Twitch,
+	Twitter,
+	TwitterSquare,
+	Typo3,
+	Uber,
+	Ubuntu,
+	Uikit,
+	Umbraco,
+	Umbrella,
+	UmbrellaBeach,
+	Underline,
+	Undo,
+	Uniregistry,
+	UniversalAccess,
+	University,
+	Unlink,
+	Unlock,
+	UnlockAlt,
+	UnlockKeyhole,
+	Unsorted,
+	Untappd,
+	UpDown,
+	UpDownLeftRight,
+	UpRightAndDownLeftFromCenter,
+	UpRightFromSquare,
+	Upload,
+	Ups,
+	Usb,
+	Usd,
+	User,
+	UserAstronaut,
+	UserCheck,
+	UserCircle,
+	UserCircleO,
+	UserClock,
+	UserDoctor,
+	UserGear,
+	UserGraduate,
+	UserGroup,
+	UserInjured,
+	UserLarge,
+	UserLargeSlash,
+	UserLock,
+	UserMd,
+	UserMinus,
+	UserNinja,
+	UserNurse,
+	UserO,
+	UserPen,
+	UserPlus,
+	UserSecret,
+	UserShield,
+	UserSlash,
+	UserTag,
+	UserTie,
+	UserTimes,
+	UserXmark,
+	Users,
+	UsersGear,
+	Usps,
+	Ussunnah,
+	Utensils,
+	Vaadin,
+	VanShuttle,
+	Vcard,
+	VcardO,
+	VectorSquare,
+	Venus,
+	VenusDouble,
+	VenusMars,
+	Viacoin,
+	Viadeo,
+	ViadeoSquare,
+	Vial,
+	Vials,
+	Viber,
+	Video,
+	VideoCamera,
+	VideoSlash,
+	Vihara,
+	Vimeo,
+	VimeoSquare,
+	VimeoV,
+	Vine,
+	Vk,
+	Vnv,
+	Voicemail,
+	Volcano,
+	Volleyball,
+	VolumeControlPhone,
+	VolumeDown,
+	VolumeHigh,
+	VolumeLow,
+	VolumeOff,
+	VolumeUp,
+	VolumeXmark,
+	VrCardboard,
+	Vuejs,
+	WalkieTalkie,
+	Wallet,
+	WandMagic,
+	WandSparkles,
+	Warehouse,
+	Warning,
+	Water,
+	WaterLadder,
+	WaveSquare,
+	Waze,
+	Wechat,
+	Weebly,
+	Weibo,
+	WeightHanging,
+	WeightScale,
+	Weixin,
+	Whatsapp,
+	Wheelchair,
+	WheelchairAlt,
+	WhiskeyGlass,
+	Whmcs,
+	Wifi,
+	WikipediaW,
+	Wind,
+	WindowClose,
+	WindowCloseO,
+	WindowMaximize,
+	WindowMinimize,
+	WindowRestore,
+	Windows,
+	WineBottle,
+	WineGlass,
+	WineGlassEmpty,
+	Wix,
+	WizardsOfTheCoast,
+	WolfPackBattalion,
+	Won,
+	WonSign,
+	Wordpress,
+	WordpressSimple,
+	Wpbeginner,
+	Wpexplorer,
+	Wpforms,
+	Wpressr,
+	Wrench,
+	XRay,
+	Xbox,
+	Xing,
+	XingSquare,
+	Xmark,
+	YCombinator,
+	YCombinatorSquare,
+	Yahoo,
+	Yammer,
+	Yandex,
+	YandexInternational,
+	Yarn,
+	Yc,
+	YcSquare,
+	Yelp,
+	Yen,
+	YenSign,
+	YinYang,
+	Yoast,
+	Youtube,
+	YoutubePlay,
+	YoutubeSquare,
+	Zhihu,
+}
 
 // AllGlyphs returns an iterator over all the glyphs in the fa class,
-// returned in no particular order.
+// sorted by ID.
 func AllGlyphs() iter.Seq[nf.Glyph] {
-	return maps.Values(allGlyphs)
+	return slices.Values(glyphValues[:])
 }
 
 // ByID finds a glyph by its short or full ID within the class, or an empty string
-// if the glyph is not found.
+// if the glyph is not found. Lookups do not allocate.
 func ByID(id string) nf.Glyph {
-	if glyph, ok := allGlyphs[id]; ok {
+	if glyph, ok := lookup(id); ok {
 		return glyph
 	}
 	if _, stripped, ok := strings.Cut(id, string(Class)+"-"); ok {
-		if glyph, gok := allGlyphs[stripped]; gok {
+		if glyph, gok := lookup(stripped); gok {
 			return glyph
 		}
 	}
 	return ""
 }
 
+// lookup binary searches for a glyph by its short ID.
+func lookup(id string) (nf.Glyph, bool) {
+	if i, ok := slices.BinarySearch(glyphIDs[:], id); ok {
+		return glyphValues[i], true
+	}
+	return "", false
+}
+
 // AllGlyphIDs returns an iterator over all the IDs of the glyphs in the class,
-// returned in no particular order.
+// sorted.
 func AllGlyphIDs() iter.Seq[string] {
-	return maps.Keys(allGlyphs)
+	return slices.Values(glyphIDs[:])
 }
 
 // AllGlyphFullIDs returns an iterator over all the full IDs of the glyphs in
-// the class, returned in no particular order.
+// the class, sorted.
 func AllGlyphFullIDs() iter.Seq[string] {
 	return func(yield func(string) bool) {
-		for id := range allGlyphs {
+		for _, id := range glyphIDs {
 			if !yield(string(Class) + "-" + id) {
 				return
 			}
 		}
 	}
 }
+
+// glyphSet implements [nf.GlyphSet] for the class.
+type glyphSet struct{}
+
+// Lookup returns the glyph with the given short ID, and whether it was found.
+func (glyphSet) Lookup(id string) (nf.Glyph, bool) {
+	return lookup(id)
+}
+
+// All returns an iterator over all short IDs and their glyphs, sorted by ID.
+func (glyphSet) All() iter.Seq2[string, nf.Glyph] {
+	return func(yield func(string, nf.Glyph) bool) {
+		for i, id := range glyphIDs {
+			if !yield(id, glyphValues[i]) {
+				return
+			}
+		}
+	}
+}
+
+// The class is registered with [nf.RegisterClass] on import.
+var _ = nf.MustRegisterClass(Class, glyphSet{})
@@ -8,221 +8,428 @@ package fae
 
 import (
 	"iter"
-	"maps"
+	"slices"
 	"strings"
 
 	"github.com/lrstanley/go-nf"
 )
 
-var (
-	allGlyphs = map[string]nf.Glyph{
-		"apple_fruit":      AppleFruit,
-		"atom":             Atom,
-		"bacteria":         Bacteria,
-		"banana":           Banana,
-		"bath":             Bath,
-		"bed":              Bed,
-		"benzene":          Benzene,
-		"bigger":           Bigger,
-		"biohazard":        Biohazard,
-		"blogger_circle":   BloggerCircle,
-		"blogger_square":   BloggerSquare,
-		"bones":            Bones,
-		"book_open":        BookOpen,
-		"book_open_o":      BookOpenO,
-		"brain":            Brain,
-		"bread":            Bread,
-		"butterfly":        Butterfly,
-		"carot":            Carot,
-		"cc_by":            CcBy,
-		"cc_cc":            CcCc,
-		"cc_nc":            CcNc,
-		"cc_nc_eu":         CcNcEu,
-		"cc_nc_jp":         CcNcJp,
-		"cc_nd":            CcNd,
-		"cc_remix":         CcRemix,
-		"cc_sa":            CcSa,
-		"cc_share":         CcShare,
-		"cc_zero":          CcZero,
-		"checklist_o":      ChecklistO,
-		"cheese":           Cheese,
-		"cherry":           Cherry,
-		"chess_bishop":     ChessBishop,
-		"chess_horse":      ChessHorse,
-		"chess_king":       ChessKing,
-		"chess_pawn":       ChessPawn,
-		"chess_queen":      ChessQueen,
-		"chess_tower":      ChessTower,
-		"chicken_thigh":    ChickenThigh,
-		"chilli":           Chilli,
-		"chip":             Chip,
-		"cicling":          Cicling,
-		"cloud":            Cloud,
-		"cockroach":        Cockroach,
-		"coffe_beans":      CoffeBeans,
-		"coins":            Coins,
-		"comb":             Comb,
-		"comet":            Comet,
-		"crown":            Crown,
-		"cup_coffe":        CupCoffe,
-		"dice":             Dice,
-		"disco":            Disco,
-		"dna":              Dna,
-		"donut":            Donut,
-		"dress":            Dress,
-		"drop":             Drop,
-		"ello":             Ello,
-		"envelope_open":    EnvelopeOpen,
-		"envelope_open_o":  EnvelopeOpenO,
-		"equal":            Equal,
-		"equal_bigger":     EqualBigger,
-		"feedly":           Feedly,
-		"file_export":      FileExport,
-		"file_import":      FileImport,
-		"fingerprint":      Fingerprint,
-		"floppy":           Floppy,
-		"footprint":        Footprint,
-		"freecodecamp":     Freecodecamp,
-		"galaxy":           Galaxy,
-		"galery":           Galery,
-		"gift_card":        GiftCard,
-		"glass":            Glass,
-		"google_drive":     GoogleDrive,
-		"google_play":      GooglePlay,
-		"gps":              Gps,
-		"grav":             Grav,
-		"guitar":           Guitar,
-		"gut":              Gut,
-		"halter":           Halter,
-		"hamburger":        Hamburger,
-		"hat":              Hat,
-		"hexagon":          Hexagon,
-		"high_heel":        HighHeel,
-		"hotdog":           Hotdog,
-		"ice_cream":        IceCream,
-		"id_card":          IdCard,
-		"imdb":             Imdb,
-		"infinity":         Infinity,
-		"injection":        Injection,
-		"isle":             Isle,
-		"java":             Java,
-		"layers":           Layers,
-		"lips":             Lips,
-		"lipstick":         Lipstick,
-		"liver":            Liver,
-		"lollipop":         Lollipop,
-		"loyalty_card":     LoyaltyCard,
-		"lung":             Lung,
-		"makeup_brushes":   MakeupBrushes,
-		"maximize":         Maximize,
-		"meat":             Meat,
-		"medicine":         Medicine,
-		"microscope":       Microscope,
-		"milk_bottle":      MilkBottle,
-		"minimize":         Minimize,
-		"molecule":         Molecule,
-		"moon_cloud":       MoonCloud,
-		"mountains":        Mountains,
-		"mushroom":         Mushroom,
-		"mustache":         Mustache,
-		"mysql":            Mysql,
-		"nintendo":         Nintendo,
-		"orange":           Orange,
-		"palette_color":    PaletteColor,
-		"peach":            Peach,
-		"pear":             Pear,
-		"pi":               Pi,
-		"pizza":            Pizza,
-		"planet":           Planet,
-		"plant":            Plant,
-		"playstation":      Playstation,
-		"poison":           Poison,
-		"popcorn":          Popcorn,
-		"popsicle":         Popsicle,
-		"pulse":            Pulse,
-		"python":           Python,
-		"quora_circle":     QuoraCircle,
-		"quora_square":     QuoraSquare,
-		"radioactive":      Radioactive,
-		"raining":          Raining,
-		"real_heart":       RealHeart,
-		"refrigerator":     Refrigerator,
-		"restore":          Restore,
-		"ring":             Ring,
-		"ruby":             Ruby,
-		"ruby_o":           RubyO,
-		"ruler":            Ruler,
-		"shirt":            Shirt,
-		"slash":            Slash,
-		"smaller":          Smaller,
-		"snowing":          Snowing,
-		"soda":             Soda,
-		"sofa":             Sofa,
-		"soup":             Soup,
-		"spermatozoon":     Spermatozoon,
-		"spin_double":      SpinDouble,
-		"stomach":          Stomach,
-		"storm":            Storm,
-		"sun_cloud":        SunCloud,
-		"sushi":            Sushi,
-		"tacos":            Tacos,
-		"telegram":         Telegram,
-		"telegram_circle":  TelegramCircle,
-		"telescope":        Telescope,
-		"thermometer":      Thermometer,
-		"thermometer_high": ThermometerHigh,
-		"thermometer_low":  ThermometerLow,
-		"thin_close":       ThinClose,
-		"toilet":           Toilet,
-		"tools":            Tools,
-		"tooth":            Tooth,
-		"tree":             Tree,
-		"triangle_ruler":   TriangleRuler,
-		"umbrella":         Umbrella,
-		"uterus":           Uterus,
-		"virus":            Virus,
-		"w3c":              W3C,
-		"walking":          Walking,
-		"wallet":           Wallet,
-		"wind":             Wind,
-		"xbox":             Xbox,
-	}
-)
+// glyphIDs are the IDs of all glyphs in the class, sorted, such that they can be
+// binary searched. The glyph for each ID is at the same index in glyphValues.
+//
+// Arrays of constants are laid out statically by the compiler, unlike maps,
+// which are built at init time.
+var glyphIDs = [...]string{
+	"apple_fruit",
+	"atom",
+	"bacteria",
+	"banana",
+	"bath",
+	"bed",
+	"benzene",
+	"bigger",
+	"biohazard",
+	"blogger_circle",
+	"blogger_square",
+	"bones",
+	"book_open",
+	"book_open_o",
+	"brain",
+	"bread",
+	"butterfly",
+	"carot",
+	"cc_by",
+	"cc_cc",
+	"cc_nc",
+	"cc_nc_eu",
+	"cc_nc_jp",
+	"cc_nd",
+	"cc_remix",
+	"cc_sa",
+	"cc_share",
+	"cc_zero",
+	"checklist_o",
+	"cheese",
+	"cherry",
+	"chess_bishop",
+	"chess_horse",
+	"chess_king",
+	"chess_pawn",
+	"chess_queen",
+	"chess_tower",
+	"chicken_thigh",
+	"chilli",
+	"chip",
+	"cicling",
+	"cloud",
+	"cockroach",
+	"coffe_beans",
+	"coins",
+	"comb",
+	"comet",
+	"crown",
+	"cup_coffe",
+	"dice",
+	"disco",
+	"dna",
+	"donut",
+	"dress",
+	"drop",
+	"ello",
+	"envelope_open",
+	"envelope_open_o",
+	"equal",
+	"equal_bigger",
+	"feedly",
+	"file_export",
+	"file_import",
+	"fingerprint",
+	"floppy",
+	"footprint",
+	"freecodecamp",
+	"galaxy",
+	"galery",
+	"gift_card",
+	"glass",
+	"google_drive",
+	"google_play",
+	"gps",
+	"grav",
+	"guitar",
+	"gut",
+	"halter",
+	"hamburger",
+	"hat",
+	"hexagon",
+	"high_heel",
+	"hotdog",
+	"ice_cream",
+	"id_card",
+	"imdb",
+	"infinity",
+	"injection",
+	"isle",
+	"java",
+	"layers",
+	"lips",
+	"lipstick",
+	"liver",
+	"lollipop",
+	"loyalty_card",
+	"lung",
+	"makeup_brushes",
+	"maximize",
+	"meat",
+	"medicine",
+	"microscope",
+	"milk_bottle",
+	"minimize",
+	"molecule",
+	"moon_cloud",
+	"mountains",
+	"mushroom",
+	"mustache",
+	"mysql",
+	"nintendo",
+	"orange",
+	"palette_color",
+	"peach",
+	"pear",
+	"pi",
+	"pizza",
+	"planet",
+	"plant",
+	"playstation",
+	"poison",
+	"popcorn",
+	"popsicle",
+	"pulse",
+	"python",
+	"quora_circle",
+	"quora_square",
+	"radioactive",
+	"raining",
+	"real_heart",
+	"refrigerator",
+	"restore",
+	"ring",
+	"ruby",
+	"ruby_o",
+	"ruler",
+	"shirt",
+	"slash",
+	"smaller",
+	"snowing",
+	"soda",
+	"sofa",
+	"soup",
+	"spermatozoon",
+	"spin_double",
+	"stomach",
+	"storm",
+	"sun_cloud",
+	"sushi",
+	"tacos",
+	"telegram",
+	"telegram_circle",
+	"telescope",
+	"thermometer",
+	"thermometer_high",
+	"thermometer_low",
+	"thin_close",
+	"toilet",
+	"tools",
+	"tooth",
+	"tree",
+	"triangle_ruler",
+	"umbrella",
+	"uterus",
+	"virus",
+	"w3c",
+	"walking",
+	"wallet",
+	"wind",
+	"xbox",
+}
+
+// glyphValues are the glyphs in the class, in the same order as glyphIDs.
+var glyphValues = [len(glyphIDs)]nf.Glyph{
+	AppleFruit,
+	Atom,
+	Bacteria,
+	Banana,
+	Bath,
+	Bed,
+	Benzene,
+	Bigger,
+	Biohazard,
+	BloggerCircle,
+	BloggerSquare,
+	Bones,
+	BookOpen,
+	BookOpenO,
+	Brain,
+	Bread,
+	Butterfly,
+	Carot,
+	CcBy,
+	CcCc,
+	CcNc,
+	CcNcEu,
+	CcNcJp,
+	CcNd,
+	CcRemix,
+	CcSa,
+	CcShare,
+	CcZero,
+	ChecklistO,
+	Cheese,
+	Cherry,
+	ChessBishop,
+	ChessHorse,
+	ChessKing,
+	ChessPawn,
+	ChessQueen,
+	ChessTower,
+	ChickenThigh,
+	Chilli,
+	Chip,
+	Cicling,
+	Cloud,
+	Cockroach,
+	CoffeBeans,
+	Coins,
+	Comb,
+	Comet,
+	Crown,
+	CupCoffe,
+	Dice,
+	Disco,
+	Dna,
+	Donut,
+	Dress,
+	Drop,
+	Ello,
+	EnvelopeOpen,
+	EnvelopeOpenO,
+	Equal,
+	EqualBigger,
+	Feedly,
+	FileExport,
+	FileImport,
+	Fingerprint,
+	Floppy,
+	Footprint,
+	Freecodecamp,
+	Galaxy,
+	Galery,
+	GiftCard,
+	Glass,
+	GoogleDrive,
+	GooglePlay,
+	Gps,
+	Grav,
+	Guitar,
+	Gut,
+	Halter,
+	Hamburger,
+	Hat,
+	Hexagon,
+	HighHeel,
+	Hotdog,
+	IceCream,
+	IdCard,
+	Imdb,
+	Infinity,
+	Injection,
+	Isle,
+	Java,
+	Layers,
+	Lips,
+	Lipstick,
+	Liver,
+	Lollipop,
+	LoyaltyCard,
+	Lung,
+	MakeupBrushes,
+	Maximize,
+	Meat,
+	Medicine,
+	Microscope,
+	MilkBottle,
+	Minimize,
+	Molecule,
+	MoonCloud,
+	Mountains,
+	Mushroom,
+	Mustache,
+	Mysql,
+	Nintendo,
+	Orange,
+	PaletteColor,
+	Peach,
+	Pear,
+	Pi,
+	Pizza,
+	Planet,
+	Plant,
+	Playstation,
+	Poison,
+	Popcorn,
+	Popsicle,
+	Pulse,
+	Python,
+	QuoraCircle,
+	QuoraSquare,
+	Radioactive,
+	Raining,
+	RealHeart,
+	Refrigerator,
+	Restore,
+	Ring,
+	Ruby,
+	RubyO,
+	Ruler,
+	Shirt,
+	Slash,
+	Smaller,
+	Snowing,
+	Soda,
+	Sofa,
+	Soup,
+	Spermatozoon,
+	SpinDouble,
+	Stomach,
+	Storm,
+	SunCloud,
+	Sushi,
+	Tacos,
+	Telegram,
+	TelegramCircle,
+	Telescope,
+	Thermometer,
+	ThermometerHigh,
+	ThermometerLow,
+	ThinClose,
+	Toilet,
+	Tools,
+	Tooth,
+	Tree,
+	TriangleRuler,
+	Umbrella,
+	Uterus,
+	Virus,
+	W3C,
+	Walking,
+	Wallet,
+	Wind,
+	Xbox,
+}
 
 // AllGlyphs returns an iterator over all the glyphs in the fae class,
-// returned in no particular order.
+// sorted by ID.
 func AllGlyphs() iter.Seq[nf.Glyph] {
-	return maps.Values(allGlyphs)
+	return slices.Values(glyphValues[:])
 }
 
 // ByID finds a glyph by its short or full ID within the class, or an empty string
-// if the glyph is not found.
+// if the glyph is not found. Lookups do not allocate.
 func ByID(id string) nf.Glyph {
-	if glyph, ok := allGlyphs[id]; ok {
+	if glyph, ok := lookup(id); ok {
 		return glyph
 	}
 	if _, stripped, ok := strings.Cut(id, string(Class)+"-"); ok {
-		if glyph, gok := allGlyphs[stripped]; gok {
+		if glyph, gok := lookup(stripped); gok {
 			return glyph
 		}
 	}
 	return ""
 }
 
+// lookup binary searches for a glyph by its short ID.
+func lookup(id string) (nf.Glyph, bool) {
+	if i, ok := slices.BinarySearch(glyphIDs[:], id); ok {
+		return glyphValues[i], true
+	}
+	return "", false
+}
+
 // AllGlyphIDs returns an iterator over all the IDs of the glyphs in the class,
-// returned in no particular order.
+// sorted.
 func AllGlyphIDs() iter.Seq[string] {
-	return maps.Keys(allGlyphs)
+	return slices.Values(glyphIDs[:])
 }
 
 // AllGlyphFullIDs returns an iterator over all the full IDs of the glyphs in
-// the class, returned in no particular order.
+// the class, sorted.
 func AllGlyphFullIDs() iter.Seq[string] {
 	return func(yield func(string) bool) {
-		for id := range allGlyphs {
+		for _, id := range glyphIDs {
 			if !yield(string(Class) + "-" + id) {
 				return
 			}
 		}
 	}
 }
+
+// glyphSet implements [nf.GlyphSet] for the class.
+type glyphSet struct{}
+
+// Lookup returns the glyph with the given short ID, and whether it was found.
+func (glyphSet) Lookup(id string) (nf.Glyph, bool) {
+	return lookup(id)
+}
+
+// All returns an iterator over all short IDs and their glyphs, sorted by ID.
+func (glyphSet) All() iter.Seq2[string, nf.Glyph] {
+	return func(yield func(string, nf.Glyph) bool) {
+		for i, id := range glyphIDs {
+			if !yield(id, glyphValues[i]) {
+				return
+			}
+		}
+	}
+}
+
+// The class is registered with [nf.RegisterClass] on import.
+var _ = nf.MustRegisterClass(Class, glyphSet{})
@@ -8,181 +8,348 @@ package linux
 
 import (
 	"iter"
-	"maps"
+	"slices"
 	"strings"
 
 	"github.com/lrstanley/go-nf"
 )
 
-var (
-	allGlyphs = map[string]nf.Glyph{
-		"almalinux":          Almalinux,
-		"alpine":             Alpine,
-		"aosc":               Aosc,
-		"apple":              Apple,
-		"archcraft":          Archcraft,
-		"archlabs":           Archlabs,
-		"archlinux":          Archlinux,
-		"arcolinux":          Arcolinux,
-		"arduino":            Arduino,
-		"artix":              Artix,
-		"awesome":            Awesome,
-		"biglinux":           Biglinux,
-		"bspwm":              Bspwm,
-		"budgie":             Budgie,
-		"centos":             Centos,
-		"cinnamon":           Cinnamon,
-		"codeberg":           Codeberg,
-		"coreos":             Coreos,
-		"crystal":            Crystal,
-		"debian":             Debian,
-		"deepin":             Deepin,
-		"devuan":             Devuan,
-		"docker":             Docker,
-		"dwm":                Dwm,
-		"elementary":         Elementary,
-		"endeavour":          Endeavour,
-		"enlightenment":      Enlightenment,
-		"fdroid":             Fdroid,
-		"fedora":             Fedora,
-		"fedora_inverse":     FedoraInverse,
-		"ferris":             Ferris,
-		"flathub":            Flathub,
-		"fluxbox":            Fluxbox,
-		"forgejo":            Forgejo,
-		"fosdem":             Fosdem,
-		"freebsd":            Freebsd,
-		"freecad":            Freecad,
-		"freedesktop":        Freedesktop,
-		"garuda":             Garuda,
-		"gentoo":             Gentoo,
-		"gimp":               Gimp,
-		"gitea":              Gitea,
-		"gnome":              Gnome,
-		"gnu_guix":           GnuGuix,
-		"gtk":                Gtk,
-		"hyperbola":          Hyperbola,
-		"hyprland":           Hyprland,
-		"i3":                 I3,
-		"illumos":            Illumos,
-		"inkscape":           Inkscape,
-		"jwm":                Jwm,
-		"kali_linux":         KaliLinux,
-		"kde":                Kde,
-		"kde_neon":           KdeNeon,
-		"kde_plasma":         KdePlasma,
-		"kdenlive":           Kdenlive,
-		"kicad":              Kicad,
-		"krita":              Krita,
-		"kubuntu":            Kubuntu,
-		"kubuntu_inverse":    KubuntuInverse,
-		"leap":               Leap,
-		"libreoffice":        Libreoffice,
-		"libreofficebase":    Libreofficebase,
-		"libreofficecalc":    Libreofficecalc,
-		"libreofficedraw":    Libreofficedraw,
-		"libreofficeimpress": Libreofficeimpress,
-		"libreofficemath":    Libreofficemath,
-		"libreofficewriter":  Libreofficewriter,
-		"linuxmint":          Linuxmint,
-		"linuxmint_inverse":  LinuxmintInverse,
-		"locos":              Locos,
-		"lxde":               Lxde,
-		"lxle":               Lxle,
-		"lxqt":               Lxqt,
-		"mageia":             Mageia,
-		"mandriva":           Mandriva,
-		"manjaro":            Manjaro,
-		"mate":               Mate,
-		"mpv":                Mpv,
-		"mxlinux":            Mxlinux,
-		"neovim":             Neovim,
-		"nixos":              Nixos,
-		"nobara":             Nobara,
-		"octoprint":          Octoprint,
-		"openbsd":            Openbsd,
-		"openscad":           Openscad,
-		"opensuse":           Opensuse,
-		"osh":                Osh,
-		"oshwa":              Oshwa,
-		"osi":                Osi,
-		"parabola":           Parabola,
-		"parrot":             Parrot,
-		"pop_os":             PopOs,
-		"postmarketos":       Postmarketos,
-		"prusaslicer":        Prusaslicer,
-		"puppy":              Puppy,
-		"qt":                 Qt,
-		"qtile":              Qtile,
-		"qubesos":            Qubesos,
-		"raspberry_pi":       RaspberryPi,
-		"redhat":             Redhat,
-		"reprap":             Reprap,
-		"riscv":              Riscv,
-		"river":              River,
-		"rocky_linux":        RockyLinux,
-		"sabayon":            Sabayon,
-		"slackware":          Slackware,
-		"slackware_inverse":  SlackwareInverse,
-		"snappy":             Snappy,
-		"solus":              Solus,
-		"sway":               Sway,
-		"tails":              Tails,
-		"thunderbird":        Thunderbird,
-		"tor":                Tor,
-		"trisquel":           Trisquel,
-		"tumbleweed":         Tumbleweed,
-		"tux":                Tux,
-		"typst":              Typst,
-		"ubuntu":             Ubuntu,
-		"ubuntu_inverse":     UbuntuInverse,
-		"vanilla":            Vanilla,
-		"void":               Void,
-		"vscodium":           Vscodium,
-		"wayland":            Wayland,
-		"wikimedia":          Wikimedia,
-		"xerolinux":          Xerolinux,
-		"xfce":               Xfce,
-		"xmonad":             Xmonad,
-		"xorg":               Xorg,
-		"zorin":              Zorin,
-	}
-)
+// glyphIDs are the IDs of all glyphs in the class, sorted, such that they can be
+// binary searched. The glyph for each ID is at the same index in glyphValues.
+//
+// Arrays of constants are laid out statically by the compiler, unlike maps,
+// which are built at init time.
+var glyphIDs = [...]string{
+	"almalinux",
+	"alpine",
+	"aosc",
+	"apple",
+	"archcraft",
+	"archlabs",
+	"archlinux",
+	"arcolinux",
+	"arduino",
+	"artix",
+	"awesome",
+	"biglinux",
+	"bspwm",
+	"budgie",
+	"centos",
+	"cinnamon",
+	"codeberg",
+	"coreos",
+	"crystal",
+	"debian",
+	"deepin",
+	"devuan",
+	"docker",
+	"dwm",
+	"elementary",
+	"endeavour",
+	"enlightenment",
+	"fdroid",
+	"fedora",
+	"fedora_inverse",
+	"ferris",
+	"flathub",
+	"fluxbox",
+	"forgejo",
+	"fosdem",
+	"freebsd",
+	"freecad",
+	"freedesktop",
+	"garuda",
+	"gentoo",
+	"gimp",
+	"gitea",
+	"gnome",
+	"gnu_guix",
+	"gtk",
+	"hyperbola",
+	"hyprland",
+	"i3",
+	"illumos",
+	"inkscape",
+	"jwm",
+	"kali_linux",
+	"kde",
+	"kde_neon",
+	"kde_plasma",
+	"kdenlive",
+	"kicad",
+	"krita",
+	"kubuntu",
+	"kubuntu_inverse",
+	"leap",
+	"libreoffice",
+	"libreofficebase",
+	"libreofficecalc",
+	"libreofficedraw",
+	"libreofficeimpress",
+	"libreofficemath",
+	"libreofficewriter",
+	"linuxmint",
+	"linuxmint_inverse",
+	"locos",
+	"lxde",
+	"lxle",
+	"lxqt",
+	"mageia",
+	"mandriva",
+	"manjaro",
+	"mate",
+	"mpv",
+	"mxlinux",
+	"neovim",
+	"nixos",
+	"nobara",
+	"octoprint",
+	"openbsd",
+	"openscad",
+	"opensuse",
+	"osh",
+	"oshwa",
+	"osi",
+	"parabola",
+	"parrot",
+	"pop_os",
+	"postmarketos",
+	"prusaslicer",
+	"puppy",
+	"qt",
+	"qtile",
+	"qubesos",
+	"raspberry_pi",
+	"redhat",
+	"reprap",
+	"riscv",
+	"river",
+	"rocky_linux",
+	"sabayon",
+	"slackware",
+	"slackware_inverse",
+	"snappy",
+	"solus",
+	"sway",
+	"tails",
+	"thunderbird",
+	"tor",
+	"trisquel",
+	"tumbleweed",
+	"tux",
+	"typst",
+	"ubuntu",
+	"ubuntu_inverse",
+	"vanilla",
+	"void",
+	"vscodium",
+	"wayland",
+	"wikimedia",
+	"xerolinux",
+	"xfce",
+	"xmonad",
+	"xorg",
+	"zorin",
+}
+
+// glyphValues are the glyphs in the class, in the same order as glyphIDs.
+var glyphValues = [len(glyphIDs)]nf.Glyph{
+	Almalinux,
+	Alpine,
+	Aosc,
+	Apple,
+	Archcraft,
+	Archlabs,
+	Archlinux,
+	Arcolinux,
+	Arduino,
+	Artix,
+	Awesome,
+	Biglinux,
+	Bspwm,
+	Budgie,
+	Centos,
+	Cinnamon,
+	Codeberg,
+	Coreos,
+	Crystal,
+	Debian,
+	Deepin,
+	Devuan,
+	Docker,
+	Dwm,
+	Elementary,
+	Endeavour,
+	Enlightenment,
+	Fdroid,
+	Fedora,
+	FedoraInverse,
+	Ferris,
+	Flathub,
+	Fluxbox,
+	Forgejo,
+	Fosdem,
+	Freebsd,
+	Freecad,
+	Freedesktop,
+	Garuda,
+	Gentoo,
+	Gimp,
+	Gitea,
+	Gnome,
+	GnuGuix,
+	Gtk,
+	Hyperbola,
+	Hyprland,
+	I3,
+	Illumos,
+	Inkscape,
+	Jwm,
+	KaliLinux,
+	Kde,
+	KdeNeon,
+	KdePlasma,
+	Kdenlive,
+	Kicad,
+	Krita,
+	Kubuntu,
+	KubuntuInverse,
+	Leap,
+	Libreoffice,
+	Libreofficebase,
+	Libreofficecalc,
+	Libreofficedraw,
+	Libreofficeimpress,
+	Libreofficemath,
+	Libreofficewriter,
+	Linuxmint,
+	LinuxmintInverse,
+	Locos,
+	Lxde,
+	Lxle,
+	Lxqt,
+	Mageia,
+	Mandriva,
+	Manjaro,
+	Mate,
+	Mpv,
+	Mxlinux,
+	Neovim,
+	Nixos,
+	Nobara,
+	Octoprint,
+	Openbsd,
+	Openscad,
+	Opensuse,
+	Osh,
+	Oshwa,
+	Osi,
+	Parabola,
+	Parrot,
+	PopOs,
+	Postmarketos,
+	Prusaslicer,
+	Puppy,
+	Qt,
+	Qtile,
+	Qubesos,
+	RaspberryPi,
+	Redhat,
+	Reprap,
+	Riscv,
+	River,
+	RockyLinux,
+	Sabayon,
+	Slackware,
+	SlackwareInverse,
+	Snappy,
+	Solus,
+	Sway,
+	Tails,
+	Thunderbird,
+	Tor,
+	Trisquel,
+	Tumbleweed,
+	Tux,
+	Typst,
+	Ubuntu,
+	UbuntuInverse,
+	Vanilla,
+	Void,
+	Vscodium,
+	Wayland,
+	Wikimedia,
+	Xerolinux,
+	Xfce,
+	Xmonad,
+	Xorg,
+	Zorin,
+}
 
 // AllGlyphs returns an iterator over all the glyphs in the linux class,
-// returned in no particular order.
+// sorted by ID.
 func AllGlyphs() iter.Seq[nf.Glyph] {
-	return maps.Values(allGlyphs)
+	return slices.Values(glyphValues[:])
 }
 
 // ByID finds a glyph by its short or full ID within the class, or an empty string
-// if the glyph is not found.
+// if the glyph is not found. Lookups do not allocate.
 func ByID(id string) nf.Glyph {
-	if glyph, ok := allGlyphs[id]; ok {
+	if glyph, ok := lookup(id); ok {
 		return glyph
 	}
 	if _, stripped, ok := strings.Cut(id, string(Class)+"-"); ok {
-		if glyph, gok := allGlyphs[stripped]; gok {
+		if glyph, gok := lookup(stripped); gok {
 			return glyph
 		}
 	}
 	return ""
 }
 
+// lookup binary searches for a glyph by its short ID.
+func lookup(id string) (nf.Glyph, bool) {
+	if i, ok := slices.BinarySearch(glyphIDs[:], id); ok {
+		return glyphValues[i], true
+	}
+	return "", false
+}
+
 // AllGlyphIDs returns an iterator over all the IDs of the glyphs in the class,
-// returned in no particular order.
+// sorted.
 func AllGlyphIDs() iter.Seq[string] {
-	return maps.Keys(allGlyphs)
+	return slices.Values(glyphIDs[:])
 }
 
 // AllGlyphFullIDs returns an iterator over all the full IDs of the glyphs in
-// the class, returned in no particular order.
+// the class, sorted.
 func AllGlyphFullIDs() iter.Seq[string] {
 	return func(yield func(string) bool) {
-		for id := range allGlyphs {
+		for _, id := range glyphIDs {
 			if !yield(string(Class) + "-" + id) {
 				return
 			}
 		}
 	}
 }
+
+// glyphSet implements [nf.GlyphSet] for the class.
+type glyphSet struct{}
+
+// Lookup returns the glyph with the given short ID, and whether it was found.
+func (glyphSet) Lookup(id string) (nf.Glyph, bool) {
+	return lookup(id)
+}
+
+// All returns an iterator over all short IDs and their glyphs, sorted by ID.
+func (glyphSet) All() iter.Seq2[string, nf.Glyph] {
+	return func(yield func(string, nf.Glyph) bool) {
+		for i, id := range glyphIDs {
+			if !yield(id, glyphValues[i]) {
+				return
+			}
+		}
+	}
+}
+
+// The class is registered with [nf.RegisterClass] on import.
+var _ = nf.MustRegisterClass(Class, glyphSet{})
@@ -8,6931 +8,13848 @@ package md
 
 import (
 	"iter"
-	"maps"
+	"slices"
 	"strings"
 
 	"github.com/lrstanley/go-nf"
 )
 
-var (
-	allGlyphs = map[string]nf.Glyph{
-		"ab_testing":                              AbTesting,
-		"abacus":                                  Abacus,
-		"abjad_arabic":                            AbjadArabic,
-		"abjad_hebrew":                            AbjadHebrew,
-		"abugida_devanagari":                      AbugidaDevanagari,
-		"abugida_thai":                            AbugidaThai,
-		"access_point":                            AccessPoint,
-		"access_point_check":                      AccessPointCheck,
-		"access_point_minus":                      AccessPointMinus,
-		"access_point_network":                    AccessPointNetwork,
-		"access_point_network_off":                AccessPointNetworkOff,
-		"access_point_off":                        AccessPointOff,
-		"access_point_plus":                       AccessPointPlus,
-		"access_point_remove":                     AccessPointRemove,
-		"account":                                 Account,
-		"account_alert":                           AccountAlert,
-		"account_alert_outline":                   AccountAlertOutline,
-		"account_arrow_down":                      AccountArrowDown,
-		"account_arrow_down_outline":              AccountArrowDownOutline,
-		"account_arrow_left":                      AccountArrowLeft,
-		"account_arrow_left_outline":              AccountArrowLeftOutline,
-		"account_arrow_right":                     AccountArrowRight,
-		"account_arrow_right_outline":             AccountArrowRightOutline,
-		"account_arrow_up":                        AccountArrowUp,
-		"account_arrow_up_outline":                AccountArrowUpOutline,
-		"account_box":                             AccountBox,
-		"account_box_multiple":                    AccountBoxMultiple,
-		"account_box_multiple_outline":            AccountBoxMultipleOutline,
-		"account_box_outline":                     AccountBoxOutline,
-		"account_cancel":                          AccountCancel,
-		"account_cancel_outline":                  AccountCancelOutline,
-		"account_cash":                            AccountCash,
-		"account_cash_outline":                    AccountCashOutline,
-		"account_check":                           AccountCheck,
-		"account_check_outline":                   AccountCheckOutline,
-		"account_child":                           AccountChild,
-		"account_child_circle":                    AccountChildCircle,
-		"account_child_outline":                   AccountChildOutline,
-		"account_circle":                          AccountCircle,
-		"account_circle_outline":                  AccountCircleOutline,
-		"account_clock":                           AccountClock,
-		"account_clock_outline":                   AccountClockOutline,
-		"account_cog":                             AccountCog,
-		"account_cog_outline":                     AccountCogOutline,
-		"account_convert":                         AccountConvert,
-		"account_convert_outline":                 AccountConvertOutline,
-		"account_cowboy_hat":                      AccountCowboyHat,
-		"account_cowboy_hat_outline":              AccountCowboyHatOutline,
-		"account_details":                         AccountDetails,
-		"account_details_outline":                 AccountDetailsOutline,
-		"account_edit":                            AccountEdit,
-		"account_edit_outline":                    AccountEditOutline,
-		"account_eye":                             AccountEye,
-		"account_eye_outline":                     AccountEyeOutline,
-		"account_filter":                          AccountFilter,
-		"account_filter_outline":                  AccountFilterOutline,
-		"account_group":                           AccountGroup,
-		"account_group_outline":                   AccountGroupOutline,
-		"account_hard_hat":                        AccountHardHat,
-		"account_hard_hat_outline":                AccountHardHatOutline,
-		"account_heart":                           AccountHeart,
-		"account_heart_outline":                   AccountHeartOutline,
-		"account_injury":                          AccountInjury,
-		"account_injury_outline":                  AccountInjuryOutline,
-		"account_key":                             AccountKey,
-		"account_key_outline":                     AccountKeyOutline,
-		"account_lock":                            AccountLock,
-		"account_lock_open":                       AccountLockOpen,
-		"account_lock_open_outline":               AccountLockOpenOutline,
-		"account_lock_outline":                    AccountLockOutline,
-		"account_minus":                           AccountMinus,
-		"account_minus_outline":                   AccountMinusOutline,
-		"account_multiple":                        AccountMultiple,
-		"account_multiple_check":                  AccountMultipleCheck,
-		"account_multiple_check_outline":          AccountMultipleCheckOutline,
-		"account_multiple_minus":                  AccountMultipleMinus,
-		"account_multiple_minus_outline":          AccountMultipleMinusOutline,
-		"account_multiple_outline":                AccountMultipleOutline,
-		"account_multiple_plus":                   AccountMultiplePlus,
-		"account_multiple_plus_outline":           AccountMultiplePlusOutline,
-		"account_multiple_remove":                 AccountMultipleRemove,
-		"account_multiple_remove_outline":         AccountMultipleRemoveOutline,
-		"account_music":                           AccountMusic,
-		"account_music_outline":                   AccountMusicOutline,
-		"account_network":                         AccountNetwork,
-		"account_network_outline":                 AccountNetworkOutline,
-		"account_off":                             AccountOff,
-		"account_off_outline":                     AccountOffOutline,
-		"account_outline":                         AccountOutline,
-		"account_plus":                            AccountPlus,
-		"account_plus_outline":                    AccountPlusOutline,
-		"account_question":                        AccountQuestion,
-		"account_question_outline":                AccountQuestionOutline,
-		"account_reactivate":                      AccountReactivate,
-		"account_reactivate_outline":              AccountReactivateOutline,
-		"account_remove":                          AccountRemove,
-		"account_remove_outline":                  AccountRemoveOutline,
-		"account_school":                          AccountSchool,
-		"account_school_outline":                  AccountSchoolOutline,
-		"account_search":                          AccountSearch,
-		"account_search_outline":                  AccountSearchOutline,
-		"account_settings":                        AccountSettings,
-		"account_settings_outline":                AccountSettingsOutline,
-		"account_star":                            AccountStar,
-		"account_star_outline":                    AccountStarOutline,
-		"account_supervisor":                      AccountSupervisor,
-		"account_supervisor_circle":               AccountSupervisorCircle,
-		"account_supervisor_circle_outline":       AccountSupervisorCircleOutline,
-		"account_supervisor_outline":              AccountSupervisorOutline,
-		"account_switch":                          AccountSwitch,
-		"account_switch_outline":                  AccountSwitchOutline,
-		"account_sync":                            AccountSync,
-		"account_sync_outline":                    AccountSyncOutline,
-		"account_tie":                             AccountTie,
-		"account_tie_hat":                         AccountTieHat,
-		"account_tie_hat_outline":                 AccountTieHatOutline,
-		"account_tie_outline":                     AccountTieOutline,
-		"account_tie_voice":                       AccountTieVoice,
-		"account_tie_voice_off":                   AccountTieVoiceOff,
-		"account_tie_voice_off_outline":           AccountTieVoiceOffOutline,
-		"account_tie_voice_outline":               AccountTieVoiceOutline,
-		"account_tie_woman":                       AccountTieWoman,
-		"account_voice":                           AccountVoice,
-		"account_voice_off":                       AccountVoiceOff,
-		"account_wrench":                          AccountWrench,
-		"account_wrench_outline":                  AccountWrenchOutline,
-		"adjust":                                  Adjust,
-		"advertisements":                          Advertisements,
-		"advertisements_off":                      AdvertisementsOff,
-		"air_conditioner":                         AirConditioner,
-		"air_filter":                              AirFilter,
-		"air_horn":                                AirHorn,
-		"air_humidifier":                          AirHumidifier,
-		"air_humidifier_off":                      AirHumidifierOff,
-		"air_purifier":                            AirPurifier,
-		"airbag":                                  Airbag,
-		"airballoon":                              Airballoon,
-		"airballoon_outline":                      AirballoonOutline,
-		"airplane":                                Airplane,
-		"airplane_alert":                          AirplaneAlert,
-		"airplane_check":                          AirplaneCheck,
-		"airplane_clock":                          AirplaneClock,
-		"airplane_cog":                            AirplaneCog,
-		"airplane_edit":                           AirplaneEdit,
-		"airplane_landing":                        AirplaneLanding,
-		"airplane_marker":                         AirplaneMarker,
-		"airplane_minus":                          AirplaneMinus,
-		"airplane_off":                            AirplaneOff,
-		"airplane_plus":                           AirplanePlus,
-		"airplane_remove":                         AirplaneRemove,
-		"airplane_search":                         AirplaneSearch,
-		"airplane_settings":                       AirplaneSettings,
-		"airplane_takeoff":                        AirplaneTakeoff,
-		"airport":                                 Airport,
-		"alarm":                                   Alarm,
-		"alarm_bell":                              AlarmBell,
-		"alarm_check":                             AlarmCheck,
-		"alarm_light":                             AlarmLight,
-		"alarm_light_off":                         AlarmLightOff,
-		"alarm_light_off_outline":                 AlarmLightOffOutline,
-		"alarm_light_outline":                     AlarmLightOutline,
-		"alarm_multiple":                          AlarmMultiple,
-		"alarm_note":                              AlarmNote,
-		"alarm_note_off":                          AlarmNoteOff,
-		"alarm_off":                               AlarmOff,
-		"alarm_panel":                             AlarmPanel,
-		"alarm_panel_outline":                     AlarmPanelOutline,
-		"alarm_plus":                              AlarmPlus,
-		"alarm_snooze":                            AlarmSnooze,
-		"album":                                   Album,
-		"alert":                                   Alert,
-		"alert_box":                               AlertBox,
-		"alert_box_outline":                       AlertBoxOutline,
-		"alert_circle":                            AlertCircle,
-		"alert_circle_check":                      AlertCircleCheck,
-		"alert_circle_check_outline":              AlertCircleCheckOutline,
-		"alert_circle_outline":                    AlertCircleOutline,
-		"alert_decagram":                          AlertDecagram,
-		"alert_decagram_outline":                  AlertDecagramOutline,
-		"alert_minus":                             AlertMinus,
-		"alert_minus_outline":                     AlertMinusOutline,
-		"alert_octagon":                           AlertOctagon,
-		"alert_octagon_outline":                   AlertOctagonOutline,
-		"alert_octagram":                          AlertOctagram,
-		"alert_octagram_outline":                  AlertOctagramOutline,
-		"alert_outline":                           AlertOutline,
-		"alert_plus":                              AlertPlus,
-		"alert_plus_outline":                      AlertPlusOutline,
-		"alert_remove":                            AlertRemove,
-		"alert_remove_outline":                    AlertRemoveOutline,
-		"alert_rhombus":                           AlertRhombus,
-		"alert_rhombus_outline":                   AlertRhombusOutline,
-		"alien":                                   Alien,
-		"alien_outline":                           AlienOutline,
-		"align_horizontal_center":                 AlignHorizontalCenter,
-		"align_horizontal_distribute":             AlignHorizontalDistribute,
-		"align_horizontal_left":                   AlignHorizontalLeft,
-		"align_horizontal_right":                  AlignHorizontalRight,
-		"align_vertical_bottom":                   AlignVerticalBottom,
-		"align_vertical_center":                   AlignVerticalCenter,
-		"align_vertical_distribute":               AlignVerticalDistribute,
-		"align_vertical_top":                      AlignVerticalTop,
-		"all_inclusive":                           AllInclusive,
-		"all_inclusive_box":                       AllInclusiveBox,
-		"all_inclusive_box_outline":               AllInclusiveBoxOutline,
-		"allergy":                                 Allergy,
-		"alpha":                                   Alpha,
-		"alpha_a":                                 AlphaA,
-		"alpha_a_box":                             AlphaABox,
-		"alpha_a_box_outline":                     AlphaABoxOutline,
-		"alpha_a_circle":                          AlphaACircle,
-		"alpha_a_circle_outline":                  AlphaACircleOutline,
-		"alpha_b":                                 AlphaB,
-		"alpha_b_box":                             AlphaBBox,
-		"alpha_b_box_outline":                     AlphaBBoxOutline,
-		"alpha_b_circle":                          AlphaBCircle,
-		"alpha_b_circle_outline":                  AlphaBCircleOutline,
-		"alpha_c":                                 AlphaC,
-		"alpha_c_box":                             AlphaCBox,
-		"alpha_c_box_outline":                     AlphaCBoxOutline,
-		"alpha_c_circle":                          AlphaCCircle,
-		"alpha_c_circle_outline":                  AlphaCCircleOutline,
-		"alpha_d":                                 AlphaD,
-		"alpha_d_box":                             AlphaDBox,
-		"alpha_d_box_outline":                     AlphaDBoxOutline,
-		"alpha_d_circle":                          AlphaDCircle,
-		"alpha_d_circle_outline":                  AlphaDCircleOutline,
-		"alpha_e":                                 AlphaE,
-		"alpha_e_box":                             AlphaEBox,
-		"alpha_e_box_outline":                     AlphaEBoxOutline,
-		"alpha_e_circle":                          AlphaECircle,
-		"alpha_e_circle_outline":                  AlphaECircleOutline,
-		"alpha_f":                                 AlphaF,
-		"alpha_f_box":                             AlphaFBox,
-		"alpha_f_box_outline":                     AlphaFBoxOutline,
-		"alpha_f_circle":                          AlphaFCircle,
-		"alpha_f_circle_outline":                  AlphaFCircleOutline,
-		"alpha_g":                                 AlphaG,
-		"alpha_g_box":                             AlphaGBox,
-		"alpha_g_box_outline":                     AlphaGBoxOutline,
-		"alpha_g_circle":                          AlphaGCircle,
-		"alpha_g_circle_outline":                  AlphaGCircleOutline,
-		"alpha_h":                                 AlphaH,
-		"alpha_h_box":                             AlphaHBox,
-		"alpha_h_box_outline":                     AlphaHBoxOutline,
-		"alpha_h_circle":                          AlphaHCircle,
-		"alpha_h_circle_outline":                  AlphaHCircleOutline,
-		"alpha_i":                                 AlphaI,
-		"alpha_i_box":                             AlphaIBox,
-		"alpha_i_box_outline":                     AlphaIBoxOutline,
-		"alpha_i_circle":                          AlphaICircle,
-		"alpha_i_circle_outline":                  AlphaICircleOutline,
-		"alpha_j":                                 AlphaJ,
-		"alpha_j_box":                             AlphaJBox,
-		"alpha_j_box_outline":                     AlphaJBoxOutline,
-		"alpha_j_circle":                          AlphaJCircle,
-		"alpha_j_circle_outline":                  AlphaJCircleOutline,
-		"alpha_k":                                 AlphaK,
-		"alpha_k_box":                             AlphaKBox,
-		"alpha_k_box_outline":                     AlphaKBoxOutline,
-		"alpha_k_circle":                          AlphaKCircle,
-		"alpha_k_circle_outline":                  AlphaKCircleOutline,
-		"alpha_l":                                 AlphaL,
-		"alpha_l_box":                             AlphaLBox,
-		"alpha_l_box_outline":                     AlphaLBoxOutline,
-		"alpha_l_circle":                          AlphaLCircle,
-		"alpha_l_circle_outline":                  AlphaLCircleOutline,
-		"alpha_m":                                 AlphaM,
-		"alpha_m_box":                             AlphaMBox,
-		"alpha_m_box_outline":                     AlphaMBoxOutline,
-		"alpha_m_circle":                          AlphaMCircle,
-		"alpha_m_circle_outline":                  AlphaMCircleOutline,
-		"alpha_n":                                 AlphaN,
-		"alpha_n_box":                             AlphaNBox,
-		"alpha_n_box_outline":                     AlphaNBoxOutline,
-		"alpha_n_circle":                          AlphaNCircle,
-		"alpha_n_circle_outline":                  AlphaNCircleOutline,
-		"alpha_o":                                 AlphaO,
-		"alpha_o_box":                             AlphaOBox,
-		"alpha_o_box_outline":                     AlphaOBoxOutline,
-		"alpha_o_circle":                          AlphaOCircle,
-		"alpha_o_circle_outline":                  AlphaOCircleOutline,
-		"alpha_p":                                 AlphaP,
-		"alpha_p_box":                             AlphaPBox,
-		"alpha_p_box_outline":                     AlphaPBoxOutline,
-		"alpha_p_circle":                          AlphaPCircle,
-		"alpha_p_circle_outline":                  AlphaPCircleOutline,
-		"alpha_q":                                 AlphaQ,
-		"alpha_q_box":                             AlphaQBox,
-		"alpha_q_box_outline":                     AlphaQBoxOutline,
-		"alpha_q_circle":                          AlphaQCircle,
-		"alpha_q_circle_outline":                  AlphaQCircleOutline,
-		"alpha_r":                                 AlphaR,
-		"alpha_r_box":                             AlphaRBox,
-		"alpha_r_box_outline":                     AlphaRBoxOutline,
-		"alpha_r_circle":                          AlphaRCircle,
-		"alpha_r_circle_outline":                  AlphaRCircleOutline,
-		"alpha_s":                                 AlphaS,
-		"alpha_s_box":                             AlphaSBox,
-		"alpha_s_box_outline":                     AlphaSBoxOutline,
-		"alpha_s_circle":                          AlphaSCircle,
-		"alpha_s_circle_outline":                  AlphaSCircleOutline,
-		"alpha_t":                                 AlphaT,
-		"alpha_t_box":                             AlphaTBox,
-		"alpha_t_box_outline":                     AlphaTBoxOutline,
-		"alpha_t_circle":                          AlphaTCircle,
-		"alpha_t_circle_outline":                  AlphaTCircleOutline,
-		"alpha_u":                                 AlphaU,
-		"alpha_u_box":                             AlphaUBox,
-		"alpha_u_box_outline":                     AlphaUBoxOutline,
-		"alpha_u_circle":                          AlphaUCircle,
-		"alpha_u_circle_outline":                  AlphaUCircleOutline,
-		"alpha_v":                                 AlphaV,
-		"alpha_v_box":                             AlphaVBox,
-		"alpha_v_box_outline":                     AlphaVBoxOutline,
-		"alpha_v_circle":                          AlphaVCircle,
-		"alpha_v_circle_outline":                  AlphaVCircleOutline,
-		"alpha_w":                                 AlphaW,
-		"alpha_w_box":                             AlphaWBox,
-		"alpha_w_box_outline":                     AlphaWBoxOutline,
-		"alpha_w_circle":                          AlphaWCircle,
-		"alpha_w_circle_outline":                  AlphaWCircleOutline,
-		"alpha_x":                                 AlphaX,
-		"alpha_x_box":                             AlphaXBox,
-		"alpha_x_box_outline":                     AlphaXBoxOutline,
-		"alpha_x_circle":                          AlphaXCircle,
-		"alpha_x_circle_outline":                  AlphaXCircleOutline,
-		"alpha_y":                                 AlphaY,
-		"alpha_y_box":                             AlphaYBox,
-		"alpha_y_box_outline":                     AlphaYBoxOutline,
-		"alpha_y_circle":                          AlphaYCircle,
-		"alpha_y_circle_outline":                  AlphaYCircleOutline,
-		"alpha_z":                                 AlphaZ,
-		"alpha_z_box":                             AlphaZBox,
-		"alpha_z_box_outline":                     AlphaZBoxOutline,
-		"alpha_z_circle":                          AlphaZCircle,
-		"alpha_z_circle_outline":                  AlphaZCircleOutline,
-		"alphabet_aurebesh":                       AlphabetAurebesh,
-		"alphabet_cyrillic":                       AlphabetCyrillic,
-		"alphabet_greek":                          AlphabetGreek,
-		"alphabet_latin":                          AlphabetLatin,
-		"alphabet_piqad":                          AlphabetPiqad,
-		"alphabet_tengwar":                        AlphabetTengwar,
-		"alphabetical":                            Alphabetical,
-		"alphabetical_off":                        AlphabeticalOff,
-		"alphabetical_variant":                    AlphabeticalVariant,
-		"alphabetical_variant_off":                AlphabeticalVariantOff,
-		"altimeter":                               Altimeter,
-		"ambulance":                               Ambulance,
-		"ammunition":                              Ammunition,
-		"ampersand":                               Ampersand,
-		"amplifier":                               Amplifier,
-		"amplifier_off":                           AmplifierOff,
-		"anchor":                                  Anchor,
-		"android":                                 Android,
-		"android_messages":                        AndroidMessages,
-		"android_studio":                          AndroidStudio,
-		"angle_acute":                             AngleAcute,
-		"angle_obtuse":                            AngleObtuse,
-		"angle_right":                             AngleRight,
-		"angular":                                 Angular,
-		"angularjs":                               Angularjs,
-		"animation":                               Animation,
-		"animation_outline":                       AnimationOutline,
-		"animation_play":                          AnimationPlay,
-		"animation_play_outline":                  AnimationPlayOutline,
-		"ansible":                                 Ansible,
-		"antenna":                                 Antenna,
-		"anvil":                                   Anvil,
-		"apache_kafka":                            ApacheKafka,
-		"api":                                     Api,
-		"api_off":                                 ApiOff,
-		"apple":                                   Apple,
-		"apple_finder":                            AppleFinder,
-		"apple_icloud":                            AppleIcloud,
-		"apple_ios":                               AppleIos,
-		"apple_keyboard_caps":                     AppleKeyboardCaps,
-		"apple_keyboard_command":                  AppleKeyboardCommand,
-		"apple_keyboard_control":                  AppleKeyboardControl,
-		"apple_keyboard_option":                   AppleKeyboardOption,
-		"apple_keyboard_shift":                    AppleKeyboardShift,
-		"apple_safari":                            AppleSafari,
-		"application":                             Application,
-		"application_array":                       ApplicationArray,
-		"application_array_outline":               ApplicationArrayOutline,
-		"application_braces":                      ApplicationBraces,
-		"application_braces_outline":              ApplicationBracesOutline,
-		"application_brackets":                    ApplicationBrackets,
-		"application_brackets_outline":            ApplicationBracketsOutline,
-		"application_cog":                         ApplicationCog,
-		"application_cog_outline":                 ApplicationCogOutline,
-		"application_edit":                        ApplicationEdit,
-		"application_edit_outline":                ApplicationEditOutline,
-		"application_export":                      ApplicationExport,
-		"application_import":                      ApplicationImport,
-		"application_outline":                     ApplicationOutline,
-		"application_parentheses":                 ApplicationParentheses,
-		"application_parentheses_outline":         ApplicationParenthesesOutline,
-		"application_settings":                    ApplicationSettings,
-		"application_settings_outline":            ApplicationSettingsOutline,
-		"application_variable":                    ApplicationVariable,
-		"application_variable_outline":            ApplicationVariableOutline,
-		"approximately_equal":                     ApproximatelyEqual,
-		"approximately_equal_box":                 ApproximatelyEqualBox,
-		"apps":                                    Apps,
-		"apps_box":                                AppsBox,
-		"arch":                                    Arch,
-		"archive":                                 Archive,
-		"archive_alert":                           ArchiveAlert,
-		"archive_alert_outline":                   ArchiveAlertOutline,
-		"archive_arrow_down":                      ArchiveArrowDown,
-		"archive_arrow_down_outline":              ArchiveArrowDownOutline,
-		"archive_arrow_up":                        ArchiveArrowUp,
-		"archive_arrow_up_outline":                ArchiveArrowUpOutline,
-		"archive_cancel":                          ArchiveCancel,
-		"archive_cancel_outline":                  ArchiveCancelOutline,
-		"archive_check":                           ArchiveCheck,
-		"archive_check_outline":                   ArchiveCheckOutline,
-		"archive_clock":                           ArchiveClock,
-		"archive_clock_outline":                   ArchiveClockOutline,
-		"archive_cog":                             ArchiveCog,
-		"archive_cog_outline":                     ArchiveCogOutline,
-		"archive_edit":                            ArchiveEdit,
-		"archive_edit_outline":                    ArchiveEditOutline,
-		"archive_eye":                             ArchiveEye,
-		"archive_eye_outline":                     ArchiveEyeOutline,
-		"archive_lock":                            ArchiveLock,
-		"archive_lock_open":                       ArchiveLockOpen,
-		"archive_lock_open_outline":               ArchiveLockOpenOutline,
-		"archive_lock_outline":                    ArchiveLockOutline,
-		"archive_marker":                          ArchiveMarker,
-		"archive_marker_outline":                  ArchiveMarkerOutline,
-		"archive_minus":                           ArchiveMinus,
-		"archive_minus_outline":                   ArchiveMinusOutline,
-		"archive_music":                           ArchiveMusic,
-		"archive_music_outline":                   ArchiveMusicOutline,
-		"archive_off":                             ArchiveOff,
-		"archive_off_outline":                     ArchiveOffOutline,
-		"archive_outline":                         ArchiveOutline,
-		"archive_plus":                            ArchivePlus,
-		"archive_plus_outline":                    ArchivePlusOutline,
-		"archive_refresh":                         ArchiveRefresh,
-		"archive_refresh_outline":                 ArchiveRefreshOutline,
-		"archive_remove":                          ArchiveRemove,
-		"archive_remove_outline":                  ArchiveRemoveOutline,
-		"archive_search":                          ArchiveSearch,
-		"archive_search_outline":                  ArchiveSearchOutline,
-		"archive_settings":                        ArchiveSettings,
-		"archive_settings_outline":                ArchiveSettingsOutline,
-		"archive_star":                            ArchiveStar,
-		"archive_star_outline":                    ArchiveStarOutline,
-		"archive_sync":                            ArchiveSync,
-		"archive_sync_outline":                    ArchiveSyncOutline,
-		"arm_flex":                                ArmFlex,
-		"arm_flex_outline":                        ArmFlexOutline,
-		"arrange_bring_forward":                   ArrangeBringForward,
-		"arrange_bring_to_front":                  ArrangeBringToFront,
-		"arrange_send_backward":                   ArrangeSendBackward,
-		"arrange_send_to_back":                    ArrangeSendToBack,
-		"arrow_all":                               ArrowAll,
-		"arrow_bottom_left":                       ArrowBottomLeft,
-		"arrow_bottom_left_bold_box":              ArrowBottomLeftBoldBox,
-		"arrow_bottom_left_bold_box_outline":      ArrowBottomLeftBoldBoxOutline,
-		"arrow_bottom_left_bold_outline":          ArrowBottomLeftBoldOutline,
-		"arrow_bottom_left_thick":                 ArrowBottomLeftThick,
-		"arrow_bottom_left_thin":                  ArrowBottomLeftThin,
-		"arrow_bottom_left_thin_circle_outline":   ArrowBottomLeftThinCircleOutline,
-		"arrow_bottom_right":                      ArrowBottomRight,
-		"arrow_bottom_right_bold_box":             ArrowBottomRightBoldBox,
-		"arrow_bottom_right_bold_box_outline":     ArrowBottomRightBoldBoxOutline,
-		"arrow_bottom_right_bold_outline":         ArrowBottomRightBoldOutline,
-		"arrow_bottom_right_thick":                ArrowBottomRightThick,
-		"arrow_bottom_right_thin":                 ArrowBottomRightThin,
-		"arrow_bottom_right_thin_circle_outline":  ArrowBottomRightThinCircleOutline,
-		"arrow_collapse":                          ArrowCollapse,
-		"arrow_collapse_all":                      ArrowCollapseAll,
-		"arrow_collapse_down":                     ArrowCollapseDown,
-		"arrow_collapse_horizontal":               ArrowCollapseHorizontal,
-		"arrow_collapse_left":                     ArrowCollapseLeft,
-		"arrow_collapse_right":                    ArrowCollapseRight,
-		"arrow_collapse_up":                       ArrowCollapseUp,
-		"arrow_collapse_vertical":                 ArrowCollapseVertical,
-		"arrow_decision":                          ArrowDecision,
-		"arrow_decision_auto":                     ArrowDecisionAuto,
-		"arrow_decision_auto_outline":             ArrowDecisionAutoOutline,
-		"arrow_decision_outline":                  ArrowDecisionOutline,
-		"arrow_down":                              ArrowDown,
-		"arrow_down_bold":                         ArrowDownBold,
-		"arrow_down_bold_box":                     ArrowDownBoldBox,
-		"arrow_down_bold_box_outline":             ArrowDownBoldBoxOutline,
-		"arrow_down_bold_circle":                  ArrowDownBoldCircle,
-		"arrow_down_bold_circle_outline":          ArrowDownBoldCircleOutline,
-		"arrow_down_bold_hexagon_outline":         ArrowDownBoldHexagonOutline,
-		"arrow_down_bold_outline":                 ArrowDownBoldOutline,
-		"arrow_down_box":                          ArrowDownBox,
-		"arrow_down_circle":                       ArrowDownCircle,
-		"arrow_down_circle_outline":               ArrowDownCircleOutline,
-		"arrow_down_drop_circle":                  ArrowDownDropCircle,
-		"arrow_down_drop_circle_outline":          ArrowDownDropCircleOutline,
-		"arrow_down_left":                         ArrowDownLeft,
-		"arrow_down_left_bold":                    ArrowDownLeftBold,
-		"arrow_down_right":                        ArrowDownRight,
-		"arrow_down_right_bold":                   ArrowDownRightBold,
-		"arrow_down_thick":                        ArrowDownThick,
-		"arrow_down_thin":                         ArrowDownThin,
-		"arrow_down_thin_circle_outline":          ArrowDownThinCircleOutline,
-		"arrow_expand":                            ArrowExpand,
-		"arrow_expand_all":                        ArrowExpandAll,
-		"arrow_expand_down":                       ArrowExpandDown,
-		"arrow_expand_horizontal":                 ArrowExpandHorizontal,
-		"arrow_expand_left":                       ArrowExpandLeft,
-		"arrow_expand_right":                      ArrowExpandRight,
-		"arrow_expand_up":                         ArrowExpandUp,
-		"arrow_expand_vertical":                   ArrowExpandVertical,
-		"arrow_horizontal_lock":                   ArrowHorizontalLock,
-		"arrow_left":                              ArrowLeft,
-		"arrow_left_bold":                         ArrowLeftBold,
-		"arrow_left_bold_box":                     ArrowLeftBoldBox,
-		"arrow_left_bold_box_outline":             ArrowLeftBoldBoxOutline,
-		"arrow_left_bold_circle":                  ArrowLeftBoldCircle,
-		"arrow_left_bold_circle_outline":          ArrowLeftBoldCircleOutline,
-		"arrow_left_bold_hexagon_outline":         ArrowLeftBoldHexagonOutline,
-		"arrow_left_bold_outline":                 ArrowLeftBoldOutline,
-		"arrow_left_bottom":                       ArrowLeftBottom,
-		"arrow_left_bottom_bold":                  ArrowLeftBottomBold,
-		"arrow_left_box":                          ArrowLeftBox,
-		"arrow_left_circle":                       ArrowLeftCircle,
-		"arrow_left_circle_outline":               ArrowLeftCircleOutline,
-		"arrow_left_drop_circle":                  ArrowLeftDropCircle,
-		"arrow_left_drop_circle_outline":          ArrowLeftDropCircleOutline,
-		"arrow_left_right":                        ArrowLeftRight,
-		"arrow_left_right_bold":                   ArrowLeftRightBold,
-		"arrow_left_right_bold_outline":           ArrowLeftRightBoldOutline,
-		"arrow_left_thick":                        ArrowLeftThick,
-		"arrow_left_thin":                         ArrowLeftThin,
-		"arrow_left_thin_circle_outline":          ArrowLeftThinCircleOutline,
-		"arrow_left_top":                          ArrowLeftTop,
-		"arrow_left_top_bold":                     ArrowLeftTopBold,
-		"arrow_projectile":                        ArrowProjectile,
-		"arrow_projectile_multiple":               ArrowProjectileMultiple,
-		"arrow_right":                             ArrowRight,
-		"arrow_right_bold":                        ArrowRightBold,
-		"arrow_right_bold_box":                    ArrowRightBoldBox,
-		"arrow_right_bold_box_outline":            ArrowRightBoldBoxOutline,
-		"arrow_right_bold_circle":                 ArrowRightBoldCircle,
-		"arrow_right_bold_circle_outline":         ArrowRightBoldCircleOutline,
-		"arrow_right_bold_hexagon_outline":        ArrowRightBoldHexagonOutline,
-		"arrow_right_bold_outline":                ArrowRightBoldOutline,
-		"arrow_right_bottom":                      ArrowRightBottom,
-		"arrow_right_bottom_bold":                 ArrowRightBottomBold,
-		"arrow_right_box":                         ArrowRightBox,
-		"arrow_right_circle":                      ArrowRightCircle,
-		"arrow_right_circle_outline":              ArrowRightCircleOutline,
-		"arrow_right_drop_circle":                 ArrowRightDropCircle,
-		"arrow_right_drop_circle_outline":         ArrowRightDropCircleOutline,
-		"arrow_right_thick":                       ArrowRightThick,
-		"arrow_right_thin":                        ArrowRightThin,
-		"arrow_right_thin_circle_outline":         ArrowRightThinCircleOutline,
-		"arrow_right_top":                         ArrowRightTop,
-		"arrow_right_top_bold":                    ArrowRightTopBold,
-		"arrow_split_horizontal":                  ArrowSplitHorizontal,
-		"arrow_split_vertical":                    ArrowSplitVertical,
-		"arrow_top_left":                          ArrowTopLeft,
-		"arrow_top_left_bold_box":                 ArrowTopLeftBoldBox,
-		"arrow_top_left_bold_box_outline":         ArrowTopLeftBoldBoxOutline,
-		"arrow_top_left_bold_outline":             ArrowTopLeftBoldOutline,
-		"arrow_top_left_bottom_right":             ArrowTopLeftBottomRight,
-		"arrow_top_left_bottom_right_bold":        ArrowTopLeftBottomRightBold,
-		"arrow_top_left_thick":                    ArrowTopLeftThick,
-		"arrow_top_left_thin":                     ArrowTopLeftThin,
-		"arrow_top_left_thin_circle_outline":      ArrowTopLeftThinCircleOutline,
-		"arrow_top_right":                         ArrowTopRight,
-		"arrow_top_right_bold_box":                ArrowTopRightBoldBox,
-		"arrow_top_right_bold_box_outline":        ArrowTopRightBoldBoxOutline,
-		"arrow_top_right_bold_outline":            ArrowTopRightBoldOutline,
-		"arrow_top_right_bottom_left":             ArrowTopRightBottomLeft,
-		"arrow_top_right_bottom_left_bold":        ArrowTopRightBottomLeftBold,
-		"arrow_top_right_thick":                   ArrowTopRightThick,
-		"arrow_top_right_thin":                    ArrowTopRightThin,
-		"arrow_top_right_thin_circle_outline":     ArrowTopRightThinCircleOutline,
-		"arrow_u_down_left":                       ArrowUDownLeft,
-		"arrow_u_down_left_bold":                  ArrowUDownLeftBold,
-		"arrow_u_down_right":                      ArrowUDownRight,
-		"arrow_u_down_right_bold":                 ArrowUDownRightBold,
-		"arrow_u_left_bottom":                     ArrowULeftBottom,
-		"arrow_u_left_bottom_bold":                ArrowULeftBottomBold,
-		"arrow_u_left_top":                        ArrowULeftTop,
-		"arrow_u_left_top_bold":                   ArrowULeftTopBold,
-		"arrow_u_right_bottom":                    ArrowURightBottom,
-		"arrow_u_right_bottom_bold":               ArrowURightBottomBold,
-		"arrow_u_right_top":                       ArrowURightTop,
-		"arrow_u_right_top_bold":                  ArrowURightTopBold,
-		"arrow_u_up_left":                         ArrowUUpLeft,
-		"arrow_u_up_left_bold":                    ArrowUUpLeftBold,
-		"arrow_u_up_right":                        ArrowUUpRight,
-		"arrow_u_up_right_bold":                   ArrowUUpRightBold,
-		"arrow_up":                                ArrowUp,
-		"arrow_up_bold":                           ArrowUpBold,
-		"arrow_up_bold_box":                       ArrowUpBoldBox,
-		"arrow_up_bold_box_outline":               ArrowUpBoldBoxOutline,
-		"arrow_up_bold_circle":                    ArrowUpBoldCircle,
-		"arrow_up_bold_circle_outline":            ArrowUpBoldCircleOutline,
-		"arrow_up_bold_hexagon_outline":           ArrowUpBoldHexagonOutline,
-		"arrow_up_bold_outline":                   ArrowUpBoldOutline,
-		"arrow_up_box":                            ArrowUpBox,
-		"arrow_up_circle":                         ArrowUpCircle,
-		"arrow_up_circle_outline":                 ArrowUpCircleOutline,
-		"arrow_up_down":                           ArrowUpDown,
-		"arrow_up_down_bold":                      ArrowUpDownBold,
-		"arrow_up_down_bold_outline":              ArrowUpDownBoldOutline,
-		"arrow_up_drop_circle":                    ArrowUpDropCircle,
-		"arrow_up_drop_circle_outline":            ArrowUpDropCircleOutline,
-		"arrow_up_left":                           ArrowUpLeft,
-		"arrow_up_left_bold":                      ArrowUpLeftBold,
-		"arrow_up_right":                          ArrowUpRight,
-		"arrow_up_right_bold":                     ArrowUpRightBold,
-		"arrow_up_thick":                          ArrowUpThick,
-		"arrow_up_thin":                           ArrowUpThin,
-		"arrow_up_thin_circle_outline":            ArrowUpThinCircleOutline,
-		"arrow_vertical_lock":                     ArrowVerticalLock,
-		"artstation":                              Artstation,
-		"aspect_ratio":                            AspectRatio,
-		"assistant":                               Assistant,
-		"asterisk":                                Asterisk,
-		"asterisk_circle_outline":                 AsteriskCircleOutline,
-		"at":                                      At,
-		"atlassian":                               Atlassian,
-		"atm":                                     Atm,
-		"atom":                                    Atom,
-		"atom_variant":                            AtomVariant,
-		"attachment":                              Attachment,
-		"attachment_check":                        AttachmentCheck,
-		"attachment_lock":                         AttachmentLock,
-		"attachment_minus":                        AttachmentMinus,
-		"attachment_off":                          AttachmentOff,
-		"attachment_plus":                         AttachmentPlus,
-		"attachment_remove":                       AttachmentRemove,
-		"audio_input_rca":                         AudioInputRca,
-		"audio_input_stereo_minijack":             AudioInputStereoMinijack,
-		"audio_input_xlr":                         AudioInputXlr,
-		"audio_video":                             AudioVideo,
-		"audio_video_off":                         AudioVideoOff,
-		"augmented_reality":                       AugmentedReality,
-		"auto_download":                           AutoDownload,
-		"auto_fix":                                AutoFix,
-		"auto_upload":                             AutoUpload,
-		"autorenew":                               Autorenew,
-		"autorenew_off":                           AutorenewOff,
-		"av_timer":                                AvTimer,
-		"aws":                                     Aws,
-		"axe":                                     Axe,
-		"axe_battle":                              AxeBattle,
-		"axis":                                    Axis,
-		"axis_arrow":                              AxisArrow,
-		"axis_arrow_info":                         AxisArrowInfo,
-		"axis_arrow_lock":                         AxisArrowLock,
-		"axis_lock":                               AxisLock,
-		"axis_x_arrow":                            AxisXArrow,
-		"axis_x_arrow_lock":                       AxisXArrowLock,
-		"axis_x_rotate_clockwise":                 AxisXRotateClockwise,
-		"axis_x_rotate_counterclockwise":          AxisXRotateCounterclockwise,
-		"axis_x_y_arrow_lock":                     AxisXYArrowLock,
-		"axis_y_arrow":                            AxisYArrow,
-		"axis_y_arrow_lock":                       AxisYArrowLock,
-		"axis_y_rotate_clockwise":                 AxisYRotateClockwise,
-		"axis_y_rotate_counterclockwise":          AxisYRotateCounterclockwise,
-		"axis_z_arrow":                            AxisZArrow,
-		"axis_z_arrow_lock":                       AxisZArrowLock,
-		"axis_z_rotate_clockwise":                 AxisZRotateClockwise,
-		"axis_z_rotate_counterclockwise":          AxisZRotateCounterclockwise,
-		"babel":                                   Babel,
-		"baby":                                    Baby,
-		"baby_bottle":                             BabyBottle,
-		"baby_bottle_outline":                     BabyBottleOutline,
-		"baby_buggy":                              BabyBuggy,
-		"baby_carriage":                           BabyCarriage,
-		"baby_carriage_off":                       BabyCarriageOff,
-		"baby_face":                               BabyFace,
-		"baby_face_outline":                       BabyFaceOutline,
-		"backburger":                              Backburger,
-		"backspace":                               Backspace,
-		"backspace_outline":                       BackspaceOutline,
-		"backspace_reverse":                       BackspaceReverse,
-		"backspace_reverse_outline":               BackspaceReverseOutline,
-		"backup_restore":                          BackupRestore,
-		"bacteria":                                Bacteria,
-		"bacteria_outline":                        BacteriaOutline,
-		"badge_account":                           BadgeAccount,
-		"badge_account_alert":                     BadgeAccountAlert,
-		"badge_account_alert_outline":             BadgeAccountAlertOutline,
-		"badge_account_horizontal":                BadgeAccountHorizontal,
-		"badge_account_horizontal_outline":        BadgeAccountHorizontalOutline,
-		"badge_account_outline":                   BadgeAccountOutline,
-		"badminton":                               Badminton,
-		"bag_carry_on":                            BagCarryOn,
-		"bag_carry_on_check":                      BagCarryOnCheck,
-		"bag_carry_on_off":                        BagCarryOnOff,
-		"bag_checked":                             BagChecked,
-		"bag_personal":                            BagPersonal,
-		"bag_personal_off":                        BagPersonalOff,
-		"bag_personal_off_outline":                BagPersonalOffOutline,
-		"bag_personal_outline":                    BagPersonalOutline,
-		"bag_suitcase":                            BagSuitcase,
-		"bag_suitcase_off":                        BagSuitcaseOff,
-		"bag_suitcase_off_outline":                BagSuitcaseOffOutline,
-		"bag_suitcase_outline":                    BagSuitcaseOutline,
-		"baguette":                                Baguette,
-		"balcony":                                 Balcony,
-		"balloon":                                 Balloon,
-		"ballot":                                  Ballot,
-		"ballot_outline":                          BallotOutline,
-		"ballot_recount":                          BallotRecount,
-		"ballot_recount_outline":                  BallotRecountOutline,
-		"bandage":                                 Bandage,
-		"bank":                                    Bank,
-		"bank_check":                              BankCheck,
-		"bank_minus":                              BankMinus,
-		"bank_off":                                BankOff,
-		"bank_off_outline":                        BankOffOutline,
-		"bank_outline":                            BankOutline,
-		"bank_plus":                               BankPlus,
-		"bank_remove":                             BankRemove,
-		"bank_transfer":                           BankTransfer,
-		"bank_transfer_in":                        BankTransferIn,
-		"bank_transfer_out":                       BankTransferOut,
-		"barcode":                                 Barcode,
-		"barcode_off":                             BarcodeOff,
-		"barcode_scan":                            BarcodeScan,
-		"barley":                                  Barley,
-		"barley_off":                              BarleyOff,
-		"barn":                                    Barn,
-		"barrel":                                  Barrel,
-		"barrel_outline":                          BarrelOutline,
-		"baseball":                                Baseball,
-		"baseball_bat":                            BaseballBat,
-		"baseball_diamond":                        BaseballDiamond,
-		"baseball_diamond_outline":                BaseballDiamondOutline,
-		"bash":                                    Bash,
-		"basket":                                  Basket,
-		"basket_check":                            BasketCheck,
-		"basket_check_outline":                    BasketCheckOutline,
-		"basket_fill":                             BasketFill,
-		"basket_minus":                            BasketMinus,
-		"basket_minus_outline":                    BasketMinusOutline,
-		"basket_off":                              BasketOff,
-		"basket_off_outline":                      BasketOffOutline,
-		"basket_outline":                          BasketOutline,
-		"basket_plus":                             BasketPlus,
-		"basket_plus_outline":                     BasketPlusOutline,
-		"basket_remove":                           BasketRemove,
-		"basket_remove_outline":                   BasketRemoveOutline,
-		"basket_unfill":                           BasketUnfill,
-		"basketball":                              Basketball,
-		"basketball_hoop":                         BasketballHoop,
-		"basketball_hoop_outline":                 BasketballHoopOutline,
-		"bat":                                     Bat,
-		"bathtub":                                 Bathtub,
-		"bathtub_outline":                         BathtubOutline,
-		"battery":                                 Battery,
-		"battery_10":                              Battery10,
-		"battery_10_bluetooth":                    Battery10Bluetooth,
-		"battery_20":                              Battery20,
-		"battery_20_bluetooth":                    Battery20Bluetooth,
-		"battery_30":                              Battery30,
-		"battery_30_bluetooth":                    Battery30Bluetooth,
-		"battery_40":                              Battery40,
-		"battery_40_bluetooth":                    Battery40Bluetooth,
-		"battery_50":                              Battery50,
-		"battery_50_bluetooth":                    Battery50Bluetooth,
-		"battery_60":                              Battery60,
-		"battery_60_bluetooth":                    Battery60Bluetooth,
-		"battery_70":                              Battery70,
-		"battery_70_bluetooth":                    Battery70Bluetooth,
-		"battery_80":                              Battery80,
-		"battery_80_bluetooth":                    Battery80Bluetooth,
-		"battery_90":                              Battery90,
-		"battery_90_bluetooth":                    Battery90Bluetooth,
-		"battery_alert":                           BatteryAlert,
-		"battery_alert_bluetooth":                 BatteryAlertBluetooth,
-		"battery_alert_variant":                   BatteryAlertVariant,
-		"battery_alert_variant_outline":           BatteryAlertVariantOutline,
-		"battery_arrow_down":                      BatteryArrowDown,
-		"battery_arrow_down_outline":              BatteryArrowDownOutline,
-		"battery_arrow_up":                        BatteryArrowUp,
-		"battery_arrow_up_outline":                BatteryArrowUpOutline,
-		"battery_bluetooth":                       BatteryBluetooth,
-		"battery_bluetooth_variant":               BatteryBluetoothVariant,
-		"battery_charging":                        BatteryCharging,
-		"battery_charging_10":                     BatteryCharging10,
-		"battery_charging_100":                    BatteryCharging100,
-		"battery_charging_20":                     BatteryCharging20,
-		"battery_charging_30":                     BatteryCharging30,
-		"battery_charging_40":                     BatteryCharging40,
-		"battery_charging_50":                     BatteryCharging50,
-		"battery_charging_60":                     BatteryCharging60,
-		"battery_charging_70":                     BatteryCharging70,
-		"battery_charging_80":                     BatteryCharging80,
-		"battery_charging_90":                     BatteryCharging90,
-		"battery_charging_high":                   BatteryChargingHigh,
-		"battery_charging_low":                    BatteryChargingLow,
-		"battery_charging_medium":                 BatteryChargingMedium,
-		"battery_charging_outline":                BatteryChargingOutline,
-		"battery_charging_wireless":               BatteryChargingWireless,
-		"battery_charging_wireless_10":            BatteryChargingWireless10,
-		"battery_charging_wireless_20":            BatteryChargingWireless20,
-		"battery_charging_wireless_30":            BatteryChargingWireless30,
-		"battery_charging_wireless_40":            BatteryChargingWireless40,
-		"battery_charging_wireless_50":            BatteryChargingWireless50,
-		"battery_charging_wireless_60":            BatteryChargingWireless60,
-		"battery_charging_wireless_70":            BatteryChargingWireless70,
-		"battery_charging_wireless_80":            BatteryChargingWireless80,
-		"battery_charging_wireless_90":            BatteryChargingWireless90,
-		"battery_charging_wireless_alert":         BatteryChargingWirelessAlert,
-		"battery_charging_wireless_outline":       BatteryChargingWirelessOutline,
-		"battery_check":                           BatteryCheck,
-		"battery_check_outline":                   BatteryCheckOutline,
-		"battery_clock":                           BatteryClock,
-		"battery_clock_outline":                   BatteryClockOutline,
-		"battery_heart":                           BatteryHeart,
-		"battery_heart_outline":                   BatteryHeartOutline,
-		"battery_heart_variant":                   BatteryHeartVariant,
-		"battery_high":                            BatteryHigh,
-		"battery_lock":                            BatteryLock,
-		"battery_lock_open":                       BatteryLockOpen,
-		"battery_low":                             BatteryLow,
-		"battery_medium":                          BatteryMedium,
-		"battery_minus":                           BatteryMinus,
-		"battery_minus_outline":                   BatteryMinusOutline,
-		"battery_minus_variant":                   BatteryMinusVariant,
-		"battery_negative":                        BatteryNegative,
-		"battery_off":                             BatteryOff,
-		"battery_off_outline":                     BatteryOffOutline,
-		"battery_outline":                         BatteryOutline,
-		"battery_plus":                            BatteryPlus,
-		"battery_plus_outline":                    BatteryPlusOutline,
-		"battery_plus_variant":                    BatteryPlusVariant,
-		"battery_positive":                        BatteryPositive,
-		"battery_remove":                          BatteryRemove,
-		"battery_remove_outline":                  BatteryRemoveOutline,
-		"battery_sync":                            BatterySync,
-		"battery_sync_outline":                    BatterySyncOutline,
-		"battery_unknown":                         BatteryUnknown,
-		"battery_unknown_bluetooth":               BatteryUnknownBluetooth,
-		"beach":                                   Beach,
-		"beaker":                                  Beaker,
-		"beaker_alert":                            BeakerAlert,
-		"beaker_alert_outline":                    BeakerAlertOutline,
-		"beaker_check":                            BeakerCheck,
-		"beaker_check_outline":                    BeakerCheckOutline,
-		"beaker_minus":                            BeakerMinus,
-		"beaker_minus_outline":                    BeakerMinusOutline,
-		"beaker_outline":                          BeakerOutline,
-		"beaker_plus":                             BeakerPlus,
-		"beaker_plus_outline":                     BeakerPlusOutline,
-		"beaker_question":                         BeakerQuestion,
-		"beaker_question_outline":                 BeakerQuestionOutline,
-		"beaker_remove":                           BeakerRemove,
-		"beaker_remove_outline":                   BeakerRemoveOutline,
-		"bed":                                     Bed,
-		"bed_double":                              BedDouble,
-		"bed_double_outline":                      BedDoubleOutline,
-		"bed_empty":                               BedEmpty,
-		"bed_king":                                BedKing,
-		"bed_king_outline":                        BedKingOutline,
-		"bed_outline":                             BedOutline,
-		"bed_queen":                               BedQueen,
-		"bed_queen_outline":                       BedQueenOutline,
-		"bed_single":                              BedSingle,
-		"bed_single_outline":                      BedSingleOutline,
-		"bee":                                     Bee,
-		"bee_flower":                              BeeFlower,
-		"beehive_off_outline":                     BeehiveOffOutline,
-		"beehive_outline":                         BeehiveOutline,
-		"beekeeper":                               Beekeeper,
-		"beer":                                    Beer,
-		"beer_outline":                            BeerOutline,
-		"bell":                                    Bell,
-		"bell_alert":                              BellAlert,
-		"bell_alert_outline":                      BellAlertOutline,
-		"bell_badge":                              BellBadge,
-		"bell_badge_outline":                      BellBadgeOutline,
-		"bell_cancel":                             BellCancel,
-		"bell_cancel_outline":                     BellCancelOutline,
-		"bell_check":                              BellCheck,
-		"bell_check_outline":                      BellCheckOutline,
-		"bell_circle":                             BellCircle,
-		"bell_circle_outline":                     BellCircleOutline,
-		"bell_cog":                                BellCog,
-		"bell_cog_outline":                        BellCogOutline,
-		"bell_minus":                              BellMinus,
-		"bell_minus_outline":                      BellMinusOutline,
-		"bell_off":                                BellOff,
-		"bell_off_outline":                        BellOffOutline,
-		"bell_outline":                            BellOutline,
-		"bell_plus":                               BellPlus,
-		"bell_plus_outline":                       BellPlusOutline,
-		"bell_remove":                             BellRemove,
-		"bell_remove_outline":                     BellRemoveOutline,
-		"bell_ring":                               BellRing,
-		"bell_ring_outline":                       BellRingOutline,
-		"bell_sleep":                              BellSleep,
-		"bell_sleep_outline":                      BellSleepOutline,
-		"beta":                                    Beta,
-		"betamax":                                 Betamax,
-		"biathlon":                                Biathlon,
-		"bicycle":                                 Bicycle,
-		"bicycle_basket":                          BicycleBasket,
-		"bicycle_cargo":                           BicycleCargo,
-		"bicycle_electric":                        BicycleElectric,
-		"bicycle_penny_farthing":                  BicyclePennyFarthing,
-		"bike":                                    Bike,
-		"bike_fast":                               BikeFast,
-		"billboard":                               Billboard,
-		"billiards":                               Billiards,
-		"billiards_rack":                          BilliardsRack,
-		"binoculars":                              Binoculars,
-		"bio":                                     Bio,
-		"biohazard":                               Biohazard,
-		"bird":                                    Bird,
-		"bitbucket":                               Bitbucket,
-		"bitcoin":                                 Bitcoin,
-		"black_mesa":                              BlackMesa,
-		"blender":                                 Blender,
-		"blender_outline":                         BlenderOutline,
-		"blender_software":                        BlenderSoftware,
-		"blinds":                                  Blinds,
-		"blinds_horizontal":                       BlindsHorizontal,
-		"blinds_horizontal_closed":                BlindsHorizontalClosed,
-		"blinds_open":                             BlindsOpen,
-		"blinds_vertical":                         BlindsVertical,
-		"blinds_vertical_closed":                  BlindsVerticalClosed,
-		"block_helper":                            BlockHelper,
-		"blood_bag":                               BloodBag,
-		"bluetooth":                               Bluetooth,
-		"bluetooth_audio":                         BluetoothAudio,
-		"bluetooth_connect":                       BluetoothConnect,
-		"bluetooth_off":                           BluetoothOff,
-		"bluetooth_settings":                      BluetoothSettings,
-		"bluetooth_transfer":                      BluetoothTransfer,
-		"blur":                                    Blur,
-		"blur_linear":                             BlurLinear,
-		"blur_off":                                BlurOff,
-		"blur_radial":                             BlurRadial,
-		"bolt":                                    Bolt,
-		"bomb":                                    Bomb,
-		"bomb_off":                                BombOff,
-		"bone":                                    Bone,
-		"bone_off":                                BoneOff,
-		"book":                                    Book,
-		"book_account":                            BookAccount,
-		"book_account_outline":                    BookAccountOutline,
-		"book_alert":                              BookAlert,
-		"book_alert_outline":                      BookAlertOutline,
-		"book_alphabet":                           BookAlphabet,
-		"book_arrow_down":                         BookArrowDown,
-		"book_arrow_down_outline":                 BookArrowDownOutline,
-		"book_arrow_left":                         BookArrowLeft,
-		"book_arrow_left_outline":                 BookArrowLeftOutline,
-		"book_arrow_right":                        BookArrowRight,
-		"book_arrow_right_outline":                BookArrowRightOutline,
-		"book_arrow_up":                           BookArrowUp,
-		"book_arrow_up_outline":                   BookArrowUpOutline,
-		"book_cancel":                             BookCancel,
-		"book_cancel_outline":                     BookCancelOutline,
-		"book_check":                              BookCheck,
-		"book_check_outline":                      BookCheckOutline,
-		"book_clock":                              BookClock,
-		"book_clock_outline":                      BookClockOutline,
-		"book_cog":                                BookCog,
-		"book_cog_outline":                        BookCogOutline,
-		"book_cross":                              BookCross,
-		"book_edit":                               BookEdit,
-		"book_edit_outline":                       BookEditOutline,
-		"book_education":                          BookEducation,
-		"book_education_outline":                  BookEducationOutline,
-		"book_heart":                              BookHeart,
-		"book_heart_outline":                      BookHeartOutline,
-		"book_information_variant":                BookInformationVariant,
-		"book_lock":                               BookLock,
-		"book_lock_open":                          BookLockOpen,
-		"book_lock_open_outline":                  BookLockOpenOutline,
-		"book_lock_outline":                       BookLockOutline,
-		"book_marker":                             BookMarker,
-		"book_marker_outline":                     BookMarkerOutline,
-		"book_minus":                              BookMinus,
-		"book_minus_multiple":                     BookMinusMultiple,
-		"book_minus_multiple_outline":             BookMinusMultipleOutline,
-		"book_minus_outline":                      BookMinusOutline,
-		"book_multiple":                           BookMultiple,
-		"book_multiple_outline":                   BookMultipleOutline,
-		"book_music":                              BookMusic,
-		"book_music_outline":                      BookMusicOutline,
-		"book_off":                                BookOff,
-		"book_off_outline":                        BookOffOutline,
-		"book_open":                               BookOpen,
-		"book_open_blank_variant":                 BookOpenBlankVariant,
-		"book_open_outline":                       BookOpenOutline,
-		"book_open_page_variant":                  BookOpenPageVariant,
-		"book_open_page_variant_outline":          BookOpenPageVariantOutline,
-		"book_open_variant":                       BookOpenVariant,
-		"book_outline":                            BookOutline,
-		"book_play":                               BookPlay,
-		"book_play_outline":                       BookPlayOutline,
-		"book_plus":                               BookPlus,
-		"book_plus_multiple":                      BookPlusMultiple,
-		"book_plus_multiple_outline":              BookPlusMultipleOutline,
-		"book_plus_outline":                       BookPlusOutline,
-		"book_refresh":                            BookRefresh,
-		"book_refresh_outline":                    BookRefreshOutline,
-		"book_remove":                             BookRemove,
-		"book_remove_multiple":                    BookRemoveMultiple,
-		"book_remove_multiple_outline":            BookRemoveMultipleOutline,
-		"book_remove_outline":                     BookRemoveOutline,
-		"book_search":                             BookSearch,
-		"book_search_outline":                     BookSearchOutline,
-		"book_settings":                           BookSettings,
-		"book_settings_outline":                   BookSettingsOutline,
-		"book_sync":                               BookSync,
-		"book_sync_outline":                       BookSyncOutline,
-		"book_variant":                            BookVariant,
-		"book_variant_multiple":                   BookVariantMultiple,
-		"bookmark":                                Bookmark,
-		"bookmark_box_multiple":                   BookmarkBoxMultiple,
-		"bookmark_box_multiple_outline":           BookmarkBoxMultipleOutline,
-		"bookmark_check":                          BookmarkCheck,
-		"bookmark_check_outline":                  BookmarkCheckOutline,
-		"bookmark_minus":                          BookmarkMinus,
-		"bookmark_minus_outline":                  BookmarkMinusOutline,
-		"bookmark_multiple":                       BookmarkMultiple,
-		"bookmark_multiple_outline":               BookmarkMultipleOutline,
-		"bookmark_music":                          BookmarkMusic,
-		"bookmark_music_outline":                  BookmarkMusicOutline,
-		"bookmark_off":                            BookmarkOff,
-		"bookmark_off_outline":                    BookmarkOffOutline,
-		"bookmark_outline":                        BookmarkOutline,
-		"bookmark_plus":                           BookmarkPlus,
-		"bookmark_plus_outline":                   BookmarkPlusOutline,
-		"bookmark_remove":                         BookmarkRemove,
-		"bookmark_remove_outline":                 BookmarkRemoveOutline,
-		"bookshelf":                               Bookshelf,
-		"boom_gate":                               BoomGate,
-		"boom_gate_alert":                         BoomGateAlert,
-		"boom_gate_alert_outline":                 BoomGateAlertOutline,
-		"boom_gate_arrow_down":                    BoomGateArrowDown,
-		"boom_gate_arrow_down_outline":            BoomGateArrowDownOutline,
-		"boom_gate_arrow_up":                      BoomGateArrowUp,
-		"boom_gate_arrow_up_outline":              BoomGateArrowUpOutline,
-		"boom_gate_outline":                       BoomGateOutline,
-		"boom_gate_up":                            BoomGateUp,
-		"boom_gate_up_outline":                    BoomGateUpOutline,
-		"boombox":                                 Boombox,
-		"boomerang":                               Boomerang,
-		"bootstrap":                               Bootstrap,
-		"border_all":                              BorderAll,
-		"border_all_variant":                      BorderAllVariant,
-		"border_bottom":                           BorderBottom,
-		"border_bottom_variant":                   BorderBottomVariant,
-		"border_color":                            BorderColor,
-		"border_horizontal":                       BorderHorizontal,
-		"border_inside":                           BorderInside,
-		"border_left":                             BorderLeft,
-		"border_left_variant":                     BorderLeftVariant,
-		"border_none":                             BorderNone,
-		"border_none_variant":                     BorderNoneVariant,
-		"border_outside":                          BorderOutside,
-		"border_right":                            BorderRight,
-		"border_right_variant":                    BorderRightVariant,
-		"border_style":                            BorderStyle,
-		"border_top":                              BorderTop,
-		"border_top_variant":                      BorderTopVariant,
-		"border_vertical":                         BorderVertical,
-		"bottle_soda":                             BottleSoda,
-		"bottle_soda_classic":                     BottleSodaClassic,
-		"bottle_soda_classic_outline":             BottleSodaClassicOutline,
-		"bottle_soda_outline":                     BottleSodaOutline,
-		"bottle_tonic":                            BottleTonic,
-		"bottle_tonic_outline":                    BottleTonicOutline,
-		"bottle_tonic_plus":                       BottleTonicPlus,
-		"bottle_tonic_plus_outline":               BottleTonicPlusOutline,
-		"bottle_tonic_skull":                      BottleTonicSkull,
-		"bottle_tonic_skull_outline":              BottleTonicSkullOutline,
-		"bottle_wine":                             BottleWine,
-		"bottle_wine_outline":                     BottleWineOutline,
-		"bow_arrow":                               BowArrow,
-		"bow_tie":                                 BowTie,
-		"bowl":                                    Bowl,
-		"bowl_mix":                                BowlMix,
-		"bowl_mix_outline":                        BowlMixOutline,
-		"bowl_outline":                            BowlOutline,
-		"bowling":                                 Bowling,
-		"box":                                     Box,
-		"box_cutter":                              BoxCutter,
-		"box_cutter_off":                          BoxCutterOff,
-		"box_shadow":                              BoxShadow,
-		"boxing_glove":                            BoxingGlove,
-		"braille":                                 Braille,
-		"brain":                                   Brain,
-		"bread_slice":                             BreadSlice,
-		"bread_slice_outline":                     BreadSliceOutline,
-		"bridge":                                  Bridge,
-		"briefcase":                               Briefcase,
-		"briefcase_account":                       BriefcaseAccount,
-		"briefcase_account_outline":               BriefcaseAccountOutline,
-		"briefcase_arrow_left_right":              BriefcaseArrowLeftRight,
-		"briefcase_arrow_left_right_outline":      BriefcaseArrowLeftRightOutline,
-		"briefcase_arrow_up_down":                 BriefcaseArrowUpDown,
-		"briefcase_arrow_up_down_outline":         BriefcaseArrowUpDownOutline,
-		"briefcase_check":                         BriefcaseCheck,
-		"briefcase_check_outline":                 BriefcaseCheckOutline,
-		"briefcase_clock":                         BriefcaseClock,
-		"briefcase_clock_outline":                 BriefcaseClockOutline,
-		"briefcase_download":                      BriefcaseDownload,
-		"briefcase_download_outline":              BriefcaseDownloadOutline,
-		"briefcase_edit":                          BriefcaseEdit,
-		"briefcase_edit_outline":                  BriefcaseEditOutline,
-		"briefcase_eye":                           BriefcaseEye,
-		"briefcase_eye_outline":                   BriefcaseEyeOutline,
-		"briefcase_minus":                         BriefcaseMinus,
-		"briefcase_minus_outline":                 BriefcaseMinusOutline,
-		"briefcase_off":                           BriefcaseOff,
-		"briefcase_off_outline":                   BriefcaseOffOutline,
-		"briefcase_outline":                       BriefcaseOutline,
-		"briefcase_plus":                          BriefcasePlus,
-		"briefcase_plus_outline":                  BriefcasePlusOutline,
-		"briefcase_remove":                        BriefcaseRemove,
-		"briefcase_remove_outline":                BriefcaseRemoveOutline,
-		"briefcase_search":                        BriefcaseSearch,
-		"briefcase_search_outline":                BriefcaseSearchOutline,
-		"briefcase_upload":                        BriefcaseUpload,
-		"briefcase_upload_outline":                BriefcaseUploadOutline,
-		"briefcase_variant":                       BriefcaseVariant,
-		"briefcase_variant_off":                   BriefcaseVariantOff,
-		"briefcase_variant_off_outline":           BriefcaseVariantOffOutline,
-		"briefcase_variant_outline":               BriefcaseVariantOutline,
-		"brightness_1":                            Brightness1,
-		"brightness_2":                            Brightness2,
-		"brightness_3":                            Brightness3,
-		"brightness_4":                            Brightness4,
-		"brightness_5":                            Brightness5,
-		"brightness_6":                            Brightness6,
-		"brightness_7":                            Brightness7,
-		"brightness_auto":                         BrightnessAuto,
-		"brightness_percent":                      BrightnessPercent,
-		"broadcast":                               Broadcast,
-		"broadcast_off":                           BroadcastOff,
-		"broom":                                   Broom,
-		"brush":                                   Brush,
-		"brush_off":                               BrushOff,
-		"brush_outline":                           BrushOutline,
-		"brush_variant":                           BrushVariant,
-		"bucket":                                  Bucket,
-		"bucket_outline":                          BucketOutline,
-		"buffet":                                  Buffet,
-		"bug":                                     Bug,
-		"bug_check":                               BugCheck,
-		"bug_check_outline":                       BugCheckOutline,
-		"bug_outline":                             BugOutline,
-		"bugle":                                   Bugle,
-		"bulkhead_light":                          BulkheadLight,
-		"bulldozer":                               Bulldozer,
-		"bullet":                                  Bullet,
-		"bulletin_board":                          BulletinBoard,
-		"bullhorn":                                Bullhorn,
-		"bullhorn_outline":                        BullhornOutline,
-		"bullhorn_variant":                        BullhornVariant,
-		"bullhorn_variant_outline":                BullhornVariantOutline,
-		"bullseye":                                Bullseye,
-		"bullseye_arrow":                          BullseyeArrow,
-		"bulma":                                   Bulma,
-		"bunk_bed":                                BunkBed,
-		"bunk_bed_outline":                        BunkBedOutline,
-		"bus":                                     Bus,
-		"bus_alert":                               BusAlert,
-		"bus_articulated_end":                     BusArticulatedEnd,
-		"bus_articulated_front":                   BusArticulatedFront,
-		"bus_clock":                               BusClock,
-		"bus_double_decker":                       BusDoubleDecker,
-		"bus_electric":                            BusElectric,
-		"bus_marker":                              BusMarker,
-		"bus_multiple":                            BusMultiple,
-		"bus_school":                              BusSchool,
-		"bus_side":                                BusSide,
-		"bus_stop":                                BusStop,
-		"bus_stop_covered":                        BusStopCovered,
-		"bus_stop_uncovered":                      BusStopUncovered,
-		"butterfly":                               Butterfly,
-		"butterfly_outline":                       ButterflyOutline,
-		"cabin_a_frame":                           CabinAFrame,
-		"cable_data":                              CableData,
-		"cached":                                  Cached,
-		"cactus":                                  Cactus,
-		"cake":                                    Cake,
-		"cake_layered":                            CakeLayered,
-		"cake_variant":                            CakeVariant,
-		"cake_variant_outline":                    CakeVariantOutline,
-		"calculator":                              Calculator,
-		"calculator_variant":                      CalculatorVariant,
-		"calculator_variant_outline":              CalculatorVariantOutline,
-		"calendar":                                Calendar,
-		"calendar_account":                        CalendarAccount,
-		"calendar_account_outline":                CalendarAccountOutline,
-		"calendar_alert":                          CalendarAlert,
-		"calendar_arrow_left":                     CalendarArrowLeft,
-		"calendar_arrow_right":                    CalendarArrowRight,
-		"calendar_blank":                          CalendarBlank,
-		"calendar_blank_multiple":                 CalendarBlankMultiple,
-		"calendar_blank_outline":                  CalendarBlankOutline,
-		"calendar_check":                          CalendarCheck,
-		"calendar_check_outline":                  CalendarCheckOutline,
-		"calendar_clock":                          CalendarClock,
-		"calendar_clock_outline":                  CalendarClockOutline,
-		"calendar_collapse_horizontal":            CalendarCollapseHorizontal,
-		"calendar_cursor":                         CalendarCursor,
-		"calendar_edit":                           CalendarEdit,
-		"calendar_end":                            CalendarEnd,
-		"calendar_expand_horizontal":              CalendarExpandHorizontal,
-		"calendar_export":                         CalendarExport,
-		"calendar_heart":                          CalendarHeart,
-		"calendar_import":                         CalendarImport,
-		"calendar_lock":                           CalendarLock,
-		"calendar_lock_outline":                   CalendarLockOutline,
-		"calendar_minus":                          CalendarMinus,
-		"calendar_month":                          CalendarMonth,
-		"calendar_month_outline":                  CalendarMonthOutline,
-		"calendar_multiple":                       CalendarMultiple,
-		"calendar_multiple_check":                 CalendarMultipleCheck,
-		"calendar_multiselect":                    CalendarMultiselect,
-		"calendar_outline":                        CalendarOutline,
-		"calendar_plus":                           CalendarPlus,
-		"calendar_question":                       CalendarQuestion,
-		"calendar_range":                          CalendarRange,
-		"calendar_range_outline":                  CalendarRangeOutline,
-		"calendar_refresh":                        CalendarRefresh,
-		"calendar_refresh_outline":                CalendarRefreshOutline,
-		"calendar_remove":                         CalendarRemove,
-		"calendar_remove_outline":                 CalendarRemoveOutline,
-		"calendar_search":                         CalendarSearch,
-		"calendar_star":                           CalendarStar,
-		"calendar_start":                          CalendarStart,
-		"calendar_sync":                           CalendarSync,
-		"calendar_sync_outline":                   CalendarSyncOutline,
-		"calendar_text":                           CalendarText,
-		"calendar_text_outline":                   CalendarTextOutline,
-		"calendar_today":                          CalendarToday,
-		"calendar_today_outline":                  CalendarTodayOutline,
-		"calendar_week":                           CalendarWeek,
-		"calendar_week_begin":                     CalendarWeekBegin,
-		"calendar_week_begin_outline":             CalendarWeekBeginOutline,
-		"calendar_week_end":                       CalendarWeekEnd,
-		"calendar_week_end_outline":               CalendarWeekEndOutline,
-		"calendar_week_outline":                   CalendarWeekOutline,
-		"calendar_weekend":                        CalendarWeekend,
-		"calendar_weekend_outline":                CalendarWeekendOutline,
-		"call_made":                               CallMade,
-		"call_merge":                              CallMerge,
-		"call_missed":                             CallMissed,
-		"call_received":                           CallReceived,
-		"call_split":                              CallSplit,
-		"camcorder":                               Camcorder,
-		"camcorder_off":                           CamcorderOff,
-		"camera":                                  Camera,
-		"camera_account":                          CameraAccount,
-		"camera_burst":                            CameraBurst,
-		"camera_control":                          CameraControl,
-		"camera_document":                         CameraDocument,
-		"camera_document_off":                     CameraDocumentOff,
-		"camera_enhance":                          CameraEnhance,
-		"camera_enhance_outline":                  CameraEnhanceOutline,
-		"camera_flip":                             CameraFlip,
-		"camera_flip_outline":                     CameraFlipOutline,
-		"camera_front":                            CameraFront,
-		"camera_front_variant":                    CameraFrontVariant,
-		"camera_gopro":                            CameraGopro,
-		"camera_image":                            CameraImage,
-		"camera_iris":                             CameraIris,
-		"camera_lock":                             CameraLock,
-		"camera_lock_outline":                     CameraLockOutline,
-		"camera_marker":                           CameraMarker,
-		"camera_marker_outline":                   CameraMarkerOutline,
-		"camera_metering_center":                  CameraMeteringCenter,
-		"camera_metering_matrix":                  CameraMeteringMatrix,
-		"camera_metering_partial":                 CameraMeteringPartial,
-		"camera_metering_spot":                    CameraMeteringSpot,
-		"camera_off":                              CameraOff,
-		"camera_off_outline":                      CameraOffOutline,
-		"camera_outline":                          CameraOutline,
-		"camera_party_mode":                       CameraPartyMode,
-		"camera_plus":                             CameraPlus,
-		"camera_plus_outline":                     CameraPlusOutline,
-		"camera_rear":                             CameraRear,
-		"camera_rear_variant":                     CameraRearVariant,
-		"camera_retake":                           CameraRetake,
-		"camera_retake_outline":                   CameraRetakeOutline,
-		"camera_switch":                           CameraSwitch,
-		"camera_switch_outline":                   CameraSwitchOutline,
-		"camera_timer":                            CameraTimer,
-		"camera_wireless":                         CameraWireless,
-		"camera_wireless_outline":                 CameraWirelessOutline,
-		"campfire":                                Campfire,
-		"cancel":                                  Cancel,
-		"candelabra":                              Candelabra,
-		"candelabra_fire":                         CandelabraFire,
-		"candle":                                  Candle,
-		"candy":                                   Candy,
-		"candy_off":                               CandyOff,
-		"candy_off_outline":                       CandyOffOutline,
-		"candy_outline":                           CandyOutline,
-		"candycane":                               Candycane,
-		"cannabis":                                Cannabis,
-		"cannabis_off":                            CannabisOff,
-		"caps_lock":                               CapsLock,
-		"car":                                     Car,
-		"car_2_plus":                              Car2Plus,
-		"car_3_plus":                              Car3Plus,
-		"car_arrow_left":                          CarArrowLeft,
-		"car_arrow_right":                         CarArrowRight,
-		"car_back":                                CarBack,
-		"car_battery":                             CarBattery,
-		"car_brake_abs":                           CarBrakeAbs,
-		"car_brake_alert":                         CarBrakeAlert,
-		"car_brake_fluid_level":                   CarBrakeFluidLevel,
-		"car_brake_hold":                          CarBrakeHold,
-		"car_brake_low_pressure":                  CarBrakeLowPressure,
-		"car_brake_parking":                       CarBrakeParking,
-		"car_brake_retarder":                      CarBrakeRetarder,
-		"car_brake_temperature":                   CarBrakeTemperature,
-		"car_brake_worn_linings":                  CarBrakeWornLinings,
-		"car_child_seat":                          CarChildSeat,
-		"car_clock":                               CarClock,
-		"car_clutch":                              CarClutch,
-		"car_cog":                                 CarCog,
-		"car_connected":                           CarConnected,
-		"car_convertible":                         CarConvertible,
-		"car_coolant_level":                       CarCoolantLevel,
-		"car_cruise_control":                      CarCruiseControl,
-		"car_defrost_front":                       CarDefrostFront,
-		"car_defrost_rear":                        CarDefrostRear,
-		"car_door":                                CarDoor,
-		"car_door_lock":                           CarDoorLock,
-		"car_electric":                            CarElectric,
-		"car_electric_outline":                    CarElectricOutline,
-		"car_emergency":                           CarEmergency,
-		"car_esp":                                 CarEsp,
-		"car_estate":                              CarEstate,
-		"car_hatchback":                           CarHatchback,
-		"car_info":                                CarInfo,
-		"car_key":                                 CarKey,
-		"car_lifted_pickup":                       CarLiftedPickup,
-		"car_light_alert":                         CarLightAlert,
-		"car_light_dimmed":                        CarLightDimmed,
-		"car_light_fog":                           CarLightFog,
-		"car_light_high":                          CarLightHigh,
-		"car_limousine":                           CarLimousine,
-		"car_multiple":                            CarMultiple,
-		"car_off":                                 CarOff,
-		"car_outline":                             CarOutline,
-		"car_parking_lights":                      CarParkingLights,
-		"car_pickup":                              CarPickup,
-		"car_seat":                                CarSeat,
-		"car_seat_cooler":                         CarSeatCooler,
-		"car_seat_heater":                         CarSeatHeater,
-		"car_select":                              CarSelect,
-		"car_settings":                            CarSettings,
-		"car_shift_pattern":                       CarShiftPattern,
-		"car_side":                                CarSide,
-		"car_speed_limiter":                       CarSpeedLimiter,
-		"car_sports":                              CarSports,
-		"car_tire_alert":                          CarTireAlert,
-		"car_traction_control":                    CarTractionControl,
-		"car_turbocharger":                        CarTurbocharger,
-		"car_wash":                                CarWash,
-		"car_windshield":                          CarWindshield,
-		"car_windshield_outline":                  CarWindshieldOutline,
-		"car_wireless":                            CarWireless,
-		"car_wrench":                              CarWrench,
-		"carabiner":                               Carabiner,
-		"caravan":                                 Caravan,
-		"card":                                    Card,
-		"card_account_details":                    CardAccountDetails,
-		"card_account_details_outline":            CardAccountDetailsOutline,
-		"card_account_details_star":               CardAccountDetailsStar,
-		"card_account_details_star_outline":       CardAccountDetailsStarOutline,
-		"card_account_mail":                       CardAccountMail,
-		"card_account_mail_outline":               CardAccountMailOutline,
-		"card_account_phone":                      CardAccountPhone,
-		"card_account_phone_outline":              CardAccountPhoneOutline,
-		"card_bulleted":                           CardBulleted,
-		"card_bulleted_off":                       CardBulletedOff,
-		"card_bulleted_off_outline":               CardBulletedOffOutline,
-		"card_bulleted_outline":                   CardBulletedOutline,
-		"card_bulleted_settings":                  CardBulletedSettings,
-		"card_bulleted_settings_outline":          CardBulletedSettingsOutline,
-		"card_minus":                              CardMinus,
-		"card_minus_outline":                      CardMinusOutline,
-		"card_multiple":                           CardMultiple,
-		"card_multiple_outline":                   CardMultipleOutline,
-		"card_off":                                CardOff,
-		"card_off_outline":                        CardOffOutline,
-		"card_outline":                            CardOutline,
-		"card_plus":                               CardPlus,
-		"card_plus_outline":                       CardPlusOutline,
-		"card_remove":                             CardRemove,
-		"card_remove_outline":                     CardRemoveOutline,
-		"card_search":                             CardSearch,
-		"card_search_outline":                     CardSearchOutline,
-		"card_text":                               CardText,
-		"card_text_outline":                       CardTextOutline,
-		"cards":                                   Cards,
-		"cards_club":                              CardsClub,
-		"cards_club_outline":                      CardsClubOutline,
-		"cards_diamond":                           CardsDiamond,
-		"cards_diamond_outline":                   CardsDiamondOutline,
-		"cards_outline":                           CardsOutline,
-		"cards_playing":                           CardsPlaying,
-		"cards_playing_club":                      CardsPlayingClub,
-		"cards_playing_club_multiple":             CardsPlayingClubMultiple,
-		"cards_playing_club_multiple_outline":     CardsPlayingClubMultipleOutline,
-		"cards_playing_club_outline":              CardsPlayingClubOutline,
-		"cards_playing_diamond":                   CardsPlayingDiamond,
-		"cards_playing_diamond_multiple":          CardsPlayingDiamondMultiple,
-		"cards_playing_diamond_multiple_outline":  CardsPlayingDiamondMultipleOutline,
-		"cards_playing_diamond_outline":           CardsPlayingDiamondOutline,
-		"cards_playing_heart":                     CardsPlayingHeart,
-		"cards_playing_heart_multiple":            CardsPlayingHeartMultiple,
-		"cards_playing_heart_multiple_outline":    CardsPlayingHeartMultipleOutline,
-		"cards_playing_heart_outline":             CardsPlayingHeartOutline,
-		"cards_playing_outline":                   CardsPlayingOutline,
-		"cards_playing_spade":                     CardsPlayingSpade,
-		"cards_playing_spade_multiple":            CardsPlayingSpadeMultiple,
-		"cards_playing_spade_multiple_outline":    CardsPlayingSpadeMultipleOutline,
-		"cards_playing_spade_outline":             CardsPlayingSpadeOutline,
-		"cards_spade":                             CardsSpade,
-		"cards_spade_outline":                     CardsSpadeOutline,
-		"cards_variant":                           CardsVariant,
-		"carrot":                                  Carrot,
-		"cart":                                    Cart,
-		"cart_arrow_down":                         CartArrowDown,
-		"cart_arrow_right":                        CartArrowRight,
-		"cart_arrow_up":                           CartArrowUp,
-		"cart_check":                              CartCheck,
-		"cart_heart":                              CartHeart,
-		"cart_minus":                              CartMinus,
-		"cart_off":                                CartOff,
-		"cart_outline":                            CartOutline,
-		"cart_plus":                               CartPlus,
-		"cart_remove":                             CartRemove,
-		"cart_variant":                            CartVariant,
-		"case_sensitive_alt":                      CaseSensitiveAlt,
-		"cash":                                    Cash,
-		"cash_100":                                Cash100,
-		"cash_check":                              CashCheck,
-		"cash_clock":                              CashClock,
-		"cash_fast":                               CashFast,
-		"cash_lock":                               CashLock,
-		"cash_lock_open":                          CashLockOpen,
-		"cash_marker":                             CashMarker,
-		"cash_minus":                              CashMinus,
-		"cash_multiple":                           CashMultiple,
-		"cash_plus":                               CashPlus,
-		"cash_refund":                             CashRefund,
-		"cash_register":                           CashRegister,
-		"cash_remove":                             CashRemove,
-		"cash_sync":                               CashSync,
-		"cassette":                                Cassette,
-		"cast":                                    Cast,
-		"cast_audio":                              CastAudio,
-		"cast_audio_variant":                      CastAudioVariant,
-		"cast_connected":                          CastConnected,
-		"cast_education":                          CastEducation,
-		"cast_off":                                CastOff,
-		"cast_variant":                            CastVariant,
-		"castle":                                  Castle,
-		"cat":                                     Cat,
-		"cctv":                                    Cctv,
-		"cctv_off":                                CctvOff,
-		"ceiling_fan":                             CeilingFan,
-		"ceiling_fan_light":                       CeilingFanLight,
-		"ceiling_light":                           CeilingLight,
-		"ceiling_light_multiple":                  CeilingLightMultiple,
-		"ceiling_light_multiple_outline":          CeilingLightMultipleOutline,
-		"ceiling_light_outline":                   CeilingLightOutline,
-		"cellphone":                               Cellphone,
-		"cellphone_arrow_down":                    CellphoneArrowDown,
-		"cellphone_arrow_down_variant":            CellphoneArrowDownVariant,
-		"cellphone_basic":                         CellphoneBasic,
-		"cellphone_charging":                      CellphoneCharging,
-		"cellphone_check":                         CellphoneCheck,
-		"cellphone_cog":                           CellphoneCog,
-		"cellphone_dock":                          CellphoneDock,
-		"cellphone_information":                   CellphoneInformation,
-		"cellphone_key":                           CellphoneKey,
-		"cellphone_link":                          CellphoneLink,
-		"cellphone_link_off":                      CellphoneLinkOff,
-		"cellphone_lock":                          CellphoneLock,
-		"cellphone_marker":                        CellphoneMarker,
-		"cellphone_message":                       CellphoneMessage,
-		"cellphone_message_off":                   CellphoneMessageOff,
-		"cellphone_nfc":                           CellphoneNfc,
-		"cellphone_nfc_off":                       CellphoneNfcOff,
-		"cellphone_off":                           CellphoneOff,
-		"cellphone_play":                          CellphonePlay,
-		"cellphone_remove":                        CellphoneRemove,
-		"cellphone_screenshot":                    CellphoneScreenshot,
-		"cellphone_settings":                      CellphoneSettings,
-		"cellphone_sound":                         CellphoneSound,
-		"cellphone_text":                          CellphoneText,
-		"cellphone_wireless":                      CellphoneWireless,
-		"centos":                                  Centos,
-		"certificate":                             Certificate,
-		"certificate_outline":                     CertificateOutline,
-		"chair_rolling":                           ChairRolling,
-		"chair_school":                            ChairSchool,
-		"chandelier":                              Chandelier,
-		"charity":                                 Charity,
-		"chart_arc":                               ChartArc,
-		"chart_areaspline":                        ChartAreaspline,
-		"chart_areaspline_variant":                ChartAreasplineVariant,
-		"chart_bar":                               ChartBar,
-		"chart_bar_stacked":                       ChartBarStacked,
-		"chart_bell_curve":                        ChartBellCurve,
-		"chart_bell_curve_cumulative":             ChartBellCurveCumulative,
-		"chart_box":                               ChartBox,
-		"chart_box_outline":                       ChartBoxOutline,
-		"chart_box_plus_outline":                  ChartBoxPlusOutline,
-		"chart_bubble":                            ChartBubble,
-		"chart_donut":                             ChartDonut,
-		"chart_donut_variant":                     ChartDonutVariant,
-		"chart_gantt":                             ChartGantt,
-		"chart_histogram":                         ChartHistogram,
-		"chart_line":                              ChartLine,
-		"chart_line_stacked":                      ChartLineStacked,
-		"chart_line_variant":                      ChartLineVariant,
-		"chart_multiline":                         ChartMultiline,
-		"chart_multiple":                          ChartMultiple,
-		"chart_pie":                               ChartPie,
-		"chart_ppf":                               ChartPpf,
-		"chart_sankey":                            ChartSankey,
-		"chart_sankey_variant":                    ChartSankeyVariant,
-		"chart_scatter_plot":                      ChartScatterPlot,
-		"chart_scatter_plot_hexbin":               ChartScatterPlotHexbin,
-		"chart_timeline":                          ChartTimeline,
-		"chart_timeline_variant":                  ChartTimelineVariant,
-		"chart_timeline_variant_shimmer":          ChartTimelineVariantShimmer,
-		"chart_tree":                              ChartTree,
-		"chart_waterfall":                         ChartWaterfall,
-		"chat":                                    Chat,
-		"chat_alert":                              ChatAlert,
-		"chat_alert_outline":                      ChatAlertOutline,
-		"chat_minus":                              ChatMinus,
-		"chat_minus_outline":                      ChatMinusOutline,
-		"chat_outline":                            ChatOutline,
-		"chat_plus":                               ChatPlus,
-		"chat_plus_outline":                       ChatPlusOutline,
-		"chat_processing":                         ChatProcessing,
-		"chat_processing_outline":                 ChatProcessingOutline,
-		"chat_question":                           ChatQuestion,
-		"chat_question_outline":                   ChatQuestionOutline,
-		"chat_remove":                             ChatRemove,
-		"chat_remove_outline":                     ChatRemoveOutline,
-		"chat_sleep":                              ChatSleep,
-		"chat_sleep_outline":                      ChatSleepOutline,
-		"check":                                   Check,
-		"check_all":                               CheckAll,
-		"check_bold":                              CheckBold,
-		"check_circle":                            CheckCircle,
-		"check_circle_outline":                    CheckCircleOutline,
-		"check_decagram":                          CheckDecagram,
-		"check_decagram_outline":                  CheckDecagramOutline,
-		"check_network":                           CheckNetwork,
-		"check_network_outline":                   CheckNetworkOutline,
-		"check_outline":                           CheckOutline,
-		"check_underline":                         CheckUnderline,
-		"check_underline_circle":                  CheckUnderlineCircle,
-		"check_underline_circle_outline":          CheckUnderlineCircleOutline,
-		"checkbook":                               Checkbook,
-		"checkbox_blank":                          CheckboxBlank,
-		"checkbox_blank_badge":                    CheckboxBlankBadge,
-		"checkbox_blank_badge_outline":            CheckboxBlankBadgeOutline,
-		"checkbox_blank_circle":                   CheckboxBlankCircle,
-		"checkbox_blank_circle_outline":           CheckboxBlankCircleOutline,
-		"checkbox_blank_off":                      CheckboxBlankOff,
-		"checkbox_blank_off_outline":              CheckboxBlankOffOutline,
-		"checkbox_blank_outline":                  CheckboxBlankOutline,
-		"checkbox_intermediate":                   CheckboxIntermediate,
-		"checkbox_marked":                         CheckboxMarked,
-		"checkbox_marked_circle":                  CheckboxMarkedCircle,
-		"checkbox_marked_circle_outline":          CheckboxMarkedCircleOutline,
-		"checkbox_marked_circle_plus_outline":     CheckboxMarkedCirclePlusOutline,
-		"checkbox_marked_outline":                 CheckboxMarkedOutline,
-		"checkbox_multiple_blank":                 CheckboxMultipleBlank,
-		"checkbox_multiple_blank_circle":          CheckboxMultipleBlankCircle,
-		"checkbox_multiple_blank_circle_outline":  CheckboxMultipleBlankCircleOutline,
-		"checkbox_multiple_blank_outline":         CheckboxMultipleBlankOutline,
-		"checkbox_multiple_marked":                CheckboxMultipleMarked,
-		"checkbox_multiple_marked_circle":         CheckboxMultipleMarkedCircle,
-		"checkbox_multiple_marked_circle_outline": CheckboxMultipleMarkedCircleOutline,
-		"checkbox_multiple_marked_outline":        CheckboxMultipleMarkedOutline,
-		"checkbox_multiple_outline":               CheckboxMultipleOutline,
-		"checkbox_outline":                        CheckboxOutline,
-		"checkerboard":                            Checkerboard,
-		"checkerboard_minus":                      CheckerboardMinus,
-		"checkerboard_plus":                       CheckerboardPlus,
-		"checkerboard_remove":                     CheckerboardRemove,
-		"cheese":                                  Cheese,
-		"cheese_off":                              CheeseOff,
-		"chef_hat":                                ChefHat,
-		"chemical_weapon":                         ChemicalWeapon,
-		"chess_bishop":                            ChessBishop,
-		"chess_king":                              ChessKing,
-		"chess_knight":                            ChessKnight,
-		"chess_pawn":                              ChessPawn,
-		"chess_queen":                             ChessQueen,
-		"chess_rook":                              ChessRook,
-		"chevron_double_down":                     ChevronDoubleDown,
-		"chevron_double_left":                     ChevronDoubleLeft,
-		"chevron_double_right":                    ChevronDoubleRight,
-		"chevron_double_up":                       ChevronDoubleUp,
-		"chevron_down":                            ChevronDown,
-		"chevron_down_box":                        ChevronDownBox,
-		"chevron_down_box_outline":                ChevronDownBoxOutline,
-		"chevron_down_circle":                     ChevronDownCircle,
-		"chevron_down_circle_outline":             ChevronDownCircleOutline,
-		"chevron_left":                            ChevronLeft,
-		"chevron_left_box":                        ChevronLeftBox,
-		"chevron_left_box_outline":                ChevronLeftBoxOutline,
-		"chevron_left_circle":                     ChevronLeftCircle,
-		"chevron_left_circle_outline":             ChevronLeftCircleOutline,
-		"chevron_right":                           ChevronRight,
-		"chevron_right_box":                       ChevronRightBox,
-		"chevron_right_box_outline":               ChevronRightBoxOutline,
-		"chevron_right_circle":                    ChevronRightCircle,
-		"chevron_right_circle_outline":            ChevronRightCircleOutline,
-		"chevron_triple_down":                     ChevronTripleDown,
-		"chevron_triple_left":                     ChevronTripleLeft,
-		"chevron_triple_right":                    ChevronTripleRight,
-		"chevron_triple_up":                       ChevronTripleUp,
-		"chevron_up":                              ChevronUp,
-		"chevron_up_box":                          ChevronUpBox,
-		"chevron_up_box_outline":                  ChevronUpBoxOutline,
-		"chevron_up_circle":                       ChevronUpCircle,
-		"chevron_up_circle_outline":               ChevronUpCircleOutline,
-		"chili_alert":                             ChiliAlert,
-		"chili_alert_outline":                     ChiliAlertOutline,
-		"chili_hot":                               ChiliHot,
-		"chili_hot_outline":                       ChiliHotOutline,
-		"chili_medium":                            ChiliMedium,
-		"chili_medium_outline":                    ChiliMediumOutline,
-		"chili_mild":                              ChiliMild,
-		"chili_mild_outline":                      ChiliMildOutline,
-		"chili_off":                               ChiliOff,
-		"chili_off_outline":                       ChiliOffOutline,
-		"chip":                                    Chip,
-		"church":                                  Church,
-		"cigar":                                   Cigar,
-		"cigar_off":                               CigarOff,
-		"circle_box":                              CircleBox,
-		"circle_box_outline":                      CircleBoxOutline,
-		"circle_double":                           CircleDouble,
-		"circle_edit_outline":                     CircleEditOutline,
-		"circle_expand":                           CircleExpand,
-		"circle_half":                             CircleHalf,
-		"circle_half_full":                        CircleHalfFull,
-		"circle_medium":                           CircleMedium,
-		"circle_multiple":                         CircleMultiple,
-		"circle_multiple_outline":                 CircleMultipleOutline,
-		"circle_off_outline":                      CircleOffOutline,
-		"circle_opacity":                          CircleOpacity,
-		"circle_slice_1":                          CircleSlice1,
-		"circle_slice_2":                          CircleSlice2,
-		"circle_slice_3":                          CircleSlice3,
-		"circle_slice_4":                          CircleSlice4,
-		"circle_slice_5":                          CircleSlice5,
-		"circle_slice_6":                          CircleSlice6,
-		"circle_slice_7":                          CircleSlice7,
-		"circle_slice_8":                          CircleSlice8,
-		"circle_small":                            CircleSmall,
-		"circular_saw":                            CircularSaw,
-		"city":                                    City,
-		"city_variant":                            CityVariant,
-		"city_variant_outline":                    CityVariantOutline,
-		"clipboard":                               Clipboard,
-		"clipboard_account":                       ClipboardAccount,
-		"clipboard_account_outline":               ClipboardAccountOutline,
-		"clipboard_alert":                         ClipboardAlert,
-		"clipboard_alert_outline":                 ClipboardAlertOutline,
-		"clipboard_arrow_down":                    ClipboardArrowDown,
-		"clipboard_arrow_down_outline":            ClipboardArrowDownOutline,
-		"clipboard_arrow_left":                    ClipboardArrowLeft,
-		"clipboard_arrow_left_outline":            ClipboardArrowLeftOutline,
-		"clipboard_arrow_right":                   ClipboardArrowRight,
-		"clipboard_arrow_right_outline":           ClipboardArrowRightOutline,
-		"clipboard_arrow_up":                      ClipboardArrowUp,
-		"clipboard_arrow_up_outline":              ClipboardArrowUpOutline,
-		"clipboard_check":                         ClipboardCheck,
-		"clipboard_check_multiple":                ClipboardCheckMultiple,
-		"clipboard_check_multiple_outline":        ClipboardCheckMultipleOutline,
-		"clipboard_check_outline":                 ClipboardCheckOutline,
-		"clipboard_clock":                         ClipboardClock,
-		"clipboard_clock_outline":                 ClipboardClockOutline,
-		"clipboard_edit":                          ClipboardEdit,
-		"clipboard_edit_outline":                  ClipboardEditOutline,
-		"clipboard_file":                          ClipboardFile,
-		"clipboard_file_outline":                  ClipboardFileOutline,
-		"clipboard_flow":                          ClipboardFlow,
-		"clipboard_flow_outline":                  ClipboardFlowOutline,
-		"clipboard_list":                          ClipboardList,
-		"clipboard_list_outline":                  ClipboardListOutline,
-		"clipboard_minus":                         ClipboardMinus,
-		"clipboard_minus_outline":                 ClipboardMinusOutline,
-		"clipboard_multiple":                      ClipboardMultiple,
-		"clipboard_multiple_outline":              ClipboardMultipleOutline,
-		"clipboard_off":                           ClipboardOff,
-		"clipboard_off_outline":                   ClipboardOffOutline,
-		"clipboard_outline":                       ClipboardOutline,
-		"clipboard_play":                          ClipboardPlay,
-		"clipboard_play_multiple":                 ClipboardPlayMultiple,
-		"clipboard_play_multiple_outline":         ClipboardPlayMultipleOutline,
-		"clipboard_play_outline":                  ClipboardPlayOutline,
-		"clipboard_plus":                          ClipboardPlus,
-		"clipboard_plus_outline":                  ClipboardPlusOutline,
-		"clipboard_pulse":                         ClipboardPulse,
-		"clipboard_pulse_outline":                 ClipboardPulseOutline,
-		"clipboard_remove":                        ClipboardRemove,
-		"clipboard_remove_outline":                ClipboardRemoveOutline,
-		"clipboard_search":                        ClipboardSearch,
-		"clipboard_search_outline":                ClipboardSearchOutline,
-		"clipboard_text":                          ClipboardText,
-		"clipboard_text_clock":                    ClipboardTextClock,
-		"clipboard_text_clock_outline":            ClipboardTextClockOutline,
-		"clipboard_text_multiple":                 ClipboardTextMultiple,
-		"clipboard_text_multiple_outline":         ClipboardTextMultipleOutline,
-		"clipboard_text_off":                      ClipboardTextOff,
-		"clipboard_text_off_outline":              ClipboardTextOffOutline,
-		"clipboard_text_outline":                  ClipboardTextOutline,
-		"clipboard_text_play":                     ClipboardTextPlay,
-		"clipboard_text_play_outline":             ClipboardTextPlayOutline,
-		"clipboard_text_search":                   ClipboardTextSearch,
-		"clipboard_text_search_outline":           ClipboardTextSearchOutline,
-		"clippy":                                  Clippy,
-		"clock":                                   Clock,
-		"clock_alert":                             ClockAlert,
-		"clock_alert_outline":                     ClockAlertOutline,
-		"clock_check":                             ClockCheck,
-		"clock_check_outline":                     ClockCheckOutline,
-		"clock_digital":                           ClockDigital,
-		"clock_edit":                              ClockEdit,
-		"clock_edit_outline":                      ClockEditOutline,
-		"clock_end":                               ClockEnd,
-		"clock_fast":                              ClockFast,
-		"clock_in":                                ClockIn,
-		"clock_minus":                             ClockMinus,
-		"clock_minus_outline":                     ClockMinusOutline,
-		"clock_out":                               ClockOut,
-		"clock_outline":                           ClockOutline,
-		"clock_plus":                              ClockPlus,
-		"clock_plus_outline":                      ClockPlusOutline,
-		"clock_remove":                            ClockRemove,
-		"clock_remove_outline":                    ClockRemoveOutline,
-		"clock_start":                             ClockStart,
-		"clock_time_eight":                        ClockTimeEight,
-		"clock_time_eight_outline":                ClockTimeEightOutline,
-		"clock_time_eleven":                       ClockTimeEleven,
-		"clock_time_eleven_outline":               ClockTimeElevenOutline,
-		"clock_time_five":                         ClockTimeFive,
-		"clock_time_five_outline":                 ClockTimeFiveOutline,
-		"clock_time_four":                         ClockTimeFour,
-		"clock_time_four_outline":                 ClockTimeFourOutline,
-		"clock_time_nine":                         ClockTimeNine,
-		"clock_time_nine_outline":                 ClockTimeNineOutline,
-		"clock_time_one":                          ClockTimeOne,
-		"clock_time_one_outline":                  ClockTimeOneOutline,
-		"clock_time_seven":                        ClockTimeSeven,
-		"clock_time_seven_outline":                ClockTimeSevenOutline,
-		"clock_time_six":                          ClockTimeSix,
-		"clock_time_six_outline":                  ClockTimeSixOutline,
-		"clock_time_ten":                          ClockTimeTen,
-		"clock_time_ten_outline":                  ClockTimeTenOutline,
-		"clock_time_three":                        ClockTimeThree,
-		"clock_time_three_outline":                ClockTimeThreeOutline,
-		"clock_time_twelve":                       ClockTimeTwelve,
-		"clock_time_twelve_outline":               ClockTimeTwelveOutline,
-		"clock_time_two":                          ClockTimeTwo,
-		"clock_time_two_outline":                  ClockTimeTwoOutline,
-		"close":                                   Close,
-		"close_box":                               CloseBox,
-		"close_box_multiple":                      CloseBoxMultiple,
-		"close_box_multiple_outline":              CloseBoxMultipleOutline,
-		"close_box_outline":                       CloseBoxOutline,
-		"close_circle":                            CloseCircle,
-		"close_circle_multiple":                   CloseCircleMultiple,
-		"close_circle_multiple_outline":           CloseCircleMultipleOutline,
-		"close_circle_outline":                    CloseCircleOutline,
-		"close_network":                           CloseNetwork,
-		"close_network_outline":                   CloseNetworkOutline,
-		"close_octagon":                           CloseOctagon,
-		"close_octagon_outline":                   CloseOctagonOutline,
-		"close_outline":                           CloseOutline,
-		"close_thick":                             CloseThick,
-		"closed_caption":                          ClosedCaption,
-		"closed_caption_outline":                  ClosedCaptionOutline,
-		"cloud":                                   Cloud,
-		"cloud_alert":                             CloudAlert,
-		"cloud_braces":                            CloudBraces,
-		"cloud_check":                             CloudCheck,
-		"cloud_check_outline":                     CloudCheckOutline,
-		"cloud_circle":                            CloudCircle,
-		"cloud_download":                          CloudDownload,
-		"cloud_download_outline":                  CloudDownloadOutline,
-		"cloud_lock":                              CloudLock,
-		"cloud_lock_outline":                      CloudLockOutline,
-		"cloud_off_outline":                       CloudOffOutline,
-		"cloud_outline":                           CloudOutline,
-		"cloud_percent":                           CloudPercent,
-		"cloud_percent_outline":                   CloudPercentOutline,
-		"cloud_print":                             CloudPrint,
-		"cloud_print_outline":                     CloudPrintOutline,
-		"cloud_question":                          CloudQuestion,
-		"cloud_refresh":                           CloudRefresh,
-		"cloud_search":                            CloudSearch,
-		"cloud_search_outline":                    CloudSearchOutline,
-		"cloud_sync":                              CloudSync,
-		"cloud_sync_outline":                      CloudSyncOutline,
-		"cloud_tags":                              CloudTags,
-		"cloud_upload":                            CloudUpload,
-		"cloud_upload_outline":                    CloudUploadOutline,
-		"clover":                                  Clover,
-		"coach_lamp":                              CoachLamp,
-		"coach_lamp_variant":                      CoachLampVariant,
-		"coat_rack":                               CoatRack,
-		"code_array":                              CodeArray,
-		"code_braces":                             CodeBraces,
-		"code_braces_box":                         CodeBracesBox,
-		"code_brackets":                           CodeBrackets,
-		"code_equal":                              CodeEqual,
-		"code_greater_than":                       CodeGreaterThan,
-		"code_greater_than_or_equal":              CodeGreaterThanOrEqual,
-		"code_json":                               CodeJson,
-		"code_less_than":                          CodeLessThan,
-		"code_less_than_or_equal":                 CodeLessThanOrEqual,
-		"code_not_equal":                          CodeNotEqual,
-		"code_not_equal_variant":                  CodeNotEqualVariant,
-		"code_parentheses":                        CodeParentheses,
-		"code_parentheses_box":                    CodeParenthesesBox,
-		"code_string":                             CodeString,
-		"code_tags":                               CodeTags,
-		"code_tags_check":                         CodeTagsCheck,
-		"codepen":                                 Codepen,
-		"coffee":                                  Coffee,
-		"coffee_maker":                            CoffeeMaker,
-		"coffee_maker_check":                      CoffeeMakerCheck,
-		"coffee_maker_check_outline":              CoffeeMakerCheckOutline,
-		"coffee_maker_outline":                    CoffeeMakerOutline,
-		"coffee_off":                              CoffeeOff,
-		"coffee_off_outline":                      CoffeeOffOutline,
-		"coffee_outline":                          CoffeeOutline,
-		"coffee_to_go":                            CoffeeToGo,
-		"coffee_to_go_outline":                    CoffeeToGoOutline,
-		"coffin":                                  Coffin,
-		"cog":                                     Cog,
-		"cog_box":                                 CogBox,
-		"cog_clockwise":                           CogClockwise,
-		"cog_counterclockwise":                    CogCounterclockwise,
-		"cog_off":                                 CogOff,
-		"cog_off_outline":                         CogOffOutline,
-		"cog_outline":                             CogOutline,
-		"cog_pause":                               CogPause,
-		"cog_pause_outline":                       CogPauseOutline,
-		"cog_play":                                CogPlay,
-		"cog_play_outline":                        CogPlayOutline,
-		"cog_refresh":                             CogRefresh,
-		"cog_refresh_outline":                     CogRefreshOutline,
-		"cog_stop":                                CogStop,
-		"cog_stop_outline":                        CogStopOutline,
-		"cog_sync":                                CogSync,
-		"cog_sync_outline":                        CogSyncOutline,
-		"cog_transfer":                            CogTransfer,
-		"cog_transfer_outline":                    CogTransferOutline,
-		"cogs":                                    Cogs,
-		"collage":                                 Collage,
-		"collapse_all":                            CollapseAll,
-		"collapse_all_outline":                    CollapseAllOutline,
-		"color_helper":                            ColorHelper,
-		"comma":                                   Comma,
-		"comma_box":                               CommaBox,
-		"comma_box_outline":                       CommaBoxOutline,
-		"comma_circle":                            CommaCircle,
-		"comma_circle_outline":                    CommaCircleOutline,
-		"comment":                                 Comment,
-		"comment_account":                         CommentAccount,
-		"comment_account_outline":                 CommentAccountOutline,
-		"comment_alert":                           CommentAlert,
-		"comment_alert_outline":                   CommentAlertOutline,
-		"comment_arrow_left":                      CommentArrowLeft,
-		"comment_arrow_left_outline":              CommentArrowLeftOutline,
-		"comment_arrow_right":                     CommentArrowRight,
-		"comment_arrow_right_outline":             CommentArrowRightOutline,
-		"comment_bookmark":                        CommentBookmark,
-		"comment_bookmark_outline":                CommentBookmarkOutline,
-		"comment_check":                           CommentCheck,
-		"comment_check_outline":                   CommentCheckOutline,
-		"comment_edit":                            CommentEdit,
-		"comment_edit_outline":                    CommentEditOutline,
-		"comment_eye":                             CommentEye,
-		"comment_eye_outline":                     CommentEyeOutline,
-		"comment_flash":                           CommentFlash,
-		"comment_flash_outline":                   CommentFlashOutline,
-		"comment_minus":                           CommentMinus,
-		"comment_minus_outline":                   CommentMinusOutline,
-		"comment_multiple":                        CommentMultiple,
-		"comment_multiple_outline":                CommentMultipleOutline,
-		"comment_off":                             CommentOff,
-		"comment_off_outline":                     CommentOffOutline,
-		"comment_outline":                         CommentOutline,
-		"comment_plus":                            CommentPlus,
-		"comment_plus_outline":                    CommentPlusOutline,
-		"comment_processing":                      CommentProcessing,
-		"comment_processing_outline":              CommentProcessingOutline,
-		"comment_question":                        CommentQuestion,
-		"comment_question_outline":                CommentQuestionOutline,
-		"comment_quote":                           CommentQuote,
-		"comment_quote_outline":                   CommentQuoteOutline,
-		"comment_remove":                          CommentRemove,
-		"comment_remove_outline":                  CommentRemoveOutline,
-		"comment_search":                          CommentSearch,
-		"comment_search_outline":                  CommentSearchOutline,
-		"comment_text":                            CommentText,
-		"comment_text_multiple":                   CommentTextMultiple,
-		"comment_text_multiple_outline":           CommentTextMultipleOutline,
-		"comment_text_outline":                    CommentTextOutline,
-		"compare":                                 Compare,
-		"compare_horizontal":                      CompareHorizontal,
-		"compare_remove":                          CompareRemove,
-		"compare_vertical":                        CompareVertical,
-		"compass":                                 Compass,
-		"compass_off":                             CompassOff,
-		"compass_off_outline":                     CompassOffOutline,
-		"compass_outline":                         CompassOutline,
-		"compass_rose":                            CompassRose,
-		"compost":                                 Compost,
-		"cone":                                    Cone,
-		"cone_off":                                ConeOff,
-		"connection":                              Connection,
-		"console":                                 Console,
-		"console_line":                            ConsoleLine,
-		"console_network":                         ConsoleNetwork,
-		"console_network_outline":                 ConsoleNetworkOutline,
-		"consolidate":                             Consolidate,
-		"contactless_payment":                     ContactlessPayment,
-		"contactless_payment_circle":              ContactlessPaymentCircle,
-		"contactless_payment_circle_outline":      ContactlessPaymentCircleOutline,
-		"contacts":                                Contacts,
-		"contacts_outline":                        ContactsOutline,
-		"contain":                                 Contain,
-		"contain_end":                             ContainEnd,
-		"contain_start":                           ContainStart,
-		"content_copy":                            ContentCopy,
-		"content_cut":                             ContentCut,
-		"content_duplicate":                       ContentDuplicate,
-		"content_paste":                           ContentPaste,
-		"content_save":                            ContentSave,
-		"content_save_alert":                      ContentSaveAlert,
-		"content_save_alert_outline":              ContentSaveAlertOutline,
-		"content_save_all":                        ContentSaveAll,
-		"content_save_all_outline":                ContentSaveAllOutline,
-		"content_save_check":                      ContentSaveCheck,
-		"content_save_check_outline":              ContentSaveCheckOutline,
-		"content_save_cog":                        ContentSaveCog,
-		"content_save_cog_outline":                ContentSaveCogOutline,
-		"content_save_edit":                       ContentSaveEdit,
-		"content_save_edit_outline":               ContentSaveEditOutline,
-		"content_save_move":                       ContentSaveMove,
-		"content_save_move_outline":               ContentSaveMoveOutline,
-		"content_save_off":                        ContentSaveOff,
-		"content_save_off_outline":                ContentSaveOffOutline,
-		"content_save_outline":                    ContentSaveOutline,
-		"content_save_settings":                   ContentSaveSettings,
-		"content_save_settings_outline":           ContentSaveSettingsOutline,
-		"contrast":                                Contrast,
-		"contrast_box":                            ContrastBox,
-		"contrast_circle":                         ContrastCircle,
-		"controller_classic":                      ControllerClassic,
-		"controller_classic_outline":              ControllerClassicOutline,
-		"cookie":                                  Cookie,
-		"cookie_alert":                            CookieAlert,
-		"cookie_alert_outline":                    CookieAlertOutline,
-		"cookie_check":                            CookieCheck,
-		"cookie_check_outline":                    CookieCheckOutline,
-		"cookie_clock":                            CookieClock,
-		"cookie_clock_outline":                    CookieClockOutline,
-		"cookie_cog":                              CookieCog,
-		"cookie_cog_outline":                      CookieCogOutline,
-		"cookie_edit":                             CookieEdit,
-		"cookie_edit_outline":                     CookieEditOutline,
-		"cookie_lock":                             CookieLock,
-		"cookie_lock_outline":                     CookieLockOutline,
-		"cookie_minus":                            CookieMinus,
-		"cookie_minus_outline":                    CookieMinusOutline,
-		"cookie_off":                              CookieOff,
-		"cookie_off_outline":                      CookieOffOutline,
-		"cookie_outline":                          CookieOutline,
-		"cookie_plus":                             CookiePlus,
-		"cookie_plus_outline":                     CookiePlusOutline,
-		"cookie_refresh":                          CookieRefresh,
-		"cookie_refresh_outline":                  CookieRefreshOutline,
-		"cookie_remove":                           CookieRemove,
-		"cookie_remove_outline":                   CookieRemoveOutline,
-		"cookie_settings":                         CookieSettings,
-		"cookie_settings_outline":                 CookieSettingsOutline,
-		"coolant_temperature":                     CoolantTemperature,
-		"copyleft":                                Copyleft,
-		"copyright":                               Copyright,
-		"cordova":                                 Cordova,
-		"corn":                                    Corn,
-		"corn_off":                                CornOff,
-		"cosine_wave":                             CosineWave,
-		"counter":                                 Counter,
-		"countertop":                              Countertop,
-		"countertop_outline":                      CountertopOutline,
-		"cow":                                     Cow,
-		"cow_off":                                 CowOff,
-		"cpu_32_bit":                              Cpu32Bit,
-		"cpu_64_bit":                              Cpu64Bit,
-		"cradle":                                  Cradle,
-		"cradle_outline":                          CradleOutline,
-		"crane":                                   Crane,
-		"creation":                                Creation,
-		"creative_commons":                        CreativeCommons,
-		"credit_card":                             CreditCard,
-		"credit_card_check":                       CreditCardCheck,
-		"credit_card_check_outline":               CreditCardCheckOutline,
-		"credit_card_chip":                        CreditCardChip,
-		"credit_card_chip_outline":                CreditCardChipOutline,
-		"credit_card_clock":                       CreditCardClock,
-		"credit_card_clock_outline":               CreditCardClockOutline,
-		"credit_card_edit":                        CreditCardEdit,
-		"credit_card_edit_outline":                CreditCardEditOutline,
-		"credit_card_fast":                        CreditCardFast,
-		"credit_card_fast_outline":                CreditCardFastOutline,
-		"credit_card_lock":                        CreditCardLock,
-		"credit_card_lock_outline":                CreditCardLockOutline,
-		"credit_card_marker":                      CreditCardMarker,
-		"credit_card_marker_outline":              CreditCardMarkerOutline,
-		"credit_card_minus":                       CreditCardMinus,
-		"credit_card_minus_outline":               CreditCardMinusOutline,
-		"credit_card_multiple":                    CreditCardMultiple,
-		"credit_card_multiple_outline":            CreditCardMultipleOutline,
-		"credit_card_off":                         CreditCardOff,
-		"credit_card_off_outline":                 CreditCardOffOutline,
-		"credit_card_outline":                     CreditCardOutline,
-		"credit_card_plus":                        CreditCardPlus,
-		"credit_card_plus_outline":                CreditCardPlusOutline,
-		"credit_card_refresh":                     CreditCardRefresh,
-		"credit_card_refresh_outline":             CreditCardRefreshOutline,
-		"credit_card_refund":                      CreditCardRefund,
-		"credit_card_refund_outline":              CreditCardRefundOutline,
-		"credit_card_remove":                      CreditCardRemove,
-		"credit_card_remove_outline":              CreditCardRemoveOutline,
-		"credit_card_scan":                        CreditCardScan,
-		"credit_card_scan_outline":                CreditCardScanOutline,
-		"credit_card_search":                      CreditCardSearch,
-		"credit_card_search_outline":              CreditCardSearchOutline,
-		"credit_card_settings":                    CreditCardSettings,
-		"credit_card_settings_outline":            CreditCardSettingsOutline,
-		"credit_card_sync":                        CreditCardSync,
-		"credit_card_sync_outline":                CreditCardSyncOutline,
-		"credit_card_wireless":                    CreditCardWireless,
-		"credit_card_wireless_off":                CreditCardWirelessOff,
-		"credit_card_wireless_off_outline":        CreditCardWirelessOffOutline,
-		"credit_card_wireless_outline":            CreditCardWirelessOutline,
-		"cricket":                                 Cricket,
-		"crop":                                    Crop,
-		"crop_free":                               CropFree,
-		"crop_landscape":                          CropLandscape,
-		"crop_portrait":                           CropPortrait,
-		"crop_rotate":                             CropRotate,
-		"crop_square":                             CropSquare,
-		"cross":                                   Cross,
-		"cross_bolnisi":                           CrossBolnisi,
-		"cross_celtic":                            CrossCeltic,
-		"cross_outline":                           CrossOutline,
-		"crosshairs":                              Crosshairs,
-		"crosshairs_gps":                          CrosshairsGps,
-		"crosshairs_off":                          CrosshairsOff,
-		"crosshairs_question":                     CrosshairsQuestion,
-		"crowd":                                   Crowd,
-		"crown":                                   Crown,
-		"crown_circle":                            CrownCircle,
-		"crown_circle_outline":                    CrownCircleOutline,
-		"crown_outline":                           CrownOutline,
-		"cryengine":                               Cryengine,
-		"crystal_ball":                            CrystalBall,
-		"cube":                                    Cube,
-		"cube_off":                                CubeOff,
-		"cube_off_outline":                        CubeOffOutline,
-		"cube_outline":                            CubeOutline,
-		"cube_scan":                               CubeScan,
-		"cube_send":                               CubeSend,
-		"cube_unfolded":                           CubeUnfolded,
-		"cup":                                     Cup,
-		"cup_off":                                 CupOff,
-		"cup_off_outline":                         CupOffOutline,
-		"cup_outline":                             CupOutline,
-		"cup_water":                               CupWater,
-		"cupboard":                                Cupboard,
-		"cupboard_outline":                        CupboardOutline,
-		"cupcake":                                 Cupcake,
-		"curling":                                 Curling,
-		"currency_bdt":                            CurrencyBdt,
-		"currency_brl":                            CurrencyBrl,
-		"currency_btc":                            CurrencyBtc,
-		"currency_cny":                            CurrencyCny,
-		"currency_eth":                            CurrencyEth,
-		"currency_eur":                            CurrencyEur,
-		"currency_eur_off":                        CurrencyEurOff,
-		"currency_fra":                            CurrencyFra,
-		"currency_gbp":                            CurrencyGbp,
-		"currency_ils":                            CurrencyIls,
-		"currency_inr":                            CurrencyInr,
-		"currency_jpy":                            CurrencyJpy,
-		"currency_krw":                            CurrencyKrw,
-		"currency_kzt":                            CurrencyKzt,
-		"currency_mnt":                            CurrencyMnt,
-		"currency_ngn":                            CurrencyNgn,
-		"currency_php":                            CurrencyPhp,
-		"currency_rial":                           CurrencyRial,
-		"currency_rub":                            CurrencyRub,
-		"currency_rupee":                          CurrencyRupee,
-		"currency_sign":                           CurrencySign,
-		"currency_try":                            CurrencyTry,
-		"currency_twd":                            CurrencyTwd,
-		"currency_usd":                            CurrencyUsd,
-		"currency_usd_off":                        CurrencyUsdOff,
-		"current_ac":                              CurrentAc,
-		"current_dc":                              CurrentDc,
-		"cursor_default":                          CursorDefault,
-		"cursor_default_click":                    CursorDefaultClick,
-		"cursor_default_click_outline":            CursorDefaultClickOutline,
-		"cursor_default_gesture":                  CursorDefaultGesture,
-		"cursor_default_gesture_outline":          CursorDefaultGestureOutline,
-		"cursor_default_outline":                  CursorDefaultOutline,
-		"cursor_move":                             CursorMove,
-		"cursor_pointer":                          CursorPointer,
-		"cursor_text":                             CursorText,
-		"curtains":                                Curtains,
-		"curtains_closed":                         CurtainsClosed,
-		"cylinder":                                Cylinder,
-		"cylinder_off":                            CylinderOff,
-		"dance_ballroom":                          DanceBallroom,
-		"dance_pole":                              DancePole,
-		"data_matrix":                             DataMatrix,
-		"data_matrix_edit":                        DataMatrixEdit,
-		"data_matrix_minus":                       DataMatrixMinus,
-		"data_matrix_plus":                        DataMatrixPlus,
-		"data_matrix_remove":                      DataMatrixRemove,
-		"data_matrix_scan":                        DataMatrixScan,
-		"database":                                Database,
-		"database_alert":                          DatabaseAlert,
-		"database_alert_outline":                  DatabaseAlertOutline,
-		"database_arrow_down":                     DatabaseArrowDown,
-		"database_arrow_down_outline":             DatabaseArrowDownOutline,
-		"database_arrow_left":                     DatabaseArrowLeft,
-		"database_arrow_left_outline":             DatabaseArrowLeftOutline,
-		"database_arrow_right":                    DatabaseArrowRight,
-		"database_arrow_right_outline":            DatabaseArrowRightOutline,
-		"database_arrow_up":                       DatabaseArrowUp,
-		"database_arrow_up_outline":               DatabaseArrowUpOutline,
-		"database_check":                          DatabaseCheck,
-		"database_check_outline":                  DatabaseCheckOutline,
-		"database_clock":                          DatabaseClock,
-		"database_clock_outline":                  DatabaseClockOutline,
-		"database_cog":                            DatabaseCog,
-		"database_cog_outline":                    DatabaseCogOutline,
-		"database_edit":                           DatabaseEdit,
-		"database_edit_outline":                   DatabaseEditOutline,
-		"database_export":                         DatabaseExport,
-		"database_export_outline":                 DatabaseExportOutline,
-		"database_eye":                            DatabaseEye,
-		"database_eye_off":                        DatabaseEyeOff,
-		"database_eye_off_outline":                DatabaseEyeOffOutline,
-		"database_eye_outline":                    DatabaseEyeOutline,
-		"database_import":                         DatabaseImport,
-		"database_import_outline":                 DatabaseImportOutline,
-		"database_lock":                           DatabaseLock,
-		"database_lock_outline":                   DatabaseLockOutline,
-		"database_marker":                         DatabaseMarker,
-		"database_marker_outline":                 DatabaseMarkerOutline,
-		"database_minus":                          DatabaseMinus,
-		"database_minus_outline":                  DatabaseMinusOutline,
-		"database_off":                            DatabaseOff,
-		"database_off_outline":                    DatabaseOffOutline,
-		"database_outline":                        DatabaseOutline,
-		"database_plus":                           DatabasePlus,
-		"database_plus_outline":                   DatabasePlusOutline,
-		"database_refresh":                        DatabaseRefresh,
-		"database_refresh_outline":                DatabaseRefreshOutline,
-		"database_remove":                         DatabaseRemove,
-		"database_remove_outline":                 DatabaseRemoveOutline,
-		"database_search":                         DatabaseSearch,
-		"database_search_outline":                 DatabaseSearchOutline,
-		"database_settings":                       DatabaseSettings,
-		"database_settings_outline":               DatabaseSettingsOutline,
-		"database_sync":                           DatabaseSync,
-		"database_sync_outline":                   DatabaseSyncOutline,
-		"death_star":                              DeathStar,
-		"death_star_variant":                      DeathStarVariant,
-		"deathly_hallows":                         DeathlyHallows,
-		"debian":                                  Debian,
-		"debug_step_into":                         DebugStepInto,
-		"debug_step_out":                          DebugStepOut,
-		"debug_step_over":                         DebugStepOver,
-		"decagram":                                Decagram,
-		"decagram_outline":                        DecagramOutline,
-		"decimal":                                 Decimal,
-		"decimal_comma":                           DecimalComma,
-		"decimal_comma_decrease":                  DecimalCommaDecrease,
-		"decimal_comma_increase":                  DecimalCommaIncrease,
-		"decimal_decrease":                        DecimalDecrease,
-		"decimal_increase":                        DecimalIncrease,
-		"delete":                                  Delete,
-		"delete_alert":                            DeleteAlert,
-		"delete_alert_outline":                    DeleteAlertOutline,
-		"delete_circle":                           DeleteCircle,
-		"delete_circle_outline":                   DeleteCircleOutline,
-		"delete_clock":                            DeleteClock,
-		"delete_clock_outline":                    DeleteClockOutline,
-		"delete_empty":                            DeleteEmpty,
-		"delete_empty_outline":                    DeleteEmptyOutline,
-		"delete_forever":                          DeleteForever,
-		"delete_forever_outline":                  DeleteForeverOutline,
-		"delete_off":                              DeleteOff,
-		"delete_off_outline":                      DeleteOffOutline,
-		"delete_outline":                          DeleteOutline,
-		"delete_restore":                          DeleteRestore,
-		"delete_sweep":                            DeleteSweep,
-		"delete_sweep_outline":                    DeleteSweepOutline,
-		"delete_variant":                          DeleteVariant,
-		"delta":                                   Delta,
-		"desk":                                    Desk,
-		"desk_lamp":                               DeskLamp,
-		"deskphone":                               Deskphone,
-		"desktop_classic":                         DesktopClassic,
-		"desktop_mac":                             DesktopMac,
-		"desktop_mac_dashboard":                   DesktopMacDashboard,
-		"desktop_tower":                           DesktopTower,
-		"desktop_tower_monitor":                   DesktopTowerMonitor,
-		"details":                                 Details,
-		"dev_to":                                  DevTo,
-		"developer_board":                         DeveloperBoard,
-		"deviantart":                              Deviantart,
-		"devices":                                 Devices,
-		"dharmachakra":                            Dharmachakra,
-		"diabetes":                                Diabetes,
-		"dialpad":                                 Dialpad,
-		"diameter":                                Diameter,
-		"diameter_outline":                        DiameterOutline,
-		"diameter_variant":                        DiameterVariant,
-		"diamond":                                 Diamond,
-		"diamond_outline":                         DiamondOutline,
-		"diamond_stone":                           DiamondStone,
-		"dice_1":                                  Dice1,
-		"dice_1_outline":                          Dice1Outline,
-		"dice_2":                                  Dice2,
-		"dice_2_outline":                          Dice2Outline,
-		"dice_3":                                  Dice3,
-		"dice_3_outline":                          Dice3Outline,
-		"dice_4":                                  Dice4,
-		"dice_4_outline":                          Dice4Outline,
-		"dice_5":                                  Dice5,
-		"dice_5_outline":                          Dice5Outline,
-		"dice_6":                                  Dice6,
-		"dice_6_outline":                          Dice6Outline,
-		"dice_d10":                                DiceD10,
-		"dice_d10_outline":                        DiceD10Outline,
-		"dice_d12":                                DiceD12,
-		"dice_d12_outline":                        DiceD12Outline,
-		"dice_d20":                                DiceD20,
-		"dice_d20_outline":                        DiceD20Outline,
-		"dice_d4":                                 DiceD4,
-		"dice_d4_outline":                         DiceD4Outline,
-		"dice_d6":                                 DiceD6,
-		"dice_d6_outline":                         DiceD6Outline,
-		"dice_d8":                                 DiceD8,
-		"dice_d8_outline":                         DiceD8Outline,
-		"dice_multiple":                           DiceMultiple,
-		"dice_multiple_outline":                   DiceMultipleOutline,
-		"digital_ocean":                           DigitalOcean,
-		"dip_switch":                              DipSwitch,
-		"directions":                              Directions,
-		"directions_fork":                         DirectionsFork,
-		"disc":                                    Disc,
-		"disc_alert":                              DiscAlert,
-		"disc_player":                             DiscPlayer,
-		"discord":                                 Discord,
-		"dishwasher":                              Dishwasher,
-		"dishwasher_alert":                        DishwasherAlert,
-		"dishwasher_off":                          DishwasherOff,
-		"disqus":                                  Disqus,
-		"distribute_horizontal_center":            DistributeHorizontalCenter,
-		"distribute_horizontal_left":              DistributeHorizontalLeft,
-		"distribute_horizontal_right":             DistributeHorizontalRight,
-		"distribute_vertical_bottom":              DistributeVerticalBottom,
-		"distribute_vertical_center":              DistributeVerticalCenter,
-		"distribute_vertical_top":                 DistributeVerticalTop,
-		"diversify":                               Diversify,
-		"diving":                                  Diving,
-		"diving_flippers":                         DivingFlippers,
-		"diving_helmet":                           DivingHelmet,
-		"diving_scuba":                            DivingScuba,
-		"diving_scuba_flag":                       DivingScubaFlag,
-		"diving_scuba_tank":                       DivingScubaTank,
-		"diving_scuba_tank_multiple":              DivingScubaTankMultiple,
-		"diving_snorkel":                          DivingSnorkel,
-		"division":                                Division,
-		"division_box":                            DivisionBox,
-		"dlna":                                    Dlna,
-		"dna":                                     Dna,
-		"dns":                                     Dns,
-		"dns_outline":                             DnsOutline,
-		"dock_bottom":                             DockBottom,
-		"dock_left":                               DockLeft,
-		"dock_right":                              DockRight,
-		"dock_top":                                DockTop,
-		"dock_window":                             DockWindow,
-		"docker":                                  Docker,
-		"doctor":                                  Doctor,
-		"dog":                                     Dog,
-		"dog_service":                             DogService,
-		"dog_side":                                DogSide,
-		"dog_side_off":                            DogSideOff,
-		"dolby":                                   Dolby,
-		"dolly":                                   Dolly,
-		"dolphin":                                 Dolphin,
-		"domain":                                  Domain,
-		"domain_off":                              DomainOff,
-		"domain_plus":                             DomainPlus,
-		"domain_remove":                           DomainRemove,
-		"dome_light":                              DomeLight,
-		"domino_mask":                             DominoMask,
-		"donkey":                                  Donkey,
-		"door":                                    Door,
-		"door_closed":                             DoorClosed,
-		"door_closed_lock":                        DoorClosedLock,
-		"door_open":                               DoorOpen,
-		"door_sliding":                            DoorSliding,
-		"door_sliding_lock":                       DoorSlidingLock,
-		"door_sliding_open":                       DoorSlidingOpen,
-		"doorbell":                                Doorbell,
-		"doorbell_video":                          DoorbellVideo,
-		"dot_net":                                 DotNet,
-		"dots_circle":                             DotsCircle,
-		"dots_grid":                               DotsGrid,
-		"dots_hexagon":                            DotsHexagon,
-		"dots_horizontal":                         DotsHorizontal,
-		"dots_horizontal_circle":                  DotsHorizontalCircle,
-		"dots_horizontal_circle_outline":          DotsHorizontalCircleOutline,
-		"dots_square":                             DotsSquare,
-		"dots_triangle":                           DotsTriangle,
-		"dots_vertical":                           DotsVertical,
-		"dots_vertical_circle":                    DotsVerticalCircle,
-		"dots_vertical_circle_outline":            DotsVerticalCircleOutline,
-		"download":                                Download,
-		"download_box":                            DownloadBox,
-		"download_box_outline":                    DownloadBoxOutline,
-		"download_circle":                         DownloadCircle,
-		"download_circle_outline":                 DownloadCircleOutline,
-		"download_lock":                           DownloadLock,
-		"download_lock_outline":                   DownloadLockOutline,
-		"download_multiple":                       DownloadMultiple,
-		"download_network":                        DownloadNetwork,
-		"download_network_outline":                DownloadNetworkOutline,
-		"download_off":                            DownloadOff,
-		"download_off_outline":                    DownloadOffOutline,
-		"download_outline":                        DownloadOutline,
-		"drag":                                    Drag,
-		"drag_horizontal":                         DragHorizontal,
-		"drag_horizontal_variant":                 DragHorizontalVariant,
-		"drag_variant":                            DragVariant,
-		"drag_vertical":                           DragVertical,
-		"drag_vertical_variant":                   DragVerticalVariant,
-		"drama_masks":                             DramaMasks,
-		"draw":                                    Draw,
-		"draw_pen":                                DrawPen,
-		"drawing":                                 Drawing,
-		"drawing_box":                             DrawingBox,
-		"dresser":                                 Dresser,
-		"dresser_outline":                         DresserOutline,
-		"drone":                                   Drone,
-		"dropbox":                                 Dropbox,
-		"drupal":                                  Drupal,
-		"duck":                                    Duck,
-		"dumbbell":                                Dumbbell,
-		"dump_truck":                              DumpTruck,
-		"ear_hearing":                             EarHearing,
-		"ear_hearing_loop":                        EarHearingLoop,
-		"ear_hearing_off":                         EarHearingOff,
-		"earbuds":                                 Earbuds,
-		"earbuds_off":                             EarbudsOff,
-		"earbuds_off_outline":                     EarbudsOffOutline,
-		"earbuds_outline":                         EarbudsOutline,
-		"earth":                                   Earth,
-		"earth_arrow_right":                       EarthArrowRight,
-		"earth_box":                               EarthBox,
-		"earth_box_minus":                         EarthBoxMinus,
-		"earth_box_off":                           EarthBoxOff,
-		"earth_box_plus":                          EarthBoxPlus,
-		"earth_box_remove":                        EarthBoxRemove,
-		"earth_minus":                             EarthMinus,
-		"earth_off":                               EarthOff,
-		"earth_plus":                              EarthPlus,
-		"earth_remove":                            EarthRemove,
-		"egg":                                     Egg,
-		"egg_easter":                              EggEaster,
-		"egg_fried":                               EggFried,
-		"egg_off":                                 EggOff,
-		"egg_off_outline":                         EggOffOutline,
-		"egg_outline":                             EggOutline,
-		"eiffel_tower":                            EiffelTower,
-		"eight_track":                             EightTrack,
-		"eject":                                   Eject,
-		"eject_outline":                           EjectOutline,
-		"electric_switch":                         ElectricSwitch,
-		"electric_switch_closed":                  ElectricSwitchClosed,
-		"electron_framework":                      ElectronFramework,
-		"elephant":                                Elephant,
-		"elevation_decline":                       ElevationDecline,
-		"elevation_rise":                          ElevationRise,
-		"elevator":                                Elevator,
-		"elevator_down":                           ElevatorDown,
-		"elevator_passenger":                      ElevatorPassenger,
-		"elevator_passenger_off":                  ElevatorPassengerOff,
-		"elevator_passenger_off_outline":          ElevatorPassengerOffOutline,
-		"elevator_passenger_outline":              ElevatorPassengerOutline,
-		"elevator_up":                             ElevatorUp,
-		"ellipse":                                 Ellipse,
-		"ellipse_outline":                         EllipseOutline,
-		"email":                                   Email,
-		"email_alert":                             EmailAlert,
-		"email_alert_outline":                     EmailAlertOutline,
-		"email_box":                               EmailBox,
-		"email_check":                             EmailCheck,
-		"email_check_outline":                     EmailCheckOutline,
-		"email_edit":                              EmailEdit,
-		"email_edit_outline":                      EmailEditOutline,
-		"email_fast":                              EmailFast,
-		"email_fast_outline":                      EmailFastOutline,
-		"email_lock":                              EmailLock,
-		"email_mark_as_unread":                    EmailMarkAsUnread,
-		"email_minus":                             EmailMinus,
-		"email_minus_outline":                     EmailMinusOutline,
-		"email_multiple":                          EmailMultiple,
-		"email_multiple_outline":                  EmailMultipleOutline,
-		"email_newsletter":                        EmailNewsletter,
-		"email_off":                               EmailOff,
-		"email_off_outline":                       EmailOffOutline,
-		"email_open":                              EmailOpen,
-		"email_open_multiple":                     EmailOpenMultiple,
-		"email_open_multiple_outline":             EmailOpenMultipleOutline,
-		"email_open_outline":                      EmailOpenOutline,
-		"email_outline":                           EmailOutline,
-		"email_plus":                              EmailPlus,
-		"email_plus_outline":                      EmailPlusOutline,
-		"email_receive":                           EmailReceive,
-		"email_receive_outline":                   EmailReceiveOutline,
-		"email_remove":                            EmailRemove,
-		"email_remove_outline":                    EmailRemoveOutline,
-		"email_seal":                              EmailSeal,
-		"email_seal_outline":                      EmailSealOutline,
-		"email_search":                            EmailSearch,
-		"email_search_outline":                    EmailSearchOutline,
-		"email_send":                              EmailSend,
-		"email_send_outline":                      EmailSendOutline,
-		"email_sync":                              EmailSync,
-		"email_sync_outline":                      EmailSyncOutline,
-		"email_variant":                           EmailVariant,
-		"ember":                                   Ember,
-		"emby":                                    Emby,
-		"emoticon":                                Emoticon,
-		"emoticon_angry":                          EmoticonAngry,
-		"emoticon_angry_outline":                  EmoticonAngryOutline,
-		"emoticon_confused":                       EmoticonConfused,
-		"emoticon_confused_outline":               EmoticonConfusedOutline,
-		"emoticon_cool":                           EmoticonCool,
-		"emoticon_cool_outline":                   EmoticonCoolOutline,
-		"emoticon_cry":                            EmoticonCry,
-		"emoticon_cry_outline":                    EmoticonCryOutline,
-		"emoticon_dead":                           EmoticonDead,
-		"emoticon_dead_outline":                   EmoticonDeadOutline,
-		"emoticon_devil":                          EmoticonDevil,
-		"emoticon_devil_outline":                  EmoticonDevilOutline,
-		"emoticon_excited":                        EmoticonExcited,
-		"emoticon_excited_outline":                EmoticonExcitedOutline,
-		"emoticon_frown":                          EmoticonFrown,
-		"emoticon_frown_outline":                  EmoticonFrownOutline,
-		"emoticon_happy":                          EmoticonHappy,
-		"emoticon_happy_outline":                  EmoticonHappyOutline,
-		"emoticon_kiss":                           EmoticonKiss,
-		"emoticon_kiss_outline":                   EmoticonKissOutline,
-		"emoticon_lol":                            EmoticonLol,
-		"emoticon_lol_outline":                    EmoticonLolOutline,
-		"emoticon_neutral":                        EmoticonNeutral,
-		"emoticon_neutral_outline":                EmoticonNeutralOutline,
-		"emoticon_outline":                        EmoticonOutline,
-		"emoticon_poop":                           EmoticonPoop,
-		"emoticon_poop_outline":                   EmoticonPoopOutline,
-		"emoticon_sad":                            EmoticonSad,
-		"emoticon_sad_outline":                    EmoticonSadOutline,
-		"emoticon_sick":                           EmoticonSick,
-		"emoticon_sick_outline":                   EmoticonSickOutline,
-		"emoticon_tongue":                         EmoticonTongue,
-		"emoticon_tongue_outline":                 EmoticonTongueOutline,
-		"emoticon_wink":                           EmoticonWink,
-		"emoticon_wink_outline":                   EmoticonWinkOutline,
-		"engine":                                  Engine,
-		"engine_off":                              EngineOff,
-		"engine_off_outline":                      EngineOffOutline,
-		"engine_outline":                          EngineOutline,
-		"epsilon":                                 Epsilon,
-		"equal":                                   Equal,
-		"equal_box":                               EqualBox,
-		"equalizer":                               Equalizer,
-		"equalizer_outline":                       EqualizerOutline,
-		"eraser":                                  Eraser,
-		"eraser_variant":                          EraserVariant,
-		"escalator":                               Escalator,
-		"escalator_box":                           EscalatorBox,
-		"escalator_down":                          EscalatorDown,
-		"escalator_up":                            EscalatorUp,
-		"eslint":                                  Eslint,
-		"et":                                      Et,
-		"ethereum":                                Ethereum,
-		"ethernet":                                Ethernet,
-		"ethernet_cable":                          EthernetCable,
-		"ethernet_cable_off":                      EthernetCableOff,
-		"ev_plug_ccs1":                            EvPlugCcs1,
-		"ev_plug_ccs2":                            EvPlugCcs2,
-		"ev_plug_chademo":                         EvPlugChademo,
-		"ev_plug_tesla":                           EvPlugTesla,
-		"ev_plug_type1":                           EvPlugType1,
-		"ev_plug_type2":                           EvPlugType2,
-		"ev_station":                              EvStation,
-		"evernote":                                Evernote,
-		"excavator":                               Excavator,
-		"exclamation":                             Exclamation,
-		"exclamation_thick":                       ExclamationThick,
-		"exit_run":                                ExitRun,
-		"exit_to_app":                             ExitToApp,
-		"expand_all":                              ExpandAll,
-		"expand_all_outline":                      ExpandAllOutline,
-		"expansion_card":                          ExpansionCard,
-		"expansion_card_variant":                  ExpansionCardVariant,
-		"exponent":                                Exponent,
-		"exponent_box":                            ExponentBox,
-		"export":                                  Export,
-		"export_variant":                          ExportVariant,
-		"eye":                                     Eye,
-		"eye_arrow_left":                          EyeArrowLeft,
-		"eye_arrow_left_outline":                  EyeArrowLeftOutline,
-		"eye_arrow_right":                         EyeArrowRight,
-		"eye_arrow_right_outline":                 EyeArrowRightOutline,
-		"eye_check":                               EyeCheck,
-		"eye_check_outline":                       EyeCheckOutline,
-		"eye_circle":                              EyeCircle,
-		"eye_circle_outline":                      EyeCircleOutline,
-		"eye_minus":                               EyeMinus,
-		"eye_minus_outline":                       EyeMinusOutline,
-		"eye_off":                                 EyeOff,
-		"eye_off_outline":                         EyeOffOutline,
-		"eye_outline":                             EyeOutline,
-		"eye_plus":                                EyePlus,
-		"eye_plus_outline":                        EyePlusOutline,
-		"eye_refresh":                             EyeRefresh,
-		"eye_refresh_outline":                     EyeRefreshOutline,
-		"eye_remove":                              EyeRemove,
-		"eye_remove_outline":                      EyeRemoveOutline,
-		"eye_settings":                            EyeSettings,
-		"eye_settings_outline":                    EyeSettingsOutline,
-		"eyedropper":                              Eyedropper,
-		"eyedropper_minus":                        EyedropperMinus,
-		"eyedropper_off":                          EyedropperOff,
-		"eyedropper_plus":                         EyedropperPlus,
-		"eyedropper_remove":                       EyedropperRemove,
-		"eyedropper_variant":                      EyedropperVariant,
-		"face_agent":                              FaceAgent,
-		"face_man":                                FaceMan,
-		"face_man_outline":                        FaceManOutline,
-		"face_man_profile":                        FaceManProfile,
-		"face_man_shimmer":                        FaceManShimmer,
-		"face_man_shimmer_outline":                FaceManShimmerOutline,
-		"face_mask":                               FaceMask,
-		"face_mask_outline":                       FaceMaskOutline,
-		"face_recognition":                        FaceRecognition,
-		"face_woman":                              FaceWoman,
-		"face_woman_outline":                      FaceWomanOutline,
-		"face_woman_profile":                      FaceWomanProfile,
-		"face_woman_shimmer":                      FaceWomanShimmer,
-		"face_woman_shimmer_outline":              FaceWomanShimmerOutline,
-		"facebook":                                Facebook,
-		"facebook_gaming":                         FacebookGaming,
-		"facebook_messenger":                      FacebookMessenger,
-		"facebook_workplace":                      FacebookWorkplace,
-		"factory":                                 Factory,
-		"family_tree":                             FamilyTree,
-		"fan":                                     Fan,
-		"fan_alert":                               FanAlert,
-		"fan_auto":                                FanAuto,
-		"fan_chevron_down":                        FanChevronDown,
-		"fan_chevron_up":                          FanChevronUp,
-		"fan_clock":                               FanClock,
-		"fan_minus":                               FanMinus,
-		"fan_off":                                 FanOff,
-		"fan_plus":                                FanPlus,
-		"fan_remove":                              FanRemove,
-		"fan_speed_1":                             FanSpeed1,
-		"fan_speed_2":                             FanSpeed2,
-		"fan_speed_3":                             FanSpeed3,
-		"fast_forward":                            FastForward,
-		"fast_forward_10":                         FastForward10,
-		"fast_forward_15":                         FastForward15,
-		"fast_forward_30":                         FastForward30,
-		"fast_forward_5":                          FastForward5,
-		"fast_forward_60":                         FastForward60,
-		"fast_forward_outline":                    FastForwardOutline,
-		"fax":                                     Fax,
-		"feather":                                 Feather,
-		"feature_search":                          FeatureSearch,
-		"feature_search_outline":                  FeatureSearchOutline,
-		"fedora":                                  Fedora,
-		"fence":                                   Fence,
-		"fence_electric":                          FenceElectric,
-		"fencing":                                 Fencing,
-		"ferris_wheel":                            FerrisWheel,
-		"ferry":                                   Ferry,
-		"file":                                    File,
-		"file_account":                            FileAccount,
-		"file_account_outline":                    FileAccountOutline,
-		"file_alert":                              FileAlert,
-		"file_alert_outline":                      FileAlertOutline,
-		"file_arrow_left_right":                   FileArrowLeftRight,
-		"file_arrow_left_right_outline":           FileArrowLeftRightOutline,
-		"file_arrow_up_down":                      FileArrowUpDown,
-		"file_arrow_up_down_outline":              FileArrowUpDownOutline,
-		"file_cabinet":                            FileCabinet,
-		"file_cad":                                FileCad,
-		"file_cad_box":                            FileCadBox,
-		"file_cancel":                             FileCancel,
-		"file_cancel_outline":                     FileCancelOutline,
-		"file_certificate":                        FileCertificate,
-		"file_certificate_outline":                FileCertificateOutline,
-		"file_chart":                              FileChart,
-		"file_chart_check":                        FileChartCheck,
-		"file_chart_check_outline":                FileChartCheckOutline,
-		"file_chart_outline":                      FileChartOutline,
-		"file_check":                              FileCheck,
-		"file_check_outline":                      FileCheckOutline,
-		"file_clock":                              FileClock,
-		"file_clock_outline":                      FileClockOutline,
-		"file_cloud":                              FileCloud,
-		"file_cloud_outline":                      FileCloudOutline,
-		"file_code":                               FileCode,
-		"file_code_outline":                       FileCodeOutline,
-		"file_cog":                                FileCog,
-		"file_cog_outline":                        FileCogOutline,
-		"file_compare":                            FileCompare,
-		"file_delimited":                          FileDelimited,
-		"file_delimited_outline":                  FileDelimitedOutline,
-		"file_document":                           FileDocument,
-		"file_document_alert":                     FileDocumentAlert,
-		"file_document_alert_outline":             FileDocumentAlertOutline,
-		"file_document_check":                     FileDocumentCheck,
-		"file_document_check_outline":             FileDocumentCheckOutline,
-		"file_document_edit":                      FileDocumentEdit,
-		"file_document_edit_outline":              FileDocumentEditOutline,
-		"file_document_minus":                     FileDocumentMinus,
-		"file_document_minus_outline":             FileDocumentMinusOutline,
-		"file_document_multiple":                  FileDocumentMultiple,
-		"file_document_multiple_outline":          FileDocumentMultipleOutline,
-		"file_document_outline":                   FileDocumentOutline,
-		"file_document_plus":                      FileDocumentPlus,
-		"file_document_plus_outline":              FileDocumentPlusOutline,
-		"file_document_remove":                    FileDocumentRemove,
-		"file_document_remove_outline":            FileDocumentRemoveOutline,
-		"file_download":                           FileDownload,
-		"file_download_outline":                   FileDownloadOutline,
-		"file_edit":                               FileEdit,
-		"file_edit_outline":                       FileEditOutline,
-		"file_excel":                              FileExcel,
-		"file_excel_box":                          FileExcelBox,
-		"file_excel_box_outline":                  FileExcelBoxOutline,
-		"file_excel_outline":                      FileExcelOutline,
-		"file_export":                             FileExport,
-		"file_export_outline":                     FileExportOutline,
-		"file_eye":                                FileEye,
-		"file_eye_outline":                        FileEyeOutline,
-		"file_find":                               FileFind,
-		"file_find_outline":                       FileFindOutline,
-		"file_gif_box":                            FileGifBox,
-		"file_hidden":                             FileHidden,
-		"file_image":                              FileImage,
-		"file_image_marker":                       FileImageMarker,
-		"file_image_marker_outline":               FileImageMarkerOutline,
-		"file_image_minus":                        FileImageMinus,
-		"file_image_minus_outline":                FileImageMinusOutline,
-		"file_image_outline":                      FileImageOutline,
-		"file_image_plus":                         FileImagePlus,
-		"file_image_plus_outline":                 FileImagePlusOutline,
-		"file_image_remove":                       FileImageRemove,
-		"file_image_remove_outline":               FileImageRemoveOutline,
-		"file_import":                             FileImport,
-		"file_import_outline":                     FileImportOutline,
-		"file_jpg_box":                            FileJpgBox,
-		"file_key":                                FileKey,
-		"file_key_outline":                        FileKeyOutline,
-		"file_link":                               FileLink,
-		"file_link_outline":                       FileLinkOutline,
-		"file_lock":                               FileLock,
-		"file_lock_open":                          FileLockOpen,
-		"file_lock_open_outline":                  FileLockOpenOutline,
-		"file_lock_outline":                       FileLockOutline,
-		"file_marker":                             FileMarker,
-		"file_marker_outline":                     FileMarkerOutline,
-		"file_minus":                              FileMinus,
-		"file_minus_outline":                      FileMinusOutline,
-		"file_move":                               FileMove,
-		"file_move_outline":                       FileMoveOutline,
-		"file_multiple":                           FileMultiple,
-		"file_multiple_outline":                   FileMultipleOutline,
-		"file_music":                              FileMusic,
-		"file_music_outline":                      FileMusicOutline,
-		"file_outline":                            FileOutline,
-		"file_pdf_box":                            FilePdfBox,
-		"file_percent":                            FilePercent,
-		"file_percent_outline":                    FilePercentOutline,
-		"file_phone":                              FilePhone,
-		"file_phone_outline":                      FilePhoneOutline,
-		"file_plus":                               FilePlus,
-		"file_plus_outline":                       FilePlusOutline,
-		"file_png_box":                            FilePngBox,
-		"file_powerpoint":                         FilePowerpoint,
-		"file_powerpoint_box":                     FilePowerpointBox,
-		"file_powerpoint_box_outline":             FilePowerpointBoxOutline,
-		"file_powerpoint_outline":                 FilePowerpointOutline,
-		"file_presentation_box":                   FilePresentationBox,
-		"file_question":                           FileQuestion,
-		"file_question_outline":                   FileQuestionOutline,
-		"file_refresh":                            FileRefresh,
-		"file_refresh_outline":                    FileRefreshOutline,
-		"file_remove":                             FileRemove,
-		"file_remove_outline":                     FileRemoveOutline,
-		"file_replace":                            FileReplace,
-		"file_replace_outline":                    FileReplaceOutline,
-		"file_restore":                            FileRestore,
-		"file_restore_outline":                    FileRestoreOutline,
-		"file_rotate_left":                        FileRotateLeft,
-		"file_rotate_left_outline":                FileRotateLeftOutline,
-		"file_rotate_right":                       FileRotateRight,
-		"file_rotate_right_outline":               FileRotateRightOutline,
-		"file_search":                             FileSearch,
-		"file_search_outline":                     FileSearchOutline,
-		"file_send":                               FileSend,
-		"file_send_outline":                       FileSendOutline,
-		"file_settings":                           FileSettings,
-		"file_settings_outline":                   FileSettingsOutline,
-		"file_sign":                               FileSign,
-		"file_star":                               FileStar,
-		"file_star_outline":                       FileStarOutline,
-		"file_swap":                               FileSwap,
-		"file_swap_outline":                       FileSwapOutline,
-		"file_sync":                               FileSync,
-		"file_sync_outline":                       FileSyncOutline,
-		"file_table":                              FileTable,
-		"file_table_box":                          FileTableBox,
-		"file_table_box_multiple":                 FileTableBoxMultiple,
-		"file_table_box_multiple_outline":         FileTableBoxMultipleOutline,
-		"file_table_box_outline":                  FileTableBoxOutline,
-		"file_table_outline":                      FileTableOutline,
-		"file_tree":                               FileTree,
-		"file_tree_outline":                       FileTreeOutline,
-		"file_undo":                               FileUndo,
-		"file_undo_outline":                       FileUndoOutline,
-		"file_upload":                             FileUpload,
-		"file_upload_outline":                     FileUploadOutline,
-		"file_video":                              FileVideo,
-		"file_video_outline":                      FileVideoOutline,
-		"file_word":                               FileWord,
-		"file_word_box":                           FileWordBox,
-		"file_word_box_outline":                   FileWordBoxOutline,
-		"file_word_outline":                       FileWordOutline,
-		"film":                                    Film,
-		"filmstrip":                               Filmstrip,
-		"filmstrip_box":                           FilmstripBox,
-		"filmstrip_box_multiple":                  FilmstripBoxMultiple,
-		"filmstrip_off":                           FilmstripOff,
-		"filter":                                  Filter,
-		"filter_check":                            FilterCheck,
-		"filter_check_outline":                    FilterCheckOutline,
-		"filter_cog":                              FilterCog,
-		"filter_cog_outline":                      FilterCogOutline,
-		"filter_menu":                             FilterMenu,
-		"filter_menu_outline":                     FilterMenuOutline,
-		"filter_minus":                            FilterMinus,
-		"filter_minus_outline":                    FilterMinusOutline,
-		"filter_multiple":                         FilterMultiple,
-		"filter_multiple_outline":                 FilterMultipleOutline,
-		"filter_off":                              FilterOff,
-		"filter_off_outline":                      FilterOffOutline,
-		"filter_outline":                          FilterOutline,
-		"filter_plus":                             FilterPlus,
-		"filter_plus_outline":                     FilterPlusOutline,
-		"filter_remove":                           FilterRemove,
-		"filter_remove_outline":                   FilterRemoveOutline,
-		"filter_settings":                         FilterSettings,
-		"filter_settings_outline":                 FilterSettingsOutline,
-		"filter_variant":                          FilterVariant,
-		"filter_variant_minus":                    FilterVariantMinus,
-		"filter_variant_plus":                     FilterVariantPlus,
-		"filter_variant_remove":                   FilterVariantRemove,
-		"finance":                                 Finance,
-		"find_replace":                            FindReplace,
-		"fingerprint":                             Fingerprint,
-		"fingerprint_off":                         FingerprintOff,
-		"fire":                                    Fire,
-		"fire_alert":                              FireAlert,
-		"fire_circle":                             FireCircle,
-		"fire_extinguisher":                       FireExtinguisher,
-		"fire_hydrant":                            FireHydrant,
-		"fire_hydrant_alert":                      FireHydrantAlert,
-		"fire_hydrant_off":                        FireHydrantOff,
-		"fire_off":                                FireOff,
-		"fire_truck":                              FireTruck,
-		"firebase":                                Firebase,
-		"firefox":                                 Firefox,
-		"fireplace":                               Fireplace,
-		"fireplace_off":                           FireplaceOff,
-		"firewire":                                Firewire,
-		"firework":                                Firework,
-		"firework_off":                            FireworkOff,
-		"fish":                                    Fish,
-		"fish_off":                                FishOff,
-		"fishbowl":                                Fishbowl,
-		"fishbowl_outline":                        FishbowlOutline,
-		"fit_to_page":                             FitToPage,
-		"fit_to_page_outline":                     FitToPageOutline,
-		"fit_to_screen":                           FitToScreen,
-		"fit_to_screen_outline":                   FitToScreenOutline,
-		"flag":                                    Flag,
-		"flag_checkered":                          FlagCheckered,
-		"flag_minus":                              FlagMinus,
-		"flag_minus_outline":                      FlagMinusOutline,
-		"flag_off":                                FlagOff,
-		"flag_off_outline":                        FlagOffOutline,
-		"flag_outline":                            FlagOutline,
-		"flag_plus":                               FlagPlus,
-		"flag_plus_outline":                       FlagPlusOutline,
-		"flag_remove":                             FlagRemove,
-		"flag_remove_outline":                     FlagRemoveOutline,
-		"flag_triangle":                           FlagTriangle,
-		"flag_variant":                            FlagVariant,
-		"flag_variant_outline":                    FlagVariantOutline,
-		"flare":                                   Flare,
-		"flash":                                   Flash,
-		"flash_alert":                             FlashAlert,
-		"flash_alert_outline":                     FlashAlertOutline,
-		"flash_auto":                              FlashAuto,
-		"flash_off":                               FlashOff,
-		"flash_outline":                           FlashOutline,
-		"flash_red_eye":                           FlashRedEye,
-		"flashlight":                              Flashlight,
-		"flashlight_off":                          FlashlightOff,
-		"flask":                                   Flask,
-		"flask_empty":                             FlaskEmpty,
-		"flask_empty_minus":                       FlaskEmptyMinus,
-		"flask_empty_minus_outline":               FlaskEmptyMinusOutline,
-		"flask_empty_off":                         FlaskEmptyOff,
-		"flask_empty_off_outline":                 FlaskEmptyOffOutline,
-		"flask_empty_outline":                     FlaskEmptyOutline,
-		"flask_empty_plus":                        FlaskEmptyPlus,
-		"flask_empty_plus_outline":                FlaskEmptyPlusOutline,
-		"flask_empty_remove":                      FlaskEmptyRemove,
-		"flask_empty_remove_outline":              FlaskEmptyRemoveOutline,
-		"flask_minus":                             FlaskMinus,
-		"flask_minus_outline":                     FlaskMinusOutline,
-		"flask_off":                               FlaskOff,
-		"flask_off_outline":                       FlaskOffOutline,
-		"flask_outline":                           FlaskOutline,
-		"flask_plus":                              FlaskPlus,
-		"flask_plus_outline":                      FlaskPlusOutline,
-		"flask_remove":                            FlaskRemove,
-		"flask_remove_outline":                    FlaskRemoveOutline,
-		"flask_round_bottom":                      FlaskRoundBottom,
-		"flask_round_bottom_empty":                FlaskRoundBottomEmpty,
-		"flask_round_bottom_empty_outline":        FlaskRoundBottomEmptyOutline,
-		"flask_round_bottom_outline":              FlaskRoundBottomOutline,
-		"fleur_de_lis":                            FleurDeLis,
-		"flip_horizontal":                         FlipHorizontal,
-		"flip_to_back":                            FlipToBack,
-		"flip_to_front":                           FlipToFront,
-		"flip_vertical":                           FlipVertical,
-		"floor_lamp":                              FloorLamp,
-		"floor_lamp_dual":                         FloorLampDual,
-		"floor_lamp_dual_outline":                 FloorLampDualOutline,
-		"floor_lamp_outline":                      FloorLampOutline,
-		"floor_lamp_torchiere":                    FloorLampTorchiere,
-		"floor_lamp_torchiere_outline":            FloorLampTorchiereOutline,
-		"floor_lamp_torchiere_variant":            FloorLampTorchiereVariant,
-		"floor_lamp_torchiere_variant_outline":    FloorLampTorchiereVariantOutline,
-		"floor_plan":                              FloorPlan,
-		"floppy":                                  Floppy,
-		"floppy_variant":                          FloppyVariant,
-		"flower":                                  Flower,
-		"flower_outline":                          FlowerOutline,
-		"flower_pollen":                           FlowerPollen,
-		"flower_pollen_outline":                   FlowerPollenOutline,
-		"flower_poppy":                            FlowerPoppy,
-		"flower_tulip":                            FlowerTulip,
-		"flower_tulip_outline":                    FlowerTulipOutline,
-		"focus_auto":                              FocusAuto,
-		"focus_field":                             FocusField,
-		"focus_field_horizontal":                  FocusFieldHorizontal,
-		"focus_field_vertical":                    FocusFieldVertical,
-		"folder":                                  Folder,
-		"folder_account":                          FolderAccount,
-		"folder_account_outline":                  FolderAccountOutline,
-		"folder_alert":                            FolderAlert,
-		"folder_alert_outline":                    FolderAlertOutline,
-		"folder_arrow_down":                       FolderArrowDown,
-		"folder_arrow_down_outline":               FolderArrowDownOutline,
-		"folder_arrow_left":                       FolderArrowLeft,
-		"folder_arrow_left_outline":               FolderArrowLeftOutline,
-		"folder_arrow_left_right":                 FolderArrowLeftRight,
-		"folder_arrow_left_right_outline":         FolderArrowLeftRightOutline,
-		"folder_arrow_right":                      FolderArrowRight,
-		"folder_arrow_right_outline":              FolderArrowRightOutline,
-		"folder_arrow_up":                         FolderArrowUp,
-		"folder_arrow_up_down":                    FolderArrowUpDown,
-		"folder_arrow_up_down_outline":            FolderArrowUpDownOutline,
-		"folder_arrow_up_outline":                 FolderArrowUpOutline,
-		"folder_cancel":                           FolderCancel,
-		"folder_cancel_outline":                   FolderCancelOutline,
-		"folder_check":                            FolderCheck,
-		"folder_check_outline":                    FolderCheckOutline,
-		"folder_clock":                            FolderClock,
-		"folder_clock_outline":                    FolderClockOutline,
-		"folder_cog":                              FolderCog,
-		"folder_cog_outline":                      FolderCogOutline,
-		"folder_download":                         FolderDownload,
-		"folder_download_outline":                 FolderDownloadOutline,
-		"folder_edit":                             FolderEdit,
-		"folder_edit_outline":                     FolderEditOutline,
-		"folder_eye":                              FolderEye,
-		"folder_eye_outline":                      FolderEyeOutline,
-		"folder_file":                             FolderFile,
-		"folder_file_outline":                     FolderFileOutline,
-		"folder_google_drive":                     FolderGoogleDrive,
-		"folder_heart":                            FolderHeart,
-		"folder_heart_outline":                    FolderHeartOutline,
-		"folder_hidden":                           FolderHidden,
-		"folder_home":                             FolderHome,
-		"folder_home_outline":                     FolderHomeOutline,
-		"folder_image":                            FolderImage,
-		"folder_information":                      FolderInformation,
-		"folder_information_outline":              FolderInformationOutline,
-		"folder_key":                              FolderKey,
-		"folder_key_network":                      FolderKeyNetwork,
-		"folder_key_network_outline":              FolderKeyNetworkOutline,
-		"folder_key_outline":                      FolderKeyOutline,
-		"folder_lock":                             FolderLock,
-		"folder_lock_open":                        FolderLockOpen,
-		"folder_lock_open_outline":                FolderLockOpenOutline,
-		"folder_lock_outline":                     FolderLockOutline,
-		"folder_marker":                           FolderMarker,
-		"folder_marker_outline":                   FolderMarkerOutline,
-		"folder_move":                             FolderMove,
-		"folder_move_outline":                     FolderMoveOutline,
-		"folder_multiple":                         FolderMultiple,
-		"folder_multiple_image":                   FolderMultipleImage,
-		"folder_multiple_outline":                 FolderMultipleOutline,
-		"folder_multiple_plus":                    FolderMultiplePlus,
-		"folder_multiple_plus_outline":            FolderMultiplePlusOutline,
-		"folder_music":                            FolderMusic,
-		"folder_music_outline":                    FolderMusicOutline,
-		"folder_network":                          FolderNetwork,
-		"folder_network_outline":                  FolderNetworkOutline,
-		"folder_off":                              FolderOff,
-		"folder_off_outline":                      FolderOffOutline,
-		"folder_open":                             FolderOpen,
-		"folder_open_outline":                     FolderOpenOutline,
-		"folder_outline":                          FolderOutline,
-		"folder_play":                             FolderPlay,
-		"folder_play_outline":                     FolderPlayOutline,
-		"folder_plus":                             FolderPlus,
-		"folder_plus_outline":                     FolderPlusOutline,
-		"folder_pound":                            FolderPound,
-		"folder_pound_outline":                    FolderPoundOutline,
-		"folder_question":                         FolderQuestion,
-		"folder_question_outline":                 FolderQuestionOutline,
-		"folder_refresh":                          FolderRefresh,
-		"folder_refresh_outline":                  FolderRefreshOutline,
-		"folder_remove":                           FolderRemove,
-		"folder_remove_outline":                   FolderRemoveOutline,
-		"folder_search":                           FolderSearch,
-		"folder_search_outline":                   FolderSearchOutline,
-		"folder_settings":                         FolderSettings,
-		"folder_settings_outline":                 FolderSettingsOutline,
-		"folder_star":                             FolderStar,
-		"folder_star_multiple":                    FolderStarMultiple,
-		"folder_star_multiple_outline":            FolderStarMultipleOutline,
-		"folder_star_outline":                     FolderStarOutline,
-		"folder_swap":                             FolderSwap,
-		"folder_swap_outline":                     FolderSwapOutline,
-		"folder_sync":                             FolderSync,
-		"folder_sync_outline":                     FolderSyncOutline,
-		"folder_table":                            FolderTable,
-		"folder_table_outline":                    FolderTableOutline,
-		"folder_text":                             FolderText,
-		"folder_text_outline":                     FolderTextOutline,
-		"folder_upload":                           FolderUpload,
-		"folder_upload_outline":                   FolderUploadOutline,
-		"folder_wrench":                           FolderWrench,
-		"folder_wrench_outline":                   FolderWrenchOutline,
-		"folder_zip":                              FolderZip,
-		"folder_zip_outline":                      FolderZipOutline,
-		"font_awesome":                            FontAwesome,
-		"food":                                    Food,
-		"food_apple":                              FoodApple,
-		"food_apple_outline":                      FoodAppleOutline,
-		"food_croissant":                          FoodCroissant,
-		"food_drumstick":                          FoodDrumstick,
-		"food_drumstick_off":                      FoodDrumstickOff,
-		"food_drumstick_off_outline":              FoodDrumstickOffOutline,
-		"food_drumstick_outline":                  FoodDrumstickOutline,
-		"food_fork_drink":                         FoodForkDrink,
-		"food_halal":                              FoodHalal,
-		"food_hot_dog":                            FoodHotDog,
-		"food_kosher":                             FoodKosher,
-		"food_off":                                FoodOff,
-		"food_off_outline":                        FoodOffOutline,
-		"food_outline":                            FoodOutline,
-		"food_steak":                              FoodSteak,
-		"food_steak_off":                          FoodSteakOff,
-		"food_takeout_box":                        FoodTakeoutBox,
-		"food_takeout_box_outline":                FoodTakeoutBoxOutline,
-		"food_turkey":                             FoodTurkey,
-		"food_variant":                            FoodVariant,
-		"food_variant_off":                        FoodVariantOff,
-		"foot_print":                              FootPrint,
-		"football":                                Football,
-		"football_australian":                     FootballAustralian,
-		"football_helmet":                         FootballHelmet,
-		"forest":                                  Forest,
-		"forklift":                                Forklift,
-		"form_dropdown":                           FormDropdown,
-		"form_select":                             FormSelect,
-		"form_textarea":                           FormTextarea,
-		"form_textbox":                            FormTextbox,
-		"form_textbox_lock":                       FormTextboxLock,
-		"form_textbox_password":                   FormTextboxPassword,
-		"format_align_bottom":                     FormatAlignBottom,
-		"format_align_center":                     FormatAlignCenter,
-		"format_align_justify":                    FormatAlignJustify,
-		"format_align_left":                       FormatAlignLeft,
-		"format_align_middle":                     FormatAlignMiddle,
-		"format_align_right":                      FormatAlignRight,
-		"format_align_top":                        FormatAlignTop,
-		"format_annotation_minus":                 FormatAnnotationMinus,
-		"format_annotation_plus":                  FormatAnnotationPlus,
-		"format_bold":                             FormatBold,
-		"format_clear":                            FormatClear,
-		"format_color_fill":                       FormatColorFill,
-		"format_color_highlight":                  FormatColorHighlight,
-		"format_color_marker_cancel":              FormatColorMarkerCancel,
-		"format_color_text":                       FormatColorText,
-		"format_columns":                          FormatColumns,
-		"format_float_center":                     FormatFloatCenter,
-		"format_float_left":                       FormatFloatLeft,
-		"format_float_none":                       FormatFloatNone,
-		"format_float_right":                      FormatFloatRight,
-		"format_font":                             FormatFont,
-		"format_font_size_decrease":               FormatFontSizeDecrease,
-		"format_font_size_increase":               FormatFontSizeIncrease,
-		"format_header_1":                         FormatHeader1,
-		"format_header_2":                         FormatHeader2,
-		"format_header_3":                         FormatHeader3,
-		"format_header_4":                         FormatHeader4,
-		"format_header_5":                         FormatHeader5,
-		"format_header_6":                         FormatHeader6,
-		"format_header_decrease":                  FormatHeaderDecrease,
-		"format_header_equal":                     FormatHeaderEqual,
-		"format_header_increase":                  FormatHeaderIncrease,
-		"format_header_pound":                     FormatHeaderPound,
-		"format_horizontal_align_center":          FormatHorizontalAlignCenter,
-		"format_horizontal_align_left":            FormatHorizontalAlignLeft,
-		"format_horizontal_align_right":           FormatHorizontalAlignRight,
-		"format_indent_decrease":                  FormatIndentDecrease,
-		"format_indent_increase":                  FormatIndentIncrease,
-		"format_italic":                           FormatItalic,
-		"format_letter_case":                      FormatLetterCase,
-		"format_letter_case_lower":                FormatLetterCaseLower,
-		"format_letter_case_upper":                FormatLetterCaseUpper,
-		"format_letter_ends_with":                 FormatLetterEndsWith,
-		"format_letter_matches":                   FormatLetterMatches,
-		"format_letter_spacing":                   FormatLetterSpacing,
-		"format_letter_starts_with":               FormatLetterStartsWith,
-		"format_line_spacing":                     FormatLineSpacing,
-		"format_line_style":                       FormatLineStyle,
-		"format_line_weight":                      FormatLineWeight,
-		"format_list_bulleted":                    FormatListBulleted,
-		"format_list_bulleted_square":             FormatListBulletedSquare,
-		"format_list_bulleted_triangle":           FormatListBulletedTriangle,
-		"format_list_bulleted_type":               FormatListBulletedType,
-		"format_list_checkbox":                    FormatListCheckbox,
-		"format_list_checks":                      FormatListChecks,
-		"format_list_group":                       FormatListGroup,
-		"format_list_numbered":                    FormatListNumbered,
-		"format_list_numbered_rtl":                FormatListNumberedRtl,
-		"format_list_text":                        FormatListText,
-		"format_overline":                         FormatOverline,
-		"format_page_break":                       FormatPageBreak,
-		"format_page_split":                       FormatPageSplit,
-		"format_paint":                            FormatPaint,
-		"format_paragraph":                        FormatParagraph,
-		"format_pilcrow":                          FormatPilcrow,
-		"format_quote_close":                      FormatQuoteClose,
-		"format_quote_close_outline":              FormatQuoteCloseOutline,
-		"format_quote_open":                       FormatQuoteOpen,
-		"format_quote_open_outline":               FormatQuoteOpenOutline,
-		"format_rotate_90":                        FormatRotate90,
-		"format_section":                          FormatSection,
-		"format_size":                             FormatSize,
-		"format_strikethrough":                    FormatStrikethrough,
-		"format_strikethrough_variant":            FormatStrikethroughVariant,
-		"format_subscript":                        FormatSubscript,
-		"format_superscript":                      FormatSuperscript,
-		"format_text":                             FormatText,
-		"format_text_rotation_angle_down":         FormatTextRotationAngleDown,
-		"format_text_rotation_angle_up":           FormatTextRotationAngleUp,
-		"format_text_rotation_down":               FormatTextRotationDown,
-		"format_text_rotation_down_vertical":      FormatTextRotationDownVertical,
-		"format_text_rotation_none":               FormatTextRotationNone,
-		"format_text_rotation_up":                 FormatTextRotationUp,
-		"format_text_rotation_vertical":           FormatTextRotationVertical,
-		"format_text_variant":                     FormatTextVariant,
-		"format_text_variant_outline":             FormatTextVariantOutline,
-		"format_text_wrapping_clip":               FormatTextWrappingClip,
-		"format_text_wrapping_overflow":           FormatTextWrappingOverflow,
-		"format_text_wrapping_wrap":               FormatTextWrappingWrap,
-		"format_textbox":                          FormatTextbox,
-		"format_textdirection_l_to_r":             FormatTextdirectionLToR,
-		"format_textdirection_r_to_l":             FormatTextdirectionRToL,
-		"format_title":                            FormatTitle,
-		"format_underline":                        FormatUnderline,
-		"format_underline_wavy":                   FormatUnderlineWavy,
-		"format_vertical_align_bottom":            FormatVerticalAlignBottom,
-		"format_vertical_align_center":            FormatVerticalAlignCenter,
-		"format_vertical_align_top":               FormatVerticalAlignTop,
-		"format_wrap_inline":                      FormatWrapInline,
-		"format_wrap_square":                      FormatWrapSquare,
-		"format_wrap_tight":                       FormatWrapTight,
-		"format_wrap_top_bottom":                  FormatWrapTopBottom,
-		"forum":                                   Forum,
-		"forum_minus":                             ForumMinus,
-		"forum_minus_outline":                     ForumMinusOutline,
-		"forum_outline":                           ForumOutline,
-		"forum_plus":                              ForumPlus,
-		"forum_plus_outline":                      ForumPlusOutline,
-		"forum_remove":                            ForumRemove,
-		"forum_remove_outline":                    ForumRemoveOutline,
-		"forward":                                 Forward,
-		"forwardburger":                           Forwardburger,
-		"fountain":                                Fountain,
-		"fountain_pen":                            FountainPen,
-		"fountain_pen_tip":                        FountainPenTip,
-		"fraction_one_half":                       FractionOneHalf,
-		"freebsd":                                 Freebsd,
-		"french_fries":                            FrenchFries,
-		"frequently_asked_questions":              FrequentlyAskedQuestions,
-		"fridge":                                  Fridge,
-		"fridge_alert":                            FridgeAlert,
-		"fridge_alert_outline":                    FridgeAlertOutline,
-		"fridge_bottom":                           FridgeBottom,
-		"fridge_industrial":                       FridgeIndustrial,
-		"fridge_industrial_alert":                 FridgeIndustrialAlert,
-		"fridge_industrial_alert_outline":         FridgeIndustrialAlertOutline,
-		"fridge_industrial_off":                   FridgeIndustrialOff,
-		"fridge_industrial_off_outline":           FridgeIndustrialOffOutline,
-		"fridge_industrial_outline":               FridgeIndustrialOutline,
-		"fridge_off":                              FridgeOff,
-		"fridge_off_outline":                      FridgeOffOutline,
-		"fridge_outline":                          FridgeOutline,
-		"fridge_top":                              FridgeTop,
-		"fridge_variant":                          FridgeVariant,
-		"fridge_variant_alert":                    FridgeVariantAlert,
-		"fridge_variant_alert_outline":            FridgeVariantAlertOutline,
-		"fridge_variant_off":                      FridgeVariantOff,
-		"fridge_variant_off_outline":              FridgeVariantOffOutline,
-		"fridge_variant_outline":                  FridgeVariantOutline,
-		"fruit_cherries":                          FruitCherries,
-		"fruit_cherries_off":                      FruitCherriesOff,
-		"fruit_citrus":                            FruitCitrus,
-		"fruit_citrus_off":                        FruitCitrusOff,
-		"fruit_grapes":                            FruitGrapes,
-		"fruit_grapes_outline":                    FruitGrapesOutline,
-		"fruit_pear":                              FruitPear,
-		"fruit_pineapple":                         FruitPineapple,
-		"fruit_watermelon":                        FruitWatermelon,
-		"fuel":                                    Fuel,
-		"fuel_cell":                               FuelCell,
-		"fullscreen":                              Fullscreen,
-		"fullscreen_exit":                         FullscreenExit,
-		"function":                                Function,
-		"function_variant":                        FunctionVariant,
-		"furigana_horizontal":                     FuriganaHorizontal,
-		"furigana_vertical":                       FuriganaVertical,
-		"fuse":                                    Fuse,
-		"fuse_alert":                              FuseAlert,
-		"fuse_blade":                              FuseBlade,
-		"fuse_off":                                FuseOff,
-		"gamepad":                                 Gamepad,
-		"gamepad_circle":                          GamepadCircle,
-		"gamepad_circle_down":                     GamepadCircleDown,
-		"gamepad_circle_left":                     GamepadCircleLeft,
-		"gamepad_circle_outline":                  GamepadCircleOutline,
-		"gamepad_circle_right":                    GamepadCircleRight,
-		"gamepad_circle_up":                       GamepadCircleUp,
-		"gamepad_down":                            GamepadDown,
-		"gamepad_left":                            GamepadLeft,
-		"gamepad_outline":                         GamepadOutline,
-		"gamepad_right":                           GamepadRight,
-		"gamepad_round":                           GamepadRound,
-		"gamepad_round_down":                      GamepadRoundDown,
-		"gamepad_round_left":                      GamepadRoundLeft,
-		"gamepad_round_outline":                   GamepadRoundOutline,
-		"gamepad_round_right":                     GamepadRoundRight,
-		"gamepad_round_up":                        GamepadRoundUp,
-		"gamepad_square":                          GamepadSquare,
-		"gamepad_square_outline":                  GamepadSquareOutline,
-		"gamepad_up":                              GamepadUp,
-		"gamepad_variant":                         GamepadVariant,
-		"gamepad_variant_outline":                 GamepadVariantOutline,
-		"gamma":                                   Gamma,
-		"gantry_crane":                            GantryCrane,
-		"garage":                                  Garage,
-		"garage_alert":                            GarageAlert,
-		"garage_alert_variant":                    GarageAlertVariant,
-		"garage_lock":                             GarageLock,
-		"garage_open":                             GarageOpen,
-		"garage_open_variant":                     GarageOpenVariant,
-		"garage_variant":                          GarageVariant,
-		"garage_variant_lock":                     GarageVariantLock,
-		"gas_burner":                              GasBurner,
-		"gas_cylinder":                            GasCylinder,
-		"gas_station":                             GasStation,
-		"gas_station_off":                         GasStationOff,
-		"gas_station_off_outline":                 GasStationOffOutline,
-		"gas_station_outline":                     GasStationOutline,
-		"gate":                                    Gate,
-		"gate_alert":                              GateAlert,
-		"gate_and":                                GateAnd,
-		"gate_arrow_left":                         GateArrowLeft,
-		"gate_arrow_right":                        GateArrowRight,
-		"gate_nand":                               GateNand,
-		"gate_nor":                                GateNor,
-		"gate_not":                                GateNot,
-		"gate_open":                               GateOpen,
-		"gate_or":                                 GateOr,
-		"gate_xnor":                               GateXnor,
-		"gate_xor":                                GateXor,
-		"gatsby":                                  Gatsby,
-		"gauge":                                   Gauge,
-		"gauge_empty":                             GaugeEmpty,
-		"gauge_full":                              GaugeFull,
-		"gauge_low":                               GaugeLow,
-		"gavel":                                   Gavel,
-		"gender_female":                           GenderFemale,
-		"gender_male":                             GenderMale,
-		"gender_male_female":                      GenderMaleFemale,
-		"gender_male_female_variant":              GenderMaleFemaleVariant,
-		"gender_non_binary":                       GenderNonBinary,
-		"gender_transgender":                      GenderTransgender,
-		"gentoo":                                  Gentoo,
-		"gesture":                                 Gesture,
-		"gesture_double_tap":                      GestureDoubleTap,
-		"gesture_pinch":                           GesturePinch,
-		"gesture_spread":                          GestureSpread,
-		"gesture_swipe":                           GestureSwipe,
-		"gesture_swipe_down":                      GestureSwipeDown,
-		"gesture_swipe_horizontal":                GestureSwipeHorizontal,
-		"gesture_swipe_left":                      GestureSwipeLeft,
-		"gesture_swipe_right":                     GestureSwipeRight,
-		"gesture_swipe_up":                        GestureSwipeUp,
-		"gesture_swipe_vertical":                  GestureSwipeVertical,
-		"gesture_tap":                             GestureTap,
-		"gesture_tap_box":                         GestureTapBox,
-		"gesture_tap_button":                      GestureTapButton,
-		"gesture_tap_hold":                        GestureTapHold,
-		"gesture_two_double_tap":                  GestureTwoDoubleTap,
-		"gesture_two_tap":                         GestureTwoTap,
-		"ghost":                                   Ghost,
-		"ghost_off":                               GhostOff,
-		"ghost_off_outline":                       GhostOffOutline,
-		"ghost_outline":                           GhostOutline,
-		"gift":                                    Gift,
-		"gift_off":                                GiftOff,
-		"gift_off_outline":                        GiftOffOutline,
-		"gift_open":                               GiftOpen,
-		"gift_open_outline":                       GiftOpenOutline,
-		"gift_outline":                            GiftOutline,
-		"git":                                     Git,
-		"github":                                  Github,
-		"gitlab":                                  Gitlab,
-		"glass_cocktail":                          GlassCocktail,
-		"glass_cocktail_off":                      GlassCocktailOff,
-		"glass_flute":                             GlassFlute,
-		"glass_fragile":                           GlassFragile,
-		"glass_mug":                               GlassMug,
-		"glass_mug_off":                           GlassMugOff,
-		"glass_mug_variant":                       GlassMugVariant,
-		"glass_mug_variant_off":                   GlassMugVariantOff,
-		"glass_pint_outline":                      GlassPintOutline,
-		"glass_stange":                            GlassStange,
-		"glass_tulip":                             GlassTulip,
-		"glass_wine":                              GlassWine,
-		"glasses":                                 Glasses,
-		"globe_light":                             GlobeLight,
-		"globe_model":                             GlobeModel,
-		"gmail":                                   Gmail,
-		"gnome":                                   Gnome,
-		"go_kart":                                 GoKart,
-		"go_kart_track":                           GoKartTrack,
-		"gog":                                     Gog,
-		"gold":                                    Gold,
-		"golf":                                    Golf,
-		"golf_cart":                               GolfCart,
-		"golf_tee":                                GolfTee,
-		"gondola":                                 Gondola,
-		"goodreads":                               Goodreads,
-		"google":                                  Google,
-		"google_ads":                              GoogleAds,
-		"google_analytics":                        GoogleAnalytics,
-		"google_assistant":                        GoogleAssistant,
-		"google_cardboard":                        GoogleCardboard,
-		"google_chrome":                           GoogleChrome,
-		"google_circles":                          GoogleCircles,
-		"google_circles_communities":              GoogleCirclesCommunities,
-		"google_circles_extended":                 GoogleCirclesExtended,
-		"google_circles_group":                    GoogleCirclesGroup,
-		"google_classroom":                        GoogleClassroom,
-		"google_cloud":                            GoogleCloud,
-		"google_controller":                       GoogleController,
-		"google_controller_off":                   GoogleControllerOff,
-		"google_downasaur":                        GoogleDownasaur,
-		"google_drive":                            GoogleDrive,
-		"google_earth":                            GoogleEarth,
-		"google_fit":                              GoogleFit,
-		"google_glass":                            GoogleGlass,
-		"google_hangouts":                         GoogleHangouts,
-		"google_home":                             GoogleHome,
-		"google_keep":                             GoogleKeep,
-		"google_lens":                             GoogleLens,
-		"google_maps":                             GoogleMaps,
-		"google_my_business":                      GoogleMyBusiness,
-		"google_nearby":                           GoogleNearby,
-		"google_play":                             GooglePlay,
-		"google_plus":                             GooglePlus,
-		"google_podcast":                          GooglePodcast,
-		"google_spreadsheet":                      GoogleSpreadsheet,
-		"google_street_view":                      GoogleStreetView,
-		"google_translate":                        GoogleTranslate,
-		"gradient_horizontal":                     GradientHorizontal,
-		"gradient_vertical":                       GradientVertical,
-		"grain":                                   Grain,
-		"graph":                                   Graph,
-		"graph_outline":                           GraphOutline,
-		"graphql":                                 Graphql,
-		"grass":                                   Grass,
-		"grave_stone":                             GraveStone,
-		"grease_pencil":                           GreasePencil,
-		"greater_than":                            GreaterThan,
-		"greater_than_or_equal":                   GreaterThanOrEqual,
-		"greenhouse":                              Greenhouse,
-		"grid":                                    Grid,
-		"grid_large":                              GridLarge,
-		"grid_off":                                GridOff,
-		"grill":                                   Grill,
-		"grill_outline":                           GrillOutline,
-		"group":                                   Group,
-		"guitar_acoustic":                         GuitarAcoustic,
-		"guitar_electric":                         GuitarElectric,
-		"guitar_pick":                             GuitarPick,
-		"guitar_pick_outline":                     GuitarPickOutline,
-		"guy_fawkes_mask":                         GuyFawkesMask,
-		"gymnastics":                              Gymnastics,
-		"hail":                                    Hail,
-		"hair_dryer":                              HairDryer,
-		"hair_dryer_outline":                      HairDryerOutline,
-		"halloween":                               Halloween,
-		"hamburger":                               Hamburger,
-		"hamburger_check":                         HamburgerCheck,
-		"hamburger_minus":                         HamburgerMinus,
-		"hamburger_off":                           HamburgerOff,
-		"hamburger_plus":                          HamburgerPlus,
-		"hamburger_remove":                        HamburgerRemove,
-		"hammer":                                  Hammer,
-		"hammer_screwdriver":                      HammerScrewdriver,
-		"hammer_sickle":                           HammerSickle,
-		"hammer_wrench":                           HammerWrench,
-		"hand_back_left":                          HandBackLeft,
-		"hand_back_left_off":                      HandBackLeftOff,
-		"hand_back_left_off_outline":              HandBackLeftOffOutline,
-		"hand_back_left_outline":                  HandBackLeftOutline,
-		"hand_back_right":                         HandBackRight,
-		"hand_back_right_off":                     HandBackRightOff,
-		"hand_back_right_off_outline":             HandBackRightOffOutline,
-		"hand_back_right_outline":                 HandBackRightOutline,
-		"hand_clap":                               HandClap,
-		"hand_clap_off":                           HandClapOff,
-		"hand_coin":                               HandCoin,
-		"hand_coin_outline":                       HandCoinOutline,
-		"hand_extended":                           HandExtended,
-		"hand_extended_outline":                   HandExtendedOutline,
-		"hand_front_left":                         HandFrontLeft,
-		"hand_front_left_outline":                 HandFrontLeftOutline,
-		"hand_front_right":                        HandFrontRight,
-		"hand_front_right_outline":                HandFrontRightOutline,
-		"hand_heart":                              HandHeart,
-		"hand_heart_outline":                      HandHeartOutline,
-		"hand_okay":                               HandOkay,
-		"hand_peace":                              HandPeace,
-		"hand_peace_variant":                      HandPeaceVariant,
-		"hand_pointing_down":                      HandPointingDown,
-		"hand_pointing_left":                      HandPointingLeft,
-		"hand_pointing_right":                     HandPointingRight,
-		"hand_pointing_up":                        HandPointingUp,
-		"hand_saw":                                HandSaw,
-		"hand_wash":                               HandWash,
-		"hand_wash_outline":                       HandWashOutline,
-		"hand_water":                              HandWater,
-		"hand_wave":                               HandWave,
-		"hand_wave_outline":                       HandWaveOutline,
-		"handball":                                Handball,
-		"handcuffs":                               Handcuffs,
-		"hands_pray":                              HandsPray,
-		"handshake":                               Handshake,
-		"handshake_outline":                       HandshakeOutline,
-		"hanger":                                  Hanger,
-		"hard_hat":                                HardHat,
-		"harddisk":                                Harddisk,
-		"harddisk_plus":                           HarddiskPlus,
-		"harddisk_remove":                         HarddiskRemove,
-		"hat_fedora":                              HatFedora,
-		"hazard_lights":                           HazardLights,
-		"hdr":                                     Hdr,
-		"hdr_off":                                 HdrOff,
-		"head":                                    Head,
-		"head_alert":                              HeadAlert,
-		"head_alert_outline":                      HeadAlertOutline,
-		"head_check":                              HeadCheck,
-		"head_check_outline":                      HeadCheckOutline,
-		"head_cog":                                HeadCog,
-		"head_cog_outline":                        HeadCogOutline,
-		"head_dots_horizontal":                    HeadDotsHorizontal,
-		"head_dots_horizontal_outline":            HeadDotsHorizontalOutline,
-		"head_flash":                              HeadFlash,
-		"head_flash_outline":                      HeadFlashOutline,
-		"head_heart":                              HeadHeart,
-		"head_heart_outline":                      HeadHeartOutline,
-		"head_lightbulb":                          HeadLightbulb,
-		"head_lightbulb_outline":                  HeadLightbulbOutline,
-		"head_minus":                              HeadMinus,
-		"head_minus_outline":                      HeadMinusOutline,
-		"head_outline":                            HeadOutline,
-		"head_plus":                               HeadPlus,
-		"head_plus_outline":                       HeadPlusOutline,
-		"head_question":                           HeadQuestion,
-		"head_question_outline":                   HeadQuestionOutline,
-		"head_remove":                             HeadRemove,
-		"head_remove_outline":                     HeadRemoveOutline,
-		"head_snowflake":                          HeadSnowflake,
-		"head_snowflake_outline":                  HeadSnowflakeOutline,
-		"head_sync":                               HeadSync,
-		"head_sync_outline":                       HeadSyncOutline,
-		"headphones":                              Headphones,
-		"headphones_bluetooth":                    HeadphonesBluetooth,
-		"headphones_box":                          HeadphonesBox,
-		"headphones_off":                          HeadphonesOff,
-		"headphones_settings":                     HeadphonesSettings,
-		"headset":                                 Headset,
-		"headset_dock":                            HeadsetDock,
-		"headset_off":                             HeadsetOff,
-		"heart":                                   Heart,
-		"heart_box":                               HeartBox,
-		"heart_box_outline":                       HeartBoxOutline,
-		"heart_broken":                            HeartBroken,
-		"heart_broken_outline":                    HeartBrokenOutline,
-		"heart_circle":                            HeartCircle,
-		"heart_circle_outline":                    HeartCircleOutline,
-		"heart_cog":                               HeartCog,
-		"heart_cog_outline":                       HeartCogOutline,
-		"heart_flash":                             HeartFlash,
-		"heart_half":                              HeartHalf,
-		"heart_half_full":                         HeartHalfFull,
-		"heart_half_outline":                      HeartHalfOutline,
-		"heart_minus":                             HeartMinus,
-		"heart_minus_outline":                     HeartMinusOutline,
-		"heart_multiple":                          HeartMultiple,
-		"heart_multiple_outline":                  HeartMultipleOutline,
-		"heart_off":                               HeartOff,
-		"heart_off_outline":                       HeartOffOutline,
-		"heart_outline":                           HeartOutline,
-		"heart_plus":                              HeartPlus,
-		"heart_plus_outline":                      HeartPlusOutline,
-		"heart_pulse":                             HeartPulse,
-		"heart_remove":                            HeartRemove,
-		"heart_remove_outline":                    HeartRemoveOutline,
-		"heart_settings":                          HeartSettings,
-		"heart_settings_outline":                  HeartSettingsOutline,
-		"heat_pump":                               HeatPump,
-		"heat_pump_outline":                       HeatPumpOutline,
-		"heat_wave":                               HeatWave,
-		"heating_coil":                            HeatingCoil,
-		"helicopter":                              Helicopter,
-		"help":                                    Help,
-		"help_box":                                HelpBox,
-		"help_circle":                             HelpCircle,
-		"help_circle_outline":                     HelpCircleOutline,
-		"help_network":                            HelpNetwork,
-		"help_network_outline":                    HelpNetworkOutline,
-		"help_rhombus":                            HelpRhombus,
-		"help_rhombus_outline":                    HelpRhombusOutline,
-		"hexadecimal":                             Hexadecimal,
-		"hexagon":                                 Hexagon,
-		"hexagon_multiple":                        HexagonMultiple,
-		"hexagon_multiple_outline":                HexagonMultipleOutline,
-		"hexagon_outline":                         HexagonOutline,
-		"hexagon_slice_1":                         HexagonSlice1,
-		"hexagon_slice_2":                         HexagonSlice2,
-		"hexagon_slice_3":                         HexagonSlice3,
-		"hexagon_slice_4":                         HexagonSlice4,
-		"hexagon_slice_5":                         HexagonSlice5,
-		"hexagon_slice_6":                         HexagonSlice6,
-		"hexagram":                                Hexagram,
-		"hexagram_outline":                        HexagramOutline,
-		"high_definition":                         HighDefinition,
-		"high_definition_box":                     HighDefinitionBox,
-		"highway":                                 Highway,
-		"hiking":                                  Hiking,
-		"history":                                 History,
-		"hockey_puck":                             HockeyPuck,
-		"hockey_sticks":                           HockeySticks,
-		"hololens":                                Hololens,
-		"home":                                    Home,
-		"home_account":                            HomeAccount,
-		"home_alert":                              HomeAlert,
-		"home_alert_outline":                      HomeAlertOutline,
-		"home_analytics":                          HomeAnalytics,
-		"home_assistant":                          HomeAssistant,
-		"home_automation":                         HomeAutomation,
-		"home_battery":                            HomeBattery,
-		"home_battery_outline":                    HomeBatteryOutline,
-		"home_circle":                             HomeCircle,
-		"home_circle_outline":                     HomeCircleOutline,
-		"home_city":                               HomeCity,
-		"home_city_outline":                       HomeCityOutline,
-		"home_clock":                              HomeClock,
-		"home_clock_outline":                      HomeClockOutline,
-		"home_edit":                               HomeEdit,
-		"home_edit_outline":                       HomeEditOutline,
-		"home_export_outline":                     HomeExportOutline,
-		"home_flood":                              HomeFlood,
-		"home_floor_0":                            HomeFloor0,
-		"home_floor_1":                            HomeFloor1,
-		"home_floor_2":                            HomeFloor2,
-		"home_floor_3":                            HomeFloor3,
-		"home_floor_a":                            HomeFloorA,
-		"home_floor_b":                            HomeFloorB,
-		"home_floor_g":                            HomeFloorG,
-		"home_floor_l":                            HomeFloorL,
-		"home_floor_negative_1":                   HomeFloorNegative1,
-		"home_group":                              HomeGroup,
-		"home_group_minus":                        HomeGroupMinus,
-		"home_group_plus":                         HomeGroupPlus,
-		"home_group_remove":                       HomeGroupRemove,
-		"home_heart":                              HomeHeart,
-		"home_import_outline":                     HomeImportOutline,
-		"home_lightbulb":                          HomeLightbulb,
-		"home_lightbulb_outline":                  HomeLightbulbOutline,
-		"home_lightning_bolt":                     HomeLightningBolt,
-		"home_lightning_bolt_outline":             HomeLightningBoltOutline,
-		"home_lock":                               HomeLock,
-		"home_lock_open":                          HomeLockOpen,
-		"home_map_marker":                         HomeMapMarker,
-		"home_minus":                              HomeMinus,
-		"home_minus_outline":                      HomeMinusOutline,
-		"home_modern":                             HomeModern,
-		"home_off":                                HomeOff,
-		"home_off_outline":                        HomeOffOutline,
-		"home_outline":                            HomeOutline,
-		"home_plus":                               HomePlus,
-		"home_plus_outline":                       HomePlusOutline,
-		"home_remove":                             HomeRemove,
-		"home_remove_outline":                     HomeRemoveOutline,
-		"home_roof":                               HomeRoof,
-		"home_search":                             HomeSearch,
-		"home_search_outline":                     HomeSearchOutline,
-		"home_switch":                             HomeSwitch,
-		"home_switch_outline":                     HomeSwitchOutline,
-		"home_thermometer":                        HomeThermometer,
-		"home_thermometer_outline":                HomeThermometerOutline,
-		"home_variant":                            HomeVariant,
-		"home_variant_outline":                    HomeVariantOutline,
-		"hook":                                    Hook,
-		"hook_off":                                HookOff,
-		"hoop_house":                              HoopHouse,
-		"hops":                                    Hops,
-		"horizontal_rotate_clockwise":             HorizontalRotateClockwise,
-		"horizontal_rotate_counterclockwise":      HorizontalRotateCounterclockwise,
-		"horse":                                   Horse,
-		"horse_human":                             HorseHuman,
-		"horse_variant":                           HorseVariant,
-		"horse_variant_fast":                      HorseVariantFast,
-		"horseshoe":                               Horseshoe,
-		"hospital":                                Hospital,
-		"hospital_box":                            HospitalBox,
-		"hospital_box_outline":                    HospitalBoxOutline,
-		"hospital_building":                       HospitalBuilding,
-		"hospital_marker":                         HospitalMarker,
-		"hot_tub":                                 HotTub,
-		"hours_24":                                Hours24,
-		"hubspot":                                 Hubspot,
-		"hulu":                                    Hulu,
-		"human":                                   Human,
-		"human_baby_changing_table":               HumanBabyChangingTable,
-		"human_cane":                              HumanCane,
-		"human_capacity_decrease":                 HumanCapacityDecrease,
-		"human_capacity_increase":                 HumanCapacityIncrease,
-		"human_child":                             HumanChild,
-		"human_dolly":                             HumanDolly,
-		"human_edit":                              HumanEdit,
-		"human_female":                            HumanFemale,
-		"human_female_boy":                        HumanFemaleBoy,
-		"human_female_dance":                      HumanFemaleDance,
-		"human_female_female":                     HumanFemaleFemale,
-		"human_female_girl":                       HumanFemaleGirl,
-		"human_greeting":                          HumanGreeting,
-		"human_greeting_proximity":                HumanGreetingProximity,
-		"human_greeting_variant":                  HumanGreetingVariant,
-		"human_handsdown":                         HumanHandsdown,
-		"human_handsup":                           HumanHandsup,
-		"human_male":                              HumanMale,
-		"human_male_board":                        HumanMaleBoard,
-		"human_male_board_poll":                   HumanMaleBoardPoll,
-		"human_male_boy":                          HumanMaleBoy,
-		"human_male_child":                        HumanMaleChild,
-		"human_male_female":                       HumanMaleFemale,
-		"human_male_female_child":                 HumanMaleFemaleChild,
-		"human_male_girl":                         HumanMaleGirl,
-		"human_male_height":                       HumanMaleHeight,
-		"human_male_height_variant":               HumanMaleHeightVariant,
-		"human_male_male":                         HumanMaleMale,
-		"human_non_binary":                        HumanNonBinary,
-		"human_pregnant":                          HumanPregnant,
-		"human_queue":                             HumanQueue,
-		"human_scooter":                           HumanScooter,
-		"human_wheelchair":                        HumanWheelchair,
-		"human_white_cane":                        HumanWhiteCane,
-		"humble_bundle":                           HumbleBundle,
-		"hvac":                                    Hvac,
-		"hvac_off":                                HvacOff,
-		"hydraulic_oil_level":                     HydraulicOilLevel,
-		"hydraulic_oil_temperature":               HydraulicOilTemperature,
-		"hydro_power":                             HydroPower,
-		"hydrogen_station":                        HydrogenStation,
-		"ice_cream":                               IceCream,
-		"ice_cream_off":                           IceCreamOff,
-		"ice_pop":                                 IcePop,
-		"id_card":                                 IdCard,
-		"identifier":                              Identifier,
-		"ideogram_cjk":                            IdeogramCjk,
-		"ideogram_cjk_variant":                    IdeogramCjkVariant,
-		"image":                                   Image,
-		"image_album":                             ImageAlbum,
-		"image_area":                              ImageArea,
-		"image_area_close":                        ImageAreaClose,
-		"image_auto_adjust":                       ImageAutoAdjust,
-		"image_broken":                            ImageBroken,
-		"image_broken_variant":                    ImageBrokenVariant,
-		"image_edit":                              ImageEdit,
-		"image_edit_outline":                      ImageEditOutline,
-		"image_filter_black_white":                ImageFilterBlackWhite,
-		"image_filter_center_focus":               ImageFilterCenterFocus,
-		"image_filter_center_focus_strong":        ImageFilterCenterFocusStrong,
-		"image_filter_center_focus_strong_outline": ImageFilterCenterFocusStrongOutline,
-		"image_filter_center_focus_weak":           ImageFilterCenterFocusWeak,
-		"image_filter_drama":                       ImageFilterDrama,
-		"image_filter_frames":                      ImageFilterFrames,
-		"image_filter_hdr":                         ImageFilterHdr,
-		"image_filter_none":                        ImageFilterNone,
-		"image_filter_tilt_shift":                  ImageFilterTiltShift,
-		"image_filter_vintage":                     ImageFilterVintage,
-		"image_frame":                              ImageFrame,
-		"image_lock":                               ImageLock,
-		"image_lock_outline":                       ImageLockOutline,
-		"image_marker":                             ImageMarker,
-		"image_marker_outline":                     ImageMarkerOutline,
-		"image_minus":                              ImageMinus,
-		"image_move":                               ImageMove,
-		"image_multiple":                           ImageMultiple,
-		"image_multiple_outline":                   ImageMultipleOutline,
-		"image_off":                                ImageOff,
-		"image_off_outline":                        ImageOffOutline,
-		"image_outline":                            ImageOutline,
-		"image_plus":                               ImagePlus,
-		"image_refresh":                            ImageRefresh,
-		"image_refresh_outline":                    ImageRefreshOutline,
-		"image_remove":                             ImageRemove,
-		"image_search":                             ImageSearch,
-		"image_search_outline":                     ImageSearchOutline,
-		"image_size_select_actual":                 ImageSizeSelectActual,
-		"image_size_select_large":                  ImageSizeSelectLarge,
-		"image_size_select_small":                  ImageSizeSelectSmall,
-		"image_sync":                               ImageSync,
-		"image_sync_outline":                       ImageSyncOutline,
-		"image_text":                               ImageText,
-		"import":                                   Import,
-		"inbox":                                    Inbox,
-		"inbox_arrow_down":                         InboxArrowDown,
-		"inbox_arrow_down_outline":                 InboxArrowDownOutline,
-		"inbox_arrow_up":                           InboxArrowUp,
-		"inbox_arrow_up_outline":                   InboxArrowUpOutline,
-		"inbox_full":                               InboxFull,
-		"inbox_full_outline":                       InboxFullOutline,
-		"inbox_multiple":                           InboxMultiple,
-		"inbox_multiple_outline":                   InboxMultipleOutline,
-		"inbox_outline":                            InboxOutline,
-		"inbox_remove":                             InboxRemove,
-		"inbox_remove_outline":                     InboxRemoveOutline,
-		"incognito":                                Incognito,
-		"incognito_circle":                         IncognitoCircle,
-		"incognito_circle_off":                     IncognitoCircleOff,
-		"incognito_off":                            IncognitoOff,
-		"induction":                                Induction,
-		"infinity":                                 Infinity,
-		"information":                              Information,
-		"information_off":                          InformationOff,
-		"information_off_outline":                  InformationOffOutline,
-		"information_outline":                      InformationOutline,
-		"information_variant":                      InformationVariant,
-		"instagram":                                Instagram,
-		"instrument_triangle":                      InstrumentTriangle,
-		"integrated_circuit_chip":                  IntegratedCircuitChip,
-		"invert_colors":                            InvertColors,
-		"invert_colors_off":                        InvertColorsOff,
-		"iobroker":                                 Iobroker,
-		"ip":                                       Ip,
-		"ip_network":                               IpNetwork,
-		"ip_network_outline":                       IpNetworkOutline,
-		"ip_outline":                               IpOutline,
-		"ipod":                                     Ipod,
-		"iron":                                     Iron,
-		"iron_board":                               IronBoard,
-		"iron_outline":                             IronOutline,
-		"island":                                   Island,
-		"iv_bag":                                   IvBag,
-		"jabber":                                   Jabber,
-		"jeepney":                                  Jeepney,
-		"jellyfish":                                Jellyfish,
-		"jellyfish_outline":                        JellyfishOutline,
-		"jira":                                     Jira,
-		"jquery":                                   Jquery,
-		"jsfiddle":                                 Jsfiddle,
-		"jump_rope":                                JumpRope,
-		"kabaddi":                                  Kabaddi,
-		"kangaroo":                                 Kangaroo,
-		"karate":                                   Karate,
-		"kayaking":                                 Kayaking,
-		"keg":                                      Keg,
-		"kettle":                                   Kettle,
-		"kettle_alert":                             KettleAlert,
-		"kettle_alert_outline":                     KettleAlertOutline,
-		"kettle_off":                               KettleOff,
-		"kettle_off_outline":                       KettleOffOutline,
-		"kettle_outline":                           KettleOutline,
-		"kettle_pour_over":                         KettlePourOver,
-		"kettle_steam":                             KettleSteam,
-		"kettle_steam_outline":                     KettleSteamOutline,
-		"kettlebell":                               Kettlebell,
-		"key":                                      Key,
-		"key_alert":                                KeyAlert,
-		"key_alert_outline":                        KeyAlertOutline,
-		"key_arrow_right":                          KeyArrowRight,
-		"key_chain":                                KeyChain,
-		"key_chain_variant":                        KeyChainVariant,
-		"key_change":                               KeyChange,
-		"key_link":                                 KeyLink,
-		"key_minus":                                KeyMinus,
-		"key_outline":                              KeyOutline,
-		"key_plus":                                 KeyPlus,
-		"key_remove":                               KeyRemove,
-		"key_star":                                 KeyStar,
-		"key_variant":                              KeyVariant,
-		"key_wireless":                             KeyWireless,
-		"keyboard":                                 Keyboard,
-		"keyboard_backspace":                       KeyboardBackspace,
-		"keyboard_caps":                            KeyboardCaps,
-		"keyboard_close":                           KeyboardClose,
-		"keyboard_esc":                             KeyboardEsc,
-		"keyboard_f1":                              KeyboardF1,
-		"keyboard_f10":                             KeyboardF10,
-		"keyboard_f11":                             KeyboardF11,
-		"keyboard_f12":                             KeyboardF12,
-		"keyboard_f2":                              KeyboardF2,
-		"keyboard_f3":                              KeyboardF3,
-		"keyboard_f4":                              KeyboardF4,
-		"keyboard_f5":                              KeyboardF5,
-		"keyboard_f6":                              KeyboardF6,
-		"keyboard_f7":                              KeyboardF7,
-		"keyboard_f8":                              KeyboardF8,
-		"keyboard_f9":                              KeyboardF9,
-		"keyboard_off":                             KeyboardOff,
-		"keyboard_off_outline":                     KeyboardOffOutline,
-		"keyboard_outline":                         KeyboardOutline,
-		"keyboard_return":                          KeyboardReturn,
-		"keyboard_settings":                        KeyboardSettings,
-		"keyboard_settings_outline":                KeyboardSettingsOutline,
-		"keyboard_space":                           KeyboardSpace,
-		"keyboard_tab":                             KeyboardTab,
-		"keyboard_tab_reverse":                     KeyboardTabReverse,
-		"keyboard_variant":                         KeyboardVariant,
-		"khanda":                                   Khanda,
-		"kickstarter":                              Kickstarter,
-		"kite":                                     Kite,
-		"kite_outline":                             KiteOutline,
-		"kitesurfing":                              Kitesurfing,
-		"klingon":                                  Klingon,
-		"knife":                                    Knife,
-		"knife_military":                           KnifeMilitary,
-		"koala":                                    Koala,
-		"kodi":                                     Kodi,
-		"kubernetes":                               Kubernetes,
-		"label":                                    Label,
-		"label_multiple":                           LabelMultiple,
-		"label_multiple_outline":                   LabelMultipleOutline,
-		"label_off":                                LabelOff,
-		"label_off_outline":                        LabelOffOutline,
-		"label_outline":                            LabelOutline,
-		"label_percent":                            LabelPercent,
-		"label_percent_outline":                    LabelPercentOutline,
-		"label_variant":                            LabelVariant,
-		"label_variant_outline":                    LabelVariantOutline,
-		"ladder":                                   Ladder,
-		"ladybug":                                  Ladybug,
-		"lambda":                                   Lambda,
-		"lamp":                                     Lamp,
-		"lamp_outline":                             LampOutline,
-		"lamps":                                    Lamps,
-		"lamps_outline":                            LampsOutline,
-		"lan":                                      Lan,
-		"lan_check":                                LanCheck,
-		"lan_connect":                              LanConnect,
-		"lan_disconnect":                           LanDisconnect,
-		"lan_pending":                              LanPending,
-		"land_fields":                              LandFields,
-		"land_plots":                               LandPlots,
-		"land_plots_circle":                        LandPlotsCircle,
-		"land_plots_circle_variant":                LandPlotsCircleVariant,
-		"land_rows_horizontal":                     LandRowsHorizontal,
-		"land_rows_vertical":                       LandRowsVertical,
-		"landslide":                                Landslide,
-		"landslide_outline":                        LandslideOutline,
-		"language_c":                               LanguageC,
-		"language_cpp":                             LanguageCpp,
-		"language_csharp":                          LanguageCsharp,
-		"language_css3":                            LanguageCss3,
-		"language_fortran":                         LanguageFortran,
-		"language_go":                              LanguageGo,
-		"language_haskell":                         LanguageHaskell,
-		"language_html5":                           LanguageHtml5,
-		"language_java":                            LanguageJava,
-		"language_javascript":                      LanguageJavascript,
-		"language_kotlin":                          LanguageKotlin,
-		"language_lua":                             LanguageLua,
-		"language_markdown":                        LanguageMarkdown,
-		"language_markdown_outline":                LanguageMarkdownOutline,
-		"language_php":                             LanguagePhp,
-		"language_python":                          LanguagePython,
-		"language_r":                               LanguageR,
-		"language_ruby":                            LanguageRuby,
-		"language_ruby_on_rails":                   LanguageRubyOnRails,
-		"language_rust":                            LanguageRust,
-		"language_swift":                           LanguageSwift,
-		"language_typescript":                      LanguageTypescript,
-		"language_xaml":                            LanguageXaml,
-		"laptop":                                   Laptop,
-		"laptop_account":                           LaptopAccount,
-		"laptop_off":                               LaptopOff,
-		"laravel":                                  Laravel,
-		"laser_pointer":                            LaserPointer,
-		"lasso":                                    Lasso,
-		"lastpass":                                 Lastpass,
-		"latitude":                                 Latitude,
-		"launch":                                   Launch,
-		"lava_lamp":                                LavaLamp,
-		"layers":                                   Layers,
-		"layers_edit":                              LayersEdit,
-		"layers_minus":                             LayersMinus,
-		"layers_off":                               LayersOff,
-		"layers_off_outline":                       LayersOffOutline,
-		"layers_outline":                           LayersOutline,
-		"layers_plus":                              LayersPlus,
-		"layers_remove":                            LayersRemove,
-		"layers_search":                            LayersSearch,
-		"layers_search_outline":                    LayersSearchOutline,
-		"layers_triple":                            LayersTriple,
-		"layers_triple_outline":                    LayersTripleOutline,
-		"lead_pencil":                              LeadPencil,
-		"leaf":                                     Leaf,
-		"leaf_circle":                              LeafCircle,
-		"leaf_circle_outline":                      LeafCircleOutline,
-		"leaf_maple":                               LeafMaple,
-		"leaf_maple_off":                           LeafMapleOff,
-		"leaf_off":                                 LeafOff,
-		"leak":                                     Leak,
-		"leak_off":                                 LeakOff,
-		"lecturn":                                  Lecturn,
-		"led_off":                                  LedOff,
-		"led_on":                                   LedOn,
-		"led_outline":                              LedOutline,
-		"led_strip":                                LedStrip,
-		"led_strip_variant":                        LedStripVariant,
-		"led_strip_variant_off":                    LedStripVariantOff,
-		"led_variant_off":                          LedVariantOff,
-		"led_variant_on":                           LedVariantOn,
-		"led_variant_outline":                      LedVariantOutline,
-		"leek":                                     Leek,
-		"less_than":                                LessThan,
-		"less_than_or_equal":                       LessThanOrEqual,
-		"library":                                  Library,
-		"library_outline":                          LibraryOutline,
-		"library_shelves":                          LibraryShelves,
-		"license":                                  License,
-		"lifebuoy":                                 Lifebuoy,
-		"light_flood_down":                         LightFloodDown,
-		"light_flood_up":                           LightFloodUp,
-		"light_recessed":                           LightRecessed,
-		"light_switch":                             LightSwitch,
-		"light_switch_off":                         LightSwitchOff,
-		"lightbulb":                                Lightbulb,
-		"lightbulb_alert":                          LightbulbAlert,
-		"lightbulb_alert_outline":                  LightbulbAlertOutline,
-		"lightbulb_auto":                           LightbulbAuto,
-		"lightbulb_auto_outline":                   LightbulbAutoOutline,
-		"lightbulb_cfl":                            LightbulbCfl,
-		"lightbulb_cfl_off":                        LightbulbCflOff,
-		"lightbulb_cfl_spiral":                     LightbulbCflSpiral,
-		"lightbulb_cfl_spiral_off":                 LightbulbCflSpiralOff,
-		"lightbulb_fluorescent_tube":               LightbulbFluorescentTube,
-		"lightbulb_fluorescent_tube_outline":       LightbulbFluorescentTubeOutline,
-		"lightbulb_group":                          LightbulbGroup,
-		"lightbulb_group_off":                      LightbulbGroupOff,
-		"lightbulb_group_off_outline":              LightbulbGroupOffOutline,
-		"lightbulb_group_outline":                  LightbulbGroupOutline,
-		"lightbulb_multiple":                       LightbulbMultiple,
-		"lightbulb_multiple_off":                   LightbulbMultipleOff,
-		"lightbulb_multiple_off_outline":           LightbulbMultipleOffOutline,
-		"lightbulb_multiple_outline":               LightbulbMultipleOutline,
-		"lightbulb_night":                          LightbulbNight,
-		"lightbulb_night_outline":                  LightbulbNightOutline,
-		"lightbulb_off":                            LightbulbOff,
-		"lightbulb_off_outline":                    LightbulbOffOutline,
-		"lightbulb_on":                             LightbulbOn,
-		"lightbulb_on_10":                          LightbulbOn10,
-		"lightbulb_on_20":                          LightbulbOn20,
-		"lightbulb_on_30":                          LightbulbOn30,
-		"lightbulb_on_40":                          LightbulbOn40,
-		"lightbulb_on_50":                          LightbulbOn50,
-		"lightbulb_on_60":                          LightbulbOn60,
-		"lightbulb_on_70":                          LightbulbOn70,
-		"lightbulb_on_80":                          LightbulbOn80,
-		"lightbulb_on_90":                          LightbulbOn90,
-		"lightbulb_on_outline":                     LightbulbOnOutline,
-		"lightbulb_outline":                        LightbulbOutline,
-		"lightbulb_question":                       LightbulbQuestion,
-		"lightbulb_question_outline":               LightbulbQuestionOutline,
-		"lightbulb_spot":                           LightbulbSpot,
-		"lightbulb_spot_off":                       LightbulbSpotOff,
-		"lightbulb_variant":                        LightbulbVariant,
-		"lightbulb_variant_outline":                LightbulbVariantOutline,
-		"lighthouse":                               Lighthouse,
-		"lighthouse_on":                            LighthouseOn,
-		"lightning_bolt":                           LightningBolt,
-		"lightning_bolt_circle":                    LightningBoltCircle,
-		"lightning_bolt_outline":                   LightningBoltOutline,
-		"line_scan":                                LineScan,
-		"lingerie":                                 Lingerie,
-		"link":                                     Link,
-		"link_box":                                 LinkBox,
-		"link_box_outline":                         LinkBoxOutline,
-		"link_box_variant":                         LinkBoxVariant,
-		"link_box_variant_outline":                 LinkBoxVariantOutline,
-		"link_lock":                                LinkLock,
-		"link_off":                                 LinkOff,
-		"link_plus":                                LinkPlus,
-		"link_variant":                             LinkVariant,
-		"link_variant_minus":                       LinkVariantMinus,
-		"link_variant_off":                         LinkVariantOff,
-		"link_variant_plus":                        LinkVariantPlus,
-		"link_variant_remove":                      LinkVariantRemove,
-		"linkedin":                                 Linkedin,
-		"linux":                                    Linux,
-		"linux_mint":                               LinuxMint,
-		"lipstick":                                 Lipstick,
-		"liquid_spot":                              LiquidSpot,
-		"liquor":                                   Liquor,
-		"list_status":                              ListStatus,
-		"litecoin":                                 Litecoin,
-		"loading":                                  Loading,
-		"location_enter":                           LocationEnter,
-		"location_exit":                            LocationExit,
-		"lock":                                     Lock,
-		"lock_alert":                               LockAlert,
-		"lock_alert_outline":                       LockAlertOutline,
-		"lock_check":                               LockCheck,
-		"lock_check_outline":                       LockCheckOutline,
-		"lock_clock":                               LockClock,
-		"lock_minus":                               LockMinus,
-		"lock_minus_outline":                       LockMinusOutline,
-		"lock_off":                                 LockOff,
-		"lock_off_outline":                         LockOffOutline,
-		"lock_open":                                LockOpen,
-		"lock_open_alert":                          LockOpenAlert,
-		"lock_open_alert_outline":                  LockOpenAlertOutline,
-		"lock_open_check":                          LockOpenCheck,
-		"lock_open_check_outline":                  LockOpenCheckOutline,
-		"lock_open_minus":                          LockOpenMinus,
-		"lock_open_minus_outline":                  LockOpenMinusOutline,
-		"lock_open_outline":                        LockOpenOutline,
-		"lock_open_plus":                           LockOpenPlus,
-		"lock_open_plus_outline":                   LockOpenPlusOutline,
-		"lock_open_remove":                         LockOpenRemove,
-		"lock_open_remove_outline":                 LockOpenRemoveOutline,
-		"lock_open_variant":                        LockOpenVariant,
-		"lock_open_variant_outline":                LockOpenVariantOutline,
-		"lock_outline":                             LockOutline,
-		"lock_pattern":                             LockPattern,
-		"lock_plus":                                LockPlus,
-		"lock_plus_outline":                        LockPlusOutline,
-		"lock_question":                            LockQuestion,
-		"lock_remove":                              LockRemove,
-		"lock_remove_outline":                      LockRemoveOutline,
-		"lock_reset":                               LockReset,
-		"lock_smart":                               LockSmart,
-		"locker":                                   Locker,
-		"locker_multiple":                          LockerMultiple,
-		"login":                                    Login,
-		"logout":                                   Logout,
-		"logout_variant":                           LogoutVariant,
-		"longitude":                                Longitude,
-		"looks":                                    Looks,
-		"lotion":                                   Lotion,
-		"lotion_outline":                           LotionOutline,
-		"lotion_plus":                              LotionPlus,
-		"lotion_plus_outline":                      LotionPlusOutline,
-		"loupe":                                    Loupe,
-		"lumx":                                     Lumx,
-		"lungs":                                    Lungs,
-		"mace":                                     Mace,
-		"magazine_pistol":                          MagazinePistol,
-		"magazine_rifle":                           MagazineRifle,
-		"magic_staff":                              MagicStaff,
-		"magnet":                                   Magnet,
-		"magnet_on":                                MagnetOn,
-		"magnify":                                  Magnify,
-		"magnify_close":                            MagnifyClose,
-		"magnify_expand":                           MagnifyExpand,
-		"magnify_minus":                            MagnifyMinus,
-		"magnify_minus_cursor":                     MagnifyMinusCursor,
-		"magnify_minus_outline":                    MagnifyMinusOutline,
-		"magnify_plus":                             MagnifyPlus,
-		"magnify_plus_cursor":                      MagnifyPlusCursor,
-		"magnify_plus_outline":                     MagnifyPlusOutline,
-		"magnify_remove_cursor":                    MagnifyRemoveCursor,
-		"magnify_remove_outline":                   MagnifyRemoveOutline,
-		"magnify_scan":                             MagnifyScan,
-		"mail":                                     Mail,
-		"mailbox":                                  Mailbox,
-		"mailbox_open":                             MailboxOpen,
-		"mailbox_open_outline":                     MailboxOpenOutline,
-		"mailbox_open_up":                          MailboxOpenUp,
-		"mailbox_open_up_outline":                  MailboxOpenUpOutline,
-		"mailbox_outline":                          MailboxOutline,
-		"mailbox_up":                               MailboxUp,
-		"mailbox_up_outline":                       MailboxUpOutline,
-		"manjaro":                                  Manjaro,
-		"map":                                      Map,
-		"map_check":                                MapCheck,
-		"map_check_outline":                        MapCheckOutline,
-		"map_clock":                                MapClock,
-		"map_clock_outline":                        MapClockOutline,
-		"map_legend":                               MapLegend,
-		"map_marker":                               MapMarker,
-		"map_marker_account":                       MapMarkerAccount,
-		"map_marker_account_outline":               MapMarkerAccountOutline,
-		"map_marker_alert":                         MapMarkerAlert,
-		"map_marker_alert_outline":                 MapMarkerAlertOutline,
-		"map_marker_check":                         MapMarkerCheck,
-		"map_marker_check_outline":                 MapMarkerCheckOutline,
-		"map_marker_circle":                        MapMarkerCircle,
-		"map_marker_distance":                      MapMarkerDistance,
-		"map_marker_down":                          MapMarkerDown,
-		"map_marker_left":                          MapMarkerLeft,
-		"map_marker_left_outline":                  MapMarkerLeftOutline,
-		"map_marker_minus":                         MapMarkerMinus,
-		"map_marker_minus_outline":                 MapMarkerMinusOutline,
-		"map_marker_multiple":                      MapMarkerMultiple,
-		"map_marker_multiple_outline":              MapMarkerMultipleOutline,
-		"map_marker_off":                           MapMarkerOff,
-		"map_marker_off_outline":                   MapMarkerOffOutline,
-		"map_marker_outline":                       MapMarkerOutline,
-		"map_marker_path":                          MapMarkerPath,
-		"map_marker_plus":                          MapMarkerPlus,
-		"map_marker_plus_outline":                  MapMarkerPlusOutline,
-		"map_marker_question":                      MapMarkerQuestion,
-		"map_marker_question_outline":              MapMarkerQuestionOutline,
-		"map_marker_radius":                        MapMarkerRadius,
-		"map_marker_radius_outline":                MapMarkerRadiusOutline,
-		"map_marker_remove":                        MapMarkerRemove,
-		"map_marker_remove_outline":                MapMarkerRemoveOutline,
-		"map_marker_remove_variant":                MapMarkerRemoveVariant,
-		"map_marker_right":                         MapMarkerRight,
-		"map_marker_right_outline":                 MapMarkerRightOutline,
-		"map_marker_star":                          MapMarkerStar,
-		"map_marker_star_outline":                  MapMarkerStarOutline,
-		"map_marker_up":                            MapMarkerUp,
-		"map_minus":                                MapMinus,
-		"map_outline":                              MapOutline,
-		"map_plus":                                 MapPlus,
-		"map_search":                               MapSearch,
-		"map_search_outline":                       MapSearchOutline,
-		"mapbox":                                   Mapbox,
-		"margin":                                   Margin,
-		"marker":                                   Marker,
-		"marker_cancel":                            MarkerCancel,
-		"marker_check":                             MarkerCheck,
-		"mastodon":                                 Mastodon,
-		"material_design":                          MaterialDesign,
-		"material_ui":                              MaterialUi,
-		"math_compass":                             MathCompass,
-		"math_cos":                                 MathCos,
-		"math_integral":                            MathIntegral,
-		"math_integral_box":                        MathIntegralBox,
-		"math_log":                                 MathLog,
-		"math_norm":                                MathNorm,
-		"math_norm_box":                            MathNormBox,
-		"math_sin":                                 MathSin,
-		"math_tan":                                 MathTan,
-		"matrix":                                   Matrix,
-		"medal":                                    Medal,
-		"medal_outline":                            MedalOutline,
-		"medical_bag":                              MedicalBag,
-		"medical_cotton_swab":                      MedicalCottonSwab,
-		"meditation":                               Meditation,
-		"memory":                                   Memory,
-		"menorah":                                  Menorah,
-		"menorah_fire":                             MenorahFire,
-		"menu":                                     Menu,
-		"menu_down":                                MenuDown,
-		"menu_down_outline":                        MenuDownOutline,
-		"menu_left":                                MenuLeft,
-		"menu_left_outline":                        MenuLeftOutline,
-		"menu_open":                                MenuOpen,
-		"menu_right":                               MenuRight,
-		"menu_right_outline":                       MenuRightOutline,
-		"menu_swap":                                MenuSwap,
-		"menu_swap_outline":                        MenuSwapOutline,
-		"menu_up":                                  MenuUp,
-		"menu_up_outline":                          MenuUpOutline,
-		"merge":                                    Merge,
-		"message":                                  Message,
-		"message_alert":                            MessageAlert,
-		"message_alert_outline":                    MessageAlertOutline,
-		"message_arrow_left":                       MessageArrowLeft,
-		"message_arrow_left_outline":               MessageArrowLeftOutline,
-		"message_arrow_right":                      MessageArrowRight,
-		"message_arrow_right_outline":              MessageArrowRightOutline,
-		"message_badge":                            MessageBadge,
-		"message_badge_outline":                    MessageBadgeOutline,
-		"message_bookmark":                         MessageBookmark,
-		"message_bookmark_outline":                 MessageBookmarkOutline,
-		"message_bulleted":                         MessageBulleted,
-		"message_bulleted_off":                     MessageBulletedOff,
-		"message_cog":                              MessageCog,
-		"message_cog_outline":                      MessageCogOutline,
-		"message_draw":                             MessageDraw,
-		"message_fast":                             MessageFast,
-		"message_fast_outline":                     MessageFastOutline,
-		"message_flash":                            MessageFlash,
-		"message_flash_outline":                    MessageFlashOutline,
-		"message_image":                            MessageImage,
-		"message_image_outline":                    MessageImageOutline,
-		"message_lock":                             MessageLock,
-		"message_lock_outline":                     MessageLockOutline,
-		"message_minus":                            MessageMinus,
-		"message_minus_outline":                    MessageMinusOutline,
-		"message_off":                              MessageOff,
-		"message_off_outline":                      MessageOffOutline,
-		"message_outline":                          MessageOutline,
-		"message_plus":                             MessagePlus,
-		"message_plus_outline":                     MessagePlusOutline,
-		"message_processing":                       MessageProcessing,
-		"message_processing_outline":               MessageProcessingOutline,
-		"message_question":                         MessageQuestion,
-		"message_question_outline":                 MessageQuestionOutline,
-		"message_reply":                            MessageReply,
-		"message_reply_outline":                    MessageReplyOutline,
-		"message_reply_text":                       MessageReplyText,
-		"message_reply_text_outline":               MessageReplyTextOutline,
-		"message_settings":                         MessageSettings,
-		"message_settings_outline":                 MessageSettingsOutline,
-		"message_star":                             MessageStar,
-		"message_star_outline":                     MessageStarOutline,
-		"message_text":                             MessageText,
-		"message_text_clock":                       MessageTextClock,
-		"message_text_clock_outline":               MessageTextClockOutline,
-		"message_text_fast":                        MessageTextFast,
-		"message_text_fast_outline":                MessageTextFastOutline,
-		"message_text_lock":                        MessageTextLock,
-		"message_text_lock_outline":                MessageTextLockOutline,
-		"message_text_outline":                     MessageTextOutline,
-		"message_video":                            MessageVideo,
-		"meteor":                                   Meteor,
-		"meter_electric":                           MeterElectric,
-		"meter_electric_outline":                   MeterElectricOutline,
-		"meter_gas":                                MeterGas,
-		"meter_gas_outline":                        MeterGasOutline,
-		"metronome":                                Metronome,
-		"metronome_tick":                           MetronomeTick,
-		"micro_sd":                                 MicroSd,
-		"microphone":                               Microphone,
-		"microphone_minus":                         MicrophoneMinus,
-		"microphone_off":                           MicrophoneOff,
-		"microphone_outline":                       MicrophoneOutline,
-		"microphone_plus":                          MicrophonePlus,
-		"microphone_question":                      MicrophoneQuestion,
-		"microphone_question_outline":              MicrophoneQuestionOutline,
-		"microphone_settings":                      MicrophoneSettings,
-		"microphone_variant":                       MicrophoneVariant,
-		"microphone_variant_off":                   MicrophoneVariantOff,
-		"microscope":                               Microscope,
-		"microsoft":                                Microsoft,
-		"microsoft_access":                         MicrosoftAccess,
-		"microsoft_azure":                          MicrosoftAzure,
-		"microsoft_azure_devops":                   MicrosoftAzureDevops,
-		"microsoft_bing":                           MicrosoftBing,
-		"microsoft_dynamics_365":                   MicrosoftDynamics365,
-		"microsoft_edge":                           MicrosoftEdge,
-		"microsoft_excel":                          MicrosoftExcel,
-		"microsoft_internet_explorer":              MicrosoftInternetExplorer,
-		"microsoft_office":                         MicrosoftOffice,
-		"microsoft_onedrive":                       MicrosoftOnedrive,
-		"microsoft_onenote":                        MicrosoftOnenote,
-		"microsoft_outlook":                        MicrosoftOutlook,
-		"microsoft_powerpoint":                     MicrosoftPowerpoint,
-		"microsoft_sharepoint":                     MicrosoftSharepoint,
-		"microsoft_teams":                          MicrosoftTeams,
-		"microsoft_visual_studio":                  MicrosoftVisualStudio,
-		"microsoft_visual_studio_code":             MicrosoftVisualStudioCode,
-		"microsoft_windows":                        MicrosoftWindows,
-		"microsoft_windows_classic":                MicrosoftWindowsClassic,
-		"microsoft_word":                           MicrosoftWord,
-		"microsoft_xbox":                           MicrosoftXbox,
-		"microsoft_xbox_controller":                MicrosoftXboxController,
-		"microsoft_xbox_controller_battery_alert":    MicrosoftXboxControllerBatteryAlert,
-		"microsoft_xbox_controller_battery_charging": MicrosoftXboxControllerBatteryCharging,
-		"microsoft_xbox_controller_battery_empty":    MicrosoftXboxControllerBatteryEmpty,
-		"microsoft_xbox_controller_battery_full":     MicrosoftXboxControllerBatteryFull,
-		"microsoft_xbox_controller_battery_low":      MicrosoftXboxControllerBatteryLow,
-		"microsoft_xbox_controller_battery_medium":   MicrosoftXboxControllerBatteryMedium,
-		"microsoft_xbox_controller_battery_unknown":  MicrosoftXboxControllerBatteryUnknown,
-		"microsoft_xbox_controller_menu":             MicrosoftXboxControllerMenu,
-		"microsoft_xbox_controller_off":              MicrosoftXboxControllerOff,
-		"microsoft_xbox_controller_view":             MicrosoftXboxControllerView,
-		"microwave":                                  Microwave,
-		"microwave_off":                              MicrowaveOff,
-		"middleware":                                 Middleware,
-		"middleware_outline":                         MiddlewareOutline,
-		"midi":                                       Midi,
-		"midi_port":                                  MidiPort,
-		"mine":                                       Mine,
-		"minecraft":                                  Minecraft,
-		"mini_sd":                                    MiniSd,
-		"minidisc":                                   Minidisc,
-		"minus":                                      Minus,
-		"minus_box":                                  MinusBox,
-		"minus_box_multiple":                         MinusBoxMultiple,
-		"minus_box_multiple_outline":                 MinusBoxMultipleOutline,
-		"minus_box_outline":                          MinusBoxOutline,
-		"minus_circle":                               MinusCircle,
-		"minus_circle_multiple":                      MinusCircleMultiple,
-		"minus_circle_multiple_outline":              MinusCircleMultipleOutline,
-		"minus_circle_off":                           MinusCircleOff,
-		"minus_circle_off_outline":                   MinusCircleOffOutline,
-		"minus_circle_outline":                       MinusCircleOutline,
-		"minus_network":                              MinusNetwork,
-		"minus_network_outline":                      MinusNetworkOutline,
-		"minus_thick":                                MinusThick,
-		"mirror":                                     Mirror,
-		"mirror_rectangle":                           MirrorRectangle,
-		"mirror_variant":                             MirrorVariant,
-		"mixed_martial_arts":                         MixedMartialArts,
-		"mixed_reality":                              MixedReality,
-		"molecule":                                   Molecule,
-		"molecule_co":                                MoleculeCo,
-		"molecule_co2":                               MoleculeCo2,
-		"monitor":                                    Monitor,
-		"monitor_account":                            MonitorAccount,
-		"monitor_arrow_down":                         MonitorArrowDown,
-		"monitor_arrow_down_variant":                 MonitorArrowDownVariant,
-		"monitor_cellphone":                          MonitorCellphone,
-		"monitor_cellphone_star":                     MonitorCellphoneStar,
-		"monitor_dashboard":                          MonitorDashboard,
-		"monitor_edit":                               MonitorEdit,
-		"monitor_eye":                                MonitorEye,
-		"monitor_lock":                               MonitorLock,
-		"monitor_multiple":                           MonitorMultiple,
-		"monitor_off":                                MonitorOff,
-		"monitor_screenshot":                         MonitorScreenshot,
-		"monitor_share":                              MonitorShare,
-		"monitor_shimmer":                            MonitorShimmer,
-		"monitor_small":                              MonitorSmall,
-		"monitor_speaker":                            MonitorSpeaker,
-		"monitor_speaker_off":                        MonitorSpeakerOff,
-		"monitor_star":                               MonitorStar,
-		"moon_first_quarter":                         MoonFirstQuarter,
-		"moon_full":                                  MoonFull,
-		"moon_last_quarter":                          MoonLastQuarter,
-		"moon_new":                                   MoonNew,
-		"moon_waning_crescent":                       MoonWaningCrescent,
-		"moon_waning_gibbous":                        MoonWaningGibbous,
-		"moon_waxing_crescent":                       MoonWaxingCrescent,
-		"moon_waxing_gibbous":                        MoonWaxingGibbous,
-		"moped":                                      Moped,
-		"moped_electric":                             MopedElectric,
-		"moped_electric_outline":                     MopedElectricOutline,
-		"moped_outline":                              MopedOutline,
-		"more":                                       More,
-		"mortar_pestle":                              MortarPestle,
-		"mortar_pestle_plus":                         MortarPestlePlus,
-		"mosque":                                     Mosque,
-		"mother_heart":                               MotherHeart,
-		"mother_nurse":                               MotherNurse,
-		"motion":                                     Motion,
-		"motion_outline":                             MotionOutline,
-		"motion_pause":                               MotionPause,
-		"motion_pause_outline":                       MotionPauseOutline,
-		"motion_play":                                MotionPlay,
-		"motion_play_outline":                        MotionPlayOutline,
-		"motion_sensor":                              MotionSensor,
-		"motion_sensor_off":                          MotionSensorOff,
-		"motorbike":                                  Motorbike,
-		"motorbike_electric":                         MotorbikeElectric,
-		"mouse":                                      Mouse,
-		"mouse_bluetooth":                            MouseBluetooth,
-		"mouse_move_down":                            MouseMoveDown,
-		"mouse_move_up":                              MouseMoveUp,
-		"mouse_move_vertical":                        MouseMoveVertical,
-		"mouse_off":                                  MouseOff,
-		"mouse_variant":                              MouseVariant,
-		"mouse_variant_off":                          MouseVariantOff,
-		"move_resize":                                MoveResize,
-		"move_resize_variant":                        MoveResizeVariant,
-		"movie":                                      Movie,
-		"movie_check":                                MovieCheck,
-		"movie_check_outline":                        MovieCheckOutline,
-		"movie_cog":                                  MovieCog,
-		"movie_cog_outline":                          MovieCogOutline,
-		"movie_edit":                                 MovieEdit,
-		"movie_edit_outline":                         MovieEditOutline,
-		"movie_filter":                               MovieFilter,
-		"movie_filter_outline":                       MovieFilterOutline,
-		"movie_minus":                                MovieMinus,
-		"movie_minus_outline":                        MovieMinusOutline,
-		"movie_off":                                  MovieOff,
-		"movie_off_outline":                          MovieOffOutline,
-		"movie_open":                                 MovieOpen,
-		"movie_open_check":                           MovieOpenCheck,
-		"movie_open_check_outline":                   MovieOpenCheckOutline,
-		"movie_open_cog":                             MovieOpenCog,
-		"movie_open_cog_outline":                     MovieOpenCogOutline,
-		"movie_open_edit":                            MovieOpenEdit,
-		"movie_open_edit_outline":                    MovieOpenEditOutline,
-		"movie_open_minus":                           MovieOpenMinus,
-		"movie_open_minus_outline":                   MovieOpenMinusOutline,
-		"movie_open_off":                             MovieOpenOff,
-		"movie_open_off_outline":                     MovieOpenOffOutline,
-		"movie_open_outline":                         MovieOpenOutline,
-		"movie_open_play":                            MovieOpenPlay,
-		"movie_open_play_outline":                    MovieOpenPlayOutline,
-		"movie_open_plus":                            MovieOpenPlus,
-		"movie_open_plus_outline":                    MovieOpenPlusOutline,
-		"movie_open_remove":                          MovieOpenRemove,
-		"movie_open_remove_outline":                  MovieOpenRemoveOutline,
-		"movie_open_settings":                        MovieOpenSettings,
-		"movie_open_settings_outline":                MovieOpenSettingsOutline,
-		"movie_open_star":                            MovieOpenStar,
-		"movie_open_star_outline":                    MovieOpenStarOutline,
-		"movie_outline":                              MovieOutline,
-		"movie_play":                                 MoviePlay,
-		"movie_play_outline":                         MoviePlayOutline,
-		"movie_plus":                                 MoviePlus,
-		"movie_plus_outline":                         MoviePlusOutline,
-		"movie_remove":                               MovieRemove,
-		"movie_remove_outline":                       MovieRemoveOutline,
-		"movie_roll":                                 MovieRoll,
-		"movie_search":                               MovieSearch,
-		"movie_search_outline":                       MovieSearchOutline,
-		"movie_settings":                             MovieSettings,
-		"movie_settings_outline":                     MovieSettingsOutline,
-		"movie_star":                                 MovieStar,
-		"movie_star_outline":                         MovieStarOutline,
-		"mower":                                      Mower,
-		"mower_bag":                                  MowerBag,
-		"muffin":                                     Muffin,
-		"multicast":                                  Multicast,
-		"multiplication":                             Multiplication,
-		"multiplication_box":                         MultiplicationBox,
-		"mushroom":                                   Mushroom,
-		"mushroom_off":                               MushroomOff,
-		"mushroom_off_outline":                       MushroomOffOutline,
-		"mushroom_outline":                           MushroomOutline,
-		"music":                                      Music,
-		"music_accidental_double_flat":               MusicAccidentalDoubleFlat,
-		"music_accidental_double_sharp":              MusicAccidentalDoubleSharp,
-		"music_accidental_flat":                      MusicAccidentalFlat,
-		"music_accidental_natural":                   MusicAccidentalNatural,
-		"music_accidental_sharp":                     MusicAccidentalSharp,
-		"music_box":                                  MusicBox,
-		"music_box_multiple":                         MusicBoxMultiple,
-		"music_box_multiple_outline":                 MusicBoxMultipleOutline,
-		"music_box_outline":                          MusicBoxOutline,
-		"music_circle":                               MusicCircle,
-		"music_circle_outline":                       MusicCircleOutline,
-		"music_clef_alto":                            MusicClefAlto,
-		"music_clef_bass":                            MusicClefBass,
-		"music_clef_treble":                          MusicClefTreble,
-		"music_note":                                 MusicNote,
-		"music_note_bluetooth":                       MusicNoteBluetooth,
-		"music_note_bluetooth_off":                   MusicNoteBluetoothOff,
-		"music_note_eighth_dotted":                   MusicNoteEighthDotted,
-		"music_note_half":                            MusicNoteHalf,
-		"music_note_half_dotted":                     MusicNoteHalfDotted,
-		"music_note_off":                             MusicNoteOff,
-		"music_note_off_outline":                     MusicNoteOffOutline,
-		"music_note_outline":                         MusicNoteOutline,
-		"music_note_plus":                            MusicNotePlus,
-		"music_note_quarter":                         MusicNoteQuarter,
-		"music_note_quarter_dotted":                  MusicNoteQuarterDotted,
-		"music_note_sixteenth":                       MusicNoteSixteenth,
-		"music_note_sixteenth_dotted":                MusicNoteSixteenthDotted,
-		"music_note_whole":                           MusicNoteWhole,
-		"music_note_whole_dotted":                    MusicNoteWholeDotted,
-		"music_off":                                  MusicOff,
-		"music_rest_eighth":                          MusicRestEighth,
-		"music_rest_half":                            MusicRestHalf,
-		"music_rest_quarter":                         MusicRestQuarter,
-		"music_rest_sixteenth":                       MusicRestSixteenth,
-		"music_rest_whole":                           MusicRestWhole,
-		"mustache":                                   Mustache,
-		"nail":                                       Nail,
-		"nas":                                        Nas,
-		"nativescript":                               Nativescript,
-		"nature":                                     Nature,
-		"nature_people":                              NaturePeople,
-		"navigation":                                 Navigation,
-		"navigation_outline":                         NavigationOutline,
-		"navigation_variant_outline":                 NavigationVariantOutline,
-		"near_me":                                    NearMe,
-		"necklace":                                   Necklace,
-		"needle":                                     Needle,
-		"needle_off":                                 NeedleOff,
-		"netflix":                                    Netflix,
-		"network":                                    Network,
-		"network_off":                                NetworkOff,
-		"network_off_outline":                        NetworkOffOutline,
-		"network_outline":                            NetworkOutline,
-		"network_pos":                                NetworkPos,
-		"network_strength_1":                         NetworkStrength1,
-		"network_strength_1_alert":                   NetworkStrength1Alert,
-		"network_strength_2":                         NetworkStrength2,
-		"network_strength_2_alert":                   NetworkStrength2Alert,
-		"network_strength_3":                         NetworkStrength3,
-		"network_strength_3_alert":                   NetworkStrength3Alert,
-		"network_strength_4":                         NetworkStrength4,
-		"network_strength_4_alert":                   NetworkStrength4Alert,
-		"network_strength_4_cog":                     NetworkStrength4Cog,
-		"network_strength_off":                       NetworkStrengthOff,
-		"network_strength_off_outline":               NetworkStrengthOffOutline,
-		"network_strength_outline":                   NetworkStrengthOutline,
-		"new_box":                                    NewBox,
-		"newspaper":                                  Newspaper,
-		"newspaper_check":                            NewspaperCheck,
-		"newspaper_minus":                            NewspaperMinus,
-		"newspaper_plus":                             NewspaperPlus,
-		"newspaper_remove":                           NewspaperRemove,
-		"newspaper_variant":                          NewspaperVariant,
-		"newspaper_variant_multiple":                 NewspaperVariantMultiple,
-		"newspaper_variant_multiple_outline":         NewspaperVariantMultipleOutline,
-		"newspaper_variant_outline":                  NewspaperVariantOutline,
-		"nfc":                                        Nfc,
-		"nfc_search_variant":                         NfcSearchVariant,
-		"nfc_tap":                                    NfcTap,
-		"nfc_variant":                                NfcVariant,
-		"nfc_variant_off":                            NfcVariantOff,
-		"ninja":                                      Ninja,
-		"nintendo_game_boy":                          NintendoGameBoy,
-		"nintendo_switch":                            NintendoSwitch,
-		"nintendo_wii":                               NintendoWii,
-		"nintendo_wiiu":                              NintendoWiiu,
-		"nix":                                        Nix,
-		"nodejs":                                     Nodejs,
-		"noodles":                                    Noodles,
-		"not_equal":                                  NotEqual,
-		"not_equal_variant":                          NotEqualVariant,
-		"note":                                       Note,
-		"note_alert":                                 NoteAlert,
-		"note_alert_outline":                         NoteAlertOutline,
-		"note_check":                                 NoteCheck,
-		"note_check_outline":                         NoteCheckOutline,
-		"note_edit":                                  NoteEdit,
-		"note_edit_outline":                          NoteEditOutline,
-		"note_minus":                                 NoteMinus,
-		"note_minus_outline":                         NoteMinusOutline,
-		"note_multiple":                              NoteMultiple,
-		"note_multiple_outline":                      NoteMultipleOutline,
-		"note_off":                                   NoteOff,
-		"note_off_outline":                           NoteOffOutline,
-		"note_outline":                               NoteOutline,
-		"note_plus":                                  NotePlus,
-		"note_plus_outline":                          NotePlusOutline,
-		"note_remove":                                NoteRemove,
-		"note_remove_outline":                        NoteRemoveOutline,
-		"note_search":                                NoteSearch,
-		"note_search_outline":                        NoteSearchOutline,
-		"note_text":                                  NoteText,
-		"note_text_outline":                          NoteTextOutline,
-		"notebook":                                   Notebook,
-		"notebook_check":                             NotebookCheck,
-		"notebook_check_outline":                     NotebookCheckOutline,
-		"notebook_edit":                              NotebookEdit,
-		"notebook_edit_outline":                      NotebookEditOutline,
-		"notebook_heart":                             NotebookHeart,
-		"notebook_heart_outline":                     NotebookHeartOutline,
-		"notebook_minus":                             NotebookMinus,
-		"notebook_minus_outline":                     NotebookMinusOutline,
-		"notebook_multiple":                          NotebookMultiple,
-		"notebook_outline":                           NotebookOutline,
-		"notebook_plus":                              NotebookPlus,
-		"notebook_plus_outline":                      NotebookPlusOutline,
-		"notebook_remove":                            NotebookRemove,
-		"notebook_remove_outline":                    NotebookRemoveOutline,
-		"notification_clear_all":                     NotificationClearAll,
-		"npm":                                        Npm,
-		"nuke":                                       Nuke,
-		"null":                                       Null,
-		"numeric":                                    Numeric,
-		"numeric_0_box":                              Numeric0Box,
-		"numeric_0_box_multiple":                     Numeric0BoxMultiple,
-		"numeric_0_box_multiple_outline":             Numeric0BoxMultipleOutline,
-		"numeric_0_box_outline":                      Numeric0BoxOutline,
-		"numeric_1":                                  Numeric1,
-		"numeric_10":                                 Numeric10,
-		"numeric_10_box":                             Numeric10Box,
-		"numeric_10_box_multiple":                    Numeric10BoxMultiple,
-		"numeric_10_box_multiple_outline":            Numeric10BoxMultipleOutline,
-		"numeric_10_box_outline":                     Numeric10BoxOutline,
-		"numeric_10_circle":                          Numeric10Circle,
-		"numeric_10_circle_outline":                  Numeric10CircleOutline,
-		"numeric_1_box":                              Numeric1Box,
-		"numeric_1_box_multiple":                     Numeric1BoxMultiple,
-		"numeric_1_box_multiple_outline":             Numeric1BoxMultipleOutline,
-		"numeric_1_box_outline":                      Numeric1BoxOutline,
-		"numeric_1_circle":                           Numeric1Circle,
-		"numeric_1_circle_outline":                   Numeric1CircleOutline,
-		"numeric_2":                                  Numeric2,
-		"numeric_2_box":                              Numeric2Box,
-		"numeric_2_box_multiple":                     Numeric2BoxMultiple,
-		"numeric_2_box_multiple_outline":             Numeric2BoxMultipleOutline,
-		"numeric_2_box_outline":                      Numeric2BoxOutline,
-		"numeric_2_circle":                           Numeric2Circle,
-		"numeric_2_circle_outline":                   Numeric2CircleOutline,
-		"numeric_3":                                  Numeric3,
-		"numeric_3_box":                              Numeric3Box,
-		"numeric_3_box_multiple":                     Numeric3BoxMultiple,
-		"numeric_3_box_multiple_outline":             Numeric3BoxMultipleOutline,
-		"numeric_3_box_outline":                      Numeric3BoxOutline,
-		"numeric_3_circle":                           Numeric3Circle,
-		"numeric_3_circle_outline":                   Numeric3CircleOutline,
-		"numeric_4":                                  Numeric4,
-		"numeric_4_box":                              Numeric4Box,
-		"numeric_4_box_multiple":                     Numeric4BoxMultiple,
-		"numeric_4_box_multiple_outline":             Numeric4BoxMultipleOutline,
-		"numeric_4_box_outline":                      Numeric4BoxOutline,
-		"numeric_4_circle":                           Numeric4Circle,
-		"numeric_4_circle_outline":                   Numeric4CircleOutline,
-		"numeric_5":                                  Numeric5,
-		"numeric_5_box":                              Numeric5Box,
-		"numeric_5_box_multiple":                     Numeric5BoxMultiple,
-		"numeric_5_box_multiple_outline":             Numeric5BoxMultipleOutline,
-		"numeric_5_box_outline":                      Numeric5BoxOutline,
-		"numeric_5_circle":                           Numeric5Circle,
-		"numeric_5_circle_outline":                   Numeric5CircleOutline,
-		"numeric_6":                                  Numeric6,
-		"numeric_6_box":                              Numeric6Box,
-		"numeric_6_box_multiple":                     Numeric6BoxMultiple,
-		"numeric_6_box_multiple_outline":             Numeric6BoxMultipleOutline,
-		"numeric_6_box_outline":                      Numeric6BoxOutline,
-		"numeric_6_circle":                           Numeric6Circle,
-		"numeric_6_circle_outline":                   Numeric6CircleOutline,
-		"numeric_7":                                  Numeric7,
-		"numeric_7_box":                              Numeric7Box,
-		"numeric_7_box_multiple":                     Numeric7BoxMultiple,
-		"numeric_7_box_multiple_outline":             Numeric7BoxMultipleOutline,
-		"numeric_7_box_outline":                      Numeric7BoxOutline,
-		"numeric_7_circle":                           Numeric7Circle,
-		"numeric_7_circle_outline":                   Numeric7CircleOutline,
-		"numeric_8":                                  Numeric8,
-		"numeric_8_box":                              Numeric8Box,
-		"numeric_8_box_multiple":                     Numeric8BoxMultiple,
-		"numeric_8_box_multiple_outline":             Numeric8BoxMultipleOutline,
-		"numeric_8_box_outline":                      Numeric8BoxOutline,
-		"numeric_8_circle":                           Numeric8Circle,
-		"numeric_8_circle_outline":                   Numeric8CircleOutline,
-		"numeric_9":                                  Numeric9,
-		"numeric_9_box":                              Numeric9Box,
-		"numeric_9_box_multiple":                     Numeric9BoxMultiple,
-		"numeric_9_box_multiple_outline":             Numeric9BoxMultipleOutline,
-		"numeric_9_box_outline":                      Numeric9BoxOutline,
-		"numeric_9_circle":                           Numeric9Circle,
-		"numeric_9_circle_outline":                   Numeric9CircleOutline,
-		"numeric_9_plus":                             Numeric9Plus,
-		"numeric_9_plus_box":                         Numeric9PlusBox,
-		"numeric_9_plus_box_multiple":                Numeric9PlusBoxMultiple,
-		"numeric_9_plus_box_multiple_outline":        Numeric9PlusBoxMultipleOutline,
-		"numeric_9_plus_box_outline":                 Numeric9PlusBoxOutline,
-		"numeric_9_plus_circle":                      Numeric9PlusCircle,
-		"numeric_9_plus_circle_outline":              Numeric9PlusCircleOutline,
-		"numeric_negative_1":                         NumericNegative1,
-		"numeric_off":                                NumericOff,
-		"numeric_positive_1":                         NumericPositive1,
-		"nut":                                        Nut,
-		"nutrition":                                  Nutrition,
-		"nuxt":                                       Nuxt,
-		"oar":                                        Oar,
-		"ocarina":                                    Ocarina,
-		"oci":                                        Oci,
-		"ocr":                                        Ocr,
-		"octagon":                                    Octagon,
-		"octagon_outline":                            OctagonOutline,
-		"octagram":                                   Octagram,
-		"octagram_outline":                           OctagramOutline,
-		"octahedron":                                 Octahedron,
-		"octahedron_off":                             OctahedronOff,
-		"odnoklassniki":                              Odnoklassniki,
-		"offer":                                      Offer,
-		"office_building":                            OfficeBuilding,
-		"office_building_cog":                        OfficeBuildingCog,
-		"office_building_cog_outline":                OfficeBuildingCogOutline,
-		"office_building_marker":                     OfficeBuildingMarker,
-		"office_building_marker_outline":             OfficeBuildingMarkerOutline,
-		"office_building_outline":                    OfficeBuildingOutline,
-		"oil":                                        Oil,
-		"oil_lamp":                                   OilLamp,
-		"oil_level":                                  OilLevel,
-		"oil_temperature":                            OilTemperature,
-		"om":                                         Om,
-		"omega":                                      Omega,
-		"one_up":                                     OneUp,
-		"onepassword":                                Onepassword,
-		"opacity":                                    Opacity,
-		"open_in_app":                                OpenInApp,
-		"open_in_new":                                OpenInNew,
-		"open_source_initiative":                     OpenSourceInitiative,
-		"openid":                                     Openid,
-		"opera":                                      Opera,
-		"orbit":                                      Orbit,
-		"orbit_variant":                              OrbitVariant,
-		"order_alphabetical_ascending":               OrderAlphabeticalAscending,
-		"order_alphabetical_descending":              OrderAlphabeticalDescending,
-		"order_bool_ascending":                       OrderBoolAscending,
-		"order_bool_ascending_variant":               OrderBoolAscendingVariant,
-		"order_bool_descending":                      OrderBoolDescending,
-		"order_bool_descending_variant":              OrderBoolDescendingVariant,
-		"order_numeric_ascending":                    OrderNumericAscending,
-		"order_numeric_descending":                   OrderNumericDescending,
-		"origin":                                     Origin,
-		"ornament":                                   Ornament,
-		"ornament_variant":                           OrnamentVariant,
-		"outdoor_lamp":                               OutdoorLamp,
-		"overscan":                                   Overscan,
-		"owl":                                        Owl,
-		"pac_man":                                    PacMan,
-		"package":                                    Package,
-		"package_down":                               PackageDown,
-		"package_up":                                 PackageUp,
-		"package_variant":                            PackageVariant,
-		"package_variant_closed":                     PackageVariantClosed,
-		"package_variant_closed_minus":               PackageVariantClosedMinus,
-		"package_variant_closed_plus":                PackageVariantClosedPlus,
-		"package_variant_closed_remove":              PackageVariantClosedRemove,
-		"package_variant_minus":                      PackageVariantMinus,
-		"package_variant_plus":                       PackageVariantPlus,
-		"package_variant_remove":                     PackageVariantRemove,
-		"page_first":                                 PageFirst,
-		"page_last":                                  PageLast,
-		"page_layout_body":                           PageLayoutBody,
-		"page_layout_footer":                         PageLayoutFooter,
-		"page_layout_header":                         PageLayoutHeader,
-		"page_layout_header_footer":                  PageLayoutHeaderFooter,
-		"page_layout_sidebar_left":                   PageLayoutSidebarLeft,
-		"page_layout_sidebar_right":                  PageLayoutSidebarRight,
-		"page_next":                                  PageNext,
-		"page_next_outline":                          PageNextOutline,
-		"page_previous":                              PagePrevious,
-		"page_previous_outline":                      PagePreviousOutline,
-		"pail":                                       Pail,
-		"pail_minus":                                 PailMinus,
-		"pail_minus_outline":                         PailMinusOutline,
-		"pail_off":                                   PailOff,
-		"pail_off_outline":                           PailOffOutline,
-		"pail_outline":                               PailOutline,
-		"pail_plus":                                  PailPlus,
-		"pail_plus_outline":                          PailPlusOutline,
-		"pail_remove":                                PailRemove,
-		"pail_remove_outline":                        PailRemoveOutline,
-		"palette":                                    Palette,
-		"palette_advanced":                           PaletteAdvanced,
-		"palette_outline":                            PaletteOutline,
-		"palette_swatch":                             PaletteSwatch,
-		"palette_swatch_outline":                     PaletteSwatchOutline,
-		"palette_swatch_variant":                     PaletteSwatchVariant,
-		"palm_tree":                                  PalmTree,
-		"pan":                                        Pan,
-		"pan_bottom_left":                            PanBottomLeft,
-		"pan_bottom_right":                           PanBottomRight,
-		"pan_down":                                   PanDown,
-		"pan_horizontal":                             PanHorizontal,
-		"pan_left":                                   PanLeft,
-		"pan_right":                                  PanRight,
-		"pan_top_left":                               PanTopLeft,
-		"pan_top_right":                              PanTopRight,
-		"pan_up":                                     PanUp,
-		"pan_vertical":                               PanVertical,
-		"panda":                                      Panda,
-		"pandora":                                    Pandora,
-		"panorama":                                   Panorama,
-		"panorama_fisheye":                           PanoramaFisheye,
-		"panorama_horizontal":                        PanoramaHorizontal,
-		"panorama_horizontal_outline":                PanoramaHorizontalOutline,
-		"panorama_outline":                           PanoramaOutline,
-		"panorama_sphere":                            PanoramaSphere,
-		"panorama_sphere_outline":                    PanoramaSphereOutline,
-		"panorama_variant":                           PanoramaVariant,
-		"panorama_variant_outline":                   PanoramaVariantOutline,
-		"panorama_vertical":                          PanoramaVertical,
-		"panorama_vertical_outline":                  PanoramaVerticalOutline,
-		"panorama_wide_angle":                        PanoramaWideAngle,
-		"panorama_wide_angle_outline":                PanoramaWideAngleOutline,
-		"paper_cut_vertical":                         PaperCutVertical,
-		"paper_roll":                                 PaperRoll,
-		"paper_roll_outline":                         PaperRollOutline,
-		"paperclip":                                  Paperclip,
-		"paperclip_check":                            PaperclipCheck,
-		"paperclip_lock":                             PaperclipLock,
-		"paperclip_minus":                            PaperclipMinus,
-		"paperclip_off":                              PaperclipOff,
-		"paperclip_plus":                             PaperclipPlus,
-		"paperclip_remove":                           PaperclipRemove,
-		"parachute":                                  Parachute,
-		"parachute_outline":                          ParachuteOutline,
-		"paragliding":                                Paragliding,
-		"parking":                                    Parking,
-		"party_popper":                               PartyPopper,
-		"passport":                                   Passport,
-		"passport_biometric":                         PassportBiometric,
-		"pasta":                                      Pasta,
-		"patio_heater":                               PatioHeater,
-		"patreon":                                    Patreon,
-		"pause":                                      Pause,
-		"pause_circle":                               PauseCircle,
-		"pause_circle_outline":                       PauseCircleOutline,
-		"pause_octagon":                              PauseOctagon,
-		"pause_octagon_outline":                      PauseOctagonOutline,
-		"paw":                                        Paw,
-		"paw_off":                                    PawOff,
-		"paw_off_outline":                            PawOffOutline,
-		"paw_outline":                                PawOutline,
-		"peace":                                      Peace,
-		"peanut":                                     Peanut,
-		"peanut_off":                                 PeanutOff,
-		"peanut_off_outline":                         PeanutOffOutline,
-		"peanut_outline":                             PeanutOutline,
-		"pen":                                        Pen,
-		"pen_lock":                                   PenLock,
-		"pen_minus":                                  PenMinus,
-		"pen_off":                                    PenOff,
-		"pen_plus":                                   PenPlus,
-		"pen_remove":                                 PenRemove,
-		"pencil":                                     Pencil,
-		"pencil_box":                                 PencilBox,
-		"pencil_box_multiple":                        PencilBoxMultiple,
-		"pencil_box_multiple_outline":                PencilBoxMultipleOutline,
-		"pencil_box_outline":                         PencilBoxOutline,
-		"pencil_circle":                              PencilCircle,
-		"pencil_circle_outline":                      PencilCircleOutline,
-		"pencil_lock":                                PencilLock,
-		"pencil_lock_outline":                        PencilLockOutline,
-		"pencil_minus":                               PencilMinus,
-		"pencil_minus_outline":                       PencilMinusOutline,
-		"pencil_off":                                 PencilOff,
-		"pencil_off_outline":                         PencilOffOutline,
-		"pencil_outline":                             PencilOutline,
-		"pencil_plus":                                PencilPlus,
-		"pencil_plus_outline":                        PencilPlusOutline,
-		"pencil_remove":                              PencilRemove,
-		"pencil_remove_outline":                      PencilRemoveOutline,
-		"pencil_ruler":                               PencilRuler,
-		"penguin":                                    Penguin,
-		"pentagon":                                   Pentagon,
-		"pentagon_outline":                           PentagonOutline,
-		"pentagram":                                  Pentagram,
-		"percent":                                    Percent,
-		"percent_box":                                PercentBox,
-		"percent_box_outline":                        PercentBoxOutline,
-		"percent_circle":                             PercentCircle,
-		"percent_circle_outline":                     PercentCircleOutline,
-		"percent_outline":                            PercentOutline,
-		"periodic_table":                             PeriodicTable,
-		"perspective_less":                           PerspectiveLess,
-		"perspective_more":                           PerspectiveMore,
-		"ph":                                         Ph,
-		"phone":                                      Phone,
-		"phone_alert":                                PhoneAlert,
-		"phone_alert_outline":                        PhoneAlertOutline,
-		"phone_bluetooth":                            PhoneBluetooth,
-		"phone_bluetooth_outline":                    PhoneBluetoothOutline,
-		"phone_cancel":                               PhoneCancel,
-		"phone_cancel_outline":                       PhoneCancelOutline,
-		"phone_check":                                PhoneCheck,
-		"phone_check_outline":                        PhoneCheckOutline,
-		"phone_classic":                              PhoneClassic,
-		"phone_classic_off":                          PhoneClassicOff,
-		"phone_clock":                                PhoneClock,
-		"phone_dial":                                 PhoneDial,
-		"phone_dial_outline":                         PhoneDialOutline,
-		"phone_forward":                              PhoneForward,
-		"phone_forward_outline":                      PhoneForwardOutline,
-		"phone_hangup":                               PhoneHangup,
-		"phone_hangup_outline":                       PhoneHangupOutline,
-		"phone_in_talk":                              PhoneInTalk,
-		"phone_in_talk_outline":                      PhoneInTalkOutline,
-		"phone_incoming":                             PhoneIncoming,
-		"phone_incoming_outline":                     PhoneIncomingOutline,
-		"phone_lock":                                 PhoneLock,
-		"phone_lock_outline":                         PhoneLockOutline,
-		"phone_log":                                  PhoneLog,
-		"phone_log_outline":                          PhoneLogOutline,
-		"phone_message":                              PhoneMessage,
-		"phone_message_outline":                      PhoneMessageOutline,
-		"phone_minus":                                PhoneMinus,
-		"phone_minus_outline":                        PhoneMinusOutline,
-		"phone_missed":                               PhoneMissed,
-		"phone_missed_outline":                       PhoneMissedOutline,
-		"phone_off":                                  PhoneOff,
-		"phone_off_outline":                          PhoneOffOutline,
-		"phone_outgoing":                             PhoneOutgoing,
-		"phone_outgoing_outline":                     PhoneOutgoingOutline,
-		"phone_outline":                              PhoneOutline,
-		"phone_paused":                               PhonePaused,
-		"phone_paused_outline":                       PhonePausedOutline,
-		"phone_plus":                                 PhonePlus,
-		"phone_plus_outline":                         PhonePlusOutline,
-		"phone_refresh":                              PhoneRefresh,
-		"phone_refresh_outline":                      PhoneRefreshOutline,
-		"phone_remove":                               PhoneRemove,
-		"phone_remove_outline":                       PhoneRemoveOutline,
-		"phone_return":                               PhoneReturn,
-		"phone_return_outline":                       PhoneReturnOutline,
-		"phone_ring":                                 PhoneRing,
-		"phone_ring_outline":                         PhoneRingOutline,
-		"phone_rotate_landscape":                     PhoneRotateLandscape,
-		"phone_rotate_portrait":                      PhoneRotatePortrait,
-		"phone_settings":                             PhoneSettings,
-		"phone_settings_outline":                     PhoneSettingsOutline,
-		"phone_sync":                                 PhoneSync,
-		"phone_sync_outline":                         PhoneSyncOutline,
-		"phone_voip":                                 PhoneVoip,
-		"pi":                                         Pi,
-		"pi_box":                                     PiBox,
-		"pi_hole":                                    PiHole,
-		"piano":                                      Piano,
-		"piano_off":                                  PianoOff,
-		"pickaxe":                                    Pickaxe,
-		"picture_in_picture_bottom_right":            PictureInPictureBottomRight,
-		"picture_in_picture_bottom_right_outline": PictureInPictureBottomRightOutline,
-		"picture_in_picture_top_right":            PictureInPictureTopRight,
-		"picture_in_picture_top_right_outline":    PictureInPictureTopRightOutline,
-		"pier":                                    Pier,
-		"pier_crane":                              PierCrane,
-		"pig":                                     Pig,
-		"pig_variant":                             PigVariant,
-		"pig_variant_outline":                     PigVariantOutline,
-		"piggy_bank":                              PiggyBank,
-		"piggy_bank_outline":                      PiggyBankOutline,
-		"pill":                                    Pill,
-		"pill_off":                                PillOff,
-		"pillar":                                  Pillar,
-		"pin":                                     Pin,
-		"pin_off":                                 PinOff,
-		"pin_off_outline":                         PinOffOutline,
-		"pin_outline":                             PinOutline,
-		"pine_tree":                               PineTree,
-		"pine_tree_box":                           PineTreeBox,
-		"pine_tree_fire":                          PineTreeFire,
-		"pinterest":                               Pinterest,
-		"pinwheel":                                Pinwheel,
-		"pinwheel_outline":                        PinwheelOutline,
-		"pipe":                                    Pipe,
-		"pipe_disconnected":                       PipeDisconnected,
-		"pipe_leak":                               PipeLeak,
-		"pipe_valve":                              PipeValve,
-		"pipe_wrench":                             PipeWrench,
-		"pirate":                                  Pirate,
-		"pistol":                                  Pistol,
-		"piston":                                  Piston,
-		"pitchfork":                               Pitchfork,
-		"pizza":                                   Pizza,
-		"play":                                    Play,
-		"play_box":                                PlayBox,
-		"play_box_lock":                           PlayBoxLock,
-		"play_box_lock_open":                      PlayBoxLockOpen,
-		"play_box_lock_open_outline":              PlayBoxLockOpenOutline,
-		"play_box_lock_outline":                   PlayBoxLockOutline,
-		"play_box_multiple":                       PlayBoxMultiple,
-		"play_box_multiple_outline":               PlayBoxMultipleOutline,
-		"play_box_outline":                        PlayBoxOutline,
-		"play_circle":                             PlayCircle,
-		"play_circle_outline":                     PlayCircleOutline,
-		"play_network":                            PlayNetwork,
-		"play_network_outline":                    PlayNetworkOutline,
-		"play_outline":                            PlayOutline,
-		"play_pause":                              PlayPause,
-		"play_protected_content":                  PlayProtectedContent,
-		"play_speed":                              PlaySpeed,
-		"playlist_check":                          PlaylistCheck,
-		"playlist_edit":                           PlaylistEdit,
-		"playlist_minus":                          PlaylistMinus,
-		"playlist_music":                          PlaylistMusic,
-		"playlist_music_outline":                  PlaylistMusicOutline,
-		"playlist_play":                           PlaylistPlay,
-		"playlist_plus":                           PlaylistPlus,
-		"playlist_remove":                         PlaylistRemove,
-		"playlist_star":                           PlaylistStar,
-		"plex":                                    Plex,
-		"pliers":                                  Pliers,
-		"plus":                                    Plus,
-		"plus_box":                                PlusBox,
-		"plus_box_multiple":                       PlusBoxMultiple,
-		"plus_box_multiple_outline":               PlusBoxMultipleOutline,
-		"plus_box_outline":                        PlusBoxOutline,
-		"plus_circle":                             PlusCircle,
-		"plus_circle_multiple":                    PlusCircleMultiple,
-		"plus_circle_multiple_outline":            PlusCircleMultipleOutline,
-		"plus_circle_outline":                     PlusCircleOutline,
-		"plus_lock":                               PlusLock,
-		"plus_lock_open":                          PlusLockOpen,
-		"plus_minus":                              PlusMinus,
-		"plus_minus_box":                          PlusMinusBox,
-		"plus_minus_variant":                      PlusMinusVariant,
-		"plus_network":                            PlusNetwork,
-		"plus_network_outline":                    PlusNetworkOutline,
-		"plus_outline":                            PlusOutline,
-		"plus_thick":                              PlusThick,
-		"podcast":                                 Podcast,
-		"podium":                                  Podium,
-		"podium_bronze":                           PodiumBronze,
-		"podium_gold":                             PodiumGold,
-		"podium_silver":                           PodiumSilver,
-		"point_of_sale":                           PointOfSale,
-		"pokeball":                                Pokeball,
-		"pokemon_go":                              PokemonGo,
-		"poker_chip":                              PokerChip,
-		"polaroid":                                Polaroid,
-		"police_badge":                            PoliceBadge,
-		"police_badge_outline":                    PoliceBadgeOutline,
-		"police_station":                          PoliceStation,
-		"poll":                                    Poll,
-		"polo":                                    Polo,
-		"polymer":                                 Polymer,
-		"pool":                                    Pool,
-		"pool_thermometer":                        PoolThermometer,
-		"popcorn":                                 Popcorn,
-		"post":                                    Post,
-		"post_lamp":                               PostLamp,
-		"post_outline":                            PostOutline,
-		"postage_stamp":                           PostageStamp,
-		"pot":                                     Pot,
-		"pot_mix":                                 PotMix,
-		"pot_mix_outline":                         PotMixOutline,
-		"pot_outline":                             PotOutline,
-		"pot_steam":                               PotSteam,
-		"pot_steam_outline":                       PotSteamOutline,
-		"pound":                                   Pound,
-		"pound_box":                               PoundBox,
-		"pound_box_outline":                       PoundBoxOutline,
-		"power":                                   Power,
-		"power_cycle":                             PowerCycle,
-		"power_off":                               PowerOff,
-		"power_on":                                PowerOn,
-		"power_plug":                              PowerPlug,
-		"power_plug_off":                          PowerPlugOff,
-		"power_plug_off_outline":                  PowerPlugOffOutline,
-		"power_plug_outline":                      PowerPlugOutline,
-		"power_settings":                          PowerSettings,
-		"power_sleep":                             PowerSleep,
-		"power_socket":                            PowerSocket,
-		"power_socket_au":                         PowerSocketAu,
-		"power_socket_ch":                         PowerSocketCh,
-		"power_socket_de":                         PowerSocketDe,
-		"power_socket_eu":                         PowerSocketEu,
-		"power_socket_fr":                         PowerSocketFr,
-		"power_socket_it":                         PowerSocketIt,
-		"power_socket_jp":                         PowerSocketJp,
-		"power_socket_uk":                         PowerSocketUk,
-		"power_socket_us":                         PowerSocketUs,
-		"power_standby":                           PowerStandby,
-		"powershell":                              Powershell,
-		"prescription":                            Prescription,
-		"presentation":                            Presentation,
-		"presentation_play":                       PresentationPlay,
-		"pretzel":                                 Pretzel,
-		"printer":                                 Printer,
-		"printer_3d":                              Printer3D,
-		"printer_3d_nozzle":                       Printer3DNozzle,
-		"printer_3d_nozzle_alert":                 Printer3DNozzleAlert,
-		"printer_3d_nozzle_alert_outline":         Printer3DNozzleAlertOutline,
-		"printer_3d_nozzle_heat":                  Printer3DNozzleHeat,
-		"printer_3d_nozzle_heat_outline":          Printer3DNozzleHeatOutline,
-		"printer_3d_nozzle_outline":               Printer3DNozzleOutline,
-		"printer_alert":                           PrinterAlert,
-		"printer_check":                           PrinterCheck,
-		"printer_eye":                             PrinterEye,
-		"printer_off":                             PrinterOff,
-		"printer_off_outline":                     PrinterOffOutline,
-		"printer_outline":                         PrinterOutline,
-		"printer_pos":                             PrinterPos,
-		"printer_search":                          PrinterSearch,
-		"printer_settings":                        PrinterSettings,
-		"printer_wireless":                        PrinterWireless,
-		"priority_high":                           PriorityHigh,
-		"priority_low":                            PriorityLow,
-		"professional_hexagon":                    ProfessionalHexagon,
-		"progress_alert":                          ProgressAlert,
-		"progress_check":                          ProgressCheck,
-		"progress_clock":                          ProgressClock,
-		"progress_close":                          ProgressClose,
-		"progress_download":                       ProgressDownload,
-		"progress_pencil":                         ProgressPencil,
-		"progress_question":                       ProgressQuestion,
-		"progress_star":                           ProgressStar,
-		"progress_upload":                         ProgressUpload,
-		"progress_wrench":                         ProgressWrench,
-		"projector":                               Projector,
-		"projector_off":                           ProjectorOff,
-		"projector_screen":                        ProjectorScreen,
-		"projector_screen_off":                    ProjectorScreenOff,
-		"projector_screen_off_outline":            ProjectorScreenOffOutline,
-		"projector_screen_outline":                ProjectorScreenOutline,
-		"projector_screen_variant":                ProjectorScreenVariant,
-		"projector_screen_variant_off":            ProjectorScreenVariantOff,
-		"projector_screen_variant_off_outline":    ProjectorScreenVariantOffOutline,
-		"projector_screen_variant_outline":        ProjectorScreenVariantOutline,
-		"propane_tank":                            PropaneTank,
-		"propane_tank_outline":                    PropaneTankOutline,
-		"protocol":                                Protocol,
-		"publish":                                 Publish,
-		"publish_off":                             PublishOff,
-		"pulse":                                   Pulse,
-		"pump":                                    Pump,
-		"pumpkin":                                 Pumpkin,
-		"purse":                                   Purse,
-		"purse_outline":                           PurseOutline,
-		"puzzle":                                  Puzzle,
-		"puzzle_check":                            PuzzleCheck,
-		"puzzle_check_outline":                    PuzzleCheckOutline,
-		"puzzle_edit":                             PuzzleEdit,
-		"puzzle_edit_outline":                     PuzzleEditOutline,
-		"puzzle_heart":                            PuzzleHeart,
-		"puzzle_heart_outline":                    PuzzleHeartOutline,
-		"puzzle_minus":                            PuzzleMinus,
-		"puzzle_minus_outline":                    PuzzleMinusOutline,
-		"puzzle_outline":                          PuzzleOutline,
-		"puzzle_plus":                             PuzzlePlus,
-		"puzzle_plus_outline":                     PuzzlePlusOutline,
-		"puzzle_remove":                           PuzzleRemove,
-		"puzzle_remove_outline":                   PuzzleRemoveOutline,
-		"puzzle_star":                             PuzzleStar,
-		"puzzle_star_outline":                     PuzzleStarOutline,
-		"pyramid":                                 Pyramid,
-		"pyramid_off":                             PyramidOff,
-		"qi":                                      Qi,
-		"qqchat":                                  Qqchat,
-		"qrcode":                                  Qrcode,
-		"qrcode_edit":                             QrcodeEdit,
-		"qrcode_minus":                            QrcodeMinus,
-		"qrcode_plus":                             QrcodePlus,
-		"qrcode_remove":                           QrcodeRemove,
-		"qrcode_scan":                             QrcodeScan,
-		"quadcopter":                              Quadcopter,
-		"quality_high":                            QualityHigh,
-		"quality_low":                             QualityLow,
-		"quality_medium":                          QualityMedium,
-		"quora":                                   Quora,
-		"rabbit":                                  Rabbit,
-		"rabbit_variant":                          RabbitVariant,
-		"rabbit_variant_outline":                  RabbitVariantOutline,
-		"racing_helmet":                           RacingHelmet,
-		"racquetball":                             Racquetball,
-		"radar":                                   Radar,
-		"radiator":                                Radiator,
-		"radiator_disabled":                       RadiatorDisabled,
-		"radiator_off":                            RadiatorOff,
-		"radio":                                   Radio,
-		"radio_am":                                RadioAm,
-		"radio_fm":                                RadioFm,
-		"radio_handheld":                          RadioHandheld,
-		"radio_off":                               RadioOff,
-		"radio_tower":                             RadioTower,
-		"radioactive":                             Radioactive,
-		"radioactive_circle":                      RadioactiveCircle,
-		"radioactive_circle_outline":              RadioactiveCircleOutline,
-		"radioactive_off":                         RadioactiveOff,
-		"radiobox_marked":                         RadioboxMarked,
-		"radiology_box":                           RadiologyBox,
-		"radiology_box_outline":                   RadiologyBoxOutline,
-		"radius":                                  Radius,
-		"radius_outline":                          RadiusOutline,
-		"railroad_light":                          RailroadLight,
-		"rake":                                    Rake,
-		"raspberry_pi":                            RaspberryPi,
-		"raw":                                     Raw,
-		"raw_off":                                 RawOff,
-		"ray_end":                                 RayEnd,
-		"ray_end_arrow":                           RayEndArrow,
-		"ray_start":                               RayStart,
-		"ray_start_arrow":                         RayStartArrow,
-		"ray_start_end":                           RayStartEnd,
-		"ray_start_vertex_end":                    RayStartVertexEnd,
-		"ray_vertex":                              RayVertex,
-		"razor_double_edge":                       RazorDoubleEdge,
-		"razor_single_edge":                       RazorSingleEdge,
-		"react":                                   React,
-		"read":                                    Read,
-		"receipt":                                 Receipt,
-		"receipt_outline":                         ReceiptOutline,
-		"receipt_text_check":                      ReceiptTextCheck,
-		"receipt_text_check_outline":              ReceiptTextCheckOutline,
-		"receipt_text_minus":                      ReceiptTextMinus,
-		"receipt_text_minus_outline":              ReceiptTextMinusOutline,
-		"receipt_text_plus":                       ReceiptTextPlus,
-		"receipt_text_plus_outline":               ReceiptTextPlusOutline,
-		"receipt_text_remove":                     ReceiptTextRemove,
-		"receipt_text_remove_outline":             ReceiptTextRemoveOutline,
-		"record":                                  Record,
-		"record_circle":                           RecordCircle,
-		"record_circle_outline":                   RecordCircleOutline,
-		"record_player":                           RecordPlayer,
-		"record_rec":                              RecordRec,
-		"rectangle":                               Rectangle,
-		"rectangle_outline":                       RectangleOutline,
-		"recycle":                                 Recycle,
-		"recycle_variant":                         RecycleVariant,
-		"reddit":                                  Reddit,
-		"redhat":                                  Redhat,
-		"redo":                                    Redo,
-		"redo_variant":                            RedoVariant,
-		"reflect_horizontal":                      ReflectHorizontal,
-		"reflect_vertical":                        ReflectVertical,
-		"refresh":                                 Refresh,
-		"refresh_auto":                            RefreshAuto,
-		"refresh_circle":                          RefreshCircle,
-		"regex":                                   Regex,
-		"registered_trademark":                    RegisteredTrademark,
-		"reiterate":                               Reiterate,
-		"relation_many_to_many":                   RelationManyToMany,
-		"relation_many_to_one":                    RelationManyToOne,
-		"relation_many_to_one_or_many":            RelationManyToOneOrMany,
-		"relation_many_to_only_one":               RelationManyToOnlyOne,
-		"relation_many_to_zero_or_many":           RelationManyToZeroOrMany,
-		"relation_many_to_zero_or_one":            RelationManyToZeroOrOne,
-		"relation_one_or_many_to_many":            RelationOneOrManyToMany,
-		"relation_one_or_many_to_one":             RelationOneOrManyToOne,
-		"relation_one_or_many_to_one_or_many":     RelationOneOrManyToOneOrMany,
-		"relation_one_or_many_to_only_one":        RelationOneOrManyToOnlyOne,
-		"relation_one_or_many_to_zero_or_many":    RelationOneOrManyToZeroOrMany,
-		"relation_one_or_many_to_zero_or_one":     RelationOneOrManyToZeroOrOne,
-		"relation_one_to_many":                    RelationOneToMany,
-		"relation_one_to_one":                     RelationOneToOne,
-		"relation_one_to_one_or_many":             RelationOneToOneOrMany,
-		"relation_one_to_only_one":                RelationOneToOnlyOne,
-		"relation_one_to_zero_or_many":            RelationOneToZeroOrMany,
-		"relation_one_to_zero_or_one":             RelationOneToZeroOrOne,
-		"relation_only_one_to_many":               RelationOnlyOneToMany,
-		"relation_only_one_to_one":                RelationOnlyOneToOne,
-		"relation_only_one_to_one_or_many":        RelationOnlyOneToOneOrMany,
-		"relation_only_one_to_only_one":           RelationOnlyOneToOnlyOne,
-		"relation_only_one_to_zero_or_many":       RelationOnlyOneToZeroOrMany,
-		"relation_only_one_to_zero_or_one":        RelationOnlyOneToZeroOrOne,
-		"relation_zero_or_many_to_many":           RelationZeroOrManyToMany,
-		"relation_zero_or_many_to_one":            RelationZeroOrManyToOne,
-		"relation_zero_or_many_to_one_or_many":    RelationZeroOrManyToOneOrMany,
-		"relation_zero_or_many_to_only_one":       RelationZeroOrManyToOnlyOne,
-		"relation_zero_or_many_to_zero_or_many":   RelationZeroOrManyToZeroOrMany,
-		"relation_zero_or_many_to_zero_or_one":    RelationZeroOrManyToZeroOrOne,
-		"relation_zero_or_one_to_many":            RelationZeroOrOneToMany,
-		"relation_zero_or_one_to_one":             RelationZeroOrOneToOne,
-		"relation_zero_or_one_to_one_or_many":     RelationZeroOrOneToOneOrMany,
-		"relation_zero_or_one_to_only_one":        RelationZeroOrOneToOnlyOne,
-		"relation_zero_or_one_to_zero_or_many":    RelationZeroOrOneToZeroOrMany,
-		"relation_zero_or_one_to_zero_or_one":     RelationZeroOrOneToZeroOrOne,
-		"relative_scale":                          RelativeScale,
-		"reload":                                  Reload,
-		"reload_alert":                            ReloadAlert,
-		"reminder":                                Reminder,
-		"remote":                                  Remote,
-		"remote_desktop":                          RemoteDesktop,
-		"remote_off":                              RemoteOff,
-		"remote_tv":                               RemoteTv,
-		"remote_tv_off":                           RemoteTvOff,
-		"rename_box":                              RenameBox,
-		"reorder_horizontal":                      ReorderHorizontal,
-		"reorder_vertical":                        ReorderVertical,
-		"repeat":                                  Repeat,
-		"repeat_off":                              RepeatOff,
-		"repeat_once":                             RepeatOnce,
-		"repeat_variant":                          RepeatVariant,
-		"replay":                                  Replay,
-		"reply":                                   Reply,
-		"reply_all":                               ReplyAll,
-		"reply_all_outline":                       ReplyAllOutline,
-		"reply_circle":                            ReplyCircle,
-		"reply_outline":                           ReplyOutline,
-		"reproduction":                            Reproduction,
-		"resistor":                                Resistor,
-		"resistor_nodes":                          ResistorNodes,
-		"resize":                                  Resize,
-		"resize_bottom_right":                     ResizeBottomRight,
-		"responsive":                              Responsive,
-		"restart":                                 Restart,
-		"restart_alert":                           RestartAlert,
-		"restart_off":                             RestartOff,
-		"restore":                                 Restore,
-		"restore_alert":                           RestoreAlert,
-		"rewind":                                  Rewind,
-		"rewind_10":                               Rewind10,
-		"rewind_15":                               Rewind15,
-		"rewind_30":                               Rewind30,
-		"rewind_5":                                Rewind5,
-		"rewind_60":                               Rewind60,
-		"rewind_outline":                          RewindOutline,
-		"rhombus":                                 Rhombus,
-		"rhombus_medium":                          RhombusMedium,
-		"rhombus_medium_outline":                  RhombusMediumOutline,
-		"rhombus_outline":                         RhombusOutline,
-		"rhombus_split":                           RhombusSplit,
-		"rhombus_split_outline":                   RhombusSplitOutline,
-		"ribbon":                                  Ribbon,
-		"rice":                                    Rice,
-		"rickshaw":                                Rickshaw,
-		"rickshaw_electric":                       RickshawElectric,
-		"ring":                                    Ring,
-		"rivet":                                   Rivet,
-		"road":                                    Road,
-		"road_variant":                            RoadVariant,
-		"robber":                                  Robber,
-		"robot":                                   Robot,
-		"robot_angry":                             RobotAngry,
-		"robot_angry_outline":                     RobotAngryOutline,
-		"robot_confused":                          RobotConfused,
-		"robot_confused_outline":                  RobotConfusedOutline,
-		"robot_dead":                              RobotDead,
-		"robot_dead_outline":                      RobotDeadOutline,
-		"robot_excited":                           RobotExcited,
-		"robot_excited_outline":                   RobotExcitedOutline,
-		"robot_happy":                             RobotHappy,
-		"robot_happy_outline":                     RobotHappyOutline,
-		"robot_industrial":                        RobotIndustrial,
-		"robot_industrial_outline":                RobotIndustrialOutline,
-		"robot_love":                              RobotLove,
-		"robot_love_outline":                      RobotLoveOutline,
-		"robot_mower":                             RobotMower,
-		"robot_mower_outline":                     RobotMowerOutline,
-		"robot_off":                               RobotOff,
-		"robot_off_outline":                       RobotOffOutline,
-		"robot_outline":                           RobotOutline,
-		"robot_vacuum":                            RobotVacuum,
-		"robot_vacuum_variant":                    RobotVacuumVariant,
-		"rocket":                                  Rocket,
-		"rocket_launch":                           RocketLaunch,
-		"rocket_launch_outline":                   RocketLaunchOutline,
-		"rocket_outline":                          RocketOutline,
-		"rodent":                                  Rodent,
-		"roller_shade":                            RollerShade,
-		"roller_shade_closed":                     RollerShadeClosed,
-		"roller_skate":                            RollerSkate,
-		"roller_skate_off":                        RollerSkateOff,
-		"rollerblade":                             Rollerblade,
-		"rollerblade_off":                         RollerbladeOff,
-		"rollupjs":                                Rollupjs,
-		"rolodex":                                 Rolodex,
-		"rolodex_outline":                         RolodexOutline,
-		"roman_numeral_2":                         RomanNumeral2,
-		"roman_numeral_3":                         RomanNumeral3,
-		"roman_numeral_4":                         RomanNumeral4,
-		"roman_numeral_6":                         RomanNumeral6,
-		"roman_numeral_7":                         RomanNumeral7,
-		"roman_numeral_8":                         RomanNumeral8,
-		"roman_numeral_9":                         RomanNumeral9,
-		"room_service":                            RoomService,
-		"room_service_outline":                    RoomServiceOutline,
-		"rotate_360":                              Rotate360,
-		"rotate_3d":                               Rotate3D,
-		"rotate_3d_variant":                       Rotate3DVariant,
-		"rotate_left":                             RotateLeft,
-		"rotate_left_variant":                     RotateLeftVariant,
-		"rotate_orbit":                            RotateOrbit,
-		"rotate_right":                            RotateRight,
-		"rotate_right_variant":                    RotateRightVariant,
-		"rounded_corner":                          RoundedCorner,
-		"router":                                  Router,
-		"router_network":                          RouterNetwork,
-		"router_wireless":                         RouterWireless,
-		"router_wireless_off":                     RouterWirelessOff,
-		"router_wireless_settings":                RouterWirelessSettings,
-		"routes":                                  Routes,
-		"routes_clock":                            RoutesClock,
-		"rowing":                                  Rowing,
-		"rss":                                     Rss,
-		"rss_box":                                 RssBox,
-		"rss_off":                                 RssOff,
-		"rug":                                     Rug,
-		"rugby":                                   Rugby,
-		"ruler":                                   Ruler,
-		"ruler_square":                            RulerSquare,
-		"ruler_square_compass":                    RulerSquareCompass,
-		"run":                                     Run,
-		"run_fast":                                RunFast,
-		"rv_truck":                                RvTruck,
-		"sack":                                    Sack,
-		"sack_percent":                            SackPercent,
-		"safe":                                    Safe,
-		"safe_square":                             SafeSquare,
-		"safe_square_outline":                     SafeSquareOutline,
-		"safety_goggles":                          SafetyGoggles,
-		"sail_boat":                               SailBoat,
-		"sail_boat_sink":                          SailBoatSink,
-		"sale":                                    Sale,
-		"sale_outline":                            SaleOutline,
-		"salesforce":                              Salesforce,
-		"sass":                                    Sass,
-		"satellite":                               Satellite,
-		"satellite_uplink":                        SatelliteUplink,
-		"satellite_variant":                       SatelliteVariant,
-		"sausage":                                 Sausage,
-		"sausage_off":                             SausageOff,
-		"saw_blade":                               SawBlade,
-		"sawtooth_wave":                           SawtoothWave,
-		"saxophone":                               Saxophone,
-		"scale":                                   Scale,
-		"scale_balance":                           ScaleBalance,
-		"scale_bathroom":                          ScaleBathroom,
-		"scale_off":                               ScaleOff,
-		"scale_unbalanced":                        ScaleUnbalanced,
-		"scan_helper":                             ScanHelper,
-		"scanner":                                 Scanner,
-		"scanner_off":                             ScannerOff,
-		"scatter_plot":                            ScatterPlot,
-		"scatter_plot_outline":                    ScatterPlotOutline,
-		"scent":                                   Scent,
-		"scent_off":                               ScentOff,
-		"school":                                  School,
-		"school_outline":                          SchoolOutline,
-		"scissors_cutting":                        ScissorsCutting,
-		"scooter":                                 Scooter,
-		"scooter_electric":                        ScooterElectric,
-		"scoreboard":                              Scoreboard,
-		"scoreboard_outline":                      ScoreboardOutline,
-		"screen_rotation":                         ScreenRotation,
-		"screen_rotation_lock":                    ScreenRotationLock,
-		"screw_flat_top":                          ScrewFlatTop,
-		"screw_lag":                               ScrewLag,
-		"screw_machine_flat_top":                  ScrewMachineFlatTop,
-		"screw_machine_round_top":                 ScrewMachineRoundTop,
-		"screw_round_top":                         ScrewRoundTop,
-		"screwdriver":                             Screwdriver,
-		"script":                                  Script,
-		"script_outline":                          ScriptOutline,
-		"script_text":                             ScriptText,
-		"script_text_key":                         ScriptTextKey,
-		"script_text_key_outline":                 ScriptTextKeyOutline,
-		"script_text_outline":                     ScriptTextOutline,
-		"script_text_play":                        ScriptTextPlay,
-		"script_text_play_outline":                ScriptTextPlayOutline,
-		"sd":                                      Sd,
-		"seal":                                    Seal,
-		"seal_variant":                            SealVariant,
-		"search_web":                              SearchWeb,
-		"seat":                                    Seat,
-		"seat_flat":                               SeatFlat,
-		"seat_flat_angled":                        SeatFlatAngled,
-		"seat_individual_suite":                   SeatIndividualSuite,
-		"seat_legroom_extra":                      SeatLegroomExtra,
-		"seat_legroom_normal":                     SeatLegroomNormal,
-		"seat_legroom_reduced":                    SeatLegroomReduced,
-		"seat_outline":                            SeatOutline,
-		"seat_passenger":                          SeatPassenger,
-		"seat_recline_extra":                      SeatReclineExtra,
-		"seat_recline_normal":                     SeatReclineNormal,
-		"seatbelt":                                Seatbelt,
-		"security":                                Security,
-		"security_network":                        SecurityNetwork,
-		"seed":                                    Seed,
-		"seed_off":                                SeedOff,
-		"seed_off_outline":                        SeedOffOutline,
-		"seed_outline":                            SeedOutline,
-		"seed_plus":                               SeedPlus,
-		"seed_plus_outline":                       SeedPlusOutline,
-		"seesaw":                                  Seesaw,
-		"segment":                                 Segment,
-		"select":                                  Select,
-		"select_all":                              SelectAll,
-		"select_color":                            SelectColor,
-		"select_compare":                          SelectCompare,
-		"select_drag":                             SelectDrag,
-		"select_group":                            SelectGroup,
-		"select_inverse":                          SelectInverse,
-		"select_marker":                           SelectMarker,
-		"select_multiple":                         SelectMultiple,
-		"select_multiple_marker":                  SelectMultipleMarker,
-		"select_off":                              SelectOff,
-		"select_place":                            SelectPlace,
-		"select_remove":                           SelectRemove,
-		"select_search":                           SelectSearch,
-		"selection":                               Selection,
-		"selection_drag":                          SelectionDrag,
-		"selection_ellipse":                       SelectionEllipse,
-		"selection_ellipse_arrow_inside":          SelectionEllipseArrowInside,
-		"selection_ellipse_remove":                SelectionEllipseRemove,
-		"selection_marker":                        SelectionMarker,
-		"selection_multiple":                      SelectionMultiple,
-		"selection_multiple_marker":               SelectionMultipleMarker,
-		"selection_off":                           SelectionOff,
-		"selection_remove":                        SelectionRemove,
-		"selection_search":                        SelectionSearch,
-		"semantic_web":                            SemanticWeb,
-		"send":                                    Send,
-		"send_check":                              SendCheck,
-		"send_check_outline":                      SendCheckOutline,
-		"send_circle":                             SendCircle,
-		"send_circle_outline":                     SendCircleOutline,
-		"send_clock":                              SendClock,
-		"send_clock_outline":                      SendClockOutline,
-		"send_lock":                               SendLock,
-		"send_lock_outline":                       SendLockOutline,
-		"send_outline":                            SendOutline,
-		"serial_port":                             SerialPort,
-		"server":                                  Server,
-		"server_minus":                            ServerMinus,
-		"server_network":                          ServerNetwork,
-		"server_network_off":                      ServerNetworkOff,
-		"server_off":                              ServerOff,
-		"server_plus":                             ServerPlus,
-		"server_remove":                           ServerRemove,
-		"server_security":                         ServerSecurity,
-		"set_all":                                 SetAll,
-		"set_center":                              SetCenter,
-		"set_center_right":                        SetCenterRight,
-		"set_left":                                SetLeft,
-		"set_left_center":                         SetLeftCenter,
-		"set_left_right":                          SetLeftRight,
-		"set_merge":                               SetMerge,
-		"set_none":                                SetNone,
-		"set_right":                               SetRight,
-		"set_split":                               SetSplit,
-		"set_square":                              SetSquare,
-		"set_top_box":                             SetTopBox,
-		"settings_helper":                         SettingsHelper,
-		"shaker":                                  Shaker,
-		"shaker_outline":                          ShakerOutline,
-		"shape":                                   Shape,
-		"shape_circle_plus":                       ShapeCirclePlus,
-		"shape_outline":                           ShapeOutline,
-		"shape_oval_plus":                         ShapeOvalPlus,
-		"shape_plus":                              ShapePlus,
-		"shape_polygon_plus":                      ShapePolygonPlus,
-		"shape_rectangle_plus":                    ShapeRectanglePlus,
-		"shape_square_plus":                       ShapeSquarePlus,
-		"shape_square_rounded_plus":               ShapeSquareRoundedPlus,
-		"share":                                   Share,
-		"share_all":                               ShareAll,
-		"share_all_outline":                       ShareAllOutline,
-		"share_circle":                            ShareCircle,
-		"share_off":                               ShareOff,
-		"share_off_outline":                       ShareOffOutline,
-		"share_outline":                           ShareOutline,
-		"share_variant":                           ShareVariant,
-		"share_variant_outline":                   ShareVariantOutline,
-		"shark":                                   Shark,
-		"shark_fin":                               SharkFin,
-		"shark_fin_outline":                       SharkFinOutline,
-		"shark_off":                               SharkOff,
-		"sheep":                                   Sheep,
-		"shield":                                  Shield,
-		"shield_account":                          ShieldAccount,
-		"shield_account_outline":                  ShieldAccountOutline,
-		"shield_account_variant":                  ShieldAccountVariant,
-		"shield_account_variant_outline":          ShieldAccountVariantOutline,
-		"shield_airplane":                         ShieldAirplane,
-		"shield_airplane_outline":                 ShieldAirplaneOutline,
-		"shield_alert":                            ShieldAlert,
-		"shield_alert_outline":                    ShieldAlertOutline,
-		"shield_bug":                              ShieldBug,
-		"shield_bug_outline":                      ShieldBugOutline,
-		"shield_car":                              ShieldCar,
-		"shield_check":                            ShieldCheck,
-		"shield_check_outline":                    ShieldCheckOutline,
-		"shield_cross":                            ShieldCross,
-		"shield_cross_outline":                    ShieldCrossOutline,
-		"shield_crown":                            ShieldCrown,
-		"shield_crown_outline":                    ShieldCrownOutline,
-		"shield_edit":                             ShieldEdit,
-		"shield_edit_outline":                     ShieldEditOutline,
-		"shield_half":                             ShieldHalf,
-		"shield_half_full":                        ShieldHalfFull,
-		"shield_home":                             ShieldHome,
-		"shield_home_outline":                     ShieldHomeOutline,
-		"shield_key":                              ShieldKey,
-		"shield_key_outline":                      ShieldKeyOutline,
-		"shield_link_variant":                     ShieldLinkVariant,
-		"shield_link_variant_outline":             ShieldLinkVariantOutline,
-		"shield_lock":                             ShieldLock,
-		"shield_lock_open":                        ShieldLockOpen,
-		"shield_lock_open_outline":                ShieldLockOpenOutline,
-		"shield_lock_outline":                     ShieldLockOutline,
-		"shield_moon":                             ShieldMoon,
-		"shield_moon_outline":                     ShieldMoonOutline,
-		"shield_off":                              ShieldOff,
-		"shield_off_outline":                      ShieldOffOutline,
-		"shield_outline":                          ShieldOutline,
-		"shield_plus":                             ShieldPlus,
-		"shield_plus_outline":                     ShieldPlusOutline,
-		"shield_refresh":                          ShieldRefresh,
-		"shield_refresh_outline":                  ShieldRefreshOutline,
-		"shield_remove":                           ShieldRemove,
-		"shield_remove_outline":                   ShieldRemoveOutline,
-		"shield_search":                           ShieldSearch,
-		"shield_star":                             ShieldStar,
-		"shield_star_outline":                     ShieldStarOutline,
-		"shield_sun":                              ShieldSun,
-		"shield_sun_outline":                      ShieldSunOutline,
-		"shield_sword":                            ShieldSword,
-		"shield_sword_outline":                    ShieldSwordOutline,
-		"shield_sync":                             ShieldSync,
-		"shield_sync_outline":                     ShieldSyncOutline,
-		"shimmer":                                 Shimmer,
-		"ship_wheel":                              ShipWheel,
-		"shipping_pallet":                         ShippingPallet,
-		"shoe_ballet":                             ShoeBallet,
-		"shoe_cleat":                              ShoeCleat,
-		"shoe_formal":                             ShoeFormal,
-		"shoe_heel":                               ShoeHeel,
-		"shoe_print":                              ShoePrint,
-		"shoe_sneaker":                            ShoeSneaker,
-		"shopping":                                Shopping,
-		"shopping_music":                          ShoppingMusic,
-		"shopping_outline":                        ShoppingOutline,
-		"shopping_search":                         ShoppingSearch,
-		"shopping_search_outline":                 ShoppingSearchOutline,
-		"shore":                                   Shore,
-		"shovel":                                  Shovel,
-		"shovel_off":                              ShovelOff,
-		"shower":                                  Shower,
-		"shower_head":                             ShowerHead,
-		"shredder":                                Shredder,
-		"shuffle":                                 Shuffle,
-		"shuffle_disabled":                        ShuffleDisabled,
-		"shuffle_variant":                         ShuffleVariant,
-		"shuriken":                                Shuriken,
-		"sickle":                                  Sickle,
-		"sigma":                                   Sigma,
-		"sigma_lower":                             SigmaLower,
-		"sign_caution":                            SignCaution,
-		"sign_direction":                          SignDirection,
-		"sign_direction_minus":                    SignDirectionMinus,
-		"sign_direction_plus":                     SignDirectionPlus,
-		"sign_direction_remove":                   SignDirectionRemove,
-		"sign_pole":                               SignPole,
-		"sign_real_estate":                        SignRealEstate,
-		"sign_text":                               SignText,
-		"signal":                                  Signal,
-		"signal_2g":                               Signal2G,
-		"signal_3g":                               Signal3G,
-		"signal_4g":                               Signal4G,
-		"signal_5g":                               Signal5G,
-		"signal_cellular_1":                       SignalCellular1,
-		"signal_cellular_2":                       SignalCellular2,
-		"signal_cellular_3":                       SignalCellular3,
-		"signal_cellular_outline":                 SignalCellularOutline,
-		"signal_distance_variant":                 SignalDistanceVariant,
-		"signal_hspa":                             SignalHspa,
-		"signal_hspa_plus":                        SignalHspaPlus,
-		"signal_off":                              SignalOff,
-		"signal_variant":                          SignalVariant,
-		"signature":                               Signature,
-		"signature_freehand":                      SignatureFreehand,
-		"signature_image":                         SignatureImage,
-		"signature_text":                          SignatureText,
-		"silo":                                    Silo,
-		"silverware":                              Silverware,
-		"silverware_clean":                        SilverwareClean,
-		"silverware_fork":                         SilverwareFork,
-		"silverware_fork_knife":                   SilverwareForkKnife,
-		"silverware_spoon":                        SilverwareSpoon,
-		"silverware_variant":                      SilverwareVariant,
-		"sim":                                     Sim,
-		"sim_alert":                               SimAlert,
-		"sim_alert_outline":                       SimAlertOutline,
-		"sim_off":                                 SimOff,
-		"sim_off_outline":                         SimOffOutline,
-		"sim_outline":                             SimOutline,
-		"simple_icons":                            SimpleIcons,
-		"sina_weibo":                              SinaWeibo,
-		"sine_wave":                               SineWave,
-		"sitemap":                                 Sitemap,
-		"sitemap_outline":                         SitemapOutline,
-		"size_m":                                  SizeM,
-		"size_s":                                  SizeS,
-		"size_xl":                                 SizeXl,
-		"size_xs":                                 SizeXs,
-		"size_xxl":                                SizeXxl,
-		"size_xxs":                                SizeXxs,
-		"size_xxxl":                               SizeXxxl,
-		"skate":                                   Skate,
-		"skate_off":                               SkateOff,
-		"skateboard":                              Skateboard,
-		"skateboarding":                           Skateboarding,
-		"skew_less":                               SkewLess,
-		"skew_more":                               SkewMore,
-		"ski":                                     Ski,
-		"ski_cross_country":                       SkiCrossCountry,
-		"ski_water":                               SkiWater,
-		"skip_backward":                           SkipBackward,
-		"skip_backward_outline":                   SkipBackwardOutline,
-		"skip_forward":                            SkipForward,
-		"skip_forward_outline":                    SkipForwardOutline,
-		"skip_next":                               SkipNext,
-		"skip_next_circle":                        SkipNextCircle,
-		"skip_next_circle_outline":                SkipNextCircleOutline,
-		"skip_next_outline":                       SkipNextOutline,
-		"skip_previous":                           SkipPrevious,
-		"skip_previous_circle":                    SkipPreviousCircle,
-		"skip_previous_circle_outline":            SkipPreviousCircleOutline,
-		"skip_previous_outline":                   SkipPreviousOutline,
-		"skull":                                   Skull,
-		"skull_crossbones":                        SkullCrossbones,
-		"skull_crossbones_outline":                SkullCrossbonesOutline,
-		"skull_outline":                           SkullOutline,
-		"skull_scan":                              SkullScan,
-		"skull_scan_outline":                      SkullScanOutline,
-		"skype":                                   Skype,
-		"skype_business":                          SkypeBusiness,
-		"slack":                                   Slack,
-		"slash_forward":                           SlashForward,
-		"slash_forward_box":                       SlashForwardBox,
-		"sledding":                                Sledding,
-		"sleep":                                   Sleep,
-		"sleep_off":                               SleepOff,
-		"slide":                                   Slide,
-		"slope_downhill":                          SlopeDownhill,
-		"slope_uphill":                            SlopeUphill,
-		"slot_machine":                            SlotMachine,
-		"slot_machine_outline":                    SlotMachineOutline,
-		"smart_card":                              SmartCard,
-		"smart_card_off":                          SmartCardOff,
-		"smart_card_off_outline":                  SmartCardOffOutline,
-		"smart_card_outline":                      SmartCardOutline,
-		"smart_card_reader":                       SmartCardReader,
-		"smart_card_reader_outline":               SmartCardReaderOutline,
-		"smog":                                    Smog,
-		"smoke":                                   Smoke,
-		"smoke_detector":                          SmokeDetector,
-		"smoke_detector_alert":                    SmokeDetectorAlert,
-		"smoke_detector_alert_outline":            SmokeDetectorAlertOutline,
-		"smoke_detector_off":                      SmokeDetectorOff,
-		"smoke_detector_off_outline":              SmokeDetectorOffOutline,
-		"smoke_detector_outline":                  SmokeDetectorOutline,
-		"smoke_detector_variant":                  SmokeDetectorVariant,
-		"smoke_detector_variant_alert":            SmokeDetectorVariantAlert,
-		"smoke_detector_variant_off":              SmokeDetectorVariantOff,
-		"smoking":                                 Smoking,
-		"smoking_off":                             SmokingOff,
-		"smoking_pipe":                            SmokingPipe,
-		"smoking_pipe_off":                        SmokingPipeOff,
-		"snail":                                   Snail,
-		"snake":                                   Snake,
-		"snapchat":                                Snapchat,
-		"snowboard":                               Snowboard,
-		"snowflake":                               Snowflake,
-		"snowflake_alert":                         SnowflakeAlert,
-		"snowflake_check":                         SnowflakeCheck,
-		"snowflake_melt":                          SnowflakeMelt,
-		"snowflake_off":                           SnowflakeOff,
-		"snowflake_thermometer":                   SnowflakeThermometer,
-		"snowflake_variant":                       SnowflakeVariant,
-		"snowman":                                 Snowman,
-		"snowmobile":                              Snowmobile,
-		"snowshoeing":                             Snowshoeing,
-		"soccer":                                  Soccer,
-		"soccer_field":                            SoccerField,
-		"social_distance_2_meters":                SocialDistance2Meters,
-		"social_distance_6_feet":                  SocialDistance6Feet,
-		"sofa":                                    Sofa,
-		"sofa_outline":                            SofaOutline,
-		"sofa_single":                             SofaSingle,
-		"sofa_single_outline":                     SofaSingleOutline,
-		"solar_panel":                             SolarPanel,
-		"solar_panel_large":                       SolarPanelLarge,
-		"solar_power":                             SolarPower,
-		"solar_power_variant":                     SolarPowerVariant,
-		"solar_power_variant_outline":             SolarPowerVariantOutline,
-		"soldering_iron":                          SolderingIron,
-		"solid":                                   Solid,
-		"sony_playstation":                        SonyPlaystation,
-		"sort":                                    Sort,
-		"sort_alphabetical_ascending":             SortAlphabeticalAscending,
-		"sort_alphabetical_ascending_variant":     SortAlphabeticalAscendingVariant,
-		"sort_alphabetical_descending":            SortAlphabeticalDescending,
-		"sort_alphabetical_descending_variant":    SortAlphabeticalDescendingVariant,
-		"sort_alphabetical_variant":               SortAlphabeticalVariant,
-		"sort_ascending":                          SortAscending,
-		"sort_bool_ascending":                     SortBoolAscending,
-		"sort_bool_ascending_variant":             SortBoolAscendingVariant,
-		"sort_bool_descending":                    SortBoolDescending,
-		"sort_bool_descending_variant":            SortBoolDescendingVariant,
-		"sort_calendar_ascending":                 SortCalendarAscending,
-		"sort_calendar_descending":                SortCalendarDescending,
-		"sort_clock_ascending":                    SortClockAscending,
-		"sort_clock_ascending_outline":            SortClockAscendingOutline,
-		"sort_clock_descending":                   SortClockDescending,
-		"sort_clock_descending_outline":           SortClockDescendingOutline,
-		"sort_descending":                         SortDescending,
-		"sort_numeric_ascending":                  SortNumericAscending,
-		"sort_numeric_ascending_variant":          SortNumericAscendingVariant,
-		"sort_numeric_descending":                 SortNumericDescending,
-		"sort_numeric_descending_variant":         SortNumericDescendingVariant,
-		"sort_numeric_variant":                    SortNumericVariant,
-		"sort_reverse_variant":                    SortReverseVariant,
-		"sort_variant":                            SortVariant,
-		"sort_variant_lock":                       SortVariantLock,
-		"sort_variant_lock_open":                  SortVariantLockOpen,
-		"sort_variant_off":                        SortVariantOff,
-		"sort_variant_remove":                     SortVariantRemove,
-		"soundbar":                                Soundbar,
-		"soundcloud":                              Soundcloud,
-		"source_branch":                           SourceBranch,
-		"source_branch_check":                     SourceBranchCheck,
-		"source_branch_minus":                     SourceBranchMinus,
-		"source_branch_plus":                      SourceBranchPlus,
-		"source_branch_refresh":                   SourceBranchRefresh,
-		"source_branch_remove":                    SourceBranchRemove,
-		"source_branch_sync":                      SourceBranchSync,
-		"source_commit":                           SourceCommit,
-		"source_commit_end":                       SourceCommitEnd,
-		"source_commit_end_local":                 SourceCommitEndLocal,
-		"source_commit_local":                     SourceCommitLocal,
-		"source_commit_next_local":                SourceCommitNextLocal,
-		"source_commit_start":                     SourceCommitStart,
-		"source_commit_start_next_local":          SourceCommitStartNextLocal,
-		"source_fork":                             SourceFork,
-		"source_merge":                            SourceMerge,
-		"source_pull":                             SourcePull,
-		"source_repository":                       SourceRepository,
-		"source_repository_multiple":              SourceRepositoryMultiple,
-		"soy_sauce":                               SoySauce,
-		"soy_sauce_off":                           SoySauceOff,
-		"spa":                                     Spa,
-		"spa_outline":                             SpaOutline,
-		"space_invaders":                          SpaceInvaders,
-		"space_station":                           SpaceStation,
-		"spade":                                   Spade,
-		"speaker":                                 Speaker,
-		"speaker_bluetooth":                       SpeakerBluetooth,
-		"speaker_multiple":                        SpeakerMultiple,
-		"speaker_off":                             SpeakerOff,
-		"speaker_wireless":                        SpeakerWireless,
-		"spear":                                   Spear,
-		"speedometer":                             Speedometer,
-		"speedometer_medium":                      SpeedometerMedium,
-		"speedometer_slow":                        SpeedometerSlow,
-		"spellcheck":                              Spellcheck,
-		"sphere":                                  Sphere,
-		"sphere_off":                              SphereOff,
-		"spider":                                  Spider,
-		"spider_thread":                           SpiderThread,
-		"spider_web":                              SpiderWeb,
-		"spirit_level":                            SpiritLevel,
-		"spoon_sugar":                             SpoonSugar,
-		"spotify":                                 Spotify,
-		"spotlight":                               Spotlight,
-		"spotlight_beam":                          SpotlightBeam,
-		"spray":                                   Spray,
-		"spray_bottle":                            SprayBottle,
-		"sprinkler":                               Sprinkler,
-		"sprinkler_fire":                          SprinklerFire,
-		"sprinkler_variant":                       SprinklerVariant,
-		"sprout":                                  Sprout,
-		"sprout_outline":                          SproutOutline,
-		"square":                                  Square,
-		"square_circle":                           SquareCircle,
-		"square_edit_outline":                     SquareEditOutline,
-		"square_medium":                           SquareMedium,
-		"square_medium_outline":                   SquareMediumOutline,
-		"square_off":                              SquareOff,
-		"square_off_outline":                      SquareOffOutline,
-		"square_opacity":                          SquareOpacity,
-		"square_outline":                          SquareOutline,
-		"square_root":                             SquareRoot,
-		"square_root_box":                         SquareRootBox,
-		"square_rounded":                          SquareRounded,
-		"square_rounded_badge":                    SquareRoundedBadge,
-		"square_rounded_badge_outline":            SquareRoundedBadgeOutline,
-		"square_rounded_outline":                  SquareRoundedOutline,
-		"square_small":                            SquareSmall,
-		"square_wave":                             SquareWave,
-		"squeegee":                                Squeegee,
-		"ssh":                                     Ssh,
-		"stack_exchange":                          StackExchange,
-		"stack_overflow":                          StackOverflow,
-		"stackpath":                               Stackpath,
-		"stadium":                                 Stadium,
-		"stadium_variant":                         StadiumVariant,
-		"stairs":                                  Stairs,
-		"stairs_box":                              StairsBox,
-		"stairs_down":                             StairsDown,
-		"stairs_up":                               StairsUp,
-		"stamper":                                 Stamper,
-		"standard_definition":                     StandardDefinition,
-		"star":                                    Star,
-		"star_box":                                StarBox,
-		"star_box_multiple":                       StarBoxMultiple,
-		"star_box_multiple_outline":               StarBoxMultipleOutline,
-		"star_box_outline":                        StarBoxOutline,
-		"star_check":                              StarCheck,
-		"star_check_outline":                      StarCheckOutline,
-		"star_circle":                             StarCircle,
-		"star_circle_outline":                     StarCircleOutline,
-		"star_cog":                                StarCog,
-		"star_cog_outline":                        StarCogOutline,
-		"star_crescent":                           StarCrescent,
-		"star_david":                              StarDavid,
-		"star_face":                               StarFace,
-		"star_four_points":                        StarFourPoints,
-		"star_four_points_outline":                StarFourPointsOutline,
-		"star_half":                               StarHalf,
-		"star_half_full":                          StarHalfFull,
-		"star_minus":                              StarMinus,
-		"star_minus_outline":                      StarMinusOutline,
-		"star_off":                                StarOff,
-		"star_off_outline":                        StarOffOutline,
-		"star_outline":                            StarOutline,
-		"star_plus":                               StarPlus,
-		"star_plus_outline":                       StarPlusOutline,
-		"star_remove":                             StarRemove,
-		"star_remove_outline":                     StarRemoveOutline,
-		"star_settings":                           StarSettings,
-		"star_settings_outline":                   StarSettingsOutline,
-		"star_shooting":                           StarShooting,
-		"star_shooting_outline":                   StarShootingOutline,
-		"star_three_points":                       StarThreePoints,
-		"star_three_points_outline":               StarThreePointsOutline,
-		"state_machine":                           StateMachine,
-		"steam":                                   Steam,
-		"steering":                                Steering,
-		"steering_off":                            SteeringOff,
-		"step_backward":                           StepBackward,
-		"step_backward_2":                         StepBackward2,
-		"step_forward":                            StepForward,
-		"step_forward_2":                          StepForward2,
-		"stethoscope":                             Stethoscope,
-		"sticker":                                 Sticker,
-		"sticker_alert":                           StickerAlert,
-		"sticker_alert_outline":                   StickerAlertOutline,
-		"sticker_check":                           StickerCheck,
-		"sticker_check_outline":                   StickerCheckOutline,
-		"sticker_circle_outline":                  StickerCircleOutline,
-		"sticker_emoji":                           StickerEmoji,
-		"sticker_minus":                           StickerMinus,
-		"sticker_minus_outline":                   StickerMinusOutline,
-		"sticker_outline":                         StickerOutline,
-		"sticker_plus":                            StickerPlus,
-		"sticker_plus_outline":                    StickerPlusOutline,
-		"sticker_remove":                          StickerRemove,
-		"sticker_remove_outline":                  StickerRemoveOutline,
-		"sticker_text":                            StickerText,
-		"sticker_text_outline":                    StickerTextOutline,
-		"stocking":                                Stocking,
-		"stomach":                                 Stomach,
-		"stool":                                   Stool,
-		"stool_outline":                           StoolOutline,
-		"stop":                                    Stop,
-		"stop_circle":                             StopCircle,
-		"stop_circle_outline":                     StopCircleOutline,
-		"storage_tank":                            StorageTank,
-		"storage_tank_outline":                    StorageTankOutline,
-		"store":                                   Store,
-		"store_24_hour":                           Store24Hour,
-		"store_alert":                             StoreAlert,
-		"store_alert_outline":                     StoreAlertOutline,
-		"store_check":                             StoreCheck,
-		"store_check_outline":                     StoreCheckOutline,
-		"store_clock":                             StoreClock,
-		"store_clock_outline":                     StoreClockOutline,
-		"store_cog":                               StoreCog,
-		"store_cog_outline":                       StoreCogOutline,
-		"store_edit":                              StoreEdit,
-		"store_edit_outline":                      StoreEditOutline,
-		"store_marker":                            StoreMarker,
-		"store_marker_outline":                    StoreMarkerOutline,
-		"store_minus":                             StoreMinus,
-		"store_minus_outline":                     StoreMinusOutline,
-		"store_off":                               StoreOff,
-		"store_off_outline":                       StoreOffOutline,
-		"store_outline":                           StoreOutline,
-		"store_plus":                              StorePlus,
-		"store_plus_outline":                      StorePlusOutline,
-		"store_remove":                            StoreRemove,
-		"store_remove_outline":                    StoreRemoveOutline,
-		"store_search":                            StoreSearch,
-		"store_search_outline":                    StoreSearchOutline,
-		"store_settings":                          StoreSettings,
-		"store_settings_outline":                  StoreSettingsOutline,
-		"storefront":                              Storefront,
-		"storefront_outline":                      StorefrontOutline,
-		"stove":                                   Stove,
-		"strategy":                                Strategy,
-		"stretch_to_page":                         StretchToPage,
-		"stretch_to_page_outline":                 StretchToPageOutline,
-		"string_lights":                           StringLights,
-		"string_lights_off":                       StringLightsOff,
-		"subdirectory_arrow_left":                 SubdirectoryArrowLeft,
-		"subdirectory_arrow_right":                SubdirectoryArrowRight,
-		"submarine":                               Submarine,
-		"subtitles":                               Subtitles,
-		"subtitles_outline":                       SubtitlesOutline,
-		"subway":                                  Subway,
-		"subway_alert_variant":                    SubwayAlertVariant,
-		"subway_variant":                          SubwayVariant,
-		"summit":                                  Summit,
-		"sun_clock":                               SunClock,
-		"sun_clock_outline":                       SunClockOutline,
-		"sun_compass":                             SunCompass,
-		"sun_snowflake":                           SunSnowflake,
-		"sun_snowflake_variant":                   SunSnowflakeVariant,
-		"sun_thermometer":                         SunThermometer,
-		"sun_thermometer_outline":                 SunThermometerOutline,
-		"sun_wireless":                            SunWireless,
-		"sun_wireless_outline":                    SunWirelessOutline,
-		"sunglasses":                              Sunglasses,
-		"surfing":                                 Surfing,
-		"surround_sound":                          SurroundSound,
-		"surround_sound_2_0":                      SurroundSound20,
-		"surround_sound_2_1":                      SurroundSound21,
-		"surround_sound_3_1":                      SurroundSound31,
-		"surround_sound_5_1":                      SurroundSound51,
-		"surround_sound_5_1_2":                    SurroundSound512,
-		"surround_sound_7_1":                      SurroundSound71,
-		"svg":                                     Svg,
-		"swap_horizontal":                         SwapHorizontal,
-		"swap_horizontal_bold":                    SwapHorizontalBold,
-		"swap_horizontal_circle":                  SwapHorizontalCircle,
-		"swap_horizontal_circle_outline":          SwapHorizontalCircleOutline,
-		"swap_horizontal_variant":                 SwapHorizontalVariant,
-		"swap_vertical":                           SwapVertical,
-		"swap_vertical_bold":                      SwapVerticalBold,
-		"swap_vertical_circle":                    SwapVerticalCircle,
-		"swap_vertical_circle_outline":            SwapVerticalCircleOutline,
-		"swap_vertical_variant":                   SwapVerticalVariant,
-		"swim":                                    Swim,
-		"switch":                                  Switch,
-		"sword":                                   Sword,
-		"sword_cross":                             SwordCross,
-		"syllabary_hangul":                        SyllabaryHangul,
-		"syllabary_hiragana":                      SyllabaryHiragana,
-		"syllabary_katakana":                      SyllabaryKatakana,
-		"syllabary_katakana_halfwidth":            SyllabaryKatakanaHalfwidth,
-		"symbol":                                  Symbol,
-		"symfony":                                 Symfony,
-		"sync":                                    Sync,
-		"sync_alert":                              SyncAlert,
-		"sync_circle":                             SyncCircle,
-		"sync_off":                                SyncOff,
-		"tab":                                     Tab,
-		"tab_minus":                               TabMinus,
-		"tab_plus":                                TabPlus,
-		"tab_remove":                              TabRemove,
-		"tab_search":                              TabSearch,
-		"tab_unselected":                          TabUnselected,
-		"table":                                   Table,
-		"table_account":                           TableAccount,
-		"table_alert":                             TableAlert,
-		"table_arrow_down":                        TableArrowDown,
-		"table_arrow_left":                        TableArrowLeft,
-		"table_arrow_right":                       TableArrowRight,
-		"table_arrow_up":                          TableArrowUp,
-		"table_border":                            TableBorder,
-		"table_cancel":                            TableCancel,
-		"table_chair":                             TableChair,
-		"table_check":                             TableCheck,
-		"table_clock":                             TableClock,
-		"table_cog":                               TableCog,
-		"table_column":                            TableColumn,
-		"table_column_plus_after":                 TableColumnPlusAfter,
-		"table_column_plus_before":                TableColumnPlusBefore,
-		"table_column_remove":                     TableColumnRemove,
-		"table_column_width":                      TableColumnWidth,
-		"table_edit":                              TableEdit,
-		"table_eye":                               TableEye,
-		"table_eye_off":                           TableEyeOff,
-		"table_furniture":                         TableFurniture,
-		"table_headers_eye":                       TableHeadersEye,
-		"table_headers_eye_off":                   TableHeadersEyeOff,
-		"table_heart":                             TableHeart,
-		"table_key":                               TableKey,
-		"table_large":                             TableLarge,
-		"table_large_plus":                        TableLargePlus,
-		"table_large_remove":                      TableLargeRemove,
-		"table_lock":                              TableLock,
-		"table_merge_cells":                       TableMergeCells,
-		"table_minus":                             TableMinus,
-		"table_multiple":                          TableMultiple,
-		"table_network":                           TableNetwork,
-		"table_of_contents":                       TableOfContents,
-		"table_off":                               TableOff,
-		"table_picnic":                            TablePicnic,
-		"table_pivot":                             TablePivot,
-		"table_plus":                              TablePlus,
-		"table_refresh":                           TableRefresh,
-		"table_remove":                            TableRemove,
-		"table_row":                               TableRow,
-		"table_row_height":                        TableRowHeight,
-		"table_row_plus_after":                    TableRowPlusAfter,
-		"table_row_plus_before":                   TableRowPlusBefore,
-		"table_row_remove":                        TableRowRemove,
-		"table_search":                            TableSearch,
-		"table_settings":                          TableSettings,
-		"table_split_cell":                        TableSplitCell,
-		"table_star":                              TableStar,
-		"table_sync":                              TableSync,
-		"table_tennis":                            TableTennis,
-		"tablet":                                  Tablet,
-		"tablet_android":                          TabletAndroid,
-		"tablet_cellphone":                        TabletCellphone,
-		"tablet_dashboard":                        TabletDashboard,
-		"taco":                                    Taco,
-		"tag":                                     Tag,
-		"tag_arrow_down":                          TagArrowDown,
-		"tag_arrow_down_outline":                  TagArrowDownOutline,
-		"tag_arrow_left":                          TagArrowLeft,
-		"tag_arrow_left_outline":                  TagArrowLeftOutline,
-		"tag_arrow_right":                         TagArrowRight,
-		"tag_arrow_right_outline":                 TagArrowRightOutline,
-		"tag_arrow_up":                            TagArrowUp,
-		"tag_arrow_up_outline":                    TagArrowUpOutline,
-		"tag_check":                               TagCheck,
-		"tag_check_outline":                       TagCheckOutline,
-		"tag_faces":                               TagFaces,
-		"tag_heart":                               TagHeart,
-		"tag_heart_outline":                       TagHeartOutline,
-		"tag_minus":                               TagMinus,
-		"tag_minus_outline":                       TagMinusOutline,
-		"tag_multiple":                            TagMultiple,
-		"tag_multiple_outline":                    TagMultipleOutline,
-		"tag_off":                                 TagOff,
-		"tag_off_outline":                         TagOffOutline,
-		"tag_outline":                             TagOutline,
-		"tag_plus":                                TagPlus,
-		"tag_plus_outline":                        TagPlusOutline,
-		"tag_remove":                              TagRemove,
-		"tag_remove_outline":                      TagRemoveOutline,
-		"tag_search":                              TagSearch,
-		"tag_search_outline":                      TagSearchOutline,
-		"tag_text":                                TagText,
-		"tag_text_outline":                        TagTextOutline,
-		"tailwind":                                Tailwind,
-		"tally_mark_1":                            TallyMark1,
-		"tally_mark_2":                            TallyMark2,
-		"tally_mark_3":                            TallyMark3,
-		"tally_mark_4":                            TallyMark4,
-		"tally_mark_5":                            TallyMark5,
-		"tangram":                                 Tangram,
-		"tank":                                    Tank,
-		"tanker_truck":                            TankerTruck,
-		"tape_drive":                              TapeDrive,
-		"tape_measure":                            TapeMeasure,
-		"target":                                  Target,
-		"target_account":                          TargetAccount,
-		"target_variant":                          TargetVariant,
-		"taxi":                                    Taxi,
-		"tea":                                     Tea,
-		"tea_outline":                             TeaOutline,
-		"teamviewer":                              Teamviewer,
-		"teddy_bear":                              TeddyBear,
-		"telescope":                               Telescope,
-		"television":                              Television,
-		"television_ambient_light":                TelevisionAmbientLight,
-		"television_box":                          TelevisionBox,
-		"television_classic":                      TelevisionClassic,
-		"television_classic_off":                  TelevisionClassicOff,
-		"television_guide":                        TelevisionGuide,
-		"television_off":                          TelevisionOff,
-		"television_pause":                        TelevisionPause,
-		"television_play":                         TelevisionPlay,
-		"television_shimmer":                      TelevisionShimmer,
-		"television_stop":                         TelevisionStop,
-		"temperature_celsius":                     TemperatureCelsius,
-		"temperature_fahrenheit":                  TemperatureFahrenheit,
-		"temperature_kelvin":                      TemperatureKelvin,
-		"tennis":                                  Tennis,
-		"tennis_ball":                             TennisBall,
-		"tent":                                    Tent,
-		"terraform":                               Terraform,
-		"test_tube":                               TestTube,
-		"test_tube_empty":                         TestTubeEmpty,
-		"test_tube_off":                           TestTubeOff,
-		"text":                                    Text,
-		"text_account":                            TextAccount,
-		"text_box":                                TextBox,
-		"text_box_check":                          TextBoxCheck,
-		"text_box_check_outline":                  TextBoxCheckOutline,
-		"text_box_edit":                           TextBoxEdit,
-		"text_box_edit_outline":                   TextBoxEditOutline,
-		"text_box_minus":                          TextBoxMinus,
-		"text_box_minus_outline":                  TextBoxMinusOutline,
-		"text_box_multiple":                       TextBoxMultiple,
-		"text_box_multiple_outline":               TextBoxMultipleOutline,
-		"text_box_outline":                        TextBoxOutline,
-		"text_box_plus":                           TextBoxPlus,
-		"text_box_plus_outline":                   TextBoxPlusOutline,
-		"text_box_remove":                         TextBoxRemove,
-		"text_box_remove_outline":                 TextBoxRemoveOutline,
-		"text_box_search":                         TextBoxSearch,
-		"text_box_search_outline":                 TextBoxSearchOutline,
-		"text_long":                               TextLong,
-		"text_recognition":                        TextRecognition,
-		"text_search":                             TextSearch,
-		"text_search_variant":                     TextSearchVariant,
-		"text_shadow":                             TextShadow,
-		"text_short":                              TextShort,
-		"text_to_speech":                          TextToSpeech,
-		"text_to_speech_off":                      TextToSpeechOff,
-		"texture":                                 Texture,
-		"texture_box":                             TextureBox,
-		"theater":                                 Theater,
-		"theme_light_dark":                        ThemeLightDark,
-		"thermometer":                             Thermometer,
-		"thermometer_alert":                       ThermometerAlert,
-		"thermometer_bluetooth":                   ThermometerBluetooth,
-		"thermometer_check":                       ThermometerCheck,
-		"thermometer_chevron_down":                ThermometerChevronDown,
-		"thermometer_chevron_up":                  ThermometerChevronUp,
-		"thermometer_high":                        ThermometerHigh,
-		"thermometer_lines":                       ThermometerLines,
-		"thermometer_low":                         ThermometerLow,
-		"thermometer_minus":                       ThermometerMinus,
-		"thermometer_off":                         ThermometerOff,
-		"thermometer_plus":                        ThermometerPlus,
-		"thermometer_water":                       ThermometerWater,
-		"thermostat":                              Thermostat,
-		"thermostat_box":                          ThermostatBox,
-		"thought_bubble":                          ThoughtBubble,
-		"thought_bubble_outline":                  ThoughtBubbleOutline,
-		"thumb_down":                              ThumbDown,
-		"thumb_down_outline":                      ThumbDownOutline,
-		"thumb_up":                                ThumbUp,
-		"thumb_up_outline":                        ThumbUpOutline,
-		"thumbs_up_down":                          ThumbsUpDown,
-		"thumbs_up_down_outline":                  ThumbsUpDownOutline,
-		"ticket":                                  Ticket,
-		"ticket_account":                          TicketAccount,
-		"ticket_confirmation":                     TicketConfirmation,
-		"ticket_confirmation_outline":             TicketConfirmationOutline,
-		"ticket_outline":                          TicketOutline,
-		"ticket_percent":                          TicketPercent,
-		"ticket_percent_outline":                  TicketPercentOutline,
-		"tie":                                     Tie,
-		"tilde":                                   Tilde,
-		"tilde_off":                               TildeOff,
-		"timelapse":                               Timelapse,
-		"timeline":                                Timeline,
-		"timeline_alert":                          TimelineAlert,
-		"timeline_alert_outline":                  TimelineAlertOutline,
-		"timeline_check":                          TimelineCheck,
-		"timeline_check_outline":                  TimelineCheckOutline,
-		"timeline_clock":                          TimelineClock,
-		"timeline_clock_outline":                  TimelineClockOutline,
-		"timeline_help":                           TimelineHelp,
-		"timeline_help_outline":                   TimelineHelpOutline,
-		"timeline_minus":                          TimelineMinus,
-		"timeline_minus_outline":                  TimelineMinusOutline,
-		"timeline_outline":                        TimelineOutline,
-		"timeline_plus":                           TimelinePlus,
-		"timeline_plus_outline":                   TimelinePlusOutline,
-		"timeline_remove":                         TimelineRemove,
-		"timeline_remove_outline":                 TimelineRemoveOutline,
-		"timeline_text":                           TimelineText,
-		"timeline_text_outline":                   TimelineTextOutline,
-		"timer":                                   Timer,
-		"timer_10":                                Timer10,
-		"timer_3":                                 Timer3,
-		"timer_alert":                             TimerAlert,
-		"timer_alert_outline":                     TimerAlertOutline,
-		"timer_cancel":                            TimerCancel,
-		"timer_cancel_outline":                    TimerCancelOutline,
-		"timer_check":                             TimerCheck,
-		"timer_check_outline":                     TimerCheckOutline,
-		"timer_cog":                               TimerCog,
-		"timer_cog_outline":                       TimerCogOutline,
-		"timer_edit":                              TimerEdit,
-		"timer_edit_outline":                      TimerEditOutline,
-		"timer_lock":                              TimerLock,
-		"timer_lock_open":                         TimerLockOpen,
-		"timer_lock_open_outline":                 TimerLockOpenOutline,
-		"timer_lock_outline":                      TimerLockOutline,
-		"timer_marker":                            TimerMarker,
-		"timer_marker_outline":                    TimerMarkerOutline,
-		"timer_minus":                             TimerMinus,
-		"timer_minus_outline":                     TimerMinusOutline,
-		"timer_music":                             TimerMusic,
-		"timer_music_outline":                     TimerMusicOutline,
-		"timer_off":                               TimerOff,
-		"timer_off_outline":                       TimerOffOutline,
-		"timer_outline":                           TimerOutline,
-		"timer_pause":                             TimerPause,
-		"timer_pause_outline":                     TimerPauseOutline,
-		"timer_play":                              TimerPlay,
-		"timer_play_outline":                      TimerPlayOutline,
-		"timer_plus":                              TimerPlus,
-		"timer_plus_outline":                      TimerPlusOutline,
-		"timer_refresh":                           TimerRefresh,
-		"timer_refresh_outline":                   TimerRefreshOutline,
-		"timer_remove":                            TimerRemove,
-		"timer_remove_outline":                    TimerRemoveOutline,
-		"timer_sand":                              TimerSand,
-		"timer_sand_complete":                     TimerSandComplete,
-		"timer_sand_empty":                        TimerSandEmpty,
-		"timer_sand_full":                         TimerSandFull,
-		"timer_sand_paused":                       TimerSandPaused,
-		"timer_settings":                          TimerSettings,
-		"timer_settings_outline":                  TimerSettingsOutline,
-		"timer_star":                              TimerStar,
-		"timer_star_outline":                      TimerStarOutline,
-		"timer_stop":                              TimerStop,
-		"timer_stop_outline":                      TimerStopOutline,
-		"timer_sync":                              TimerSync,
-		"timer_sync_outline":                      TimerSyncOutline,
-		"timetable":                               Timetable,
-		"tire":                                    Tire,
-		"toaster":                                 Toaster,
-		"toaster_off":                             ToasterOff,
-		"toaster_oven":                            ToasterOven,
-		"toggle_switch":                           ToggleSwitch,
-		"toggle_switch_off":                       ToggleSwitchOff,
-		"toggle_switch_off_outline":               ToggleSwitchOffOutline,
-		"toggle_switch_outline":                   ToggleSwitchOutline,
-		"toggle_switch_variant":                   ToggleSwitchVariant,
-		"toggle_switch_variant_off":               ToggleSwitchVariantOff,
-		"toilet":                                  Toilet,
-		"toolbox":                                 Toolbox,
-		"toolbox_outline":                         ToolboxOutline,
-		"tools":                                   Tools,
-		"tooltip":                                 Tooltip,
-		"tooltip_account":                         TooltipAccount,
-		"tooltip_cellphone":                       TooltipCellphone,
-		"tooltip_check":                           TooltipCheck,
-		"tooltip_check_outline":                   TooltipCheckOutline,
-		"tooltip_edit":                            TooltipEdit,
-		"tooltip_edit_outline":                    TooltipEditOutline,
-		"tooltip_image":                           TooltipImage,
-		"tooltip_image_outline":                   TooltipImageOutline,
-		"tooltip_minus":                           TooltipMinus,
-		"tooltip_minus_outline":                   TooltipMinusOutline,
-		"tooltip_outline":                         TooltipOutline,
-		"tooltip_plus":                            TooltipPlus,
-		"tooltip_plus_outline":                    TooltipPlusOutline,
-		"tooltip_remove":                          TooltipRemove,
-		"tooltip_remove_outline":                  TooltipRemoveOutline,
-		"tooltip_text":                            TooltipText,
-		"tooltip_text_outline":                    TooltipTextOutline,
-		"tooth":                                   Tooth,
-		"tooth_outline":                           ToothOutline,
-		"toothbrush":                              Toothbrush,
-		"toothbrush_electric":                     ToothbrushElectric,
-		"toothbrush_paste":                        ToothbrushPaste,
-		"torch":                                   Torch,
-		"tortoise":                                Tortoise,
-		"toslink":                                 Toslink,
-		"tournament":                              Tournament,
-		"tow_truck":                               TowTruck,
-		"tower_beach":                             TowerBeach,
-		"tower_fire":                              TowerFire,
-		"town_hall":                               TownHall,
-		"toy_brick":                               ToyBrick,
-		"toy_brick_marker":                        ToyBrickMarker,
-		"toy_brick_marker_outline":                ToyBrickMarkerOutline,
-		"toy_brick_minus":                         ToyBrickMinus,
-		"toy_brick_minus_outline":                 ToyBrickMinusOutline,
-		"toy_brick_outline":                       ToyBrickOutline,
-		"toy_brick_plus":                          ToyBrickPlus,
-		"toy_brick_plus_outline":                  ToyBrickPlusOutline,
-		"toy_brick_remove":                        ToyBrickRemove,
-		"toy_brick_remove_outline":                ToyBrickRemoveOutline,
-		"toy_brick_search":                        ToyBrickSearch,
-		"toy_brick_search_outline":                ToyBrickSearchOutline,
-		"track_light":                             TrackLight,
-		"trackpad":                                Trackpad,
-		"trackpad_lock":                           TrackpadLock,
-		"tractor":                                 Tractor,
-		"tractor_variant":                         TractorVariant,
-		"trademark":                               Trademark,
-		"traffic_cone":                            TrafficCone,
-		"traffic_light":                           TrafficLight,
-		"traffic_light_outline":                   TrafficLightOutline,
-		"train":                                   Train,
-		"train_car":                               TrainCar,
-		"train_car_passenger":                     TrainCarPassenger,
-		"train_car_passenger_door":                TrainCarPassengerDoor,
-		"train_car_passenger_door_open":           TrainCarPassengerDoorOpen,
-		"train_car_passenger_variant":             TrainCarPassengerVariant,
-		"train_variant":                           TrainVariant,
-		"tram":                                    Tram,
-		"tram_side":                               TramSide,
-		"transcribe":                              Transcribe,
-		"transcribe_close":                        TranscribeClose,
-		"transfer":                                Transfer,
-		"transfer_down":                           TransferDown,
-		"transfer_left":                           TransferLeft,
-		"transfer_right":                          TransferRight,
-		"transfer_up":                             TransferUp,
-		"transit_connection":                      TransitConnection,
-		"transit_connection_horizontal":           TransitConnectionHorizontal,
-		"transit_connection_variant":              TransitConnectionVariant,
-		"transit_detour":                          TransitDetour,
-		"transit_skip":                            TransitSkip,
-		"transit_transfer":                        TransitTransfer,
-		"transition":                              Transition,
-		"transition_masked":                       TransitionMasked,
-		"translate":                               Translate,
-		"translate_off":                           TranslateOff,
-		"transmission_tower":                      TransmissionTower,
-		"transmission_tower_export":               TransmissionTowerExport,
-		"transmission_tower_import":               TransmissionTowerImport,
-		"transmission_tower_off":                  TransmissionTowerOff,
-		"trash_can":                               TrashCan,
-		"trash_can_outline":                       TrashCanOutline,
-		"tray":                                    Tray,
-		"tray_alert":                              TrayAlert,
-		"tray_arrow_down":                         TrayArrowDown,
-		"tray_arrow_up":                           TrayArrowUp,
-		"tray_full":                               TrayFull,
-		"tray_minus":                              TrayMinus,
-		"tray_plus":                               TrayPlus,
-		"tray_remove":                             TrayRemove,
-		"treasure_chest":                          TreasureChest,
-		"tree":                                    Tree,
-		"tree_outline":                            TreeOutline,
-		"trello":                                  Trello,
-		"trending_down":                           TrendingDown,
-		"trending_neutral":                        TrendingNeutral,
-		"trending_up":                             TrendingUp,
-		"triangle":                                Triangle,
-		"triangle_outline":                        TriangleOutline,
-		"triangle_small_down":                     TriangleSmallDown,
-		"triangle_small_up":                       TriangleSmallUp,
-		"triangle_wave":                           TriangleWave,
-		"triforce":                                Triforce,
-		"trophy":                                  Trophy,
-		"trophy_award":                            TrophyAward,
-		"trophy_broken":                           TrophyBroken,
-		"trophy_outline":                          TrophyOutline,
-		"trophy_variant":                          TrophyVariant,
-		"trophy_variant_outline":                  TrophyVariantOutline,
-		"truck":                                   Truck,
-		"truck_alert":                             TruckAlert,
-		"truck_alert_outline":                     TruckAlertOutline,
-		"truck_cargo_container":                   TruckCargoContainer,
-		"truck_check":                             TruckCheck,
-		"truck_check_outline":                     TruckCheckOutline,
-		"truck_delivery":                          TruckDelivery,
-		"truck_delivery_outline":                  TruckDeliveryOutline,
-		"truck_fast":                              TruckFast,
-		"truck_fast_outline":                      TruckFastOutline,
-		"truck_flatbed":                           TruckFlatbed,
-		"truck_minus":                             TruckMinus,
-		"truck_minus_outline":                     TruckMinusOutline,
-		"truck_outline":                           TruckOutline,
-		"truck_plus":                              TruckPlus,
-		"truck_plus_outline":                      TruckPlusOutline,
-		"truck_remove":                            TruckRemove,
-		"truck_remove_outline":                    TruckRemoveOutline,
-		"truck_snowflake":                         TruckSnowflake,
-		"truck_trailer":                           TruckTrailer,
-		"trumpet":                                 Trumpet,
-		"tshirt_crew":                             TshirtCrew,
-		"tshirt_crew_outline":                     TshirtCrewOutline,
-		"tshirt_v":                                TshirtV,
-		"tshirt_v_outline":                        TshirtVOutline,
-		"tsunami":                                 Tsunami,
-		"tumble_dryer":                            TumbleDryer,
-		"tumble_dryer_alert":                      TumbleDryerAlert,
-		"tumble_dryer_off":                        TumbleDryerOff,
-		"tune":                                    Tune,
-		"tune_variant":                            TuneVariant,
-		"tune_vertical":                           TuneVertical,
-		"tune_vertical_variant":                   TuneVerticalVariant,
-		"tunnel":                                  Tunnel,
-		"tunnel_outline":                          TunnelOutline,
-		"turbine":                                 Turbine,
-		"turkey":                                  Turkey,
-		"turnstile":                               Turnstile,
-		"turnstile_outline":                       TurnstileOutline,
-		"turtle":                                  Turtle,
-		"twitch":                                  Twitch,
-		"twitter":                                 Twitter,
-		"two_factor_authentication":               TwoFactorAuthentication,
-		"typewriter":                              Typewriter,
-		"ubisoft":                                 Ubisoft,
-		"ubuntu":                                  Ubuntu,
-		"ufo":                                     Ufo,
-		"ufo_outline":                             UfoOutline,
-		"ultra_high_definition":                   UltraHighDefinition,
-		"umbraco":                                 Umbraco,
-		"umbrella":                                Umbrella,
-		"umbrella_beach":                          UmbrellaBeach,
-		"umbrella_beach_outline":                  UmbrellaBeachOutline,
-		"umbrella_closed":                         UmbrellaClosed,
-		"umbrella_closed_outline":                 UmbrellaClosedOutline,
-		"umbrella_closed_variant":                 UmbrellaClosedVariant,
-		"umbrella_outline":                        UmbrellaOutline,
-		"undo":                                    Undo,
-		"undo_variant":                            UndoVariant,
-		"unfold_less_horizontal":                  UnfoldLessHorizontal,
-		"unfold_less_vertical":                    UnfoldLessVertical,
-		"unfold_more_horizontal":                  UnfoldMoreHorizontal,
-		"unfold_more_vertical":                    UnfoldMoreVertical,
-		"ungroup":                                 Ungroup,
-		"unicode":                                 Unicode,
-		"unicorn":                                 Unicorn,
-		"unicorn_variant":                         UnicornVariant,
-		"unicycle":                                Unicycle,
-		"unity":                                   Unity,
-		"unreal":                                  Unreal,
-		"update":                                  Update,
-		"upload":                                  Upload,
-		"upload_lock":                             UploadLock,
-		"upload_lock_outline":                     UploadLockOutline,
-		"upload_multiple":                         UploadMultiple,
-		"upload_network":                          UploadNetwork,
-		"upload_network_outline":                  UploadNetworkOutline,
-		"upload_off":                              UploadOff,
-		"upload_off_outline":                      UploadOffOutline,
-		"upload_outline":                          UploadOutline,
-		"usb":                                     Usb,
-		"usb_flash_drive":                         UsbFlashDrive,
-		"usb_flash_drive_outline":                 UsbFlashDriveOutline,
-		"usb_port":                                UsbPort,
-		"vacuum":                                  Vacuum,
-		"vacuum_outline":                          VacuumOutline,
-		"valve":                                   Valve,
-		"valve_closed":                            ValveClosed,
-		"valve_open":                              ValveOpen,
-		"van_passenger":                           VanPassenger,
-		"van_utility":                             VanUtility,
-		"vanish":                                  Vanish,
-		"vanish_quarter":                          VanishQuarter,
-		"vanity_light":                            VanityLight,
-		"variable":                                Variable,
-		"variable_box":                            VariableBox,
-		"vector_arrange_above":                    VectorArrangeAbove,
-		"vector_arrange_below":                    VectorArrangeBelow,
-		"vector_bezier":                           VectorBezier,
-		"vector_circle":                           VectorCircle,
-		"vector_circle_variant":                   VectorCircleVariant,
-		"vector_combine":                          VectorCombine,
-		"vector_curve":                            VectorCurve,
-		"vector_difference":                       VectorDifference,
-		"vector_difference_ab":                    VectorDifferenceAb,
-		"vector_difference_ba":                    VectorDifferenceBa,
-		"vector_ellipse":                          VectorEllipse,
-		"vector_intersection":                     VectorIntersection,
-		"vector_line":                             VectorLine,
-		"vector_link":                             VectorLink,
-		"vector_point":                            VectorPoint,
-		"vector_polygon":                          VectorPolygon,
-		"vector_polygon_variant":                  VectorPolygonVariant,
-		"vector_polyline":                         VectorPolyline,
-		"vector_polyline_edit":                    VectorPolylineEdit,
-		"vector_polyline_minus":                   VectorPolylineMinus,
-		"vector_polyline_plus":                    VectorPolylinePlus,
-		"vector_polyline_remove":                  VectorPolylineRemove,
-		"vector_radius":                           VectorRadius,
-		"vector_rectangle":                        VectorRectangle,
-		"vector_selection":                        VectorSelection,
-		"vector_square":                           VectorSquare,
-		"vector_square_close":                     VectorSquareClose,
-		"vector_square_edit":                      VectorSquareEdit,
-		"vector_square_minus":                     VectorSquareMinus,
-		"vector_square_open":                      VectorSquareOpen,
-		"vector_square_plus":                      VectorSquarePlus,
-		"vector_square_remove":                    VectorSquareRemove,
-		"vector_triangle":                         VectorTriangle,
-		"vector_union":                            VectorUnion,
-		"vhs":                                     Vhs,
-		"vibrate":                                 Vibrate,
-		"vibrate_off":                             VibrateOff,
-		"video":                                   Video,
-		"video_2d":                                Video2D,
-		"video_3d":                                Video3D,
-		"video_3d_off":                            Video3DOff,
-		"video_3d_variant":                        Video3DVariant,
-		"video_4k_box":                            Video4KBox,
-		"video_account":                           VideoAccount,
-		"video_box":                               VideoBox,
-		"video_box_off":                           VideoBoxOff,
-		"video_check":                             VideoCheck,
-		"video_check_outline":                     VideoCheckOutline,
-		"video_high_definition":                   VideoHighDefinition,
-		"video_image":                             VideoImage,
-		"video_input_antenna":                     VideoInputAntenna,
-		"video_input_component":                   VideoInputComponent,
-		"video_input_hdmi":                        VideoInputHdmi,
-		"video_input_scart":                       VideoInputScart,
-		"video_input_svideo":                      VideoInputSvideo,
-		"video_marker":                            VideoMarker,
-		"video_marker_outline":                    VideoMarkerOutline,
-		"video_minus":                             VideoMinus,
-		"video_minus_outline":                     VideoMinusOutline,
-		"video_off":                               VideoOff,
-		"video_off_outline":                       VideoOffOutline,
-		"video_outline":                           VideoOutline,
-		"video_plus":                              VideoPlus,
-		"video_plus_outline":                      VideoPlusOutline,
-		"video_stabilization":                     VideoStabilization,
-		"video_switch":                            VideoSwitch,
-		"video_switch_outline":                    VideoSwitchOutline,
-		"video_vintage":                           VideoVintage,
-		"video_wireless":                          VideoWireless,
-		"video_wireless_outline":                  VideoWirelessOutline,
-		"view_agenda":                             ViewAgenda,
-		"view_agenda_outline":                     ViewAgendaOutline,
-		"view_array":                              ViewArray,
-		"view_array_outline":                      ViewArrayOutline,
-		"view_carousel":                           ViewCarousel,
-		"view_carousel_outline":                   ViewCarouselOutline,
-		"view_column":                             ViewColumn,
-		"view_column_outline":                     ViewColumnOutline,
-		"view_comfy":                              ViewComfy,
-		"view_comfy_outline":                      ViewComfyOutline,
-		"view_compact":                            ViewCompact,
-		"view_compact_outline":                    ViewCompactOutline,
-		"view_dashboard":                          ViewDashboard,
-		"view_dashboard_edit":                     ViewDashboardEdit,
-		"view_dashboard_edit_outline":             ViewDashboardEditOutline,
-		"view_dashboard_outline":                  ViewDashboardOutline,
-		"view_dashboard_variant":                  ViewDashboardVariant,
-		"view_dashboard_variant_outline":          ViewDashboardVariantOutline,
-		"view_day":                                ViewDay,
-		"view_day_outline":                        ViewDayOutline,
-		"view_gallery":                            ViewGallery,
-		"view_gallery_outline":                    ViewGalleryOutline,
-		"view_grid":                               ViewGrid,
-		"view_grid_outline":                       ViewGridOutline,
-		"view_grid_plus":                          ViewGridPlus,
-		"view_grid_plus_outline":                  ViewGridPlusOutline,
-		"view_headline":                           ViewHeadline,
-		"view_list":                               ViewList,
-		"view_list_outline":                       ViewListOutline,
-		"view_module":                             ViewModule,
-		"view_module_outline":                     ViewModuleOutline,
-		"view_parallel":                           ViewParallel,
-		"view_parallel_outline":                   ViewParallelOutline,
-		"view_quilt":                              ViewQuilt,
-		"view_quilt_outline":                      ViewQuiltOutline,
-		"view_sequential":                         ViewSequential,
-		"view_sequential_outline":                 ViewSequentialOutline,
-		"view_split_horizontal":                   ViewSplitHorizontal,
-		"view_split_vertical":                     ViewSplitVertical,
-		"view_stream":                             ViewStream,
-		"view_stream_outline":                     ViewStreamOutline,
-		"view_week":                               ViewWeek,
-		"view_week_outline":                       ViewWeekOutline,
-		"vimeo":                                   Vimeo,
-		"violin":                                  Violin,
-		"virtual_reality":                         VirtualReality,
-		"virus":                                   Virus,
-		"virus_off":                               VirusOff,
-		"virus_off_outline":                       VirusOffOutline,
-		"virus_outline":                           VirusOutline,
-		"vlc":                                     Vlc,
-		"voicemail":                               Voicemail,
-		"volcano":                                 Volcano,
-		"volcano_outline":                         VolcanoOutline,
-		"volleyball":                              Volleyball,
-		"volume_high":                             VolumeHigh,
-		"volume_low":                              VolumeLow,
-		"volume_medium":                           VolumeMedium,
-		"volume_minus":                            VolumeMinus,
-		"volume_mute":                             VolumeMute,
-		"volume_off":                              VolumeOff,
-		"volume_plus":                             VolumePlus,
-		"volume_source":                           VolumeSource,
-		"volume_variant_off":                      VolumeVariantOff,
-		"volume_vibrate":                          VolumeVibrate,
-		"vote":                                    Vote,
-		"vote_outline":                            VoteOutline,
-		"vpn":                                     Vpn,
-		"vuejs":                                   Vuejs,
-		"vuetify":                                 Vuetify,
-		"walk":                                    Walk,
-		"wall":                                    Wall,
-		"wall_fire":                               WallFire,
-		"wall_sconce":                             WallSconce,
-		"wall_sconce_flat":                        WallSconceFlat,
-		"wall_sconce_flat_outline":                WallSconceFlatOutline,
-		"wall_sconce_flat_variant":                WallSconceFlatVariant,
-		"wall_sconce_flat_variant_outline":        WallSconceFlatVariantOutline,
-		"wall_sconce_outline":                     WallSconceOutline,
-		"wall_sconce_round":                       WallSconceRound,
-		"wall_sconce_round_outline":               WallSconceRoundOutline,
-		"wall_sconce_round_variant":               WallSconceRoundVariant,
-		"wall_sconce_round_variant_outline":       WallSconceRoundVariantOutline,
-		"wallet":                                  Wallet,
-		"wallet_giftcard":                         WalletGiftcard,
-		"wallet_membership":                       WalletMembership,
-		"wallet_outline":                          WalletOutline,
-		"wallet_plus":                             WalletPlus,
-		"wallet_plus_outline":                     WalletPlusOutline,
-		"wallet_travel":                           WalletTravel,
-		"wallpaper":                               Wallpaper,
-		"wan":                                     Wan,
-		"wardrobe":                                Wardrobe,
-		"wardrobe_outline":                        WardrobeOutline,
-		"warehouse":                               Warehouse,
-		"washing_machine":                         WashingMachine,
-		"washing_machine_alert":                   WashingMachineAlert,
-		"washing_machine_off":                     WashingMachineOff,
-		"watch":                                   Watch,
-		"watch_export":                            WatchExport,
-		"watch_export_variant":                    WatchExportVariant,
-		"watch_import":                            WatchImport,
-		"watch_import_variant":                    WatchImportVariant,
-		"watch_variant":                           WatchVariant,
-		"watch_vibrate":                           WatchVibrate,
-		"watch_vibrate_off":                       WatchVibrateOff,
-		"water":                                   Water,
-		"water_alert":                             WaterAlert,
-		"water_alert_outline":                     WaterAlertOutline,
-		"water_boiler":                            WaterBoiler,
-		"water_boiler_alert":                      WaterBoilerAlert,
-		"water_boiler_off":                        WaterBoilerOff,
-		"water_check":                             WaterCheck,
-		"water_check_outline":                     WaterCheckOutline,
-		"water_circle":                            WaterCircle,
-		"water_minus":                             WaterMinus,
-		"water_minus_outline":                     WaterMinusOutline,
-		"water_off":                               WaterOff,
-		"water_off_outline":                       WaterOffOutline,
-		"water_opacity":                           WaterOpacity,
-		"water_outline":                           WaterOutline,
-		"water_percent":                           WaterPercent,
-		"water_percent_alert":                     WaterPercentAlert,
-		"water_plus":                              WaterPlus,
-		"water_plus_outline":                      WaterPlusOutline,
-		"water_polo":                              WaterPolo,
-		"water_pump":                              WaterPump,
-		"water_pump_off":                          WaterPumpOff,
-		"water_remove":                            WaterRemove,
-		"water_remove_outline":                    WaterRemoveOutline,
-		"water_sync":                              WaterSync,
-		"water_thermometer":                       WaterThermometer,
-		"water_thermometer_outline":               WaterThermometerOutline,
-		"water_well":                              WaterWell,
-		"water_well_outline":                      WaterWellOutline,
-		"waterfall":                               Waterfall,
-		"watering_can":                            WateringCan,
-		"watering_can_outline":                    WateringCanOutline,
-		"watermark":                               Watermark,
-		"wave":                                    Wave,
-		"waveform":                                Waveform,
-		"waves":                                   Waves,
-		"waves_arrow_left":                        WavesArrowLeft,
-		"waves_arrow_right":                       WavesArrowRight,
-		"waves_arrow_up":                          WavesArrowUp,
-		"waze":                                    Waze,
-		"weather_cloudy":                          WeatherCloudy,
-		"weather_cloudy_alert":                    WeatherCloudyAlert,
-		"weather_cloudy_arrow_right":              WeatherCloudyArrowRight,
-		"weather_cloudy_clock":                    WeatherCloudyClock,
-		"weather_fog":                             WeatherFog,
-		"weather_hail":                            WeatherHail,
-		"weather_hazy":                            WeatherHazy,
-		"weather_hurricane":                       WeatherHurricane,
-		"weather_lightning":                       WeatherLightning,
-		"weather_lightning_rainy":                 WeatherLightningRainy,
-		"weather_night":                           WeatherNight,
-		"weather_night_partly_cloudy":             WeatherNightPartlyCloudy,
-		"weather_partly_cloudy":                   WeatherPartlyCloudy,
-		"weather_partly_lightning":                WeatherPartlyLightning,
-		"weather_partly_rainy":                    WeatherPartlyRainy,
-		"weather_partly_snowy":                    WeatherPartlySnowy,
-		"weather_partly_snowy_rainy":              WeatherPartlySnowyRainy,
-		"weather_pouring":                         WeatherPouring,
-		"weather_rainy":                           WeatherRainy,
-		"weather_snowy":                           WeatherSnowy,
-		"weather_snowy_heavy":                     WeatherSnowyHeavy,
-		"weather_snowy_rainy":                     WeatherSnowyRainy,
-		"weather_sunny":                           WeatherSunny,
-		"weather_sunny_alert":                     WeatherSunnyAlert,
-		"weather_sunny_off":                       WeatherSunnyOff,
-		"weather_sunset":                          WeatherSunset,
-		"weather_sunset_down":                     WeatherSunsetDown,
-		"weather_sunset_up":                       WeatherSunsetUp,
-		"weather_tornado":                         WeatherTornado,
-		"weather_windy":                           WeatherWindy,
-		"weather_windy_variant":                   WeatherWindyVariant,
-		"web":                                     Web,
-		"web_box":                                 WebBox,
-		"web_cancel":                              WebCancel,
-		"web_check":                               WebCheck,
-		"web_clock":                               WebClock,
-		"web_minus":                               WebMinus,
-		"web_off":                                 WebOff,
-		"web_plus":                                WebPlus,
-		"web_refresh":                             WebRefresh,
-		"web_remove":                              WebRemove,
-		"web_sync":                                WebSync,
-		"webcam":                                  Webcam,
-		"webcam_off":                              WebcamOff,
-		"webhook":                                 Webhook,
-		"webpack":                                 Webpack,
-		"webrtc":                                  Webrtc,
-		"wechat":                                  Wechat,
-		"weight":                                  Weight,
-		"weight_gram":                             WeightGram,
-		"weight_kilogram":                         WeightKilogram,
-		"weight_lifter":                           WeightLifter,
-		"weight_pound":                            WeightPound,
-		"whatsapp":                                Whatsapp,
-		"wheel_barrow":                            WheelBarrow,
-		"wheelchair":                              Wheelchair,
-		"wheelchair_accessibility":                WheelchairAccessibility,
-		"whistle":                                 Whistle,
-		"whistle_outline":                         WhistleOutline,
-		"white_balance_auto":                      WhiteBalanceAuto,
-		"white_balance_incandescent":              WhiteBalanceIncandescent,
-		"white_balance_iridescent":                WhiteBalanceIridescent,
-		"white_balance_sunny":                     WhiteBalanceSunny,
-		"widgets":                                 Widgets,
-		"widgets_outline":                         WidgetsOutline,
-		"wifi":                                    Wifi,
-		"wifi_alert":                              WifiAlert,
-		"wifi_arrow_down":                         WifiArrowDown,
-		"wifi_arrow_left":                         WifiArrowLeft,
-		"wifi_arrow_left_right":                   WifiArrowLeftRight,
-		"wifi_arrow_right":                        WifiArrowRight,
-		"wifi_arrow_up":                           WifiArrowUp,
-		"wifi_arrow_up_down":                      WifiArrowUpDown,
-		"wifi_cancel":                             WifiCancel,
-		"wifi_check":                              WifiCheck,
-		"wifi_cog":                                WifiCog,
-		"wifi_lock":                               WifiLock,
-		"wifi_lock_open":                          WifiLockOpen,
-		"wifi_marker":                             WifiMarker,
-		"wifi_minus":                              WifiMinus,
-		"wifi_off":                                WifiOff,
-		"wifi_plus":                               WifiPlus,
-		"wifi_refresh":                            WifiRefresh,
-		"wifi_remove":                             WifiRemove,
-		"wifi_settings":                           WifiSettings,
-		"wifi_star":                               WifiStar,
-		"wifi_strength_1":                         WifiStrength1,
-		"wifi_strength_1_alert":                   WifiStrength1Alert,
-		"wifi_strength_1_lock":                    WifiStrength1Lock,
-		"wifi_strength_1_lock_open":               WifiStrength1LockOpen,
-		"wifi_strength_2":                         WifiStrength2,
-		"wifi_strength_2_alert":                   WifiStrength2Alert,
-		"wifi_strength_2_lock":                    WifiStrength2Lock,
-		"wifi_strength_2_lock_open":               WifiStrength2LockOpen,
-		"wifi_strength_3":                         WifiStrength3,
-		"wifi_strength_3_alert":                   WifiStrength3Alert,
-		"wifi_strength_3_lock":                    WifiStrength3Lock,
-		"wifi_strength_3_lock_open":               WifiStrength3LockOpen,
-		"wifi_strength_4":                         WifiStrength4,
-		"wifi_strength_4_alert":                   WifiStrength4Alert,
-		"wifi_strength_4_lock":                    WifiStrength4Lock,
-		"wifi_strength_4_lock_open":               WifiStrength4LockOpen,
-		"wifi_strength_alert_outline":             WifiStrengthAlertOutline,
-		"wifi_strength_lock_open_outline":         WifiStrengthLockOpenOutline,
-		"wifi_strength_lock_outline":              WifiStrengthLockOutline,
-		"wifi_strength_off":                       WifiStrengthOff,
-		"wifi_strength_off_outline":               WifiStrengthOffOutline,
-		"wifi_strength_outline":                   WifiStrengthOutline,
-		"wifi_sync":                               WifiSync,
-		"wikipedia":                               Wikipedia,
-		"wind_power":                              WindPower,
-		"wind_power_outline":                      WindPowerOutline,
-		"wind_turbine":                            WindTurbine,
-		"wind_turbine_alert":                      WindTurbineAlert,
-		"wind_turbine_check":                      WindTurbineCheck,
-		"window_close":                            WindowClose,
-		"window_closed":                           WindowClosed,
-		"window_closed_variant":                   WindowClosedVariant,
-		"window_maximize":                         WindowMaximize,
-		"window_minimize":                         WindowMinimize,
-		"window_open":                             WindowOpen,
-		"window_open_variant":                     WindowOpenVariant,
-		"window_restore":                          WindowRestore,
-		"window_shutter":                          WindowShutter,
-		"window_shutter_alert":                    WindowShutterAlert,
-		"window_shutter_cog":                      WindowShutterCog,
-		"window_shutter_open":                     WindowShutterOpen,
-		"window_shutter_settings":                 WindowShutterSettings,
-		"windsock":                                Windsock,
-		"wiper":                                   Wiper,
-		"wiper_wash":                              WiperWash,
-		"wiper_wash_alert":                        WiperWashAlert,
-		"wizard_hat":                              WizardHat,
-		"wordpress":                               Wordpress,
-		"wrap":                                    Wrap,
-		"wrap_disabled":                           WrapDisabled,
-		"wrench":                                  Wrench,
-		"wrench_clock":                            WrenchClock,
-		"wrench_outline":                          WrenchOutline,
-		"xamarin":                                 Xamarin,
-		"xml":                                     Xml,
-		"xmpp":                                    Xmpp,
-		"yahoo":                                   Yahoo,
-		"yeast":                                   Yeast,
-		"yin_yang":                                YinYang,
-		"yoga":                                    Yoga,
-		"youtube":                                 Youtube,
-		"youtube_gaming":                          YoutubeGaming,
-		"youtube_studio":                          YoutubeStudio,
-		"youtube_subscription":                    YoutubeSubscription,
-		"youtube_tv":                              YoutubeTv,
-		"yurt":                                    Yurt,
-		"z_wave":                                  ZWave,
-		"zend":                                    Zend,
-		"zigbee":                                  Zigbee,
-		"zip_box":                                 ZipBox,
-		"zip_box_outline":                         ZipBoxOutline,
-		"zip_disk":                                ZipDisk,
-		"zodiac_aquarius":                         ZodiacAquarius,
-		"zodiac_aries":                            ZodiacAries,
-		"zodiac_cancer":                           ZodiacCancer,
-		"zodiac_capricorn":                        ZodiacCapricorn,
-		"zodiac_gemini":                           ZodiacGemini,
-		"zodiac_leo":                              ZodiacLeo,
-		"zodiac_libra":                            ZodiacLibra,
-		"zodiac_pisces":                           ZodiacPisces,
-		"zodiac_sagittarius":                      ZodiacSagittarius,
-		"zodiac_scorpio":                          ZodiacScorpio,
-		"zodiac_taurus":                           ZodiacTaurus,
-		"zodiac_virgo":                            ZodiacVirgo,
-	}
-)
+// glyphIDs are the IDs of all glyphs in the class, sorted, such that they can be
+// binary searched. The glyph for each ID is at the same index in glyphValues.
+//
+// Arrays of constants are laid out statically by the compiler, unlike maps,
+// which are built at init time.
+var glyphIDs = [...]string{
+	"ab_testing",
+	"abacus",
+	"abjad_arabic",
+	"abjad_hebrew",
+	"abugida_devanagari",
+	"abugida_thai",
+	"access_point",
+	"access_point_check",
+	"access_point_minus",
+	"access_point_network",
+	"access_point_network_off",
+	"access_point_off",
+	"access_point_plus",
+	"access_point_remove",
+	"account",
+	"account_alert",
+	"account_alert_outline",
+	"account_arrow_down",
+	"account_arrow_down_outline",
+	"account_arrow_left",
+	"account_arrow_left_outline",
+	"account_arrow_right",
+	"account_arrow_right_outline",
+	"account_arrow_up",
+	"account_arrow_up_outline",
+	"account_box",
+	"account_box_multiple",
+	"account_box_multiple_outline",
+	"account_box_outline",
+	"account_cancel",
+	"account_cancel_outline",
+	"account_cash",
+	"account_cash_outline",
+	"account_check",
+	"account_check_outline",
+	"account_child",
+	"account_child_circle",
+	"account_child_outline",
+	"account_circle",
+	"account_circle_outline",
+	"account_clock",
+	"account_clock_outline",
+	"account_cog",
+	"account_cog_outline",
+	"account_convert",
+	"account_convert_outline",
+	"account_cowboy_hat",
+	"account_cowboy_hat_outline",
+	"account_details",
+	"account_details_outline",
+	"account_edit",
+	"account_edit_outline",
+	"account_eye",
+	"account_eye_outline",
+	"account_filter",
+	"account_filter_outline",
+	"account_group",
+	"account_group_outline",
+	"account_hard_hat",
+	"account_hard_hat_outline",
+	"account_heart",
+	"account_heart_outline",
+	"account_injury",
+	"account_injury_outline",
+	"account_key",
+	"account_key_outline",
+	"account_lock",
+	"account_lock_open",
+	"account_lock_open_outline",
+	"account_lock_outline",
+	"account_minus",
+	"account_minus_outline",
+	"account_multiple",
+	"account_multiple_check",
+	"account_multiple_check_outline",
+	"account_multiple_minus",
+	"account_multiple_minus_outline",
+	"account_multiple_outline",
+	"account_multiple_plus",
+	"account_multiple_plus_outline",
+	"account_multiple_remove",
+	"account_multiple_remove_outline",
+	"account_music",
+	"account_music_outline",
+	"account_network",
+	"account_network_outline",
+	"account_off",
+	"account_off_outline",
+	"account_outline",
+	"account_plus",
+	"account_plus_outline",
+	"account_question",
+	"account_question_outline",
+	"account_reactivate",
+	"account_reactivate_outline",
+	"account_remove",
+	"account_remove_outline",
+	"account_school",
+	"account_school_outline",
+	"account_search",
+	"account_search_outline",
+	"account_settings",
+	"account_settings_outline",
+	"account_star",
+	"account_star_outline",
+	"account_supervisor",
+	"account_supervisor_circle",
+	"account_supervisor_circle_outline",
+	"account_supervisor_outline",
+	"account_switch",
+	"account_switch_outline",
+	"account_sync",
+	"account_sync_outline",
+	"account_tie",
+	"account_tie_hat",
+	"account_tie_hat_outline",
+	"account_tie_outline",
+	"account_tie_voice",
+	"account_tie_voice_off",
+	"account_tie_voice_off_outline",
+	"account_tie_voice_outline",
+	"account_tie_woman",
+	"account_voice",
+	"account_voice_off",
+	"account_wrench",
+	"account_wrench_outline",
+	"adjust",
+	"advertisements",
+	"advertisements_off",
+	"air_conditioner",
+	"air_filter",
+	"air_horn",
+	"air_humidifier",
+	"air_humidifier_off",
+	"air_purifier",
+	"airbag",
+	"airballoon",
+	"airballoon_outline",
+	"airplane",
+	"airplane_alert",
+	"airplane_check",
+	"airplane_clock",
+	"airplane_cog",
+	"airplane_edit",
+	"airplane_landing",
+	"airplane_marker",
+	"airplane_minus",
+	"airplane_off",
+	"airplane_plus",
+	"airplane_remove",
+	"airplane_search",
+	"airplane_settings",
+	"airplane_takeoff",
+	"airport",
+	"alarm",
+	"alarm_bell",
+	"alarm_check",
+	"alarm_light",
+	"alarm_light_off",
+	"alarm_light_off_outline",
+	"alarm_light_outline",
+	"alarm_multiple",
+	"alarm_note",
+	"alarm_note_off",
+	"alarm_off",
+	"alarm_panel",
+	"alarm_panel_outline",
+	"alarm_plus",
+	"alarm_snooze",
+	"album",
+	"alert",
+	"alert_box",
+	"alert_box_outline",
+	"alert_circle",
+	"alert_circle_check",
+	"alert_circle_check_outline",
+	"alert_circle_outline",
+	"alert_decagram",
+	"alert_decagram_outline",
+	"alert_minus",
+	"alert_minus_outline",
+	"alert_octagon",
+	"alert_octagon_outline",
+	"alert_octagram",
+	"alert_octagram_outline",
+	"alert_outline",
+	"alert_plus",
+	"alert_plus_outline",
+	"alert_remove",
+	"alert_remove_outline",
+	"alert_rhombus",
+	"alert_rhombus_outline",
+	"alien",
+	"alien_outline",
+	"align_horizontal_center",
+	"align_horizontal_distribute",
+	"align_horizontal_left",
+	"align_horizontal_right",
+	"align_vertical_bottom",
+	"align_vertical_center",
+	"align_vertical_distribute",
+	"align_vertical_top",
+	"all_inclusive",
+	"all_inclusive_box",
+	"all_inclusive_box_outline",
+	"allergy",
+	"alpha",
+	"alpha_a",
+	"alpha_a_box",
+	"alpha_a_box_outline",
+	"alpha_a_circle",
+	"alpha_a_circle_outline",
+	"alpha_b",
+	"alpha_b_box",
+	"alpha_b_box_outline",
+	"alpha_b_circle",
+	"alpha_b_circle_outline",
+	"alpha_c",
+	"alpha_c_box",
+	"alpha_c_box_outline",
+	"alpha_c_circle",
+	"alpha_c_circle_outline",
+	"alpha_d",
+	"alpha_d_box",
+	"alpha_d_box_outline",
+	"alpha_d_circle",
+	"alpha_d_circle_outline",
+	"alpha_e",
+	"alpha_e_box",
+	"alpha_e_box_outline",
+	"alpha_e_circle",
+	"alpha_e_circle_outline",
+	"alpha_f",
+	"alpha_f_box",
+	"alpha_f_box_outline",
+	"alpha_f_circle",
+	"alpha_f_circle_outline",
+	"alpha_g",
+	"alpha_g_box",
+	"alpha_g_box_outline",
+	"alpha_g_circle",
+	"alpha_g_circle_outline",
+	"alpha_h",
+	"alpha_h_box",
+	"alpha_h_box_outline",
+	"alpha_h_circle",
+	"alpha_h_circle_outline",
+	"alpha_i",
+	"alpha_i_box",
+	"alpha_i_box_outline",
+	"alpha_i_circle",
+	"alpha_i_circle_outline",
+	"alpha_j",
+	"alpha_j_box",
+	"alpha_j_box_outline",
+	"alpha_j_circle",
+	"alpha_j_circle_outline",
+	"alpha_k",
+	"alpha_k_box",
+	"alpha_k_box_outline",
+	"alpha_k_circle",
+	"alpha_k_circle_outline",
+	"alpha_l",
+	"alpha_l_box",
+	"alpha_l_box_outline",
+	"alpha_l_circle",
+	"alpha_l_circle_outline",
+	"alpha_m",
+	"alpha_m_box",
+	"alpha_m_box_outline",
+	"alpha_m_circle",
+	"alpha_m_circle_outline",
+	"alpha_n",
+	"alpha_n_box",
+	"alpha_n_box_outline",
+	"alpha_n_circle",
+	"alpha_n_circle_outline",
+	"alpha_o",
+	"alpha_o_box",
+	"alpha_o_box_outline",
+	"alpha_o_circle",
+	"alpha_o_circle_outline",
+	"alpha_p",
+	"alpha_p_box",
+	"alpha_p_box_outline",
+	"alpha_p_circle",
+	"alpha_p_circle_outline",
+	"alpha_q",
+	"alpha_q_box",
+	"alpha_q_box_outline",
+	"alpha_q_circle",
+	"alpha_q_circle_outline",
+	"alpha_r",
+	"alpha_r_box",
+	"alpha_r_box_outline",
+	"alpha_r_circle",
+	"alpha_r_circle_outline",
+	"alpha_s",
+	"alpha_s_box",
+	"alpha_s_box_outline",
+	"alpha_s_circle",
+	"alpha_s_circle_outline",
+	"alpha_t",
+	"alpha_t_box",
+	"alpha_t_box_outline",
+	"alpha_t_circle",
+	"alpha_t_circle_outline",
+	"alpha_u",
+	"alpha_u_box",
+	"alpha_u_box_outline",
+	"alpha_u_circle",
+	"alpha_u_circle_outline",
+	"alpha_v",
+	"alpha_v_box",
+	"alpha_v_box_outline",
+	"alpha_v_circle",
+	"alpha_v_circle_outline",
+	"alpha_w",
+	"alpha_w_box",
+	"alpha_w_box_outline",
+	"alpha_w_circle",
+	"alpha_w_circle_outline",
+	"alpha_x",
+	"alpha_x_box",
+	"alpha_x_box_outline",
+	"alpha_x_circle",
+	"alpha_x_circle_outline",
+	"alpha_y",
+	"alpha_y_box",
+	"alpha_y_box_outline",
+	"alpha_y_circle",
+	"alpha_y_circle_outline",
+	"alpha_z",
+	"alpha_z_box",
+	"alpha_z_box_outline",
+	"alpha_z_circle",
+	"alpha_z_circle_outline",
+	"alphabet_aurebesh",
+	"alphabet_cyrillic",
+	"alphabet_greek",
+	"alphabet_latin",
+	"alphabet_piqad",
+	"alphabet_tengwar",
+	"alphabetical",
+	"alphabetical_off",
+	"alphabetical_variant",
+	"alphabetical_variant_off",
+	"altimeter",
+	"ambulance",
+	"ammunition",
+	"ampersand",
+	"amplifier",
+	"amplifier_off",
+	"anchor",
+	"android",
+	"android_messages",
+	"android_studio",
+	"angle_acute",
+	"angle_obtuse",
+	"angle_right",
+	"angular",
+	"angularjs",
+	"animation",
+	"animation_outline",
+	"animation_play",
+	"animation_play_outline",
+	"ansible",
+	"antenna",
+	"anvil",
+	"apache_kafka",
+	"api",
+	"api_off",
+	"apple",
+	"apple_finder",
+	"apple_icloud",
+	"apple_ios",
+	"apple_keyboard_caps",
+	"apple_keyboard_command",
+	"apple_keyboard_control",
+	"apple_keyboard_option",
+	"apple_keyboard_shift",
+	"apple_safari",
+	"application",
+	"application_array",
+	"application_array_outline",
+	"application_braces",
+	"application_braces_outline",
+	"application_brackets",
+	"application_brackets_outline",
+	"application_cog",
+	"application_cog_outline",
+	"application_edit",
+	"application_edit_outline",
+	"application_export",
+	"application_import",
+	"application_outline",
+	"application_parentheses",
+	"application_parentheses_outline",
+	"application_settings",
+	"application_settings_outline",
+	"application_variable",
+	"application_variable_outline",
+	"approximately_equal",
+	"approximately_equal_box",
+	"apps",
+	"apps_box",
+	"arch",
+	"archive",
+	"archive_alert",
+	"archive_alert_outline",
+	"archive_arrow_down",
+	"archive_arrow_down_outline",
+	"archive_arrow_up",
+	"archive_arrow_up_outline",
+	"archive_cancel",
+	"archive_cancel_outline",
+	"archive_check",
+	"archive_check_outline",
+	"archive_clock",
+	"archive_clock_outline",
+	"archive_cog",
+	"archive_cog_outline",
+	"archive_edit",
+	"archive_edit_outline",
+	"archive_eye",
+	"archive_eye_outline",
+	"archive_lock",
+	"archive_lock_open",
+	"archive_lock_open_outline",
+	"archive_lock_outline",
+	"archive_marker",
+	"archive_marker_outline",
+	"archive_minus",
+	"archive_minus_outline",
+	"archive_music",
+	"archive_music_outline",
+	"archive_off",
+	"archive_off_outline",
+	"archive_outline",
+	"archive_plus",
+	"archive_plus_outline",
+	"archive_refresh",
+	"archive_refresh_outline",
+	"archive_remove",
+	"archive_remove_outline",
+	"archive_search",
+	"archive_search_outline",
+	"archive_settings",
+	"archive_settings_outline",
+	"archive_star",
+	"archive_star_outline",
+	"archive_sync",
+	"archive_sync_outline",
+	"arm_flex",
+	"arm_flex_outline",
+	"arrange_bring_forward",
+	"arrange_bring_to_front",
+	"arrange_send_backward",
+	"arrange_send_to_back",
+	"arrow_all",
+	"arrow_bottom_left",
+	"arrow_bottom_left_bold_box",
+	"arrow_bottom_left_bold_box_outline",
+	"arrow_bottom_left_bold_outline",
+	"arrow_bottom_left_thick",
+	"arrow_bottom_left_thin",
+	"arrow_bottom_left_thin_circle_outline",
+	"arrow_bottom_right",
+	"arrow_bottom_right_bold_box",
+	"arrow_bottom_right_bold_box_outline",
+	"arrow_bottom_right_bold_outline",
+	"arrow_bottom_right_thick",
+	"arrow_bottom_right_thin",
+	"arrow_bottom_right_thin_circle_outline",
+	"arrow_collapse",
+	"arrow_collapse_all",
+	"arrow_collapse_down",
+	"arrow_collapse_horizontal",
+	"arrow_collapse_left",
+	"arrow_collapse_right",
+	"arrow_collapse_up",
+	"arrow_collapse_vertical",
+	"arrow_decision",
+	"arrow_decision_auto",
+	"arrow_decision_auto_outline",
+	"arrow_decision_outline",
+	"arrow_down",
+	"arrow_down_bold",
+	"arrow_down_bold_box",
+	"arrow_down_bold_box_outline",
+	"arrow_down_bold_circle",
+	"arrow_down_bold_circle_outline",
+	"arrow_down_bold_hexagon_outline",
+	"arrow_down_bold_outline",
+	"arrow_down_box",
+	"arrow_down_circle",
+	"arrow_down_circle_outline",
+	"arrow_down_drop_circle",
+	"arrow_down_drop_circle_outline",
+	"arrow_down_left",
+	"arrow_down_left_bold",
+	"arrow_down_right",
+	"arrow_down_right_bold",
+	"arrow_down_thick",
+	"arrow_down_thin",
+	"arrow_down_thin_circle_outline",
+	"arrow_expand",
+	"arrow_expand_all",
+	"arrow_expand_down",
+	"arrow_expand_horizontal",
+	"arrow_expand_left",
+	"arrow_expand_right",
+	"arrow_expand_up",
+	"arrow_expand_vertical",
+	"arrow_horizontal_lock",
+	"arrow_left",
+	"arrow_left_bold",
+	"arrow_left_bold_box",
+	"arrow_left_bold_box_outline",
+	"arrow_left_bold_circle",
+	"arrow_left_bold_circle_outline",
+	"arrow_left_bold_hexagon_outline",
+	"arrow_left_bold_outline",
+	"arrow_left_bottom",
+	"arrow_left_bottom_bold",
+	"arrow_left_box",
+	"arrow_left_circle",
+	"arrow_left_circle_outline",
+	"arrow_left_drop_circle",
+	"arrow_left_drop_circle_outline",
+	"arrow_left_right",
+	"arrow_left_right_bold",
+	"arrow_left_right_bold_outline",
+	"arrow_left_thick",
+	"arrow_left_thin",
+	"arrow_left_thin_circle_outline",
+	"arrow_left_top",
+	"arrow_left_top_bold",
+	"arrow_projectile",
+	"arrow_projectile_multiple",
+	"arrow_right",
+	"arrow_right_bold",
+	"arrow_right_bold_box",
+	"arrow_right_bold_box_outline",
+	"arrow_right_bold_circle",
+	"arrow_right_bold_circle_outline",
+	"arrow_right_bold_hexagon_outline",
+	"arrow_right_bold_outline",
+	"arrow_right_bottom",
+	"arrow_right_bottom_bold",
+	"arrow_right_box",
+	"arrow_right_circle",
+	"arrow_right_circle_outline",
+	"arrow_right_drop_circle",
+	"arrow_right_drop_circle_outline",
+	"arrow_right_thick",
+	"arrow_right_thin",
+	"arrow_right_thin_circle_outline",
+	"arrow_right_top",
+	"arrow_right_top_bold",
+	"arrow_split_horizontal",
+	"arrow_split_vertical",
+	"arrow_top_left",
+	"arrow_top_left_bold_box",
+	"arrow_top_left_bold_box_outline",
+	"arrow_top_left_bold_outline",
+	"arrow_top_left_bottom_right",
+	"arrow_top_left_bottom_right_bold",
+	"arrow_top_left_thick",
+	"arrow_top_left_thin",
+	"arrow_top_left_thin_circle_outline",
+	"arrow_top_right",
+	"arrow_top_right_bold_box",
+	"arrow_top_right_bold_box_outline",
+	"arrow_top_right_bold_outline",
+	"arrow_top_right_bottom_left",
+	"arrow_top_right_bottom_left_bold",
+	"arrow_top_right_thick",
+	"arrow_top_right_thin",
+	"arrow_top_right_thin_circle_outline",
+	"arrow_u_down_left",
+	"arrow_u_down_left_bold",
+	"arrow_u_down_right",
+	"arrow_u_down_right_bold",
+	"arrow_u_left_bottom",
+	"arrow_u_left_bottom_bold",
+	"arrow_u_left_top",
+	"arrow_u_left_top_bold",
+	"arrow_u_right_bottom",
+	"arrow_u_right_bottom_bold",
+	"arrow_u_right_top",
+	"arrow_u_right_top_bold",
+	"arrow_u_up_left",
+	"arrow_u_up_left_bold",
+	"arrow_u_up_right",
+	"arrow_u_up_right_bold",
+	"arrow_up",
+	"arrow_up_bold",
+	"arrow_up_bold_box",
+	"arrow_up_bold_box_outline",
+	"arrow_up_bold_circle",
+	"arrow_up_bold_circle_outline",
+	"arrow_up_bold_hexagon_outline",
+	"arrow_up_bold_outline",
+	"arrow_up_box",
+	"arrow_up_circle",
+	"arrow_up_circle_outline",
+	"arrow_up_down",
+	"arrow_up_down_bold",
+	"arrow_up_down_bold_outline",
+	"arrow_up_drop_circle",
+	"arrow_up_drop_circle_outline",
+	"arrow_up_left",
+	"arrow_up_left_bold",
+	"arrow_up_right",
+	"arrow_up_right_bold",
+	"arrow_up_thick",
+	"arrow_up_thin",
+	"arrow_up_thin_circle_outline",
+	"arrow_vertical_lock",
+	"artstation",
+	"aspect_ratio",
+	"assistant",
+	"asterisk",
+	"asterisk_circle_outline",
+	"at",
+	"atlassian",
+	"atm",
+	"atom",
+	"atom_variant",
+	"attachment",
+	"attachment_check",
+	"attachment_lock",
+	"attachment_minus",
+	"attachment_off",
+	"attachment_plus",
+	"attachment_remove",
+	"audio_input_rca",
+	"audio_input_stereo_minijack",
+	"audio_input_xlr",
+	"audio_video",
+	"audio_video_off",
+	"augmented_reality",
+	"auto_download",
+	"auto_fix",
+	"auto_upload",
+	"autorenew",
+	"autorenew_off",
+	"av_timer",
+	"aws",
+	"axe",
+	"axe_battle",
+	"axis",
+	"axis_arrow",
+	"axis_arrow_info",
+	"axis_arrow_lock",
+	"axis_lock",
+	"axis_x_arrow",
+	"axis_x_arrow_lock",
+	"axis_x_rotate_clockwise",
+	"axis_x_rotate_counterclockwise",
+	"axis_x_y_arrow_lock",
+	"axis_y_arrow",
+	"axis_y_arrow_lock",
+	"axis_y_rotate_clockwise",
+	"axis_y_rotate_counterclockwise",
+	"axis_z_arrow",
+	"axis_z_arrow_lock",
+	"axis_z_rotate_clockwise",
+	"axis_z_rotate_counterclockwise",
+	"babel",
+	"baby",
+	"baby_bottle",
+	"baby_bottle_outline",
+	"baby_buggy",
+	"baby_carriage",
+	"baby_carriage_off",
+	"baby_face",
+	"baby_face_outline",
+	"backburger",
+	"backspace",
+	"backspace_outline",
+	"backspace_reverse",
+	"backspace_reverse_outline",
+	"backup_restore",
+	"bacteria",
+	"bacteria_outline",
+	"badge_account",
+	"badge_account_alert",
+	"badge_account_alert_outline",
+	"badge_account_horizontal",
+	"badge_account_horizontal_outline",
+	"badge_account_outline",
+	"badminton",
+	"bag_carry_on",
+	"bag_carry_on_check",
+	"bag_carry_on_off",
+	"bag_checked",
+	"bag_personal",
+	"bag_personal_off",
+	"bag_personal_off_outline",
+	"bag_personal_outline",
+	"bag_suitcase",
+	"bag_suitcase_off",
+	"bag_suitcase_off_outline",
+	"bag_suitcase_outline",
+	"baguette",
+	"balcony",
+	"balloon",
+	"ballot",
+	"ballot_outline",
+	"ballot_recount",
+	"ballot_recount_outline",
+	"bandage",
+	"bank",
+	"bank_check",
+	"bank_minus",
+	"bank_off",
+	"bank_off_outline",
+	"bank_outline",
+	"bank_plus",
+	"bank_remove",
+	"bank_transfer",
+	"bank_transfer_in",
+	"bank_transfer_out",
+	"barcode",
+	"barcode_off",
+	"barcode_scan",
+	"barley",
+	"barley_off",
+	"barn",
+	"barrel",
+	"barrel_outline",
+	"baseball",
+	"baseball_bat",
+	"baseball_diamond",
+	"baseball_diamond_outline",
+	"bash",
+	"basket",
+	"basket_check",
+	"basket_check_outline",
+	"basket_fill",
+	"basket_minus",
+	"basket_minus_outline",
+	"basket_off",
+	"basket_off_outline",
+	"basket_outline",
+	"basket_plus",
+	"basket_plus_outline",
+	"basket_remove",
+	"basket_remove_outline",
+	"basket_unfill",
+	"basketball",
+	"basketball_hoop",
+	"basketball_hoop_outline",
+	"bat",
+	"bathtub",
+	"bathtub_outline",
+	"battery",
+	"battery_10",
+	"battery_10_bluetooth",
+	"battery_20",
+	"battery_20_bluetooth",
+	"battery_30",
+	"battery_30_bluetooth",
+	"battery_40",
+	"battery_40_bluetooth",
+	"battery_50",
+	"battery_50_bluetooth",
+	"battery_60",
+	"battery_60_bluetooth",
+	"battery_70",
+	"battery_70_bluetooth",
+	"battery_80",
+	"battery_80_bluetooth",
+	"battery_90",
+	"battery_90_bluetooth",
+	"battery_alert",
+	"battery_alert_bluetooth",
+	"battery_alert_variant",
+	"battery_alert_variant_outline",
+	"battery_arrow_down",
+	"battery_arrow_down_outline",
+	"battery_arrow_up",
+	"battery_arrow_up_outline",
+	"battery_bluetooth",
+	"battery_bluetooth_variant",
+	"battery_charging",
+	"battery_charging_10",
+	"battery_charging_100",
+	"battery_charging_20",
+	"battery_charging_30",
+	"battery_charging_40",
+	"battery_charging_50",
+	"battery_charging_60",
+	"battery_charging_70",
+	"battery_charging_80",
+	"battery_charging_90",
+	"battery_charging_high",
+	"battery_charging_low",
+	"battery_charging_medium",
+	"battery_charging_outline",
+	"battery_charging_wireless",
+	"battery_charging_wireless_10",
+	"battery_charging_wireless_20",
+	"battery_charging_wireless_30",
+	"battery_charging_wireless_40",
+	"battery_charging_wireless_50",
+	"battery_charging_wireless_60",
+	"battery_charging_wireless_70",
+	"battery_charging_wireless_80",
+	"battery_charging_wireless_90",
+	"battery_charging_wireless_alert",
+	"battery_charging_wireless_outline",
+	"battery_check",
+	"battery_check_outline",
+	"battery_clock",
+	"battery_clock_outline",
+	"battery_heart",
+	"battery_heart_outline",
+	"battery_heart_variant",
+	"battery_high",
+	"battery_lock",
+	"battery_lock_open",
+	"battery_low",
+	"battery_medium",
+	"battery_minus",
+	"battery_minus_outline",
+	"battery_minus_variant",
+	"battery_negative",
+	"battery_off",
+	"battery_off_outline",
+	"battery_outline",
+	"battery_plus",
+	"battery_plus_outline",
+	"battery_plus_variant",
+	"battery_positive",
+	"battery_remove",
+	"battery_remove_outline",
+	"battery_sync",
+	"battery_sync_outline",
+	"battery_unknown",
+	"battery_unknown_bluetooth",
+	"beach",
+	"beaker",
+	"beaker_alert",
+	"beaker_alert_outline",
+	"beaker_check",
+	"beaker_check_outline",
+	"beaker_minus",
+	"beaker_minus_outline",
+	"beaker_outline",
+	"beaker_plus",
+	"beaker_plus_outline",
+	"beaker_question",
+	"beaker_question_outline",
+	"beaker_remove",
+	"beaker_remove_outline",
+	"bed",
+	"bed_double",
+	"bed_double_outline",
+	"bed_empty",
+	"bed_king",
+	"bed_king_outline",
+	"bed_outline",
+	"bed_queen",
+	"bed_queen_outline",
+	"bed_single",
+	"bed_single_outline",
+	"bee",
+	"bee_flower",
+	"beehive_off_outline",
+	"beehive_outline",
+	"beekeeper",
+	"beer",
+	"beer_outline",
+	"bell",
+	"bell_alert",
+	"bell_alert_outline",
+	"bell_badge",
+	"bell_badge_outline",
+	"bell_cancel",
+	"bell_cancel_outline",
+	"bell_check",
+	"bell_check_outline",
+	"bell_circle",
+	"bell_circle_outline",
+	"bell_cog",
+	"bell_cog_outline",
+	"bell_minus",
+	"bell_minus_outline",
+	"bell_off",
+	"bell_off_outline",
+	"bell_outline",
+	"bell_plus",
+	"bell_plus_outline",
+	"bell_remove",
+	"bell_remove_outline",
+	"bell_ring",
+	"bell_ring_outline",
+	"bell_sleep",
+	"bell_sleep_outline",
+	"beta",
+	"betamax",
+	"biathlon",
+	"bicycle",
+	"bicycle_basket",
+	"bicycle_cargo",
+	"bicycle_electric",
+	"bicycle_penny_farthing",
+	"bike",
+	"bike_fast",
+	"billboard",
+	"billiards",
+	"billiards_rack",
+	"binoculars",
+	"bio",
+	"biohazard",
+	"bird",
+	"bitbucket",
+	"bitcoin",
+	"black_mesa",
+	"blender",
+	"blender_outline",
+	"blender_software",
+	"blinds",
+	"blinds_horizontal",
+	"blinds_horizontal_closed",
+	"blinds_open",
+	"blinds_vertical",
+	"blinds_vertical_closed",
+	"block_helper",
+	"blood_bag",
+	"bluetooth",
+	"bluetooth_audio",
+	"bluetooth_connect",
+	"bluetooth_off",
+	"bluetooth_settings",
+	"bluetooth_transfer",
+	"blur",
+	"blur_linear",
+	"blur_off",
+	"blur_radial",
+	"bolt",
+	"bomb",
+	"bomb_off",
+	"bone",
+	"bone_off",
+	"book",
+	"book_account",
+	"book_account_outline",
+	"book_alert",
+	"book_alert_outline",
+	"book_alphabet",
+	"book_arrow_down",
+	"book_arrow_down_outline",
+	"book_arrow_left",
+	"book_arrow_left_outline",
+	"book_arrow_right",
+	"book_arrow_right_outline",
+	"book_arrow_up",
+	"book_arrow_up_outline",
+	"book_cancel",
+	"book_cancel_outline",
+	"book_check",
+	"book_check_outline",
+	"book_clock",
+	"book_clock_outline",
+	"book_cog",
+	"book_cog_outline",
+	"book_cross",
+	"book_edit",
+	"book_edit_outline",
+	"book_education",
+	"book_education_outline",
+	"book_heart",
+	"book_heart_outline",
+	"book_information_variant",
+	"book_lock",
+	"book_lock_open",
+	"book_lock_open_outline",
+	"book_lock_outline",
+	"book_marker",
+	"book_marker_outline",
+	"book_minus",
+	"book_minus_multiple",
+	"book_minus_multiple_outline",
+	"book_minus_outline",
+	"book_multiple",
+	"book_multiple_outline",
+	"book_music",
+	"book_music_outline",
+	"book_off",
+	"book_off_outline",
+	"book_open",
+	"book_open_blank_variant",
+	"book_open_outline",
+	"book_open_page_variant",
+	"book_open_page_variant_outline",
+	"book_open_variant",
+	"book_outline",
+	"book_play",
+	"book_play_outline",
+	"book_plus",
+	"book_plus_multiple",
+	"book_plus_multiple_outline",
+	"book_plus_outline",
+	"book_refresh",
+	"book_refresh_outline",
+	"book_remove",
+	"book_remove_multiple",
+	"book_remove_multiple_outline",
+	"book_remove_outline",
+	"book_search",
+	"book_search_outline",
+	"book_settings",
+	"book_settings_outline",
+	"book_sync",
+	"book_sync_outline",
+	"book_variant",
+	"book_variant_multiple",
+	"bookmark",
+	"bookmark_box_multiple",
+	"bookmark_box_multiple_outline",
+	"bookmark_check",
+	"bookmark_check_outline",
+	"bookmark_minus",
+	"bookmark_minus_outline",
+	"bookmark_multiple",
+	"bookmark_multiple_outline",
+	"bookmark_music",
+	"bookmark_music_outline",
+	"bookmark_off",
+	"bookmark_off_outline",
+	"bookmark_outline",
+	"bookmark_plus",
+	"bookmark_plus_outline",
+	"bookmark_remove",
+	"bookmark_remove_outline",
+	"bookshelf",
+	"boom_gate",
+	"boom_gate_alert",
+	"boom_gate_alert_outline",
+	"boom_gate_arrow_down",
+	"boom_gate_arrow_down_outline",
+	"boom_gate_arrow_up",
+	"boom_gate_arrow_up_outline",
+	"boom_gate_outline",
+	"boom_gate_up",
+	"boom_gate_up_outline",
+	"boombox",
+	"boomerang",
+	"bootstrap",
+	"border_all",
+	"border_all_variant",
+	"border_bottom",
+	"border_bottom_variant",
+	"border_color",
+	"border_horizontal",
+	"border_inside",
+	"border_left",
+	"border_left_variant",
+	"border_none",
+	"border_none_variant",
+	"border_outside",
+	"border_right",
+	"border_right_variant",
+	"border_style",
+	"border_top",
+	"border_top_variant",
+	"border_vertical",
+	"bottle_soda",
+	"bottle_soda_classic",
+	"bottle_soda_classic_outline",
+	"bottle_soda_outline",
+	"bottle_tonic",
+	"bottle_tonic_outline",
+	"bottle_tonic_plus",
+	"bottle_tonic_plus_outline",
+	"bottle_tonic_skull",
+	"bottle_tonic_skull_outline",
+	"bottle_wine",
+	"bottle_wine_outline",
+	"bow_arrow",
+	"bow_tie",
+	"bowl",
+	"bowl_mix",
+	"bowl_mix_outline",
+	"bowl_outline",
+	"bowling",
+	"box",
+	"box_cutter",
+	"box_cutter_off",
+	"box_shadow",
+	"boxing_glove",
+	"braille",
+	"brain",
+	"bread_slice",
+	"bread_slice_outline",
+	"bridge",
+	"briefcase",
+	"briefcase_account",
+	"briefcase_account_outline",
+	"briefcase_arrow_left_right",
+	"briefcase_arrow_left_right_outline",
+	"briefcase_arrow_up_down",
+	"briefcase_arrow_up_down_outline",
+	"briefcase_check",
+	"briefcase_check_outline",
+	"briefcase_clock",
+	"briefcase_clock_outline",
+	"briefcase_download",
+	"briefcase_download_outline",
+	"briefcase_edit",
+	"briefcase_edit_outline",
+	"briefcase_eye",
+	"briefcase_eye_outline",
+	"briefcase_minus",
+	"briefcase_minus_outline",
+	"briefcase_off",
+	"briefcase_off_outline",
+	"briefcase_outline",
+	"briefcase_plus",
+	"briefcase_plus_outline",
+	"briefcase_remove",
+	"briefcase_remove_outline",
+	"briefcase_search",
+	"briefcase_search_outline",
+	"briefcase_upload",
+	"briefcase_upload_outline",
+	"briefcase_variant",
+	"briefcase_variant_off",
+	"briefcase_variant_off_outline",
+	"briefcase_variant_outline",
+	"brightness_1",
+	"brightness_2",
+	"brightness_3",
+	"brightness_4",
+	"brightness_5",
+	"brightness_6",
+	"brightness_7",
+	"brightness_auto",
+	"brightness_percent",
+	"broadcast",
+	"broadcast_off",
+	"broom",
+	"brush",
+	"brush_off",
+	"brush_outline",
+	"brush_variant",
+	"bucket",
+	"bucket_outline",
+	"buffet",
+	"bug",
+	"bug_check",
+	"bug_check_outline",
+	"bug_outline",
+	"bugle",
+	"bulkhead_light",
+	"bulldozer",
+	"bullet",
+	"bulletin_board",
+	"bullhorn",
+	"bullhorn_outline",
+	"bullhorn_variant",
+	"bullhorn_variant_outline",
+	"bullseye",
+	"bullseye_arrow",
+	"bulma",
+	"bunk_bed",
+	"bunk_bed_outline",
+	"bus",
+	"bus_alert",
+	"bus_articulated_end",
+	"bus_articulated_front",
+	"bus_clock",
+	"bus_double_decker",
+	"bus_electric",
+	"bus_marker",
+	"bus_multiple",
+	"bus_school",
+	"bus_side",
+	"bus_stop",
+	"bus_stop_covered",
+	"bus_stop_uncovered",
+	"butterfly",
+	"butterfly_outline",
+	"cabin_a_frame",
+	"cable_data",
+	"cached",
+	"cactus",
+	"cake",
+	"cake_layered",
+	"cake_variant",
+	"cake_variant_outline",
+	"calculator",
+	"calculator_variant",
+	"calculator_variant_outline",
+	"calendar",
+	"calendar_account",
+	"calendar_account_outline",
+	"calendar_alert",
+	"calendar_arrow_left",
+	"calendar_arrow_right",
+	"calendar_blank",
+	"calendar_blank_multiple",
+	"calendar_blank_outline",
+	"calendar_check",
+	"calendar_check_outline",
+	"calendar_clock",
+	"calendar_clock_outline",
+	"calendar_collapse_horizontal",
+	"calendar_cursor",
+	"calendar_edit",
+	"calendar_end",
+	"calendar_expand_horizontal",
+	"calendar_export",
+	"calendar_heart",
+	"calendar_import",
+	"calendar_lock",
+	"calendar_lock_outline",
+	"calendar_minus",
+	"calendar_month",
+	"calendar_month_outline",
+	"calendar_multiple",
+	"calendar_multiple_check",
+	"calendar_multiselect",
+	"calendar_outline",
+	"calendar_plus",
+	"calendar_question",
+	"calendar_range",
+	"calendar_range_outline",
+	"calendar_refresh",
+	"calendar_refresh_outline",
+	"calendar_remove",
+	"calendar_remove_outline",
+	"calendar_search",
+	"calendar_star",
+	"calendar_start",
+	"calendar_sync",
+	"calendar_sync_outline",
+	"calendar_text",
+	"calendar_text_outline",
+	"calendar_today",
+	"calendar_today_outline",
+	"calendar_week",
+	"calendar_week_begin",
+	"calendar_week_begin_outline",
+	"calendar_week_end",
+	"calendar_week_end_outline",
+	"calendar_week_outline",
+	"calendar_weekend",
+	"calendar_weekend_outline",
+	"call_made",
+	"call_merge",
+	"call_missed",
+	"call_received",
+	"call_split",
+	"camcorder",
+	"camcorder_off",
+	"camera",
+	"camera_account",
+	"camera_burst",
+	"camera_control",
+	"camera_document",
+	"camera_document_off",
+	"camera_enhance",
+	"camera_enhance_outline",
+	"camera_flip",
+	"camera_flip_outline",
+	"camera_front",
+	"camera_front_variant",
+	"camera_gopro",
+	"camera_image",
+	"camera_iris",
+	"camera_lock",
+	"camera_lock_outline",
+	"camera_marker",
+	"camera_marker_outline",
+	"camera_metering_center",
+	"camera_metering_matrix",
+	"camera_metering_partial",
+	"camera_metering_spot",
+	"camera_off",
+	"camera_off_outline",
+	"camera_outline",
+	"camera_party_mode",
+	"camera_plus",
+	"camera_plus_outline",
+	"camera_rear",
+	"camera_rear_variant",
+	"camera_retake",
+	"camera_retake_outline",
+	"camera_switch",
+	"camera_switch_outline",
+	"camera_timer",
+	"camera_wireless",
+	"camera_wireless_outline",
+	"campfire",
+	"cancel",
+	"candelabra",
+	"candelabra_fire",
+	"candle",
+	"candy",
+	"candy_off",
+	"candy_off_outline",
+	"candy_outline",
+	"candycane",
+	"cannabis",
+	"cannabis_off",
+	"caps_lock",
+	"car",
+	"car_2_plus",
+	"car_3_plus",
+	"car_arrow_left",
+	"car_arrow_right",
+	"car_back",
+	"car_battery",
+	"car_brake_abs",
+	"car_brake_alert",
+	"car_brake_fluid_level",
+	"car_brake_hold",
+	"car_brake_low_pressure",
+	"car_brake_parking",
+	"car_brake_retarder",
+	"car_brake_temperature",
+	"car_brake_worn_linings",
+	"car_child_seat",
+	"car_clock",
+	"car_clutch",
+	"car_cog",
+	"car_connected",
+	"car_convertible",
+	"car_coolant_level",
+	"car_cruise_control",
+	"car_defrost_front",
+	"car_defrost_rear",
+	"car_door",
+	"car_door_lock",
+	"car_electric",
+	"car_electric_outline",
+	"car_emergency",
+	"car_esp",
+	"car_estate",
+	"car_hatchback",
+	"car_info",
+	"car_key",
+	"car_lifted_pickup",
+	"car_light_alert",
+	"car_light_dimmed",
+	"car_light_fog",
+	"car_light_high",
+	"car_limousine",
+	"car_multiple",
+	"car_off",
+	"car_outline",
+	"car_parking_lights",
+	"car_pickup",
+	"car_seat",
+	"car_seat_cooler",
+	"car_seat_heater",
+	"car_select",
+	"car_settings",
+	"car_shift_pattern",
+	"car_side",
+	"car_speed_limiter",
+	"car_sports",
+	"car_tire_alert",
+	"car_traction_control",
+	"car_turbocharger",
+	"car_wash",
+	"car_windshield",
+	"car_windshield_outline",
+	"car_wireless",
+	"car_wrench",
+	"carabiner",
+	"caravan",
+	"card",
+	"card_account_details",
+	"card_account_details_outline",
+	"card_account_details_star",
+	"card_account_details_star_outline",
+	"card_account_mail",
+	"card_account_mail_outline",
+	"card_account_phone",
+	"card_account_phone_outline",
+	"card_bulleted",
+	"card_bulleted_off",
+	"card_bulleted_off_outline",
+	"card_bulleted_outline",
+	"card_bulleted_settings",
+	"card_bulleted_settings_outline",
+	"card_minus",
+	"card_minus_outline",
+	"card_multiple",
+	"card_multiple_outline",
+	"card_off",
+	"card_off_outline",
+	"card_outline",
+	"card_plus",
+	"card_plus_outline",
+	"card_remove",
+	"card_remove_outline",
+	"card_search",
+	"card_search_outline",
+	"card_text",
+	"card_text_outline",
+	"cards",
+	"cards_club",
+	"cards_club_outline",
+	"cards_diamond",
+	"cards_diamond_outline",
+	"cards_outline",
+	"cards_playing",
+	"cards_playing_club",
+	"cards_playing_club_multiple",
+	"cards_playing_club_multiple_outline",
+	"cards_playing_club_outline",
+	"cards_playing_diamond",
+	"cards_playing_diamond_multiple",
+	"cards_playing_diamond_multiple_outline",
+	"cards_playing_diamond_outline",
+	"cards_playing_heart",
+	"cards_playing_heart_multiple",
+	"cards_playing_heart_multiple_outline",
+	"cards_playing_heart_outline",
+	"cards_playing_outline",
+	"cards_playing_spade",
+	"cards_playing_spade_multiple",
+	"cards_playing_spade_multiple_outline",
+	"cards_playing_spade_outline",
+	"cards_spade",
+	"cards_spade_outline",
+	"cards_variant",
+	"carrot",
+	"cart",
+	"cart_arrow_down",
+	"cart_arrow_right",
+	"cart_arrow_up",
+	"cart_check",
+	"cart_heart",
+	"cart_minus",
+	"cart_off",
+	"cart_outline",
+	"cart_plus",
+	"cart_remove",
+	"cart_variant",
+	"case_sensitive_alt",
+	"cash",
+	"cash_100",
+	"cash_check",
+	"cash_clock",
+	"cash_fast",
+	"cash_lock",
+	"cash_lock_open",
+	"cash_marker",
+	"cash_minus",
+	"cash_multiple",
+	"cash_plus",
+	"cash_refund",
+	"cash_register",
+	"cash_remove",
+	"cash_sync",
+	"cassette",
+	"cast",
+	"cast_audio",
+	"cast_audio_variant",
+	"cast_connected",
+	"cast_education",
+	"cast_off",
+	"cast_variant",
+	"castle",
+	"cat",
+	"cctv",
+	"cctv_off",
+	"ceiling_fan",
+	"ceiling_fan_light",
+	"ceiling_light",
+	"ceiling_light_multiple",
+	"ceiling_light_multiple_outline",
+	"ceiling_light_outline",
+	"cellphone",
+	"cellphone_arrow_down",
+	"cellphone_arrow_down_variant",
+	"cellphone_basic",
+	"cellphone_charging",
+	"cellphone_check",
+	"cellphone_cog",
+	"cellphone_dock",
+	"cellphone_information",
+	"cellphone_key",
+	"cellphone_link",
+	"cellphone_link_off",
+	"cellphone_lock",
+	"cellphone_marker",
+	"cellphone_message",
+	"cellphone_message_off",
+	"cellphone_nfc",
+	"cellphone_nfc_off",
+	"cellphone_off",
+	"cellphone_play",
+	"cellphone_remove",
+	"cellphone_screenshot",
+	"cellphone_settings",
+	"cellphone_sound",
+	"cellphone_text",
+	"cellphone_wireless",
+	"centos",
+	"certificate",
+	"certificate_outline",
+	"chair_rolling",
+	"chair_school",
+	"chandelier",
+	"charity",
+	"chart_arc",
+	"chart_areaspline",
+	"chart_areaspline_variant",
+	"chart_bar",
+	"chart_bar_stacked",
+	"chart_bell_curve",
+	"chart_bell_curve_cumulative",
+	"chart_box",
+	"chart_box_outline",
+	"chart_box_plus_outline",
+	"chart_bubble",
+	"chart_donut",
+	"chart_donut_variant",
+	"chart_gantt",
+	"chart_histogram",
+	"chart_line",
+	"chart_line_stacked",
+	"chart_line_variant",
+	"chart_multiline",
+	"chart_multiple",
+	"chart_pie",
+	"chart_ppf",
+	"chart_sankey",
+	"chart_sankey_variant",
+	"chart_scatter_plot",
+	"chart_scatter_plot_hexbin",
+	"chart_timeline",
+	"chart_timeline_variant",
+	"chart_timeline_variant_shimmer",
+	"chart_tree",
+	"chart_waterfall",
+	"chat",
+	"chat_alert",
+	"chat_alert_outline",
+	"chat_minus",
+	"chat_minus_outline",
+	"chat_outline",
+	"chat_plus",
+	"chat_plus_outline",
+	"chat_processing",
+	"chat_processing_outline",
+	"chat_question",
+	"chat_question_outline",
+	"chat_remove",
+	"chat_remove_outline",
+	"chat_sleep",
+	"chat_sleep_outline",
+	"check",
+	"check_all",
+	"check_bold",
+	"check_circle",
+	"check_circle_outline",
+	"check_decagram",
+	"check_decagram_outline",
+	"check_network",
+	"check_network_outline",
+	"check_outline",
+	"check_underline",
+	"check_underline_circle",
+	"check_underline_circle_outline",
+	"checkbook",
+	"checkbox_blank",
+	"checkbox_blank_badge",
+	"checkbox_blank_badge_outline",
+	"checkbox_blank_circle",
+	"checkbox_blank_circle_outline",
+	"checkbox_blank_off",
+	"checkbox_blank_off_outline",
+	"checkbox_blank_outline",
+	"checkbox_intermediate",
+	"checkbox_marked",
+	"checkbox_marked_circle",
+	"checkbox_marked_circle_outline",
+	"checkbox_marked_circle_plus_outline",
+	"checkbox_marked_outline",
+	"checkbox_multiple_blank",
+	"checkbox_multiple_blank_circle",
+	"checkbox_multiple_blank_circle_outline",
+	"checkbox_multiple_blank_outline",
+	"checkbox_multiple_marked",
+	"checkbox_multiple_marked_circle",
+	"checkbox_multiple_marked_circle_outline",
+	"checkbox_multiple_marked_outline",
+	"checkbox_multiple_outline",
+	"checkbox_outline",
+	"checkerboard",
+	"checkerboard_minus",
+	"checkerboard_plus",
+	"checkerboard_remove",
+	"cheese",
+	"cheese_off",
+	"chef_hat",
+	"chemical_weapon",
+	"chess_bishop",
+	"chess_king",
+	"chess_knight",
+	"chess_pawn",
+	"chess_queen",
+	"chess_rook",
+	"chevron_double_down",
+	"chevron_double_left",
+	"chevron_double_right",
+	"chevron_double_up",
+	"chevron_down",
+	"chevron_down_box",
+	"chevron_down_box_outline",
+	"chevron_down_circle",
+	"chevron_down_circle_outline",
+	"chevron_left",
+	"chevron_left_box",
+	"chevron_left_box_outline",
+	"chevron_left_circle",
+	"chevron_left_circle_outline",
+	"chevron_right",
+	"chevron_right_box",
+	"chevron_right_box_outline",
+	"chevron_right_circle",
+	"chevron_right_circle_outline",
+	"chevron_triple_down",
+	"chevron_triple_left",
+	"chevron_triple_right",
+	"chevron_triple_up",
+	"chevron_up",
+	"chevron_up_box",
+	"chevron_up_box_outline",
+	"chevron_up_circle",
+	"chevron_up_circle_outline",
+	"chili_alert",
+	"chili_alert_outline",
+	"chili_hot",
+	"chili_hot_outline",
+	"chili_medium",
+	"chili_medium_outline",
+	"chili_mild",
+	"chili_mild_outline",
+	"chili_off",
+	"chili_off_outline",
+	"chip",
+	"church",
+	"cigar",
+	"cigar_off",
+	"circle_box",
+	"circle_box_outline",
+	"circle_double",
+	"circle_edit_outline",
+	"circle_expand",
+	"circle_half",
+	"circle_half_full",
+	"circle_medium",
+	"circle_multiple",
+	"circle_multiple_outline",
+	"circle_off_outline",
+	"circle_opacity",
+	"circle_slice_1",
+	"circle_slice_2",
+	"circle_slice_3",
+	"circle_slice_4",
+	"circle_slice_5",
+	"circle_slice_6",
+	"circle_slice_7",
+	"circle_slice_8",
+	"circle_small",
+	"circular_saw",
+	"city",
+	"city_variant",
+	"city_variant_outline",
+	"clipboard",
+	"clipboard_account",
+	"clipboard_account_outline",
+	"clipboard_alert",
+	"clipboard_alert_outline",
+	"clipboard_arrow_down",
+	"clipboard_arrow_down_outline",
+	"clipboard_arrow_left",
+	"clipboard_arrow_left_outline",
+	"clipboard_arrow_right",
+	"clipboard_arrow_right_outline",
+	"clipboard_arrow_up",
+	"clipboard_arrow_up_outline",
+	"clipboard_check",
+	"clipboard_check_multiple",
+	"clipboard_check_multiple_outline",
+	"clipboard_check_outline",
+	"clipboard_clock",
+	"clipboard_clock_outline",
+	"clipboard_edit",
+	"clipboard_edit_outline",
+	"clipboard_file",
+	"clipboard_file_outline",
+	"clipboard_flow",
+	"clipboard_flow_outline",
+	"clipboard_list",
+	"clipboard_list_outline",
+	"clipboard_minus",
+	"clipboard_minus_outline",
+	"clipboard_multiple",
+	"clipboard_multiple_outline",
+	"clipboard_off",
+	"clipboard_off_outline",
+	"clipboard_outline",
+	"clipboard_play",
+	"clipboard_play_multiple",
+	"clipboard_play_multiple_outline",
+	"clipboard_play_outline",
+	"clipboard_plus",
+	"clipboard_plus_outline",
+	"clipboard_pulse",
+	"clipboard_pulse_outline",
+	"clipboard_remove",
+	"clipboard_remove_outline",
+	"clipboard_search",
+	"clipboard_search_outline",
+	"clipboard_text",
+	"clipboard_text_clock",
+	"clipboard_text_clock_outline",
+	"clipboard_text_multiple",
+	"clipboard_text_multiple_outline",
+	"clipboard_text_off",
+	"clipboard_text_off_outline",
+	"clipboard_text_outline",
+	"clipboard_text_play",
+	"clipboard_text_play_outline",
+	"clipboard_text_search",
+	"clipboard_text_search_outline",
+	"clippy",
+	"clock",
+	"clock_alert",
+	"clock_alert_outline",
+	"clock_check",
+	"clock_check_outline",
+	"clock_digital",
+	"clock_edit",
+	"clock_edit_outline",
+	"clock_end",
+	"clock_fast",
+	"clock_in",
+	"clock_minus",
+	"clock_minus_outline",
+	"clock_out",
+	"clock_outline",
+	"clock_plus",
+	"clock_plus_outline",
+	"clock_remove",
+	"clock_remove_outline",
+	"clock_start",
+	"clock_time_eight",
+	"clock_time_eight_outline",
+	"clock_time_eleven",
+	"clock_time_eleven_outline",
+	"clock_time_five",
+	"clock_time_five_outline",
+	"clock_time_four",
+	"clock_time_four_outline",
+	"clock_time_nine",
+	"clock_time_nine_outline",
+	"clock_time_one",
+	"clock_time_one_outline",
+	"clock_time_seven",
+	"clock_time_seven_outline",
+	"clock_time_six",
+	"clock_time_six_outline",
+	"clock_time_ten",
+	"clock_time_ten_outline",
+	"clock_time_three",
+	"clock_time_three_outline",
+	"clock_time_twelve",
+	"clock_time_twelve_outline",
+	"clock_time_two",
+	"clock_time_two_outline",
+	"close",
+	"close_box",
+	"close_box_multiple",
+	"close_box_multiple_outline",
+	"close_box_outline",
+	"close_circle",
+	"close_circle_multiple",
+	"close_circle_multiple_outline",
+	"close_circle_outline",
+	"close_network",
+	"close_network_outline",
+	"close_octagon",
+	"close_octagon_outline",
+	"close_outline",
+	"close_thick",
+	"closed_caption",
+	"closed_caption_outline",
+	"cloud",
+	"cloud_alert",
+	"cloud_braces",
+	"cloud_check",
+	"cloud_check_outline",
+	"cloud_circle",
+	"cloud_download",
+	"cloud_download_outline",
+	"cloud_lock",
+	"cloud_lock_outline",
+	"cloud_off_outline",
+	"cloud_outline",
+	"cloud_percent",
+	"cloud_percent_outline",
+	"cloud_print",
+	"cloud_print_outline",
+	"cloud_question",
+	"cloud_refresh",
+	"cloud_search",
+	"cloud_search_outline",
+	"cloud_sync",
+	"cloud_sync_outline",
+	"cloud_tags",
+	"cloud_upload",
+	"cloud_upload_outline",
+	"clover",
+	"coach_lamp",
+	"coach_lamp_variant",
+	"coat_rack",
+	"code_array",
+	"code_braces",
+	"code_braces_box",
+	"code_brackets",
+	"code_equal",
+	"code_greater_than",
+	"code_greater_than_or_equal",
+	"code_json",
+	"code_less_than",
+	"code_less_than_or_equal",
+	"code_not_equal",
+	"code_not_equal_variant",
+	"code_parentheses",
+	"code_parentheses_box",
+	"code_string",
+	"code_tags",
+	"code_tags_check",
+	"codepen",
+	"coffee",
+	"coffee_maker",
+	"coffee_maker_check",
+	"coffee_maker_check_outline",
+	"coffee_maker_outline",
+	"coffee_off",
+	"coffee_off_outline",
+	"coffee_outline",
+	"coffee_to_go",
+	"coffee_to_go_outline",
+	"coffin",
+	"cog",
+	"cog_box",
+	"cog_clockwise",
+	"cog_counterclockwise",
+	"cog_off",
+	"cog_off_outline",
+	"cog_outline",
+	"cog_pause",
+	"cog_pause_outline",
+	"cog_play",
+	"cog_play_outline",
+	"cog_refresh",
+	"cog_refresh_outline",
+	"cog_stop",
+	"cog_stop_outline",
+	"cog_sync",
+	"cog_sync_outline",
+	"cog_transfer",
+	"cog_transfer_outline",
+	"cogs",
+	"collage",
+	"collapse_all",
+	"collapse_all_outline",
+	"color_helper",
+	"comma",
+	"comma_box",
+	"comma_box_outline",
+	"comma_circle",
+	"comma_circle_outline",
+	"comment",
+	"comment_account",
+	"comment_account_outline",
+	"comment_alert",
+	"comment_alert_outline",
+	"comment_arrow_left",
+	"comment_arrow_left_outline",
+	"comment_arrow_right",
+	"comment_arrow_right_outline",
+	"comment_bookmark",
+	"comment_bookmark_outline",
+	"comment_check",
+	"comment_check_outline",
+	"comment_edit",
+	"comment_edit_outline",
+	"comment_eye",
+	"comment_eye_outline",
+	"comment_flash",
+	"comment_flash_outline",
+	"comment_minus",
+	"comment_minus_outline",
+	"comment_multiple",
+	"comment_multiple_outline",
+	"comment_off",
+	"comment_off_outline",
+	"comment_outline",
+	"comment_plus",
+	"comment_plus_outline",
+	"comment_processing",
+	"comment_processing_outline",
+	"comment_question",
+	"comment_question_outline",
+	"comment_quote",
+	"comment_quote_outline",
+	"comment_remove",
+	"comment_remove_outline",
+	"comment_search",
+	"comment_search_outline",
+	"comment_text",
+	"comment_text_multiple",
+	"comment_text_multiple_outline",
+	"comment_text_outline",
+	"compare",
+	"compare_horizontal",
+	"compare_remove",
+	"compare_vertical",
+	"compass",
+	"compass_off",
+	"compass_off_outline",
+	"compass_outline",
+	"compass_rose",
+	"compost",
+	"cone",
+	"cone_off",
+	"connection",
+	"console",
+	"console_line",
+	"console_network",
+	"console_network_outline",
+	"consolidate",
+	"contactless_payment",
+	"contactless_payment_circle",
+	"contactless_payment_circle_outline",
+	"contacts",
+	"contacts_outline",
+	"contain",
+	"contain_end",
+	"contain_start",
+	"content_copy",
+	"content_cut",
+	"content_duplicate",
+	"content_paste",
+	"content_save",
+	"content_save_alert",
+	"content_save_alert_outline",
+	"content_save_all",
+	"content_save_all_outline",
+	"content_save_check",
+	"content_save_check_outline",
+	"content_save_cog",
+	"content_save_cog_outline",
+	"content_save_edit",
+	"content_save_edit_outline",
+	"content_save_move",
+	"content_save_move_outline",
+	"content_save_off",
+	"content_save_off_outline",
+	"content_save_outline",
+	"content_save_settings",
+	"content_save_settings_outline",
+	"contrast",
+	"contrast_box",
+	"contrast_circle",
+	"controller_classic",
+	"controller_classic_outline",
+	"cookie",
+	"cookie_alert",
+	"cookie_alert_outline",
+	"cookie_check",
+	"cookie_check_outline",
+	"cookie_clock",
+	"cookie_clock_outline",
+	"cookie_cog",
+	"cookie_cog_outline",
+	"cookie_edit",
+	"cookie_edit_outline",
+	"cookie_lock",
+	"cookie_lock_outline",
+	"cookie_minus",
+	"cookie_minus_outline",
+	"cookie_off",
+	"cookie_off_outline",
+	"cookie_outline",
+	"cookie_plus",
+	"cookie_plus_outline",
+	"cookie_refresh",
+	"cookie_refresh_outline",
+	"cookie_remove",
+	"cookie_remove_outline",
+	"cookie_settings",
+	"cookie_settings_outline",
+	"coolant_temperature",
+	"copyleft",
+	"copyright",
+	"cordova",
+	"corn",
+	"corn_off",
+	"cosine_wave",
+	"counter",
+	"countertop",
+	"countertop_outline",
+	"cow",
+	"cow_off",
+	"cpu_32_bit",
+	"cpu_64_bit",
+	"cradle",
+	"cradle_outline",
+	"crane",
+	"creation",
+	"creative_commons",
+	"credit_card",
+	"credit_card_check",
+	"credit_card_check_outline",
+	"credit_card_chip",
+	"credit_card_chip_outline",
+	"credit_card_clock",
+	"credit_card_clock_outline",
+	"credit_card_edit",
+	"credit_card_edit_outline",
+	"credit_card_fast",
+	"credit_card_fast_outline",
+	"credit_card_lock",
+	"credit_card_lock_outline",
+	"credit_card_marker",
+	"credit_card_marker_outline",
+	"credit_card_minus",
+	"credit_card_minus_outline",
+	"credit_card_multiple",
+	"credit_card_multiple_outline",
+	"credit_card_off",
+	"credit_card_off_outline",
+	"credit_card_outline",
+	"credit_card_plus",
+	"credit_card_plus_outline",
+	"credit_card_refresh",
+	"credit_card_refresh_outline",
+	"credit_card_refund",
+	"credit_card_refund_outline",
+	"credit_card_remove",
+	"credit_card_remove_outline",
+	"credit_card_scan",
+	"credit_card_scan_outline",
+	"credit_card_search",
+	"credit_card_search_outline",
+	"credit_card_settings",
+	"credit_card_settings_outline",
+	"credit_card_sync",
+	"credit_card_sync_outline",
+	"credit_card_wireless",
+	"credit_card_wireless_off",
+	"credit_card_wireless_off_outline",
+	"credit_card_wireless_outline",
+	"cricket",
+	"crop",
+	"crop_free",
+	"crop_landscape",
+	"crop_portrait",
+	"crop_rotate",
+	"crop_square",
+	"cross",
+	"cross_bolnisi",
+	"cross_celtic",
+	"cross_outline",
+	"crosshairs",
+	"crosshairs_gps",
+	"crosshairs_off",
+	"crosshairs_question",
+	"crowd",
+	"crown",
+	"crown_circle",
+	"crown_circle_outline",
+	"crown_outline",
+	"cryengine",
+	"crystal_ball",
+	"cube",
+	"cube_off",
+	"cube_off_outline",
+	"cube_outline",
+	"cube_scan",
+	"cube_send",
+	"cube_unfolded",
+	"cup",
+	"cup_off",
+	"cup_off_outline",
+	"cup_outline",
+	"cup_water",
+	"cupboard",
+	"cupboard_outline",
+	"cupcake",
+	"curling",
+	"currency_bdt",
+	"currency_brl",
+	"currency_btc",
+	"currency_cny",
+	"currency_eth",
+	"currency_eur",
+	"currency_eur_off",
+	"currency_fra",
+	"currency_gbp",
+	"currency_ils",
+	"currency_inr",
+	"currency_jpy",
+	"currency_krw",
+	"currency_kzt",
+	"currency_mnt",
+	"currency_ngn",
+	"currency_php",
+	"currency_rial",
+	"currency_rub",
+	"currency_rupee",
+	"currency_sign",
+	"currency_try",
+	"currency_twd",
+	"currency_usd",
+	"currency_usd_off",
+	"current_ac",
+	"current_dc",
+	"cursor_default",
+	"cursor_default_click",
+	"cursor_default_click_outline",
+	"cursor_default_gesture",
+	"cursor_default_gesture_outline",
+	"cursor_default_outline",
+	"cursor_move",
+	"cursor_pointer",
+	"cursor_text",
+	"curtains",
+	"curtains_closed",
+	"cylinder",
+	"cylinder_off",
+	"dance_ballroom",
+	"dance_pole",
+	"data_matrix",
+	"data_matrix_edit",
+	"data_matrix_minus",
+	"data_matrix_plus",
+	"data_matrix_remove",
+	"data_matrix_scan",
+	"database",
+	"database_alert",
+	"database_alert_outline",
+	"database_arrow_down",
+	"database_arrow_down_outline",
+	"database_arrow_left",
+	"database_arrow_left_outline",
+	"database_arrow_right",
+	"database_arrow_right_outline",
+	"database_arrow_up",
+	"database_arrow_up_outline",
+	"database_check",
+	"database_check_outline",
+	"database_clock",
+	"database_clock_outline",
+	"database_cog",
+	"database_cog_outline",
+	"database_edit",
+	"database_edit_outline",
+	"database_export",
+	"database_export_outline",
+	"database_eye",
+	"database_eye_off",
+	"database_eye_off_outline",
+	"database_eye_outline",
+	"database_import",
+	"database_import_outline",
+	"database_lock",
+	"database_lock_outline",
+	"database_marker",
+	"database_marker_outline",
+	"database_minus",
+	"database_minus_outline",
+	"database_off",
+	"database_off_outline",
+	"database_outline",
+	"database_plus",
+	"database_plus_outline",
+	"database_refresh",
+	"database_refresh_outline",
+	"database_remove",
+	"database_remove_outline",
+	"database_search",
+	"database_search_outline",
+	"database_settings",
+	"database_settings_outline",
+	"database_sync",
+	"database_sync_outline",
+	"death_star",
+	"death_star_variant",
+	"deathly_hallows",
+	"debian",
+	"debug_step_into",
+	"debug_step_out",
+	"debug_step_over",
+	"decagram",
+	"decagram_outline",
+	"decimal",
+	"decimal_comma",
+	"decimal_comma_decrease",
+	"decimal_comma_increase",
+	"decimal_decrease",
+	"decimal_increase",
+	"delete",
+	"delete_alert",
+	"delete_alert_outline",
+	"delete_circle",
+	"delete_circle_outline",
+	"delete_clock",
+	"delete_clock_outline",
+	"delete_empty",
+	"delete_empty_outline",
+	"delete_forever",
+	"delete_forever_outline",
+	"delete_off",
+	"delete_off_outline",
+	"delete_outline",
+	"delete_restore",
+	"delete_sweep",
+	"delete_sweep_outline",
+	"delete_variant",
+	"delta",
+	"desk",
+	"desk_lamp",
+	"deskphone",
+	"desktop_classic",
+	"desktop_mac",
+	"desktop_mac_dashboard",
+	"desktop_tower",
+	"desktop_tower_monitor",
+	"details",
+	"dev_to",
+	"developer_board",
+	"deviantart",
+	"devices",
+	"dharmachakra",
+	"diabetes",
+	"dialpad",
+	"diameter",
+	"diameter_outline",
+	"diameter_variant",
+	"diamond",
+	"diamond_outline",
+	"diamond_stone",
+	"dice_1",
+	"dice_1_outline",
+	"dice_2",
+	"dice_2_outline",
+	"dice_3",
+	"dice_3_outline",
+	"dice_4",
+	"dice_4_outline",
+	"dice_5",
+	"dice_5_outline",
+	"dice_6",
+	"dice_6_outline",
+	"dice_d10",
+	"dice_d10_outline",
+	"dice_d12",
+	"dice_d12_outline",
+	"dice_d20",
+	"dice_d20_outline",
+	"dice_d4",
+	"dice_d4_outline",
+	"dice_d6",
+	"dice_d6_outline",
+	"dice_d8",
+	"dice_d8_outline",
+	"dice_multiple",
+	"dice_multiple_outline",
+	"digital_ocean",
+	"dip_switch",
+	"directions",
+	"directions_fork",
+	"disc",
+	"disc_alert",
+	"disc_player",
+	"discord",
+	"dishwasher",
+	"dishwasher_alert",
+	"dishwasher_off",
+	"disqus",
+	"distribute_horizontal_center",
+	"distribute_horizontal_left",
+	"distribute_horizontal_right",
+	"distribute_vertical_bottom",
+	"distribute_vertical_center",
+	"distribute_vertical_top",
+	"diversify",
+	"diving",
+	"diving_flippers",
+	"diving_helmet",
+	"diving_scuba",
+	"diving_scuba_flag",
+	"diving_scuba_tank",
+	"diving_scuba_tank_multiple",
+	"diving_snorkel",
+	"division",
+	"division_box",
+	"dlna",
+	"dna",
+	"dns",
+	"dns_outline",
+	"dock_bottom",
+	"dock_left",
+	"dock_right",
+	"dock_top",
+	"dock_window",
+	"docker",
+	"doctor",
+	"dog",
+	"dog_service",
+	"dog_side",
+	"dog_side_off",
+	"dolby",
+	"dolly",
+	"dolphin",
+	"domain",
+	"domain_off",
+	"domain_plus",
+	"domain_remove",
+	"dome_light",
+	"domino_mask",
+	"donkey",
+	"door",
+	"door_closed",
+	"door_closed_lock",
+	"door_open",
+	"door_sliding",
+	"door_sliding_lock",
+	"door_sliding_open",
+	"doorbell",
+	"doorbell_video",
+	"dot_net",
+	"dots_circle",
+	"dots_grid",
+	"dots_hexagon",
+	"dots_horizontal",
+	"dots_horizontal_circle",
+	"dots_horizontal_circle_outline",
+	"dots_square",
+	"dots_triangle",
+	"dots_vertical",
+	"dots_vertical_circle",
+	"dots_vertical_circle_outline",
+	"download",
+	"download_box",
+	"download_box_outline",
+	"download_circle",
+	"download_circle_outline",
+	"download_lock",
+	"download_lock_outline",
+	"download_multiple",
+	"download_network",
+	"download_network_outline",
+	"download_off",
+	"download_off_outline",
+	"download_outline",
+	"drag",
+	"drag_horizontal",
+	"drag_horizontal_variant",
+	"drag_variant",
+	"drag_vertical",
+	"drag_vertical_variant",
+	"drama_masks",
+	"draw",
+	"draw_pen",
+	"drawing",
+	"drawing_box",
+	"dresser",
+	"dresser_outline",
+	"drone",
+	"dropbox",
+	"drupal",
+	"duck",
+	"dumbbell",
+	"dump_truck",
+	"ear_hearing",
+	"ear_hearing_loop",
+	"ear_hearing_off",
+	"earbuds",
+	"earbuds_off",
+	"earbuds_off_outline",
+	"earbuds_outline",
+	"earth",
+	"earth_arrow_right",
+	"earth_box",
+	"earth_box_minus",
+	"earth_box_off",
+	"earth_box_plus",
+	"earth_box_remove",
+	"earth_minus",
+	"earth_off",
+	"earth_plus",
+	"earth_remove",
+	"egg",
+	"egg_easter",
+	"egg_fried",
+	"egg_off",
+	"egg_off_outline",
+	"egg_outline",
+	"eiffel_tower",
+	"eight_track",
+	"eject",
+	"eject_outline",
+	"electric_switch",
+	"electric_switch_closed",
+	"electron_framework",
+	"elephant",
+	"elevation_decline",
+	"elevation_rise",
+	"elevator",
+	"elevator_down",
+	"elevator_passenger",
+	"elevator_passenger_off",
+	"elevator_passenger_off_outline",
+	"elevator_passenger_outline",
+	"elevator_up",
+	"ellipse",
+	"ellipse_outline",
+	"email",
+	"email_alert",
+	"email_alert_outline",
+	"email_box",
+	"email_check",
+	"email_check_outline",
+	"email_edit",
+	"email_edit_outline",
+	"email_fast",
+	"email_fast_outline",
+	"email_lock",
+	"email_mark_as_unread",
+	"email_minus",
+	"email_minus_outline",
+	"email_multiple",
+	"email_multiple_outline",
+	"email_newsletter",
+	"email_off",
+	"email_off_outline",
+	"email_open",
+	"email_open_multiple",
+	"email_open_multiple_outline",
+	"email_open_outline",
+	"email_outline",
+	"email_plus",
+	"email_plus_outline",
+	"email_receive",
+	"email_receive_outline",
+	"email_remove",
+	"email_remove_outline",
+	"email_seal",
+	"email_seal_outline",
+	"email_search",
+	"email_search_outline",
+	"email_send",
+	"email_send_outline",
+	"email_sync",
+	"email_sync_outline",
+	"email_variant",
+	"ember",
+	"emby",
+	"emoticon",
+	"emoticon_angry",
+	"emoticon_angry_outline",
+	"emoticon_confused",
+	"emoticon_confused_outline",
+	"emoticon_cool",
+	"emoticon_cool_outline",
+	"emoticon_cry",
+	"emoticon_cry_outline",
+	"emoticon_dead",
+	"emoticon_dead_outline",
+	"emoticon_devil",
+	"emoticon_devil_outline",
+	"emoticon_excited",
+	"emoticon_excited_outline",
+	"emoticon_frown",
+	"emoticon_frown_outline",
+	"emoticon_happy",
+	"emoticon_happy_outline",
+	"emoticon_kiss",
+	"emoticon_kiss_outline",
+	"emoticon_lol",
+	"emoticon_lol_outline",
+	"emoticon_neutral",
+	"emoticon_neutral_outline",
+	"emoticon_outline",
+	"emoticon_poop",
+	"emoticon_poop_outline",
+	"emoticon_sad",
+	"emoticon_sad_outline",
+	"emoticon_sick",
+	"emoticon_sick_outline",
+	"emoticon_tongue",
+	"emoticon_tongue_outline",
+	"emoticon_wink",
+	"emoticon_wink_outline",
+	"engine",
+	"engine_off",
+	"engine_off_outline",
+	"engine_outline",
+	"epsilon",
+	"equal",
+	"equal_box",
+	"equalizer",
+	"equalizer_outline",
+	"eraser",
+	"eraser_variant",
+	"escalator",
+	"escalator_box",
+	"escalator_down",
+	"escalator_up",
+	"eslint",
+	"et",
+	"ethereum",
+	"ethernet",
+	"ethernet_cable",
+	"ethernet_cable_off",
+	"ev_plug_ccs1",
+	"ev_plug_ccs2",
+	"ev_plug_chademo",
+	"ev_plug_tesla",
+	"ev_plug_type1",
+	"ev_plug_type2",
+	"ev_station",
+	"evernote",
+	"excavator",
+	"exclamation",
+	"exclamation_thick",
+	"exit_run",
+	"exit_to_app",
+	"expand_all",
+	"expand_all_outline",
+	"expansion_card",
+	"expansion_card_variant",
+	"exponent",
+	"exponent_box",
+	"export",
+	"export_variant",
+	"eye",
+	"eye_arrow_left",
+	"eye_arrow_left_outline",
+	"eye_arrow_right",
+	"eye_arrow_right_outline",
+	"eye_check",
+	"eye_check_outline",
+	"eye_circle",
+	"eye_circle_outline",
+	"eye_minus",
+	"eye_minus_outline",
+	"eye_off",
+	"eye_off_outline",
+	"eye_outline",
+	"eye_plus",
+	"eye_plus_outline",
+	"eye_refresh",
+	"eye_refresh_outline",
+	"eye_remove",
+	"eye_remove_outline",
+	"eye_settings",
+	"eye_settings_outline",
+	"eyedropper",
+	"eyedropper_minus",
+	"eyedropper_off",
+	"eyedropper_plus",
+	"eyedropper_remove",
+	"eyedropper_variant",
+	"face_agent",
+	"face_man",
+	"face_man_outline",
+	"face_man_profile",
+	"face_man_shimmer",
+	"face_man_shimmer_outline",
+	"face_mask",
+	"face_mask_outline",
+	"face_recognition",
+	"face_woman",
+	"face_woman_outline",
+	"face_woman_profile",
+	"face_woman_shimmer",
+	"face_woman_shimmer_outline",
+	"facebook",
+	"facebook_gaming",
+	"facebook_messenger",
+	"facebook_workplace",
+	"factory",
+	"family_tree",
+	"fan",
+	"fan_alert",
+	"fan_auto",
+	"fan_chevron_down",
+	"fan_chevron_up",
+	"fan_clock",
+	"fan_minus",
+	"fan_off",
+	"fan_plus",
+	"fan_remove",
+	"fan_speed_1",
+	"fan_speed_2",
+	"fan_speed_3",
+	"fast_forward",
+	"fast_forward_10",
+	"fast_forward_15",
+	"fast_forward_30",
+	"fast_forward_5",
+	"fast_forward_60",
+	"fast_forward_outline",
+	"fax",
+	"feather",
+	"feature_search",
+	"feature_search_outline",
+	"fedora",
+	"fence",
+	"fence_electric",
+	"fencing",
+	"ferris_wheel",
+	"ferry",
+	"file",
+	"file_account",
+	"file_account_outline",
+	"file_alert",
+	"file_alert_outline",
+	"file_arrow_left_right",
+	"file_arrow_left_right_outline",
+	"file_arrow_up_down",
+	"file_arrow_up_down_outline",
+	"file_cabinet",
+	"file_cad",
+	"file_cad_box",
+	"file_cancel",
+	"file_cancel_outline",
+	"file_certificate",
+	"file_certificate_outline",
+	"file_chart",
+	"file_chart_check",
+	"file_chart_check_outline",
+	"file_chart_outline",
+	"file_check",
+	"file_check_outline",
+	"file_clock",
+	"file_clock_outline",
+	"file_cloud",
+	"file_cloud_outline",
+	"file_code",
+	"file_code_outline",
+	"file_cog",
+	"file_cog_outline",
+	"file_compare",
+	"file_delimited",
+	"file_delimited_outline",
+	"file_document",
+	"file_document_alert",
+	"file_document_alert_outline",
+	"file_document_check",
+	"file_document_check_outline",
+	"file_document_edit",
+	"file_document_edit_outline",
+	"file_document_minus",
+	"file_document_minus_outline",
+	"file_document_multiple",
+	"file_document_multiple_outline",
+	"file_document_outline",
+	"file_document_plus",
+	"file_document_plus_outline",
+	"file_document_remove",
+	"file_document_remove_outline",
+	"file_download",
+	"file_download_outline",
+	"file_edit",
+	"file_edit_outline",
+	"file_excel",
+	"file_excel_box",
+	"file_excel_box_outline",
+	"file_excel_outline",
+	"file_export",
+	"file_export_outline",
+	"file_eye",
+	"file_eye_outline",
+	"file_find",
+	"file_find_outline",
+	"file_gif_box",
+	"file_hidden",
+	"file_image",
+	"file_image_marker",
+	"file_image_marker_outline",
+	"file_image_minus",
+	"file_image_minus_outline",
+	"file_image_outline",
+	"file_image_plus",
+	"file_image_plus_outline",
+	"file_image_remove",
+	"file_image_remove_outline",
+	"file_import",
+	"file_import_outline",
+	"file_jpg_box",
+	"file_key",
+	"file_key_outline",
+	"file_link",
+	"file_link_outline",
+	"file_lock",
+	"file_lock_open",
+	"file_lock_open_outline",
+	"file_lock_outline",
+	"file_marker",
+	"file_marker_outline",
+	"file_minus",
+	"file_minus_outline",
+	"file_move",
+	"file_move_outline",
+	"file_multiple",
+	"file_multiple_outline",
+	"file_music",
+	"file_music_outline",
+	"file_outline",
+	"file_pdf_box",
+	"file_percent",
+	"file_percent_outline",
+	"file_phone",
+	"file_phone_outline",
+	"file_plus",
+	"file_plus_outline",
+	"file_png_box",
+	"file_powerpoint",
+	"file_powerpoint_box",
+	"file_powerpoint_box_outline",
+	"file_powerpoint_outline",
+	"file_presentation_box",
+	"file_question",
+	"file_question_outline",
+	"file_refresh",
+	"file_refresh_outline",
+	"file_remove",
+	"file_remove_outline",
+	"file_replace",
+	"file_replace_outline",
+	"file_restore",
+	"file_restore_outline",
+	"file_rotate_left",
+	"file_rotate_left_outline",
+	"file_rotate_right",
+	"file_rotate_right_outline",
+	"file_search",
+	"file_search_outline",
+	"file_send",
+	"file_send_outline",
+	"file_settings",
+	"file_settings_outline",
+	"file_sign",
+	"file_star",
+	"file_star_outline",
+	"file_swap",
+	"file_swap_outline",
+	"file_sync",
+	"file_sync_outline",
+	"file_table",
+	"file_table_box",
+	"file_table_box_multiple",
+	"file_table_box_multiple_outline",
+	"file_table_box_outline",
+	"file_table_outline",
+	"file_tree",
+	"file_tree_outline",
+	"file_undo",
+	"file_undo_outline",
+	"file_upload",
+	"file_upload_outline",
+	"file_video",
+	"file_video_outline",
+	"file_word",
+	"file_word_box",
+	"file_word_box_outline",
+	"file_word_outline",
+	"film",
+	"filmstrip",
+	"filmstrip_box",
+	"filmstrip_box_multiple",
+	"filmstrip_off",
+	"filter",
+	"filter_check",
+	"filter_check_outline",
+	"filter_cog",
+	"filter_cog_outline",
+	"filter_menu",
+	"filter_menu_outline",
+	"filter_minus",
+	"filter_minus_outline",
+	"filter_multiple",
+	"filter_multiple_outline",
+	"filter_off",
+	"filter_off_outline",
+	"filter_outline",
+	"filter_plus",
+	"filter_plus_outline",
+	"filter_remove",
+	"filter_remove_outline",
+	"filter_settings",
+	"filter_settings_outline",
+	"filter_variant",
+	"filter_variant_minus",
+	"filter_variant_plus",
+	"filter_variant_remove",
+	"finance",
+	"find_replace",
+	"fingerprint",
+	"fingerprint_off",
+	"fire",
+	"fire_alert",
+	"fire_circle",
+	"fire_extinguisher",
+	"fire_hydrant",
+	"fire_hydrant_alert",
+	"fire_hydrant_off",
+	"fire_off",
+	"fire_truck",
+	"firebase",
+	"firefox",
+	"fireplace",
+	"fireplace_off",
+	"firewire",
+	"firework",
+	"firework_off",
+	"fish",
+	"fish_off",
+	"fishbowl",
+	"fishbowl_outline",
+	"fit_to_page",
+	"fit_to_page_outline",
+	"fit_to_screen",
+	"fit_to_screen_outline",
+	"flag",
+	"flag_checkered",
+	"flag_minus",
+	"flag_minus_outline",
+	"flag_off",
+	"flag_off_outline",
+	"flag_outline",
+	"flag_plus",
+	"flag_plus_outline",
+	"flag_remove",
+	"flag_remove_outline",
+	"flag_triangle",
+	"flag_variant",
+	"flag_variant_outline",
+	"flare",
+	"flash",
+	"flash_alert",
+	"flash_alert_outline",
+	"flash_auto",
+	"flash_off",
+	"flash_outline",
+	"flash_red_eye",
+	"flashlight",
+	"flashlight_off",
+	"flask",
+	"flask_empty",
+	"flask_empty_minus",
+	"flask_empty_minus_outline",
+	"flask_empty_off",
+	"flask_empty_off_outline",
+	"flask_empty_outline",
+	"flask_empty_plus",
+	"flask_empty_plus_outline",
+	"flask_empty_remove",
+	"flask_empty_remove_outline",
+	"flask_minus",
+	"flask_minus_outline",
+	"flask_off",
+	"flask_off_outline",
+	"flask_outline",
+	"flask_plus",
+	"flask_plus_outline",
+	"flask_remove",
+	"flask_remove_outline",
+	"flask_round_bottom",
+	"flask_round_bottom_empty",
+	"flask_round_bottom_empty_outline",
+	"flask_round_bottom_outline",
+	"fleur_de_lis",
+	"flip_horizontal",
+	"flip_to_back",
+	"flip_to_front",
+	"flip_vertical",
+	"floor_lamp",
+	"floor_lamp_dual",
+	"floor_lamp_dual_outline",
+	"floor_lamp_outline",
+	"floor_lamp_torchiere",
+	"floor_lamp_torchiere_outline",
+	"floor_lamp_torchiere_variant",
+	"floor_lamp_torchiere_variant_outline",
+	"floor_plan",
+	"floppy",
+	"floppy_variant",
+	"flower",
+	"flower_outline",
+	"flower_pollen",
+	"flower_pollen_outline",
+	"flower_poppy",
+	"flower_tulip",
+	"flower_tulip_outline",
+	"focus_auto",
+	"focus_field",
+	"focus_field_horizontal",
+	"focus_field_vertical",
+	"folder",
+	"folder_account",
+	"folder_account_outline",
+	"folder_alert",
+	"folder_alert_outline",
+	"folder_arrow_down",
+	"folder_arrow_down_outline",
+	"folder_arrow_left",
+	"folder_arrow_left_outline",
+	"folder_arrow_left_right",
+	"folder_arrow_left_right_outline",
+	"folder_arrow_right",
+	"folder_arrow_right_outline",
+	"folder_arrow_up",
+	"folder_arrow_up_down",
+	"folder_arrow_up_down_outline",
+	"folder_arrow_up_outline",
+	"folder_cancel",
+	"folder_cancel_outline",
+	"folder_check",
+	"folder_check_outline",
+	"folder_clock",
+	"folder_clock_outline",
+	"folder_cog",
+	"folder_cog_outline",
+	"folder_download",
+	"folder_download_outline",
+	"folder_edit",
+	"folder_edit_outline",
+	"folder_eye",
+	"folder_eye_outline",
+	"folder_file",
+	"folder_file_outline",
+	"folder_google_drive",
+	"folder_heart",
+	"folder_heart_outline",
+	"folder_hidden",
+	"folder_home",
+	"folder_home_outline",
+	"folder_image",
+	"folder_information",
+	"folder_information_outline",
+	"folder_key",
+	"folder_key_network",
+	"folder_key_network_outline",
+	"folder_key_outline",
+	"folder_lock",
+	"folder_lock_open",
+	"folder_lock_open_outline",
+	"folder_lock_outline",
+	"folder_marker",
+	"folder_marker_outline",
+	"folder_move",
+	"folder_move_outline",
+	"folder_multiple",
+	"folder_multiple_image",
+	"folder_multiple_outline",
+	"folder_multiple_plus",
+	"folder_multiple_plus_outline",
+	"folder_music",
+	"folder_music_outline",
+	"folder_network",
+	"folder_network_outline",
+	"folder_off",
+	"folder_off_outline",
+	"folder_open",
+	"folder_open_outline",
+	"folder_outline",
+	"folder_play",
+	"folder_play_outline",
+	"folder_plus",
+	"folder_plus_outline",
+	"folder_pound",
+	"folder_pound_outline",
+	"folder_question",
+	"folder_question_outline",
+	"folder_refresh",
+	"folder_refresh_outline",
+	"folder_remove",
+	"folder_remove_outline",
+	"folder_search",
+	"folder_search_outline",
+	"folder_settings",
+	"folder_settings_outline",
+	"folder_star",
+	"folder_star_multiple",
+	"folder_star_multiple_outline",
+	"folder_star_outline",
+	"folder_swap",
+	"folder_swap_outline",
+	"folder_sync",
+	"folder_sync_outline",
+	"folder_table",
+	"folder_table_outline",
+	"folder_text",
+	"folder_text_outline",
+	"folder_upload",
+	"folder_upload_outline",
+	"folder_wrench",
+	"folder_wrench_outline",
+	"folder_zip",
+	"folder_zip_outline",
+	"font_awesome",
+	"food",
+	"food_apple",
+	"food_apple_outline",
+	"food_croissant",
+	"food_drumstick",
+	"food_drumstick_off",
+	"food_drumstick_off_outline",
+	"food_drumstick_outline",
+	"food_fork_drink",
+	"food_halal",
+	"food_hot_dog",
+	"food_kosher",
+	"food_off",
+	"food_off_outline",
+	"food_outline",
+	"food_steak",
+	"food_steak_off",
+	"food_takeout_box",
+	"food_takeout_box_outline",
+	"food_turkey",
+	"food_variant",
+	"food_variant_off",
+	"foot_print",
+	"football",
+	"football_australian",
+	"football_helmet",
+	"forest",
+	"forklift",
+	"form_dropdown",
+	"form_select",
+	"form_textarea",
+	"form_textbox",
+	"form_textbox_lock",
+	"form_textbox_password",
+	"format_align_bottom",
+	"format_align_center",
+	"format_align_justify",
+	"format_align_left",
+	"format_align_middle",
+	"format_align_right",
+	"format_align_top",
+	"format_annotation_minus",
+	"format_annotation_plus",
+	"format_bold",
+	"format_clear",
+	"format_color_fill",
+	"format_color_highlight",
+	"format_color_marker_cancel",
+	"format_color_text",
+	"format_columns",
+	"format_float_center",
+	"format_float_left",
+	"format_float_none",
+	"format_float_right",
+	"format_font",
+	"format_font_size_decrease",
+	"format_font_size_increase",
+	"format_header_1",
+	"format_header_2",
+	"format_header_3",
+	"format_header_4",
+	"format_header_5",
+	"format_header_6",
+	"format_header_decrease",
+	"format_header_equal",
+	"format_header_increase",
+	"format_header_pound",
+	"format_horizontal_align_center",
+	"format_horizontal_align_left",
+	"format_horizontal_align_right",
+	"format_indent_decrease",
+	"format_indent_increase",
+	"format_italic",
+	"format_letter_case",
+	"format_letter_case_lower",
+	"format_letter_case_upper",
+	"format_letter_ends_with",
+	"format_letter_matches",
+	"format_letter_spacing",
+	"format_letter_starts_with",
+	"format_line_spacing",
+	"format_line_style",
+	"format_line_weight",
+	"format_list_bulleted",
+	"format_list_bulleted_square",
+	"format_list_bulleted_triangle",
+	"format_list_bulleted_type",
+	"format_list_checkbox",
+	"format_list_checks",
+	"format_list_group",
+	"format_list_numbered",
+	"format_list_numbered_rtl",
+	"format_list_text",
+	"format_overline",
+	"format_page_break",
+	"format_page_split",
+	"format_paint",
+	"format_paragraph",
+	"format_pilcrow",
+	"format_quote_close",
+	"format_quote_close_outline",
+	"format_quote_open",
+	"format_quote_open_outline",
+	"format_rotate_90",
+	"format_section",
+	"format_size",
+	"format_strikethrough",
+	"format_strikethrough_variant",
+	"format_subscript",
+	"format_superscript",
+	"format_text",
+	"format_text_rotation_angle_down",
+	"format_text_rotation_angle_up",
+	"format_text_rotation_down",
+	"format_text_rotation_down_vertical",
+	"format_text_rotation_none",
+	"format_text_rotation_up",
+	"format_text_rotation_vertical",
+	"format_text_variant",
+	"format_text_variant_outline",
+	"format_text_wrapping_clip",
+	"format_text_wrapping_overflow",
+	"format_text_wrapping_wrap",
+	"format_textbox",
+	"format_textdirection_l_to_r",
+	"format_textdirection_r_to_l",
+	"format_title",
+	"format_underline",
+	"format_underline_wavy",
+	"format_vertical_align_bottom",
+	"format_vertical_align_center",
+	"format_vertical_align_top",
+	"format_wrap_inline",
+	"format_wrap_square",
+	"format_wrap_tight",
+	"format_wrap_top_bottom",
+	"forum",
+	"forum_minus",
+	"forum_minus_outline",
+	"forum_outline",
+	"forum_plus",
+	"forum_plus_outline",
+	"forum_remove",
+	"forum_remove_outline",
+	"forward",
+	"forwardburger",
+	"fountain",
+	"fountain_pen",
+	"fountain_pen_tip",
+	"fraction_one_half",
+	"freebsd",
+	"french_fries",
+	"frequently_asked_questions",
+	"fridge",
+	"fridge_alert",
+	"fridge_alert_outline",
+	"fridge_bottom",
+	"fridge_industrial",
+	"fridge_industrial_alert",
+	"fridge_industrial_alert_outline",
+	"fridge_industrial_off",
+	"fridge_industrial_off_outline",
+	"fridge_industrial_outline",
+	"fridge_off",
+	"fridge_off_outline",
+	"fridge_outline",
+	"fridge_top",
+	"fridge_variant",
+	"fridge_variant_alert",
+	"fridge_variant_alert_outline",
+	"fridge_variant_off",
+	"fridge_variant_off_outline",
+	"fridge_variant_outline",
+	"fruit_cherries",
+	"fruit_cherries_off",
+	"fruit_citrus",
+	"fruit_citrus_off",
+	"fruit_grapes",
+	"fruit_grapes_outline",
+	"fruit_pear",
+	"fruit_pineapple",
+	"fruit_watermelon",
+	"fuel",
+	"fuel_cell",
+	"fullscreen",
+	"fullscreen_exit",
+	"function",
+	"function_variant",
+	"furigana_horizontal",
+	"furigana_vertical",
+	"fuse",
+	"fuse_alert",
+	"fuse_blade",
+	"fuse_off",
+	"gamepad",
+	"gamepad_circle",
+	"gamepad_circle_down",
+	"gamepad_circle_left",
+	"gamepad_circle_outline",
+	"gamepad_circle_right",
+	"gamepad_circle_up",
+	"gamepad_down",
+	"gamepad_left",
+	"gamepad_outline",
+	"gamepad_right",
+	"gamepad_round",
+	"gamepad_round_down",
+	"gamepad_round_left",
+	"gamepad_round_outline",
+	"gamepad_round_right",
+	"gamepad_round_up",
+	"gamepad_square",
+	"gamepad_square_outline",
+	"gamepad_up",
+	"gamepad_variant",
+	"gamepad_variant_outline",
+	"gamma",
+	"gantry_crane",
+	"garage",
+	"garage_alert",
+	"garage_alert_variant",
+	"garage_lock",
+	"garage_open",
+	"garage_open_variant",
+	"garage_variant",
+	"garage_variant_lock",
+	"gas_burner",
+	"gas_cylinder",
+	"gas_station",
+	"gas_station_off",
+	"gas_station_off_outline",
+	"gas_station_outline",
+	"gate",
+	"gate_alert",
+	"gate_and",
+	"gate_arrow_left",
+	"gate_arrow_right",
+	"gate_nand",
+	"gate_nor",
+	"gate_not",
+	"gate_open",
+	"gate_or",
+	"gate_xnor",
+	"gate_xor",
+	"gatsby",
+	"gauge",
+	"gauge_empty",
+	"gauge_full",
+	"gauge_low",
+	"gavel",
+	"gender_female",
+	"gender_male",
+	"gender_male_female",
+	"gender_male_female_variant",
+	"gender_non_binary",
+	"gender_transgender",
+	"gentoo",
+	"gesture",
+	"gesture_double_tap",
+	"gesture_pinch",
+	"gesture_spread",
+	"gesture_swipe",
+	"gesture_swipe_down",
+	"gesture_swipe_horizontal",
+	"gesture_swipe_left",
+	"gesture_swipe_right",
+	"gesture_swipe_up",
+	"gesture_swipe_vertical",
+	"gesture_tap",
+	"gesture_tap_box",
+	"gesture_tap_button",
+	"gesture_tap_hold",
+	"gesture_two_double_tap",
+	"gesture_two_tap",
+	"ghost",
+	"ghost_off",
+	"ghost_off_outline",
+	"ghost_outline",
+	"gift",
+	"gift_off",
+	"gift_off_outline",
+	"gift_open",
+	"gift_open_outline",
+	"gift_outline",
+	"git",
+	"github",
+	"gitlab",
+	"glass_cocktail",
+	"glass_cocktail_off",
+	"glass_flute",
+	"glass_fragile",
+	"glass_mug",
+	"glass_mug_off",
+	"glass_mug_variant",
+	"glass_mug_variant_off",
+	"glass_pint_outline",
+	"glass_stange",
+	"glass_tulip",
+	"glass_wine",
+	"glasses",
+	"globe_light",
+	"globe_model",
+	"gmail",
+	"gnome",
+	"go_kart",
+	"go_kart_track",
+	"gog",
+	"gold",
+	"golf",
+	"golf_cart",
+	"golf_tee",
+	"gondola",
+	"goodreads",
+	"google",
+	"google_ads",
+	"google_analytics",
+	"google_assistant",
+	"google_cardboard",
+	"google_chrome",
+	"google_circles",
+	"google_circles_communities",
+	"google_circles_extended",
+	"google_circles_group",
+	"google_classroom",
+	"google_cloud",
+	"google_controller",
+	"google_controller_off",
+	"google_downasaur",
+	"google_drive",
+	"google_earth",
+	"google_fit",
+	"google_glass",
+	"google_hangouts",
+	"google_home",
+	"google_keep",
+	"google_lens",
+	"google_maps",
+	"google_my_business",
+	"google_nearby",
+	"google_play",
+	"google_plus",
+	"google_podcast",
+	"google_spreadsheet",
+	"google_street_view",
+	"google_translate",
+	"gradient_horizontal",
+	"gradient_vertical",
+	"grain",
+	"graph",
+	"graph_outline",
+	"graphql",
+	"grass",
+	"grave_stone",
+	"grease_pencil",
+	"greater_than",
+	"greater_than_or_equal",
+	"greenhouse",
+	"grid",
+	"grid_large",
+	"grid_off",
+	"grill",
+	"grill_outline",
+	"group",
+	"guitar_acoustic",
+	"guitar_electric",
+	"guitar_pick",
+	"guitar_pick_outline",
+	"guy_fawkes_mask",
+	"gymnastics",
+	"hail",
+	"hair_dryer",
+	"hair_dryer_outline",
+	"halloween",
+	"hamburger",
+	"hamburger_check",
+	"hamburger_minus",
+	"hamburger_off",
+	"hamburger_plus",
+	"hamburger_remove",
+	"hammer",
+	"hammer_screwdriver",
+	"hammer_sickle",
+	"hammer_wrench",
+	"hand_back_left",
+	"hand_back_left_off",
+	"hand_back_left_off_outline",
+	"hand_back_left_outline",
+	"hand_back_right",
+	"hand_back_right_off",
+	"hand_back_right_off_outline",
+	"hand_back_right_outline",
+	"hand_clap",
+	"hand_clap_off",
+	"hand_coin",
+	"hand_coin_outline",
+	"hand_extended",
+	"hand_extended_outline",
+	"hand_front_left",
+	"hand_front_left_outline",
+	"hand_front_right",
+	"hand_front_right_outline",
+	"hand_heart",
+	"hand_heart_outline",
+	"hand_okay",
+	"hand_peace",
+	"hand_peace_variant",
+	"hand_pointing_down",
+	"hand_pointing_left",
+	"hand_pointing_right",
+	"hand_pointing_up",
+	"hand_saw",
+	"hand_wash",
+	"hand_wash_outline",
+	"hand_water",
+	"hand_wave",
+	"hand_wave_outline",
+	"handball",
+	"handcuffs",
+	"hands_pray",
+	"handshake",
+	"handshake_outline",
+	"hanger",
+	"hard_hat",
+	"harddisk",
+	"harddisk_plus",
+	"harddisk_remove",
+	"hat_fedora",
+	"hazard_lights",
+	"hdr",
+	"hdr_off",
+	"head",
+	"head_alert",
+	"head_alert_outline",
+	"head_check",
+	"head_check_outline",
+	"head_cog",
+	"head_cog_outline",
+	"head_dots_horizontal",
+	"head_dots_horizontal_outline",
+	"head_flash",
+	"head_flash_outline",
+	"head_heart",
+	"head_heart_outline",
+	"head_lightbulb",
+	"head_lightbulb_outline",
+	"head_minus",
+	"head_minus_outline",
+	"head_outline",
+	"head_plus",
+	"head_plus_outline",
+	"head_question",
+	"head_question_outline",
+	"head_remove",
+	"head_remove_outline",
+	"head_snowflake",
+	"head_snowflake_outline",
+	"head_sync",
+	"head_sync_outline",
+	"headphones",
+	"headphones_bluetooth",
+	"headphones_box",
+	"headphones_off",
+	"headphones_settings",
+	"headset",
+	"headset_dock",
+	"headset_off",
+	"heart",
+	"heart_box",
+	"heart_box_outline",
+	"heart_broken",
+	"heart_broken_outline",
+	"heart_circle",
+	"heart_circle_outline",
+	"heart_cog",
+	"heart_cog_outline",
+	"heart_flash",
+	"heart_half",
+	"heart_half_full",
+	"heart_half_outline",
+	"heart_minus",
+	"heart_minus_outline",
+	"heart_multiple",
+	"heart_multiple_outline",
+	"heart_off",
+	"heart_off_outline",
+	"heart_outline",
+	"heart_plus",
+	"heart_plus_outline",
+	"heart_pulse",
+	"heart_remove",
+	"heart_remove_outline",
+	"heart_settings",
+	"heart_settings_outline",
+	"heat_pump",
+	"heat_pump_outline",
+	"heat_wave",
+	"heating_coil",
+	"helicopter",
+	"help",
+	"help_box",
+	"help_circle",
+	"help_circle_outline",
+	"help_network",
+	"help_network_outline",
+	"help_rhombus",
+	"help_rhombus_outline",
+	"hexadecimal",
+	"hexagon",
+	"hexagon_multiple",
+	"hexagon_multiple_outline",
+	"hexagon_outline",
+	"hexagon_slice_1",
+	"hexagon_slice_2",
+	"hexagon_slice_3",
+	"hexagon_slice_4",
+	"hexagon_slice_5",
+	"hexagon_slice_6",
+	"hexagram",
+	"hexagram_outline",
+	"high_definition",
+	"high_definition_box",
+	"highway",
+	"hiking",
+	"history",
+	"hockey_puck",
+	"hockey_sticks",
+	"hololens",
+	"home",
+	"home_account",
+	"home_alert",
+	"home_alert_outline",
+	"home_analytics",
+	"home_assistant",
+	"home_automation",
+	"home_battery",
+	"home_battery_outline",
+	"home_circle",
+	"home_circle_outline",
+	"home_city",
+	"home_city_outline",
+	"home_clock",
+	"home_clock_outline",
+	"home_edit",
+	"home_edit_outline",
+	"home_export_outline",
+	"home_flood",
+	"home_floor_0",
+	"home_floor_1",
+	"home_floor_2",
+	"home_floor_3",
+	"home_floor_a",
+	"home_floor_b",
+	"home_floor_g",
+	"home_floor_l",
+	"home_floor_negative_1",
+	"home_group",
+	"home_group_minus",
+	"home_group_plus",
+	"home_group_remove",
+	"home_heart",
+	"home_import_outline",
+	"home_lightbulb",
+	"home_lightbulb_outline",
+	"home_lightning_bolt",
+	"home_lightning_bolt_outline",
+	"home_lock",
+	"home_lock_open",
+	"home_map_marker",
+	"home_minus",
+	"home_minus_outline",
+	"home_modern",
+	"home_off",
+	"home_off_outline",
+	"home_outline",
+	"home_plus",
+	"home_plus_outline",
+	"home_remove",
+	"home_remove_outline",
+	"home_roof",
+	"home_search",
+	"home_search_outline",
+	"home_switch",
+	"home_switch_outline",
+	"home_thermometer",
+	"home_thermometer_outline",
+	"home_variant",
+	"home_variant_outline",
+	"hook",
+	"hook_off",
+	"hoop_house",
+	"hops",
+	"horizontal_rotate_clockwise",
+	"horizontal_rotate_counterclockwise",
+	"horse",
+	"horse_human",
+	"horse_variant",
+	"horse_variant_fast",
+	"horseshoe",
+	"hospital",
+	"hospital_box",
+	"hospital_box_outline",
+	"hospital_building",
+	"hospital_marker",
+	"hot_tub",
+	"hours_24",
+	"hubspot",
+	"hulu",
+	"human",
+	"human_baby_changing_table",
+	"human_cane",
+	"human_capacity_decrease",
+	"human_capacity_increase",
+	"human_child",
+	"human_dolly",
+	"human_edit",
+	"human_female",
+	"human_female_boy",
+	"human_female_dance",
+	"human_female_female",
+	"human_female_girl",
+	"human_greeting",
+	"human_greeting_proximity",
+	"human_greeting_variant",
+	"human_handsdown",
+	"human_handsup",
+	"human_male",
+	"human_male_board",
+	"human_male_board_poll",
+	"human_male_boy",
+	"human_male_child",
+	"human_male_female",
+	"human_male_female_child",
+	"human_male_girl",
+	"human_male_height",
+	"human_male_height_variant",
+	"human_male_male",
+	"human_non_binary",
+	"human_pregnant",
+	"human_queue",
+	"human_scooter",
+	"human_wheelchair",
+	"human_white_cane",
+	"humble_bundle",
+	"hvac",
+	"hvac_off",
+	"hydraulic_oil_level",
+	"hydraulic_oil_temperature",
+	"hydro_power",
+	"hydrogen_station",
+	"ice_cream",
+	"ice_cream_off",
+	"ice_pop",
+	"id_card",
+	"identifier",
+	"ideogram_cjk",
+	"ideogram_cjk_variant",
+	"image",
+	"image_album",
+	"image_area",
+	"image_area_close",
+	"image_auto_adjust",
+	"image_broken",
+	"image_broken_variant",
+	"image_edit",
+	"image_edit_outline",
+	"image_filter_black_white",
+	"image_filter_center_focus",
+	"image_filter_center_focus_strong",
+	"image_filter_center_focus_strong_outline",
+	"image_filter_center_focus_weak",
+	"image_filter_drama",
+	"image_filter_frames",
+	"image_filter_hdr",
+	"image_filter_none",
+	"image_filter_tilt_shift",
+	"image_filter_vintage",
+	"image_frame",
+	"image_lock",
+	"image_lock_outline",
+	"image_marker",
+	"image_marker_outline",
+	"image_minus",
+	"image_move",
+	"image_multiple",
+	"image_multiple_outline",
+	"image_off",
+	"image_off_outline",
+	"image_outline",
+	"image_plus",
+	"image_refresh",
+	"image_refresh_outline",
+	"image_remove",
+	"image_search",
+	"image_search_outline",
+	"image_size_select_actual",
+	"image_size_select_large",
+	"image_size_select_small",
+	"image_sync",
+	"image_sync_outline",
+	"image_text",
+	"import",
+	"inbox",
+	"inbox_arrow_down",
+	"inbox_arrow_down_outline",
+	"inbox_arrow_up",
+	"inbox_arrow_up_outline",
+	"inbox_full",
+	"inbox_full_outline",
+	"inbox_multiple",
+	"inbox_multiple_outline",
+	"inbox_outline",
+	"inbox_remove",
+	"inbox_remove_outline",
+	"incognito",
+	"incognito_circle",
+	"incognito_circle_off",
+	"incognito_off",
+	"induction",
+	"infinity",
+	"information",
+	"information_off",
+	"information_off_outline",
+	"information_outline",
+	"information_variant",
+	"instagram",
+	"instrument_triangle",
+	"integrated_circuit_chip",
+	"invert_colors",
+	"invert_colors_off",
+	"iobroker",
+	"ip",
+	"ip_network",
+	"ip_network_outline",
+	"ip_outline",
+	"ipod",
+	"iron",
+	"iron_board",
+	"iron_outline",
+	"island",
+	"iv_bag",
+	"jabber",
+	"jeepney",
+	"jellyfish",
+	"jellyfish_outline",
+	"jira",
+	"jquery",
+	"jsfiddle",
+	"jump_rope",
+	"kabaddi",
+	"kangaroo",
+	"karate",
+	"kayaking",
+	"keg",
+	"kettle",
+	"kettle_alert",
+	"kettle_alert_outline",
+	"kettle_off",
+	"kettle_off_outline",
+	"kettle_outline",
+	"kettle_pour_over",
+	"kettle_steam",
+	"kettle_steam_outline",
+	"kettlebell",
+	"key",
+	"key_alert",
+	"key_alert_outline",
+	"key_arrow_right",
+	"key_chain",
+	"key_chain_variant",
+	"key_change",
+	"key_link",
+	"key_minus",
+	"key_outline",
+	"key_plus",
+	"key_remove",
+	"key_star",
+	"key_variant",
+	"key_wireless",
+	"keyboard",
+	"keyboard_backspace",
+	"keyboard_caps",
+	"keyboard_close",
+	"keyboard_esc",
+	"keyboard_f1",
+	"keyboard_f10",
+	"keyboard_f11",
+	"keyboard_f12",
+	"keyboard_f2",
+	"keyboard_f3",
+	"keyboard_f4",
+	"keyboard_f5",
+	"keyboard_f6",
+	"keyboard_f7",
+	"keyboard_f8",
+	"keyboard_f9",
+	"keyboard_off",
+	"keyboard_off_outline",
+	"keyboard_outline",
+	"keyboard_return",
+	"keyboard_settings",
+	"keyboard_settings_outline",
+	"keyboard_space",
+	"keyboard_tab",
+	"keyboard_tab_reverse",
+	"keyboard_variant",
+	"khanda",
+	"kickstarter",
+	"kite",
+	"kite_outline",
+	"kitesurfing",
+	"klingon",
+	"knife",
+	"knife_military",
+	"koala",
+	"kodi",
+	"kubernetes",
+	"label",
+	"label_multiple",
+	"label_multiple_outline",
+	"label_off",
+	"label_off_outline",
+	"label_outline",
+	"label_percent",
+	"label_percent_outline",
+	"label_variant",
+	"label_variant_outline",
+	"ladder",
+	"ladybug",
+	"lambda",
+	"lamp",
+	"lamp_outline",
+	"lamps",
+	"lamps_outline",
+	"lan",
+	"lan_check",
+	"lan_connect",
+	"lan_disconnect",
+	"lan_pending",
+	"land_fields",
+	"land_plots",
+	"land_plots_circle",
+	"land_plots_circle_variant",
+	"land_rows_horizontal",
+	"land_rows_vertical",
+	"landslide",
+	"landslide_outline",
+	"language_c",
+	"language_cpp",
+	"language_csharp",
+	"language_css3",
+	"language_fortran",
+	"language_go",
+	"language_haskell",
+	"language_html5",
+	"language_java",
+	"language_javascript",
+	"language_kotlin",
+	"language_lua",
+	"language_markdown",
+	"language_markdown_outline",
+	"language_php",
+	"language_python",
+	"language_r",
+	"language_ruby",
+	"language_ruby_on_rails",
+	"language_rust",
+	"language_swift",
+	"language_typescript",
+	"language_xaml",
+	"laptop",
+	"laptop_account",
+	"laptop_off",
+	"laravel",
+	"laser_pointer",
+	"lasso",
+	"lastpass",
+	"latitude",
+	"launch",
+	"lava_lamp",
+	"layers",
+	"layers_edit",
+	"layers_minus",
+	"layers_off",
+	"layers_off_outline",
+	"layers_outline",
+	"layers_plus",
+	"layers_remove",
+	"layers_search",
+	"layers_search_outline",
+	"layers_triple",
+	"layers_triple_outline",
+	"lead_pencil",
+	"leaf",
+	"leaf_circle",
+	"leaf_circle_outline",
+	"leaf_maple",
+	"leaf_maple_off",
+	"leaf_off",
+	"leak",
+	"leak_off",
+	"lecturn",
+	"led_off",
+	"led_on",
+	"led_outline",
+	"led_strip",
+	"led_strip_variant",
+	"led_strip_variant_off",
+	"led_variant_off",
+	"led_variant_on",
+	"led_variant_outline",
+	"leek",
+	"less_than",
+	"less_than_or_equal",
+	"library",
+	"library_outline",
+	"library_shelves",
+	"license",
+	"lifebuoy",
+	"light_flood_down",
+	"light_flood_up",
+	"light_recessed",
+	"light_switch",
+	"light_switch_off",
+	"lightbulb",
+	"lightbulb_alert",
+	"lightbulb_alert_outline",
+	"lightbulb_auto",
+	"lightbulb_auto_outline",
+	"lightbulb_cfl",
+	"lightbulb_cfl_off",
+	"lightbulb_cfl_spiral",
+	"lightbulb_cfl_spiral_off",
+	"lightbulb_fluorescent_tube",
+	"lightbulb_fluorescent_tube_outline",
+	"lightbulb_group",
+	"lightbulb_group_off",
+	"lightbulb_group_off_outline",
+	"lightbulb_group_outline",
+	"lightbulb_multiple",
+	"lightbulb_multiple_off",
+	"lightbulb_multiple_off_outline",
+	"lightbulb_multiple_outline",
+	"lightbulb_night",
+	"lightbulb_night_outline",
+	"lightbulb_off",
+	"lightbulb_off_outline",
+	"lightbulb_on",
+	"lightbulb_on_10",
+	"lightbulb_on_20",
+	"lightbulb_on_30",
+	"lightbulb_on_40",
+	"lightbulb_on_50",
+	"lightbulb_on_60",
+	"lightbulb_on_70",
+	"lightbulb_on_80",
+	"lightbulb_on_90",
+	"lightbulb_on_outline",
+	"lightbulb_outline",
+	"lightbulb_question",
+	"lightbulb_question_outline",
+	"lightbulb_spot",
+	"lightbulb_spot_off",
+	"lightbulb_variant",
+	"lightbulb_variant_outline",
+	"lighthouse",
+	"lighthouse_on",
+	"lightning_bolt",
+	"lightning_bolt_circle",
+	"lightning_bolt_outline",
+	"line_scan",
+	"lingerie",
+	"link",
+	"link_box",
+	"link_box_outline",
+	"link_box_variant",
+	"link_box_variant_outline",
+	"link_lock",
+	"link_off",
+	"link_plus",
+	"link_variant",
+	"link_variant_minus",
+	"link_variant_off",
+	"link_variant_plus",
+	"link_variant_remove",
+	"linkedin",
+	"linux",
+	"linux_mint",
+	"lipstick",
+	"liquid_spot",
+	"liquor",
+	"list_status",
+	"litecoin",
+	"loading",
+	"location_enter",
+	"location_exit",
+	"lock",
+	"lock_alert",
+	"lock_alert_outline",
+	"lock_check",
+	"lock_check_outline",
+	"lock_clock",
+	"lock_minus",
+	"lock_minus_outline",
+	"lock_off",
+	"lock_off_outline",
+	"lock_open",
+	"lock_open_alert",
+	"lock_open_alert_outline",
+	"lock_open_check",
+	"lock_open_check_outline",
+	"lock_open_minus",
+	"lock_open_minus_outline",
+	"lock_open_outline",
+	"lock_open_plus",
+	"lock_open_plus_outline",
+	"lock_open_remove",
+	"lock_open_remove_outline",
+	"lock_open_variant",
+	"lock_open_variant_outline",
+	"lock_outline",
+	"lock_pattern",
+	"lock_plus",
+	"lock_plus_outline",
+	"lock_question",
+	"lock_remove",
+	"lock_remove_outline",
+	"lock_reset",
+	"lock_smart",
+	"locker",
+	"locker_multiple",
+	"login",
+	"logout",
+	"logout_variant",
+	"longitude",
+	"looks",
+	"lotion",
+	"lotion_outline",
+	"lotion_plus",
+	"lotion_plus_outline",
+	"loupe",
+	"lumx",
+	"lungs",
+	"mace",
+	"magazine_pistol",
+	"magazine_rifle",
+	"magic_staff",
+	"magnet",
+	"magnet_on",
+	"magnify",
+	"magnify_close",
+	"magnify_expand",
+	"magnify_minus",
+	"magnify_minus_cursor",
+	"magnify_minus_outline",
+	"magnify_plus",
+	"magnify_plus_cursor",
+	"magnify_plus_outline",
+	"magnify_remove_cursor",
+	"magnify_remove_outline",
+	"magnify_scan",
+	"mail",
+	"mailbox",
+	"mailbox_open",
+	"mailbox_open_outline",
+	"mailbox_open_up",
+	"mailbox_open_up_outline",
+	"mailbox_outline",
+	"mailbox_up",
+	"mailbox_up_outline",
+	"manjaro",
+	"map",
+	"map_check",
+	"map_check_outline",
+	"map_clock",
+	"map_clock_outline",
+	"map_legend",
+	"map_marker",
+	"map_marker_account",
+	"map_marker_account_outline",
+	"map_marker_alert",
+	"map_marker_alert_outline",
+	"map_marker_check",
+	"map_marker_check_outline",
+	"map_marker_circle",
+	"map_marker_distance",
+	"map_marker_down",
+	"map_marker_left",
+	"map_marker_left_outline",
+	"map_marker_minus",
+	"map_marker_minus_outline",
+	"map_marker_multiple",
+	"map_marker_multiple_outline",
+	"map_marker_off",
+	"map_marker_off_outline",
+	"map_marker_outline",
+	"map_marker_path",
+	"map_marker_plus",
+	"map_marker_plus_outline",
+	"map_marker_question",
+	"map_marker_question_outline",
+	"map_marker_radius",
+	"map_marker_radius_outline",
+	"map_marker_remove",
+	"map_marker_remove_outline",
+	"map_marker_remove_variant",
+	"map_marker_right",
+	"map_marker_right_outline",
+	"map_marker_star",
+	"map_marker_star_outline",
+	"map_marker_up",
+	"map_minus",
+	"map_outline",
+	"map_plus",
+	"map_search",
+	"map_search_outline",
+	"mapbox",
+	"margin",
+	"marker",
+	"marker_cancel",
+	"marker_check",
+	"mastodon",
+	"material_design",
+	"material_ui",
+	"math_compass",
+	"math_cos",
+	"math_integral",
+	"math_integral_box",
+	"math_log",
+	"math_norm",
+	"math_norm_box",
+	"math_sin",
+	"math_tan",
+	"matrix",
+	"medal",
+	"medal_outline",
+	"medical_bag",
+	"medical_cotton_swab",
+	"meditation",
+	"memory",
+	"menorah",
+	"menorah_fire",
+	"menu",
+	"menu_down",
+	"menu_down_outline",
+	"menu_left",
+	"menu_left_outline",
+	"menu_open",
+	"menu_right",
+	"menu_right_outline",
+	"menu_swap",
+	"menu_swap_outline",
+	"menu_up",
+	"menu_up_outline",
+	"merge",
+	"message",
+	"message_alert",
+	"message_alert_outline",
+	"message_arrow_left",
+	"message_arrow_left_outline",
+	"message_arrow_right",
+	"message_arrow_right_outline",
+	"message_badge",
+	"message_badge_outline",
+	"message_bookmark",
+	"message_bookmark_outline",
+	"message_bulleted",
+	"message_bulleted_off",
+	"message_cog",
+	"message_cog_outline",
+	"message_draw",
+	"message_fast",
+	"message_fast_outline",
+	"message_flash",
+	"message_flash_outline",
+	"message_image",
+	"message_image_outline",
+	"message_lock",
+	"message_lock_outline",
+	"message_minus",
+	"message_minus_outline",
+	"message_off",
+	"message_off_outline",
+	"message_outline",
+	"message_plus",
+	"message_plus_outline",
+	"message_processing",
+	"message_processing_outline",
+	"message_question",
+	"message_question_outline",
+	"message_reply",
+	"message_reply_outline",
+	"message_reply_text",
+	"message_reply_text_outline",
+	"message_settings",
+	"message_settings_outline",
+	"message_star",
+	"message_star_outline",
+	"message_text",
+	"message_text_clock",
+	"message_text_clock_outline",
+	"message_text_fast",
+	"message_text_fast_outline",
+	"message_text_lock",
+	"message_text_lock_outline",
+	"message_text_outline",
+	"message_video",
+	"meteor",
+	"meter_electric",
+	"meter_electric_outline",
+	"meter_gas",
+	"meter_gas_outline",
+	"metronome",
+	"metronome_tick",
+	"micro_sd",
+	"microphone",
+	"microphone_minus",
+	"microphone_off",
+	"microphone_outline",
+	"microphone_plus",
+	"microphone_question",
+	"microphone_question_outline",
+	"microphone_settings",
+	"microphone_variant",
+	"microphone_variant_off",
+	"microscope",
+	"microsoft",
+	"microsoft_access",
+	"microsoft_azure",
+	"microsoft_azure_devops",
+	"microsoft_bing",
+	"microsoft_dynamics_365",
+	"microsoft_edge",
+	"microsoft_excel",
+	"microsoft_internet_explorer",
+	"microsoft_office",
+	"microsoft_onedrive",
+	"microsoft_onenote",
+	"microsoft_outlook",
+	"microsoft_powerpoint",
+	"microsoft_sharepoint",
+	"microsoft_teams",
+	"microsoft_visual_studio",
+	"microsoft_visual_studio_code",
+	"microsoft_windows",
+	"microsoft_windows_classic",
+	"microsoft_word",
+	"microsoft_xbox",
+	"microsoft_xbox_controller",
+	"microsoft_xbox_controller_battery_alert",
+	"microsoft_xbox_controller_battery_charging",
+	"microsoft_xbox_controller_battery_empty",
+	"microsoft_xbox_controller_battery_full",
+	"microsoft_xbox_controller_battery_low",
+	"microsoft_xbox_controller_battery_medium",
+	"microsoft_xbox_controller_battery_unknown",
+	"microsoft_xbox_controller_menu",
+	"microsoft_xbox_controller_off",
+	"microsoft_xbox_controller_view",
+	"microwave",
+	"microwave_off",
+	"middleware",
+	"middleware_outline",
+	"midi",
+	"midi_port",
+	"mine",
+	"minecraft",
+	"mini_sd",
+	"minidisc",
+	"minus",
+	"minus_box",
+	"minus_box_multiple",
+	"minus_box_multiple_outline",
+	"minus_box_outline",
+	"minus_circle",
+	"minus_circle_multiple",
+	"minus_circle_multiple_outline",
+	"minus_circle_off",
+	"minus_circle_off_outline",
+	"minus_circle_outline",
+	"minus_network",
+	"minus_network_outline",
+	"minus_thick",
+	"mirror",
+	"mirror_rectangle",
+	"mirror_variant",
+	"mixed_martial_arts",
+	"mixed_reality",
+	"molecule",
+	"molecule_co",
+	"molecule_co2",
+	"monitor",
+	"monitor_account",
+	"monitor_arrow_down",
+	"monitor_arrow_down_variant",
+	"monitor_cellphone",
+	"monitor_cellphone_star",
+	"monitor_dashboard",
+	"monitor_edit",
+	"monitor_eye",
+	"monitor_lock",
+	"monitor_multiple",
+	"monitor_off",
+	"monitor_screenshot",
+	"monitor_share",
+	"monitor_shimmer",
+	"monitor_small",
+	"monitor_speaker",
+	"monitor_speaker_off",
+	"monitor_star",
+	"moon_first_quarter",
+	"moon_full",
+	"moon_last_quarter",
+	"moon_new",
+	"moon_waning_crescent",
+	"moon_waning_gibbous",
+	"moon_waxing_crescent",
+	"moon_waxing_gibbous",
+	"moped",
+	"moped_electric",
+	"moped_electric_outline",
+	"moped_outline",
+	"more",
+	"mortar_pestle",
+	"mortar_pestle_plus",
+	"mosque",
+	"mother_heart",
+	"mother_nurse",
+	"motion",
+	"motion_outline",
+	"motion_pause",
+	"motion_pause_outline",
+	"motion_play",
+	"motion_play_outline",
+	"motion_sensor",
+	"motion_sensor_off",
+	"motorbike",
+	"motorbike_electric",
+	"mouse",
+	"mouse_bluetooth",
+	"mouse_move_down",
+	"mouse_move_up",
+	"mouse_move_vertical",
+	"mouse_off",
+	"mouse_variant",
+	"mouse_variant_off",
+	"move_resize",
+	"move_resize_variant",
+	"movie",
+	"movie_check",
+	"movie_check_outline",
+	"movie_cog",
+	"movie_cog_outline",
+	"movie_edit",
+	"movie_edit_outline",
+	"movie_filter",
+	"movie_filter_outline",
+	"movie_minus",
+	"movie_minus_outline",
+	"movie_off",
+	"movie_off_outline",
+	"movie_open",
+	"movie_open_check",
+	"movie_open_check_outline",
+	"movie_open_cog",
+	"movie_open_cog_outline",
+	"movie_open_edit",
+	"movie_open_edit_outline",
+	"movie_open_minus",
+	"movie_open_minus_outline",
+	"movie_open_off",
+	"movie_open_off_outline",
+	"movie_open_outline",
+	"movie_open_play",
+	"movie_open_play_outline",
+	"movie_open_plus",
+	"movie_open_plus_outline",
+	"movie_open_remove",
+	"movie_open_remove_outline",
+	"movie_open_settings",
+	"movie_open_settings_outline",
+	"movie_open_star",
+	"movie_open_star_outline",
+	"movie_outline",
+	"movie_play",
+	"movie_play_outline",
+	"movie_plus",
+	"movie_plus_outline",
+	"movie_remove",
+	"movie_remove_outline",
+	"movie_roll",
+	"movie_search",
+	"movie_search_outline",
+	"movie_settings",
+	"movie_settings_outline",
+	"movie_star",
+	"movie_star_outline",
+	"mower",
+	"mower_bag",
+	"muffin",
+	"multicast",
+	"multiplication",
+	"multiplication_box",
+	"mushroom",
+	"mushroom_off",
+	"mushroom_off_outline",
+	"mushroom_outline",
+	"music",
+	"music_accidental_double_flat",
+	"music_accidental_double_sharp",
+	"music_accidental_flat",
+	"music_accidental_natural",
+	"music_accidental_sharp",
+	"music_box",
+	"music_box_multiple",
+	"music_box_multiple_outline",
+	"music_box_outline",
+	"music_circle",
+	"music_circle_outline",
+	"music_clef_alto",
+	"music_clef_bass",
+	"music_clef_treble",
+	"music_note",
+	"music_note_bluetooth",
+	"music_note_bluetooth_off",
+	"music_note_eighth_dotted",
+	"music_note_half",
+	"music_note_half_dotted",
+	"music_note_off",
+	"music_note_off_outline",
+	"music_note_outline",
+	"music_note_plus",
+	"music_note_quarter",
+	"music_note_quarter_dotted",
+	"music_note_sixteenth",
+	"music_note_sixteenth_dotted",
+	"music_note_whole",
+	"music_note_whole_dotted",
+	"music_off",
+	"music_rest_eighth",
+	"music_rest_half",
+	"music_rest_quarter",
+	"music_rest_sixteenth",
+	"music_rest_whole",
+	"mustache",
+	"nail",
+	"nas",
+	"nativescript",
+	"nature",
+	"nature_people",
+	"navigation",
+	"navigation_outline",
+	"navigation_variant_outline",
+	"near_me",
+	"necklace",
+	"needle",
+	"needle_off",
+	"netflix",
+	"network",
+	"network_off",
+	"network_off_outline",
+	"network_outline",
+	"network_pos",
+	"network_strength_1",
+	"network_strength_1_alert",
+	"network_strength_2",
+	"network_strength_2_alert",
+	"network_strength_3",
+	"network_strength_3_alert",
+	"network_strength_4",
+	"network_strength_4_alert",
+	"network_strength_4_cog",
+	"network_strength_off",
+	"network_strength_off_outline",
+	"network_strength_outline",
+	"new_box",
+	"newspaper",
+	"newspaper_check",
+	"newspaper_minus",
+	"newspaper_plus",
+	"newspaper_remove",
+	"newspaper_variant",
+	"newspaper_variant_multiple",
+	"newspaper_variant_multiple_outline",
+	"newspaper_variant_outline",
+	"nfc",
+	"nfc_search_variant",
+	"nfc_tap",
+	"nfc_variant",
+	"nfc_variant_off",
+	"ninja",
+	"nintendo_game_boy",
+	"nintendo_switch",
+	"nintendo_wii",
+	"nintendo_wiiu",
+	"nix",
+	"nodejs",
+	"noodles",
+	"not_equal",
+	"not_equal_variant",
+	"note",
+	"note_alert",
+	"note_alert_outline",
+	"note_check",
+	"note_check_outline",
+	"note_edit",
+	"note_edit_outline",
+	"note_minus",
+	"note_minus_outline",
+	"note_multiple",
+	"note_multiple_outline",
+	"note_off",
+	"note_off_outline",
+	"note_outline",
+	"note_plus",
+	"note_plus_outline",
+	"note_remove",
+	"note_remove_outline",
+	"note_search",
+	"note_search_outline",
+	"note_text",
+	"note_text_outline",
+	"notebook",
+	"notebook_check",
+	"notebook_check_outline",
+	"notebook_edit",
+	"notebook_edit_outline",
+	"notebook_heart",
+	"notebook_heart_outline",
+	"notebook_minus",
+	"notebook_minus_outline",
+	"notebook_multiple",
+	"notebook_outline",
+	"notebook_plus",
+	"notebook_plus_outline",
+	"notebook_remove",
+	"notebook_remove_outline",
+	"notification_clear_all",
+	"npm",
+	"nuke",
+	"null",
+	"numeric",
+	"numeric_0_box",
+	"numeric_0_box_multiple",
+	"numeric_0_box_multiple_outline",
+	"numeric_0_box_outline",
+	"numeric_1",
+	"numeric_10",
+	"numeric_10_box",
+	"numeric_10_box_multiple",
+	"numeric_10_box_multiple_outline",
+	"numeric_10_box_outline",
+	"numeric_10_circle",
+	"numeric_10_circle_outline",
+	"numeric_1_box",
+	"numeric_1_box_multiple",
+	"numeric_1_box_multiple_outline",
+	"numeric_1_box_outline",
+	"numeric_1_circle",
+	"numeric_1_circle_outline",
+	"numeric_2",
+	"numeric_2_box",
+	"numeric_2_box_multiple",
+	"numeric_2_box_multiple_outline",
+	"numeric_2_box_outline",
+	"numeric_2_circle",
+	"numeric_2_circle_outline",
+	"numeric_3",
+	"numeric_3_box",
+	"numeric_3_box_multiple",
+	"numeric_3_box_multiple_outline",
+	"numeric_3_box_outline",
+	"numeric_3_circle",
+	"numeric_3_circle_outline",
+	"numeric_4",
+	"numeric_4_box",
+	"numeric_4_box_multiple",
+	"numeric_4_box_multiple_outline",
+	"numeric_4_box_outline",
+	"numeric_4_circle",
+	"numeric_4_circle_outline",
+	"numeric_5",
+	"numeric_5_box",
+	"numeric_5_box_multiple",
+	"numeric_5_box_multiple_outline",
+	"numeric_5_box_outline",
+	"numeric_5_circle",
+	"numeric_5_circle_outline",
+	"numeric_6",
+	"numeric_6_box",
+	"numeric_6_box_multiple",
+	"numeric_6_box_multiple_outline",
+	"numeric_6_box_outline",
+	"numeric_6_circle",
+	"numeric_6_circle_outline",
+	"numeric_7",
+	"numeric_7_box",
+	"numeric_7_box_multiple",
+	"numeric_7_box_multiple_outline",
+	"numeric_7_box_outline",
+	"numeric_7_circle",
+	"numeric_7_circle_outline",
+	"numeric_8",
+	"numeric_8_box",
+	"numeric_8_box_multiple",
+	"numeric_8_box_multiple_outline",
+	"numeric_8_box_outline",
+	"numeric_8_circle",
+	"numeric_8_circle_outline",
+	"numeric_9",
+	"numeric_9_box",
+	"numeric_9_box_multiple",
+	"numeric_9_box_multiple_outline",
+	"numeric_9_box_outline",
+	"numeric_9_circle",
+	"numeric_9_circle_outline",
+	"numeric_9_plus",
+	"numeric_9_plus_box",
+	"numeric_9_plus_box_multiple",
+	"numeric_9_plus_box_multiple_outline",
+	"numeric_9_plus_box_outline",
+	"numeric_9_plus_circle",
+	"numeric_9_plus_circle_outline",
+	"numeric_negative_1",
+	"numeric_off",
+	"numeric_positive_1",
+	"nut",
+	"nutrition",
+	"nuxt",
+	"oar",
+	"ocarina",
+	"oci",
+	"ocr",
+	"octagon",
+	"octagon_outline",
+	"octagram",
+	"octagram_outline",
+	"octahedron",
+	"octahedron_off",
+	"odnoklassniki",
+	"offer",
+	"office_building",
+	"office_building_cog",
+	"office_building_cog_outline",
+	"office_building_marker",
+	"office_building_marker_outline",
+	"office_building_outline",
+	"oil",
+	"oil_lamp",
+	"oil_level",
+	"oil_temperature",
+	"om",
+	"omega",
+	"one_up",
+	"onepassword",
+	"opacity",
+	"open_in_app",
+	"open_in_new",
+	"open_source_initiative",
+	"openid",
+	"opera",
+	"orbit",
+	"orbit_variant",
+	"order_alphabetical_ascending",
+	"order_alphabetical_descending",
+	"order_bool_ascending",
+	"order_bool_ascending_variant",
+	"order_bool_descending",
+	"order_bool_descending_variant",
+	"order_numeric_ascending",
+	"order_numeric_descending",
+	"origin",
+	"ornament",
+	"ornament_variant",
+	"outdoor_lamp",
+	"overscan",
+	"owl",
+	"pac_man",
+	"package",
+	"package_down",
+	"package_up",
+	"package_variant",
+	"package_variant_closed",
+	"package_variant_closed_minus",
+	"package_variant_closed_plus",
+	"package_variant_closed_remove",
+	"package_variant_minus",
+	"package_variant_plus",
+	"package_variant_remove",
+	"page_first",
+	"page_last",
+	"page_layout_body",
+	"page_layout_footer",
+	"page_layout_header",
+	"page_layout_header_footer",
+	"page_layout_sidebar_left",
+	"page_layout_sidebar_right",
+	"page_next",
+	"page_next_outline",
+	"page_previous",
+	"page_previous_outline",
+	"pail",
+	"pail_minus",
+	"pail_minus_outline",
+	"pail_off",
+	"pail_off_outline",
+	"pail_outline",
+	"pail_plus",
+	"pail_plus_outline",
+	"pail_remove",
+	"pail_remove_outline",
+	"palette",
+	"palette_advanced",
+	"palette_outline",
+	"palette_swatch",
+	"palette_swatch_outline",
+	"palette_swatch_variant",
+	"palm_tree",
+	"pan",
+	"pan_bottom_left",
+	"pan_bottom_right",
+	"pan_down",
+	"pan_horizontal",
+	"pan_left",
+	"pan_right",
+	"pan_top_left",
+	"pan_top_right",
+	"pan_up",
+	"pan_vertical",
+	"panda",
+	"pandora",
+	"panorama",
+	"panorama_fisheye",
+	"panorama_horizontal",
+	"panorama_horizontal_outline",
+	"panorama_outline",
+	"panorama_sphere",
+	"panorama_sphere_outline",
+	"panorama_variant",
+	"panorama_variant_outline",
+	"panorama_vertical",
+	"panorama_vertical_outline",
+	"panorama_wide_angle",
+	"panorama_wide_angle_outline",
+	"paper_cut_vertical",
+	"paper_roll",
+	"paper_roll_outline",
+	"paperclip",
+	"paperclip_check",
+	"paperclip_lock",
+	"paperclip_minus",
+	"paperclip_off",
+	"paperclip_plus",
+	"paperclip_remove",
+	"parachute",
+	"parachute_outline",
+	"paragliding",
+	"parking",
+	"party_popper",
+	"passport",
+	"passport_biometric",
+	"pasta",
+	"patio_heater",
+	"patreon",
+	"pause",
+	"pause_circle",
+	"pause_circle_outline",
+	"pause_octagon",
+	"pause_octagon_outline",
+	"paw",
+	"paw_off",
+	"paw_off_outline",
+	"paw_outline",
+	"peace",
+	"peanut",
+	"peanut_off",
+	"peanut_off_outline",
+	"peanut_outline",
+	"pen",
+	"pen_lock",
+	"pen_minus",
+	"pen_off",
+	"pen_plus",
+	"pen_remove",
+	"pencil",
+	"pencil_box",
+	"pencil_box_multiple",
+	"pencil_box_multiple_outline",
+	"pencil_box_outline",
+	"pencil_circle",
+	"pencil_circle_outline",
+	"pencil_lock",
+	"pencil_lock_outline",
+	"pencil_minus",
+	"pencil_minus_outline",
+	"pencil_off",
+	"pencil_off_outline",
+	"pencil_outline",
+	"pencil_plus",
+	"pencil_plus_outline",
+	"pencil_remove",
+	"pencil_remove_outline",
+	"pencil_ruler",
+	"penguin",
+	"pentagon",
+	"pentagon_outline",
+	"pentagram",
+	"percent",
+	"percent_box",
+	"percent_box_outline",
+	"percent_circle",
+	"percent_circle_outline",
+	"percent_outline",
+	"periodic_table",
+	"perspective_less",
+	"perspective_more",
+	"ph",
+	"phone",
+	"phone_alert",
+	"phone_alert_outline",
+	"phone_bluetooth",
+	"phone_bluetooth_outline",
+	"phone_cancel",
+	"phone_cancel_outline",
+	"phone_check",
+	"phone_check_outline",
+	"phone_classic",
+	"phone_classic_off",
+	"phone_clock",
+	"phone_dial",
+	"phone_dial_outline",
+	"phone_forward",
+	"phone_forward_outline",
+	"phone_hangup",
+	"phone_hangup_outline",
+	"phone_in_talk",
+	"phone_in_talk_outline",
+	"phone_incoming",
+	"phone_incoming_outline",
+	"phone_lock",
+	"phone_lock_outline",
+	"phone_log",
+	"phone_log_outline",
+	"phone_message",
+	"phone_message_outline",
+	"phone_minus",
+	"phone_minus_outline",
+	"phone_missed",
+	"phone_missed_outline",
+	"phone_off",
+	"phone_off_outline",
+	"phone_outgoing",
+	"phone_outgoing_outline",
+	"phone_outline",
+	"phone_paused",
+	"phone_paused_outline",
+	"phone_plus",
+	"phone_plus_outline",
+	"phone_refresh",
+	"phone_refresh_outline",
+	"phone_remove",
+	"phone_remove_outline",
+	"phone_return",
+	"phone_return_outline",
+	"phone_ring",
+	"phone_ring_outline",
+	"phone_rotate_landscape",
+	"phone_rotate_portrait",
+	"phone_settings",
+	"phone_settings_outline",
+	"phone_sync",
+	"phone_sync_outline",
+	"phone_voip",
+	"pi",
+	"pi_box",
+	"pi_hole",
+	"piano",
+	"piano_off",
+	"pickaxe",
+	"picture_in_picture_bottom_right",
+	"picture_in_picture_bottom_right_outline",
+	"picture_in_picture_top_right",
+	"picture_in_picture_top_right_outline",
+	"pier",
+	"pier_crane",
+	"pig",
+	"pig_variant",
+	"pig_variant_outline",
+	"piggy_bank",
+	"piggy_bank_outline",
+	"pill",
+	"pill_off",
+	"pillar",
+	"pin",
+	"pin_off",
+	"pin_off_outline",
+	"pin_outline",
+	"pine_tree",
+	"pine_tree_box",
+	"pine_tree_fire",
+	"pinterest",
+	"pinwheel",
+	"pinwheel_outline",
+	"pipe",
+	"pipe_disconnected",
+	"pipe_leak",
+	"pipe_valve",
+	"pipe_wrench",
+	"pirate",
+	"pistol",
+	"piston",
+	"pitchfork",
+	"pizza",
+	"play",
+	"play_box",
+	"play_box_lock",
+	"play_box_lock_open",
+	"play_box_lock_open_outline",
+	"play_box_lock_outline",
+	"play_box_multiple",
+	"play_box_multiple_outline",
+	"play_box_outline",
+	"play_circle",
+	"play_circle_outline",
+	"play_network",
+	"play_network_outline",
+	"play_outline",
+	"play_pause",
+	"play_protected_content",
+	"play_speed",
+	"playlist_check",
+	"playlist_edit",
+	"playlist_minus",
+	"playlist_music",
+	"playlist_music_outline",
+	"playlist_play",
+	"playlist_plus",
+	"playlist_remove",
+	"playlist_star",
+	"plex",
+	"pliers",
+	"plus",
+	"plus_box",
+	"plus_box_multiple",
+	"plus_box_multiple_outline",
+	"plus_box_outline",
+	"plus_circle",
+	"plus_circle_multiple",
+	"plus_circle_multiple_outline",
+	"plus_circle_outline",
+	"plus_lock",
+	"plus_lock_open",
+	"plus_minus",
+	"plus_minus_box",
+	"plus_minus_variant",
+	"plus_network",
+	"plus_network_outline",
+	"plus_outline",
+	"plus_thick",
+	"podcast",
+	"podium",
+	"podium_bronze",
+	"podium_gold",
+	"podium_silver",
+	"point_of_sale",
+	"pokeball",
+	"pokemon_go",
+	"poker_chip",
+	"polaroid",
+	"police_badge",
+	"police_badge_outline",
+	"police_station",
+	"poll",
+	"polo",
+	"polymer",
+	"pool",
+	"pool_thermometer",
+	"popcorn",
+	"post",
+	"post_lamp",
+	"post_outline",
+	"postage_stamp",
+	"pot",
+	"pot_mix",
+	"pot_mix_outline",
+	"pot_outline",
+	"pot_steam",
+	"pot_steam_outline",
+	"pound",
+	"pound_box",
+	"pound_box_outline",
+	"power",
+	"power_cycle",
+	"power_off",
+	"power_on",
+	"power_plug",
+	"power_plug_off",
+	"power_plug_off_outline",
+	"power_plug_outline",
+	"power_settings",
+	"power_sleep",
+	"power_socket",
+	"power_socket_au",
+	"power_socket_ch",
+	"power_socket_de",
+	"power_socket_eu",
+	"power_socket_fr",
+	"power_socket_it",
+	"power_socket_jp",
+	"power_socket_uk",
+	"power_socket_us",
+	"power_standby",
+	"powershell",
+	"prescription",
+	"presentation",
+	"presentation_play",
+	"pretzel",
+	"printer",
+	"printer_3d",
+	"printer_3d_nozzle",
+	"printer_3d_nozzle_alert",
+	"printer_3d_nozzle_alert_outline",
+	"printer_3d_nozzle_heat",
+	"printer_3d_nozzle_heat_outline",
+	"printer_3d_nozzle_outline",
+	"printer_alert",
+	"printer_check",
+	"printer_eye",
+	"printer_off",
+	"printer_off_outline",
+	"printer_outline",
+	"printer_pos",
+	"printer_search",
+	"printer_settings",
+	"printer_wireless",
+	"priority_high",
+	"priority_low",
+	"professional_hexagon",
+	"progress_alert",
+	"progress_check",
+	"progress_clock",
+	"progress_close",
+	"progress_download",
+	"progress_pencil",
+	"progress_question",
+	"progress_star",
+	"progress_upload",
+	"progress_wrench",
+	"projector",
+	"projector_off",
+	"projector_screen",
+	"projector_screen_off",
+	"projector_screen_off_outline",
+	"projector_screen_outline",
+	"projector_screen_variant",
+	"projector_screen_variant_off",
+	"projector_screen_variant_off_outline",
+	"projector_screen_variant_outline",
+	"propane_tank",
+	"propane_tank_outline",
+	"protocol",
+	"publish",
+	"publish_off",
+	"pulse",
+	"pump",
+	"pumpkin",
+	"purse",
+	"purse_outline",
+	"puzzle",
+	"puzzle_check",
+	"puzzle_check_outline",
+	"puzzle_edit",
+	"puzzle_edit_outline",
+	"puzzle_heart",
+	"puzzle_heart_outline",
+	"puzzle_minus",
+	"puzzle_minus_outline",
+	"puzzle_outline",
+	"puzzle_plus",
+	"puzzle_plus_outline",
+	"puzzle_remove",
+	"puzzle_remove_outline",
+	"puzzle_star",
+	"puzzle_star_outline",
+	"pyramid",
+	"pyramid_off",
+	"qi",
+	"qqchat",
+	"qrcode",
+	"qrcode_edit",
+	"qrcode_minus",
+	"qrcode_plus",
+	"qrcode_remove",
+	"qrcode_scan",
+	"quadcopter",
+	"quality_high",
+	"quality_low",
+	"quality_medium",
+	"quora",
+	"rabbit",
+	"rabbit_variant",
+	"rabbit_variant_outline",
+	"racing_helmet",
+	"racquetball",
+	"radar",
+	"radiator",
+	"radiator_disabled",
+	"radiator_off",
+	"radio",
+	"radio_am",
+	"radio_fm",
+	"radio_handheld",
+	"radio_off",
+	"radio_tower",
+	"radioactive",
+	"radioactive_circle",
+	"radioactive_circle_outline",
+	"radioactive_off",
+	"radiobox_marked",
+	"radiology_box",
+	"radiology_box_outline",
+	"radius",
+	"radius_outline",
+	"railroad_light",
+	"rake",
+	"raspberry_pi",
+	"raw",
+	"raw_off",
+	"ray_end",
+	"ray_end_arrow",
+	"ray_start",
+	"ray_start_arrow",
+	"ray_start_end",
+	"ray_start_vertex_end",
+	"ray_vertex",
+	"razor_double_edge",
+	"razor_single_edge",
+	"react",
+	"read",
+	"receipt",
+	"receipt_outline",
+	"receipt_text_check",
+	"receipt_text_check_outline",
+	"receipt_text_minus",
+	"receipt_text_minus_outline",
+	"receipt_text_plus",
+	"receipt_text_plus_outline",
+	"receipt_text_remove",
+	"receipt_text_remove_outline",
+	"record",
+	"record_circle",
+	"record_circle_outline",
+	"record_player",
+	"record_rec",
+	"rectangle",
+	"rectangle_outline",
+	"recycle",
+	"recycle_variant",
+	"reddit",
+	"redhat",
+	"redo",
+	"redo_variant",
+	"reflect_horizontal",
+	"reflect_vertical",
+	"refresh",
+	"refresh_auto",
+	"refresh_circle",
+	"regex",
+	"registered_trademark",
+	"reiterate",
+	"relation_many_to_many",
+	"relation_many_to_one",
+	"relation_many_to_one_or_many",
+	"relation_many_to_only_one",
+	"relation_many_to_zero_or_many",
+	"relation_many_to_zero_or_one",
+	"relation_one_or_many_to_many",
+	"relation_one_or_many_to_one",
+	"relation_one_or_many_to_one_or_many",
+	"relation_one_or_many_to_only_one",
+	"relation_one_or_many_to_zero_or_many",
+	"relation_one_or_many_to_zero_or_one",
+	"relation_one_to_many",
+	"relation_one_to_one",
+	"relation_one_to_one_or_many",
+	"relation_one_to_only_one",
+	"relation_one_to_zero_or_many",
+	"relation_one_to_zero_or_one",
+	"relation_only_one_to_many",
+	"relation_only_one_to_one",
+	"relation_only_one_to_one_or_many",
+	"relation_only_one_to_only_one",
+	"relation_only_one_to_zero_or_many",
+	"relation_only_one_to_zero_or_one",
+	"relation_zero_or_many_to_many",
+	"relation_zero_or_many_to_one",
+	"relation_zero_or_many_to_one_or_many",
+	"relation_zero_or_many_to_only_one",
+	"relation_zero_or_many_to_zero_or_many",
+	"relation_zero_or_many_to_zero_or_one",
+	"relation_zero_or_one_to_many",
+	"relation_zero_or_one_to_one",
+	"relation_zero_or_one_to_one_or_many",
+	"relation_zero_or_one_to_only_one",
+	"relation_zero_or_one_to_zero_or_many",
+	"relation_zero_or_one_to_zero_or_one",
+	"relative_scale",
+	"reload",
+	"reload_alert",
+	"reminder",
+	"remote",
+	"remote_desktop",
+	"remote_off",
+	"remote_tv",
+	"remote_tv_off",
+	"rename_box",
+	"reorder_horizontal",
+	"reorder_vertical",
+	"repeat",
+	"repeat_off",
+	"repeat_once",
+	"repeat_variant",
+	"replay",
+	"reply",
+	"reply_all",
+	"reply_all_outline",
+	"reply_circle",
+	"reply_outline",
+	"reproduction",
+	"resistor",
+	"resistor_nodes",
+	"resize",
+	"resize_bottom_right",
+	"responsive",
+	"restart",
+	"restart_alert",
+	"restart_off",
+	"restore",
+	"restore_alert",
+	"rewind",
+	"rewind_10",
+	"rewind_15",
+	"rewind_30",
+	"rewind_5",
+	"rewind_60",
+	"rewind_outline",
+	"rhombus",
+	"rhombus_medium",
+	"rhombus_medium_outline",
+	"rhombus_outline",
+	"rhombus_split",
+	"rhombus_split_outline",
+	"ribbon",
+	"rice",
+	"rickshaw",
+	"rickshaw_electric",
+	"ring",
+	"rivet",
+	"road",
+	"road_variant",
+	"robber",
+	"robot",
+	"robot_angry",
+	"robot_angry_outline",
+	"robot_confused",
+	"robot_confused_outline",
+	"robot_dead",
+	"robot_dead_outline",
+	"robot_excited",
+	"robot_excited_outline",
+	"robot_happy",
+	"robot_happy_outline",
+	"robot_industrial",
+	"robot_industrial_outline",
+	"robot_love",
+	"robot_love_outline",
+	"robot_mower",
+	"robot_mower_outline",
+	"robot_off",
+	"robot_off_outline",
+	"robot_outline",
+	"robot_vacuum",
+	"robot_vacuum_variant",
+	"rocket",
+	"rocket_launch",
+	"rocket_launch_outline",
+	"rocket_outline",
+	"rodent",
+	"roller_shade",
+	"roller_shade_closed",
+	"roller_skate",
+	"roller_skate_off",
+	"rollerblade",
+	"rollerblade_off",
+	"rollupjs",
+	"rolodex",
+	"rolodex_outline",
+	"roman_numeral_2",
+	"roman_numeral_3",
+	"roman_numeral_4",
+	"roman_numeral_6",
+	"roman_numeral_7",
+	"roman_numeral_8",
+	"roman_numeral_9",
+	"room_service",
+	"room_service_outline",
+	"rotate_360",
+	"rotate_3d",
+	"rotate_3d_variant",
+	"rotate_left",
+	"rotate_left_variant",
+	"rotate_orbit",
+	"rotate_right",
+	"rotate_right_variant",
+	"rounded_corner",
+	"router",
+	"router_network",
+	"router_wireless",
+	"router_wireless_off",
+	"router_wireless_settings",
+	"routes",
+	"routes_clock",
+	"rowing",
+	"rss",
+	"rss_box",
+	"rss_off",
+	"rug",
+	"rugby",
+	"ruler",
+	"ruler_square",
+	"ruler_square_compass",
+	"run",
+	"run_fast",
+	"rv_truck",
+	"sack",
+	"sack_percent",
+	"safe",
+	"safe_square",
+	"safe_square_outline",
+	"safety_goggles",
+	"sail_boat",
+	"sail_boat_sink",
+	"sale",
+	"sale_outline",
+	"salesforce",
+	"sass",
+	"satellite",
+	"satellite_uplink",
+	"satellite_variant",
+	"sausage",
+	"sausage_off",
+	"saw_blade",
+	"sawtooth_wave",
+	"saxophone",
+	"scale",
+	"scale_balance",
+	"scale_bathroom",
+	"scale_off",
+	"scale_unbalanced",
+	"scan_helper",
+	"scanner",
+	"scanner_off",
+	"scatter_plot",
+	"scatter_plot_outline",
+	"scent",
+	"scent_off",
+	"school",
+	"school_outline",
+	"scissors_cutting",
+	"scooter",
+	"scooter_electric",
+	"scoreboard",
+	"scoreboard_outline",
+	"screen_rotation",
+	"screen_rotation_lock",
+	"screw_flat_top",
+	"screw_lag",
+	"screw_machine_flat_top",
+	"screw_machine_round_top",
+	"screw_round_top",
+	"screwdriver",
+	"script",
+	"script_outline",
+	"script_text",
+	"script_text_key",
+	"script_text_key_outline",
+	"script_text_outline",
+	"script_text_play",
+	"script_text_play_outline",
+	"sd",
+	"seal",
+	"seal_variant",
+	"search_web",
+	"seat",
+	"seat_flat",
+	"seat_flat_angled",
+	"seat_individual_suite",
+	"seat_legroom_extra",
+	"seat_legroom_normal",
+	"seat_legroom_reduced",
+	"seat_outline",
+	"seat_passenger",
+	"seat_recline_extra",
+	"seat_recline_normal",
+	"seatbelt",
+	"security",
+	"security_network",
+	"seed",
+	"seed_off",
+	"seed_off_outline",
+	"seed_outline",
+	"seed_plus",
+	"seed_plus_outline",
+	"seesaw",
+	"segment",
+	"select",
+	"select_all",
+	"select_color",
+	"select_compare",
+	"select_drag",
+	"select_group",
+	"select_inverse",
+	"select_marker",
+	"select_multiple",
+	"select_multiple_marker",
+	"select_off",
+	"select_place",
+	"select_remove",
+	"select_search",
+	"selection",
+	"selection_drag",
+	"selection_ellipse",
+	"selection_ellipse_arrow_inside",
+	"selection_ellipse_remove",
+	"selection_marker",
+	"selection_multiple",
+	"selection_multiple_marker",
+	"selection_off",
+	"selection_remove",
+	"selection_search",
+	"semantic_web",
+	"send",
+	"send_check",
+	"send_check_outline",
+	"send_circle",
+	"send_circle_outline",
+	"send_clock",
+	"send_clock_outline",
+	"send_lock",
+	"send_lock_outline",
+	"send_outline",
+	"serial_port",
+	"server",
+	"server_minus",
+	"server_network",
+	"server_network_off",
+	"server_off",
+	"server_plus",
+	"server_remove",
+	"server_security",
+	"set_all",
+	"set_center",
+	"set_center_right",
+	"set_left",
+	"set_left_center",
+	"set_left_right",
+	"set_merge",
+	"set_none",
+	"set_right",
+	"set_split",
+	"set_square",
+	"set_top_box",
+	"settings_helper",
+	"shaker",
+	"shaker_outline",
+	"shape",
+	"shape_circle_plus",
+	"shape_outline",
+	"shape_oval_plus",
+	"shape_plus",
+	"shape_polygon_plus",
+	"shape_rectangle_plus",
+	"shape_square_plus",
+	"shape_square_rounded_plus",
+	"share",
+	"share_all",
+	"share_all_outline",
+	"share_circle",
+	"share_off",
+	"share_off_outline",
+	"share_outline",
+	"share_variant",
+	"share_variant_outline",
+	"shark",
+	"shark_fin",
+	"shark_fin_outline",
+	"shark_off",
+	"sheep",
+	"shield",
+	"shield_account",
+	"shield_account_outline",
+	"shield_account_variant",
+	"shield_account_variant_outline",
+	"shield_airplane",
+	"shield_airplane_outline",
+	"shield_alert",
+	"shield_alert_outline",
+	"shield_bug",
+	"shield_bug_outline",
+	"shield_car",
+	"shield_check",
+	"shield_check_outline",
+	"shield_cross",
+	"shield_cross_outline",
+	"shield_crown",
+	"shield_crown_outline",
+	"shield_edit",
+	"shield_edit_outline",
+	"shield_half",
+	"shield_half_full",
+	"shield_home",
+	"shield_home_outline",
+	"shield_key",
+	"shield_key_outline",
+	"shield_link_variant",
+	"shield_link_variant_outline",
+	"shield_lock",
+	"shield_lock_open",
+	"shield_lock_open_outline",
+	"shield_lock_outline",
+	"shield_moon",
+	"shield_moon_outline",
+	"shield_off",
+	"shield_off_outline",
+	"shield_outline",
+	"shield_plus",
+	"shield_plus_outline",
+	"shield_refresh",
+	"shield_refresh_outline",
+	"shield_remove",
+	"shield_remove_outline",
+	"shield_search",
+	"shield_star",
+	"shield_star_outline",
+	"shield_sun",
+	"shield_sun_outline",
+	"shield_sword",
+	"shield_sword_outline",
+	"shield_sync",
+	"shield_sync_outline",
+	"shimmer",
+	"ship_wheel",
+	"shipping_pallet",
+	"shoe_ballet",
+	"shoe_cleat",
+	"shoe_formal",
+	"shoe_heel",
+	"shoe_print",
+	"shoe_sneaker",
+	"shopping",
+	"shopping_music",
+	"shopping_outline",
+	"shopping_search",
+	"shopping_search_outline",
+	"shore",
+	"shovel",
+	"shovel_off",
+	"shower",
+	"shower_head",
+	"shredder",
+	"shuffle",
+	"shuffle_disabled",
+	"shuffle_variant",
+	"shuriken",
+	"sickle",
+	"sigma",
+	"sigma_lower",
+	"sign_caution",
+	"sign_direction",
+	"sign_direction_minus",
+	"sign_direction_plus",
+	"sign_direction_remove",
+	"sign_pole",
+	"sign_real_estate",
+	"sign_text",
+	"signal",
+	"signal_2g",
+	"signal_3g",
+	"signal_4g",
+	"signal_5g",
+	"signal_cellular_1",
+	"signal_cellular_2",
+	"signal_cellular_3",
+	"signal_cellular_outline",
+	"signal_distance_variant",
+	"signal_hspa",
+	"signal_hspa_plus",
+	"signal_off",
+	"signal_variant",
+	"signature",
+	"signature_freehand",
+	"signature_image",
+	"signature_text",
+	"silo",
+	"silverware",
+	"silverware_clean",
+	"silverware_fork",
+	"silverware_fork_knife",
+	"silverware_spoon",
+	"silverware_variant",
+	"sim",
+	"sim_alert",
+	"sim_alert_outline",
+	"sim_off",
+	"sim_off_outline",
+	"sim_outline",
+	"simple_icons",
+	"sina_weibo",
+	"sine_wave",
+	"sitemap",
+	"sitemap_outline",
+	"size_m",
+	"size_s",
+	"size_xl",
+	"size_xs",
+	"size_xxl",
+	"size_xxs",
+	"size_xxxl",
+	"skate",
+	"skate_off",
+	"skateboard",
+	"skateboarding",
+	"skew_less",
+	"skew_more",
+	"ski",
+	"ski_cross_country",
+	"ski_water",
+	"skip_backward",
+	"skip_backward_outline",
+	"skip_forward",
+	"skip_forward_outline",
+	"skip_next",
+	"skip_next_circle",
+	"skip_next_circle_outline",
+	"skip_next_outline",
+	"skip_previous",
+	"skip_previous_circle",
+	"skip_previous_circle_outline",
+	"skip_previous_outline",
+	"skull",
+	"skull_crossbones",
+	"skull_crossbones_outline",
+	"skull_outline",
+	"skull_scan",
+	"skull_scan_outline",
+	"skype",
+	"skype_business",
+	"slack",
+	"slash_forward",
+	"slash_forward_box",
+	"sledding",
+	"sleep",
+	"sleep_off",
+	"slide",
+	"slope_downhill",
+	"slope_uphill",
+	"slot_machine",
+	"slot_machine_outline",
+	"smart_card",
+	"smart_card_off",
+	"smart_card_off_outline",
+	"smart_card_outline",
+	"smart_card_reader",
+	"smart_card_reader_outline",
+	"smog",
+	"smoke",
+	"smoke_detector",
+	"smoke_detector_alert",
+	"smoke_detector_alert_outline",
+	"smoke_detector_off",
+	"smoke_detector_off_outline",
+	"smoke_detector_outline",
+	"smoke_detector_variant",
+	"smoke_detector_variant_alert",
+	"smoke_detector_variant_off",
+	"smoking",
+	"smoking_off",
+	"smoking_pipe",
+	"smoking_pipe_off",
+	"snail",
+	"snake",
+	"snapchat",
+	"snowboard",
+	"snowflake",
+	"snowflake_alert",
+	"snowflake_check",
+	"snowflake_melt",
+	"snowflake_off",
+	"snowflake_thermometer",
+	"snowflake_variant",
+	"snowman",
+	"snowmobile",
+	"snowshoeing",
+	"soccer",
+	"soccer_field",
+	"social_distance_2_meters",
+	"social_distance_6_feet",
+	"sofa",
+	"sofa_outline",
+	"sofa_single",
+	"sofa_single_outline",
+	"solar_panel",
+	"solar_panel_large",
+	"solar_power",
+	"solar_power_variant",
+	"solar_power_variant_outline",
+	"soldering_iron",
+	"solid",
+	"sony_playstation",
+	"sort",
+	"sort_alphabetical_ascending",
+	"sort_alphabetical_ascending_variant",
+	"sort_alphabetical_descending",
+	"sort_alphabetical_descending_variant",
+	"sort_alphabetical_variant",
+	"sort_ascending",
+	"sort_bool_ascending",
+	"sort_bool_ascending_variant",
+	"sort_bool_descending",
+	"sort_bool_descending_variant",
+	"sort_calendar_ascending",
+	"sort_calendar_descending",
+	"sort_clock_ascending",
+	"sort_clock_ascending_outline",
+	"sort_clock_descending",
+	"sort_clock_descending_outline",
+	"sort_descending",
+	"sort_numeric_ascending",
+	"sort_numeric_ascending_variant",
+	"sort_numeric_descending",
+	"sort_numeric_descending_variant",
+	"sort_numeric_variant",
+	"sort_reverse_variant",
+	"sort_variant",
+	"sort_variant_lock",
+	"sort_variant_lock_open",
+	"sort_variant_off",
+	"sort_variant_remove",
+	"soundbar",
+	"soundcloud",
+	"source_branch",
+	"source_branch_check",
+	"source_branch_minus",
+	"source_branch_plus",
+	"source_branch_refresh",
+	"source_branch_remove",
+	"source_branch_sync",
+	"source_commit",
+	"source_commit_end",
+	"source_commit_end_local",
+	"source_commit_local",
+	"source_commit_next_local",
+	"source_commit_start",
+	"source_commit_start_next_local",
+	"source_fork",
+	"source_merge",
+	"source_pull",
+	"source_repository",
+	"source_repository_multiple",
+	"soy_sauce",
+	"soy_sauce_off",
+	"spa",
+	"spa_outline",
+	"space_invaders",
+	"space_station",
+	"spade",
+	"speaker",
+	"speaker_bluetooth",
+	"speaker_multiple",
+	"speaker_off",
+	"speaker_wireless",
+	"spear",
+	"speedometer",
+	"speedometer_medium",
+	"speedometer_slow",
+	"spellcheck",
+	"sphere",
+	"sphere_off",
+	"spider",
+	"spider_thread",
+	"spider_web",
+	"spirit_level",
+	"spoon_sugar",
+	"spotify",
+	"spotlight",
+	"spotlight_beam",
+	"spray",
+	"spray_bottle",
+	"sprinkler",
+	"sprinkler_fire",
+	"sprinkler_variant",
+	"sprout",
+	"sprout_outline",
+	"square",
+	"square_circle",
+	"square_edit_outline",
+	"square_medium",
+	"square_medium_outline",
+	"square_off",
+	"square_off_outline",
+	"square_opacity",
+	"square_outline",
+	"square_root",
+	"square_root_box",
+	"square_rounded",
+	"square_rounded_badge",
+	"square_rounded_badge_outline",
+	"square_rounded_outline",
+	"square_small",
+	"square_wave",
+	"squeegee",
+	"ssh",
+	"stack_exchange",
+	"stack_overflow",
+	"stackpath",
+	"stadium",
+	"stadium_variant",
+	"stairs",
+	"stairs_box",
+	"stairs_down",
+	"stairs_up",
+	"stamper",
+	"standard_definition",
+	"star",
+	"star_box",
+	"star_box_multiple",
+	"star_box_multiple_outline",
+	"star_box_outline",
+	"star_check",
+	"star_check_outline",
+	"star_circle",
+	"star_circle_outline",
+	"star_cog",
+	"star_cog_outline",
+	"star_crescent",
+	"star_david",
+	"star_face",
+	"star_four_points",
+	"star_four_points_outline",
+	"star_half",
+	"star_half_full",
+	"star_minus",
+	"star_minus_outline",
+	"star_off",
+	"star_off_outline",
+	"star_outline",
+	"star_plus",
+	"star_plus_outline",
+	"star_remove",
+	"star_remove_outline",
+	"star_settings",
+	"star_settings_outline",
+	"star_shooting",
+	"star_shooting_outline",
+	"star_three_points",
+	"star_three_points_outline",
+	"state_machine",
+	"steam",
+	"steering",
+	"steering_off",
+	"step_backward",
+	"step_backward_2",
+	"step_forward",
+	"step_forward_2",
+	"stethoscope",
+	"sticker",
+	"sticker_alert",
+	"sticker_alert_outline",
+	"sticker_check",
+	"sticker_check_outline",
+	"sticker_circle_outline",
+	"sticker_emoji",
+	"sticker_minus",
+	"sticker_minus_outline",
+	"sticker_outline",
+	"sticker_plus",
+	"sticker_plus_outline",
+	"sticker_remove",
+	"sticker_remove_outline",
+	"sticker_text",
+	"sticker_text_outline",
+	"stocking",
+	"stomach",
+	"stool",
+	"stool_outline",
+	"stop",
+	"stop_circle",
+	"stop_circle_outline",
+	"storage_tank",
+	"storage_tank_outline",
+	"store",
+	"store_24_hour",
+	"store_alert",
+	"store_alert_outline",
+	"store_check",
+	"store_check_outline",
+	"store_clock",
+	"store_clock_outline",
+	"store_cog",
+	"store_cog_outline",
+	"store_edit",
+	"store_edit_outline",
+	"store_marker",
+	"store_marker_outline",
+	"store_minus",
+	"store_minus_outline",
+	"store_off",
+	"store_off_outline",
+	"store_outline",
+	"store_plus",
+	"store_plus_outline",
+	"store_remove",
+	"store_remove_outline",
+	"store_search",
+	"store_search_outline",
+	"store_settings",
+	"store_settings_outline",
+	"storefront",
+	"storefront_outline",
+	"stove",
+	"strategy",
+	"stretch_to_page",
+	"stretch_to_page_outline",
+	"string_lights",
+	"string_lights_off",
+	"subdirectory_arrow_left",
+	"subdirectory_arrow_right",
+	"submarine",
+	"subtitles",
+	"subtitles_outline",
+	"subway",
+	"subway_alert_variant",
+	"subway_variant",
+	"summit",
+	"sun_clock",
+	"sun_clock_outline",
+	"sun_compass",
+	"sun_snowflake",
+	"sun_snowflake_variant",
+	"sun_thermometer",
+	"sun_thermometer_outline",
+	"sun_wireless",
+	"sun_wireless_outline",
+	"sunglasses",
+	"surfing",
+	"surround_sound",
+	"surround_sound_2_0",
+	"surround_sound_2_1",
+	"surround_sound_3_1",
+	"surround_sound_5_1",
+	"surround_sound_5_1_2",
+	"surround_sound_7_1",
+	"svg",
+	"swap_horizontal",
+	"swap_horizontal_bold",
+	"swap_horizontal_circle",
+	"swap_horizontal_circle_outline",
+	"swap_horizontal_variant",
+	"swap_vertical",
+	"swap_vertical_bold",
+	"swap_vertical_circle",
+	"swap_vertical_circle_outline",
+	"swap_vertical_variant",
+	"swim",
+	"switch",
+	"sword",
+	"sword_cross",
+	"syllabary_hangul",
+	"syllabary_hiragana",
+	"syllabary_katakana",
+	"syllabary_katakana_halfwidth",
+	"symbol",
+	"symfony",
+	"sync",
+	"sync_alert",
+	"sync_circle",
+	"sync_off",
+	"tab",
+	"tab_minus",
+	"tab_plus",
+	"tab_remove",
+	"tab_search",
+	"tab_unselected",
+	"table",
+	"table_account",
+	"table_alert",
+	"table_arrow_down",
+	"table_arrow_left",
+	"table_arrow_right",
+	"table_arrow_up",
+	"table_border",
+	"table_cancel",
+	"table_chair",
+	"table_check",
+	"table_clock",
+	"table_cog",
+	"table_column",
+	"table_column_plus_after",
+	"table_column_plus_before",
+	"table_column_remove",
+	"table_column_width",
+	"table_edit",
+	"table_eye",
+	"table_eye_off",
+	"table_furniture",
+	"table_headers_eye",
+	"table_headers_eye_off",
+	"table_heart",
+	"table_key",
+	"table_large",
+	"table_large_plus",
+	"table_large_remove",
+	"table_lock",
+	"table_merge_cells",
+	"table_minus",
+	"table_multiple",
+	"table_network",
+	"table_of_contents",
+	"table_off",
+	"table_picnic",
+	"table_pivot",
+	"table_plus",
+	"table_refresh",
+	"table_remove",
+	"table_row",
+	"table_row_height",
+	"table_row_plus_after",
+	"table_row_plus_before",
+	"table_row_remove",
+	"table_search",
+	"table_settings",
+	"table_split_cell",
+	"table_star",
+	"table_sync",
+	"table_tennis",
+	"tablet",
+	"tablet_android",
+	"tablet_cellphone",
+	"tablet_dashboard",
+	"taco",
+	"tag",
+	"tag_arrow_down",
+	"tag_arrow_down_outline",
+	"tag_arrow_left",
+	"tag_arrow_left_outline",
+	"tag_arrow_right",
+	"tag_arrow_right_outline",
+	"tag_arrow_up",
+	"tag_arrow_up_outline",
+	"tag_check",
+	"tag_check_outline",
+	"tag_faces",
+	"tag_heart",
+	"tag_heart_outline",
+	"tag_minus",
+	"tag_minus_outline",
+	"tag_multiple",
+	"tag_multiple_outline",
+	"tag_off",
+	"tag_off_outline",
+	"tag_outline",
+	"tag_plus",
+	"tag_plus_outline",
+	"tag_remove",
+	"tag_remove_outline",
+	"tag_search",
+	"tag_search_outline",
+	"tag_text",
+	"tag_text_outline",
+	"tailwind",
+	"tally_mark_1",
+	"tally_mark_2",
+	"tally_mark_3",
+	"tally_mark_4",
+	"tally_mark_5",
+	"tangram",
+	"tank",
+	"tanker_truck",
+	"tape_drive",
+	"tape_measure",
+	"target",
+	"target_account",
+	"target_variant",
+	"taxi",
+	"tea",
+	"tea_outline",
+	"teamviewer",
+	"teddy_bear",
+	"telescope",
+	"television",
+	"television_ambient_light",
+	"television_box",
+	"television_classic",
+	"television_classic_off",
+	"television_guide",
+	"television_off",
+	"television_pause",
+	"television_play",
+	"television_shimmer",
+	"television_stop",
+	"temperature_celsius",
+	"temperature_fahrenheit",
+	"temperature_kelvin",
+	"tennis",
+	"tennis_ball",
+	"tent",
+	"terraform",
+	"test_tube",
+	"test_tube_empty",
+	"test_tube_off",
+	"text",
+	"text_account",
+	"text_box",
+	"text_box_check",
+	"text_box_check_outline",
+	"text_box_edit",
+	"text_box_edit_outline",
+	"text_box_minus",
+	"text_box_minus_outline",
+	"text_box_multiple",
+	"text_box_multiple_outline",
+	"text_box_outline",
+	"text_box_plus",
+	"text_box_plus_outline",
+	"text_box_remove",
+	"text_box_remove_outline",
+	"text_box_search",
+	"text_box_search_outline",
+	"text_long",
+	"text_recognition",
+	"text_search",
+	"text_search_variant",
+	"text_shadow",
+	"text_short",
+	"text_to_speech",
+	"text_to_speech_off",
+	"texture",
+	"texture_box",
+	"theater",
+	"theme_light_dark",
+	"thermometer",
+	"thermometer_alert",
+	"thermometer_bluetooth",
+	"thermometer_check",
+	"thermometer_chevron_down",
+	"thermometer_chevron_up",
+	"thermometer_high",
+	"thermometer_lines",
+	"thermometer_low",
+	"thermometer_minus",
+	"thermometer_off",
+	"thermometer_plus",
+	"thermometer_water",
+	"thermostat",
+	"thermostat_box",
+	"thought_bubble",
+	"thought_bubble_outline",
+	"thumb_down",
+	"thumb_down_outline",
+	"thumb_up",
+	"thumb_up_outline",
+	"thumbs_up_down",
+	"thumbs_up_down_outline",
+	"ticket",
+	"ticket_account",
+	"ticket_confirmation",
+	"ticket_confirmation_outline",
+	"ticket_outline",
+	"ticket_percent",
+	"ticket_percent_outline",
+	"tie",
+	"tilde",
+	"tilde_off",
+	"timelapse",
+	"timeline",
+	"timeline_alert",
+	"timeline_alert_outline",
+	"timeline_check",
+	"timeline_check_outline",
+	"timeline_clock",
+	"timeline_clock_outline",
+	"timeline_help",
+	"timeline_help_outline",
+	"timeline_minus",
+	"timeline_minus_outline",
+	"timeline_outline",
+	"timeline_plus",
+	"timeline_plus_outline",
+	"timeline_remove",
+	"timeline_remove_outline",
+	"timeline_text",
+	"timeline_text_outline",
+	"timer",
+	"timer_10",
+	"timer_3",
+	"timer_alert",
+	"timer_alert_outline",
+	"timer_cancel",
+	"timer_cancel_outline",
+	"timer_check",
+	"timer_check_outline",
+	"timer_cog",
+	"timer_cog_outline",
+	"timer_edit",
+	"timer_edit_outline",
+	"timer_lock",
+	"timer_lock_open",
+	"timer_lock_open_outline",
+	"timer_lock_outline",
+	"timer_marker",
+	"timer_marker_outline",
+	"timer_minus",
+	"timer_minus_outline",
+	"timer_music",
+	"timer_music_outline",
+	"timer_off",
+	"timer_off_outline",
+	"timer_outline",
+	"timer_pause",
+	"timer_pause_outline",
+	"timer_play",
+	"timer_play_outline",
+	"timer_plus",
+	"timer_plus_outline",
+	"timer_refresh",
+	"timer_refresh_outline",
+	"timer_remove",
+	"timer_remove_outline",
+	"timer_sand",
+	"timer_sand_complete",
+	"timer_sand_empty",
+	"timer_sand_full",
+	"timer_sand_paused",
+	"timer_settings",
+	"timer_settings_outline",
+	"timer_star",
+	"timer_star_outline",
+	"timer_stop",
+	"timer_stop_outline",
+	"timer_sync",
+	"timer_sync_outline",
+	"timetable",
+	"tire",
+	"toaster",
+	"toaster_off",
+	"toaster_oven",
+	"toggle_switch",
+	"toggle_switch_off",
+	"toggle_switch_off_outline",
+	"toggle_switch_outline",
+	"toggle_switch_variant",
+	"toggle_switch_variant_off",
+	"toilet",
+	"toolbox",
+	"toolbox_outline",
+	"tools",
+	"tooltip",
+	"tooltip_account",
+	"tooltip_cellphone",
+	"tooltip_check",
+	"tooltip_check_outline",
+	"tooltip_edit",
+	"tooltip_edit_outline",
+	"tooltip_image",
+	"tooltip_image_outline",
+	"tooltip_minus",
+	"tooltip_minus_outline",
+	"tooltip_outline",
+	"tooltip_plus",
+	"tooltip_plus_outline",
+	"tooltip_remove",
+	"tooltip_remove_outline",
+	"tooltip_text",
+	"tooltip_text_outline",
+	"tooth",
+	"tooth_outline",
+	"toothbrush",
+	"toothbrush_electric",
+	"toothbrush_paste",
+	"torch",
+	"tortoise",
+	"toslink",
+	"tournament",
+	"tow_truck",
+	"tower_beach",
+	"tower_fire",
+	"town_hall",
+	"toy_brick",
+	"toy_brick_marker",
+	"toy_brick_marker_outline",
+	"toy_brick_minus",
+	"toy_brick_minus_outline",
+	"toy_brick_outline",
+	"toy_brick_plus",
+	"toy_brick_plus_outline",
+	"toy_brick_remove",
+	"toy_brick_remove_outline",
+	"toy_brick_search",
+	"toy_brick_search_outline",
+	"track_light",
+	"trackpad",
+	"trackpad_lock",
+	"tractor",
+	"tractor_variant",
+	"trademark",
+	"traffic_cone",
+	"traffic_light",
+	"traffic_light_outline",
+	"train",
+	"train_car",
+	"train_car_passenger",
+	"train_car_passenger_door",
+	"train_car_passenger_door_open",
+	"train_car_passenger_variant",
+	"train_variant",
+	"tram",
+	"tram_side",
+	"transcribe",
+	"transcribe_close",
+	"transfer",
+	"transfer_down",
+	"transfer_left",
+	"transfer_right",
+	"transfer_up",
+	"transit_connection",
+	"transit_connection_horizontal",
+	"transit_connection_variant",
+	"transit_detour",
+	"transit_skip",
+	"transit_transfer",
+	"transition",
+	"transition_masked",
+	"translate",
+	"translate_off",
+	"transmission_tower",
+	"transmission_tower_export",
+	"transmission_tower_import",
+	"transmission_tower_off",
+	"trash_can",
+	"trash_can_outline",
+	"tray",
+	"tray_alert",
+	"tray_arrow_down",
+	"tray_arrow_up",
+	"tray_full",
+	"tray_minus",
+	"tray_plus",
+	"tray_remove",
+	"treasure_chest",
+	"tree",
+	"tree_outline",
+	"trello",
+	"trending_down",
+	"trending_neutral",
+	"trending_up",
+	"triangle",
+	"triangle_outline",
+	"triangle_small_down",
+	"triangle_small_up",
+	"triangle_wave",
+	"triforce",
+	"trophy",
+	"trophy_award",
+	"trophy_broken",
+	"trophy_outline",
+	"trophy_variant",
+	"trophy_variant_outline",
+	"truck",
+	"truck_alert",
+	"truck_alert_outline",
+	"truck_cargo_container",
+	"truck_check",
+	"truck_check_outline",
+	"truck_delivery",
+	"truck_delivery_outline",
+	"truck_fast",
+	"truck_fast_outline",
+	"truck_flatbed",
+	"truck_minus",
+	"truck_minus_outline",
+	"truck_outline",
+	"truck_plus",
+	"truck_plus_outline",
+	"truck_remove",
+	"truck_remove_outline",
+	"truck_snowflake",
+	"truck_trailer",
+	"trumpet",
+	"tshirt_crew",
+	"tshirt_crew_outline",
+	"tshirt_v",
+	"tshirt_v_outline",
+	"tsunami",
+	"tumble_dryer",
+	"tumble_dryer_alert",
+	"tumble_dryer_off",
+	"tune",
+	"tune_variant",
+	"tune_vertical",
+	"tune_vertical_variant",
+	"tunnel",
+	"tunnel_outline",
+	"turbine",
+	"turkey",
+	"turnstile",
+	"turnstile_outline",
+	"turtle",
+	"twitch",
+	"twitter",
+	"two_factor_authentication",
+	"typewriter",
+	"ubisoft",
+	"ubuntu",
+	"ufo",
+	"ufo_outline",
+	"ultra_high_definition",
+	"umbraco",
+	"umbrella",
+	"umbrella_beach",
+	"umbrella_beach_outline",
+	"umbrella_closed",
+	"umbrella_closed_outline",
+	"umbrella_closed_variant",
+	"umbrella_outline",
+	"undo",
+	"undo_variant",
+	"unfold_less_horizontal",
+	"unfold_less_vertical",
+	"unfold_more_horizontal",
+	"unfold_more_vertical",
+	"ungroup",
+	"unicode",
+	"unicorn",
+	"unicorn_variant",
+	"unicycle",
+	"unity",
+	"unreal",
+	"update",
+	"upload",
+	"upload_lock",
+	"upload_lock_outline",
+	"upload_multiple",
+	"upload_network",
+	"upload_network_outline",
+	"upload_off",
+	"upload_off_outline",
+	"upload_outline",
+	"usb",
+	"usb_flash_drive",
+	"usb_flash_drive_outline",
+	"usb_port",
+	"vacuum",
+	"vacuum_outline",
+	"valve",
+	"valve_closed",
+	"valve_open",
+	"van_passenger",
+	"van_utility",
+	"vanish",
+	"vanish_quarter",
+	"vanity_light",
+	"variable",
+	"variable_box",
+	"vector_arrange_above",
+	"vector_arrange_below",
+	"vector_bezier",
+	"vector_circle",
+	"vector_circle_variant",
+	"vector_combine",
+	"vector_curve",
+	"vector_difference",
+	"vector_difference_ab",
+	"vector_difference_ba",
+	"vector_ellipse",
+	"vector_intersection",
+	"vector_line",
+	"vector_link",
+	"vector_point",
+	"vector_polygon",
+	"vector_polygon_variant",
+	"vector_polyline",
+	"vector_polyline_edit",
+	"vector_polyline_minus",
+	"vector_polyline_plus",
+	"vector_polyline_remove",
+	"vector_radius",
+	"vector_rectangle",
+	"vector_selection",
+	"vector_square",
+	"vector_square_close",
+	"vector_square_edit",
+	"vector_square_minus",
+	"vector_square_open",
+	"vector_square_plus",
+	"vector_square_remove",
+	"vector_triangle",
+	"vector_union",
+	"vhs",
+	"vibrate",
+	"vibrate_off",
+	"video",
+	"video_2d",
+	"video_3d",
+	"video_3d_off",
+	"video_3d_variant",
+	"video_4k_box",
+	"video_account",
+	"video_box",
+	"video_box_off",
+	"video_check",
+	"video_check_outline",
+	"video_high_definition",
+	"video_image",
+	"video_input_antenna",
+	"video_input_component",
+	"video_input_hdmi",
+	"video_input_scart",
+	"video_input_svideo",
+	"video_marker",
+	"video_marker_outline",
+	"video_minus",
+	"video_minus_outline",
+	"video_off",
+	"video_off_outline",
+	"video_outline",
+	"video_plus",
+	"video_plus_outline",
+	"video_stabilization",
+	"video_switch",
+	"video_switch_outline",
+	"video_vintage",
+	"video_wireless",
+	"video_wireless_outline",
+	"view_agenda",
+	"view_agenda_outline",
+	"view_array",
+	"view_array_outline",
+	"view_carousel",
+	"view_carousel_outline",
+	"view_column",
+	"view_column_outline",
+	"view_comfy",
+	"view_comfy_outline",
+	"view_compact",
+	"view_compact_outline",
+	"view_dashboard",
+	"view_dashboard_edit",
+	"view_dashboard_edit_outline",
+	"view_dashboard_outline",
+	"view_dashboard_variant",
+	"view_dashboard_variant_outline",
+	"view_day",
+	"view_day_outline",
+	"view_gallery",
+	"view_gallery_outline",
+	"view_grid",
+	"view_grid_outline",
+	"view_grid_plus",
+	"view_grid_plus_outline",
+	"view_headline",
+	"view_list",
+	"view_list_outline",
+	"view_module",
+	"view_module_outline",
+	"view_parallel",
+	"view_parallel_outline",
+	"view_quilt",
+	"view_quilt_outline",
+	"view_sequential",
+	"view_sequential_outline",
+	"view_split_horizontal",
+	"view_split_vertical",
+	"view_stream",
+	"view_stream_outline",
+	"view_week",
+	"view_week_outline",
+	"vimeo",
+	"violin",
+	"virtual_reality",
+	"virus",
+	"virus_off",
+	"virus_off_outline",
+	"virus_outline",
+	"vlc",
+	"voicemail",
+	"volcano",
+	"volcano_outline",
+	"volleyball",
+	"volume_high",
+	"volume_low",
+	"volume_medium",
+	"volume_minus",
+	"volume_mute",
+	"volume_off",
+	"volume_plus",
+	"volume_source",
+	"volume_variant_off",
+	"volume_vibrate",
+	"vote",
+	"vote_outline",
+	"vpn",
+	"vuejs",
+	"vuetify",
+	"walk",
+	"wall",
+	"wall_fire",
+	"wall_sconce",
+	"wall_sconce_flat",
+	"wall_sconce_flat_outline",
+	"wall_sconce_flat_variant",
+	"wall_sconce_flat_variant_outline",
+	"wall_sconce_outline",
+	"wall_sconce_round",
+	"wall_sconce_round_outline",
+	"wall_sconce_round_variant",
+	"wall_sconce_round_variant_outline",
+	"wallet",
+	"wallet_giftcard",
+	"wallet_membership",
+	"wallet_outline",
+	"wallet_plus",
+	"wallet_plus_outline",
+	"wallet_travel",
+	"wallpaper",
+	"wan",
+	"wardrobe",
+	"wardrobe_outline",
+	"warehouse",
+	"washing_machine",
+	"washing_machine_alert",
+	"washing_machine_off",
+	"watch",
+	"watch_export",
+	"watch_export_variant",
+	"watch_import",
+	"watch_import_variant",
+	"watch_variant",
+	"watch_vibrate",
+	"watch_vibrate_off",
+	"water",
+	"water_alert",
+	"water_alert_outline",
+	"water_boiler",
+	"water_boiler_alert",
+	"water_boiler_off",
+	"water_check",
+	"water_check_outline",
+	"water_circle",
+	"water_minus",
+	"water_minus_outline",
+	"water_off",
+	"water_off_outline",
+	"water_opacity",
+	"water_outline",
+	"water_percent",
+	"water_percent_alert",
+	"water_plus",
+	"water_plus_outline",
+	"water_polo",
+	"water_pump",
+	"water_pump_off",
+	"water_remove",
+	"water_remove_outline",
+	"water_sync",
+	"water_thermometer",
+	"water_thermometer_outline",
+	"water_well",
+	"water_well_outline",
+	"waterfall",
+	"watering_can",
+	"watering_can_outline",
+	"watermark",
+	"wave",
+	"waveform",
+	"waves",
+	"waves_arrow_left",
+	"waves_arrow_right",
+	"waves_arrow_up",
+	"waze",
+	"weather_cloudy",
+	"weather_cloudy_alert",
+	"weather_cloudy_arrow_right",
+	"weather_cloudy_clock",
+	"weather_fog",
+	"weather_hail",
+	"weather_hazy",
+	"weather_hurricane",
+	"weather_lightning",
+	"weather_lightning_rainy",
+	"weather_night",
+	"weather_night_partly_cloudy",
+	"weather_partly_cloudy",
+	"weather_partly_lightning",
+	"weather_partly_rainy",
+	"weather_partly_snowy",
+	"weather_partly_snowy_rainy",
+	"weather_pouring",
+	"weather_rainy",
+	"weather_snowy",
+	"weather_snowy_heavy",
+	"weather_snowy_rainy",
+	"weather_sunny",
+	"weather_sunny_alert",
+	"weather_sunny_off",
+	"weather_sunset",
+	"weather_sunset_down",
+	"weather_sunset_up",
+	"weather_tornado",
+	"weather_windy",
+	"weather_windy_variant",
+	"web",
+	"web_box",
+	"web_cancel",
+	"web_check",
+	"web_clock",
+	"web_minus",
+	"web_off",
+	"web_plus",
+	"web_refresh",
+	"web_remove",
+	"web_sync",
+	"webcam",
+	"webcam_off",
+	"webhook",
+	"webpack",
+	"webrtc",
+	"wechat",
+	"weight",
+	"weight_gram",
+	"weight_kilogram",
+	"weight_lifter",
+	"weight_pound",
+	"whatsapp",
+	"wheel_barrow",
+	"wheelchair",
+	"wheelchair_accessibility",
+	"whistle",
+	"whistle_outline",
+	"white_balance_auto",
+	"white_balance_incandescent",
+	"white_balance_iridescent",
+	"white_balance_sunny",
+	"widgets",
+	"widgets_outline",
+	"wifi",
+	"wifi_alert",
+	"wifi_arrow_down",
+	"wifi_arrow_left",
+	"wifi_arrow_left_right",
+	"wifi_arrow_right",
+	"wifi_arrow_up",
+	"wifi_arrow_up_down",
+	"wifi_cancel",
+	"wifi_check",
+	"wifi_cog",
+	"wifi_lock",
+	"wifi_lock_open",
+	"wifi_marker",
+	"wifi_minus",
+	"wifi_off",
+	"wifi_plus",
+	"wifi_refresh",
+	"wifi_remove",
+	"wifi_settings",
+	"wifi_star",
+	"wifi_strength_1",
+	"wifi_strength_1_alert",
+	"wifi_strength_1_lock",
+	"wifi_strength_1_lock_open",
+	"wifi_strength_2",
+	"wifi_strength_2_alert",
+	"wifi_strength_2_lock",
+	"wifi_strength_2_lock_open",
+	"wifi_strength_3",
+	"wifi_strength_3_alert",
+	"wifi_strength_3_lock",
+	"wifi_strength_3_lock_open",
+	"wifi_strength_4",
+	"wifi_strength_4_alert",
+	"wifi_strength_4_lock",
+	"wifi_strength_4_lock_open",
+	"wifi_strength_alert_outline",
+	"wifi_strength_lock_open_outline",
+	"wifi_strength_lock_outline",
+	"wifi_strength_off",
+	"wifi_strength_off_outline",
+	"wifi_strength_outline",
+	"wifi_sync",
+	"wikipedia",
+	"wind_power",
+	"wind_power_outline",
+	"wind_turbine",
+	"wind_turbine_alert",
+	"wind_turbine_check",
+	"window_close",
+	"window_closed",
+	"window_closed_variant",
+	"window_maximize",
+	"window_minimize",
+	"window_open",
+	"window_open_variant",
+	"window_restore",
+	"window_shutter",
+	"window_shutter_alert",
+	"window_shutter_cog",
+	"window_shutter_open",
+	"window_shutter_settings",
+	"windsock",
+	"wiper",
+	"wiper_wash",
+	"wiper_wash_alert",
+	"wizard_hat",
+	"wordpress",
+	"wrap",
+	"wrap_disabled",
+	"wrench",
+	"wrench_clock",
+	"wrench_outline",
+	"xamarin",
+	"xml",
+	"xmpp",
+	"yahoo",
+	"yeast",
+	"yin_yang",
+	"yoga",
+	"youtube",
+	"youtube_gaming",
+	"youtube_studio",
+	"youtube_subscription",
+	"youtube_tv",
+	"yurt",
+	"z_wave",
+	"zend",
+	"zigbee",
+	"zip_box",
+	"zip_box_outline",
+	"zip_disk",
+	"zodiac_aquarius",
+	"zodiac_aries",
+	"zodiac_cancer",
+	"zodiac_capricorn",
+	"zodiac_gemini",
+	"zodiac_leo",
+	"zodiac_libra",
+	"zodiac_pisces",
+	"zodiac_sagittarius",
+	"zodiac_scorpio",
+	"zodiac_taurus",
+	"zodiac_virgo",
+}
+
+// glyphValues are the glyphs in the class, in the same order as glyphIDs.
+var glyphValues = [len(glyphIDs)]nf.Glyph{
+	AbTesting,
+	Abacus,
+	AbjadArabic,
+	AbjadHebrew,
+	AbugidaDevanagari,
+	AbugidaThai,
+	AccessPoint,
+	AccessPointCheck,
+	AccessPointMinus,
+	AccessPointNetwork,
+	AccessPointNetworkOff,
+	AccessPointOff,
+	AccessPointPlus,
+	AccessPointRemove,
+	Account,
+	AccountAlert,
+	AccountAlertOutline,
+	AccountArrowDown,
+	AccountArrowDownOutline,
+	AccountArrowLeft,
+	AccountArrowLeftOutline,
+	AccountArrowRight,
+	AccountArrowRightOutline,
+	AccountArrowUp,
+	AccountArrowUpOutline,
+	AccountBox,
+	AccountBoxMultiple,
+	AccountBoxMultipleOutline,
+	AccountBoxOutline,
+	AccountCancel,
+	AccountCancelOutline,
+	AccountCash,
+	AccountCashOutline,
+	AccountCheck,
+	AccountCheckOutline,
+	AccountChild,
+	AccountChildCircle,
+	AccountChildOutline,
+	AccountCircle,
+	AccountCircleOutline,
+	AccountClock,
+	AccountClockOutline,
+	AccountCog,
+	AccountCogOutline,
+	AccountConvert,
+	AccountConvertOutline,
+	AccountCowboyHat,
+	AccountCowboyHatOutline,
+	AccountDetails,
+	AccountDetailsOutline,
+	AccountEdit,
+	AccountEditOutline,
+	AccountEye,
+	AccountEyeOutline,
+	AccountFilter,
+	AccountFilterOutline,
+	AccountGroup,
+	AccountGroupOutline,
+	AccountHardHat,
+	AccountHardHatOutline,
+	AccountHeart,
+	AccountHeartOutline,
+	AccountInjury,
+	AccountInjuryOutline,
+	AccountKey,
+	AccountKeyOutline,
+	AccountLock,
+	AccountLockOpen,
+	AccountLockOpenOutline,
+	AccountLockOutline,
+	AccountMinus,
+	AccountMinusOutline,
+	AccountMultiple,
+	AccountMultipleCheck,
+	AccountMultipleCheckOutline,
+	AccountMultipleMinus,
+	AccountMultipleMinusOutline,
+	AccountMultipleOutline,
+	AccountMultiplePlus,
+	AccountMultiplePlusOutline,
+	AccountMultipleRemove,
+	AccountMultipleRemoveOutline,
+	AccountMusic,
+	AccountMusicOutline,
+	AccountNetwork,
+	AccountNetworkOutline,
+	AccountOff,
+	AccountOffOutline,
+	AccountOutline,
+	AccountPlus,
+	AccountPlusOutline,
+	AccountQuestion,
+	AccountQuestionOutline,
+	AccountReactivate,
+	AccountReactivateOutline,
+	AccountRemove,
+	AccountRemoveOutline,
+	AccountSchool,
+	AccountSchoolOutline,
+	AccountSearch,
+	AccountSearchOutline,
+	AccountSettings,
+	AccountSettingsOutline,
+	AccountStar,
+	AccountStarOutline,
+	AccountSupervisor,
+	AccountSupervisorCircle,
+	AccountSupervisorCircleOutline,
+	AccountSupervisorOutline,
+	AccountSwitch,
+	AccountSwitchOutline,
+	AccountSync,
+	AccountSyncOutline,
+	AccountTie,
+	AccountTieHat,
+	AccountTieHatOutline,
+	AccountTieOutline,
+	AccountTieVoice,
+	AccountTieVoiceOff,
+	AccountTieVoiceOffOutline,
+	AccountTieVoiceOutline,
+	AccountTieWoman,
+	AccountVoice,
+	AccountVoiceOff,
+	AccountWrench,
+	AccountWrenchOutline,
+	Adjust,
+	Advertisements,
+	AdvertisementsOff,
+	AirConditioner,
+	AirFilter,
+	AirHorn,
+	AirHumidifier,
+	AirHumidifierOff,
+	AirPurifier,
+	Airbag,
+	Airballoon,
+	AirballoonOutline,
+	Airplane,
+	AirplaneAlert,
+	AirplaneCheck,
+	AirplaneClock,
+	AirplaneCog,
+	AirplaneEdit,
+	AirplaneLanding,
+	AirplaneMarker,
+	AirplaneMinus,
+	AirplaneOff,
+	AirplanePlus,
+	AirplaneRemove,
+	AirplaneSearch,
+	AirplaneSettings,
+	AirplaneTakeoff,
+	Airport,
+	Alarm,
+	AlarmBell,
+	AlarmCheck,
+	AlarmLight,
+	AlarmLightOff,
+	AlarmLightOffOutline,
+	AlarmLightOutline,
+	AlarmMultiple,
+	AlarmNote,
+	AlarmNoteOff,
+	AlarmOff,
+	AlarmPanel,
+	AlarmPanelOutline,
+	AlarmPlus,
+	AlarmSnooze,
+	Album,
+	Alert,
+	AlertBox,
+	AlertBoxOutline,
+	AlertCircle,
+	AlertCircleCheck,
+	AlertCircleCheckOutline,
+	AlertCircleOutline,
+	AlertDecagram,
+	AlertDecagramOutline,
+	AlertMinus,
+	AlertMinusOutline,
+	AlertOctagon,
+	AlertOctagonOutline,
+	AlertOctagram,
+	AlertOctagramOutline,
+	AlertOutline,
+	AlertPlus,
+	AlertPlusOutline,
+	AlertRemove,
+	AlertRemoveOutline,
+	AlertRhombus,
+	AlertRhombusOutline,
+	Alien,
+	AlienOutline,
+	AlignHorizontalCenter,
+	AlignHorizontalDistribute,
+	AlignHorizontalLeft,
+	AlignHorizontalRight,
+	AlignVerticalBottom,
+	AlignVerticalCenter,
+	AlignVerticalDistribute,
+	AlignVerticalTop,
+	AllInclusive,
+	AllInclusiveBox,
+	AllInclusiveBoxOutline,
+	Allergy,
+	Alpha,
+	AlphaA,
+	AlphaABox,
+	AlphaABoxOutline,
+	AlphaACircle,
+	AlphaACircleOutline,
+	AlphaB,
+	AlphaBBox,
+	AlphaBBoxOutline,
+	AlphaBCircle,
+	AlphaBCircleOutline,
+	AlphaC,
+	AlphaCBox,
+	AlphaCBoxOutline,
+	AlphaCCircle,
+	AlphaCCircleOutline,
+	AlphaD,
+	AlphaDBox,
+	AlphaDBoxOutline,
+	AlphaDCircle,
+	AlphaDCircleOutline,
+	AlphaE,
+	AlphaEBox,
+	AlphaEBoxOutline,
+	AlphaECircle,
+	AlphaECircleOutline,
+	AlphaF,
+	AlphaFBox,
+	AlphaFBoxOutline,
+	AlphaFCircle,
+	AlphaFCircleOutline,
+	AlphaG,
+	AlphaGBox,
+	AlphaGBoxOutline,
+	AlphaGCircle,
+	AlphaGCircleOutline,
+	AlphaH,
+	AlphaHBox,
+	AlphaHBoxOutline,
+	AlphaHCircle,
+	AlphaHCircleOutline,
+	AlphaI,
+	AlphaIBox,
+	AlphaIBoxOutline,
+	AlphaICircle,
+	AlphaICircleOutline,
+	AlphaJ,
+	AlphaJBox,
+	AlphaJBoxOutline,
+	AlphaJCircle,
+	AlphaJCircleOutline,
+	AlphaK,
+	AlphaKBox,
+	AlphaKBoxOutline,
+	AlphaKCircle,
+	AlphaKCircleOutline,
+	AlphaL,
+	AlphaLBox,
+	AlphaLBoxOutline,
+	AlphaLCircle,
+	AlphaLCircleOutline,
+	AlphaM,
+	AlphaMBox,
+	AlphaMBoxOutline,
+	AlphaMCircle,
+	AlphaMCircleOutline,
+	AlphaN,
+	AlphaNBox,
+	AlphaNBoxOutline,
+	AlphaNCircle,
+	AlphaNCircleOutline,
+	AlphaO,
+	AlphaOBox,
+	AlphaOBoxOutline,
+	AlphaOCircle,
+	AlphaOCircleOutline,
+	AlphaP,
+	AlphaPBox,
+	AlphaPBoxOutline,
+	AlphaPCircle,
+	AlphaPCircleOutline,
+	AlphaQ,
+	AlphaQBox,
+	AlphaQBoxOutline,
+	AlphaQCircle,
+	AlphaQCircleOutline,
+	AlphaR,
+	AlphaRBox,
+	AlphaRBoxOutline,
+	AlphaRCircle,
+	AlphaRCircleOutline,
+	AlphaS,
+	AlphaSBox,
+	AlphaSBoxOutline,
+	AlphaSCircle,
+	AlphaSCircleOutline,
+	AlphaT,
+	AlphaTBox,
+	AlphaTBoxOutline,
+	AlphaTCircle,
+	AlphaTCircleOutline,
+	AlphaU,
+	AlphaUBox,
+	AlphaUBoxOutline,
+	AlphaUCircle,
+	AlphaUCircleOutline,
+	AlphaV,
+	AlphaVBox,
+	AlphaVBoxOutline,
+	AlphaVCircle,
+	AlphaVCircleOutline,
+	AlphaW,
+	AlphaWBox,
+	AlphaWBoxOutline,
+	AlphaWCircle,
+	AlphaWCircleOutline,
+	AlphaX,
+	AlphaXBox,
+	AlphaXBoxOutline,
+	AlphaXCircle,
+	AlphaXCircleOutline,
+	AlphaY,
+	AlphaYBox,
+	AlphaYBoxOutline,
+	AlphaYCircle,
+	AlphaYCircleOutline,
+	AlphaZ,
+	AlphaZBox,
+	AlphaZBoxOutline,
+	AlphaZCircle,
+	AlphaZCircleOutline,
+	AlphabetAurebesh,
+	AlphabetCyrillic,
+	AlphabetGreek,
+	AlphabetLatin,
+	AlphabetPiqad,
+	AlphabetTengwar,
+	Alphabetical,
+	AlphabeticalOff,
+	AlphabeticalVariant,
+	AlphabeticalVariantOff,
+	Altimeter,
+	Ambulance,
+	Ammunition,
+	Ampersand,
+	Amplifier,
+	AmplifierOff,
+	Anchor,
+	Android,
+	AndroidMessages,
+	AndroidStudio,
+	AngleAcute,
+	AngleObtuse,
+	AngleRight,
+	Angular,
+	Angularjs,
+	Animation,
+	AnimationOutline,
+	AnimationPlay,
+	AnimationPlayOutline,
+	Ansible,
+	Antenna,
+	Anvil,
+	ApacheKafka,
+	Api,
+	ApiOff,
+	Apple,
+	AppleFinder,
+	AppleIcloud,
+	AppleIos,
+	AppleKeyboardCaps,
+	AppleKeyboardCommand,
+	AppleKeyboardControl,
+	AppleKeyboardOption,
+	AppleKeyboardShift,
+	AppleSafari,
+	Application,
+	ApplicationArray,
+	ApplicationArrayOutline,
+	ApplicationBraces,
+	ApplicationBracesOutline,
+	ApplicationBrackets,
+	ApplicationBracketsOutline,
+	ApplicationCog,
+	ApplicationCogOutline,
+	ApplicationEdit,
+	ApplicationEditOutline,
+	ApplicationExport,
+	ApplicationImport,
+	ApplicationOutline,
+	ApplicationParentheses,
+	ApplicationParenthesesOutline,
+	ApplicationSettings,
+	ApplicationSettingsOutline,
+	ApplicationVariable,
+	ApplicationVariableOutline,
+	ApproximatelyEqual,
+	ApproximatelyEqualBox,
+	Apps,
+	AppsBox,
+	Arch,
+	Archive,
+	ArchiveAlert,
+	ArchiveAlertOutline,
+	ArchiveArrowDown,
+	ArchiveArrowDownOutline,
+	ArchiveArrowUp,
+	ArchiveArrowUpOutline,
+	ArchiveCancel,
+	ArchiveCancelOutline,
+	ArchiveCheck,
+	ArchiveCheckOutline,
+	ArchiveClock,
+	ArchiveClockOutline,
+	ArchiveCog,
+	ArchiveCogOutline,
+	ArchiveEdit,
+	ArchiveEditOutline,
+	ArchiveEye,
+	ArchiveEyeOutline,
+	ArchiveLock,
+	ArchiveLockOpen,
+	ArchiveLockOpenOutline,
+	ArchiveLockOutline,
+	ArchiveMarker,
+	ArchiveMarkerOutline,
+	ArchiveMinus,
+	ArchiveMinusOutline,
+	ArchiveMusic,
+	ArchiveMusicOutline,
+	ArchiveOff,
+	ArchiveOffOutline,
+	ArchiveOutline,
+	ArchivePlus,
+	ArchivePlusOutline,
+	ArchiveRefresh,
+	ArchiveRefreshOutline,
+	ArchiveRemove,
+	ArchiveRemoveOutline,
+	ArchiveSearch,
+	ArchiveSearchOutline,
+	ArchiveSettings,
+	ArchiveSettingsOutline,
+	ArchiveStar,
+	ArchiveStarOutline,
+	ArchiveSync,
+	ArchiveSyncOutline,
+	ArmFlex,
+	ArmFlexOutline,
+	ArrangeBringForward,
+	ArrangeBringToFront,
+	ArrangeSendBackward,
+	ArrangeSendToBack,
+	ArrowAll,
+	ArrowBottomLeft,
+	ArrowBottomLeftBoldBox,
+	ArrowBottomLeftBoldBoxOutline,
+	ArrowBottomLeftBoldOutline,
+	ArrowBottomLeftThick,
+	ArrowBottomLeftThin,
+	ArrowBottomLeftThinCircleOutline,
+	ArrowBottomRight,
+	ArrowBottomRightBoldBox,
+	ArrowBottomRightBoldBoxOutline,
+	ArrowBottomRightBoldOutline,
+	ArrowBottomRightThick,
+	ArrowBottomRightThin,
+	ArrowBottomRightThinCircleOutline,
+	ArrowCollapse,
+	ArrowCollapseAll,
+	ArrowCollapseDown,
+	ArrowCollapseHorizontal,
+	ArrowCollapseLeft,
+	ArrowCollapseRight,
+	ArrowCollapseUp,
+	ArrowCollapseVertical,
+	ArrowDecision,
+	ArrowDecisionAuto,
+	ArrowDecisionAutoOutline,
+	ArrowDecisionOutline,
+	ArrowDown,
+	ArrowDownBold,
+	ArrowDownBoldBox,
+	ArrowDownBoldBoxOutline,
+	ArrowDownBoldCircle,
+	ArrowDownBoldCircleOutline,
+	ArrowDownBoldHexagonOutline,
+	ArrowDownBoldOutline,
+	ArrowDownBox,
+	ArrowDownCircle,
+	ArrowDownCircleOutline,
+	ArrowDownDropCircle,
+	ArrowDownDropCircleOutline,
+	ArrowDownLeft,
+	ArrowDownLeftBold,
+	ArrowDownRight,
+	ArrowDownRightBold,
+	ArrowDownThick,
+	ArrowDownThin,
+	ArrowDownThinCircleOutline,
+	ArrowExpand,
+	ArrowExpandAll,
+	ArrowExpandDown,
+	ArrowExpandHorizontal,
+	ArrowExpandLeft,
+	ArrowExpandRight,
+	ArrowExpandUp,
+	ArrowExpandVertical,
+	ArrowHorizontalLock,
+	ArrowLeft,
+	ArrowLeftBold,
+	ArrowLeftBoldBox,
+	ArrowLeftBoldBoxOutline,
+	ArrowLeftBoldCircle,
+	ArrowLeftBoldCircleOutline,
+	ArrowLeftBoldHexagonOutline,
+	ArrowLeftBoldOutline,
+	ArrowLeftBottom,
+	ArrowLeftBottomBold,
+	ArrowLeftBox,
+	ArrowLeftCircle,
+	ArrowLeftCircleOutline,
+	ArrowLeftDropCircle,
+	ArrowLeftDropCircleOutline,
+	ArrowLeftRight,
+	ArrowLeftRightBold,
+	ArrowLeftRightBoldOutline,
+	ArrowLeftThick,
+	ArrowLeftThin,
+	ArrowLeftThinCircleOutline,
+	ArrowLeftTop,
+	ArrowLeftTopBold,
+	ArrowProjectile,
+	ArrowProjectileMultiple,
+	ArrowRight,
+	ArrowRightBold,
+	ArrowRightBoldBox,
+	ArrowRightBoldBoxOutline,
+	ArrowRightBoldCircle,
+	ArrowRightBoldCircleOutline,
+	ArrowRightBoldHexagonOutline,
+	ArrowRightBoldOutline,
+	ArrowRightBottom,
+	ArrowRightBottomBold,
+	ArrowRightBox,
+	ArrowRightCircle,
+	ArrowRightCircleOutline,
+	ArrowRightDropCircle,
+	ArrowRightDropCircleOutline,
+	ArrowRightThick,
+	ArrowRightThin,
+	ArrowRightThinCircleOutline,
+	ArrowRightTop,
+	ArrowRightTopBold,
+	ArrowSplitHorizontal,
+	ArrowSplitVertical,
+	ArrowTopLeft,
+	ArrowTopLeftBoldBox,
+	ArrowTopLeftBoldBoxOutline,
+	ArrowTopLeftBoldOutline,
+	ArrowTopLeftBottomRight,
+	ArrowTopLeftBottomRightBold,
+	ArrowTopLeftThick,
+	ArrowTopLeftThin,
+	ArrowTopLeftThinCircleOutline,
+	ArrowTopRight,
+	ArrowTopRightBoldBox,
+	ArrowTopRightBoldBoxOutline,
+	ArrowTopRightBoldOutline,
+	ArrowTopRightBottomLeft,
+	ArrowTopRightBottomLeftBold,
+	ArrowTopRightThick,
+	ArrowTopRightThin,
+	ArrowTopRightThinCircleOutline,
+	ArrowUDownLeft,
+	ArrowUDownLeftBold,
+	ArrowUDownRight,
+	ArrowUDownRightBold,
+	ArrowULeftBottom,
+	ArrowULeftBottomBold,
+	ArrowULeftTop,
+	ArrowULeftTopBold,
+	ArrowURightBottom,
+	ArrowURightBottomBold,
+	ArrowURightTop,
+	ArrowURightTopBold,
+	ArrowUUpLeft,
+	ArrowUUpLeftBold,
+	ArrowUUpRight,
+	ArrowUUpRightBold,
+	ArrowUp,
+	ArrowUpBold,
+	ArrowUpBoldBox,
+	ArrowUpBoldBoxOutline,
+	ArrowUpBoldCircle,
+	ArrowUpBoldCircleOutline,
+	ArrowUpBoldHexagonOutline,
+	ArrowUpBoldOutline,
+	ArrowUpBox,
+	ArrowUpCircle,
+	ArrowUpCircleOutline,
+	ArrowUpDown,
+	ArrowUpDownBold,
+	ArrowUpDownBoldOutline,
+	ArrowUpDropCircle,
+	ArrowUpDropCircleOutline,
+	ArrowUpLeft,
+	ArrowUpLeftBold,
+	ArrowUpRight,
+	ArrowUpRightBold,
+	ArrowUpThick,
+	ArrowUpThin,
+	ArrowUpThinCircleOutline,
+	ArrowVerticalLock,
+	Artstation,
+	AspectRatio,
+	Assistant,
+	Asterisk,
+	AsteriskCircleOutline,
+	At,
+	Atlassian,
+	Atm,
+	Atom,
+	AtomVariant,
+	Attachment,
+	AttachmentCheck,
+	AttachmentLock,
+	AttachmentMinus,
+	AttachmentOff,
+	AttachmentPlus,
+	AttachmentRemove,
+	AudioInputRca,
+	AudioInputStereoMinijack,
+	AudioInputXlr,
+	AudioVideo,
+	AudioVideoOff,
+	AugmentedReality,
+	AutoDownload,
+	AutoFix,
+	AutoUpload,
+	Autorenew,
+	AutorenewOff,
+	AvTimer,
+	Aws,
+	Axe,
+	AxeBattle,
+	Axis,
+	AxisArrow,
+	AxisArrowInfo,
+	AxisArrowLock,
+	AxisLock,
+	AxisXArrow,
+	AxisXArrowLock,
+	AxisXRotateClockwise,
+	AxisXRotateCounterclockwise,
+	AxisXYArrowLock,
+	AxisYArrow,
+	AxisYArrowLock,
+	AxisYRotateClockwise,
+	AxisYRotateCounterclockwise,
+	AxisZArrow,
+	AxisZArrowLock,
+	AxisZRotateClockwise,
+	AxisZRotateCounterclockwise,
+	Babel,
+	Baby,
+	BabyBottle,
+	BabyBottleOutline,
+	BabyBuggy,
+	BabyCarriage,
+	BabyCarriageOff,
+	BabyFace,
+	BabyFaceOutline,
+	Backburger,
+	Backspace,
+	BackspaceOutline,
+	BackspaceReverse,
+	BackspaceReverseOutline,
+	BackupRestore,
+	Bacteria,
+	BacteriaOutline,
+	BadgeAccount,
+	BadgeAccountAlert,
+	BadgeAccountAlertOutline,
+	BadgeAccountHorizontal,
+	BadgeAccountHorizontalOutline,
+	BadgeAccountOutline,
+	Badminton,
+	BagCarryOn,
+	BagCarryOnCheck,
+	BagCarryOnOff,
+	BagChecked,
+	BagPersonal,
+	BagPersonalOff,
+	BagPersonalOffOutline,
+	BagPersonalOutline,
+	BagSuitcase,
+	BagSuitcaseOff,
+	BagSuitcaseOffOutline,
+	BagSuitcaseOutline,
+	Baguette,
+	Balcony,
+	Balloon,
+	Ballot,
+	BallotOutline,
+	BallotRecount,
+	BallotRecountOutline,
+	Bandage,
+	Bank,
+	BankCheck,
+	BankMinus,
+	BankOff,
+	BankOffOutline,
+	BankOutline,
+	BankPlus,
+	BankRemove,
+	BankTransfer,
+	BankTransferIn,
+	BankTransferOut,
+	Barcode,
+	BarcodeOff,
+	BarcodeScan,
+	Barley,
+	BarleyOff,
+	Barn,
+	Barrel,
+	BarrelOutline,
+	Baseball,
+	BaseballBat,
+	BaseballDiamond,
+	BaseballDiamondOutline,
+	Bash,
+	Basket,
+	BasketCheck,
+	BasketCheckOutline,
+	BasketFill,
+	BasketMinus,
+	BasketMinusOutline,
+	BasketOff,
+	BasketOffOutline,
+	BasketOutline,
+	BasketPlus,
+	BasketPlusOutline,
+	BasketRemove,
+	BasketRemoveOutline,
+	BasketUnfill,
+	Basketball,
+	BasketballHoop,
+	BasketballHoopOutline,
+	Bat,
+	Bathtub,
+	BathtubOutline,
+	Battery,
+	Battery10,
+	Battery10Bluetooth,
+	Battery20,
+	Battery20Bluetooth,
+	Battery30,
+	Battery30Bluetooth,
+	Battery40,
+	Battery40Bluetooth,
+	Battery50,
+	Battery50Bluetooth,
+	Battery60,
+	Battery60Bluetooth,
+	Battery70,
+	Battery70Bluetooth,
+	Battery80,
+	Battery80Bluetooth,
+	Battery90,
+	Battery90Bluetooth,
+	BatteryAlert,
+	BatteryAlertBluetooth,
+	BatteryAlertVariant,
+	BatteryAlertVariantOutline,
+	BatteryArrowDown,
+	BatteryArrowDownOutline,
+	BatteryArrowUp,
+	BatteryArrowUpOutline,
+	BatteryBluetooth,
+	BatteryBluetoothVariant,
+	BatteryCharging,
+	BatteryCharging10,
+	BatteryCharging100,
+	BatteryCharging20,
+	BatteryCharging30,
+	BatteryCharging40,
+	BatteryCharging50,
+	BatteryCharging60,
+	BatteryCharging70,
+	BatteryCharging80,
+	BatteryCharging90,
+	BatteryChargingHigh,
+	BatteryChargingLow,
+	BatteryChargingMedium,
+	BatteryChargingOutline,
+	BatteryChargingWireless,
+	BatteryChargingWireless10,
+	BatteryChargingWireless20,
+	BatteryChargingWireless30,
+	BatteryChargingWireless40,
+	BatteryChargingWireless50,
+	BatteryChargingWireless60,
+	BatteryChargingWireless70,
+	BatteryChargingWireless80,
+	BatteryChargingWireless90,
+	BatteryChargingWirelessAlert,
+	BatteryChargingWirelessOutline,
+	BatteryCheck,
+	BatteryCheckOutline,
+	BatteryClock,
+	BatteryClockOutline,
+	BatteryHeart,
+	BatteryHeartOutline,
+	BatteryHeartVariant,
+	BatteryHigh,
+	BatteryLock,
+	BatteryLockOpen,
+	BatteryLow,
+	BatteryMedium,
+	BatteryMinus,
+	BatteryMinusOutline,
+	BatteryMinusVariant,
+	BatteryNegative,
+	BatteryOff,
+	BatteryOffOutline,
+	BatteryOutline,
+	BatteryPlus,
+	BatteryPlusOutline,
+	BatteryPlusVariant,
+	BatteryPositive,
+	BatteryRemove,
+	BatteryRemoveOutline,
+	BatterySync,
+	BatterySyncOutline,
+	BatteryUnknown,
+	BatteryUnknownBluetooth,
+	Beach,
+	Beaker,
+	BeakerAlert,
+	BeakerAlertOutline,
+	BeakerCheck,
+	BeakerCheckOutline,
+	BeakerMinus,
+	BeakerMinusOutline,
+	BeakerOutline,
+	BeakerPlus,
+	BeakerPlusOutline,
+	BeakerQuestion,
+	BeakerQuestionOutline,
+	BeakerRemove,
+	BeakerRemoveOutline,
+	Bed,
+	BedDouble,
+	BedDoubleOutline,
+	BedEmpty,
+	BedKing,
+	BedKingOutline,
+	BedOutline,
+	BedQueen,
+	BedQueenOutline,
+	BedSingle,
+	BedSingleOutline,
+	Bee,
+	BeeFlower,
+	BeehiveOffOutline,
+	BeehiveOutline,
+	Beekeeper,
+	Beer,
+	BeerOutline,
+	Bell,
+	BellAlert,
+	BellAlertOutline,
+	BellBadge,
+	BellBadgeOutline,
+	BellCancel,
+	BellCancelOutline,
+	BellCheck,
+	BellCheckOutline,
+	BellCircle,
+	BellCircleOutline,
+	BellCog,
+	BellCogOutline,
+	BellMinus,
+	BellMinusOutline,
+	BellOff,
+	BellOffOutline,
+	BellOutline,
+	BellPlus,
+	BellPlusOutline,
+	BellRemove,
+	BellRemoveOutline,
+	BellRing,
+	BellRingOutline,
+	BellSleep,
+	BellSleepOutline,
+	Beta,
+	Betamax,
+	Biathlon,
+	Bicycle,
+	BicycleBasket,
+	BicycleCargo,
+	BicycleElectric,
+	BicyclePennyFarthing,
+	Bike,
+	BikeFast,
+	Billboard,
+	Billiards,
+	BilliardsRack,
+	Binoculars,
+	Bio,
+	Biohazard,
+	Bird,
+	Bitbucket,
+	Bitcoin,
+	BlackMesa,
+	Blender,
+	BlenderOutline,
+	BlenderSoftware,
+	Blinds,
+	BlindsHorizontal,
+	BlindsHorizontalClosed,
+	BlindsOpen,
+	BlindsVertical,
+	BlindsVerticalClosed,
+	BlockHelper,
+	BloodBag,
+	Bluetooth,
+	BluetoothAudio,
+	BluetoothConnect,
+	BluetoothOff,
+	BluetoothSettings,
+	BluetoothTransfer,
+	Blur,
+	BlurLinear,
+	BlurOff,
+	BlurRadial,
+	Bolt,
+	Bomb,
+	BombOff,
+	Bone,
+	BoneOff,
+	Book,
+	BookAccount,
+	BookAccountOutline,
+	BookAlert,
+	BookAlertOutline,
+	BookAlphabet,
+	BookArrowDown,
+	BookArrowDownOutline,
+	BookArrowLeft,
+	BookArrowLeftOutline,
+	BookArrowRight,
+	BookArrowRightOutline,
+	BookArrowUp,
+	BookArrowUpOutline,
+	BookCancel,
+	BookCancelOutline,
+	BookCheck,
+	BookCheckOutline,
+	BookClock,
+	BookClockOutline,
+	BookCog,
+	BookCogOutline,
+	BookCross,
+	BookEdit,
+	BookEditOutline,
+	BookEducation,
+	BookEducationOutline,
+	BookHeart,
+	BookHeartOutline,
+	BookInformationVariant,
+	BookLock,
+	BookLockOpen,
+	BookLockOpenOutline,
+	BookLockOutline,
+	BookMarker,
+	BookMarkerOutline,
+	BookMinus,
+	BookMinusMultiple,
+	BookMinusMultipleOutline,
+	BookMinusOutline,
+	BookMultiple,
+	BookMultipleOutline,
+	BookMusic,
+	BookMusicOutline,
+	BookOff,
+	BookOffOutline,
+	BookOpen,
+	BookOpenBlankVariant,
+	BookOpenOutline,
+	BookOpenPageVariant,
+	BookOpenPageVariantOutline,
+	BookOpenVariant,
+	BookOutline,
+	BookPlay,
+	BookPlayOutline,
+	BookPlus,
+	BookPlusMultiple,
+	BookPlusMultipleOutline,
+	BookPlusOutline,
+	BookRefresh,
+	BookRefreshOutline,
+	BookRemove,
+	BookRemoveMultiple,
+	BookRemoveMultipleOutline,
+	BookRemoveOutline,
+	BookSearch,
+	BookSearchOutline,
+	BookSettings,
+	BookSettingsOutline,
+	BookSync,
+	BookSyncOutline,
+	BookVariant,
+	BookVariantMultiple,
+	Bookmark,
+	BookmarkBoxMultiple,
+	BookmarkBoxMultipleOutline,
+	BookmarkCheck,
+	BookmarkCheckOutline,
+	BookmarkMinus,
+	BookmarkMinusOutline,
+	BookmarkMultiple,
+	BookmarkMultipleOutline,
+	BookmarkMusic,
+	BookmarkMusicOutline,
+	BookmarkOff,
+	BookmarkOffOutline,
+	BookmarkOutline,
+	BookmarkPlus,
+	BookmarkPlusOutline,
+	BookmarkRemove,
+	BookmarkRemoveOutline,
+	Bookshelf,
+	BoomGate,
+	BoomGateAlert,
+	BoomGateAlertOutline,
+	BoomGateArrowDown,
+	BoomGateArrowDownOutline,
+	BoomGateArrowUp,
+	BoomGateArrowUpOutline,
+	BoomGateOutline,
+	BoomGateUp,
+	BoomGateUpOutline,
+	Boombox,
+	Boomerang,
+	Bootstrap,
+	BorderAll,
+	BorderAllVariant,
+	BorderBottom,
+	BorderBottomVariant,
+	BorderColor,
+	BorderHorizontal,
+	BorderInside,
+	BorderLeft,
+	BorderLeftVariant,
+	BorderNone,
+	BorderNoneVariant,
+	BorderOutside,
+	BorderRight,
+	BorderRightVariant,
+	BorderStyle,
+	BorderTop,
+	BorderTopVariant,
+	BorderVertical,
+	BottleSoda,
+	BottleSodaClassic,
+	BottleSodaClassicOutline,
+	BottleSodaOutline,
+	BottleTonic,
+	BottleTonicOutline,
+	BottleTonicPlus,
+	BottleTonicPlusOutline,
+	BottleTonicSkull,
+	BottleTonicSkullOutline,
+	BottleWine,
+	BottleWineOutline,
+	BowArrow,
+	BowTie,
+	Bowl,
+	BowlMix,
+	BowlMixOutline,
+	BowlOutline,
+	Bowling,
+	Box,
+	BoxCutter,
+	BoxCutterOff,
+	BoxShadow,
+	BoxingGlove,
+	Braille,
+	Brain,
+	BreadSlice,
+	BreadSliceOutline,
+	Bridge,
+	Briefcase,
+	BriefcaseAccount,
+	BriefcaseAccountOutline,
+	BriefcaseArrowLeftRight,
+	BriefcaseArrowLeftRightOutline,
+	BriefcaseArrowUpDown,
+	BriefcaseArrowUpDownOutline,
+	BriefcaseCheck,
+	BriefcaseCheckOutline,
+	BriefcaseClock,
+	BriefcaseClockOutline,
+	BriefcaseDownload,
+	BriefcaseDownloadOutline,
+	BriefcaseEdit,
+	BriefcaseEditOutline,
+	BriefcaseEye,
+	BriefcaseEyeOutline,
+	BriefcaseMinus,
+	BriefcaseMinusOutline,
+	BriefcaseOff,
+	BriefcaseOffOutline,
+	BriefcaseOutline,
+	BriefcasePlus,
+	BriefcasePlusOutline,
+	BriefcaseRemove,
+	BriefcaseRemoveOutline,
+	BriefcaseSearch,
+	BriefcaseSearchOutline,
+	BriefcaseUpload,
+	BriefcaseUploadOutline,
+	BriefcaseVariant,
+	BriefcaseVariantOff,
+	BriefcaseVariantOffOutline,
+	BriefcaseVariantOutline,
+	Brightness1,
+	Brightness2,
+	Brightness3,
+	Brightness4,
+	Brightness5,
+	Brightness6,
+	Brightness7,
+	BrightnessAuto,
+	BrightnessPercent,
+	Broadcast,
+	BroadcastOff,
+	Broom,
+	Brush,
+	BrushOff,
+	BrushOutline,
+	BrushVariant,
+	Bucket,
+	BucketOutline,
+	Buffet,
+	Bug,
+	BugCheck,
+	BugCheckOutline,
+	BugOutline,
+	Bugle,
+	BulkheadLight,
+	Bulldozer,
+	Bullet,
+	BulletinBoard,
+	Bullhorn,
+	BullhornOutline,
+	BullhornVariant,
+	BullhornVariantOutline,
+	Bullseye,
+	BullseyeArrow,
+	Bulma,
+	BunkBed,
+	BunkBedOutline,
+	Bus,
+	BusAlert,
+	BusArticulatedEnd,
+	BusArticulatedFront,
+	BusClock,
+	BusDoubleDecker,
+	BusElectric,
+	BusMarker,
+	BusMultiple,
+	BusSchool,
+	BusSide,
+	BusStop,
+	BusStopCovered,
+	BusStopUncovered,
+	Butterfly,
+	ButterflyOutline,
+	CabinAFrame,
+	CableData,
+	Cached,
+	Cactus,
+	Cake,
+	CakeLayered,
+	CakeVariant,
+	CakeVariantOutline,
+	Calculator,
+	CalculatorVariant,
+	CalculatorVariantOutline,
+	Calendar,
+	CalendarAccount,
+	CalendarAccountOutline,
+	CalendarAlert,
+	CalendarArrowLeft,
+	CalendarArrowRight,
+	CalendarBlank,
+	CalendarBlankMultiple,
+	CalendarBlankOutline,
+	CalendarCheck,
+	CalendarCheckOutline,
+	CalendarClock,
+	CalendarClockOutline,
+	CalendarCollapseHorizontal,
+	CalendarCursor,
+	CalendarEdit,
+	CalendarEnd,
+	CalendarExpandHorizontal,
+	CalendarExport,
+	CalendarHeart,
+	CalendarImport,
+	CalendarLock,
+	CalendarLockOutline,
+	CalendarMinus,
+	CalendarMonth,
+	CalendarMonthOutline,
+	CalendarMultiple,
+	CalendarMultipleCheck,
+	CalendarMultiselect,
+	CalendarOutline,
+	CalendarPlus,
+	CalendarQuestion,
+	CalendarRange,
+	CalendarRangeOutline,
+	CalendarRefresh,
+	CalendarRefreshOutline,
+	CalendarRemove,
+	CalendarRemoveOutline,
+	CalendarSearch,
+	CalendarStar,
+	CalendarStart,
+	CalendarSync,
+	CalendarSyncOutline,
+	CalendarText,
+	CalendarTextOutline,
+	CalendarToday,
+	CalendarTodayOutline,
+	CalendarWeek,
+	CalendarWeekBegin,
+	CalendarWeekBeginOutline,
+	CalendarWeekEnd,
+	CalendarWeekEndOutline,
+	CalendarWeekOutline,
+	CalendarWeekend,
+	CalendarWeekendOutline,
+	CallMade,
+	CallMerge,
+	CallMissed,
+	CallReceived,
+	CallSplit,
+	Camcorder,
+	CamcorderOff,
+	Camera,
+	CameraAccount,
+	CameraBurst,
+	CameraControl,
+	CameraDocument,
+	CameraDocumentOff,
+	CameraEnhance,
+	CameraEnhanceOutline,
+	CameraFlip,
+	CameraFlipOutline,
+	CameraFront,
+	CameraFrontVariant,
+	CameraGopro,
+	CameraImage,
+	CameraIris,
+	CameraLock,
+	CameraLockOutline,
+	CameraMarker,
+	CameraMarkerOutline,
+	CameraMeteringCenter,
+	CameraMeteringMatrix,
+	CameraMeteringPartial,
+	CameraMeteringSpot,
+	CameraOff,
+	CameraOffOutline,
+	CameraOutline,
+	CameraPartyMode,
+	CameraPlus,
+	CameraPlusOutline,
+	CameraRear,
+	CameraRearVariant,
+	CameraRetake,
+	CameraRetakeOutline,
+	CameraSwitch,
+	CameraSwitchOutline,
+	CameraTimer,
+	CameraWireless,
+	CameraWirelessOutline,
+	Campfire,
+	Cancel,
+	Candelabra,
+	CandelabraFire,
+	Candle,
+	Candy,
+	CandyOff,
+	CandyOffOutline,
+	CandyOutline,
+	Candycane,
+	Cannabis,
+	CannabisOff,
+	CapsLock,
+	Car,
+	Car2Plus,
+	Car3Plus,
+	CarArrowLeft,
+	CarArrowRight,
+	CarBack,
+	CarBattery,
+	CarBrakeAbs,
+	CarBrakeAlert,
+	CarBrakeFluidLevel,
+	CarBrakeHold,
+	CarBrakeLowPressure,
+	CarBrakeParking,
+	CarBrakeRetarder,
+	CarBrakeTemperature,
+	CarBrakeWornLinings,
+	CarChildSeat,
+	CarClock,
+	CarClutch,
+	CarCog,
+	CarConnected,
+	CarConvertible,
+	CarCoolantLevel,
+	CarCruiseControl,
+	CarDefrostFront,
+	CarDefrostRear,
+	CarDoor,
+	CarDoorLock,
+	CarElectric,
+	CarElectricOutline,
+	CarEmergency,
+	CarEsp,
+	CarEstate,
+	CarHatchback,
+	CarInfo,
+	CarKey,
+	CarLiftedPickup,
+	CarLightAlert,
+	CarLightDimmed,
+	CarLightFog,
+	CarLightHigh,
+	CarLimousine,
+	CarMultiple,
+	CarOff,
+	CarOutline,
+	CarParkingLights,
+	CarPickup,
+	CarSeat,
+	CarSeatCooler,
+	CarSeatHeater,
+	CarSelect,
+	CarSettings,
+	CarShiftPattern,
+	CarSide,
+	CarSpeedLimiter,
+	CarSports,
+	CarTireAlert,
+	CarTractionControl,
+	CarTurbocharger,
+	CarWash,
+	CarWindshield,
+	CarWindshieldOutline,
+	CarWireless,
+	CarWrench,
+	Carabiner,
+	Caravan,
+	Card,
+	CardAccountDetails,
+	CardAccountDetailsOutline,
+	CardAccountDetailsStar,
+	CardAccountDetailsStarOutline,
+	CardAccountMail,
+	CardAccountMailOutline,
+	CardAccountPhone,
+	CardAccountPhoneOutline,
+	CardBulleted,
+	CardBulletedOff,
+	CardBulletedOffOutline,
+	CardBulletedOutline,
+	CardBulletedSettings,
+	CardBulletedSettingsOutline,
+	CardMinus,
+	CardMinusOutline,
+	CardMultiple,
+	CardMultipleOutline,
+	CardOff,
+	CardOffOutline,
+	CardOutline,
+	CardPlus,
+	CardPlusOutline,
+	CardRemove,
+	CardRemoveOutline,
+	CardSearch,
+	CardSearchOutline,
+	CardText,
+	CardTextOutline,
+	Cards,
+	CardsClub,
+	CardsClubOutline,
+	CardsDiamond,
+	CardsDiamondOutline,
+	CardsOutline,
+	CardsPlaying,
+	CardsPlayingClub,
+	CardsPlayingClubMultiple,
+	CardsPlayingClubMultipleOutline,
+	CardsPlayingClubOutline,
+	CardsPlayingDiamond,
+	CardsPlayingDiamondMultiple,
+	CardsPlayingDiamondMultipleOutline,
+	CardsPlayingDiamondOutline,
+	CardsPlayingHeart,
+	CardsPlayingHeartMultiple,
+	CardsPlayingHeartMultipleOutline,
+	CardsPlayingHeartOutline,
+	CardsPlayingOutline,
+	CardsPlayingSpade,
+	CardsPlayingSpadeMultiple,
+	CardsPlayingSpadeMultipleOutline,
+	CardsPlayingSpadeOutline,
+	CardsSpade,
+	CardsSpadeOutline,
+	CardsVariant,
+	Carrot,
+	Cart,
+	CartArrowDown,
+	CartArrowRight,
+	CartArrowUp,
+	CartCheck,
+	CartHeart,
+	CartMinus,
+	CartOff,
+	CartOutline,
+	CartPlus,
+	CartRemove,
+	CartVariant,
+	CaseSensitiveAlt,
+	Cash,
+	Cash100,
+	CashCheck,
+	CashClock,
+	CashFast,
+	CashLock,
+	CashLockOpen,
+	CashMarker,
+	CashMinus,
+	CashMultiple,
+	CashPlus,
+	CashRefund,
+	CashRegister,
+	CashRemove,
+	CashSync,
+	Cassette,
+	Cast,
+	CastAudio,
+	CastAudioVariant,
+	CastConnected,
+	CastEducation,
+	CastOff,
+	CastVariant,
+	Castle,
+	Cat,
+	Cctv,
+	CctvOff,
+	CeilingFan,
+	CeilingFanLight,
+	CeilingLight,
+	CeilingLightMultiple,
+	CeilingLightMultipleOutline,
+	CeilingLightOutline,
+	Cellphone,
+	CellphoneArrowDown,
+	CellphoneArrowDownVariant,
+	CellphoneBasic,
+	CellphoneCharging,
+	CellphoneCheck,
+	CellphoneCog,
+	CellphoneDock,
+	CellphoneInformation,
+	CellphoneKey,
+	CellphoneLink,
+	CellphoneLinkOff,
+	CellphoneLock,
+	CellphoneMarker,
+	CellphoneMessage,
+	CellphoneMessageOff,
+	CellphoneNfc,
+	CellphoneNfcOff,
+	CellphoneOff,
+	CellphonePlay,
+	CellphoneRemove,
+	CellphoneScreenshot,
+	CellphoneSettings,
+	CellphoneSound,
+	CellphoneText,
+	CellphoneWireless,
+	Centos,
+	Certificate,
+	CertificateOutline,
+	ChairRolling,
+	ChairSchool,
+	Chandelier,
+	Charity,
+	ChartArc,
+	ChartAreaspline,
+	ChartAreasplineVariant,
+	ChartBar,
+	ChartBarStacked,
+	ChartBellCurve,
+	ChartBellCurveCumulative,
+	ChartBox,
+	ChartBoxOutline,
+	ChartBoxPlusOutline,
+	ChartBubble,
+	ChartDonut,
+	ChartDonutVariant,
+	ChartGantt,
+	ChartHistogram,
+	ChartLine,
+	ChartLineStacked,
+	ChartLineVariant,
+	ChartMultiline,
+	ChartMultiple,
+	ChartPie,
+	ChartPpf,
+	ChartSankey,
+	ChartSankeyVariant,
+	ChartScatterPlot,
+	ChartScatterPlotHexbin,
+	ChartTimeline,
+	ChartTimelineVariant,
+	ChartTimelineVariantShimmer,
+	ChartTree,
+	ChartWaterfall,
+	Chat,
+	ChatAlert,
+	ChatAlertOutline,
+	ChatMinus,
+	ChatMinusOutline,
+	ChatOutline,
+	ChatPlus,
+	ChatPlusOutline,
+	ChatProcessing,
+	ChatProcessingOutline,
+	ChatQuestion,
+	ChatQuestionOutline,
+	ChatRemove,
+	ChatRemoveOutline,
+	ChatSleep,
+	ChatSleepOutline,
+	Check,
+	CheckAll,
+	CheckBold,
+	CheckCircle,
+	CheckCircleOutline,
+	CheckDecagram,
+	CheckDecagramOutline,
+	CheckNetwork,
+	CheckNetworkOutline,
+	CheckOutline,
+	CheckUnderline,
+	CheckUnderlineCircle,
+	CheckUnderlineCircleOutline,
+	Checkbook,
+	CheckboxBlank,
+	CheckboxBlankBadge,
+	CheckboxBlankBadgeOutline,
+	CheckboxBlankCircle,
+	CheckboxBlankCircleOutline,
+	CheckboxBlankOff,
+	CheckboxBlankOffOutline,
+	CheckboxBlankOutline,
+	CheckboxIntermediate,
+	CheckboxMarked,
+	CheckboxMarkedCircle,
+	CheckboxMarkedCircleOutline,
+	CheckboxMarkedCirclePlusOutline,
+	CheckboxMarkedOutline,
+	CheckboxMultipleBlank,
+	CheckboxMultipleBlankCircle,
+	CheckboxMultipleBlankCircleOutline,
+	CheckboxMultipleBlankOutline,
+	CheckboxMultipleMarked,
+	CheckboxMultipleMarkedCircle,
+	CheckboxMultipleMarkedCircleOutline,
+	CheckboxMultipleMarkedOutline,
+	CheckboxMultipleOutline,
+	CheckboxOutline,
+	Checkerboard,
+	CheckerboardMinus,
+	CheckerboardPlus,
+	CheckerboardRemove,
+	Cheese,
+	CheeseOff,
+	ChefHat,
+	ChemicalWeapon,
+	ChessBishop,
+	ChessKing,
+	ChessKnight,
+	ChessPawn,
+	ChessQueen,
+	ChessRook,
+	ChevronDoubleDown,
+	ChevronDoubleLeft,
+	ChevronDoubleRight,
+	ChevronDoubleUp,
+	ChevronDown,
+	ChevronDownBox,
+	ChevronDownBoxOutline,
+	ChevronDownCircle,
+	ChevronDownCircleOutline,
+	ChevronLeft,
+	ChevronLeftBox,
+	ChevronLeftBoxOutline,
+	ChevronLeftCircle,
+	ChevronLeftCircleOutline,
+	ChevronRight,
+	ChevronRightBox,
+	ChevronRightBoxOutline,
+	ChevronRightCircle,
+	ChevronRightCircleOutline,
+	ChevronTripleDown,
+	ChevronTripleLeft,
+	ChevronTripleRight,
+	ChevronTripleUp,
+	ChevronUp,
+	ChevronUpBox,
+	ChevronUpBoxOutline,
+	ChevronUpCircle,
+	ChevronUpCircleOutline,
+	ChiliAlert,
+	ChiliAlertOutline,
+	ChiliHot,
+	ChiliHotOutline,
+	ChiliMedium,
+	ChiliMediumOutline,
+	ChiliMild,
+	ChiliMildOutline,
+	ChiliOff,
+	ChiliOffOutline,
+	Chip,
+	Church,
+	Cigar,
+	CigarOff,
+	CircleBox,
+	CircleBoxOutline,
+	CircleDouble,
+	CircleEditOutline,
+	CircleExpand,
+	CircleHalf,
+	CircleHalfFull,
+	CircleMedium,
+	CircleMultiple,
+	CircleMultipleOutline,
+	CircleOffOutline,
+	CircleOpacity,
+	CircleSlice1,
+	CircleSlice2,
+	CircleSlice3,
+	CircleSlice4,
+	CircleSlice5,
+	CircleSlice6,
+	CircleSlice7,
+	CircleSlice8,
+	CircleSmall,
+	CircularSaw,
+	City,
+	CityVariant,
+	CityVariantOutline,
+	Clipboard,
+	ClipboardAccount,
+	ClipboardAccountOutline,
+	ClipboardAlert,
+	ClipboardAlertOutline,
+	ClipboardArrowDown,
+	ClipboardArrowDownOutline,
+	ClipboardArrowLeft,
+	ClipboardArrowLeftOutline,
+	ClipboardArrowRight,
+	ClipboardArrowRightOutline,
+	ClipboardArrowUp,
+	ClipboardArrowUpOutline,
+	ClipboardCheck,
+	ClipboardCheckMultiple,
+	ClipboardCheckMultipleOutline,
+	ClipboardCheckOutline,
+	ClipboardClock,
+	ClipboardClockOutline,
+	ClipboardEdit,
+	ClipboardEditOutline,
+	ClipboardFile,
+	ClipboardFileOutline,
+	ClipboardFlow,
+	ClipboardFlowOutline,
+	ClipboardList,
+	ClipboardListOutline,
+	ClipboardMinus,
+	ClipboardMinusOutline,
+	ClipboardMultiple,
+	ClipboardMultipleOutline,
+	ClipboardOff,
+	ClipboardOffOutline,
+	ClipboardOutline,
+	ClipboardPlay,
+	ClipboardPlayMultiple,
+	ClipboardPlayMultipleOutline,
+	ClipboardPlayOutline,
+	ClipboardPlus,
+	ClipboardPlusOutline,
+	ClipboardPulse,
+	ClipboardPulseOutline,
+	ClipboardRemove,
+	ClipboardRemoveOutline,
+	ClipboardSearch,
+	ClipboardSearchOutline,
+	ClipboardText,
+	ClipboardTextClock,
+	ClipboardTextClockOutline,
+	ClipboardTextMultiple,
+	ClipboardTextMultipleOutline,
+	ClipboardTextOff,
+	ClipboardTextOffOutline,
+	ClipboardTextOutline,
+	ClipboardTextPlay,
+	ClipboardTextPlayOutline,
+	ClipboardTextSearch,
+	ClipboardTextSearchOutline,
+	Clippy,
+	Clock,
+	ClockAlert,
+	ClockAlertOutline,
+	ClockCheck,
+	ClockCheckOutline,
+	ClockDigital,
+	ClockEdit,
+	ClockEditOutline,
+	ClockEnd,
+	ClockFast,
+	ClockIn,
+	ClockMinus,
+	ClockMinusOutline,
+	ClockOut,
+	ClockOutline,
+	ClockPlus,
+	ClockPlusOutline,
+	ClockRemove,
+	ClockRemoveOutline,
+	ClockStart,
+	ClockTimeEight,
+	ClockTimeEightOutline,
+	ClockTimeEleven,
+	ClockTimeElevenOutline,
+	ClockTimeFive,
+	ClockTimeFiveOutline,
+	ClockTimeFour,
+	ClockTimeFourOutline,
+	ClockTimeNine,
+	ClockTimeNineOutline,
+	ClockTimeOne,
+	ClockTimeOneOutline,
+	ClockTimeSeven,
+	ClockTimeSevenOutline,
+	ClockTimeSix,
+	ClockTimeSixOutline,
+	ClockTimeTen,
+	ClockTimeTenOutline,
+	ClockTimeThree,
+	ClockTimeThreeOutline,
+	ClockTimeTwelve,
+	ClockTimeTwelveOutline,
+	ClockTimeTwo,
+	ClockTimeTwoOutline,
+	Close,
+	CloseBox,
+	CloseBoxMultiple,
+	CloseBoxMultipleOutline,
+	CloseBoxOutline,
+	CloseCircle,
+	CloseCircleMultiple,
+	CloseCircleMultipleOutline,
+	CloseCircleOutline,
+	CloseNetwork,
+	CloseNetworkOutline,
+	CloseOctagon,
+	CloseOctagonOutline,
+	CloseOutline,
+	CloseThick,
+	ClosedCaption,
+	ClosedCaptionOutline,
+	Cloud,
+	CloudAlert,
+	CloudBraces,
+	CloudCheck,
+	CloudCheckOutline,
+	CloudCircle,
+	CloudDownload,
+	CloudDownloadOutline,
+	CloudLock,
+	CloudLockOutline,
+	CloudOffOutline,
+	CloudOutline,
+	CloudPercent,
+	CloudPercentOutline,
+	CloudPrint,
+	CloudPrintOutline,
+	CloudQuestion,
+	CloudRefresh,
+	CloudSearch,
+	CloudSearchOutline,
+	CloudSync,
+	CloudSyncOutline,
+	CloudTags,
+	CloudUpload,
+	CloudUploadOutline,
+	Clover,
+	CoachLamp,
+	CoachLampVariant,
+	CoatRack,
+	CodeArray,
+	CodeBraces,
+	CodeBracesBox,
+	CodeBrackets,
+	CodeEqual,
+	CodeGreaterThan,
+	CodeGreaterThanOrEqual,
+	CodeJson,
+	CodeLessThan,
+	CodeLessThanOrEqual,
+	CodeNotEqual,
+	CodeNotEqualVariant,
+	CodeParentheses,
+	CodeParenthesesBox,
+	CodeString,
+	CodeTags,
+	CodeTagsCheck,
+	Codepen,
+	Coffee,
+	CoffeeMaker,
+	CoffeeMakerCheck,
+	CoffeeMakerCheckOutline,
+	CoffeeMakerOutline,
+	CoffeeOff,
+	CoffeeOffOutline,
+	CoffeeOutline,
+	CoffeeToGo,
+	CoffeeToGoOutline,
+	Coffin,
+	Cog,
+	CogBox,
+	CogClockwise,
+	CogCounterclockwise,
+	CogOff,
+	CogOffOutline,
+	CogOutline,
+	CogPause,
+	CogPauseOutline,
+	CogPlay,
+	CogPlayOutline,
+	CogRefresh,
+	CogRefreshOutline,
+	CogStop,
+	CogStopOutline,
+	CogSync,
+	CogSyncOutline,
+	CogTransfer,
+	CogTransferOutline,
+	Cogs,
+	Collage,
+	CollapseAll,
+	CollapseAllOutline,
+	ColorHelper,
+	Comma,
+	CommaBox,
+	CommaBoxOutline,
+	CommaCircle,
+	CommaCircleOutline,
+	Comment,
+	CommentAccount,
+	CommentAccountOutline,
+	CommentAlert,
+	CommentAlertOutline,
+	CommentArrowLeft,
+	CommentArrowLeftOutline,
+	CommentArrowRight,
+	CommentArrowRightOutline,
+	CommentBookmark,
+	CommentBookmarkOutline,
+	CommentCheck,
+	CommentCheckOutline,
+	CommentEdit,
+	CommentEditOutline,
+	CommentEye,
+	CommentEyeOutline,
+	CommentFlash,
+	CommentFlashOutline,
+	CommentMinus,
+	CommentMinusOutline,
+	CommentMultiple,
+	CommentMultipleOutline,
+	CommentOff,
+	CommentOffOutline,
+	CommentOutline,
+	CommentPlus,
+	CommentPlusOutline,
+	CommentProcessing,
+	CommentProcessingOutline,
+	CommentQuestion,
+	CommentQuestionOutline,
+	CommentQuote,
+	CommentQuoteOutline,
+	CommentRemove,
+	CommentRemoveOutline,
+	CommentSearch,
+	CommentSearchOutline,
+	CommentText,
+	CommentTextMultiple,
+	CommentTextMultipleOutline,
+	CommentTextOutline,
+	Compare,
+	CompareHorizontal,
+	CompareRemove,
+	CompareVertical,
+	Compass,
+	CompassOff,
+	CompassOffOutline,
+	CompassOutline,
+	CompassRose,
+	Compost,
+	Cone,
+	ConeOff,
+	Connection,
+	Console,
+	ConsoleLine,
+	ConsoleNetwork,
+	ConsoleNetworkOutline,
+	Consolidate,
+	ContactlessPayment,
+	ContactlessPaymentCircle,
+	ContactlessPaymentCircleOutline,
+	Contacts,
+	ContactsOutline,
+	Contain,
+	ContainEnd,
+	ContainStart,
+	ContentCopy,
+	ContentCut,
+	ContentDuplicate,
+	ContentPaste,
+	ContentSave,
+	ContentSaveAlert,
+	ContentSaveAlertOutline,
+	ContentSaveAll,
+	ContentSaveAllOutline,
+	ContentSaveCheck,
+	ContentSaveCheckOutline,
+	ContentSaveCog,
+	ContentSaveCogOutline,
+	ContentSaveEdit,
+	ContentSaveEditOutline,
+	ContentSaveMove,
+	ContentSaveMoveOutline,
+	ContentSaveOff,
+	ContentSaveOffOutline,
+	ContentSaveOutline,
+	ContentSaveSettings,
+	ContentSaveSettingsOutline,
+	Contrast,
+	ContrastBox,
+	ContrastCircle,
+	ControllerClassic,
+	ControllerClassicOutline,
+	Cookie,
+	CookieAlert,
+	CookieAlertOutline,
+	CookieCheck,
+	CookieCheckOutline,
+	CookieClock,
+	CookieClockOutline,
+	CookieCog,
+	CookieCogOutline,
+	CookieEdit,
+	CookieEditOutline,
+	CookieLock,
+	CookieLockOutline,
+	CookieMinus,
+	CookieMinusOutline,
+	CookieOff,
+	CookieOffOutline,
+	CookieOutline,
+	CookiePlus,
+	CookiePlusOutline,
+	CookieRefresh,
+	CookieRefreshOutline,
+	CookieRemove,
+	CookieRemoveOutline,
+	CookieSettings,
+	CookieSettingsOutline,
+	CoolantTemperature,
+	Copyleft,
+	Copyright,
+	Cordova,
+	Corn,
+	CornOff,
+	CosineWave,
+	Counter,
+	Countertop,
+	CountertopOutline,
+	Cow,
+	CowOff,
+	Cpu32Bit,
+	Cpu64Bit,
+	Cradle,
+	CradleOutline,
+	Crane,
+	Creation,
+	CreativeCommons,
+	CreditCard,
+	CreditCardCheck,
+	CreditCardCheckOutline,
+	CreditCardChip,
+	CreditCardChipOutline,
+	CreditCardClock,
+	CreditCardClockOutline,
+	CreditCardEdit,
+	CreditCardEditOutline,
+	CreditCardFast,
+	CreditCardFastOutline,
+	CreditCardLock,
+	CreditCardLockOutline,
+	CreditCardMarker,
+	CreditCardMarkerOutline,
+	CreditCardMinus,
+	CreditCardMinusOutline,
+	CreditCardMultiple,
+	CreditCardMultipleOutline,
+	CreditCardOff,
+	CreditCardOffOutline,
+	CreditCardOutline,
+	CreditCardPlus,
+	CreditCardPlusOutline,
+	CreditCardRefresh,
+	CreditCardRefreshOutline,
+	CreditCardRefund,
+	CreditCardRefundOutline,
+	CreditCardRemove,
+	CreditCardRemoveOutline,
+	CreditCardScan,
+	CreditCardScanOutline,
+	CreditCardSearch,
+	CreditCardSearchOutline,
+	CreditCardSettings,
+	CreditCardSettingsOutline,
+	CreditCardSync,
+	CreditCardSyncOutline,
+	CreditCardWireless,
+	CreditCardWirelessOff,
+	CreditCardWirelessOffOutline,
+	CreditCardWirelessOutline,
+	Cricket,
+	Crop,
+	CropFree,
+	CropLandscape,
+	CropPortrait,
+	CropRotate,
+	CropSquare,
+	Cross,
+	CrossBolnisi,
+	CrossCeltic,
+	CrossOutline,
+	Crosshairs,
+	CrosshairsGps,
+	CrosshairsOff,
+	CrosshairsQuestion,
+	Crowd,
+	Crown,
+	CrownCircle,
+	CrownCircleOutline,
+	CrownOutline,
+	Cryengine,
+	CrystalBall,
+	Cube,
+	CubeOff,
+	CubeOffOutline,
+	CubeOutline,
+	CubeScan,
+	CubeSend,
+	CubeUnfolded,
+	Cup,
+	CupOff,
+	CupOffOutline,
+	CupOutline,
+	CupWater,
+	Cupboard,
+	CupboardOutline,
+	Cupcake,
+	Curling,
+	CurrencyBdt,
+	CurrencyBrl,
+	CurrencyBtc,
+	CurrencyCny,
+	CurrencyEth,
+	CurrencyEur,
+	CurrencyEurOff,
+	CurrencyFra,
+	CurrencyGbp,
+	CurrencyIls,
+	CurrencyInr,
+	CurrencyJpy,
+	CurrencyKrw,
+	CurrencyKzt,
+	CurrencyMnt,
+	CurrencyNgn,
+	CurrencyPhp,
+	CurrencyRial,
+	CurrencyRub,
+	CurrencyRupee,
+	CurrencySign,
+	CurrencyTry,
+	CurrencyTwd,
+	CurrencyUsd,
+	CurrencyUsdOff,
+	CurrentAc,
+	CurrentDc,
+	CursorDefault,
+	CursorDefaultClick,
+	CursorDefaultClickOutline,
+	CursorDefaultGesture,
+	CursorDefaultGestureOutline,
+	CursorDefaultOutline,
+	CursorMove,
+	CursorPointer,
+	CursorText,
+	Curtains,
+	CurtainsClosed,
+	Cylinder,
+	CylinderOff,
+	DanceBallroom,
+	DancePole,
+	DataMatrix,
+	DataMatrixEdit,
+	DataMatrixMinus,
+	DataMatrixPlus,
+	DataMatrixRemove,
+	DataMatrixScan,
+	Database,
+	DatabaseAlert,
+	DatabaseAlertOutline,
+	DatabaseArrowDown,
+	DatabaseArrowDownOutline,
+	DatabaseArrowLeft,
+	DatabaseArrowLeftOutline,
+	DatabaseArrowRight,
+	DatabaseArrowRightOutline,
+	DatabaseArrowUp,
+	DatabaseArrowUpOutline,
+	DatabaseCheck,
+	DatabaseCheckOutline,
+	DatabaseClock,
+	DatabaseClockOutline,
+	DatabaseCog,
+	DatabaseCogOutline,
+	DatabaseEdit,
+	DatabaseEditOutline,
+	DatabaseExport,
+	DatabaseExportOutline,
+	DatabaseEye,
+	DatabaseEyeOff,
+	DatabaseEyeOffOutline,
+	DatabaseEyeOutline,
+	DatabaseImport,
+	DatabaseImportOutline,
+	DatabaseLock,
+	DatabaseLockOutline,
+	DatabaseMarker,
+	DatabaseMarkerOutline,
+	DatabaseMinus,
+	DatabaseMinusOutline,
+	DatabaseOff,
+	DatabaseOffOutline,
+	DatabaseOutline,
+	DatabasePlus,
+	DatabasePlusOutline,
+	DatabaseRefresh,
+	DatabaseRefreshOutline,
+	DatabaseRemove,
+	DatabaseRemoveOutline,
+	DatabaseSearch,
+	DatabaseSearchOutline,
+	DatabaseSettings,
+	DatabaseSettingsOutline,
+	DatabaseSync,
+	DatabaseSyncOutline,
+	DeathStar,
+	DeathStarVariant,
+	DeathlyHallows,
+	Debian,
+	DebugStepInto,
+	DebugStepOut,
+	DebugStepOver,
+	Decagram,
+	DecagramOutline,
+	Decimal,
+	DecimalComma,
+	DecimalCommaDecrease,
+	DecimalCommaIncrease,
+	DecimalDecrease,
+	DecimalIncrease,
+	Delete,
+	DeleteAlert,
+	DeleteAlertOutline,
+	DeleteCircle,
+	DeleteCircleOutline,
+	DeleteClock,
+	DeleteClockOutline,
+	DeleteEmpty,
+	DeleteEmptyOutline,
+	DeleteForever,
+	DeleteForeverOutline,
+	DeleteOff,
+	DeleteOffOutline,
+	DeleteOutline,
+	DeleteRestore,
+	DeleteSweep,
+	DeleteSweepOutline,
+	DeleteVariant,
+	Delta,
+	Desk,
+	DeskLamp,
+	Deskphone,
+	DesktopClassic,
+	DesktopMac,
+	DesktopMacDashboard,
+	DesktopTower,
+	DesktopTowerMonitor,
+	Details,
+	DevTo,
+	DeveloperBoard,
+	Deviantart,
+	Devices,
+	Dharmachakra,
+	Diabetes,
+	Dialpad,
+	Diameter,
+	DiameterOutline,
+	DiameterVariant,
+	Diamond,
+	DiamondOutline,
+	DiamondStone,
+	Dice1,
+	Dice1Outline,
+	Dice2,
+	Dice2Outline,
+	Dice3,
+	Dice3Outline,
+	Dice4,
+	Dice4Outline,
+	Dice5,
+	Dice5Outline,
+	Dice6,
+	Dice6Outline,
+	DiceD10,
+	DiceD10Outline,
+	DiceD12,
+	DiceD12Outline,
+	DiceD20,
+	DiceD20Outline,
+	DiceD4,
+	DiceD4Outline,
+	DiceD6,
+	DiceD6Outline,
+	DiceD8,
+	DiceD8Outline,
+	DiceMultiple,
+	DiceMultipleOutline,
+	DigitalOcean,
+	DipSwitch,
+	Directions,
+	DirectionsFork,
+	Disc,
+	DiscAlert,
+	DiscPlayer,
+	Discord,
+	Dishwasher,
+	DishwasherAlert,
+	DishwasherOff,
+	Disqus,
+	DistributeHorizontalCenter,
+	DistributeHorizontalLeft,
+	DistributeHorizontalRight,
+	DistributeVerticalBottom,
+	DistributeVerticalCenter,
+	DistributeVerticalTop,
+	Diversify,
+	Diving,
+	DivingFlippers,
+	DivingHelmet,
+	DivingScuba,
+	DivingScubaFlag,
+	DivingScubaTank,
+	DivingScubaTankMultiple,
+	DivingSnorkel,
+	Division,
+	DivisionBox,
+	Dlna,
+	Dna,
+	Dns,
+	DnsOutline,
+	DockBottom,
+	DockLeft,
+	DockRight,
+	DockTop,
+	DockWindow,
+	Docker,
+	Doctor,
+	Dog,
+	DogService,
+	DogSide,
+	DogSideOff,
+	Dolby,
+	Dolly,
+	Dolphin,
+	Domain,
+	DomainOff,
+	DomainPlus,
+	DomainRemove,
+	DomeLight,
+	DominoMask,
+	Donkey,
+	Door,
+	DoorClosed,
+	DoorClosedLock,
+	DoorOpen,
+	DoorSliding,
+	DoorSlidingLock,
+	DoorSlidingOpen,
+	Doorbell,
+	DoorbellVideo,
+	DotNet,
+	DotsCircle,
+	DotsGrid,
+	DotsHexagon,
+	DotsHorizontal,
+	DotsHorizontalCircle,
+	DotsHorizontalCircleOutline,
+	DotsSquare,
+	DotsTriangle,
+	DotsVertical,
+	DotsVerticalCircle,
+	DotsVerticalCircleOutline,
+	Download,
+	DownloadBox,
+	DownloadBoxOutline,
+	DownloadCircle,
+	DownloadCircleOutline,
+	DownloadLock,
+	DownloadLockOutline,
+	DownloadMultiple,
+	DownloadNetwork,
+	DownloadNetworkOutline,
+	DownloadOff,
+	DownloadOffOutline,
+	DownloadOutline,
+	Drag,
+	DragHorizontal,
+	DragHorizontalVariant,
+	DragVariant,
+	DragVertical,
+	DragVerticalVariant,
+	DramaMasks,
+	Draw,
+	DrawPen,
+	Drawing,
+	DrawingBox,
+	Dresser,
+	DresserOutline,
+	Drone,
+	Dropbox,
+	Drupal,
+	Duck,
+	Dumbbell,
+	DumpTruck,
+	EarHearing,
+	EarHearingLoop,
+	EarHearingOff,
+	Earbuds,
+	EarbudsOff,
+	EarbudsOffOutline,
+	EarbudsOutline,
+	Earth,
+	EarthArrowRight,
+	EarthBox,
+	EarthBoxMinus,
+	EarthBoxOff,
+	EarthBoxPlus,
+	EarthBoxRemove,
+	EarthMinus,
+	EarthOff,
+	EarthPlus,
+	EarthRemove,
+	Egg,
+	EggEaster,
+	EggFried,
+	EggOff,
+	EggOffOutline,
+	EggOutline,
+	EiffelTower,
+	EightTrack,
+	Eject,
+	EjectOutline,
+	ElectricSwitch,
+	ElectricSwitchClosed,
+	ElectronFramework,
+	Elephant,
+	ElevationDecline,
+	ElevationRise,
+	Elevator,
+	ElevatorDown,
+	ElevatorPassenger,
+	ElevatorPassengerOff,
+	ElevatorPassengerOffOutline,
+	ElevatorPassengerOutline,
+	ElevatorUp,
+	Ellipse,
+	EllipseOutline,
+	Email,
+	EmailAlert,
+	EmailAlertOutline,
+	EmailBox,
+	EmailCheck,
+	EmailCheckOutline,
+	EmailEdit,
+	EmailEditOutline,
+	EmailFast,
+	EmailFastOutline,
+	EmailLock,
+	EmailMarkAsUnread,
+	EmailMinus,
+	EmailMinusOutline,
+	EmailMultiple,
+	EmailMultipleOutline,
+	EmailNewsletter,
+	EmailOff,
+	EmailOffOutline,
+	EmailOpen,
+	EmailOpenMultiple,
+	EmailOpenMultipleOutline,
+	EmailOpenOutline,
+	EmailOutline,
+	EmailPlus,
+	EmailPlusOutline,
+	EmailReceive,
+	EmailReceiveOutline,
+	EmailRemove,
+	EmailRemoveOutline,
+	EmailSeal,
+	EmailSealOutline,
+	EmailSearch,
+	EmailSearchOutline,
+	EmailSend,
+	EmailSendOutline,
+	EmailSync,
+	EmailSyncOutline,
+	EmailVariant,
+	Ember,
+	Emby,
+	Emoticon,
+	EmoticonAngry,
+	EmoticonAngryOutline,
+	EmoticonConfused,
+	EmoticonConfusedOutline,
+	EmoticonCool,
+	EmoticonCoolOutline,
+	EmoticonCry,
+	EmoticonCryOutline,
+	EmoticonDead,
+	EmoticonDeadOutline,
+	EmoticonDevil,
+	EmoticonDevilOutline,
+	EmoticonExcited,
+	EmoticonExcitedOutline,
+	EmoticonFrown,
+	EmoticonFrownOutline,
+	EmoticonHappy,
+	EmoticonHappyOutline,
+	EmoticonKiss,
+	EmoticonKissOutline,
+	EmoticonLol,
+	EmoticonLolOutline,
+	EmoticonNeutral,
+	EmoticonNeutralOutline,
+	EmoticonOutline,
+	EmoticonPoop,
+	EmoticonPoopOutline,
+	EmoticonSad,
+	EmoticonSadOutline,
+	EmoticonSick,
+	EmoticonSickOutline,
+	EmoticonTongue,
+	EmoticonTongueOutline,
+	EmoticonWink,
+	EmoticonWinkOutline,
+	Engine,
+	EngineOff,
+	EngineOffOutline,
+	EngineOutline,
+	Epsilon,
+	Equal,
+	EqualBox,
+	Equalizer,
+	EqualizerOutline,
+	Eraser,
+	EraserVariant,
+	Escalator,
+	EscalatorBox,
+	EscalatorDown,
+	EscalatorUp,
+	Eslint,
+	Et,
+	Ethereum,
+	Ethernet,
+	EthernetCable,
+	EthernetCableOff,
+	EvPlugCcs1,
+	EvPlugCcs2,
+	EvPlugChademo,
+	EvPlugTesla,
+	EvPlugType1,
+	EvPlugType2,
+	EvStation,
+	Evernote,
+	Excavator,
+	Exclamation,
+	ExclamationThick,
+	ExitRun,
+	ExitToApp,
+	ExpandAll,
+	ExpandAllOutline,
+	ExpansionCard,
+	ExpansionCardVariant,
+	Exponent,
+	ExponentBox,
+	Export,
+	ExportVariant,
+	Eye,
+	EyeArrowLeft,
+	EyeArrowLeftOutline,
+	EyeArrowRight,
+	EyeArrowRightOutline,
+	EyeCheck,
+	EyeCheckOutline,
+	EyeCircle,
+	EyeCircleOutline,
+	EyeMinus,
+	EyeMinusOutline,
+	EyeOff,
+	EyeOffOutline,
+	EyeOutline,
+	EyePlus,
+	EyePlusOutline,
+	EyeRefresh,
+	EyeRefreshOutline,
+	EyeRemove,
+	EyeRemoveOutline,
+	EyeSettings,
+	EyeSettingsOutline,
+	Eyedropper,
+	EyedropperMinus,
+	EyedropperOff,
+	EyedropperPlus,
+	EyedropperRemove,
+	EyedropperVariant,
+	FaceAgent,
+	FaceMan,
+	FaceManOutline,
+	FaceManProfile,
+	FaceManShimmer,
+	FaceManShimmerOutline,
+	FaceMask,
+	FaceMaskOutline,
+	FaceRecognition,
+	FaceWoman,
+	FaceWomanOutline,
+	FaceWomanProfile,
+	FaceWomanShimmer,
+	FaceWomanShimmerOutline,
+	Facebook,
+	FacebookGaming,
+	FacebookMessenger,
+	FacebookWorkplace,
+	Factory,
+	FamilyTree,
+	Fan,
+	FanAlert,
+	FanAuto,
+	FanChevronDown,
+	FanChevronUp,
+	FanClock,
+	FanMinus,
+	FanOff,
+	FanPlus,
+	FanRemove,
+	FanSpeed1,
+	FanSpeed2,
+	FanSpeed3,
+	FastForward,
+	FastForward10,
+	FastForward15,
+	FastForward30,
+	FastForward5,
+	FastForward60,
+	FastForwardOutline,
+	Fax,
+	Feather,
+	FeatureSearch,
+	FeatureSearchOutline,
+	Fedora,
+	Fence,
+	FenceElectric,
+	Fencing,
+	FerrisWheel,
+	Ferry,
+	File,
+	FileAccount,
+	FileAccountOutline,
+	FileAlert,
+	FileAlertOutline,
+	FileArrowLeftRight,
+	FileArrowLeftRightOutline,
+	FileArrowUpDown,
+	FileArrowUpDownOutline,
+	FileCabinet,
+	FileCad,
+	FileCadBox,
+	FileCancel,
+	FileCancelOutline,
+	FileCertificate,
+	FileCertificateOutline,
+	FileChart,
+	FileChartCheck,
+	FileChartCheckOutline,
+	FileChartOutline,
+	FileCheck,
+	FileCheckOutline,
+	FileClock,
+	FileClockOutline,
+	FileCloud,
+	FileCloudOutline,
+	FileCode,
+	FileCodeOutline,
+	FileCog,
+	FileCogOutline,
+	FileCompare,
+	FileDelimited,
+	FileDelimitedOutline,
+	FileDocument,
+	FileDocumentAlert,
+	FileDocumentAlertOutline,
+	FileDocumentCheck,
+	FileDocumentCheckOutline,
+	FileDocumentEdit,
+	FileDocumentEditOutline,
+	FileDocumentMinus,
+	FileDocumentMinusOutline,
+	FileDocumentMultiple,
+	FileDocumentMultipleOutline,
+	FileDocumentOutline,
+	FileDocumentPlus,
+	FileDocumentPlusOutline,
+	FileDocumentRemove,
+	FileDocumentRemoveOutline,
+	FileDownload,
+	FileDownloadOutline,
+	FileEdit,
+	FileEditOutline,
+	FileExcel,
+	FileExcelBox,
+	FileExcelBoxOutline,
+	FileExcelOutline,
+	FileExport,
+	FileExportOutline,
+	FileEye,
+	FileEyeOutline,
+	FileFind,
+	FileFindOutline,
+	FileGifBox,
+	FileHidden,
+	FileImage,
+	FileImageMarker,
+	FileImageMarkerOutline,
+	FileImageMinus,
+	FileImageMinusOutline,
+	FileImageOutline,
+	FileImagePlus,
+	FileImagePlusOutline,
+	FileImageRemove,
+	FileImageRemoveOutline,
+	FileImport,
+	FileImportOutline,
+	FileJpgBox,
+	FileKey,
+	FileKeyOutline,
+	FileLink,
+	FileLinkOutline,
+	FileLock,
+	FileLockOpen,
+	FileLockOpenOutline,
+	FileLockOutline,
+	FileMarker,
+	FileMarkerOutline,
+	FileMinus,
+	FileMinusOutline,
+	FileMove,
+	FileMoveOutline,
+	FileMultiple,
+	FileMultipleOutline,
+	FileMusic,
+	FileMusicOutline,
+	FileOutline,
+	FilePdfBox,
+	FilePercent,
+	FilePercentOutline,
+	FilePhone,
+	FilePhoneOutline,
+	FilePlus,
+	FilePlusOutline,
+	FilePngBox,
+	FilePowerpoint,
+	FilePowerpointBox,
+	FilePowerpointBoxOutline,
+	FilePowerpointOutline,
+	FilePresentationBox,
+	FileQuestion,
+	FileQuestionOutline,
+	FileRefresh,
+	FileRefreshOutline,
+	FileRemove,
+	FileRemoveOutline,
+	FileReplace,
+	FileReplaceOutline,
+	FileRestore,
+	FileRestoreOutline,
+	FileRotateLeft,
+	FileRotateLeftOutline,
+	FileRotateRight,
+	FileRotateRightOutline,
+	FileSearch,
+	FileSearchOutline,
+	FileSend,
+	FileSendOutline,
+	FileSettings,
+	FileSettingsOutline,
+	FileSign,
+	FileStar,
+	FileStarOutline,
+	FileSwap,
+	FileSwapOutline,
+	FileSync,
+	FileSyncOutline,
+	FileTable,
+	FileTableBox,
+	FileTableBoxMultiple,
+	FileTableBoxMultipleOutline,
+	FileTableBoxOutline,
+	FileTableOutline,
+	FileTree,
+	FileTreeOutline,
+	FileUndo,
+	FileUndoOutline,
+	FileUpload,
+	FileUploadOutline,
+	FileVideo,
+	FileVideoOutline,
+	FileWord,
+	FileWordBox,
+	FileWordBoxOutline,
+	FileWordOutline,
+	Film,
+	Filmstrip,
+	FilmstripBox,
+	FilmstripBoxMultiple,
+	FilmstripOff,
+	Filter,
+	FilterCheck,
+	FilterCheckOutline,
+	FilterCog,
+	FilterCogOutline,
+	FilterMenu,
+	FilterMenuOutline,
+	FilterMinus,
+	FilterMinusOutline,
+	FilterMultiple,
+	FilterMultipleOutline,
+	FilterOff,
+	FilterOffOutline,
+	FilterOutline,
+	FilterPlus,
+	FilterPlusOutline,
+	FilterRemove,
+	FilterRemoveOutline,
+	FilterSettings,
+	FilterSettingsOutline,
+	FilterVariant,
+	FilterVariantMinus,
+	FilterVariantPlus,
+	FilterVariantRemove,
+	Finance,
+	FindReplace,
+	Fingerprint,
+	FingerprintOff,
+	Fire,
+	FireAlert,
+	FireCircle,
+	FireExtinguisher,
+	FireHydrant,
+	FireHydrantAlert,
+	FireHydrantOff,
+	FireOff,
+	FireTruck,
+	Firebase,
+	Firefox,
+	Fireplace,
+	FireplaceOff,
+	Firewire,
+	Firework,
+	FireworkOff,
+	Fish,
+	FishOff,
+	Fishbowl,
+	FishbowlOutline,
+	FitToPage,
+	FitToPageOutline,
+	FitToScreen,
+	FitToScreenOutline,
+	Flag,
+	FlagCheckered,
+	FlagMinus,
+	FlagMinusOutline,
+	FlagOff,
+	FlagOffOutline,
+	FlagOutline,
+	FlagPlus,
+	FlagPlusOutline,
+	FlagRemove,
+	FlagRemoveOutline,
+	FlagTriangle,
+	FlagVariant,
+	FlagVariantOutline,
+	Flare,
+	Flash,
+	FlashAlert,
+	FlashAlertOutline,
+	FlashAuto,
+	FlashOff,
+	FlashOutline,
+	FlashRedEye,
+	Flashlight,
+	FlashlightOff,
+	Flask,
+	FlaskEmpty,
+	FlaskEmptyMinus,
+	FlaskEmptyMinusOutline,
+	FlaskEmptyOff,
+	FlaskEmptyOffOutline,
+	FlaskEmptyOutline,
+	FlaskEmptyPlus,
+	FlaskEmptyPlusOutline,
+	FlaskEmptyRemove,
+	FlaskEmptyRemoveOutline,
+	FlaskMinus,
+	FlaskMinusOutline,
+	FlaskOff,
+	FlaskOffOutline,
+	FlaskOutline,
+	FlaskPlus,
+	FlaskPlusOutline,
+	FlaskRemove,
+	FlaskRemoveOutline,
+	FlaskRoundBottom,
+	FlaskRoundBottomEmpty,
+	FlaskRoundBottomEmptyOutline,
+	FlaskRoundBottomOutline,
+	FleurDeLis,
+	FlipHorizontal,
+	FlipToBack,
+	FlipToFront,
+	FlipVertical,
+	FloorLamp,
+	FloorLampDual,
+	FloorLampDualOutline,
+	FloorLampOutline,
+	FloorLampTorchiere,
+	FloorLampTorchiereOutline,
+	FloorLampTorchiereVariant,
+	FloorLampTorchiereVariantOutline,
+	FloorPlan,
+	Floppy,
+	FloppyVariant,
+	Flower,
+	FlowerOutline,
+	FlowerPollen,
+	FlowerPollenOutline,
+	FlowerPoppy,
+	FlowerTulip,
+	FlowerTulipOutline,
+	FocusAuto,
+	FocusField,
+	FocusFieldHorizontal,
+	FocusFieldVertical,
+	Folder,
+	FolderAccount,
+	FolderAccountOutline,
+	FolderAlert,
+	FolderAlertOutline,
+	FolderArrowDown,
+	FolderArrowDownOutline,
+	FolderArrowLeft,
+	FolderArrowLeftOutline,
+	FolderArrowLeftRight,
+	FolderArrowLeftRightOutline,
+	FolderArrowRight,
+	FolderArrowRightOutline,
+	FolderArrowUp,
+	FolderArrowUpDown,
+	FolderArrowUpDownOutline,
+	FolderArrowUpOutline,
+	FolderCancel,
+	FolderCancelOutline,
+	FolderCheck,
+	FolderCheckOutline,
+	FolderClock,
+	FolderClockOutline,
+	FolderCog,
+	FolderCogOutline,
+	FolderDownload,
+	FolderDownloadOutline,
+	FolderEdit,
+	FolderEditOutline,
+	FolderEye,
+	FolderEyeOutline,
+	FolderFile,
+	FolderFileOutline,
+	FolderGoogleDrive,
+	FolderHeart,
+	FolderHeartOutline,
+	FolderHidden,
+	FolderHome,
+	FolderHomeOutline,
+	FolderImage,
+	FolderInformation,
+	FolderInformationOutline,
+	FolderKey,
+	FolderKeyNetwork,
+	FolderKeyNetworkOutline,
+	FolderKeyOutline,
+	FolderLock,
+	FolderLockOpen,
+	FolderLockOpenOutline,
+	FolderLockOutline,
+	FolderMarker,
+	FolderMarkerOutline,
+	FolderMove,
+	FolderMoveOutline,
+	FolderMultiple,
+	FolderMultipleImage,
+	FolderMultipleOutline,
+	FolderMultiplePlus,
+	FolderMultiplePlusOutline,
+	FolderMusic,
+	FolderMusicOutline,
+	FolderNetwork,
+	FolderNetworkOutline,
+	FolderOff,
+	FolderOffOutline,
+	FolderOpen,
+	FolderOpenOutline,
+	FolderOutline,
+	FolderPlay,
+	FolderPlayOutline,
+	FolderPlus,
+	FolderPlusOutline,
+	FolderPound,
+	FolderPoundOutline,
+	FolderQuestion,
+	FolderQuestionOutline,
+	FolderRefresh,
+	FolderRefreshOutline,
+	FolderRemove,
+	FolderRemoveOutline,
+	FolderSearch,
+	FolderSearchOutline,
+	FolderSettings,
+	FolderSettingsOutline,
+	FolderStar,
+	FolderStarMultiple,
+	FolderStarMultipleOutline,
+	FolderStarOutline,
+	FolderSwap,
+	FolderSwapOutline,
+	FolderSync,
+	FolderSyncOutline,
+	FolderTable,
+	FolderTableOutline,
+	FolderText,
+	FolderTextOutline,
+	FolderUpload,
+	FolderUploadOutline,
+	FolderWrench,
+	FolderWrenchOutline,
+	FolderZip,
+	FolderZipOutline,
+	FontAwesome,
+	Food,
+	FoodApple,
+	FoodAppleOutline,
+	FoodCroissant,
+	FoodDrumstick,
+	FoodDrumstickOff,
+	FoodDrumstickOffOutline,
+	FoodDrumstickOutline,
+	FoodForkDrink,
+	FoodHalal,
+	FoodHotDog,
+	FoodKosher,
+	FoodOff,
+	FoodOffOutline,
+	FoodOutline,
+	FoodSteak,
+	FoodSteakOff,
+	FoodTakeoutBox,
+	FoodTakeoutBoxOutline,
+	FoodTurkey,
+	FoodVariant,
+	FoodVariantOff,
+	FootPrint,
+	Football,
+	FootballAustralian,
+	FootballHelmet,
+	Forest,
+	Forklift,
+	FormDropdown,
+	FormSelect,
+	FormTextarea,
+	FormTextbox,
+	FormTextboxLock,
+	FormTextboxPassword,
+	FormatAlignBottom,
+	FormatAlignCenter,
+	FormatAlignJustify,
+	FormatAlignLeft,
+	FormatAlignMiddle,
+	FormatAlignRight,
+	FormatAlignTop,
+	FormatAnnotationMinus,
+	FormatAnnotationPlus,
+	FormatBold,
+	FormatClear,
+	FormatColorFill,
+	FormatColorHighlight,
+	FormatColorMarkerCancel,
+	FormatColorText,
+	FormatColumns,
+	FormatFloatCenter,
+	FormatFloatLeft,
+	FormatFloatNone,
+	FormatFloatRight,
+	FormatFont,
+	FormatFontSizeDecrease,
+	FormatFontSizeIncrease,
+	FormatHeader1,
+	FormatHeader2,
+	FormatHeader3,
+	FormatHeader4,
+	FormatHeader5,
+	FormatHeader6,
+	FormatHeaderDecrease,
+	FormatHeaderEqual,
+	FormatHeaderIncrease,
+	FormatHeaderPound,
+	FormatHorizontalAlignCenter,
+	FormatHorizontalAlignLeft,
+	FormatHorizontalAlignRight,
+	FormatIndentDecrease,
+	FormatIndentIncrease,
+	FormatItalic,
+	FormatLetterCase,
+	FormatLetterCaseLower,
+	FormatLetterCaseUpper,
+	FormatLetterEndsWith,
+	FormatLetterMatches,
+	FormatLetterSpacing,
+	FormatLetterStartsWith,
+	FormatLineSpacing,
+	FormatLineStyle,
+	FormatLineWeight,
+	FormatListBulleted,
+	FormatListBulletedSquare,
+	FormatListBulletedTriangle,
+	FormatListBulletedType,
+	FormatListCheckbox,
+	FormatListChecks,
+	FormatListGroup,
+	FormatListNumbered,
+	FormatListNumberedRtl,
+	FormatListText,
+	FormatOverline,
+	FormatPageBreak,
+	FormatPageSplit,
+	FormatPaint,
+	FormatParagraph,
+	FormatPilcrow,
+	FormatQuoteClose,
+	FormatQuoteCloseOutline,
+	FormatQuoteOpen,
+	FormatQuoteOpenOutline,
+	FormatRotate90,
+	FormatSection,
+	FormatSize,
+	FormatStrikethrough,
+	FormatStrikethroughVariant,
+	FormatSubscript,
+	FormatSuperscript,
+	FormatText,
+	FormatTextRotationAngleDown,
+	FormatTextRotationAngleUp,
+	FormatTextRotationDown,
+	FormatTextRotationDownVertical,
+	FormatTextRotationNone,
+	FormatTextRotationUp,
+	FormatTextRotationVertical,
+	FormatTextVariant,
+	FormatTextVariantOutline,
+	FormatTextWrappingClip,
+	FormatTextWrappingOverflow,
+	FormatTextWrappingWrap,
+	FormatTextbox,
+	FormatTextdirectionLToR,
+	FormatTextdirectionRToL,
+	FormatTitle,
+	FormatUnderline,
+	FormatUnderlineWavy,
+	FormatVerticalAlignBottom,
+	FormatVerticalAlignCenter,
+	FormatVerticalAlignTop,
+	FormatWrapInline,
+	FormatWrapSquare,
+	FormatWrapTight,
+	FormatWrapTopBottom,
+	Forum,
+	ForumMinus,
+	ForumMinusOutline,
+	ForumOutline,
+	ForumPlus,
+	ForumPlusOutline,
+	ForumRemove,
+	ForumRemoveOutline,
+	Forward,
+	Forwardburger,
+	Fountain,
+	FountainPen,
+	FountainPenTip,
+	FractionOneHalf,
+	Freebsd,
+	FrenchFries,
+	FrequentlyAskedQuestions,
+	Fridge,
+	FridgeAlert,
+	FridgeAlertOutline,
+	FridgeBottom,
+	FridgeIndustrial,
+	FridgeIndustrialAlert,
+	FridgeIndustrialAlertOutline,
+	FridgeIndustrialOff,
+	FridgeIndustrialOffOutline,
+	FridgeIndustrialOutline,
+	FridgeOff,
+	FridgeOffOutline,
+	FridgeOutline,
+	FridgeTop,
+	FridgeVariant,
+	FridgeVariantAlert,
+	FridgeVariantAlertOutline,
+	FridgeVariantOff,
+	FridgeVariantOffOutline,
+	FridgeVariantOutline,
+	FruitCherries,
+	FruitCherriesOff,
+	FruitCitrus,
+	FruitCitrusOff,
+	FruitGrapes,
+	FruitGrapesOutline,
+	FruitPear,
+	FruitPineapple,
+	FruitWatermelon,
+	Fuel,
+	FuelCell,
+	Fullscreen,
+	FullscreenExit,
+	Function,
+	FunctionVariant,
+	FuriganaHorizontal,
+	FuriganaVertical,
+	Fuse,
+	FuseAlert,
+	FuseBlade,
+	FuseOff,
+	Gamepad,
+	GamepadCircle,
+	GamepadCircleDown,
+	GamepadCircleLeft,
+	GamepadCircleOutline,
+	GamepadCircleRight,
+	GamepadCircleUp,
+	GamepadDown,
+	GamepadLeft,
+	GamepadOutline,
+	GamepadRight,
+	GamepadRound,
+	GamepadRoundDown,
+	GamepadRoundLeft,
+	GamepadRoundOutline,
+	GamepadRoundRight,
+	GamepadRoundUp,
+	GamepadSquare,
+	GamepadSquareOutline,
+	GamepadUp,
+	GamepadVariant,
+	GamepadVariantOutline,
+	Gamma,
+	GantryCrane,
+	Garage,
+	GarageAlert,
+	GarageAlertVariant,
+	GarageLock,
+	GarageOpen,
+	GarageOpenVariant,
+	GarageVariant,
+	GarageVariantLock,
+	GasBurner,
+	GasCylinder,
+	GasStation,
+	GasStationOff,
+	GasStationOffOutline,
+	GasStationOutline,
+	Gate,
+	GateAlert,
+	GateAnd,
+	GateArrowLeft,
+	GateArrowRight,
+	GateNand,
+	GateNor,
+	GateNot,
+	GateOpen,
+	GateOr,
+	GateXnor,
+	GateXor,
+	Gatsby,
+	Gauge,
+	GaugeEmpty,
+	GaugeFull,
+	GaugeLow,
+	Gavel,
+	GenderFemale,
+	GenderMale,
+	GenderMaleFemale,
+	GenderMaleFemaleVariant,
+	GenderNonBinary,
+	GenderTransgender,
+	Gentoo,
+	Gesture,
+	GestureDoubleTap,
+	GesturePinch,
+	GestureSpread,
+	GestureSwipe,
+	GestureSwipeDown,
+	GestureSwipeHorizontal,
+	GestureSwipeLeft,
+	GestureSwipeRight,
+	GestureSwipeUp,
+	GestureSwipeVertical,
+	GestureTap,
+	GestureTapBox,
+	GestureTapButton,
+	GestureTapHold,
+	GestureTwoDoubleTap,
+	GestureTwoTap,
+	Ghost,
+	GhostOff,
+	GhostOffOutline,
+	GhostOutline,
+	Gift,
+	GiftOff,
+	GiftOffOutline,
+	GiftOpen,
+	GiftOpenOutline,
+	GiftOutline,
+	Git,
+	Github,
+	Gitlab,
+	GlassCocktail,
+	GlassCocktailOff,
+	GlassFlute,
+	GlassFragile,
+	GlassMug,
+	GlassMugOff,
+	GlassMugVariant,
+	GlassMugVariantOff,
+	GlassPintOutline,
+	GlassStange,
+	GlassTulip,
+	GlassWine,
+	Glasses,
+	GlobeLight,
+	GlobeModel,
+	Gmail,
+	Gnome,
+	GoKart,
+	GoKartTrack,
+	Gog,
+	Gold,
+	Golf,
+	GolfCart,
+	GolfTee,
+	Gondola,
+	Goodreads,
+	Google,
+	GoogleAds,
+	GoogleAnalytics,
+	GoogleAssistant,
+	GoogleCardboard,
+	GoogleChrome,
+	GoogleCircles,
+	GoogleCirclesCommunities,
+	GoogleCirclesExtended,
+	GoogleCirclesGroup,
+	GoogleClassroom,
+	GoogleCloud,
+	GoogleController,
+	GoogleControllerOff,
+	GoogleDownasaur,
+	GoogleDrive,
+	GoogleEarth,
+	GoogleFit,
+	GoogleGlass,
+	GoogleHangouts,
+	GoogleHome,
+	GoogleKeep,
+	GoogleLens,
+	GoogleMaps,
+	GoogleMyBusiness,
+	GoogleNearby,
+	GooglePlay,
+	GooglePlus,
+	GooglePodcast,
+	GoogleSpreadsheet,
+	GoogleStreetView,
+	GoogleTranslate,
+	GradientHorizontal,
+	GradientVertical,
+	Grain,
+	Graph,
+	GraphOutline,
+	Graphql,
+	Grass,
+	GraveStone,
+	GreasePencil,
+	GreaterThan,
+	GreaterThanOrEqual,
+	Greenhouse,
+	Grid,
+	GridLarge,
+	GridOff,
+	Grill,
+	GrillOutline,
+	Group,
+	GuitarAcoustic,
+	GuitarElectric,
+	GuitarPick,
+	GuitarPickOutline,
+	GuyFawkesMask,
+	Gymnastics,
+	Hail,
+	HairDryer,
+	HairDryerOutline,
+	Halloween,
+	Hamburger,
+	HamburgerCheck,
+	HamburgerMinus,
+	HamburgerOff,
+	HamburgerPlus,
+	HamburgerRemove,
+	Hammer,
+	HammerScrewdriver,
+	HammerSickle,
+	HammerWrench,
+	HandBackLeft,
+	HandBackLeftOff,
+	HandBackLeftOffOutline,
+	HandBackLeftOutline,
+	HandBackRight,
+	HandBackRightOff,
+	HandBackRightOffOutline,
+	HandBackRightOutline,
+	HandClap,
+	HandClapOff,
+	HandCoin,
+	HandCoinOutline,
+	HandExtended,
+	HandExtendedOutline,
+	HandFrontLeft,
+	HandFrontLeftOutline,
+	HandFrontRight,
+	HandFrontRightOutline,
+	HandHeart,
+	HandHeartOutline,
+	HandOkay,
+	HandPeace,
+	HandPeaceVariant,
+	HandPointingDown,
+	HandPointingLeft,
+	HandPointingRight,
+	HandPointingUp,
+	HandSaw,
+	HandWash,
+	HandWashOutline,
+	HandWater,
+	HandWave,
+	HandWaveOutline,
+	Handball,
+	Handcuffs,
+	HandsPray,
+	Handshake,
+	HandshakeOutline,
+	Hanger,
+	HardHat,
+	Harddisk,
+	HarddiskPlus,
+	HarddiskRemove,
+	HatFedora,
+	HazardLights,
+	Hdr,
+	HdrOff,
+	Head,
+	HeadAlert,
+	HeadAlertOutline,
+	HeadCheck,
+	HeadCheckOutline,
+	HeadCog,
+	HeadCogOutline,
+	HeadDotsHorizontal,
+	HeadDotsHorizontalOutline,
+	HeadFlash,
+	HeadFlashOutline,
+	HeadHeart,
+	HeadHeartOutline,
+	HeadLightbulb,
+	HeadLightbulbOutline,
+	HeadMinus,
+	HeadMinusOutline,
+	HeadOutline,
+	HeadPlus,
+	HeadPlusOutline,
+	HeadQuestion,
+	HeadQuestionOutline,
+	HeadRemove,
+	HeadRemoveOutline,
+	HeadSnowflake,
+	HeadSnowflakeOutline,
+	HeadSync,
+	HeadSyncOutline,
+	Headphones,
+	HeadphonesBluetooth,
+	HeadphonesBox,
+	HeadphonesOff,
+	HeadphonesSettings,
+	Headset,
+	HeadsetDock,
+	HeadsetOff,
+	Heart,
+	HeartBox,
+	HeartBoxOutline,
+	HeartBroken,
+	HeartBrokenOutline,
+	HeartCircle,
+	HeartCircleOutline,
+	HeartCog,
+	HeartCogOutline,
+	HeartFlash,
+	HeartHalf,
+	HeartHalfFull,
+	HeartHalfOutline,
+	HeartMinus,
+	HeartMinusOutline,
+	HeartMultiple,
+	HeartMultipleOutline,
+	HeartOff,
+	HeartOffOutline,
+	HeartOutline,
+	HeartPlus,
+	HeartPlusOutline,
+	HeartPulse,
+	HeartRemove,
+	HeartRemoveOutline,
+	HeartSettings,
+	HeartSettingsOutline,
+	HeatPump,
+	HeatPumpOutline,
+	HeatWave,
+	HeatingCoil,
+	Helicopter,
+	Help,
+	HelpBox,
+	HelpCircle,
+	HelpCircleOutline,
+	HelpNetwork,
+	HelpNetworkOutline,
+	HelpRhombus,
+	HelpRhombusOutline,
+	Hexadecimal,
+	Hexagon,
+	HexagonMultiple,
+	HexagonMultipleOutline,
+	HexagonOutline,
+	HexagonSlice1,
+	HexagonSlice2,
+	HexagonSlice3,
+	HexagonSlice4,
+	HexagonSlice5,
+	HexagonSlice6,
+	Hexagram,
+	HexagramOutline,
+	HighDefinition,
+	HighDefinitionBox,
+	Highway,
+	Hiking,
+	History,
+	HockeyPuck,
+	HockeySticks,
+	Hololens,
+	Home,
+	HomeAccount,
+	HomeAlert,
+	HomeAlertOutline,
+	HomeAnalytics,
+	HomeAssistant,
+	HomeAutomation,
+	HomeBattery,
+	HomeBatteryOutline,
+	HomeCircle,
+	HomeCircleOutline,
+	HomeCity,
+	HomeCityOutline,
+	HomeClock,
+	HomeClockOutline,
+	HomeEdit,
+	HomeEditOutline,
+	HomeExportOutline,
+	HomeFlood,
+	HomeFloor0,
+	HomeFloor1,
+	HomeFloor2,
+	HomeFloor3,
+	HomeFloorA,
+	HomeFloorB,
+	HomeFloorG,
+	HomeFloorL,
+	HomeFloorNegative1,
+	HomeGroup,
+	HomeGroupMinus,
+	HomeGroupPlus,
+	HomeGroupRemove,
+	HomeHeart,
+	HomeImportOutline,
+	HomeLightbulb,
+	HomeLightbulbOutline,
+	HomeLightningBolt,
+	HomeLightningBoltOutline,
+	HomeLock,
+	HomeLockOpen,
+	HomeMapMarker,
+	HomeMinus,
+	HomeMinusOutline,
+	HomeModern,
+	HomeOff,
+	HomeOffOutline,
+	HomeOutline,
+	HomePlus,
+	HomePlusOutline,
+	HomeRemove,
+	HomeRemoveOutline,
+	HomeRoof,
+	HomeSearch,
+	HomeSearchOutline,
+	HomeSwitch,
+	HomeSwitchOutline,
+	HomeThermometer,
+	HomeThermometerOutline,
+	HomeVariant,
+	HomeVariantOutline,
+	Hook,
+	HookOff,
+	HoopHouse,
+	Hops,
+	HorizontalRotateClockwise,
+	HorizontalRotateCounterclockwise,
+	Horse,
+	HorseHuman,
+	HorseVariant,
+	HorseVariantFast,
+	Horseshoe,
+	Hospital,
+	HospitalBox,
+	HospitalBoxOutline,
+	HospitalBuilding,
+	HospitalMarker,
+	HotTub,
+	Hours24,
+	Hubspot,
+	Hulu,
+	Human,
+	HumanBabyChangingTable,
+	HumanCane,
+	HumanCapacityDecrease,
+	HumanCapacityIncrease,
+	HumanChild,
+	HumanDolly,
+	HumanEdit,
+	HumanFemale,
+	HumanFemaleBoy,
+	HumanFemaleDance,
+	HumanFemaleFemale,
+	HumanFemaleGirl,
+	HumanGreeting,
+	HumanGreetingProximity,
+	HumanGreetingVariant,
+	HumanHandsdown,
+	HumanHandsup,
+	HumanMale,
+	HumanMaleBoard,
+	HumanMaleBoardPoll,
+	HumanMaleBoy,
+	HumanMaleChild,
+	HumanMaleFemale,
+	HumanMaleFemaleChild,
+	HumanMaleGirl,
+	HumanMaleHeight,
+	HumanMaleHeightVariant,
+	HumanMaleMale,
+	HumanNonBinary,
+	HumanPregnant,
+	HumanQueue,
+	HumanScooter,
+	HumanWheelchair,
+	HumanWhiteCane,
+	HumbleBundle,
+	Hvac,
+	HvacOff,
+	HydraulicOilLevel,
+	HydraulicOilTemperature,
+	HydroPower,
+	HydrogenStation,
+	IceCream,
+	IceCreamOff,
+	IcePop,
+	IdCard,
+	Identifier,
+	IdeogramCjk,
+	IdeogramCjkVariant,
+	Image,
+	ImageAlbum,
+	ImageArea,
+	ImageAreaClose,
+	ImageAutoAdjust,
+	ImageBroken,
+	ImageBrokenVariant,
+	ImageEdit,
+	ImageEditOutline,
+	ImageFilterBlackWhite,
+	ImageFilterCenterFocus,
+	ImageFilterCenterFocusStrong,
+	ImageFilterCenterFocusStrongOutline,
+	ImageFilterCenterFocusWeak,
+	ImageFilterDrama,
+	ImageFilterFrames,
+	ImageFilterHdr,
+	ImageFilterNone,
+	ImageFilterTiltShift,
+	ImageFilterVintage,
+	ImageFrame,
+	ImageLock,
+	ImageLockOutline,
+	ImageMarker,
+	ImageMarkerOutline,
+	ImageMinus,
+	ImageMove,
+	ImageMultiple,
+	ImageMultipleOutline,
+	ImageOff,
+	ImageOffOutline,
+	ImageOutline,
+	ImagePlus,
+	ImageRefresh,
+	ImageRefreshOutline,
+	ImageRemove,
+	ImageSearch,
+	ImageSearchOutline,
+	ImageSizeSelectActual,
+	ImageSizeSelectLarge,
+	ImageSizeSelectSmall,
+	ImageSync,
+	ImageSyncOutline,
+	ImageText,
+	Import,
+	Inbox,
+	InboxArrowDown,
+	InboxArrowDownOutline,
+	InboxArrowUp,
+	InboxArrowUpOutline,
+	InboxFull,
+	InboxFullOutline,
+	InboxMultiple,
+	InboxMultipleOutline,
+	InboxOutline,
+	InboxRemove,
+	InboxRemoveOutline,
+	Incognito,
+	IncognitoCircle,
+	IncognitoCircleOff,
+	IncognitoOff,
+	Induction,
+	Infinity,
+	Information,
+	InformationOff,
+	InformationOffOutline,
+	InformationOutline,
+	InformationVariant,
+	Instagram,
+	InstrumentTriangle,
+	IntegratedCircuitChip,
+	InvertColors,
+	InvertColorsOff,
+	Iobroker,
+	Ip,
+	IpNetwork,
+	IpNetworkOutline,
+	IpOutline,
+	Ipod,
+	Iron,
+	IronBoard,
+	IronOutline,
+	Island,
+	IvBag,
+	Jabber,
+	Jeepney,
+	Jellyfish,
+	JellyfishOutline,
+	Jira,
+	Jquery,
+	Jsfiddle,
+	JumpRope,
+	Kabaddi,
+	Kangaroo,
+	Karate,
+	Kayaking,
+	Keg,
+	Kettle,
+	KettleAlert,
+	KettleAlertOutline,
+	KettleOff,
+	KettleOffOutline,
+	KettleOutline,
+	KettlePourOver,
+	KettleSteam,
+	KettleSteamOutline,
+	Kettlebell,
+	Key,
+	KeyAlert,
+	KeyAlertOutline,
+	KeyArrowRight,
+	KeyChain,
+	KeyChainVariant,
+	KeyChange,
+	KeyLink,
+	KeyMinus,
+	KeyOutline,
+	KeyPlus,
+	KeyRemove,
+	KeyStar,
+	KeyVariant,
+	KeyWireless,
+	Keyboard,
+	KeyboardBackspace,
+	KeyboardCaps,
+	KeyboardClose,
+	KeyboardEsc,
+	KeyboardF1,
+	KeyboardF10,
+	KeyboardF11,
+	KeyboardF12,
+	KeyboardF2,
+	KeyboardF3,
+	KeyboardF4,
+	KeyboardF5,
+	KeyboardF6,
+	KeyboardF7,
+	KeyboardF8,
+	KeyboardF9,
+	KeyboardOff,
+	KeyboardOffOutline,
+	KeyboardOutline,
+	KeyboardReturn,
+	KeyboardSettings,
+	KeyboardSettingsOutline,
+	KeyboardSpace,
+	KeyboardTab,
+	KeyboardTabReverse,
+	KeyboardVariant,
+	Khanda,
+	Kickstarter,
+	Kite,
+	KiteOutline,
+	Kitesurfing,
+	Klingon,
+	Knife,
+	KnifeMilitary,
+	Koala,
+	Kodi,
+	Kubernetes,
+	Label,
+	LabelMultiple,
+	LabelMultipleOutline,
+	LabelOff,
+	LabelOffOutline,
+	LabelOutline,
+	LabelPercent,
+	LabelPercentOutline,
+	LabelVariant,
+	LabelVariantOutline,
+	Ladder,
+	Ladybug,
+	Lambda,
+	Lamp,
+	LampOutline,
+	Lamps,
+	LampsOutline,
+	Lan,
+	LanCheck,
+	LanConnect,
+	LanDisconnect,
+	LanPending,
+	LandFields,
+	LandPlots,
+	LandPlotsCircle,
+	LandPlotsCircleVariant,
+	LandRowsHorizontal,
+	LandRowsVertical,
+	Landslide,
+	LandslideOutline,
+	LanguageC,
+	LanguageCpp,
+	LanguageCsharp,
+	LanguageCss3,
+	LanguageFortran,
+	LanguageGo,
+	LanguageHaskell,
+	LanguageHtml5,
+	LanguageJava,
+	LanguageJavascript,
+	LanguageKotlin,
+	LanguageLua,
+	LanguageMarkdown,
+	LanguageMarkdownOutline,
+	LanguagePhp,
+	LanguagePython,
+	LanguageR,
+	LanguageRuby,
+	LanguageRubyOnRails,
+	LanguageRust,
+	LanguageSwift,
+	LanguageTypescript,
+	LanguageXaml,
+	Laptop,
+	LaptopAccount,
+	LaptopOff,
+	Laravel,
+	LaserPointer,
+	Lasso,
+	Lastpass,
+	Latitude,
+	Launch,
+	LavaLamp,
+	Layers,
+	LayersEdit,
+	LayersMinus,
+	LayersOff,
+	LayersOffOutline,
+	LayersOutline,
+	LayersPlus,
+	LayersRemove,
+	LayersSearch,
+	LayersSearchOutline,
+	LayersTriple,
+	LayersTripleOutline,
+	LeadPencil,
+	Leaf,
+	LeafCircle,
+	LeafCircleOutline,
+	LeafMaple,
+	LeafMapleOff,
+	LeafOff,
+	Leak,
+	LeakOff,
+	Lecturn,
+	LedOff,
+	LedOn,
+	LedOutline,
+	LedStrip,
+	LedStripVariant,
+	LedStripVariantOff,
+	LedVariantOff,
+	LedVariantOn,
+	LedVariantOutline,
+	Leek,
+	LessThan,
+	LessThanOrEqual,
+	Library,
+	LibraryOutline,
+	LibraryShelves,
+	License,
+	Lifebuoy,
+	LightFloodDown,
+	LightFloodUp,
+	LightRecessed,
+	LightSwitch,
+	LightSwitchOff,
+	Lightbulb,
+	LightbulbAlert,
+	LightbulbAlertOutline,
+	LightbulbAuto,
+	LightbulbAutoOutline,
+	LightbulbCfl,
+	LightbulbCflOff,
+	LightbulbCflSpiral,
+	LightbulbCflSpiralOff,
+	LightbulbFluorescentTube,
+	LightbulbFluorescentTubeOutline,
+	LightbulbGroup,
+	LightbulbGroupOff,
+	LightbulbGroupOffOutline,
+	LightbulbGroupOutline,
+	LightbulbMultiple,
+	LightbulbMultipleOff,
+	LightbulbMultipleOffOutline,
+	LightbulbMultipleOutline,
+	LightbulbNight,
+	LightbulbNightOutline,
+	LightbulbOff,
+	LightbulbOffOutline,
+	LightbulbOn,
+	LightbulbOn10,
+	LightbulbOn20,
+	LightbulbOn30,
+	LightbulbOn40,
+	LightbulbOn50,
+	LightbulbOn60,
+	LightbulbOn70,
+	LightbulbOn80,
+	LightbulbOn90,
+	LightbulbOnOutline,
+	LightbulbOutline,
+	LightbulbQuestion,
+	LightbulbQuestionOutline,
+	LightbulbSpot,
+	LightbulbSpotOff,
+	LightbulbVariant,
+	LightbulbVariantOutline,
+	Lighthouse,
+	LighthouseOn,
+	LightningBolt,
+	LightningBoltCircle,
+	LightningBoltOutline,
+	LineScan,
+	Lingerie,
+	Link,
+	LinkBox,
+	LinkBoxOutline,
+	LinkBoxVariant,
+	LinkBoxVariantOutline,
+	LinkLock,
+	LinkOff,
+	LinkPlus,
+	LinkVariant,
+	LinkVariantMinus,
+	LinkVariantOff,
+	LinkVariantPlus,
+	LinkVariantRemove,
+	Linkedin,
+	Linux,
+	LinuxMint,
+	Lipstick,
+	LiquidSpot,
+	Liquor,
+	ListStatus,
+	Litecoin,
+	Loading,
+	LocationEnter,
+	LocationExit,
+	Lock,
+	LockAlert,
+	LockAlertOutline,
+	LockCheck,
+	LockCheckOutline,
+	LockClock,
+	LockMinus,
+	LockMinusOutline,
+	LockOff,
+	LockOffOutline,
+	LockOpen,
+	LockOpenAlert,
+	LockOpenAlertOutline,
+	LockOpenCheck,
+	LockOpenCheckOutline,
+	LockOpenMinus,
+	LockOpenMinusOutline,
+	LockOpenOutline,
+	LockOpenPlus,
+	LockOpenPlusOutline,
+	LockOpenRemove,
+	LockOpenRemoveOutline,
+	LockOpenVariant,
+	LockOpenVariantOutline,
+	LockOutline,
+	LockPattern,
+	LockPlus,
+	LockPlusOutline,
+	LockQuestion,
+	LockRemove,
+	LockRemoveOutline,
+	LockReset,
+	LockSmart,
+	Locker,
+	LockerMultiple,
+	Login,
+	Logout,
+	LogoutVariant,
+	Longitude,
+	Looks,
+	Lotion,
+	LotionOutline,
+	LotionPlus,
+	LotionPlusOutline,
+	Loupe,
+	Lumx,
+	Lungs,
+	Mace,
+	MagazinePistol,
+	MagazineRifle,
+	MagicStaff,
+	Magnet,
+	MagnetOn,
+	Magnify,
+	MagnifyClose,
+	MagnifyExpand,
+	MagnifyMinus,
+	MagnifyMinusCursor,
+	MagnifyMinusOutline,
+	MagnifyPlus,
+	MagnifyPlusCursor,
+	MagnifyPlusOutline,
+	MagnifyRemoveCursor,
+	MagnifyRemoveOutline,
+	MagnifyScan,
+	Mail,
+	Mailbox,
+	MailboxOpen,
+	MailboxOpenOutline,
+	MailboxOpenUp,
+	MailboxOpenUpOutline,
+	MailboxOutline,
+	MailboxUp,
+	MailboxUpOutline,
+	Manjaro,
+	Map,
+	MapCheck,
+	MapCheckOutline,
+	MapClock,
+	MapClockOutline,
+	MapLegend,
+	MapMarker,
+	MapMarkerAccount,
+	MapMarkerAccountOutline,
+	MapMarkerAlert,
+	MapMarkerAlertOutline,
+	MapMarkerCheck,
+	MapMarkerCheckOutline,
+	MapMarkerCircle,
+	MapMarkerDistance,
+	MapMarkerDown,
+	MapMarkerLeft,
+	MapMarkerLeftOutline,
+	MapMarkerMinus,
+	MapMarkerMinusOutline,
+	MapMarkerMultiple,
+	MapMarkerMultipleOutline,
+	MapMarkerOff,
+	MapMarkerOffOutline,
+	MapMarkerOutline,
+	MapMarkerPath,
+	MapMarkerPlus,
+	MapMarkerPlusOutline,
+	MapMarkerQuestion,
+	MapMarkerQuestionOutline,
+	MapMarkerRadius,
+	MapMarkerRadiusOutline,
+	MapMarkerRemove,
+	MapMarkerRemoveOutline,
+	MapMarkerRemoveVariant,
+	MapMarkerRight,
+	MapMarkerRightOutline,
+	MapMarkerStar,
+	MapMarkerStarOutline,
+	MapMarkerUp,
+	MapMinus,
+	MapOutline,
+	MapPlus,
+	MapSearch,
+	MapSearchOutline,
+	Mapbox,
+	Margin,
+	Marker,
+	MarkerCancel,
+	MarkerCheck,
+	Mastodon,
+	MaterialDesign,
+	MaterialUi,
+	MathCompass,
+	MathCos,
+	MathIntegral,
+	MathIntegralBox,
+	MathLog,
+	MathNorm,
+	MathNormBox,
+	MathSin,
+	MathTan,
+	Matrix,
+	Medal,
+	MedalOutline,
+	MedicalBag,
+	MedicalCottonSwab,
+	Meditation,
+	Memory,
+	Menorah,
+	MenorahFire,
+	Menu,
+	MenuDown,
+	MenuDownOutline,
+	MenuLeft,
+	MenuLeftOutline,
+	MenuOpen,
+	MenuRight,
+	MenuRightOutline,
+	MenuSwap,
+	MenuSwapOutline,
+	MenuUp,
+	MenuUpOutline,
+	Merge,
+	Message,
+	MessageAlert,
+	MessageAlertOutline,
+	MessageArrowLeft,
+	MessageArrowLeftOutline,
+	MessageArrowRight,
+	MessageArrowRightOutline,
+	MessageBadge,
+	MessageBadgeOutline,
+	MessageBookmark,
+	MessageBookmarkOutline,
+	MessageBulleted,
+	MessageBulletedOff,
+	MessageCog,
+	MessageCogOutline,
+	MessageDraw,
+	MessageFast,
+	MessageFastOutline,
+	MessageFlash,
+	MessageFlashOutline,
+	MessageImage,
+	MessageImageOutline,
+	MessageLock,
+	MessageLockOutline,
+	MessageMinus,
+	MessageMinusOutline,
+	MessageOff,
+	MessageOffOutline,
+	MessageOutline,
+	MessagePlus,
+	MessagePlusOutline,
+	MessageProcessing,
+	MessageProcessingOutline,
+	MessageQuestion,
+	MessageQuestionOutline,
+	MessageReply,
+	MessageReplyOutline,
+	MessageReplyText,
+	MessageReplyTextOutline,
+	MessageSettings,
+	MessageSettingsOutline,
+	MessageStar,
+	MessageStarOutline,
+	MessageText,
+	MessageTextClock,
+	MessageTextClockOutline,
+	MessageTextFast,
+	MessageTextFastOutline,
+	MessageTextLock,
+	MessageTextLockOutline,
+	MessageTextOutline,
+	MessageVideo,
+	Meteor,
+	MeterElectric,
+	MeterElectricOutline,
+	MeterGas,
+	MeterGasOutline,
+	Metronome,
+	MetronomeTick,
+	MicroSd,
+	Microphone,
+	MicrophoneMinus,
+	MicrophoneOff,
+	MicrophoneOutline,
+	MicrophonePlus,
+	MicrophoneQuestion,
+	MicrophoneQuestionOutline,
+	MicrophoneSettings,
+	MicrophoneVariant,
+	MicrophoneVariantOff,
+	Microscope,
+	Microsoft,
+	MicrosoftAccess,
+	MicrosoftAzure,
+	MicrosoftAzureDevops,
+	MicrosoftBing,
+	MicrosoftDynamics365,
+	MicrosoftEdge,
+	MicrosoftExcel,
+	MicrosoftInternetExplorer,
+	MicrosoftOffice,
+	MicrosoftOnedrive,
+	MicrosoftOnenote,
+	MicrosoftOutlook,
+	MicrosoftPowerpoint,
+	MicrosoftSharepoint,
+	MicrosoftTeams,
+	MicrosoftVisualStudio,
+	MicrosoftVisualStudioCode,
+	MicrosoftWindows,
+	MicrosoftWindowsClassic,
+	MicrosoftWord,
+	MicrosoftXbox,
+	MicrosoftXboxController,
+	MicrosoftXboxControllerBatteryAlert,
+	MicrosoftXboxControllerBatteryCharging,
+	MicrosoftXboxControllerBatteryEmpty,
+	MicrosoftXboxControllerBatteryFull,
+	MicrosoftXboxControllerBatteryLow,
+	MicrosoftXboxControllerBatteryMedium,
+	MicrosoftXboxControllerBatteryUnknown,
+	MicrosoftXboxControllerMenu,
+	MicrosoftXboxControllerOff,
+	MicrosoftXboxControllerView,
+	Microwave,
+	MicrowaveOff,
+	Middleware,
+	MiddlewareOutline,
+	Midi,
+	MidiPort,
+	Mine,
+	Minecraft,
+	MiniSd,
+	Minidisc,
+	Minus,
+	MinusBox,
+	MinusBoxMultiple,
+	MinusBoxMultipleOutline,
+	MinusBoxOutline,
+	MinusCircle,
+	MinusCircleMultiple,
+	MinusCircleMultipleOutline,
+	MinusCircleOff,
+	MinusCircleOffOutline,
+	MinusCircleOutline,
+	MinusNetwork,
+	MinusNetworkOutline,
+	MinusThick,
+	Mirror,
+	MirrorRectangle,
+	MirrorVariant,
+	MixedMartialArts,
+	MixedReality,
+	Molecule,
+	MoleculeCo,
+	MoleculeCo2,
+	Monitor,
+	MonitorAccount,
+	MonitorArrowDown,
+	MonitorArrowDownVariant,
+	MonitorCellphone,
+	MonitorCellphoneStar,
+	MonitorDashboard,
+	MonitorEdit,
+	MonitorEye,
+	MonitorLock,
+	MonitorMultiple,
+	MonitorOff,
+	MonitorScreenshot,
+	MonitorShare,
+	MonitorShimmer,
+	MonitorSmall,
+	MonitorSpeaker,
+	MonitorSpeakerOff,
+	MonitorStar,
+	MoonFirstQuarter,
+	MoonFull,
+	MoonLastQuarter,
+	MoonNew,
+	MoonWaningCrescent,
+	MoonWaningGibbous,
+	MoonWaxingCrescent,
+	MoonWaxingGibbous,
+	Moped,
+	MopedElectric,
+	MopedElectricOutline,
+	MopedOutline,
+	More,
+	MortarPestle,
+	MortarPestlePlus,
+	Mosque,
+	MotherHeart,
+	MotherNurse,
+	Motion,
+	MotionOutline,
+	MotionPause,
+	MotionPauseOutline,
+	MotionPlay,
+	MotionPlayOutline,
+	MotionSensor,
+	MotionSensorOff,
+	Motorbike,
+	MotorbikeElectric,
+	Mouse,
+	MouseBluetooth,
+	MouseMoveDown,
+	MouseMoveUp,
+	MouseMoveVertical,
+	MouseOff,
+	MouseVariant,
+	MouseVariantOff,
+	MoveResize,
+	MoveResizeVariant,
+	Movie,
+	MovieCheck,
+	MovieCheckOutline,
+	MovieCog,
+	MovieCogOutline,
+	MovieEdit,
+	MovieEditOutline,
+	MovieFilter,
+	MovieFilterOutline,
+	MovieMinus,
+	MovieMinusOutline,
+	MovieOff,
+	MovieOffOutline,
+	MovieOpen,
+	MovieOpenCheck,
+	MovieOpenCheckOutline,
+	MovieOpenCog,
+	MovieOpenCogOutline,
+	MovieOpenEdit,
+	MovieOpenEditOutline,
+	MovieOpenMinus,
+	MovieOpenMinusOutline,
+	MovieOpenOff,
+	MovieOpenOffOutline,
+	MovieOpenOutline,
+	MovieOpenPlay,
+	MovieOpenPlayOutline,
+	MovieOpenPlus,
+	MovieOpenPlusOutline,
+	MovieOpenRemove,
+	MovieOpenRemoveOutline,
+	MovieOpenSettings,
+	MovieOpenSettingsOutline,
+	MovieOpenStar,
+	MovieOpenStarOutline,
+	MovieOutline,
+	MoviePlay,
+	MoviePlayOutline,
+	MoviePlus,
+	MoviePlusOutline,
+	MovieRemove,
+	MovieRemoveOutline,
+	MovieRoll,
+	MovieSearch,
+	MovieSearchOutline,
+	MovieSettings,
+	MovieSettingsOutline,
+	MovieStar,
+	MovieStarOutline,
+	Mower,
+	MowerBag,
+	Muffin,
+	Multicast,
+	Multiplication,
+	MultiplicationBox,
+	Mushroom,
+	MushroomOff,
+	MushroomOffOutline,
+	MushroomOutline,
+	Music,
+	MusicAccidentalDoubleFlat,
+	MusicAccidentalDoubleSharp,
+	MusicAccidentalFlat,
+	MusicAccidentalNatural,
+	MusicAccidentalSharp,
+	MusicBox,
+	MusicBoxMultiple,
+	MusicBoxMultipleOutline,
+	MusicBoxOutline,
+	MusicCircle,
+	MusicCircleOutline,
+	MusicClefAlto,
+	MusicClefBass,
+	MusicClefTreble,
+	MusicNote,
+	MusicNoteBluetooth,
+	MusicNoteBluetoothOff,
+	MusicNoteEighthDotted,
+	MusicNoteHalf,
+	MusicNoteHalfDotted,
+	MusicNoteOff,
+	MusicNoteOffOutline,
+	MusicNoteOutline,
+	MusicNotePlus,
+	MusicNoteQuarter,
+	MusicNoteQuarterDotted,
+	MusicNoteSixteenth,
+	MusicNoteSixteenthDotted,
+	MusicNoteWhole,
+	MusicNoteWholeDotted,
+	MusicOff,
+	MusicRestEighth,
+	MusicRestHalf,
+	MusicRestQuarter,
+	MusicRestSixteenth,
+	MusicRestWhole,
+	Mustache,
+	Nail,
+	Nas,
+	Nativescript,
+	Nature,
+	NaturePeople,
+	Navigation,
+	NavigationOutline,
+	NavigationVariantOutline,
+	NearMe,
+	Necklace,
+	Needle,
+	NeedleOff,
+	Netflix,
+	Network,
+	NetworkOff,
+	NetworkOffOutline,
+	NetworkOutline,
+	NetworkPos,
+	NetworkStrength1,
+	NetworkStrength1Alert,
+	NetworkStrength2,
+	NetworkStrength2Alert,
+	NetworkStrength3,
+	NetworkStrength3Alert,
+	NetworkStrength4,
+	NetworkStrength4Alert,
+	NetworkStrength4Cog,
+	NetworkStrengthOff,
+	NetworkStrengthOffOutline,
+	NetworkStrengthOutline,
+	NewBox,
+	Newspaper,
+	NewspaperCheck,
+	NewspaperMinus,
+	NewspaperPlus,
+	NewspaperRemove,
+	NewspaperVariant,
+	NewspaperVariantMultiple,
+	NewspaperVariantMultipleOutline,
+	NewspaperVariantOutline,
+	Nfc,
+	NfcSearchVariant,
+	NfcTap,
+	NfcVariant,
+	NfcVariantOff,
+	Ninja,
+	NintendoGameBoy,
+	NintendoSwitch,
+	NintendoWii,
+	NintendoWiiu,
+	Nix,
+	Nodejs,
+	Noodles,
+	NotEqual,
+	NotEqualVariant,
+	Note,
+	NoteAlert,
+	NoteAlertOutline,
+	NoteCheck,
+	NoteCheckOutline,
+	NoteEdit,
+	NoteEditOutline,
+	NoteMinus,
+	NoteMinusOutline,
+	NoteMultiple,
+	NoteMultipleOutline,
+	NoteOff,
+	NoteOffOutline,
+	NoteOutline,
+	NotePlus,
+	NotePlusOutline,
+	NoteRemove,
+	NoteRemoveOutline,
+	NoteSearch,
+	NoteSearchOutline,
+	NoteText,
+	NoteTextOutline,
+	Notebook,
+	NotebookCheck,
+	NotebookCheckOutline,
+	NotebookEdit,
+	NotebookEditOutline,
+	NotebookHeart,
+	NotebookHeartOutline,
+	NotebookMinus,
+	NotebookMinusOutline,
+	NotebookMultiple,
+	NotebookOutline,
+	NotebookPlus,
+	NotebookPlusOutline,
+	NotebookRemove,
+	NotebookRemoveOutline,
+	NotificationClearAll,
+	Npm,
+	Nuke,
+	Null,
+	Numeric,
+	Numeric0Box,
+	Numeric0BoxMultiple,
+	Numeric0BoxMultipleOutline,
+	Numeric0BoxOutline,
+	Numeric1,
+	Numeric10,
+	Numeric10Box,
+	Numeric10BoxMultiple,
+	Numeric10BoxMultipleOutline,
+	Numeric10BoxOutline,
+	Numeric10Circle,
+	Numeric10CircleOutline,
+	Numeric1Box,
+	Numeric1BoxMultiple,
+	Numeric1BoxMultipleOutline,
+	Numeric1BoxOutline,
+	Numeric1Circle,
+	Numeric1CircleOutline,
+	Numeric2,
+	Numeric2Box,
+	Numeric2BoxMultiple,
+	Numeric2BoxMultipleOutline,
+	Numeric2BoxOutline,
+	Numeric2Circle,
+	Numeric2CircleOutline,
+	Numeric3,
+	Numeric3Box,
+	Numeric3BoxMultiple,
+	Numeric3BoxMultipleOutline,
+	Numeric3BoxOutline,
+	Numeric3Circle,
+	Numeric3CircleOutline,
+	Numeric4,
+	Numeric4Box,
+	Numeric4BoxMultiple,
+	Numeric4BoxMultipleOutline,
+	Numeric4BoxOutline,
+	Numeric4Circle,
+	Numeric4CircleOutline,
+	Numeric5,
+	Numeric5Box,
+	Numeric5BoxMultiple,
+	Numeric5BoxMultipleOutline,
+	Numeric5BoxOutline,
+	Numeric5Circle,
+	Numeric5CircleOutline,
+	Numeric6,
+	Numeric6Box,
+	Numeric6BoxMultiple,
+	Numeric6BoxMultipleOutline,
+	Numeric6BoxOutline,
+	Numeric6Circle,
+	Numeric6CircleOutline,
+	Numeric7,
+	Numeric7Box,
+	Numeric7BoxMultiple,
+	Numeric7BoxMultipleOutline,
+	Numeric7BoxOutline,
+	Numeric7Circle,
+	Numeric7CircleOutline,
+	Numeric8,
+	Numeric8Box,
+	Numeric8BoxMultiple,
+	Numeric8BoxMultipleOutline,
+	Numeric8BoxOutline,
+	Numeric8Circle,
+	Numeric8CircleOutline,
+	Numeric9,
+	Numeric9Box,
+	Numeric9BoxMultiple,
+	Numeric9BoxMultipleOutline,
+	Numeric9BoxOutline,
+	Numeric9Circle,
+	Numeric9CircleOutline,
+	Numeric9Plus,
+	Numeric9PlusBox,
+	Numeric9PlusBoxMultiple,
+	Numeric9PlusBoxMultipleOutline,
+	Numeric9PlusBoxOutline,
+	Numeric9PlusCircle,
+	Numeric9PlusCircleOutline,
+	NumericNegative1,
+	NumericOff,
+	NumericPositive1,
+	Nut,
+	Nutrition,
+	Nuxt,
+	Oar,
+	Ocarina,
+	Oci,
+	Ocr,
+	Octagon,
+	OctagonOutline,
+	Octagram,
+	OctagramOutline,
+	Octahedron,
+	OctahedronOff,
+	Odnoklassniki,
+	Offer,
+	OfficeBuilding,
+	OfficeBuildingCog,
+	OfficeBuildingCogOutline,
+	OfficeBuildingMarker,
+	OfficeBuildingMarkerOutline,
+	OfficeBuildingOutline,
+	Oil,
+	OilLamp,
+	OilLevel,
+	OilTemperature,
+	Om,
+	Omega,
+	OneUp,
+	Onepassword,
+	Opacity,
+	OpenInApp,
+	OpenInNew,
+	OpenSourceInitiative,
+	Openid,
+	Opera,
+	Orbit,
+	OrbitVariant,
+	OrderAlphabeticalAscending,
+	OrderAlphabeticalDescending,
+	OrderBoolAscending,
+	OrderBoolAscendingVariant,
+	OrderBoolDescending,
+	OrderBoolDescendingVariant,
+	OrderNumericAscending,
+	OrderNumericDescending,
+	Origin,
+	Ornament,
+	OrnamentVariant,
+	OutdoorLamp,
+	Overscan,
+	Owl,
+	PacMan,
+	Package,
+	PackageDown,
+	PackageUp,
+	PackageVariant,
+	PackageVariantClosed,
+	PackageVariantClosedMinus,
+	PackageVariantClosedPlus,
+	PackageVariantClosedRemove,
+	PackageVariantMinus,
+	PackageVariantPlus,
+	PackageVariantRemove,
+	PageFirst,
+	PageLast,
+	PageLayoutBody,
+	PageLayoutFooter,
+	PageLayoutHeader,
+	PageLayoutHeaderFooter,
+	PageLayoutSidebarLeft,
+	PageLayoutSidebarRight,
+	PageNext,
+	PageNextOutline,
+	PagePrevious,
+	PagePreviousOutline,
+	Pail,
+	PailMinus,
+	PailMinusOutline,
+	PailOff,
+	PailOffOutline,
+	PailOutline,
+	PailPlus,
+	PailPlusOutline,
+	PailRemove,
+	PailRemoveOutline,
+	Palette,
+	PaletteAdvanced,
+	PaletteOutline,
+	PaletteSwatch,
+	PaletteSwatchOutline,
+	PaletteSwatchVariant,
+	PalmTree,
+	Pan,
+	PanBottomLeft,
+	PanBottomRight,
+	PanDown,
+	PanHorizontal,
+	PanLeft,
+	PanRight,
+	PanTopLeft,
+	PanTopRight,
+	PanUp,
+	PanVertical,
+	Panda,
+	Pandora,
+	Panorama,
+	PanoramaFisheye,
+	PanoramaHorizontal,
+	PanoramaHorizontalOutline,
+	PanoramaOutline,
+	PanoramaSphere,
+	PanoramaSphereOutline,
+	PanoramaVariant,
+	PanoramaVariantOutline,
+	PanoramaVertical,
+	PanoramaVerticalOutline,
+	PanoramaWideAngle,
+	PanoramaWideAngleOutline,
+	PaperCutVertical,
+	PaperRoll,
+	PaperRollOutline,
+	Paperclip,
+	PaperclipCheck,
+	PaperclipLock,
+	PaperclipMinus,
+	PaperclipOff,
+	PaperclipPlus,
+	PaperclipRemove,
+	Parachute,
+	ParachuteOutline,
+	Paragliding,
+	Parking,
+	PartyPopper,
+	Passport,
+	PassportBiometric,
+	Pasta,
+	PatioHeater,
+	Patreon,
+	Pause,
+	PauseCircle,
+	PauseCircleOutline,
+	PauseOctagon,
+	PauseOctagonOutline,
+	Paw,
+	PawOff,
+	PawOffOutline,
+	PawOutline,
+	Peace,
+	Peanut,
+	PeanutOff,
+	PeanutOffOutline,
+	PeanutOutline,
+	Pen,
+	PenLock,
+	PenMinus,
+	PenOff,
+	PenPlus,
+	PenRemove,
+	Pencil,
+	PencilBox,
+	PencilBoxMultiple,
+	PencilBoxMultipleOutline,
+	PencilBoxOutline,
+	PencilCircle,
+	PencilCircleOutline,
+	PencilLock,
+	PencilLockOutline,
+	PencilMinus,
+	PencilMinusOutline,
+	PencilOff,
+	PencilOffOutline,
+	PencilOutline,
+	PencilPlus,
+	PencilPlusOutline,
+	PencilRemove,
+	PencilRemoveOutline,
+	PencilRuler,
+	Penguin,
+	Pentagon,
+	PentagonOutline,
+	Pentagram,
+	Percent,
+	PercentBox,
+	PercentBoxOutline,
+	PercentCircle,
+	PercentCircleOutline,
+	PercentOutline,
+	PeriodicTable,
+	PerspectiveLess,
+	PerspectiveMore,
+	Ph,
+	Phone,
+	PhoneAlert,
+	PhoneAlertOutline,
+	PhoneBluetooth,
+	PhoneBluetoothOutline,
+	PhoneCancel,
+	PhoneCancelOutline,
+	PhoneCheck,
+	PhoneCheckOutline,
+	PhoneClassic,
+	PhoneClassicOff,
+	PhoneClock,
+	PhoneDial,
+	PhoneDialOutline,
+	PhoneForward,
+	PhoneForwardOutline,
+	PhoneHangup,
+	PhoneHangupOutline,
+	PhoneInTalk,
+	PhoneInTalkOutline,
+	PhoneIncoming,
+	PhoneIncomingOutline,
+	PhoneLock,
+	PhoneLockOutline,
+	PhoneLog,
+	PhoneLogOutline,
+	PhoneMessage,
+	PhoneMessageOutline,
+	PhoneMinus,
+	PhoneMinusOutline,
+	PhoneMissed,
+	PhoneMissedOutline,
+	PhoneOff,
+	PhoneOffOutline,
+	PhoneOutgoing,
+	PhoneOutgoingOutline,
+	PhoneOutline,
+	PhonePaused,
+	PhonePausedOutline,
+	PhonePlus,
+	PhonePlusOutline,
+	PhoneRefresh,
+	PhoneRefreshOutline,
+	PhoneRemove,
+	PhoneRemoveOutline,
+	PhoneReturn,
+	PhoneReturnOutline,
+	PhoneRing,
+	PhoneRingOutline,
+	PhoneRotateLandscape,
+	PhoneRotatePortrait,
+	PhoneSettings,
+	PhoneSettingsOutline,
+	PhoneSync,
+	PhoneSyncOutline,
+	PhoneVoip,
+	Pi,
+	PiBox,
+	PiHole,
+	Piano,
+	PianoOff,
+	Pickaxe,
+	PictureInPictureBottomRight,
+	PictureInPictureBottomRightOutline,
+	PictureInPictureTopRight,
+	PictureInPictureTopRightOutline,
+	Pier,
+	PierCrane,
+	Pig,
+	PigVariant,
+	PigVariantOutline,
+	PiggyBank,
+	PiggyBankOutline,
+	Pill,
+	PillOff,
+	Pillar,
+	Pin,
+	PinOff,
+	PinOffOutline,
+	PinOutline,
+	PineTree,
+	PineTreeBox,
+	PineTreeFire,
+	Pinterest,
+	Pinwheel,
+	PinwheelOutline,
+	Pipe,
+	PipeDisconnected,
+	PipeLeak,
+	PipeValve,
+	PipeWrench,
+	Pirate,
+	Pistol,
+	Piston,
+	Pitchfork,
+	Pizza,
+	Play,
+	PlayBox,
+	PlayBoxLock,
+	PlayBoxLockOpen,
+	PlayBoxLockOpenOutline,
+	PlayBoxLockOutline,
+	PlayBoxMultiple,
+	PlayBoxMultipleOutline,
+	PlayBoxOutline,
+	PlayCircle,
+	PlayCircleOutline,
+	PlayNetwork,
+	PlayNetworkOutline,
+	PlayOutline,
+	PlayPause,
+	PlayProtectedContent,
+	PlaySpeed,
+	PlaylistCheck,
+	PlaylistEdit,
+	PlaylistMinus,
+	PlaylistMusic,
+	PlaylistMusicOutline,
+	PlaylistPlay,
+	PlaylistPlus,
+	PlaylistRemove,
+	PlaylistStar,
+	Plex,
+	Pliers,
+	Plus,
+	PlusBox,
+	PlusBoxMultiple,
+	PlusBoxMultipleOutline,
+	PlusBoxOutline,
+	PlusCircle,
+	PlusCircleMultiple,
+	PlusCircleMultipleOutline,
+	PlusCircleOutline,
+	PlusLock,
+	PlusLockOpen,
+	PlusMinus,
+	PlusMinusBox,
+	PlusMinusVariant,
+	PlusNetwork,
+	PlusNetworkOutline,
+	PlusOutline,
+	PlusThick,
+	Podcast,
+	Podium,
+	PodiumBronze,
+	PodiumGold,
+	PodiumSilver,
+	PointOfSale,
+	Pokeball,
+	PokemonGo,
+	PokerChip,
+	Polaroid,
+	PoliceBadge,
+	PoliceBadgeOutline,
+	PoliceStation,
+	Poll,
+	Polo,
+	Polymer,
+	Pool,
+	PoolThermometer,
+	Popcorn,
+	Post,
+	PostLamp,
+	PostOutline,
+	PostageStamp,
+	Pot,
+	PotMix,
+	PotMixOutline,
+	PotOutline,
+	PotSteam,
+	PotSteamOutline,
+	Pound,
+	PoundBox,
+	PoundBoxOutline,
+	Power,
+	PowerCycle,
+	PowerOff,
+	PowerOn,
+	PowerPlug,
+	PowerPlugOff,
+	PowerPlugOffOutline,
+	PowerPlugOutline,
+	PowerSettings,
+	PowerSleep,
+	PowerSocket,
+	PowerSocketAu,
+	PowerSocketCh,
+	PowerSocketDe,
+	PowerSocketEu,
+	PowerSocketFr,
+	PowerSocketIt,
+	PowerSocketJp,
+	PowerSocketUk,
+	PowerSocketUs,
+	PowerStandby,
+	Powershell,
+	Prescription,
+	Presentation,
+	PresentationPlay,
+	Pretzel,
+	Printer,
+	Printer3D,
+	Printer3DNozzle,
+	Printer3DNozzleAlert,
+	Printer3DNozzleAlertOutline,
+	Printer3DNozzleHeat,
+	Printer3DNozzleHeatOutline,
+	Printer3DNozzleOutline,
+	PrinterAlert,
+	PrinterCheck,
+	PrinterEye,
+	PrinterOff,
+	PrinterOffOutline,
+	PrinterOutline,
+	PrinterPos,
+	PrinterSearch,
+	PrinterSettings,
+	PrinterWireless,
+	PriorityHigh,
+	PriorityLow,
+	ProfessionalHexagon,
+	ProgressAlert,
+	ProgressCheck,
+	ProgressClock,
+	ProgressClose,
+	ProgressDownload,
+	ProgressPencil,
+	ProgressQuestion,
+	ProgressStar,
+	ProgressUpload,
+	ProgressWrench,
+	Projector,
+	ProjectorOff,
+	ProjectorScreen,
+	ProjectorScreenOff,
+	ProjectorScreenOffOutline,
+	ProjectorScreenOutline,
+	ProjectorScreenVariant,
+	ProjectorScreenVariantOff,
+	ProjectorScreenVariantOffOutline,
+	ProjectorScreenVariantOutline,
+	PropaneTank,
+	PropaneTankOutline,
+	Protocol,
+	Publish,
+	PublishOff,
+	Pulse,
+	Pump,
+	Pumpkin,
+	Purse,
+	PurseOutline,
+	Puzzle,
+	PuzzleCheck,
+	PuzzleCheckOutline,
+	PuzzleEdit,
+	PuzzleEditOutline,
+	PuzzleHeart,
+	PuzzleHeartOutline,
+	PuzzleMinus,
+	PuzzleMinusOutline,
+	PuzzleOutline,
+	PuzzlePlus,
+	PuzzlePlusOutline,
+	PuzzleRemove,
+	PuzzleRemoveOutline,
+	PuzzleStar,
+	PuzzleStarOutline,
+	Pyramid,
+	PyramidOff,
+	Qi,
+	Qqchat,
+	Qrcode,
+	QrcodeEdit,
+	QrcodeMinus,
+	QrcodePlus,
+	QrcodeRemove,
+	QrcodeScan,
+	Quadcopter,
+	QualityHigh,
+	QualityLow,
+	QualityMedium,
+	Quora,
+	Rabbit,
+	RabbitVariant,
+	RabbitVariantOutline,
+	RacingHelmet,
+	Racquetball,
+	Radar,
+	Radiator,
+	RadiatorDisabled,
+	RadiatorOff,
+	Radio,
+	RadioAm,
+	RadioFm,
+	RadioHandheld,
+	RadioOff,
+	RadioTower,
+	Radioactive,
+	RadioactiveCircle,
+	RadioactiveCircleOutline,
+	RadioactiveOff,
+	RadioboxMarked,
+	RadiologyBox,
+	RadiologyBoxOutline,
+	Radius,
+	RadiusOutline,
+	RailroadLight,
+	Rake,
+	RaspberryPi,
+	Raw,
+	RawOff,
+	RayEnd,
+	RayEndArrow,
+	RayStart,
+	RayStartArrow,
+	RayStartEnd,
+	RayStartVertexEnd,
+	RayVertex,
+	RazorDoubleEdge,
+	RazorSingleEdge,
+	React,
+	Read,
+	Receipt,
+	ReceiptOutline,
+	ReceiptTextCheck,
+	ReceiptTextCheckOutline,
+	ReceiptTextMinus,
+	ReceiptTextMinusOutline,
+	ReceiptTextPlus,
+	ReceiptTextPlusOutline,
+	ReceiptTextRemove,
+	ReceiptTextRemoveOutline,
+	Record,
+	RecordCircle,
+	RecordCircleOutline,
+	RecordPlayer,
+	RecordRec,
+	Rectangle,
+	RectangleOutline,
+	Recycle,
+	RecycleVariant,
+	Reddit,
+	Redhat,
+	Redo,
+	RedoVariant,
+	ReflectHorizontal,
+	ReflectVertical,
+	Refresh,
+	RefreshAuto,
+	RefreshCircle,
+	Regex,
+	RegisteredTrademark,
+	Reiterate,
+	RelationManyToMany,
+	RelationManyToOne,
+	RelationManyToOneOrMany,
+	RelationManyToOnlyOne,
+	RelationManyToZeroOrMany,
+	RelationManyToZeroOrOne,
+	RelationOneOrManyToMany,
+	RelationOneOrManyToOne,
+	RelationOneOrManyToOneOrMany,
+	RelationOneOrManyToOnlyOne,
+	RelationOneOrManyToZeroOrMany,
+	RelationOneOrManyToZeroOrOne,
+	RelationOneToMany,
+	RelationOneToOne,
+	RelationOneToOneOrMany,
+	RelationOneToOnlyOne,
+	RelationOneToZeroOrMany,
+	RelationOneToZeroOrOne,
+	RelationOnlyOneToMany,
+	RelationOnlyOneToOne,
+	RelationOnlyOneToOneOrMany,
+	RelationOnlyOneToOnlyOne,
+	RelationOnlyOneToZeroOrMany,
+	RelationOnlyOneToZeroOrOne,
+	RelationZeroOrManyToMany,
+	RelationZeroOrManyToOne,
+	RelationZeroOrManyToOneOrMany,
+	RelationZeroOrManyToOnlyOne,
+	RelationZeroOrManyToZeroOrMany,
+	RelationZeroOrManyToZeroOrOne,
+	RelationZeroOrOneToMany,
+	RelationZeroOrOneToOne,
+	RelationZeroOrOneToOneOrMany,
+	RelationZeroOrOneToOnlyOne,
+	RelationZeroOrOneToZeroOrMany,
+	RelationZeroOrOneToZeroOrOne,
+	RelativeScale,
+	Reload,
+	ReloadAlert,
+	Reminder,
+	Remote,
+	RemoteDesktop,
+	RemoteOff,
+	RemoteTv,
+	RemoteTvOff,
+	RenameBox,
+	ReorderHorizontal,
+	ReorderVertical,
+	Repeat,
+	RepeatOff,
+	RepeatOnce,
+	RepeatVariant,
+	Replay,
+	Reply,
+	ReplyAll,
+	ReplyAllOutline,
+	ReplyCircle,
+	ReplyOutline,
+	Reproduction,
+	Resistor,
+	ResistorNodes,
+	Resize,
+	ResizeBottomRight,
+	Responsive,
+	Restart,
+	RestartAlert,
+	RestartOff,
+	Restore,
+	RestoreAlert,
+	Rewind,
+	Rewind10,
+	Rewind15,
+	Rewind30,
+	Rewind5,
+	Rewind60,
+	RewindOutline,
+	Rhombus,
+	RhombusMedium,
+	RhombusMediumOutline,
+	RhombusOutline,
+	RhombusSplit,
+	RhombusSplitOutline,
+	Ribbon,
+	Rice,
+	Rickshaw,
+	RickshawElectric,
+	Ring,
+	Rivet,
+	Road,
+	RoadVariant,
+	Robber,
+	Robot,
+	RobotAngry,
+	RobotAngryOutline,
+	RobotConfused,
+	RobotConfusedOutline,
+	RobotDead,
+	RobotDeadOutline,
+	RobotExcited,
+	RobotExcitedOutline,
+	RobotHappy,
+	RobotHappyOutline,
+	RobotIndustrial,
+	RobotIndustrialOutline,
+	RobotLove,
+	RobotLoveOutline,
+	RobotMower,
+	RobotMowerOutline,
+	RobotOff,
+	RobotOffOutline,
+	RobotOutline,
+	RobotVacuum,
+	RobotVacuumVariant,
+	Rocket,
+	RocketLaunch,
+	RocketLaunchOutline,
+	RocketOutline,
+	Rodent,
+	RollerShade,
+	RollerShadeClosed,
+	RollerSkate,
+	RollerSkateOff,
+	Rollerblade,
+	RollerbladeOff,
+	Rollupjs,
+	Rolodex,
+	RolodexOutline,
+	RomanNumeral2,
+	RomanNumeral3,
+	RomanNumeral4,
+	RomanNumeral6,
+	RomanNumeral7,
+	RomanNumeral8,
+	RomanNumeral9,
+	RoomService,
+	RoomServiceOutline,
+	Rotate360,
+	Rotate3D,
+	Rotate3DVariant,
+	RotateLeft,
+	RotateLeftVariant,
+	RotateOrbit,
+	RotateRight,
+	RotateRightVariant,
+	RoundedCorner,
+	Router,
+	RouterNetwork,
+	RouterWireless,
+	RouterWirelessOff,
+	RouterWirelessSettings,
+	Routes,
+	RoutesClock,
+	Rowing,
+	Rss,
+	RssBox,
+	RssOff,
+	Rug,
+	Rugby,
+	Ruler,
+	RulerSquare,
+	RulerSquareCompass,
+	Run,
+	RunFast,
+	RvTruck,
+	Sack,
+	SackPercent,
+	Safe,
+	SafeSquare,
+	SafeSquareOutline,
+	SafetyGoggles,
+	SailBoat,
+	SailBoatSink,
+	Sale,
+	SaleOutline,
+	Salesforce,
+	Sass,
+	Satellite,
+	SatelliteUplink,
+	SatelliteVariant,
+	Sausage,
+	SausageOff,
+	SawBlade,
+	SawtoothWave,
+	Saxophone,
+	Scale,
+	ScaleBalance,
+	ScaleBathroom,
+	ScaleOff,
+	ScaleUnbalanced,
+	ScanHelper,
+	Scanner,
+	ScannerOff,
+	ScatterPlot,
+	ScatterPlotOutline,
+	Scent,
+	ScentOff,
+	School,
+	SchoolOutline,
+	ScissorsCutting,
+	Scooter,
+	ScooterElectric,
+	Scoreboard,
+	ScoreboardOutline,
+	ScreenRotation,
+	ScreenRotationLock,
+	ScrewFlatTop,
+	ScrewLag,
+	ScrewMachineFlatTop,
+	ScrewMachineRoundTop,
+	ScrewRoundTop,
+	Screwdriver,
+	Script,
+	ScriptOutline,
+	ScriptText,
+	ScriptTextKey,
+	ScriptTextKeyOutline,
+	ScriptTextOutline,
+	ScriptTextPlay,
+	ScriptTextPlayOutline,
+	Sd,
+	Seal,
+	SealVariant,
+	SearchWeb,
+	Seat,
+	SeatFlat,
+	SeatFlatAngled,
+	SeatIndividualSuite,
+	SeatLegroomExtra,
+	SeatLegroomNormal,
+	SeatLegroomReduced,
+	SeatOutline,
+	SeatPassenger,
+	SeatReclineExtra,
+	SeatReclineNormal,
+	Seatbelt,
+	Security,
+	SecurityNetwork,
+	Seed,
+	SeedOff,
+	SeedOffOutline,
+	SeedOutline,
+	SeedPlus,
+	SeedPlusOutline,
+	Seesaw,
+	Segment,
+	Select,
+	SelectAll,
+	SelectColor,
+	SelectCompare,
+	SelectDrag,
+	SelectGroup,
+	SelectInverse,
+	SelectMarker,
+	SelectMultiple,
+	SelectMultipleMarker,
+	SelectOff,
+	SelectPlace,
+	SelectRemove,
+	SelectSearch,
+	Selection,
+	SelectionDrag,
+	SelectionEllipse,
+	SelectionEllipseArrowInside,
+	SelectionEllipseRemove,
+	SelectionMarker,
+	SelectionMultiple,
+	SelectionMultipleMarker,
+	SelectionOff,
+	SelectionRemove,
+	SelectionSearch,
+	SemanticWeb,
+	Send,
+	SendCheck,
+	SendCheckOutline,
+	SendCircle,
+	SendCircleOutline,
+	SendClock,
+	SendClockOutline,
+	SendLock,
+	SendLockOutline,
+	SendOutline,
+	SerialPort,
+	Server,
+	ServerMinus,
+	ServerNetwork,
+	ServerNetworkOff,
+	ServerOff,
+	ServerPlus,
+	ServerRemove,
+	ServerSecurity,
+	SetAll,
+	SetCenter,
+	SetCenterRight,
+	SetLeft,
+	SetLeftCenter,
+	SetLeftRight,
+	SetMerge,
+	SetNone,
+	SetRight,
+	SetSplit,
+	SetSquare,
+	SetTopBox,
+	SettingsHelper,
+	Shaker,
+	ShakerOutline,
+	Shape,
+	ShapeCirclePlus,
+	ShapeOutline,
+	ShapeOvalPlus,
+	ShapePlus,
+	ShapePolygonPlus,
+	ShapeRectanglePlus,
+	ShapeSquarePlus,
+	ShapeSquareRoundedPlus,
+	Share,
+	ShareAll,
+	ShareAllOutline,
+	ShareCircle,
+	ShareOff,
+	ShareOffOutline,
+	ShareOutline,
+	ShareVariant,
+	ShareVariantOutline,
+	Shark,
+	SharkFin,
+	SharkFinOutline,
+	SharkOff,
+	Sheep,
+	Shield,
+	ShieldAccount,
+	ShieldAccountOutline,
+	ShieldAccountVariant,
+	ShieldAccountVariantOutline,
+	ShieldAirplane,
+	ShieldAirplaneOutline,
+	ShieldAlert,
+	ShieldAlertOutline,
+	ShieldBug,
+	ShieldBugOutline,
+	ShieldCar,
+	ShieldCheck,
+	ShieldCheckOutline,
+	ShieldCross,
+	ShieldCrossOutline,
+	ShieldCrown,
+	ShieldCrownOutline,
+	ShieldEdit,
+	ShieldEditOutline,
+	ShieldHalf,
+	ShieldHalfFull,
+	ShieldHome,
+	ShieldHomeOutline,
+	ShieldKey,
+	ShieldKeyOutline,
+	ShieldLinkVariant,
+	ShieldLinkVariantOutline,
+	ShieldLock,
+	ShieldLockOpen,
+	ShieldLockOpenOutline,
+	ShieldLockOutline,
+	ShieldMoon,
+	ShieldMoonOutline,
+	ShieldOff,
+	ShieldOffOutline,
+	ShieldOutline,
+	ShieldPlus,
+	ShieldPlusOutline,
+	ShieldRefresh,
+	ShieldRefreshOutline,
+	ShieldRemove,
+	ShieldRemoveOutline,
+	ShieldSearch,
+	ShieldStar,
+	ShieldStarOutline,
+	ShieldSun,
+	ShieldSunOutline,
+	ShieldSword,
+	ShieldSwordOutline,
+	ShieldSync,
+	ShieldSyncOutline,
+	Shimmer,
+	ShipWheel,
+	ShippingPallet,
+	ShoeBallet,
+	ShoeCleat,
+	ShoeFormal,
+	ShoeHeel,
+	ShoePrint,
+	ShoeSneaker,
+	Shopping,
+	ShoppingMusic,
+	ShoppingOutline,
+	ShoppingSearch,
+	ShoppingSearchOutline,
+	Shore,
+	Shovel,
+	ShovelOff,
+	Shower,
+	ShowerHead,
+	Shredder,
+	Shuffle,
+	ShuffleDisabled,
+	ShuffleVariant,
+	Shuriken,
+	Sickle,
+	Sigma,
+	SigmaLower,
+	SignCaution,
+	SignDirection,
+	SignDirectionMinus,
+	SignDirectionPlus,
+	SignDirectionRemove,
+	SignPole,
+	SignRealEstate,
+	SignText,
+	Signal,
+	Signal2G,
+	Signal3G,
+	Signal4G,
+	Signal5G,
+	SignalCellular1,
+	SignalCellular2,
+	SignalCellular3,
+	SignalCellularOutline,
+	SignalDistanceVariant,
+	SignalHspa,
+	SignalHspaPlus,
+	SignalOff,
+	SignalVariant,
+	Signature,
+	SignatureFreehand,
+	SignatureImage,
+	SignatureText,
+	Silo,
+	Silverware,
+	SilverwareClean,
+	SilverwareFork,
+	SilverwareForkKnife,
+	SilverwareSpoon,
+	SilverwareVariant,
+	Sim,
+	SimAlert,
+	SimAlertOutline,
+	SimOff,
+	SimOffOutline,
+	SimOutline,
+	SimpleIcons,
+	SinaWeibo,
+	SineWave,
+	Sitemap,
+	SitemapOutline,
+	SizeM,
+	SizeS,
+	SizeXl,
+	SizeXs,
+	SizeXxl,
+	SizeXxs,
+	SizeXxxl,
+	Skate,
+	SkateOff,
+	Skateboard,
+	Skateboarding,
+	SkewLess,
+	SkewMore,
+	Ski,
+	SkiCrossCountry,
+	SkiWater,
+	SkipBackward,
+	SkipBackwardOutline,
+	SkipForward,
+	SkipForwardOutline,
+	SkipNext,
+	SkipNextCircle,
+	SkipNextCircleOutline,
+	SkipNextOutline,
+	SkipPrevious,
+	SkipPreviousCircle,
+	SkipPreviousCircleOutline,
+	SkipPreviousOutline,
+	Skull,
+	SkullCrossbones,
+	SkullCrossbonesOutline,
+	SkullOutline,
+	SkullScan,
+	SkullScanOutline,
+	Skype,
+	SkypeBusiness,
+	Slack,
+	SlashForward,
+	SlashForwardBox,
+	Sledding,
+	Sleep,
+	SleepOff,
+	Slide,
+	SlopeDownhill,
+	SlopeUphill,
+	SlotMachine,
+	SlotMachineOutline,
+	SmartCard,
+	SmartCardOff,
+	SmartCardOffOutline,
+	SmartCardOutline,
+	SmartCardReader,
+	SmartCardReaderOutline,
+	Smog,
+	Smoke,
+	SmokeDetector,
+	SmokeDetectorAlert,
+	SmokeDetectorAlertOutline,
+	SmokeDetectorOff,
+	SmokeDetectorOffOutline,
+	SmokeDetectorOutline,
+	SmokeDetectorVariant,
+	SmokeDetectorVariantAlert,
+	SmokeDetectorVariantOff,
+	Smoking,
+	SmokingOff,
+	SmokingPipe,
+	SmokingPipeOff,
+	Snail,
+	Snake,
+	Snapchat,
+	Snowboard,
+	Snowflake,
+	SnowflakeAlert,
+	SnowflakeCheck,
+	SnowflakeMelt,
+	SnowflakeOff,
+	SnowflakeThermometer,
+	SnowflakeVariant,
+	Snowman,
+	Snowmobile,
+	Snowshoeing,
+	Soccer,
+	SoccerField,
+	SocialDistance2Meters,
+	SocialDistance6Feet,
+	Sofa,
+	SofaOutline,
+	SofaSingle,
+	SofaSingleOutline,
+	SolarPanel,
+	SolarPanelLarge,
+	SolarPower,
+	SolarPowerVariant,
+	SolarPowerVariantOutline,
+	SolderingIron,
+	Solid,
+	SonyPlaystation,
+	Sort,
+	SortAlphabeticalAscending,
+	SortAlphabeticalAscendingVariant,
+	SortAlphabeticalDescending,
+	SortAlphabeticalDescendingVariant,
+	SortAlphabeticalVariant,
+	SortAscending,
+	SortBoolAscending,
+	SortBoolAscendingVariant,
+	SortBoolDescending,
+	SortBoolDescendingVariant,
+	SortCalendarAscending,
+	SortCalendarDescending,
+	SortClockAscending,
+	SortClockAscendingOutline,
+	SortClockDescending,
+	SortClockDescendingOutline,
+	SortDescending,
+	SortNumericAscending,
+	SortNumericAscendingVariant,
+	SortNumericDescending,
+	SortNumericDescendingVariant,
+	SortNumericVariant,
+	SortReverseVariant,
+	SortVariant,
+	SortVariantLock,
+	SortVariantLockOpen,
+	SortVariantOff,
+	SortVariantRemove,
+	Soundbar,
+	Soundcloud,
+	SourceBranch,
+	SourceBranchCheck,
+	SourceBranchMinus,
+	SourceBranchPlus,
+	SourceBranchRefresh,
+	SourceBranchRemove,
+	SourceBranchSync,
+	SourceCommit,
+	SourceCommitEnd,
+	SourceCommitEndLocal,
+	SourceCommitLocal,
+	SourceCommitNextLocal,
+	SourceCommitStart,
+	SourceCommitStartNextLocal,
+	SourceFork,
+	SourceMerge,
+	SourcePull,
+	SourceRepository,
+	SourceRepositoryMultiple,
+	SoySauce,
+	SoySauceOff,
+	Spa,
+	SpaOutline,
+	SpaceInvaders,
+	SpaceStation,
+	Spade,
+	Speaker,
+	SpeakerBluetooth,
+	SpeakerMultiple,
+	SpeakerOff,
+	SpeakerWireless,
+	Spear,
+	Speedometer,
+	SpeedometerMedium,
+	SpeedometerSlow,
+	Spellcheck,
+	Sphere,
+	SphereOff,
+	Spider,
+	SpiderThread,
+	SpiderWeb,
+	SpiritLevel,
+	SpoonSugar,
+	Spotify,
+	Spotlight,
+	SpotlightBeam,
+	Spray,
+	SprayBottle,
+	Sprinkler,
+	SprinklerFire,
+	SprinklerVariant,
+	Sprout,
+	SproutOutline,
+	Square,
+	SquareCircle,
+	SquareEditOutline,
+	SquareMedium,
+	SquareMediumOutline,
+	SquareOff,
+	SquareOffOutline,
+	SquareOpacity,
+	SquareOutline,
+	SquareRoot,
+	SquareRootBox,
+	SquareRounded,
+	SquareRoundedBadge,
+	SquareRoundedBadgeOutline,
+	SquareRoundedOutline,
+	SquareSmall,
+	SquareWave,
+	Squeegee,
+	Ssh,
+	StackExchange,
+	StackOverflow,
+	Stackpath,
+	Stadium,
+	StadiumVariant,
+	Stairs,
+	StairsBox,
+	StairsDown,
+	StairsUp,
+	Stamper,
+	StandardDefinition,
+	Star,
+	StarBox,
+	StarBoxMultiple,
+	StarBoxMultipleOutline,
+	StarBoxOutline,
+	StarCheck,
+	StarCheckOutline,
+	StarCircle,
+	StarCircleOutline,
+	StarCog,
+	StarCogOutline,
+	StarCrescent,
+	StarDavid,
+	StarFace,
+	StarFourPoints,
+	StarFourPointsOutline,
+	StarHalf,
+	StarHalfFull,
+	StarMinus,
+	StarMinusOutline,
+	StarOff,
+	StarOffOutline,
+	StarOutline,
+	StarPlus,
+	StarPlusOutline,
+	StarRemove,
+	StarRemoveOutline,
+	StarSettings,
+	StarSettingsOutline,
+	StarShooting,
+	StarShootingOutline,
+	StarThreePoints,
+	StarThreePointsOutline,
+	StateMachine,
+	Steam,
+	Steering,
+	SteeringOff,
+	StepBackward,
+	StepBackward2,
+	StepForward,
+	StepForward2,
+	Stethoscope,
+	Sticker,
+	StickerAlert,
+	StickerAlertOutline,
+	StickerCheck,
+	StickerCheckOutline,
+	StickerCircleOutline,
+	StickerEmoji,
+	StickerMinus,
+	StickerMinusOutline,
+	StickerOutline,
+	StickerPlus,
+	StickerPlusOutline,
+	StickerRemove,
+	StickerRemoveOutline,
+	StickerText,
+	StickerTextOutline,
+	Stocking,
+	Stomach,
+	Stool,
+	StoolOutline,
+	Stop,
+	StopCircle,
+	StopCircleOutline,
+	StorageTank,
+	StorageTankOutline,
+	Store,
+	Store24Hour,
+	StoreAlert,
+	StoreAlertOutline,
+	StoreCheck,
+	StoreCheckOutline,
+	StoreClock,
+	StoreClockOutline,
+	StoreCog,
+	StoreCogOutline,
+	StoreEdit,
+	StoreEditOutline,
+	StoreMarker,
+	StoreMarkerOutline,
+	StoreMinus,
+	StoreMinusOutline,
+	StoreOff,
+	StoreOffOutline,
+	StoreOutline,
+	StorePlus,
+	StorePlusOutline,
+	StoreRemove,
+	StoreRemoveOutline,
+	StoreSearch,
+	StoreSearchOutline,
+	StoreSettings,
+	StoreSettingsOutline,
+	Storefront,
+	StorefrontOutline,
+	Stove,
+	Strategy,
+	StretchToPage,
+	StretchToPageOutline,
+	StringLights,
+	StringLightsOff,
+	SubdirectoryArrowLeft,
+	SubdirectoryArrowRight,
+	Submarine,
+	Subtitles,
+	SubtitlesOutline,
+	Subway,
+	SubwayAlertVariant,
+	SubwayVariant,
+	Summit,
+	SunClock,
+	SunClockOutline,
+	SunCompass,
+	SunSnowflake,
+	SunSnowflakeVariant,
+	SunThermometer,
+	SunThermometerOutline,
+	SunWireless,
+	SunWirelessOutline,
+	Sunglasses,
+	Surfing,
+	SurroundSound,
+	SurroundSound20,
+	SurroundSound21,
+	SurroundSound31,
+	SurroundSound51,
+	SurroundSound512,
+	SurroundSound71,
+	Svg,
+	SwapHorizontal,
+	SwapHorizontalBold,
+	SwapHorizontalCircle,
+	SwapHorizontalCircleOutline,
+	SwapHorizontalVariant,
+	SwapVertical,
+	SwapVerticalBold,
+	SwapVerticalCircle,
+	SwapVerticalCircleOutline,
+	SwapVerticalVariant,
+	Swim,
+	Switch,
+	Sword,
+	SwordCross,
+	SyllabaryHangul,
+	SyllabaryHiragana,
+	SyllabaryKatakana,
+	SyllabaryKatakanaHalfwidth,
+	Symbol,
+	Symfony,
+	Sync,
+	SyncAlert,
+	SyncCircle,
+	SyncOff,
+	Tab,
+	TabMinus,
+	TabPlus,
+	TabRemove,
+	TabSearch,
+	TabUnselected,
+	Table,
+	TableAccount,
+	TableAlert,
+	TableArrowDown,
+	TableArrowLeft,
+	TableArrowRight,
+	TableArrowUp,
+	TableBorder,
+	TableCancel,
+	TableChair,
+	TableCheck,
+	TableClock,
+	TableCog,
+	TableColumn,
+	TableColumnPlusAfter,
+	TableColumnPlusBefore,
+	TableColumnRemove,
+	TableColumnWidth,
+	TableEdit,
+	TableEye,
+	TableEyeOff,
+	TableFurniture,
+	TableHeadersEye,
+	TableHeadersEyeOff,
+	TableHeart,
+	TableKey,
+	TableLarge,
+	TableLargePlus,
+	TableLargeRemove,
+	TableLock,
+	TableMergeCells,
+	TableMinus,
+	TableMultiple,
+	TableNetwork,
+	TableOfContents,
+	TableOff,
+	TablePicnic,
+	TablePivot,
+	TablePlus,
+	TableRefresh,
+	TableRemove,
+	TableRow,
+	TableRowHeight,
+	TableRowPlusAfter,
+	TableRowPlusBefore,
+	TableRowRemove,
+	TableSearch,
+	TableSettings,
+	TableSplitCell,
+	TableStar,
+	TableSync,
+	TableTennis,
+	Tablet,
+	TabletAndroid,
+	TabletCellphone,
+	TabletDashboard,
+	Taco,
+	Tag,
+	TagArrowDown,
+	TagArrowDownOutline,
+	TagArrowLeft,
+	TagArrowLeftOutline,
+	TagArrowRight,
+	TagArrowRightOutline,
+	TagArrowUp,
+	TagArrowUpOutline,
+	TagCheck,
+	TagCheckOutline,
+	TagFaces,
+	TagHeart,
+	TagHeartOutline,
+	TagMinus,
+	TagMinusOutline,
+	TagMultiple,
+	TagMultipleOutline,
+	TagOff,
+	TagOffOutline,
+	TagOutline,
+	TagPlus,
+	TagPlusOutline,
+	TagRemove,
+	TagRemoveOutline,
+	TagSearch,
+	TagSearchOutline,
+	TagText,
+	TagTextOutline,
+	Tailwind,
+	TallyMark1,
+	TallyMark2,
+	TallyMark3,
+	TallyMark4,
+	TallyMark5,
+	Tangram,
+	Tank,
+	TankerTruck,
+	TapeDrive,
+	TapeMeasure,
+	Target,
+	TargetAccount,
+	TargetVariant,
+	Taxi,
+	Tea,
+	TeaOutline,
+	Teamviewer,
+	TeddyBear,
+	Telescope,
+	Television,
+	TelevisionAmbientLight,
+	TelevisionBox,
+	TelevisionClassic,
+	TelevisionClassicOff,
+	TelevisionGuide,
+	TelevisionOff,
+	TelevisionPause,
+	TelevisionPlay,
+	TelevisionShimmer,
+	TelevisionStop,
+	TemperatureCelsius,
+	TemperatureFahrenheit,
+	TemperatureKelvin,
+	Tennis,
+	TennisBall,
+	Tent,
+	Terraform,
+	TestTube,
+	TestTubeEmpty,
+	TestTubeOff,
+	Text,
+	TextAccount,
+	TextBox,
+	TextBoxCheck,
+	TextBoxCheckOutline,
+	TextBoxEdit,
+	TextBoxEditOutline,
+	TextBoxMinus,
+	TextBoxMinusOutline,
+	TextBoxMultiple,
+	TextBoxMultipleOutline,
+	TextBoxOutline,
+	TextBoxPlus,
+	TextBoxPlusOutline,
+	TextBoxRemove,
+	TextBoxRemoveOutline,
+	TextBoxSearch,
+	TextBoxSearchOutline,
+	TextLong,
+	TextRecognition,
+	TextSearch,
+	TextSearchVariant,
+	TextShadow,
+	TextShort,
+	TextToSpeech,
+	TextToSpeechOff,
+	Texture,
+	TextureBox,
+	Theater,
+	ThemeLightDark,
+	Thermometer,
+	ThermometerAlert,
+	ThermometerBluetooth,
+	ThermometerCheck,
+	ThermometerChevronDown,
+	ThermometerChevronUp,
+	ThermometerHigh,
+	ThermometerLines,
+	ThermometerLow,
+	ThermometerMinus,
+	ThermometerOff,
+	ThermometerPlus,
+	ThermometerWater,
+	Thermostat,
+	ThermostatBox,
+	ThoughtBubble,
+	ThoughtBubbleOutline,
+	ThumbDown,
+	ThumbDownOutline,
+	ThumbUp,
+	ThumbUpOutline,
+	ThumbsUpDown,
+	ThumbsUpDownOutline,
+	Ticket,
+	TicketAccount,
+	TicketConfirmation,
+	TicketConfirmationOutline,
+	TicketOutline,
+	TicketPercent,
+	TicketPercentOutline,
+	Tie,
+	Tilde,
+	TildeOff,
+	Timelapse,
+	Timeline,
+	TimelineAlert,
+	TimelineAlertOutline,
+	TimelineCheck,
+	TimelineCheckOutline,
+	TimelineClock,
+	TimelineClockOutline,
+	TimelineHelp,
+	TimelineHelpOutline,
+	TimelineMinus,
+	TimelineMinusOutline,
+	TimelineOutline,
+	TimelinePlus,
+	TimelinePlusOutline,
+	TimelineRemove,
+	TimelineRemoveOutline,
+	TimelineText,
+	TimelineTextOutline,
+	Timer,
+	Timer10,
+	Timer3,
+	TimerAlert,
+	TimerAlertOutline,
+	TimerCancel,
+	TimerCancelOutline,
+	TimerCheck,
+	TimerCheckOutline,
+	TimerCog,
+	TimerCogOutline,
+	TimerEdit,
+	TimerEditOutline,
+	TimerLock,
+	TimerLockOpen,
+	TimerLockOpenOutline,
+	TimerLockOutline,
+	TimerMarker,
+	TimerMarkerOutline,
+	TimerMinus,
+	TimerMinusOutline,
+	TimerMusic,
+	TimerMusicOutline,
+	TimerOff,
+	TimerOffOutline,
+	TimerOutline,
+	TimerPause,
+	TimerPauseOutline,
+	TimerPlay,
+	TimerPlayOutline,
+	TimerPlus,
+	TimerPlusOutline,
+	TimerRefresh,
+	TimerRefreshOutline,
+	TimerRemove,
+	TimerRemoveOutline,
+	TimerSand,
+	TimerSandComplete,
+	TimerSandEmpty,
+	TimerSandFull,
+	TimerSandPaused,
+	TimerSettings,
+	TimerSettingsOutline,
+	TimerStar,
+	TimerStarOutline,
+	TimerStop,
+	TimerStopOutline,
+	TimerSync,
+	TimerSyncOutline,
+	Timetable,
+	Tire,
+	Toaster,
+	ToasterOff,
+	ToasterOven,
+	ToggleSwitch,
+	ToggleSwitchOff,
+	ToggleSwitchOffOutline,
+	ToggleSwitchOutline,
+	ToggleSwitchVariant,
+	ToggleSwitchVariantOff,
+	Toilet,
+	Toolbox,
+	ToolboxOutline,
+	Tools,
+	Tooltip,
+	TooltipAccount,
+	TooltipCellphone,
+	TooltipCheck,
+	TooltipCheckOutline,
+	TooltipEdit,
+	TooltipEditOutline,
+	TooltipImage,
+	TooltipImageOutline,
+	TooltipMinus,
+	TooltipMinusOutline,
+	TooltipOutline,
+	TooltipPlus,
+	TooltipPlusOutline,
+	TooltipRemove,
+	TooltipRemoveOutline,
+	TooltipText,
+	TooltipTextOutline,
+	Tooth,
+	ToothOutline,
+	Toothbrush,
+	ToothbrushElectric,
+	ToothbrushPaste,
+	Torch,
+	Tortoise,
+	Toslink,
+	Tournament,
+	TowTruck,
+	TowerBeach,
+	TowerFire,
+	TownHall,
+	ToyBrick,
+	ToyBrickMarker,
+	ToyBrickMarkerOutline,
+	ToyBrickMinus,
+	ToyBrickMinusOutline,
+	ToyBrickOutline,
+	ToyBrickPlus,
+	ToyBrickPlusOutline,
+	ToyBrickRemove,
+	ToyBrickRemoveOutline,
+	ToyBrickSearch,
+	ToyBrickSearchOutline,
+	TrackLight,
+	Trackpad,
+	TrackpadLock,
+	Tractor,
+	TractorVariant,
+	Trademark,
+	TrafficCone,
+	TrafficLight,
+	TrafficLightOutline,
+	Train,
+	TrainCar,
+	TrainCarPassenger,
+	TrainCarPassengerDoor,
+	TrainCarPassengerDoorOpen,
+	TrainCarPassengerVariant,
+	TrainVariant,
+	Tram,
+	TramSide,
+	Transcribe,
+	TranscribeClose,
+	Transfer,
+	TransferDown,
+	TransferLeft,
+	TransferRight,
+	TransferUp,
+	TransitConnection,
+	TransitConnectionHorizontal,
+	TransitConnectionVariant,
+	TransitDetour,
+	TransitSkip,
+	TransitTransfer,
+	Transition,
+	TransitionMasked,
+	Translate,
+	TranslateOff,
+	TransmissionTower,
+	TransmissionTowerExport,
+	TransmissionTowerImport,
+	TransmissionTowerOff,
+	TrashCan,
+	TrashCanOutline,
+	Tray,
+	TrayAlert,
+	TrayArrowDown,
+	TrayArrowUp,
+	TrayFull,
+	TrayMinus,
+	TrayPlus,
+	TrayRemove,
+	TreasureChest,
+	Tree,
+	TreeOutline,
+	Trello,
+	TrendingDown,
+	TrendingNeutral,
+	TrendingUp,
+	Triangle,
+	TriangleOutline,
+	TriangleSmallDown,
+	TriangleSmallUp,
+	TriangleWave,
+	Triforce,
+	Trophy,
+	TrophyAward,
+	TrophyBroken,
+	TrophyOutline,
+	TrophyVariant,
+	TrophyVariantOutline,
+	Truck,
+	TruckAlert,
+	TruckAlertOutline,
+	TruckCargoContainer,
+	TruckCheck,
+	TruckCheckOutline,
+	TruckDelivery,
+	TruckDeliveryOutline,
+	TruckFast,
+	TruckFastOutline,
+	TruckFlatbed,
+	TruckMinus,
+	TruckMinusOutline,
+	TruckOutline,
+	TruckPlus,
+	TruckPlusOutline,
+	TruckRemove,
+	TruckRemoveOutline,
+	TruckSnowflake,
+	TruckTrailer,
+	Trumpet,
+	TshirtCrew,
+	TshirtCrewOutline,
+	TshirtV,
+	TshirtVOutline,
+	Tsunami,
+	TumbleDryer,
+	TumbleDryerAlert,
+	TumbleDryerOff,
+	Tune,
+	TuneVariant,
+	TuneVertical,
+	TuneVerticalVariant,
+	Tunnel,
+	TunnelOutline,
+	Turbine,
+	Turkey,
+	Turnstile,
+	TurnstileOutline,
+	Turtle,
+	Twitch,
+	Twitter,
+	TwoFactorAuthentication,
+	Typewriter,
+	Ubisoft,
+	Ubuntu,
+	Ufo,
+	UfoOutline,
+	UltraHighDefinition,
+	Umbraco,
+	Umbrella,
+	UmbrellaBeach,
+	UmbrellaBeachOutline,
+	UmbrellaClosed,
+	UmbrellaClosedOutline,
+	UmbrellaClosedVariant,
+	UmbrellaOutline,
+	Undo,
+	UndoVariant,
+	UnfoldLessHorizontal,
+	UnfoldLessVertical,
+	UnfoldMoreHorizontal,
+	UnfoldMoreVertical,
+	Ungroup,
+	Unicode,
+	Unicorn,
+	UnicornVariant,
+	Unicycle,
+	Unity,
+	Unreal,
+	Update,
+	Upload,
+	UploadLock,
+	UploadLockOutline,
+	UploadMultiple,
+	UploadNetwork,
+	UploadNetworkOutline,
+	UploadOff,
+	UploadOffOutline,
+	UploadOutline,
+	Usb,
+	UsbFlashDrive,
+	UsbFlashDriveOutline,
+	UsbPort,
+	Vacuum,
+	VacuumOutline,
+	Valve,
+	ValveClosed,
+	ValveOpen,
+	VanPassenger,
+	VanUtility,
+	Vanish,
+	VanishQuarter,
+	VanityLight,
+	Variable,
+	VariableBox,
+	VectorArrangeAbove,
+	VectorArrangeBelow,
+	VectorBezier,
+	VectorCircle,
+	VectorCircleVariant,
+	VectorCombine,
+	VectorCurve,
+	VectorDifference,
+	VectorDifferenceAb,
+	VectorDifferenceBa,
+	VectorEllipse,
+	VectorIntersection,
+	VectorLine,
+	VectorLink,
+	VectorPoint,
+	VectorPolygon,
+	VectorPolygonVariant,
+	VectorPolyline,
+	VectorPolylineEdit,
+	VectorPolylineMinus,
+	VectorPolylinePlus,
+	VectorPolylineRemove,
+	VectorRadius,
+	VectorRectangle,
+	VectorSelection,
+	VectorSquare,
+	VectorSquareClose,
+	VectorSquareEdit,
+	VectorSquareMinus,
+	VectorSquareOpen,
+	VectorSquarePlus,
+	VectorSquareRemove,
+	VectorTriangle,
+	VectorUnion,
+	Vhs,
+	Vibrate,
+	VibrateOff,
+	Video,
+	Video2D,
+	Video3D,
+	Video3DOff,
+	Video3DVariant,
+	Video4KBox,
+	VideoAccount,
+	VideoBox,
+	VideoBoxOff,
+	VideoCheck,
+	VideoCheckOutline,
+	VideoHighDefinition,
+	VideoImage,
+	VideoInputAntenna,
+	VideoInputComponent,
+	VideoInputHdmi,
+	VideoInputScart,
+	VideoInputSvideo,
+	VideoMarker,
+	VideoMarkerOutline,
+	VideoMinus,
+	VideoMinusOutline,
+	VideoOff,
+	VideoOffOutline,
+	VideoOutline,
+	VideoPlus,
+	VideoPlusOutline,
+	VideoStabilization,
+	VideoSwitch,
+	VideoSwitchOutline,
+	VideoVintage,
+	VideoWireless,
+	VideoWirelessOutline,
+	ViewAgenda,
+	ViewAgendaOutline,
+	ViewArray,
+	ViewArrayOutline,
+	ViewCarousel,
+	ViewCarouselOutline,
+	ViewColumn,
+	ViewColumnOutline,
+	ViewComfy,
+	ViewComfyOutline,
+	ViewCompact,
+	ViewCompactOutline,
+	ViewDashboard,
+	ViewDashboardEdit,
+	ViewDashboardEditOutline,
+	ViewDashboardOutline,
+	ViewDashboardVariant,
+	ViewDashboardVariantOutline,
+	ViewDay,
+	ViewDayOutline,
+	ViewGallery,
+	ViewGalleryOutline,
+	ViewGrid,
+	ViewGridOutline,
+	ViewGridPlus,
+	ViewGridPlusOutline,
+	ViewHeadline,
+	ViewList,
+	ViewListOutline,
+	ViewModule,
+	ViewModuleOutline,
+	ViewParallel,
+	ViewParallelOutline,
+	ViewQuilt,
+	ViewQuiltOutline,
+	ViewSequential,
+	ViewSequentialOutline,
+	ViewSplitHorizontal,
+	ViewSplitVertical,
+	ViewStream,
+	ViewStreamOutline,
+	ViewWeek,
+	ViewWeekOutline,
+	Vimeo,
+	Violin,
+	VirtualReality,
+	Virus,
+	VirusOff,
+	VirusOffOutline,
+	VirusOutline,
+	Vlc,
+	Voicemail,
+	Volcano,
+	VolcanoOutline,
+	Volleyball,
+	VolumeHigh,
+	VolumeLow,
+	VolumeMedium,
+	VolumeMinus,
+	VolumeMute,
+	VolumeOff,
+	VolumePlus,
+	VolumeSource,
+	VolumeVariantOff,
+	VolumeVibrate,
+	Vote,
+	VoteOutline,
+	Vpn,
+	Vuejs,
+	Vuetify,
+	Walk,
+	Wall,
+	WallFire,
+	WallSconce,
+	WallSconceFlat,
+	WallSconceFlatOutline,
+	WallSconceFlatVariant,
+	WallSconceFlatVariantOutline,
+	WallSconceOutline,
+	WallSconceRound,
+	WallSconceRoundOutline,
+	WallSconceRoundVariant,
+	WallSconceRoundVariantOutline,
+	Wallet,
+	WalletGiftcard,
+	WalletMembership,
+	WalletOutline,
+	WalletPlus,
+	WalletPlusOutline,
+	WalletTravel,
+	Wallpaper,
+	Wan,
+	Wardrobe,
+	WardrobeOutline,
+	Warehouse,
+	WashingMachine,
+	WashingMachineAlert,
+	WashingMachineOff,
+	Watch,
+	WatchExport,
+	WatchExportVariant,
+	WatchImport,
+	WatchImportVariant,
+	WatchVariant,
+	WatchVibrate,
+	WatchVibrateOff,
+	Water,
+	WaterAlert,
+	WaterAlertOutline,
+	WaterBoiler,
+	WaterBoilerAlert,
+	WaterBoilerOff,
+	WaterCheck,
+	WaterCheckOutline,
+	WaterCircle,
+	WaterMinus,
+	WaterMinusOutline,
+	WaterOff,
+	WaterOffOutline,
+	WaterOpacity,
+	WaterOutline,
+	WaterPercent,
+	WaterPercentAlert,
+	WaterPlus,
+	WaterPlusOutline,
+	WaterPolo,
+	WaterPump,
+	WaterPumpOff,
+	WaterRemove,
+	WaterRemoveOutline,
+	WaterSync,
+	WaterThermometer,
+	WaterThermometerOutline,
+	WaterWell,
+	WaterWellOutline,
+	Waterfall,
+	WateringCan,
+	WateringCanOutline,
+	Watermark,
+	Wave,
+	Waveform,
+	Waves,
+	WavesArrowLeft,
+	WavesArrowRight,
+	WavesArrowUp,
+	Waze,
+	WeatherCloudy,
+	WeatherCloudyAlert,
+	WeatherCloudyArrowRight,
+	WeatherCloudyClock,
+	WeatherFog,
+	WeatherHail,
+	WeatherHazy,
+	WeatherHurricane,
+	WeatherLightning,
+	WeatherLightningRainy,
+	WeatherNight,
+	WeatherNightPartlyCloudy,
+	WeatherPartlyCloudy,
+	WeatherPartlyLightning,
+	WeatherPartlyRainy,
+	WeatherPartlySnowy,
+	WeatherPartlySnowyRainy,
+	WeatherPouring,
+	WeatherRainy,
+	WeatherSnowy,
+	WeatherSnowyHeavy,
+	WeatherSnowyRainy,
+	WeatherSunny,
+	WeatherSunnyAlert,
+	WeatherSunnyOff,
+	WeatherSunset,
+	WeatherSunsetDown,
+	WeatherSunsetUp,
+	WeatherTornado,
+	WeatherWindy,
+	WeatherWindyVariant,
+	Web,
+	WebBox,
+	WebCancel,
+	WebCheck,
+	WebClock,
+	WebMinus,
+	WebOff,
+	WebPlus,
+	WebRefresh,
+	WebRemove,
+	WebSync,
+	Webcam,
+	WebcamOff,
+	Webhook,
+	Webpack,
+	Webrtc,
+	Wechat,
+	Weight,
+	WeightGram,
+	WeightKilogram,
+	WeightLifter,
+	WeightPound,
+	Whatsapp,
+	WheelBarrow,
+	Wheelchair,
+	WheelchairAccessibility,
+	Whistle,
+	WhistleOutline,
+	WhiteBalanceAuto,
+	WhiteBalanceIncandescent,
+	WhiteBalanceIridescent,
+	WhiteBalanceSunny,
+	Widgets,
+	WidgetsOutline,
+	Wifi,
+	WifiAlert,
+	WifiArrowDown,
+	WifiArrowLeft,
+	WifiArrowLeftRight,
+	WifiArrowRight,
+	WifiArrowUp,
+	WifiArrowUpDown,
+	WifiCancel,
+	WifiCheck,
+	WifiCog,
+	WifiLock,
+	WifiLockOpen,
+	WifiMarker,
+	WifiMinus,
+	WifiOff,
+	WifiPlus,
+	WifiRefresh,
+	WifiRemove,
+	WifiSettings,
+	WifiStar,
+	WifiStrength1,
+	WifiStrength1Alert,
+	WifiStrength1Lock,
+	WifiStrength1LockOpen,
+	WifiStrength2,
+	WifiStrength2Alert,
+	WifiStrength2Lock,
+	WifiStrength2LockOpen,
+	WifiStrength3,
+	WifiStrength3Alert,
+	WifiStrength3Lock,
+	WifiStrength3LockOpen,
+	WifiStrength4,
+	WifiStrength4Alert,
+	WifiStrength4Lock,
+	WifiStrength4LockOpen,
+	WifiStrengthAlertOutline,
+	WifiStrengthLockOpenOutline,
+	WifiStrengthLockOutline,
+	WifiStrengthOff,
+	WifiStrengthOffOutline,
+	WifiStrengthOutline,
+	WifiSync,
+	Wikipedia,
+	WindPower,
+	WindPowerOutline,
+	WindTurbine,
+	WindTurbineAlert,
+	WindTurbineCheck,
+	WindowClose,
+	WindowClosed,
+	WindowClosedVariant,
+	WindowMaximize,
+	WindowMinimize,
+	WindowOpen,
+	WindowOpenVariant,
+	WindowRestore,
+	WindowShutter,
+	WindowShutterAlert,
+	WindowShutterCog,
+	WindowShutterOpen,
+	WindowShutterSettings,
+	Windsock,
+	Wiper,
+	WiperWash,
+	WiperWashAlert,
+	WizardHat,
+	Wordpress,
+	Wrap,
+	WrapDisabled,
+	Wrench,
+	WrenchClock,
+	WrenchOutline,
+	Xamarin,
+	Xml,
+	Xmpp,
+	Yahoo,
+	Yeast,
+	YinYang,
+	Yoga,
+	Youtube,
+	YoutubeGaming,
+	YoutubeStudio,
+	YoutubeSubscription,
+	YoutubeTv,
+	Yurt,
+	ZWave,
+	Zend,
+	Zigbee,
+	ZipBox,
+	ZipBoxOutline,
+	ZipDisk,
+	ZodiacAquarius,
+	ZodiacAries,
+	ZodiacCancer,
+	ZodiacCapricorn,
+	ZodiacGemini,
+	ZodiacLeo,
+	ZodiacLibra,
+	ZodiacPisces,
+	ZodiacSagittarius,
+	ZodiacScorpio,
+	ZodiacTaurus,
+	ZodiacVirgo,
+}
 
 // AllGlyphs returns an iterator over all the glyphs in the md class,
-// returned in no particular order.
+// sorted by ID.
 func AllGlyphs() iter.Seq[nf.Glyph] {
-	return maps.Values(allGlyphs)
+	return slices.Values(glyphValues[:])
 }
 
 // ByID finds a glyph by its short or full ID within the class, or an empty string
-// if the glyph is not found.
+// if the glyph is not found. Lookups do not allocate.
 func ByID(id string) nf.Glyph {
-	if glyph, ok := allGlyphs[id]; ok {
+	if glyph, ok := lookup(id); ok {
 		return glyph
 	}
 	if _, stripped, ok := strings.Cut(id, string(Class)+"-"); ok {
-		if glyph, gok := allGlyphs[stripped]; gok {
+		if glyph, gok := lookup(stripped); gok {
 			return glyph
 		}
 	}
 	return ""
 }
 
+// lookup binary searches for a glyph by its short ID.
+func lookup(id string) (nf.Glyph, bool) {
+	if i, ok := slices.BinarySearch(glyphIDs[:], id); ok {
+		return glyphValues[i], true
+	}
+	return "", false
+}
+
 // AllGlyphIDs returns an iterator over all the IDs of the glyphs in the class,
-// returned in no particular order.
+// sorted.
 func AllGlyphIDs() iter.Seq[string] {
-	return maps.Keys(allGlyphs)
+	return slices.Values(glyphIDs[:])
 }
 
 // AllGlyphFullIDs returns an iterator over all the full IDs of the glyphs in
-// the class, returned in no particular order.
+// the class, sorted.
 func AllGlyphFullIDs() iter.Seq[string] {
 	return func(yield func(string) bool) {
-		for id := range allGlyphs {
+		for _, id := range glyphIDs {
 			if !yield(string(Class) + "-" + id) {
 				return
 			}
 		}
 	}
 }
+
+// glyphSet implements [nf.GlyphSet] for the class.
+type glyphSet struct{}
+
+// Lookup returns the glyph with the given short ID, and whether it was found.
+func (glyphSet) Lookup(id string) (nf.Glyph, bool) {
+	return lookup(id)
+}
+
+// All returns an iterator over all short IDs and their glyphs, sorted by ID.
+func (glyphSet) All() iter.Seq2[string, nf.Glyph] {
+	return func(yield func(string, nf.Glyph) bool) {
+		for i, id := range glyphIDs {
+			if !yield(id, glyphValues[i]) {
+				return
+			}
+		}
+	}
+}
+
+// The class is registered with [nf.RegisterClass] on import.
+var _ = nf.MustRegisterClass(Class, glyphSet{})
@@ -25,64 +25,96 @@ import (
 var (
 	desktopEnvironments = map[string]Result{
 		"budgie": &neoGlyph{
+			category:       CategoryDesktopEnvironment,
+			key:            "budgie",
 			name:           "Budgie",
 			glyph:          linux.Budgie,
+			glyphClass:     linux.Class,
+			glyphID:        "budgie",
 			darkColor:      &color.RGBA{R: 78, G: 83, B: 97, A: 255},
 			darkColorANSI:  240,
 			lightColor:     &color.RGBA{R: 78, G: 83, B: 97, A: 255},
 			lightColorANSI: 240,
 		},
 		"cinnamon": &neoGlyph{
+			category:       CategoryDesktopEnvironment,
+			key:            "cinnamon",
 			name:           "Cinnamon",
 			glyph:          linux.Cinnamon,
+			glyphClass:     linux.Class,
+			glyphID:        "cinnamon",
 			darkColor:      &color.RGBA{R: 220, G: 104, B: 46, A: 255},
 			darkColorANSI:  166,
 			lightColor:     &color.RGBA{R: 147, G: 69, B: 31, A: 255},
 			lightColorANSI: 124,
 		},
 		"gnome": &neoGlyph{
+			category:       CategoryDesktopEnvironment,
+			key:            "gnome",
 			name:           "GNOME",
 			glyph:          linux.Gnome,
+			glyphClass:     linux.Class,
+			glyphID:        "gnome",
 			darkColor:      &color.RGBA{R: 255, G: 255, B: 255, A: 255},
 			darkColorANSI:  231,
 			lightColor:     &color.RGBA{R: 51, G: 51, B: 51, A: 255},
 			lightColorANSI: 236,
 		},
 		"lxde": &neoGlyph{
+			category:       CategoryDesktopEnvironment,
+			key:            "lxde",
 			name:           "LXDE",
 			glyph:          linux.Lxde,
+			glyphClass:     linux.Class,
+			glyphID:        "lxde",
 			darkColor:      &color.RGBA{R: 163, G: 163, B: 163, A: 255},
 			darkColorANSI:  248,
 			lightColor:     &color.RGBA{R: 81, G: 81, B: 81, A: 255},
 			lightColorANSI: 239,
 		},
 		"lxqt": &neoGlyph{
+			category:       CategoryDesktopEnvironment,
+			key:            "lxqt",
 			name:           "LXQt",
 			glyph:          linux.Lxqt,
+			glyphClass:     linux.Class,
+			glyphID:        "lxqt",
 			darkColor:      &color.RGBA{R: 1, G: 145, B: 210, A: 255},
 			darkColorANSI:  32,
 			lightColor:     &color.RGBA{R: 1, G: 109, B: 158, A: 255},
 			lightColorANSI: 24,
 		},
 		"mate": &neoGlyph{
+			category:       CategoryDesktopEnvironment,
+			key:            "mate",
 			name:           "MATE",
 			glyph:          linux.Mate,
+			glyphClass:     linux.Class,
+			glyphID:        "mate",
 			darkColor:      &color.RGBA{R: 155, G: 218, B: 92, A: 255},
 			darkColorANSI:  113,
 			lightColor:     &color.RGBA{R: 78, G: 109, B: 46, A: 255},
 			lightColorANSI: 22,
 		},
 		"plasma": &neoGlyph{
+			category:       CategoryDesktopEnvironment,
+			key:            "plasma",
 			name:           "KDEPlasma",
 			glyph:          linux.KdePlasma,
+			glyphClass:     linux.Class,
+			glyphID:        "kde_plasma",
 			darkColor:      &color.RGBA{R: 27, G: 137, B: 243, A: 255},
 			darkColorANSI:  33,
 			lightColor:     &color.RGBA{R: 20, G: 103, B: 183, A: 255},
 			lightColorANSI: 25,
 		},
 		"xfce": &neoGlyph{
+			category:       CategoryDesktopEnvironment,
+			key:            "xfce",
 			name:           "Xfce",
 			glyph:          linux.Xfce,
+			glyphClass:     linux.Class,
+			glyphID:        "xfce",
 			darkColor:      &color.RGBA{R: 0, G: 170, B: 223, A: 255},
 			darkColorANSI:  74,
 			lightColor:     &color.RGBA{R: 0, G: 128, B: 167, A: 255},
@@ -91,3896 +123,5844 @@ var (
 	}
 	fileExtensions = map[string]Result{
 		"3gp": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "3gp",
 			name:           "3gp",
 			glyph:          seti.Video,
+			glyphClass:     seti.Class,
+			glyphID:        "video",
 			darkColor:      &color.RGBA{R: 253, G: 151, B: 31, A: 255},
 			darkColorANSI:  208,
 			lightColor:     &color.RGBA{R: 126, G: 76, B: 16, A: 255},
 			lightColorANSI: 94,
 		},
 		"3mf": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "3mf",
 			name:           "3DObjectFile",
 			glyph:          md.CubeOutline,
+			glyphClass:     md.Class,
+			glyphID:        "cube_outline",
 			darkColor:      &color.RGBA{R: 136, G: 136, B: 136, A: 255},
 			darkColorANSI:  102,
 			lightColor:     &color.RGBA{R: 91, G: 91, B: 91, A: 255},
 			lightColorANSI: 240,
 		},
 		"7z": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "7z",
 			name:           "7z",
 			glyph:          oct.FileZip,
+			glyphClass:     oct.Class,
+			glyphID:        "file_zip",
 			darkColor:      &color.RGBA{R: 236, G: 165, B: 23, A: 255},
 			darkColorANSI:  214,
 			lightColor:     &color.RGBA{R: 118, G: 81, B: 12, A: 255},
 			lightColorANSI: 94,
 		},
 		"Dockerfile": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "Dockerfile",
 			name:           "Dockerfile",
 			glyph:          md.Docker,
+			glyphClass:     md.Class,
+			glyphID:        "docker",
 			darkColor:      &color.RGBA{R: 69, G: 142, B: 230, A: 255},
 			darkColorANSI:  68,
 			lightColor:     &color.RGBA{R: 46, G: 95, B: 153, A: 255},
 			lightColorANSI: 25,
 		},
 		"R": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "R",
 			name:           "R",
 			glyph:          md.LanguageR,
+			glyphClass:     md.Class,
+			glyphID:        "language_r",
 			darkColor:      &color.RGBA{R: 34, G: 102, B: 186, A: 255},
 			darkColorANSI:  25,
 			lightColor:     &color.RGBA{R: 26, G: 76, B: 140, A: 255},
 			lightColorANSI: 25,
 		},
 		"a": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "a",
 			name:           "StaticLibraryArchive",
 			glyph:          cod.Library,
+			glyphClass:     cod.Class,
+			glyphID:        "library",
 			darkColor:      &color.RGBA{R: 220, G: 221, B: 214, A: 255},
 			darkColorANSI:  253,
 			lightColor:     &color.RGBA{R: 73, G: 73, B: 71, A: 255},
 			lightColorANSI: 239,
 		},
 		"aac": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "aac",
 			name:           "AdvancedAudioCoding",
 			glyph:          fa.Music,
+			glyphClass:     fa.Class,
+			glyphID:        "music",
 			darkColor:      &color.RGBA{R: 0, G: 175, B: 255, A: 255},
 			darkColorANSI:  39,
 			lightColor:     &color.RGBA{R: 0, G: 117, B: 170, A: 255},
 			lightColorANSI: 24,
 		},
 		"ada": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "ada",
 			name:           "AdaFile",
 			glyph:          custom.Ada,
+			glyphClass:     custom.Class,
+			glyphID:        "ada",
 			darkColor:      &color.RGBA{R: 89, G: 158, B: 255, A: 255},
 			darkColorANSI:  111,
 			lightColor:     &color.RGBA{R: 59, G: 105, B: 170, A: 255},
 			lightColorANSI: 25,
 		},
 		"adb": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "adb",
 			name:           "AdaBody",
 			glyph:          custom.Ada,
+			glyphClass:     custom.Class,
+			glyphID:        "ada",
 			darkColor:      &color.RGBA{R: 89, G: 158, B: 255, A: 255},
 			darkColorANSI:  111,
 			lightColor:     &color.RGBA{R: 59, G: 105, B: 170, A: 255},
 			lightColorANSI: 25,
 		},
 		"ads": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "ads",
 			name:           "AdaSpecification",
 			glyph:          custom.Ada,
+			glyphClass:     custom.Class,
+			glyphID:        "ada",
 			darkColor:      &color.RGBA{R: 160, G: 116, B: 195, A: 255},
 			darkColorANSI:  140,
 			lightColor:     &color.RGBA{R: 107, G: 77, B: 131, A: 255},
 			lightColorANSI: 96,
 		},
 		"ai": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "ai",
 			name:           "Ai",
 			glyph:          dev.Illustrator,
+			glyphClass:     dev.Class,
+			glyphID:        "illustrator",
 			darkColor:      &color.RGBA{R: 203, G: 203, B: 65, A: 255},
 			darkColorANSI:  185,
 			lightColor:     &color.RGBA{R: 102, G: 102, B: 32, A: 255},
 			lightColorANSI: 58,
 		},
 		"aif": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "aif",
 			name:           "AudioInterchangeFileFormat",
 			glyph:          fa.Music,
+			glyphClass:     fa.Class,
+			glyphID:        "music",
 			darkColor:      &color.RGBA{R: 0, G: 175, B: 255, A: 255},
 			darkColorANSI:  39,
 			lightColor:     &color.RGBA{R: 0, G: 117, B: 170, A: 255},
 			lightColorANSI: 24,
 		},
 		"aiff": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "aiff",
 			name:           "AudioInterchangeFileFormat",
 			glyph:          fa.Music,
+			glyphClass:     fa.Class,
+			glyphID:        "music",
 			darkColor:      &color.RGBA{R: 0, G: 175, B: 255, A: 255},
 			darkColorANSI:  39,
 			lightColor:     &color.RGBA{R: 0, G: 117, B: 170, A: 255},
 			lightColorANSI: 24,
 		},
 		"android": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "android",
 			name:           "Android",
 			glyph:          dev.Android,
+			glyphClass:     dev.Class,
+			glyphID:        "android",
 			darkColor:      &color.RGBA{R: 52, G: 168, B: 83, A: 255},
 			darkColorANSI:  35,
 			lightColor:     &color.RGBA{R: 39, G: 126, B: 62, A: 255},
 			lightColorANSI: 29,
 		},
 		"ape": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "ape",
 			name:           "MonkeysAudio",
 			glyph:          fa.Music,
+			glyphClass:     fa.Class,
+			glyphID:        "music",
 			darkColor:      &color.RGBA{R: 0, G: 175, B: 255, A: 255},
 			darkColorANSI:  39,
 			lightColor:     &color.RGBA{R: 0, G: 117, B: 170, A: 255},
 			lightColorANSI: 24,
 		},
 		"apk": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "apk",
 			name:           "apk",
 			glyph:          dev.Android,
+			glyphClass:     dev.Class,
+			glyphID:        "android",
 			darkColor:      &color.RGBA{R: 52, G: 168, B: 83, A: 255},
 			darkColorANSI:  35,
 			lightColor:     &color.RGBA{R: 39, G: 126, B: 62, A: 255},
 			lightColorANSI: 29,
 		},
 		"apl": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "apl",
 			name:           "APL",
 			glyph:          dev.Apl,
+			glyphClass:     dev.Class,
+			glyphID:        "apl",
 			darkColor:      &color.RGBA{R: 36, G: 161, B: 72, A: 255},
 			darkColorANSI:  35,
 			lightColor:     &color.RGBA{R: 27, G: 121, B: 54, A: 255},
 			lightColorANSI: 28,
 		},
 		"app": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "app",
 			name:           "App",
 			glyph:          cod.FileBinary,
+			glyphClass:     cod.Class,
+			glyphID:        "file_binary",
 			darkColor:      &color.RGBA{R: 159, G: 5, B: 0, A: 255},
 			darkColorANSI:  124,
 			lightColor:     &color.RGBA{R: 159, G: 5, B: 0, A: 255},
 			lightColorANSI: 124,
 		},
 		"applescript": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "applescript",
 			name:           "AppleScript",
 			glyph:          fa.Apple,
+			glyphClass:     fa.Class,
+			glyphID:        "apple",
 			darkColor:      &color.RGBA{R: 109, G: 128, B: 133, A: 255},
 			darkColorANSI:  66,
 			lightColor:     &color.RGBA{R: 81, G: 96, B: 100, A: 255},
 			lightColorANSI: 59,
 		},
 		"asc": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "asc",
 			name:           "Asc",
 			glyph:          md.ShieldLock,
+			glyphClass:     md.Class,
+			glyphID:        "shield_lock",
 			darkColor:      &color.RGBA{R: 87, G: 109, B: 127, A: 255},
 			darkColorANSI:  242,
 			lightColor:     &color.RGBA{R: 65, G: 81, B: 95, A: 255},
 			lightColorANSI: 239,
 		},
 		"asm": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "asm",
 			name:           "ASM",
 			glyph:          seti.Asm,
+			glyphClass:     seti.Class,
+			glyphID:        "asm",
 			darkColor:      &color.RGBA{R: 0, G: 145, B: 189, A: 255},
 			darkColorANSI:  31,
 			lightColor:     &color.RGBA{R: 0, G: 109, B: 142, A: 255},
 			lightColorANSI: 24,
 		},
 		"ass": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "ass",
 			name:           "Ass",
 			glyph:          md.Subtitles,
+			glyphClass:     md.Class,
+			glyphID:        "subtitles",
 			darkColor:      &color.RGBA{R: 255, G: 183, B: 19, A: 255},
 			darkColorANSI:  214,
 			lightColor:     &color.RGBA{R: 128, G: 92, B: 10, A: 255},
 			lightColorANSI: 94,
 		},
 		"astro": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "astro",
 			name:           "Astro",
 			glyph:          custom.Astro,
+			glyphClass:     custom.Class,
+			glyphID:        "astro",
 			darkColor:      &color.RGBA{R: 226, G: 63, B: 103, A: 255},
 			darkColorANSI:  197,
 			lightColor:     &color.RGBA{R: 170, G: 47, B: 77, A: 255},
 			lightColorANSI: 125,
 		},
 		"avif": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "avif",
 			name:           "Avif",
 			glyph:          seti.Image,
+			glyphClass:     seti.Class,
+			glyphID:        "image",
 			darkColor:      &color.RGBA{R: 160, G: 116, B: 195, A: 255},
 			darkColorANSI:  140,
 			lightColor:     &color.RGBA{R: 107, G: 77, B: 131, A: 255},
 			lightColorANSI: 96,
 		},
 		"awk": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "awk",
 			name:           "Awk",
 			glyph:          dev.Terminal,
+			glyphClass:     dev.Class,
+			glyphID:        "terminal",
 			darkColor:      &color.RGBA{R: 77, G: 89, B: 94, A: 255},
 			darkColorANSI:  240,
 			lightColor:     &color.RGBA{R: 58, G: 68, B: 70, A: 255},
 			lightColorANSI: 238,
 		},
 		"azcli": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "azcli",
 			name:           "AzureCli",
 			glyph:          cod.AzureDevops,
+			glyphClass:     cod.Class,
+			glyphID:        "azure_devops",
 			darkColor:      &color.RGBA{R: 0, G: 120, B: 211, A: 255},
 			darkColorANSI:  32,
 			lightColor:     &color.RGBA{R: 0, G: 89, B: 159, A: 255},
 			lightColorANSI: 25,
 		},
 		"bak": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "bak",
 			name:           "Backup",
 			glyph:          md.BackupRestore,
+			glyphClass:     md.Class,
+			glyphID:        "backup_restore",
 			darkColor:      &color.RGBA{R: 109, G: 128, B: 134, A: 255},
 			darkColorANSI:  66,
 			lightColor:     &color.RGBA{R: 81, G: 96, B: 100, A: 255},
 			lightColorANSI: 59,
 		},
 		"bash": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "bash",
 			name:           "Bash",
 			glyph:          dev.Bash,
+			glyphClass:     dev.Class,
+			glyphID:        "bash",
 			darkColor:      &color.RGBA{R: 137, G: 224, B: 81, A: 255},
 			darkColorANSI:  113,
 			lightColor:     &color.RGBA{R: 68, G: 112, B: 40, A: 255},
 			lightColorANSI: 22,
 		},
 		"bat": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "bat",
 			name:           "Bat",
 			glyph:          seti.Config,
+			glyphClass:     seti.Class,
+			glyphID:        "config",
 			darkColor:      &color.RGBA{R: 193, G: 241, B: 46, A: 255},
 			darkColorANSI:  191,
 			lightColor:     &color.RGBA{R: 64, G: 80, B: 15, A: 255},
 			lightColorANSI: 58,
 		},
 		"bazel": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "bazel",
 			name:           "Bazel",
 			glyph:          custom.Bazel,
+			glyphClass:     custom.Class,
+			glyphID:        "bazel",
 			darkColor:      &color.RGBA{R: 137, G: 224, B: 81, A: 255},
 			darkColorANSI:  113,
 			lightColor:     &color.RGBA{R: 68, G: 112, B: 40, A: 255},
 			lightColorANSI: 22,
 		},
 		"bib": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "bib",
 			name:           "BibTeX",
 			glyph:          md.Bookshelf,
+			glyphClass:     md.Class,
+			glyphID:        "bookshelf",
 			darkColor:      &color.RGBA{R: 203, G: 203, B: 65, A: 255},
 			darkColorANSI:  185,
 			lightColor:     &color.RGBA{R: 102, G: 102, B: 32, A: 255},
 			lightColorANSI: 58,
 		},
 		"bicep": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "bicep",
 			name:           "Bicep",
 			glyph:          seti.Bicep,
+			glyphClass:     seti.Class,
+			glyphID:        "bicep",
 			darkColor:      &color.RGBA{R: 81, G: 154, B: 186, A: 255},
 			darkColorANSI:  74,
 			lightColor:     &color.RGBA{R: 54, G: 103, B: 124, A: 255},
 			lightColorANSI: 24,
 		},
 		"bicepparam": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "bicepparam",
 			name:           "BicepParameters",
 			glyph:          seti.Bicep,
+			glyphClass:     seti.Class,
+			glyphID:        "bicep",
 			darkColor:      &color.RGBA{R: 159, G: 116, B: 179, A: 255},
 			darkColorANSI:  133,
 			lightColor:     &color.RGBA{R: 105, G: 77, B: 119, A: 255},
 			lightColorANSI: 96,
 		},
 		"bin": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "bin",
 			name:           "Bin",
 			glyph:          cod.FileBinary,
+			glyphClass:     cod.Class,
+			glyphID:        "file_binary",
 			darkColor:      &color.RGBA{R: 159, G: 5, B: 0, A: 255},
 			darkColorANSI:  124,
 			lightColor:     &color.RGBA{R: 159, G: 5, B: 0, A: 255},
 			lightColorANSI: 124,
 		},
 		"blade.php": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "blade.php",
 			name:           "Blade",
 			glyph:          fa.Laravel,
+			glyphClass:     fa.Class,
+			glyphID:        "laravel",
 			darkColor:      &color.RGBA{R: 240, G: 83, B: 64, A: 255},
 			darkColorANSI:  203,
 			lightColor:     &color.RGBA{R: 160, G: 55, B: 43, A: 255},
 			lightColorANSI: 124,
 		},
 		"blend": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "blend",
 			name:           "Blender",
 			glyph:          md.BlenderSoftware,
+			glyphClass:     md.Class,
+			glyphID:        "blender_software",
 			darkColor:      &color.RGBA{R: 234, G: 118, B: 0, A: 255},
 			darkColorANSI:  208,
 			lightColor:     &color.RGBA{R: 156, G: 79, B: 0, A: 255},
 			lightColorANSI: 130,
 		},
 		"blp": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "blp",
 			name:           "Blueprint",
 			glyph:          md.RulerSquareCompass,
+			glyphClass:     md.Class,
+			glyphID:        "ruler_square_compass",
 			darkColor:      &color.RGBA{R: 87, G: 150, B: 226, A: 255},
 			darkColorANSI:  68,
 			lightColor:     &color.RGBA{R: 58, G: 100, B: 151, A: 255},
 			lightColorANSI: 25,
 		},
 		"bmp": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "bmp",
 			name:           "Bmp",
 			glyph:          seti.Image,
+			glyphClass:     seti.Class,
+			glyphID:        "image",
 			darkColor:      &color.RGBA{R: 160, G: 116, B: 195, A: 255},
 			darkColorANSI:  140,
 			lightColor:     &color.RGBA{R: 107, G: 77, B: 131, A: 255},
 			lightColorANSI: 96,
 		},
 		"bqn": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "bqn",
 			name:           "APL",
 			glyph:          dev.Apl,
+			glyphClass:     dev.Class,
+			glyphID:        "apl",
 			darkColor:      &color.RGBA{R: 36, G: 161, B: 72, A: 255},
 			darkColorANSI:  35,
 			lightColor:     &color.RGBA{R: 27, G: 121, B: 54, A: 255},
 			lightColorANSI: 28,
 		},
 		"brep": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "brep",
 			name:           "BoundaryRepresentation",
 			glyph:          md.FileCad,
+			glyphClass:     md.Class,
+			glyphID:        "file_cad",
 			darkColor:      &color.RGBA{R: 131, G: 147, B: 99, A: 255},
 			darkColorANSI:  101,
 			lightColor:     &color.RGBA{R: 87, G: 99, B: 65, A: 255},
 			lightColorANSI: 58,
 		},
 		"bz2": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "bz2",
 			name:           "Bz2",
 			glyph:          oct.FileZip,
+			glyphClass:     oct.Class,
+			glyphID:        "file_zip",
 			darkColor:      &color.RGBA{R: 236, G: 165, B: 23, A: 255},
 			darkColorANSI:  214,
 			lightColor:     &color.RGBA{R: 118, G: 81, B: 12, A: 255},
 			lightColorANSI: 94,
 		},
 		"bz3": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "bz3",
 			name:           "Bz3",
 			glyph:          oct.FileZip,
+			glyphClass:     oct.Class,
+			glyphID:        "file_zip",
 			darkColor:      &color.RGBA{R: 236, G: 165, B: 23, A: 255},
 			darkColorANSI:  214,
 			lightColor:     &color.RGBA{R: 118, G: 81, B: 12, A: 255},
 			lightColorANSI: 94,
 		},
 		"bz": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "bz",
 			name:           "Bz",
 			glyph:          oct.FileZip,
+			glyphClass:     oct.Class,
+			glyphID:        "file_zip",
 			darkColor:      &color.RGBA{R: 236, G: 165, B: 23, A: 255},
 			darkColorANSI:  214,
 			lightColor:     &color.RGBA{R: 118, G: 81, B: 12, A: 255},
 			lightColorANSI: 94,
 		},
 		"bzl": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "bzl",
 			name:           "Bzl",
 			glyph:          custom.Bazel,
+			glyphClass:     custom.Class,
+			glyphID:        "bazel",
 			darkColor:      &color.RGBA{R: 137, G: 224, B: 81, A: 255},
 			darkColorANSI:  113,
 			lightColor:     &color.RGBA{R: 68, G: 112, B: 40, A: 255},
 			lightColorANSI: 22,
 		},
 		"c++": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "c++",
 			name:           "CPlusPlus",
 			glyph:          custom.Cpp,
+			glyphClass:     custom.Class,
+			glyphID:        "cpp",
 			darkColor:      &color.RGBA{R: 243, G: 75, B: 125, A: 255},
 			darkColorANSI:  204,
 			lightColor:     &color.RGBA{R: 162, G: 50, B: 83, A: 255},
 			lightColorANSI: 125,
 		},
 		"c": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "c",
 			name:           "C",
 			glyph:          custom.C,
+			glyphClass:     custom.Class,
+			glyphID:        "c",
 			darkColor:      &color.RGBA{R: 89, G: 158, B: 255, A: 255},
 			darkColorANSI:  111,
 			lightColor:     &color.RGBA{R: 59, G: 105, B: 170, A: 255},
 			lightColorANSI: 25,
 		},
 		"cache": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "cache",
 			name:           "Cache",
 			glyph:          oct.Cache,
+			glyphClass:     oct.Class,
+			glyphID:        "cache",
 			darkColor:      &color.RGBA{R: 255, G: 255, B: 255, A: 255},
 			darkColorANSI:  231,
 			lightColor:     &color.RGBA{R: 51, G: 51, B: 51, A: 255},
 			lightColorANSI: 236,
 		},
 		"cast": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "cast",
 			name:           "Asciinema",
 			glyph:          seti.Video,
+			glyphClass:     seti.Class,
+			glyphID:        "video",
 			darkColor:      &color.RGBA{R: 253, G: 151, B: 31, A: 255},
 			darkColorANSI:  208,
 			lightColor:     &color.RGBA{R: 126, G: 76, B: 16, A: 255},
 			lightColorANSI: 94,
 		},
 		"cbl": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "cbl",
 			name:           "Cobol",
 			glyph:          oct.Gear,
+			glyphClass:     oct.Class,
+			glyphID:        "gear",
 			darkColor:      &color.RGBA{R: 0, G: 92, B: 165, A: 255},
 			darkColorANSI:  25,
 			lightColor:     &color.RGBA{R: 0, G: 92, B: 165, A: 255},
 			lightColorANSI: 25,
 		},
 		"cc": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "cc",
 			name:           "CPlusPlus",
 			glyph:          custom.Cpp,
+			glyphClass:     custom.Class,
+			glyphID:        "cpp",
 			darkColor:      &color.RGBA{R: 243, G: 75, B: 125, A: 255},
 			darkColorANSI:  204,
 			lightColor:     &color.RGBA{R: 162, G: 50, B: 83, A: 255},
 			lightColorANSI: 125,
 		},
 		"ccm": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "ccm",
 			name:           "CPlusPlusModule",
 			glyph:          custom.Cpp,
+			glyphClass:     custom.Class,
+			glyphID:        "cpp",
 			darkColor:      &color.RGBA{R: 243, G: 75, B: 125, A: 255},
 			darkColorANSI:  204,
 			lightColor:     &color.RGBA{R: 162, G: 50, B: 83, A: 255},
 			lightColorANSI: 125,
 		},
 		"cfc": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "cfc",
 			name:           "ColdFusionScript",
 			glyph:          seti.Coldfusion,
+			glyphClass:     seti.Class,
+			glyphID:        "coldfusion",
 			darkColor:      &color.RGBA{R: 1, G: 163, B: 186, A: 255},
 			darkColorANSI:  38,
 			lightColor:     &color.RGBA{R: 1, G: 123, B: 140, A: 255},
 			lightColorANSI: 30,
 		},
 		"cfg": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "cfg",
 			name:           "Configuration",
 			glyph:          seti.Config,
+			glyphClass:     seti.Class,
+			glyphID:        "config",
 			darkColor:      &color.RGBA{R: 109, G: 128, B: 134, A: 255},
 			darkColorANSI:  66,
 			lightColor:     &color.RGBA{R: 81, G: 96, B: 100, A: 255},
 			lightColorANSI: 59,
 		},
 		"cfm": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "cfm",
 			name:           "ColdFusionTag",
 			glyph:          seti.Coldfusion,
+			glyphClass:     seti.Class,
+			glyphID:        "coldfusion",
 			darkColor:      &color.RGBA{R: 1, G: 163, B: 186, A: 255},
 			darkColorANSI:  38,
 			lightColor:     &color.RGBA{R: 1, G: 123, B: 140, A: 255},
 			lightColorANSI: 30,
 		},
 		"cjs": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "cjs",
 			name:           "Cjs",
 			glyph:          seti.Javascript,
+			glyphClass:     seti.Class,
+			glyphID:        "javascript",
 			darkColor:      &color.RGBA{R: 203, G: 203, B: 65, A: 255},
 			darkColorANSI:  185,
 			lightColor:     &color.RGBA{R: 102, G: 102, B: 32, A: 255},
 			lightColorANSI: 58,
 		},
 		"clj": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "clj",
 			name:           "Clojure",
 			glyph:          dev.Clojure,
+			glyphClass:     dev.Class,
+			glyphID:        "clojure",
 			darkColor:      &color.RGBA{R: 141, G: 193, B: 73, A: 255},
 			darkColorANSI:  113,
 			lightColor:     &color.RGBA{R: 70, G: 96, B: 36, A: 255},
 			lightColorANSI: 22,
 		},
 		"cljc": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "cljc",
 			name:           "ClojureC",
 			glyph:          dev.Clojure,
+			glyphClass:     dev.Class,
+			glyphID:        "clojure",
 			darkColor:      &color.RGBA{R: 141, G: 193, B: 73, A: 255},
 			darkColorANSI:  113,
 			lightColor:     &color.RGBA{R: 70, G: 96, B: 36, A: 255},
 			lightColorANSI: 22,
 		},
 		"cljd": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "cljd",
 			name:           "ClojureDart",
 			glyph:          dev.ClojureAlt,
+			glyphClass:     dev.Class,
+			glyphID:        "clojure_alt",
 			darkColor:      &color.RGBA{R: 81, G: 154, B: 186, A: 255},
 			darkColorANSI:  74,
 			lightColor:     &color.RGBA{R: 54, G: 103, B: 124, A: 255},
 			lightColorANSI: 24,
 		},
 		"cljs": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "cljs",
 			name:           "ClojureJS",
 			glyph:          dev.ClojureAlt,
+			glyphClass:     dev.Class,
+			glyphID:        "clojure_alt",
 			darkColor:      &color.RGBA{R: 81, G: 154, B: 186, A: 255},
 			darkColorANSI:  74,
 			lightColor:     &color.RGBA{R: 54, G: 103, B: 124, A: 255},
 			lightColorANSI: 24,
 		},
 		"cmake": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "cmake",
 			name:           "CMake",
 			glyph:          dev.Cmake,
+			glyphClass:     dev.Class,
+			glyphID:        "cmake",
 			darkColor:      &color.RGBA{R: 220, G: 227, B: 235, A: 255},
 			darkColorANSI:  254,
 			lightColor:     &color.RGBA{R: 44, G: 44, B: 47, A: 255},
 			lightColorANSI: 236,
 		},
 		"cob": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "cob",
 			name:           "Cobol",
 			glyph:          oct.Gear,
+			glyphClass:     oct.Class,
+			glyphID:        "gear",
 			darkColor:      &color.RGBA{R: 0, G: 92, B: 165, A: 255},
 			darkColorANSI:  25,
 			lightColor:     &color.RGBA{R: 0, G: 92, B: 165, A: 255},
 			lightColorANSI: 25,
 		},
 		"cobol": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "cobol",
 			name:           "Cobol",
 			glyph:          oct.Gear,
+			glyphClass:     oct.Class,
+			glyphID:        "gear",
 			darkColor:      &color.RGBA{R: 0, G: 92, B: 165, A: 255},
 			darkColorANSI:  25,
 			lightColor:     &color.RGBA{R: 0, G: 92, B: 165, A: 255},
 			lightColorANSI: 25,
 		},
 		"coffee": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "coffee",
 			name:           "Coffee",
 			glyph:          seti.Cjsx,
+			glyphClass:     seti.Class,
+			glyphID:        "cjsx",
 			darkColor:      &color.RGBA{R: 203, G: 203, B: 65, A: 255},
 			darkColorANSI:  185,
 			lightColor:     &color.RGBA{R: 102, G: 102, B: 32, A: 255},
 			lightColorANSI: 58,
 		},
 		"conda": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "conda",
 			name:           "Conda",
 			glyph:          dev.Anaconda,
+			glyphClass:     dev.Class,
+			glyphID:        "anaconda",
 			darkColor:      &color.RGBA{R: 67, G: 176, B: 42, A: 255},
 			darkColorANSI:  34,
 			lightColor:     &color.RGBA{R: 44, G: 117, B: 28, A: 255},
 			lightColorANSI: 28,
 		},
 		"conf": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "conf",
 			name:           "Conf",
 			glyph:          seti.Config,
+			glyphClass:     seti.Class,
+			glyphID:        "config",
 			darkColor:      &color.RGBA{R: 109, G: 128, B: 134, A: 255},
 			darkColorANSI:  66,
 			lightColor:     &color.RGBA{R: 81, G: 96, B: 100, A: 255},
 			lightColorANSI: 59,
 		},
 		"config.ru": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "config.ru",
 			name:           "ConfigRu",
 			glyph:          dev.RubyRough,
+			glyphClass:     dev.Class,
+			glyphID:        "ruby_rough",
 			darkColor:      &color.RGBA{R: 112, G: 21, B: 22, A: 255},
 			darkColorANSI:  52,
 			lightColor:     &color.RGBA{R: 112, G: 21, B: 22, A: 255},
 			lightColorANSI: 52,
 		},
 		"cow": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "cow",
 			name:           "CowsayFile",
 			glyph:          md.Cow,
+			glyphClass:     md.Class,
+			glyphID:        "cow",
 			darkColor:      &color.RGBA{R: 150, G: 88, B: 36, A: 255},
 			darkColorANSI:  130,
 			lightColor:     &color.RGBA{R: 112, G: 65, B: 27, A: 255},
 			lightColorANSI: 94,
 		},
 		"cp": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "cp",
 			name:           "Cp",
 			glyph:          custom.Cpp,
+			glyphClass:     custom.Class,
+			glyphID:        "cpp",
 			darkColor:      &color.RGBA{R: 81, G: 154, B: 186, A: 255},
 			darkColorANSI:  74,
 			lightColor:     &color.RGBA{R: 54, G: 103, B: 124, A: 255},
 			lightColorANSI: 24,
 		},
 		"cpp": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "cpp",
 			name:           "Cpp",
 			glyph:          custom.Cpp,
+			glyphClass:     custom.Class,
+			glyphID:        "cpp",
 			darkColor:      &color.RGBA{R: 81, G: 154, B: 186, A: 255},
 			darkColorANSI:  74,
 			lightColor:     &color.RGBA{R: 54, G: 103, B: 124, A: 255},
 			lightColorANSI: 24,
 		},
 		"cppm": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "cppm",
 			name:           "Cppm",
 			glyph:          custom.Cpp,
+			glyphClass:     custom.Class,
+			glyphID:        "cpp",
 			darkColor:      &color.RGBA{R: 81, G: 154, B: 186, A: 255},
 			darkColorANSI:  74,
 			lightColor:     &color.RGBA{R: 54, G: 103, B: 124, A: 255},
 			lightColorANSI: 24,
 		},
 		"cpy": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "cpy",
 			name:           "Cobol",
 			glyph:          oct.Gear,
+			glyphClass:     oct.Class,
+			glyphID:        "gear",
 			darkColor:      &color.RGBA{R: 0, G: 92, B: 165, A: 255},
 			darkColorANSI:  25,
 			lightColor:     &color.RGBA{R: 0, G: 92, B: 165, A: 255},
 			lightColorANSI: 25,
 		},
 		"cr": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "cr",
 			name:           "Crystal",
 			glyph:          custom.Crystal,
+			glyphClass:     custom.Class,
+			glyphID:        "crystal",
 			darkColor:      &color.RGBA{R: 200, G: 200, B: 200, A: 255},
 			darkColorANSI:  251,
 			lightColor:     &color.RGBA{R: 67, G: 67, B: 67, A: 255},
 			lightColorANSI: 238,
 		},
 		"crdownload": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "crdownload",
 			name:           "Crdownload",
 			glyph:          fa.Download,
+			glyphClass:     fa.Class,
+			glyphID:        "download",
 			darkColor:      &color.RGBA{R: 68, G: 205, B: 168, A: 255},
 			darkColorANSI:  43,
 			lightColor:     &color.RGBA{R: 34, G: 102, B: 84, A: 255},
 			lightColorANSI: 23,
 		},
 		"cs": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "cs",
 			name:           "Cs",
 			glyph:          md.LanguageCsharp,
+			glyphClass:     md.Class,
+			glyphID:        "language_csharp",
 			darkColor:      &color.RGBA{R: 89, G: 103, B: 6, A: 255},
 			darkColorANSI:  58,
 			lightColor:     &color.RGBA{R: 67, G: 77, B: 4, A: 255},
 			lightColorANSI: 58,
 		},
 		"csh": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "csh",
 			name:           "Csh",
 			glyph:          dev.Terminal,
+			glyphClass:     dev.Class,
+			glyphID:        "terminal",
 			darkColor:      &color.RGBA{R: 77, G: 89, B: 94, A: 255},
 			darkColorANSI:  240,
 			lightColor:     &color.RGBA{R: 58, G: 68, B: 70, A: 255},
 			lightColorANSI: 238,
 		},
 		"cshtml": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "cshtml",
 			name:           "RazorPage",
 			glyph:          md.RazorDoubleEdge,
+			glyphClass:     md.Class,
+			glyphID:        "razor_double_edge",
 			darkColor:      &color.RGBA{R: 81, G: 43, B: 211, A: 255},
 			darkColorANSI:  56,
 			lightColor:     &color.RGBA{R: 81, G: 43, B: 211, A: 255},
 			lightColorANSI: 56,
 		},
 		"cson": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "cson",
 			name:           "Cson",
 			glyph:          seti.Json,
+			glyphClass:     seti.Class,
+			glyphID:        "json",
 			darkColor:      &color.RGBA{R: 203, G: 203, B: 65, A: 255},
 			darkColorANSI:  185,
 			lightColor:     &color.RGBA{R: 102, G: 102, B: 32, A: 255},
 			lightColorANSI: 58,
 		},
 		"csproj": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "csproj",
 			name:           "CSharpProject",
 			glyph:          md.DotNet,
+			glyphClass:     md.Class,
+			glyphID:        "dot_net",
 			darkColor:      &color.RGBA{R: 81, G: 43, B: 211, A: 255},
 			darkColorANSI:  56,
 			lightColor:     &color.RGBA{R: 81, G: 43, B: 211, A: 255},
 			lightColorANSI: 56,
 		},
 		"css": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "css",
 			name:           "Css",
 			glyph:          custom.Css,
+			glyphClass:     custom.Class,
+			glyphID:        "css",
 			darkColor:      &color.RGBA{R: 102, G: 51, B: 153, A: 255},
 			darkColorANSI:  91,
 			lightColor:     &color.RGBA{R: 102, G: 51, B: 153, A: 255},
 			lightColorANSI: 91,
 		},
 		"csv": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "csv",
 			name:           "Csv",
 			glyph:          seti.Csv,
+			glyphClass:     seti.Class,
+			glyphID:        "csv",
 			darkColor:      &color.RGBA{R: 137, G: 224, B: 81, A: 255},
 			darkColorANSI:  113,
 			lightColor:     &color.RGBA{R: 68, G: 112, B: 40, A: 255},
 			lightColorANSI: 22,
 		},
 		"cts": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "cts",
 			name:           "Cts",
 			glyph:          seti.Typescript,
+			glyphClass:     seti.Class,
+			glyphID:        "typescript",
 			darkColor:      &color.RGBA{R: 81, G: 154, B: 186, A: 255},
 			darkColorANSI:  74,
 			lightColor:     &color.RGBA{R: 54, G: 103, B: 124, A: 255},
 			lightColorANSI: 24,
 		},
 		"cu": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "cu",
 			name:           "cuda",
 			glyph:          seti.Cu,
+			glyphClass:     seti.Class,
+			glyphID:        "cu",
 			darkColor:      &color.RGBA{R: 137, G: 224, B: 81, A: 255},
 			darkColorANSI:  113,
 			lightColor:     &color.RGBA{R: 68, G: 112, B: 40, A: 255},
 			lightColorANSI: 22,
 		},
 		"cue": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "cue",
 			name:           "Cue",
 			glyph:          md.PlaylistMusicOutline,
+			glyphClass:     md.Class,
+			glyphID:        "playlist_music_outline",
 			darkColor:      &color.RGBA{R: 237, G: 149, B: 174, A: 255},
 			darkColorANSI:  211,
 			lightColor:     &color.RGBA{R: 118, G: 73, B: 87, A: 255},
 			lightColorANSI: 95,
 		},
 		"cuh": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "cuh",
 			name:           "cudah",
 			glyph:          seti.Cu,
+			glyphClass:     seti.Class,
+			glyphID:        "cu",
 			darkColor:      &color.RGBA{R: 160, G: 116, B: 195, A: 255},
 			darkColorANSI:  140,
 			lightColor:     &color.RGBA{R: 107, G: 77, B: 131, A: 255},
 			lightColorANSI: 96,
 		},
 		"cxx": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "cxx",
 			name:           "Cxx",
 			glyph:          custom.Cpp,
+			glyphClass:     custom.Class,
+			glyphID:        "cpp",
 			darkColor:      &color.RGBA{R: 81, G: 154, B: 186, A: 255},
 			darkColorANSI:  74,
 			lightColor:     &color.RGBA{R: 54, G: 103, B: 124, A: 255},
 			lightColorANSI: 24,
 		},
 		"cxxm": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "cxxm",
 			name:           "Cxxm",
 			glyph:          custom.Cpp,
+			glyphClass:     custom.Class,
+			glyphID:        "cpp",
 			darkColor:      &color.RGBA{R: 81, G: 154, B: 186, A: 255},
 			darkColorANSI:  74,
 			lightColor:     &color.RGBA{R: 54, G: 103, B: 124, A: 255},
 			lightColorANSI: 24,
 		},
 		"d.ts": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "d.ts",
 			name:           "TypeScriptDeclaration",
 			glyph:          seti.Typescript,
+			glyphClass:     seti.Class,
+			glyphID:        "typescript",
 			darkColor:      &color.RGBA{R: 213, G: 152, B: 85, A: 255},
 			darkColorANSI:  172,
 			lightColor:     &color.RGBA{R: 105, G: 76, B: 42, A: 255},
 			lightColorANSI: 94,
 		},
 		"d": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "d",
 			name:           "D",
 			glyph:          dev.Dlang,
+			glyphClass:     dev.Class,
+			glyphID:        "dlang",
 			darkColor:      &color.RGBA{R: 176, G: 56, B: 48, A: 255},
 			darkColorANSI:  124,
 			lightColor:     &color.RGBA{R: 131, G: 43, B: 36, A: 255},
 			lightColorANSI: 88,
 		},
 		"dart": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "dart",
 			name:           "Dart",
 			glyph:          dev.Dart,
+			glyphClass:     dev.Class,
+			glyphID:        "dart",
 			darkColor:      &color.RGBA{R: 3, G: 88, B: 156, A: 255},
 			darkColorANSI:  25,
 			lightColor:     &color.RGBA{R: 3, G: 88, B: 156, A: 255},
 			lightColorANSI: 25,
 		},
 		"db": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "db",
 			name:           "Db",
 			glyph:          dev.Database,
+			glyphClass:     dev.Class,
+			glyphID:        "database",
 			darkColor:      &color.RGBA{R: 218, G: 216, B: 216, A: 255},
 			darkColorANSI:  188,
 			lightColor:     &color.RGBA{R: 73, G: 72, B: 72, A: 255},
 			lightColorANSI: 238,
 		},
 		"dconf": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "dconf",
 			name:           "Dconf",
 			glyph:          linux.Gnome,
+			glyphClass:     linux.Class,
+			glyphID:        "gnome",
 			darkColor:      &color.RGBA{R: 255, G: 255, B: 255, A: 255},
 			darkColorANSI:  231,
 			lightColor:     &color.RGBA{R: 51, G: 51, B: 51, A: 255},
 			lightColorANSI: 236,
 		},
 		"desktop": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "desktop",
 			name:           "DesktopEntry",
 			glyph:          fa.Desktop,
+			glyphClass:     fa.Class,
+			glyphID:        "desktop",
 			darkColor:      &color.RGBA{R: 86, G: 60, B: 124, A: 255},
 			darkColorANSI:  54,
 			lightColor:     &color.RGBA{R: 86, G: 60, B: 124, A: 255},
 			lightColorANSI: 54,
 		},
 		"diff": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "diff",
 			name:           "Diff",
 			glyph:          dev.GitCompare,
+			glyphClass:     dev.Class,
+			glyphID:        "git_compare",
 			darkColor:      &color.RGBA{R: 65, G: 83, B: 91, A: 255},
 			darkColorANSI:  239,
 			lightColor:     &color.RGBA{R: 65, G: 83, B: 91, A: 255},
 			lightColorANSI: 239,
 		},
 		"dll": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "dll",
 			name:           "Dll",
 			glyph:          cod.Library,
+			glyphClass:     cod.Class,
+			glyphID:        "library",
 			darkColor:      &color.RGBA{R: 77, G: 44, B: 11, A: 255},
 			darkColorANSI:  52,
 			lightColor:     &color.RGBA{R: 77, G: 44, B: 11, A: 255},
 			lightColorANSI: 52,
 		},
 		"doc": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "doc",
 			name:           "Doc",
 			glyph:          md.FileWord,
+			glyphClass:     md.Class,
+			glyphID:        "file_word",
 			darkColor:      &color.RGBA{R: 24, G: 89, B: 189, A: 255},
 			darkColorANSI:  26,
 			lightColor:     &color.RGBA{R: 24, G: 89, B: 189, A: 255},
 			lightColorANSI: 26,
 		},
 		"dockerignore": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "dockerignore",
 			name:           "DockerIgnore",
 			glyph:          md.Docker,
+			glyphClass:     md.Class,
+			glyphID:        "docker",
 			darkColor:      &color.RGBA{R: 69, G: 142, B: 230, A: 255},
 			darkColorANSI:  68,
 			lightColor:     &color.RGBA{R: 46, G: 95, B: 153, A: 255},
 			lightColorANSI: 25,
 		},
 		"docx": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "docx",
 			name:           "Docx",
 			glyph:          md.FileWord,
+			glyphClass:     md.Class,
+			glyphID:        "file_word",
 			darkColor:      &color.RGBA{R: 24, G: 89, B: 189, A: 255},
 			darkColorANSI:  26,
 			lightColor:     &color.RGBA{R: 24, G: 89, B: 189, A: 255},
 			lightColorANSI: 26,
 		},
 		"dot": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "dot",
 			name:           "Dot",
 			glyph:          md.Graph,
+			glyphClass:     md.Class,
+			glyphID:        "graph",
 			darkColor:      &color.RGBA{R: 48, G: 99, B: 142, A: 255},
 			darkColorANSI:  24,
 			lightColor:     &color.RGBA{R: 36, G: 73, B: 105, A: 255},
 			lightColorANSI: 24,
 		},
 		"download": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "download",
 			name:           "Download",
 			glyph:          fa.Download,
+			glyphClass:     fa.Class,
+			glyphID:        "download",
 			darkColor:      &color.RGBA{R: 68, G: 205, B: 168, A: 255},
 			darkColorANSI:  43,
 			lightColor:     &color.RGBA{R: 34, G: 102, B: 84, A: 255},
 			lightColorANSI: 23,
 		},
 		"drl": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "drl",
 			name:           "Drools",
 			glyph:          fae.Brain,
+			glyphClass:     fae.Class,
+			glyphID:        "brain",
 			darkColor:      &color.RGBA{R: 255, G: 175, B: 175, A: 255},
 			darkColorANSI:  217,
 			lightColor:     &color.RGBA{R: 85, G: 58, B: 58, A: 255},
 			lightColorANSI: 238,
 		},
 		"dropbox": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "dropbox",
 			name:           "Dropbox",
 			glyph:          dev.Dropbox,
+			glyphClass:     dev.Class,
+			glyphID:        "dropbox",
 			darkColor:      &color.RGBA{R: 0, G: 97, B: 254, A: 255},
 			darkColorANSI:  27,
 			lightColor:     &color.RGBA{R: 0, G: 73, B: 190, A: 255},
 			lightColorANSI: 26,
 		},
 		"dump": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "dump",
 			name:           "Dump",
 			glyph:          dev.Database,
+			glyphClass:     dev.Class,
+			glyphID:        "database",
 			darkColor:      &color.RGBA{R: 218, G: 216, B: 216, A: 255},
 			darkColorANSI:  188,
 			lightColor:     &color.RGBA{R: 73, G: 72, B: 72, A: 255},
 			lightColorANSI: 238,
 		},
 		"dwg": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "dwg",
 			name:           "AutoCADDwg",
 			glyph:          md.FileCad,
+			glyphClass:     md.Class,
+			glyphID:        "file_cad",
 			darkColor:      &color.RGBA{R: 131, G: 147, B: 99, A: 255},
 			darkColorANSI:  101,
 			lightColor:     &color.RGBA{R: 87, G: 99, B: 65, A: 255},
 			lightColorANSI: 58,
 		},
 		"dxf": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "dxf",
 			name:           "AutoCADDxf",
 			glyph:          md.FileCad,
+			glyphClass:     md.Class,
+			glyphID:        "file_cad",
 			darkColor:      &color.RGBA{R: 131, G: 147, B: 99, A: 255},
 			darkColorANSI:  101,
 			lightColor:     &color.RGBA{R: 87, G: 99, B: 65, A: 255},
 			lightColorANSI: 58,
 		},
 		"ebook": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "ebook",
 			name:           "Ebook",
 			glyph:          fae.BookOpenO,
+			glyphClass:     fae.Class,
+			glyphID:        "book_open_o",
 			darkColor:      &color.RGBA{R: 234, G: 177, B: 109, A: 255},
 			darkColorANSI:  215,
 			lightColor:     &color.RGBA{R: 117, G: 88, B: 54, A: 255},
 			lightColorANSI: 94,
 		},
 		"ebuild": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "ebuild",
 			name:           "GentooBuild",
 			glyph:          linux.Gentoo,
+			glyphClass:     linux.Class,
+			glyphID:        "gentoo",
 			darkColor:      &color.RGBA{R: 76, G: 65, B: 110, A: 255},
 			darkColorANSI:  60,
 			lightColor:     &color.RGBA{R: 76, G: 65, B: 110, A: 255},
 			lightColorANSI: 60,
 		},
 		"edn": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "edn",
 			name:           "Edn",
 			glyph:          dev.ClojureAlt,
+			glyphClass:     dev.Class,
+			glyphID:        "clojure_alt",
 			darkColor:      &color.RGBA{R: 81, G: 154, B: 186, A: 255},
 			darkColorANSI:  74,
 			lightColor:     &color.RGBA{R: 54, G: 103, B: 124, A: 255},
 			lightColorANSI: 24,
 		},
 		"eex": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "eex",
 			name:           "Eex",
 			glyph:          custom.Elixir,
+			glyphClass:     custom.Class,
+			glyphID:        "elixir",
 			darkColor:      &color.RGBA{R: 160, G: 116, B: 195, A: 255},
 			darkColorANSI:  140,
 			lightColor:     &color.RGBA{R: 107, G: 77, B: 131, A: 255},
 			lightColorANSI: 96,
 		},
 		"ejs": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "ejs",
 			name:           "Ejs",
 			glyph:          seti.Html,
+			glyphClass:     seti.Class,
+			glyphID:        "html",
 			darkColor:      &color.RGBA{R: 203, G: 203, B: 65, A: 255},
 			darkColorANSI:  185,
 			lightColor:     &color.RGBA{R: 102, G: 102, B: 32, A: 255},
 			lightColorANSI: 58,
 		},
 		"el": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "el",
 			name:           "Elisp",
 			glyph:          custom.Emacs,
+			glyphClass:     custom.Class,
+			glyphID:        "emacs",
 			darkColor:      &color.RGBA{R: 129, G: 113, B: 190, A: 255},
 			darkColorANSI:  97,
 			lightColor:     &color.RGBA{R: 97, G: 86, B: 142, A: 255},
 			lightColorANSI: 60,
 		},
 		"elc": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "elc",
 			name:           "Elisp",
 			glyph:          custom.Emacs,
+			glyphClass:     custom.Class,
+			glyphID:        "emacs",
 			darkColor:      &color.RGBA{R: 129, G: 113, B: 190, A: 255},
 			darkColorANSI:  97,
 			lightColor:     &color.RGBA{R: 97, G: 86, B: 142, A: 255},
 			lightColorANSI: 60,
 		},
 		"elf": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "elf",
 			name:           "Elf",
 			glyph:          cod.FileBinary,
+			glyphClass:     cod.Class,
+			glyphID:        "file_binary",
 			darkColor:      &color.RGBA{R: 159, G: 5, B: 0, A: 255},
 			darkColorANSI:  124,
 			lightColor:     &color.RGBA{R: 159, G: 5, B: 0, A: 255},
 			lightColorANSI: 124,
 		},
 		"elm": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "elm",
 			name:           "Elm",
 			glyph:          custom.Elm,
+			glyphClass:     custom.Class,
+			glyphID:        "elm",
 			darkColor:      &color.RGBA{R: 81, G: 154, B: 186, A: 255},
 			darkColorANSI:  74,
 			lightColor:     &color.RGBA{R: 54, G: 103, B: 124, A: 255},
 			lightColorANSI: 24,
 		},
 		"eln": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "eln",
 			name:           "Elisp",
 			glyph:          custom.Emacs,
+			glyphClass:     custom.Class,
+			glyphID:        "emacs",
 			darkColor:      &color.RGBA{R: 129, G: 113, B: 190, A: 255},
 			darkColorANSI:  97,
 			lightColor:     &color.RGBA{R: 97, G: 86, B: 142, A: 255},
 			lightColorANSI: 60,
 		},
 		"env": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "env",
 			name:           "Env",
 			glyph:          oct.Sliders,
+			glyphClass:     oct.Class,
+			glyphID:        "sliders",
 			darkColor:      &color.RGBA{R: 250, G: 247, B: 67, A: 255},
 			darkColorANSI:  227,
 			lightColor:     &color.RGBA{R: 50, G: 48, B: 13, A: 255},
 			lightColorANSI: 236,
 		},
 		"eot": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "eot",
 			name:           "EmbeddedOpenTypeFont",
 			glyph:          fa.Font,
+			glyphClass:     fa.Class,
+			glyphID:        "font",
 			darkColor:      &color.RGBA{R: 236, G: 236, B: 236, A: 255},
 			darkColorANSI:  255,
 			lightColor:     &color.RGBA{R: 47, G: 47, B: 47, A: 255},
 			lightColorANSI: 236,
 		},
 		"epp": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "epp",
 			name:           "Epp",
 			glyph:          custom.Puppet,
+			glyphClass:     custom.Class,
+			glyphID:        "puppet",
 			darkColor:      &color.RGBA{R: 255, G: 166, B: 26, A: 255},
 			darkColorANSI:  214,
 			lightColor:     &color.RGBA{R: 128, G: 83, B: 13, A: 255},
 			lightColorANSI: 94,
 		},
 		"epub": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "epub",
 			name:           "Epub",
 			glyph:          fae.BookOpenO,
+			glyphClass:     fae.Class,
+			glyphID:        "book_open_o",
 			darkColor:      &color.RGBA{R: 234, G: 177, B: 109, A: 255},
 			darkColorANSI:  215,
 			lightColor:     &color.RGBA{R: 117, G: 88, B: 54, A: 255},
 			lightColorANSI: 94,
 		},
 		"erb": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "erb",
 			name:           "Erb",
 			glyph:          seti.Html,
+			glyphClass:     seti.Class,
+			glyphID:        "html",
 			darkColor:      &color.RGBA{R: 112, G: 21, B: 22, A: 255},
 			darkColorANSI:  52,
 			lightColor:     &color.RGBA{R: 112, G: 21, B: 22, A: 255},
 			lightColorANSI: 52,
 		},
 		"erl": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "erl",
 			name:           "Erl",
 			glyph:          dev.Erlang,
+			glyphClass:     dev.Class,
+			glyphID:        "erlang",
 			darkColor:      &color.RGBA{R: 184, G: 56, B: 152, A: 255},
 			darkColorANSI:  163,
 			lightColor:     &color.RGBA{R: 138, G: 43, B: 113, A: 255},
 			lightColorANSI: 89,
 		},
 		"ex": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "ex",
 			name:           "Ex",
 			glyph:          custom.Elixir,
+			glyphClass:     custom.Class,
+			glyphID:        "elixir",
 			darkColor:      &color.RGBA{R: 160, G: 116, B: 195, A: 255},
 			darkColorANSI:  140,
 			lightColor:     &color.RGBA{R: 107, G: 77, B: 131, A: 255},
 			lightColorANSI: 96,
 		},
 		"exe": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "exe",
 			name:           "Exe",
 			glyph:          cod.FileBinary,
+			glyphClass:     cod.Class,
+			glyphID:        "file_binary",
 			darkColor:      &color.RGBA{R: 159, G: 5, B: 0, A: 255},
 			darkColorANSI:  124,
 			lightColor:     &color.RGBA{R: 159, G: 5, B: 0, A: 255},
 			lightColorANSI: 124,
 		},
 		"exs": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "exs",
 			name:           "Exs",
 			glyph:          custom.Elixir,
+			glyphClass:     custom.Class,
+			glyphID:        "elixir",
 			darkColor:      &color.RGBA{R: 160, G: 116, B: 195, A: 255},
 			darkColorANSI:  140,
 			lightColor:     &color.RGBA{R: 107, G: 77, B: 131, A: 255},
 			lightColorANSI: 96,
 		},
 		"f#": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "f#",
 			name:           "Fsharp",
 			glyph:          dev.Fsharp,
+			glyphClass:     dev.Class,
+			glyphID:        "fsharp",
 			darkColor:      &color.RGBA{R: 81, G: 154, B: 186, A: 255},
 			darkColorANSI:  74,
 			lightColor:     &color.RGBA{R: 54, G: 103, B: 124, A: 255},
 			lightColorANSI: 24,
 		},
 		"f3d": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "f3d",
 			name:           "Fusion360",
 			glyph:          md.FileCad,
+			glyphClass:     md.Class,
+			glyphID:        "file_cad",
 			darkColor:      &color.RGBA{R: 131, G: 147, B: 99, A: 255},
 			darkColorANSI:  101,
 			lightColor:     &color.RGBA{R: 87, G: 99, B: 65, A: 255},
 			lightColorANSI: 58,
 		},
 		"f90": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "f90",
 			name:           "Fortran",
 			glyph:          md.LanguageFortran,
+			glyphClass:     md.Class,
+			glyphID:        "language_fortran",
 			darkColor:      &color.RGBA{R: 115, G: 79, B: 150, A: 255},
 			darkColorANSI:  97,
 			lightColor:     &color.RGBA{R: 86, G: 59, B: 112, A: 255},
 			lightColorANSI: 53,
 		},
 		"fbx": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "fbx",
 			name:           "3DObjectFile",
 			glyph:          md.CubeOutline,
+			glyphClass:     md.Class,
+			glyphID:        "cube_outline",
 			darkColor:      &color.RGBA{R: 136, G: 136, B: 136, A: 255},
 			darkColorANSI:  102,
 			lightColor:     &color.RGBA{R: 91, G: 91, B: 91, A: 255},
 			lightColorANSI: 240,
 		},
 		"fcbak": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "fcbak",
 			name:           "FreeCAD",
 			glyph:          linux.Freecad,
+			glyphClass:     linux.Class,
+			glyphID:        "freecad",
 			darkColor:      &color.RGBA{R: 203, G: 51, B: 59, A: 255},
 			darkColorANSI:  160,
 			lightColor:     &color.RGBA{R: 152, G: 38, B: 44, A: 255},
 			lightColorANSI: 88,
 		},
 		"fcmacro": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "fcmacro",
 			name:           "FreeCAD",
 			glyph:          linux.Freecad,
+			glyphClass:     linux.Class,
+			glyphID:        "freecad",
 			darkColor:      &color.RGBA{R: 203, G: 51, B: 59, A: 255},
 			darkColorANSI:  160,
 			lightColor:     &color.RGBA{R: 152, G: 38, B: 44, A: 255},
 			lightColorANSI: 88,
 		},
 		"fcmat": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "fcmat",
 			name:           "FreeCAD",
 			glyph:          linux.Freecad,
+			glyphClass:     linux.Class,
+			glyphID:        "freecad",
 			darkColor:      &color.RGBA{R: 203, G: 51, B: 59, A: 255},
 			darkColorANSI:  160,
 			lightColor:     &color.RGBA{R: 152, G: 38, B: 44, A: 255},
 			lightColorANSI: 88,
 		},
 		"fcparam": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "fcparam",
 			name:           "FreeCAD",
 			glyph:          linux.Freecad,
+			glyphClass:     linux.Class,
+			glyphID:        "freecad",
 			darkColor:      &color.RGBA{R: 203, G: 51, B: 59, A: 255},
 			darkColorANSI:  160,
 			lightColor:     &color.RGBA{R: 152, G: 38, B: 44, A: 255},
 			lightColorANSI: 88,
 		},
 		"fcscript": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "fcscript",
 			name:           "FreeCAD",
 			glyph:          linux.Freecad,
+			glyphClass:     linux.Class,
+			glyphID:        "freecad",
 			darkColor:      &color.RGBA{R: 203, G: 51, B: 59, A: 255},
 			darkColorANSI:  160,
 			lightColor:     &color.RGBA{R: 152, G: 38, B: 44, A: 255},
 			lightColorANSI: 88,
 		},
 		"fcstd1": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "fcstd1",
 			name:           "FreeCAD",
 			glyph:          linux.Freecad,
+			glyphClass:     linux.Class,
+			glyphID:        "freecad",
 			darkColor:      &color.RGBA{R: 203, G: 51, B: 59, A: 255},
 			darkColorANSI:  160,
 			lightColor:     &color.RGBA{R: 152, G: 38, B: 44, A: 255},
 			lightColorANSI: 88,
 		},
 		"fcstd": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "fcstd",
 			name:           "FreeCAD",
 			glyph:          linux.Freecad,
+			glyphClass:     linux.Class,
+			glyphID:        "freecad",
 			darkColor:      &color.RGBA{R: 203, G: 51, B: 59, A: 255},
 			darkColorANSI:  160,
 			lightColor:     &color.RGBA{R: 152, G: 38, B: 44, A: 255},
 			lightColorANSI: 88,
 		},
 		"fctb": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "fctb",
 			name:           "FreeCAD",
 			glyph:          linux.Freecad,
+			glyphClass:     linux.Class,
+			glyphID:        "freecad",
 			darkColor:      &color.RGBA{R: 203, G: 51, B: 59, A: 255},
 			darkColorANSI:  160,
 			lightColor:     &color.RGBA{R: 152, G: 38, B: 44, A: 255},
 			lightColorANSI: 88,
 		},
 		"fctl": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "fctl",
 			name:           "FreeCAD",
 			glyph:          linux.Freecad,
+			glyphClass:     linux.Class,
+			glyphID:        "freecad",
 			darkColor:      &color.RGBA{R: 203, G: 51, B: 59, A: 255},
 			darkColorANSI:  160,
 			lightColor:     &color.RGBA{R: 152, G: 38, B: 44, A: 255},
 			lightColorANSI: 88,
 		},
 		"fdmdownload": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "fdmdownload",
 			name:           "Fdmdownload",
 			glyph:          fa.Download,
+			glyphClass:     fa.Class,
+			glyphID:        "download",
 			darkColor:      &color.RGBA{R: 68, G: 205, B: 168, A: 255},
 			darkColorANSI:  43,
 			lightColor:     &color.RGBA{R: 34, G: 102, B: 84, A: 255},
 			lightColorANSI: 23,
 		},
 		"feature": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "feature",
 			name:           "Feature",
 			glyph:          dev.Cucumber,
+			glyphClass:     dev.Class,
+			glyphID:        "cucumber",
 			darkColor:      &color.RGBA{R: 0, G: 168, B: 24, A: 255},
 			darkColorANSI:  34,
 			lightColor:     &color.RGBA{R: 0, G: 126, B: 18, A: 255},
 			lightColorANSI: 28,
 		},
 		"fish": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "fish",
 			name:           "Fish",
 			glyph:          dev.Terminal,
+			glyphClass:     dev.Class,
+			glyphID:        "terminal",
 			darkColor:      &color.RGBA{R: 77, G: 89, B: 94, A: 255},
 			darkColorANSI:  240,
 			lightColor:     &color.RGBA{R: 58, G: 68, B: 70, A: 255},
 			lightColorANSI: 238,
 		},
 		"flac": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "flac",
 			name:           "FreeLosslessAudioCodec",
 			glyph:          fa.Music,
+			glyphClass:     fa.Class,
+			glyphID:        "music",
 			darkColor:      &color.RGBA{R: 0, G: 117, B: 170, A: 255},
 			darkColorANSI:  24,
 			lightColor:     &color.RGBA{R: 0, G: 88, B: 128, A: 255},
 			lightColorANSI: 24,
 		},
 		"flc": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "flc",
 			name:           "FIGletFontControl",
 			glyph:          fa.Font,
+			glyphClass:     fa.Class,
+			glyphID:        "font",
 			darkColor:      &color.RGBA{R: 236, G: 236, B: 236, A: 255},
 			darkColorANSI:  255,
 			lightColor:     &color.RGBA{R: 47, G: 47, B: 47, A: 255},
 			lightColorANSI: 236,
 		},
 		"flf": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "flf",
 			name:           "FIGletFontFormat",
 			glyph:          fa.Font,
+			glyphClass:     fa.Class,
+			glyphID:        "font",
 			darkColor:      &color.RGBA{R: 236, G: 236, B: 236, A: 255},
 			darkColorANSI:  255,
 			lightColor:     &color.RGBA{R: 47, G: 47, B: 47, A: 255},
 			lightColorANSI: 236,
 		},
 		"fnl": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "fnl",
 			name:           "Fennel",
 			glyph:          custom.Fennel,
+			glyphClass:     custom.Class,
+			glyphID:        "fennel",
 			darkColor:      &color.RGBA{R: 255, G: 243, B: 215, A: 255},
 			darkColorANSI:  230,
 			lightColor:     &color.RGBA{R: 51, G: 48, B: 43, A: 255},
 			lightColorANSI: 236,
 		},
 		"fodg": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "fodg",
 			name:           "LibreOfficeGraphics",
 			glyph:          linux.Libreofficedraw,
+			glyphClass:     linux.Class,
+			glyphID:        "libreofficedraw",
 			darkColor:      &color.RGBA{R: 255, G: 251, B: 87, A: 255},
 			darkColorANSI:  227,
 			lightColor:     &color.RGBA{R: 51, G: 50, B: 17, A: 255},
 			lightColorANSI: 236,
 		},
 		"fodp": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "fodp",
 			name:           "LibreOfficeImpress",
 			glyph:          linux.Libreofficeimpress,
+			glyphClass:     linux.Class,
+			glyphID:        "libreofficeimpress",
 			darkColor:      &color.RGBA{R: 254, G: 156, B: 69, A: 255},
 			darkColorANSI:  215,
 			lightColor:     &color.RGBA{R: 127, G: 78, B: 34, A: 255},
 			lightColorANSI: 94,
 		},
 		"fods": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "fods",
 			name:           "LibreOfficeCalc",
 			glyph:          linux.Libreofficecalc,
+			glyphClass:     linux.Class,
+			glyphID:        "libreofficecalc",
 			darkColor:      &color.RGBA{R: 120, G: 252, B: 78, A: 255},
 			darkColorANSI:  119,
 			lightColor:     &color.RGBA{R: 40, G: 84, B: 26, A: 255},
 			lightColorANSI: 22,
 		},
 		"fodt": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "fodt",
 			name:           "LibreOfficeWriter",
 			glyph:          linux.Libreofficewriter,
+			glyphClass:     linux.Class,
+			glyphID:        "libreofficewriter",
 			darkColor:      &color.RGBA{R: 44, G: 203, B: 253, A: 255},
 			darkColorANSI:  81,
 			lightColor:     &color.RGBA{R: 22, G: 102, B: 126, A: 255},
 			lightColorANSI: 24,
 		},
 		"frag": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "frag",
 			name:           "FragmentShader",
 			glyph:          dev.Opengl,
+			glyphClass:     dev.Class,
+			glyphID:        "opengl",
 			darkColor:      &color.RGBA{R: 85, G: 134, B: 166, A: 255},
 			darkColorANSI:  67,
 			lightColor:     &color.RGBA{R: 64, G: 100, B: 124, A: 255},
 			lightColorANSI: 24,
 		},
 		"fs": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "fs",
 			name:           "Fs",
 			glyph:          dev.Fsharp,
+			glyphClass:     dev.Class,
+			glyphID:        "fsharp",
 			darkColor:      &color.RGBA{R: 81, G: 154, B: 186, A: 255},
 			darkColorANSI:  74,
 			lightColor:     &color.RGBA{R: 54, G: 103, B: 124, A: 255},
 			lightColorANSI: 24,
 		},
 		"fsi": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "fsi",
 			name:           "Fsi",
 			glyph:          dev.Fsharp,
+			glyphClass:     dev.Class,
+			glyphID:        "fsharp",
 			darkColor:      &color.RGBA{R: 81, G: 154, B: 186, A: 255},
 			darkColorANSI:  74,
 			lightColor:     &color.RGBA{R: 54, G: 103, B: 124, A: 255},
 			lightColorANSI: 24,
 		},
 		"fsscript": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "fsscript",
 			name:           "Fsscript",
 			glyph:          dev.Fsharp,
+			glyphClass:     dev.Class,
+			glyphID:        "fsharp",
 			darkColor:      &color.RGBA{R: 81, G: 154, B: 186, A: 255},
 			darkColorANSI:  74,
 			lightColor:     &color.RGBA{R: 54, G: 103, B: 124, A: 255},
 			lightColorANSI: 24,
 		},
 		"fsx": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "fsx",
 			name:           "Fsx",
 			glyph:          dev.Fsharp,
+			glyphClass:     dev.Class,
+			glyphID:        "fsharp",
 			darkColor:      &color.RGBA{R: 81, G: 154, B: 186, A: 255},
 			darkColorANSI:  74,
 			lightColor:     &color.RGBA{R: 54, G: 103, B: 124, A: 255},
 			lightColorANSI: 24,
 		},
 		"gcode": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "gcode",
 			name:           "GCode",
 			glyph:          md.Printer3D,
+			glyphClass:     md.Class,
+			glyphID:        "printer_3d",
 			darkColor:      &color.RGBA{R: 20, G: 113, B: 173, A: 255},
 			darkColorANSI:  32,
 			lightColor:     &color.RGBA{R: 15, G: 85, B: 130, A: 255},
 			lightColorANSI: 24,
 		},
 		"gd": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "gd",
 			name:           "GDScript",
 			glyph:          seti.Godot,
+			glyphClass:     seti.Class,
+			glyphID:        "godot",
 			darkColor:      &color.RGBA{R: 109, G: 128, B: 134, A: 255},
 			darkColorANSI:  66,
 			lightColor:     &color.RGBA{R: 81, G: 96, B: 100, A: 255},
 			lightColorANSI: 59,
 		},
 		"gemspec": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "gemspec",
 			name:           "Gemspec",
 			glyph:          dev.RubyRough,
+			glyphClass:     dev.Class,
+			glyphID:        "ruby_rough",
 			darkColor:      &color.RGBA{R: 112, G: 21, B: 22, A: 255},
 			darkColorANSI:  52,
 			lightColor:     &color.RGBA{R: 112, G: 21, B: 22, A: 255},
 			lightColorANSI: 52,
 		},
 		"geom": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "geom",
 			name:           "GeometryShader",
 			glyph:          dev.Opengl,
+			glyphClass:     dev.Class,
+			glyphID:        "opengl",
 			darkColor:      &color.RGBA{R: 85, G: 134, B: 166, A: 255},
 			darkColorANSI:  67,
 			lightColor:     &color.RGBA{R: 64, G: 100, B: 124, A: 255},
 			lightColorANSI: 24,
 		},
 		"gif": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "gif",
 			name:           "Gif",
 			glyph:          seti.Image,
+			glyphClass:     seti.Class,
+			glyphID:        "image",
 			darkColor:      &color.RGBA{R: 160, G: 116, B: 195, A: 255},
 			darkColorANSI:  140,
 			lightColor:     &color.RGBA{R: 107, G: 77, B: 131, A: 255},
 			lightColorANSI: 96,
 		},
 		"git": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "git",
 			name:           "GitLogo",
 			glyph:          dev.Git,
+			glyphClass:     dev.Class,
+			glyphID:        "git",
 			darkColor:      &color.RGBA{R: 241, G: 76, B: 40, A: 255},
 			darkColorANSI:  196,
 			lightColor:     &color.RGBA{R: 181, G: 56, B: 30, A: 255},
 			lightColorANSI: 160,
 		},
 		"glb": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "glb",
 			name:           "BinaryGLTF",
 			glyph:          fa.Cube,
+			glyphClass:     fa.Class,
+			glyphID:        "cube",
 			darkColor:      &color.RGBA{R: 255, G: 177, B: 59, A: 255},
 			darkColorANSI:  214,
 			lightColor:     &color.RGBA{R: 128, G: 88, B: 30, A: 255},
 			lightColorANSI: 94,
 		},
 		"gleam": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "gleam",
 			name:           "Gleam",
 			glyph:          fa.Star,
+			glyphClass:     fa.Class,
+			glyphID:        "star",
 			darkColor:      &color.RGBA{R: 255, G: 175, B: 243, A: 255},
 			darkColorANSI:  219,
 			lightColor:     &color.RGBA{R: 85, G: 58, B: 81, A: 255},
 			lightColorANSI: 53,
 		},
 		"glsl": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "glsl",
 			name:           "OpenGLShadingLanguage",
 			glyph:          dev.Opengl,
+			glyphClass:     dev.Class,
+			glyphID:        "opengl",
 			darkColor:      &color.RGBA{R: 85, G: 134, B: 166, A: 255},
 			darkColorANSI:  67,
 			lightColor:     &color.RGBA{R: 64, G: 100, B: 124, A: 255},
 			lightColorANSI: 24,
 		},
 		"gnumakefile": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "gnumakefile",
 			name:           "Makefile",
 			glyph:          dev.Gnu,
+			glyphClass:     dev.Class,
+			glyphID:        "gnu",
 			darkColor:      &color.RGBA{R: 109, G: 128, B: 134, A: 255},
 			darkColorANSI:  66,
 			lightColor:     &color.RGBA{R: 81, G: 96, B: 100, A: 255},
 			lightColorANSI: 59,
 		},
 		"go": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "go",
 			name:           "Go",
 			glyph:          seti.Go,
+			glyphClass:     seti.Class,
+			glyphID:        "go",
 			darkColor:      &color.RGBA{R: 0, G: 173, B: 216, A: 255},
 			darkColorANSI:  38,
 			lightColor:     &color.RGBA{R: 0, G: 130, B: 162, A: 255},
 			lightColorANSI: 31,
 		},
 		"godot": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "godot",
 			name:           "GodotProject",
 			glyph:          seti.Godot,
+			glyphClass:     seti.Class,
+			glyphID:        "godot",
 			darkColor:      &color.RGBA{R: 109, G: 128, B: 134, A: 255},
 			darkColorANSI:  66,
 			lightColor:     &color.RGBA{R: 81, G: 96, B: 100, A: 255},
 			lightColorANSI: 59,
 		},
 		"gpr": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "gpr",
 			name:           "GPRBuildProject",
 			glyph:          custom.Ada,
+			glyphClass:     custom.Class,
+			glyphID:        "ada",
 			darkColor:      &color.RGBA{R: 109, G: 128, B: 134, A: 255},
 			darkColorANSI:  66,
 			lightColor:     &color.RGBA{R: 81, G: 96, B: 100, A: 255},
 			lightColorANSI: 59,
 		},
 		"gql": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "gql",
 			name:           "GraphQL",
 			glyph:          fa.Connectdevelop,
+			glyphClass:     fa.Class,
+			glyphID:        "connectdevelop",
 			darkColor:      &color.RGBA{R: 229, G: 52, B: 171, A: 255},
 			darkColorANSI:  199,
 			lightColor:     &color.RGBA{R: 172, G: 40, B: 128, A: 255},
 			lightColorANSI: 126,
 		},
 		"gradle": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "gradle",
 			name:           "Gradle",
 			glyph:          seti.Gradle,
+			glyphClass:     seti.Class,
+			glyphID:        "gradle",
 			darkColor:      &color.RGBA{R: 0, G: 95, B: 135, A: 255},
 			darkColorANSI:  24,
 			lightColor:     &color.RGBA{R: 0, G: 95, B: 135, A: 255},
 			lightColorANSI: 24,
 		},
 		"graphql": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "graphql",
 			name:           "GraphQL",
 			glyph:          fa.Connectdevelop,
+			glyphClass:     fa.Class,
+			glyphID:        "connectdevelop",
 			darkColor:      &color.RGBA{R: 229, G: 52, B: 171, A: 255},
 			darkColorANSI:  199,
 			lightColor:     &color.RGBA{R: 172, G: 40, B: 128, A: 255},
 			lightColorANSI: 126,
 		},
 		"gresource": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "gresource",
 			name:           "GTK",
 			glyph:          linux.Gtk,
+			glyphClass:     linux.Class,
+			glyphID:        "gtk",
 			darkColor:      &color.RGBA{R: 255, G: 255, B: 255, A: 255},
 			darkColorANSI:  231,
 			lightColor:     &color.RGBA{R: 51, G: 51, B: 51, A: 255},
 			lightColorANSI: 236,
 		},
 		"gv": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "gv",
 			name:           "Gv",
 			glyph:          md.Graph,
+			glyphClass:     md.Class,
+			glyphID:        "graph",
 			darkColor:      &color.RGBA{R: 48, G: 99, B: 142, A: 255},
 			darkColorANSI:  24,
 			lightColor:     &color.RGBA{R: 36, G: 73, B: 105, A: 255},
 			lightColorANSI: 24,
 		},
 		"gz": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "gz",
 			name:           "Gz",
 			glyph:          oct.FileZip,
+			glyphClass:     oct.Class,
+			glyphID:        "file_zip",
 			darkColor:      &color.RGBA{R: 236, G: 165, B: 23, A: 255},
 			darkColorANSI:  214,
 			lightColor:     &color.RGBA{R: 118, G: 81, B: 12, A: 255},
 			lightColorANSI: 94,
 		},
 		"h": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "h",
 			name:           "H",
 			glyph:          fa.HSquare,
+			glyphClass:     fa.Class,
+			glyphID:        "h_square",
 			darkColor:      &color.RGBA{R: 160, G: 116, B: 195, A: 255},
 			darkColorANSI:  140,
 			lightColor:     &color.RGBA{R: 107, G: 77, B: 131, A: 255},
 			lightColorANSI: 96,
 		},
 		"haml": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "haml",
 			name:           "Haml",
 			glyph:          seti.Html,
+			glyphClass:     seti.Class,
+			glyphID:        "html",
 			darkColor:      &color.RGBA{R: 234, G: 234, B: 225, A: 255},
 			darkColorANSI:  255,
 			lightColor:     &color.RGBA{R: 47, G: 47, B: 44, A: 255},
 			lightColorANSI: 236,
 		},
 		"hbs": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "hbs",
 			name:           "Hbs",
 			glyph:          seti.Mustache,
+			glyphClass:     seti.Class,
+			glyphID:        "mustache",
 			darkColor:      &color.RGBA{R: 240, G: 119, B: 43, A: 255},
 			darkColorANSI:  202,
 			lightColor:     &color.RGBA{R: 160, G: 79, B: 29, A: 255},
 			lightColorANSI: 130,
 		},
 		"heex": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "heex",
 			name:           "Heex",
 			glyph:          custom.Elixir,
+			glyphClass:     custom.Class,
+			glyphID:        "elixir",
 			darkColor:      &color.RGBA{R: 160, G: 116, B: 195, A: 255},
 			darkColorANSI:  140,
 			lightColor:     &color.RGBA{R: 107, G: 77, B: 131, A: 255},
 			lightColorANSI: 96,
 		},
 		"hex": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "hex",
 			name:           "Hexadecimal",
 			glyph:          seti.Hex,
+			glyphClass:     seti.Class,
+			glyphID:        "hex",
 			darkColor:      &color.RGBA{R: 46, G: 99, B: 255, A: 255},
 			darkColorANSI:  27,
 			lightColor:     &color.RGBA{R: 34, G: 73, B: 191, A: 255},
 			lightColorANSI: 26,
 		},
 		"hh": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "hh",
 			name:           "Hh",
 			glyph:          fa.HSquare,
+			glyphClass:     fa.Class,
+			glyphID:        "h_square",
 			darkColor:      &color.RGBA{R: 160, G: 116, B: 195, A: 255},
 			darkColorANSI:  140,
 			lightColor:     &color.RGBA{R: 107, G: 77, B: 131, A: 255},
 			lightColorANSI: 96,
 		},
 		"hpp": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "hpp",
 			name:           "Hpp",
 			glyph:          fa.HSquare,
+			glyphClass:     fa.Class,
+			glyphID:        "h_square",
 			darkColor:      &color.RGBA{R: 160, G: 116, B: 195, A: 255},
 			darkColorANSI:  140,
 			lightColor:     &color.RGBA{R: 107, G: 77, B: 131, A: 255},
 			lightColorANSI: 96,
 		},
 		"hrl": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "hrl",
 			name:           "Hrl",
 			glyph:          dev.Erlang,
+			glyphClass:     dev.Class,
+			glyphID:        "erlang",
 			darkColor:      &color.RGBA{R: 184, G: 56, B: 152, A: 255},
 			darkColorANSI:  163,
 			lightColor:     &color.RGBA{R: 138, G: 43, B: 113, A: 255},
 			lightColorANSI: 89,
 		},
 		"hs": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "hs",
 			name:           "Hs",
 			glyph:          seti.Haskell,
+			glyphClass:     seti.Class,
+			glyphID:        "haskell",
 			darkColor:      &color.RGBA{R: 160, G: 116, B: 195, A: 255},
 			darkColorANSI:  140,
 			lightColor:     &color.RGBA{R: 107, G: 77, B: 131, A: 255},
 			lightColorANSI: 96,
 		},
 		"htm": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "htm",
 			name:           "Htm",
 			glyph:          seti.Html,
+			glyphClass:     seti.Class,
+			glyphID:        "html",
 			darkColor:      &color.RGBA{R: 227, G: 76, B: 38, A: 255},
 			darkColorANSI:  196,
 			lightColor:     &color.RGBA{R: 170, G: 56, B: 28, A: 255},
 			lightColorANSI: 124,
 		},
 		"html": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "html",
 			name:           "Html",
 			glyph:          dev.Html5,
+			glyphClass:     dev.Class,
+			glyphID:        "html5",
 			darkColor:      &color.RGBA{R: 227, G: 77, B: 38, A: 255},
 			darkColorANSI:  196,
 			lightColor:     &color.RGBA{R: 171, G: 58, B: 28, A: 255},
 			lightColorANSI: 124,
 		},
 		"http": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "http",
 			name:           "HTTP",
 			glyph:          fa.PaperPlane,
+			glyphClass:     fa.Class,
+			glyphID:        "paper_plane",
 			darkColor:      &color.RGBA{R: 0, G: 142, B: 199, A: 255},
 			darkColorANSI:  31,
 			lightColor:     &color.RGBA{R: 0, G: 105, B: 149, A: 255},
 			lightColorANSI: 24,
 		},
 		"huff": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "huff",
 			name:           "Huff",
 			glyph:          md.ChessKnight,
+			glyphClass:     md.Class,
+			glyphID:        "chess_knight",
 			darkColor:      &color.RGBA{R: 65, G: 65, B: 199, A: 255},
 			darkColorANSI:  56,
 			lightColor:     &color.RGBA{R: 65, G: 65, B: 199, A: 255},
 			lightColorANSI: 56,
 		},
 		"hurl": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "hurl",
 			name:           "Hurl",
 			glyph:          fa.ArrowRightArrowLeft,
+			glyphClass:     fa.Class,
+			glyphID:        "arrow_right_arrow_left",
 			darkColor:      &color.RGBA{R: 255, G: 2, B: 136, A: 255},
 			darkColorANSI:  198,
 			lightColor:     &color.RGBA{R: 191, G: 2, B: 102, A: 255},
 			lightColorANSI: 125,
 		},
 		"hx": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "hx",
 			name:           "Haxe",
 			glyph:          seti.Haxe,
+			glyphClass:     seti.Class,
+			glyphID:        "haxe",
 			darkColor:      &color.RGBA{R: 234, G: 130, B: 32, A: 255},
 			darkColorANSI:  208,
 			lightColor:     &color.RGBA{R: 156, G: 87, B: 21, A: 255},
 			lightColorANSI: 130,
 		},
 		"hxx": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "hxx",
 			name:           "Hxx",
 			glyph:          fa.HSquare,
+			glyphClass:     fa.Class,
+			glyphID:        "h_square",
 			darkColor:      &color.RGBA{R: 160, G: 116, B: 195, A: 255},
 			darkColorANSI:  140,
 			lightColor:     &color.RGBA{R: 107, G: 77, B: 131, A: 255},
 			lightColorANSI: 96,
 		},
 		"ical": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "ical",
 			name:           "Ical",
 			glyph:          fa.Calendar,
+			glyphClass:     fa.Class,
+			glyphID:        "calendar",
 			darkColor:      &color.RGBA{R: 43, G: 46, B: 131, A: 255},
 			darkColorANSI:  18,
 			lightColor:     &color.RGBA{R: 43, G: 46, B: 131, A: 255},
 			lightColorANSI: 18,
 		},
 		"icalendar": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "icalendar",
 			name:           "Icalendar",
 			glyph:          fa.Calendar,
+			glyphClass:     fa.Class,
+			glyphID:        "calendar",
 			darkColor:      &color.RGBA{R: 43, G: 46, B: 131, A: 255},
 			darkColorANSI:  18,
 			lightColor:     &color.RGBA{R: 43, G: 46, B: 131, A: 255},
 			lightColorANSI: 18,
 		},
 		"ico": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "ico",
 			name:           "Ico",
 			glyph:          seti.Image,
+			glyphClass:     seti.Class,
+			glyphID:        "image",
 			darkColor:      &color.RGBA{R: 203, G: 203, B: 65, A: 255},
 			darkColorANSI:  185,
 			lightColor:     &color.RGBA{R: 102, G: 102, B: 32, A: 255},
 			lightColorANSI: 58,
 		},
 		"ics": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "ics",
 			name:           "Ics",
 			glyph:          fa.Calendar,
+			glyphClass:     fa.Class,
+			glyphID:        "calendar",
 			darkColor:      &color.RGBA{R: 43, G: 46, B: 131, A: 255},
 			darkColorANSI:  18,
 			lightColor:     &color.RGBA{R: 43, G: 46, B: 131, A: 255},
 			lightColorANSI: 18,
 		},
 		"ifb": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "ifb",
 			name:           "Ifb",
 			glyph:          fa.Calendar,
+			glyphClass:     fa.Class,
+			glyphID:        "calendar",
 			darkColor:      &color.RGBA{R: 43, G: 46, B: 131, A: 255},
 			darkColorANSI:  18,
 			lightColor:     &color.RGBA{R: 43, G: 46, B: 131, A: 255},
 			lightColorANSI: 18,
 		},
 		"ifc": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "ifc",
 			name:           "Ifc",
 			glyph:          md.FileCad,
+			glyphClass:     md.Class,
+			glyphID:        "file_cad",
 			darkColor:      &color.RGBA{R: 131, G: 147, B: 99, A: 255},
 			darkColorANSI:  101,
 			lightColor:     &color.RGBA{R: 87, G: 99, B: 65, A: 255},
 			lightColorANSI: 58,
 		},
 		"ige": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "ige",
 			name:           "Ige",
 			glyph:          md.FileCad,
+			glyphClass:     md.Class,
+			glyphID:        "file_cad",
 			darkColor:      &color.RGBA{R: 131, G: 147, B: 99, A: 255},
 			darkColorANSI:  101,
 			lightColor:     &color.RGBA{R: 87, G: 99, B: 65, A: 255},
 			lightColorANSI: 58,
 		},
 		"iges": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "iges",
 			name:           "Iges",
 			glyph:          md.FileCad,
+			glyphClass:     md.Class,
+			glyphID:        "file_cad",
 			darkColor:      &color.RGBA{R: 131, G: 147, B: 99, A: 255},
 			darkColorANSI:  101,
 			lightColor:     &color.RGBA{R: 87, G: 99, B: 65, A: 255},
 			lightColorANSI: 58,
 		},
 		"igs": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "igs",
 			name:           "Igs",
 			glyph:          md.FileCad,
+			glyphClass:     md.Class,
+			glyphID:        "file_cad",
 			darkColor:      &color.RGBA{R: 131, G: 147, B: 99, A: 255},
 			darkColorANSI:  101,
 			lightColor:     &color.RGBA{R: 87, G: 99, B: 65, A: 255},
 			lightColorANSI: 58,
 		},
 		"image": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "image",
 			name:           "Image",
 			glyph:          fae.Disco,
+			glyphClass:     fae.Class,
+			glyphID:        "disco",
 			darkColor:      &color.RGBA{R: 208, G: 190, B: 200, A: 255},
 			darkColorANSI:  181,
 			lightColor:     &color.RGBA{R: 69, G: 63, B: 67, A: 255},
 			lightColorANSI: 238,
 		},
 		"img": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "img",
 			name:           "Img",
 			glyph:          fae.Disco,
+			glyphClass:     fae.Class,
+			glyphID:        "disco",
 			darkColor:      &color.RGBA{R: 208, G: 190, B: 200, A: 255},
 			darkColorANSI:  181,
 			lightColor:     &color.RGBA{R: 69, G: 63, B: 67, A: 255},
 			lightColorANSI: 238,
 		},
 		"import": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "import",
 			name:           "ImportConfiguration",
 			glyph:          fa.Paperclip,
+			glyphClass:     fa.Class,
+			glyphID:        "paperclip",
 			darkColor:      &color.RGBA{R: 236, G: 236, B: 236, A: 255},
 			darkColorANSI:  255,
 			lightColor:     &color.RGBA{R: 47, G: 47, B: 47, A: 255},
 			lightColorANSI: 236,
 		},
 		"info": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "info",
 			name:           "Info",
 			glyph:          fa.Info,
+			glyphClass:     fa.Class,
+			glyphID:        "info",
 			darkColor:      &color.RGBA{R: 255, G: 255, B: 205, A: 255},
 			darkColorANSI:  230,
 			lightColor:     &color.RGBA{R: 51, G: 51, B: 40, A: 255},
 			lightColorANSI: 236,
 		},
 		"ini": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "ini",
 			name:           "Ini",
 			glyph:          seti.Config,
+			glyphClass:     seti.Class,
+			glyphID:        "config",
 			darkColor:      &color.RGBA{R: 109, G: 128, B: 134, A: 255},
 			darkColorANSI:  66,
 			lightColor:     &color.RGBA{R: 81, G: 96, B: 100, A: 255},
 			lightColorANSI: 59,
 		},
 		"ino": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "ino",
 			name:           "Arduino",
 			glyph:          linux.Arduino,
+			glyphClass:     linux.Class,
+			glyphID:        "arduino",
 			darkColor:      &color.RGBA{R: 86, G: 182, B: 194, A: 255},
 			darkColorANSI:  73,
 			lightColor:     &color.RGBA{R: 56, G: 121, B: 129, A: 255},
 			lightColorANSI: 30,
 		},
 		"ipynb": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "ipynb",
 			name:           "Notebook",
 			glyph:          dev.Jupyter,
+			glyphClass:     dev.Class,
+			glyphID:        "jupyter",
 			darkColor:      &color.RGBA{R: 245, G: 125, B: 1, A: 255},
 			darkColorANSI:  208,
 			lightColor:     &color.RGBA{R: 163, G: 83, B: 1, A: 255},
 			lightColorANSI: 130,
 		},
 		"iso": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "iso",
 			name:           "Iso",
 			glyph:          fae.Disco,
+			glyphClass:     fae.Class,
+			glyphID:        "disco",
 			darkColor:      &color.RGBA{R: 208, G: 190, B: 200, A: 255},
 			darkColorANSI:  181,
 			lightColor:     &color.RGBA{R: 69, G: 63, B: 67, A: 255},
 			lightColorANSI: 238,
 		},
 		"ixx": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "ixx",
 			name:           "Ixx",
 			glyph:          custom.Cpp,
+			glyphClass:     custom.Class,
+			glyphID:        "cpp",
 			darkColor:      &color.RGBA{R: 81, G: 154, B: 186, A: 255},
 			darkColorANSI:  74,
 			lightColor:     &color.RGBA{R: 54, G: 103, B: 124, A: 255},
 			lightColorANSI: 24,
 		},
 		"jar": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "jar",
 			name:           "Jar",
 			glyph:          dev.Java,
+			glyphClass:     dev.Class,
+			glyphID:        "java",
 			darkColor:      &color.RGBA{R: 255, G: 175, B: 103, A: 255},
 			darkColorANSI:  215,
 			lightColor:     &color.RGBA{R: 128, G: 88, B: 52, A: 255},
 			lightColorANSI: 94,
 		},
 		"java": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "java",
 			name:           "Java",
 			glyph:          dev.Java,
+			glyphClass:     dev.Class,
+			glyphID:        "java",
 			darkColor:      &color.RGBA{R: 204, G: 62, B: 68, A: 255},
 			darkColorANSI:  167,
 			lightColor:     &color.RGBA{R: 153, G: 46, B: 51, A: 255},
 			lightColorANSI: 88,
 		},
 		"jl": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "jl",
 			name:           "Jl",
 			glyph:          seti.Julia,
+			glyphClass:     seti.Class,
+			glyphID:        "julia",
 			darkColor:      &color.RGBA{R: 162, G: 112, B: 186, A: 255},
 			darkColorANSI:  133,
 			lightColor:     &color.RGBA{R: 108, G: 75, B: 124, A: 255},
 			lightColorANSI: 96,
 		},
 		"jpeg": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "jpeg",
 			name:           "Jpeg",
 			glyph:          seti.Image,
+			glyphClass:     seti.Class,
+			glyphID:        "image",
 			darkColor:      &color.RGBA{R: 160, G: 116, B: 195, A: 255},
 			darkColorANSI:  140,
 			lightColor:     &color.RGBA{R: 107, G: 77, B: 131, A: 255},
 			lightColorANSI: 96,
 		},
 		"jpg": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "jpg",
 			name:           "Jpg",
 			glyph:          seti.Image,
+			glyphClass:     seti.Class,
+			glyphID:        "image",
 			darkColor:      &color.RGBA{R: 160, G: 116, B: 195, A: 255},
 			darkColorANSI:  140,
 			lightColor:     &color.RGBA{R: 107, G: 77, B: 131, A: 255},
 			lightColorANSI: 96,
 		},
 		"js": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "js",
 			name:           "Js",
 			glyph:          seti.Javascript,
+			glyphClass:     seti.Class,
+			glyphID:        "javascript",
 			darkColor:      &color.RGBA{R: 203, G: 203, B: 65, A: 255},
 			darkColorANSI:  185,
 			lightColor:     &color.RGBA{R: 102, G: 102, B: 32, A: 255},
 			lightColorANSI: 58,
 		},
 		"json5": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "json5",
 			name:           "Json5",
 			glyph:          seti.Json,
+			glyphClass:     seti.Class,
+			glyphID:        "json",
 			darkColor:      &color.RGBA{R: 203, G: 203, B: 65, A: 255},
 			darkColorANSI:  185,
 			lightColor:     &color.RGBA{R: 102, G: 102, B: 32, A: 255},
 			lightColorANSI: 58,
 		},
 		"json": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "json",
 			name:           "Json",
 			glyph:          seti.Json,
+			glyphClass:     seti.Class,
+			glyphID:        "json",
 			darkColor:      &color.RGBA{R: 203, G: 203, B: 65, A: 255},
 			darkColorANSI:  185,
 			lightColor:     &color.RGBA{R: 102, G: 102, B: 32, A: 255},
 			lightColorANSI: 58,
 		},
 		"jsonc": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "jsonc",
 			name:           "Jsonc",
 			glyph:          seti.Json,
+			glyphClass:     seti.Class,
+			glyphID:        "json",
 			darkColor:      &color.RGBA{R: 203, G: 203, B: 65, A: 255},
 			darkColorANSI:  185,
 			lightColor:     &color.RGBA{R: 102, G: 102, B: 32, A: 255},
 			lightColorANSI: 58,
 		},
 		"jsx": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "jsx",
 			name:           "Jsx",
 			glyph:          seti.React,
+			glyphClass:     seti.Class,
+			glyphID:        "react",
 			darkColor:      &color.RGBA{R: 32, G: 194, B: 227, A: 255},
 			darkColorANSI:  45,
 			lightColor:     &color.RGBA{R: 21, G: 129, B: 151, A: 255},
 			lightColorANSI: 31,
 		},
 		"jwmrc": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "jwmrc",
 			name:           "JWM",
 			glyph:          linux.Jwm,
+			glyphClass:     linux.Class,
+			glyphID:        "jwm",
 			darkColor:      &color.RGBA{R: 0, G: 120, B: 205, A: 255},
 			darkColorANSI:  32,
 			lightColor:     &color.RGBA{R: 0, G: 89, B: 154, A: 255},
 			lightColorANSI: 25,
 		},
 		"jxl": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "jxl",
 			name:           "JpegXl",
 			glyph:          seti.Image,
+			glyphClass:     seti.Class,
+			glyphID:        "image",
 			darkColor:      &color.RGBA{R: 160, G: 116, B: 195, A: 255},
 			darkColorANSI:  140,
 			lightColor:     &color.RGBA{R: 107, G: 77, B: 131, A: 255},
 			lightColorANSI: 96,
 		},
 		"kbx": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "kbx",
 			name:           "Kbx",
 			glyph:          md.ShieldKey,
+			glyphClass:     md.Class,
+			glyphID:        "shield_key",
 			darkColor:      &color.RGBA{R: 115, G: 118, B: 113, A: 255},
 			darkColorANSI:  243,
 			lightColor:     &color.RGBA{R: 86, G: 88, B: 86, A: 255},
 			lightColorANSI: 240,
 		},
 		"kdb": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "kdb",
 			name:           "Kdb",
 			glyph:          fa.Expeditedssl,
+			glyphClass:     fa.Class,
+			glyphID:        "expeditedssl",
 			darkColor:      &color.RGBA{R: 81, G: 155, B: 52, A: 255},
 			darkColorANSI:  71,
 			lightColor:     &color.RGBA{R: 62, G: 116, B: 39, A: 255},
 			lightColorANSI: 28,
 		},
 		"kdbx": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "kdbx",
 			name:           "Kdbx",
 			glyph:          fa.Expeditedssl,
+			glyphClass:     fa.Class,
+			glyphID:        "expeditedssl",
 			darkColor:      &color.RGBA{R: 81, G: 155, B: 52, A: 255},
 			darkColorANSI:  71,
 			lightColor:     &color.RGBA{R: 62, G: 116, B: 39, A: 255},
 			lightColorANSI: 28,
 		},
 		"kdenlive": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "kdenlive",
 			name:           "Kdenlive",
 			glyph:          linux.Kdenlive,
+			glyphClass:     linux.Class,
+			glyphID:        "kdenlive",
 			darkColor:      &color.RGBA{R: 131, G: 184, B: 242, A: 255},
 			darkColorANSI:  110,
 			lightColor:     &color.RGBA{R: 65, G: 92, B: 121, A: 255},
 			lightColorANSI: 24,
 		},
 		"kdenlivetitle": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "kdenlivetitle",
 			name:           "Kdenlive",
 			glyph:          linux.Kdenlive,
+			glyphClass:     linux.Class,
+			glyphID:        "kdenlive",
 			darkColor:      &color.RGBA{R: 131, G: 184, B: 242, A: 255},
 			darkColorANSI:  110,
 			lightColor:     &color.RGBA{R: 65, G: 92, B: 121, A: 255},
 			lightColorANSI: 24,
 		},
 		"kicad_dru": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "kicad_dru",
 			name:           "KiCad",
 			glyph:          linux.Kicad,
+			glyphClass:     linux.Class,
+			glyphID:        "kicad",
 			darkColor:      &color.RGBA{R: 255, G: 255, B: 255, A: 255},
 			darkColorANSI:  231,
 			lightColor:     &color.RGBA{R: 51, G: 51, B: 51, A: 255},
 			lightColorANSI: 236,
 		},
 		"kicad_mod": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "kicad_mod",
 			name:           "KiCad",
 			glyph:          linux.Kicad,
+			glyphClass:     linux.Class,
+			glyphID:        "kicad",
 			darkColor:      &color.RGBA{R: 255, G: 255, B: 255, A: 255},
 			darkColorANSI:  231,
 			lightColor:     &color.RGBA{R: 51, G: 51, B: 51, A: 255},
 			lightColorANSI: 236,
 		},
 		"kicad_pcb": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "kicad_pcb",
 			name:           "KiCad",
 			glyph:          linux.Kicad,
+			glyphClass:     linux.Class,
+			glyphID:        "kicad",
 			darkColor:      &color.RGBA{R: 255, G: 255, B: 255, A: 255},
 			darkColorANSI:  231,
 			lightColor:     &color.RGBA{R: 51, G: 51, B: 51, A: 255},
 			lightColorANSI: 236,
 		},
 		"kicad_prl": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "kicad_prl",
 			name:           "KiCad",
 			glyph:          linux.Kicad,
+			glyphClass:     linux.Class,
+			glyphID:        "kicad",
 			darkColor:      &color.RGBA{R: 255, G: 255, B: 255, A: 255},
 			darkColorANSI:  231,
 			lightColor:     &color.RGBA{R: 51, G: 51, B: 51, A: 255},
 			lightColorANSI: 236,
 		},
 		"kicad_pro": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "kicad_pro",
 			name:           "KiCad",
 			glyph:          linux.Kicad,
+			glyphClass:     linux.Class,
+			glyphID:        "kicad",
 			darkColor:      &color.RGBA{R: 255, G: 255, B: 255, A: 255},
 			darkColorANSI:  231,
 			lightColor:     &color.RGBA{R: 51, G: 51, B: 51, A: 255},
 			lightColorANSI: 236,
 		},
 		"kicad_sch": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "kicad_sch",
 			name:           "KiCad",
 			glyph:          linux.Kicad,
+			glyphClass:     linux.Class,
+			glyphID:        "kicad",
 			darkColor:      &color.RGBA{R: 255, G: 255, B: 255, A: 255},
 			darkColorANSI:  231,
 			lightColor:     &color.RGBA{R: 51, G: 51, B: 51, A: 255},
 			lightColorANSI: 236,
 		},
 		"kicad_sym": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "kicad_sym",
 			name:           "KiCad",
 			glyph:          linux.Kicad,
+			glyphClass:     linux.Class,
+			glyphID:        "kicad",
 			darkColor:      &color.RGBA{R: 255, G: 255, B: 255, A: 255},
 			darkColorANSI:  231,
 			lightColor:     &color.RGBA{R: 51, G: 51, B: 51, A: 255},
 			lightColorANSI: 236,
 		},
 		"kicad_wks": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "kicad_wks",
 			name:           "KiCad",
 			glyph:          linux.Kicad,
+			glyphClass:     linux.Class,
+			glyphID:        "kicad",
 			darkColor:      &color.RGBA{R: 255, G: 255, B: 255, A: 255},
 			darkColorANSI:  231,
 			lightColor:     &color.RGBA{R: 51, G: 51, B: 51, A: 255},
 			lightColorANSI: 236,
 		},
 		"ko": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "ko",
 			name:           "LinuxKernelObject",
 			glyph:          fa.Linux,
+			glyphClass:     fa.Class,
+			glyphID:        "linux",
 			darkColor:      &color.RGBA{R: 220, G: 221, B: 214, A: 255},
 			darkColorANSI:  253,
 			lightColor:     &color.RGBA{R: 73, G: 73, B: 71, A: 255},
 			lightColorANSI: 239,
 		},
 		"kpp": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "kpp",
 			name:           "Krita",
 			glyph:          linux.Krita,
+			glyphClass:     linux.Class,
+			glyphID:        "krita",
 			darkColor:      &color.RGBA{R: 242, G: 69, B: 251, A: 255},
 			darkColorANSI:  201,
 			lightColor:     &color.RGBA{R: 161, G: 46, B: 167, A: 255},
 			lightColorANSI: 127,
 		},
 		"kra": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "kra",
 			name:           "Krita",
 			glyph:          linux.Krita,
+			glyphClass:     linux.Class,
+			glyphID:        "krita",
 			darkColor:      &color.RGBA{R: 242, G: 69, B: 251, A: 255},
 			darkColorANSI:  201,
 			lightColor:     &color.RGBA{R: 161, G: 46, B: 167, A: 255},
 			lightColorANSI: 127,
 		},
 		"krz": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "krz",
 			name:           "Krita",
 			glyph:          linux.Krita,
+			glyphClass:     linux.Class,
+			glyphID:        "krita",
 			darkColor:      &color.RGBA{R: 242, G: 69, B: 251, A: 255},
 			darkColorANSI:  201,
 			lightColor:     &color.RGBA{R: 161, G: 46, B: 167, A: 255},
 			lightColorANSI: 127,
 		},
 		"ksh": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "ksh",
 			name:           "Ksh",
 			glyph:          dev.Terminal,
+			glyphClass:     dev.Class,
+			glyphID:        "terminal",
 			darkColor:      &color.RGBA{R: 77, G: 89, B: 94, A: 255},
 			darkColorANSI:  240,
 			lightColor:     &color.RGBA{R: 58, G: 68, B: 70, A: 255},
 			lightColorANSI: 238,
 		},
 		"kt": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "kt",
 			name:           "Kotlin",
 			glyph:          custom.Kotlin,
+			glyphClass:     custom.Class,
+			glyphID:        "kotlin",
 			darkColor:      &color.RGBA{R: 127, G: 81, B: 255, A: 255},
 			darkColorANSI:  99,
 			lightColor:     &color.RGBA{R: 95, G: 62, B: 191, A: 255},
 			lightColorANSI: 92,
 		},
 		"kts": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "kts",
 			name:           "KotlinScript",
 			glyph:          custom.Kotlin,
+			glyphClass:     custom.Class,
+			glyphID:        "kotlin",
 			darkColor:      &color.RGBA{R: 127, G: 81, B: 255, A: 255},
 			darkColorANSI:  99,
 			lightColor:     &color.RGBA{R: 95, G: 62, B: 191, A: 255},
 			lightColorANSI: 92,
 		},
 		"lck": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "lck",
 			name:           "Lock",
 			glyph:          seti.Lock,
+			glyphClass:     seti.Class,
+			glyphID:        "lock",
 			darkColor:      &color.RGBA{R: 187, G: 187, B: 187, A: 255},
 			darkColorANSI:  250,
 			lightColor:     &color.RGBA{R: 94, G: 94, B: 94, A: 255},
 			lightColorANSI: 59,
 		},
 		"leex": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "leex",
 			name:           "Leex",
 			glyph:          custom.Elixir,
+			glyphClass:     custom.Class,
+			glyphID:        "elixir",
 			darkColor:      &color.RGBA{R: 160, G: 116, B: 195, A: 255},
 			darkColorANSI:  140,
 			lightColor:     &color.RGBA{R: 107, G: 77, B: 131, A: 255},
 			lightColorANSI: 96,
 		},
 		"less": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "less",
 			name:           "Less",
 			glyph:          seti.Css,
+			glyphClass:     seti.Class,
+			glyphID:        "css",
 			darkColor:      &color.RGBA{R: 86, G: 60, B: 124, A: 255},
 			darkColorANSI:  54,
 			lightColor:     &color.RGBA{R: 86, G: 60, B: 124, A: 255},
 			lightColorANSI: 54,
 		},
 		"lff": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "lff",
 			name:           "LibrecadFontFile",
 			glyph:          fa.Font,
+			glyphClass:     fa.Class,
+			glyphID:        "font",
 			darkColor:      &color.RGBA{R: 236, G: 236, B: 236, A: 255},
 			darkColorANSI:  255,
 			lightColor:     &color.RGBA{R: 47, G: 47, B: 47, A: 255},
 			lightColorANSI: 236,
 		},
 		"lhs": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "lhs",
 			name:           "Lhs",
 			glyph:          seti.Haskell,
+			glyphClass:     seti.Class,
+			glyphID:        "haskell",
 			darkColor:      &color.RGBA{R: 160, G: 116, B: 195, A: 255},
 			darkColorANSI:  140,
 			lightColor:     &color.RGBA{R: 107, G: 77, B: 131, A: 255},
 			lightColorANSI: 96,
 		},
 		"lib": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "lib",
 			name:           "Lib",
 			glyph:          cod.Library,
+			glyphClass:     cod.Class,
+			glyphID:        "library",
 			darkColor:      &color.RGBA{R: 77, G: 44, B: 11, A: 255},
 			darkColorANSI:  52,
 			lightColor:     &color.RGBA{R: 77, G: 44, B: 11, A: 255},
 			lightColorANSI: 52,
 		},
 		"license": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "license",
 			name:           "License",
 			glyph:          seti.License,
+			glyphClass:     seti.Class,
+			glyphID:        "license",
 			darkColor:      &color.RGBA{R: 203, G: 203, B: 65, A: 255},
 			darkColorANSI:  185,
 			lightColor:     &color.RGBA{R: 102, G: 102, B: 32, A: 255},
 			lightColorANSI: 58,
 		},
 		"liquid": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "liquid",
 			name:           "Liquid",
 			glyph:          seti.Liquid,
+			glyphClass:     seti.Class,
+			glyphID:        "liquid",
 			darkColor:      &color.RGBA{R: 149, G: 191, B: 71, A: 255},
 			darkColorANSI:  106,
 			lightColor:     &color.RGBA{R: 73, G: 96, B: 36, A: 255},
 			lightColorANSI: 58,
 		},
 		"lock": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "lock",
 			name:           "Lock",
 			glyph:          seti.Lock,
+			glyphClass:     seti.Class,
+			glyphID:        "lock",
 			darkColor:      &color.RGBA{R: 187, G: 187, B: 187, A: 255},
 			darkColorANSI:  250,
 			lightColor:     &color.RGBA{R: 94, G: 94, B: 94, A: 255},
 			lightColorANSI: 59,
 		},
 		"log": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "log",
 			name:           "Log",
 			glyph:          md.Library,
+			glyphClass:     md.Class,
+			glyphID:        "library",
 			darkColor:      &color.RGBA{R: 221, G: 221, B: 221, A: 255},
 			darkColorANSI:  253,
 			lightColor:     &color.RGBA{R: 73, G: 73, B: 73, A: 255},
 			lightColorANSI: 239,
 		},
 		"lrc": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "lrc",
 			name:           "Lrc",
 			glyph:          md.Subtitles,
+			glyphClass:     md.Class,
+			glyphID:        "subtitles",
 			darkColor:      &color.RGBA{R: 255, G: 183, B: 19, A: 255},
 			darkColorANSI:  214,
 			lightColor:     &color.RGBA{R: 128, G: 92, B: 10, A: 255},
 			lightColorANSI: 94,
 		},
 		"lua": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "lua",
 			name:           "Lua",
 			glyph:          seti.Lua,
+			glyphClass:     seti.Class,
+			glyphID:        "lua",
 			darkColor:      &color.RGBA{R: 81, G: 160, B: 207, A: 255},
 			darkColorANSI:  74,
 			lightColor:     &color.RGBA{R: 54, G: 107, B: 138, A: 255},
 			lightColorANSI: 24,
 		},
 		"luac": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "luac",
 			name:           "Lua",
 			glyph:          seti.Lua,
+			glyphClass:     seti.Class,
+			glyphID:        "lua",
 			darkColor:      &color.RGBA{R: 81, G: 160, B: 207, A: 255},
 			darkColorANSI:  74,
 			lightColor:     &color.RGBA{R: 54, G: 107, B: 138, A: 255},
 			lightColorANSI: 24,
 		},
 		"luau": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "luau",
 			name:           "Luau",
 			glyph:          seti.Lua,
+			glyphClass:     seti.Class,
+			glyphID:        "lua",
 			darkColor:      &color.RGBA{R: 0, G: 162, B: 255, A: 255},
 			darkColorANSI:  75,
 			lightColor:     &color.RGBA{R: 0, G: 121, B: 191, A: 255},
 			lightColorANSI: 32,
 		},
 		"m3u8": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "m3u8",
 			name:           "M3u8",
 			glyph:          md.PlaylistMusicOutline,
+			glyphClass:     md.Class,
+			glyphID:        "playlist_music_outline",
 			darkColor:      &color.RGBA{R: 237, G: 149, B: 174, A: 255},
 			darkColorANSI:  211,
 			lightColor:     &color.RGBA{R: 118, G: 73, B: 87, A: 255},
 			lightColorANSI: 95,
 		},
 		"m3u": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "m3u",
 			name:           "M3u",
 			glyph:          md.PlaylistMusicOutline,
+			glyphClass:     md.Class,
+			glyphID:        "playlist_music_outline",
 			darkColor:      &color.RGBA{R: 237, G: 149, B: 174, A: 255},
 			darkColorANSI:  211,
 			lightColor:     &color.RGBA{R: 118, G: 73, B: 87, A: 255},
 			lightColorANSI: 95,
 		},
 		"m4a": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "m4a",
 			name:           "MPEG4",
 			glyph:          fa.Music,
+			glyphClass:     fa.Class,
+			glyphID:        "music",
 			darkColor:      &color.RGBA{R: 0, G: 175, B: 255, A: 255},
 			darkColorANSI:  39,
 			lightColor:     &color.RGBA{R: 0, G: 117, B: 170, A: 255},
 			lightColorANSI: 24,
 		},
 		"m4v": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "m4v",
 			name:           "M4V",
 			glyph:          seti.Video,
+			glyphClass:     seti.Class,
+			glyphID:        "video",
 			darkColor:      &color.RGBA{R: 253, G: 151, B: 31, A: 255},
 			darkColorANSI:  208,
 			lightColor:     &color.RGBA{R: 126, G: 76, B: 16, A: 255},
 			lightColorANSI: 94,
 		},
 		"m": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "m",
 			name:           "ObjectiveC",
 			glyph:          custom.C,
+			glyphClass:     custom.Class,
+			glyphID:        "c",
 			darkColor:      &color.RGBA{R: 89, G: 158, B: 255, A: 255},
 			darkColorANSI:  111,
 			lightColor:     &color.RGBA{R: 59, G: 105, B: 170, A: 255},
 			lightColorANSI: 25,
 		},
 		"magnet": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "magnet",
 			name:           "Magnet",
 			glyph:          fa.Magnet,
+			glyphClass:     fa.Class,
+			glyphID:        "magnet",
 			darkColor:      &color.RGBA{R: 165, G: 27, B: 22, A: 255},
 			darkColorANSI:  124,
 			lightColor:     &color.RGBA{R: 165, G: 27, B: 22, A: 255},
 			lightColorANSI: 124,
 		},
 		"makefile": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "makefile",
 			name:           "Makefile",
 			glyph:          dev.Gnu,
+			glyphClass:     dev.Class,
+			glyphID:        "gnu",
 			darkColor:      &color.RGBA{R: 109, G: 128, B: 134, A: 255},
 			darkColorANSI:  66,
 			lightColor:     &color.RGBA{R: 81, G: 96, B: 100, A: 255},
 			lightColorANSI: 59,
 		},
 		"markdown": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "markdown",
 			name:           "Markdown",
 			glyph:          seti.Markdown,
+			glyphClass:     seti.Class,
+			glyphID:        "markdown",
 			darkColor:      &color.RGBA{R: 221, G: 221, B: 221, A: 255},
 			darkColorANSI:  253,
 			lightColor:     &color.RGBA{R: 73, G: 73, B: 73, A: 255},
 			lightColorANSI: 239,
 		},
 		"material": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "material",
 			name:           "Material",
 			glyph:          fae.Mountains,
+			glyphClass:     fae.Class,
+			glyphID:        "mountains",
 			darkColor:      &color.RGBA{R: 184, G: 56, B: 152, A: 255},
 			darkColorANSI:  163,
 			lightColor:     &color.RGBA{R: 138, G: 43, B: 113, A: 255},
 			lightColorANSI: 89,
 		},
 		"md5": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "md5",
 			name:           "Md5",
 			glyph:          md.ShieldCheck,
+			glyphClass:     md.Class,
+			glyphID:        "shield_check",
 			darkColor:      &color.RGBA{R: 140, G: 134, B: 175, A: 255},
 			darkColorANSI:  103,
 			lightColor:     &color.RGBA{R: 93, G: 89, B: 117, A: 255},
 			lightColorANSI: 60,
 		},
 		"md": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "md",
 			name:           "Md",
 			glyph:          oct.Markdown,
+			glyphClass:     oct.Class,
+			glyphID:        "markdown",
 			darkColor:      &color.RGBA{R: 221, G: 221, B: 221, A: 255},
 			darkColorANSI:  253,
 			lightColor:     &color.RGBA{R: 73, G: 73, B: 73, A: 255},
 			lightColorANSI: 239,
 		},
 		"mdx": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "mdx",
 			name:           "Mdx",
 			glyph:          oct.Markdown,
+			glyphClass:     oct.Class,
+			glyphID:        "markdown",
 			darkColor:      &color.RGBA{R: 81, G: 154, B: 186, A: 255},
 			darkColorANSI:  74,
 			lightColor:     &color.RGBA{R: 54, G: 103, B: 124, A: 255},
 			lightColorANSI: 24,
 		},
 		"mint": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "mint",
 			name:           "Mint",
 			glyph:          md.Leaf,
+			glyphClass:     md.Class,
+			glyphID:        "leaf",
 			darkColor:      &color.RGBA{R: 135, G: 192, B: 149, A: 255},
 			darkColorANSI:  108,
 			lightColor:     &color.RGBA{R: 68, G: 96, B: 73, A: 255},
 			lightColorANSI: 23,
 		},
 		"mjs": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "mjs",
 			name:           "Mjs",
 			glyph:          seti.Javascript,
+			glyphClass:     seti.Class,
+			glyphID:        "javascript",
 			darkColor:      &color.RGBA{R: 241, G: 224, B: 89, A: 255},
 			darkColorANSI:  185,
 			lightColor:     &color.RGBA{R: 80, G: 75, B: 30, A: 255},
 			lightColorANSI: 58,
 		},
 		"mk": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "mk",
 			name:           "Makefile",
 			glyph:          dev.Gnu,
+			glyphClass:     dev.Class,
+			glyphID:        "gnu",
 			darkColor:      &color.RGBA{R: 109, G: 128, B: 134, A: 255},
 			darkColorANSI:  66,
 			lightColor:     &color.RGBA{R: 81, G: 96, B: 100, A: 255},
 			lightColorANSI: 59,
 		},
 		"mkv": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "mkv",
 			name:           "Mkv",
 			glyph:          seti.Video,
+			glyphClass:     seti.Class,
+			glyphID:        "video",
 			darkColor:      &color.RGBA{R: 253, G: 151, B: 31, A: 255},
 			darkColorANSI:  208,
 			lightColor:     &color.RGBA{R: 126, G: 76, B: 16, A: 255},
 			lightColorANSI: 94,
 		},
 		"ml": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "ml",
 			name:           "Ml",
 			glyph:          seti.Ocaml,
+			glyphClass:     seti.Class,
+			glyphID:        "ocaml",
 			darkColor:      &color.RGBA{R: 227, G: 121, B: 51, A: 255},
 			darkColorANSI:  166,
 			lightColor:     &color.RGBA{R: 151, G: 81, B: 34, A: 255},
 			lightColorANSI: 130,
 		},
 		"mli": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "mli",
 			name:           "Mli",
 			glyph:          seti.Ocaml,
+			glyphClass:     seti.Class,
+			glyphID:        "ocaml",
 			darkColor:      &color.RGBA{R: 227, G: 121, B: 51, A: 255},
 			darkColorANSI:  166,
 			lightColor:     &color.RGBA{R: 151, G: 81, B: 34, A: 255},
 			lightColorANSI: 130,
 		},
 		"mm": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "mm",
 			name:           "ObjectiveCPlusPlus",
 			glyph:          custom.Cpp,
+			glyphClass:     custom.Class,
+			glyphID:        "cpp",
 			darkColor:      &color.RGBA{R: 81, G: 154, B: 186, A: 255},
 			darkColorANSI:  74,
 			lightColor:     &color.RGBA{R: 54, G: 103, B: 124, A: 255},
 			lightColorANSI: 24,
 		},
 		"mo": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "mo",
 			name:           "Motoko",
 			glyph:          fa.Infinity,
+			glyphClass:     fa.Class,
+			glyphID:        "infinity",
 			darkColor:      &color.RGBA{R: 151, G: 113, B: 251, A: 255},
 			darkColorANSI:  135,
 			lightColor:     &color.RGBA{R: 101, G: 76, B: 167, A: 255},
 			lightColorANSI: 61,
 		},
 		"mobi": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "mobi",
 			name:           "Mobi",
 			glyph:          fae.BookOpenO,
+			glyphClass:     fae.Class,
+			glyphID:        "book_open_o",
 			darkColor:      &color.RGBA{R: 234, G: 177, B: 109, A: 255},
 			darkColorANSI:  215,
 			lightColor:     &color.RGBA{R: 117, G: 88, B: 54, A: 255},
 			lightColorANSI: 94,
 		},
 		"mojo": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "mojo",
 			name:           "Mojo",
 			glyph:          fa.Fire,
+			glyphClass:     fa.Class,
+			glyphID:        "fire",
 			darkColor:      &color.RGBA{R: 255, G: 76, B: 31, A: 255},
 			darkColorANSI:  196,
 			lightColor:     &color.RGBA{R: 191, G: 56, B: 23, A: 255},
 			lightColorANSI: 160,
 		},
 		"mov": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "mov",
 			name:           "MOV",
 			glyph:          seti.Video,
+			glyphClass:     seti.Class,
+			glyphID:        "video",
 			darkColor:      &color.RGBA{R: 253, G: 151, B: 31, A: 255},
 			darkColorANSI:  208,
 			lightColor:     &color.RGBA{R: 126, G: 76, B: 16, A: 255},
 			lightColorANSI: 94,
 		},
 		"mp3": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "mp3",
 			name:           "MPEGAudioLayerIII",
 			glyph:          fa.Music,
+			glyphClass:     fa.Class,
+			glyphID:        "music",
 			darkColor:      &color.RGBA{R: 0, G: 175, B: 255, A: 255},
 			darkColorANSI:  39,
 			lightColor:     &color.RGBA{R: 0, G: 117, B: 170, A: 255},
 			lightColorANSI: 24,
 		},
 		"mp4": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "mp4",
 			name:           "Mp4",
 			glyph:          seti.Video,
+			glyphClass:     seti.Class,
+			glyphID:        "video",
 			darkColor:      &color.RGBA{R: 253, G: 151, B: 31, A: 255},
 			darkColorANSI:  208,
 			lightColor:     &color.RGBA{R: 126, G: 76, B: 16, A: 255},
 			lightColorANSI: 94,
 		},
 		"mpp": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "mpp",
 			name:           "Mpp",
 			glyph:          custom.Cpp,
+			glyphClass:     custom.Class,
+			glyphID:        "cpp",
 			darkColor:      &color.RGBA{R: 81, G: 154, B: 186, A: 255},
 			darkColorANSI:  74,
 			lightColor:     &color.RGBA{R: 54, G: 103, B: 124, A: 255},
 			lightColorANSI: 24,
 		},
 		"msf": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "msf",
 			name:           "Thunderbird",
 			glyph:          linux.Thunderbird,
+			glyphClass:     linux.Class,
+			glyphID:        "thunderbird",
 			darkColor:      &color.RGBA{R: 19, G: 123, B: 225, A: 255},
 			darkColorANSI:  33,
 			lightColor:     &color.RGBA{R: 14, G: 92, B: 169, A: 255},
 			lightColorANSI: 25,
 		},
 		"mts": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "mts",
 			name:           "Mts",
 			glyph:          seti.Typescript,
+			glyphClass:     seti.Class,
+			glyphID:        "typescript",
 			darkColor:      &color.RGBA{R: 81, G: 154, B: 186, A: 255},
 			darkColorANSI:  74,
 			lightColor:     &color.RGBA{R: 54, G: 103, B: 124, A: 255},
 			lightColorANSI: 24,
 		},
 		"mustache": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "mustache",
 			name:           "Mustache",
 			glyph:          seti.Mustache,
+			glyphClass:     seti.Class,
+			glyphID:        "mustache",
 			darkColor:      &color.RGBA{R: 227, G: 121, B: 51, A: 255},
 			darkColorANSI:  166,
 			lightColor:     &color.RGBA{R: 151, G: 81, B: 34, A: 255},
 			lightColorANSI: 130,
 		},
 		"nfo": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "nfo",
 			name:           "Nfo",
 			glyph:          fa.Info,
+			glyphClass:     fa.Class,
+			glyphID:        "info",
 			darkColor:      &color.RGBA{R: 255, G: 255, B: 205, A: 255},
 			darkColorANSI:  230,
 			lightColor:     &color.RGBA{R: 51, G: 51, B: 40, A: 255},
 			lightColorANSI: 236,
 		},
 		"nim": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "nim",
 			name:           "Nim",
 			glyph:          seti.Nim,
+			glyphClass:     seti.Class,
+			glyphID:        "nim",
 			darkColor:      &color.RGBA{R: 243, G: 211, B: 0, A: 255},
 			darkColorANSI:  220,
 			lightColor:     &color.RGBA{R: 81, G: 71, B: 0, A: 255},
 			lightColorANSI: 58,
 		},
 		"nix": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "nix",
 			name:           "Nix",
 			glyph:          linux.Nixos,
+			glyphClass:     linux.Class,
+			glyphID:        "nixos",
 			darkColor:      &color.RGBA{R: 126, G: 186, B: 227, A: 255},
 			darkColorANSI:  110,
 			lightColor:     &color.RGBA{R: 63, G: 93, B: 113, A: 255},
 			lightColorANSI: 24,
 		},
 		"norg": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "norg",
 			name:           "Norg",
 			glyph:          dev.Norg,
+			glyphClass:     dev.Class,
+			glyphID:        "norg",
 			darkColor:      &color.RGBA{R: 72, G: 120, B: 190, A: 255},
 			darkColorANSI:  32,
 			lightColor:     &color.RGBA{R: 54, G: 89, B: 142, A: 255},
 			lightColorANSI: 25,
 		},
 		"nswag": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "nswag",
 			name:           "Nswag",
 			glyph:          seti.Json,
+			glyphClass:     seti.Class,
+			glyphID:        "json",
 			darkColor:      &color.RGBA{R: 133, G: 234, B: 44, A: 255},
 			darkColorANSI:  112,
 			lightColor:     &color.RGBA{R: 65, G: 117, B: 22, A: 255},
 			lightColorANSI: 28,
 		},
 		"nu": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "nu",
 			name:           "Nushell",
 			glyph:          fa.ChevronRight,
+			glyphClass:     fa.Class,
+			glyphID:        "chevron_right",
 			darkColor:      &color.RGBA{R: 58, G: 166, B: 117, A: 255},
 			darkColorANSI:  36,
 			lightColor:     &color.RGBA{R: 39, G: 111, B: 78, A: 255},
 			lightColorANSI: 29,
 		},
 		"o": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "o",
 			name:           "ObjectFile",
 			glyph:          cod.FileBinary,
+			glyphClass:     cod.Class,
+			glyphID:        "file_binary",
 			darkColor:      &color.RGBA{R: 159, G: 5, B: 0, A: 255},
 			darkColorANSI:  124,
 			lightColor:     &color.RGBA{R: 159, G: 5, B: 0, A: 255},
 			lightColorANSI: 124,
 		},
 		"obj": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "obj",
 			name:           "3DObjectFile",
 			glyph:          md.CubeOutline,
+			glyphClass:     md.Class,
+			glyphID:        "cube_outline",
 			darkColor:      &color.RGBA{R: 136, G: 136, B: 136, A: 255},
 			darkColorANSI:  102,
 			lightColor:     &color.RGBA{R: 91, G: 91, B: 91, A: 255},
 			lightColorANSI: 240,
 		},
 		"odf": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "odf",
 			name:           "LibreOfficeFormula",
 			glyph:          linux.Libreofficemath,
+			glyphClass:     linux.Class,
+			glyphID:        "libreofficemath",
 			darkColor:      &color.RGBA{R: 255, G: 89, B: 150, A: 255},
 			darkColorANSI:  204,
 			lightColor:     &color.RGBA{R: 170, G: 60, B: 100, A: 255},
 			lightColorANSI: 125,
 		},
 		"odg": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "odg",
 			name:           "LibreOfficeGraphics",
 			glyph:          linux.Libreofficedraw,
+			glyphClass:     linux.Class,
+			glyphID:        "libreofficedraw",
 			darkColor:      &color.RGBA{R: 255, G: 251, B: 87, A: 255},
 			darkColorANSI:  227,
 			lightColor:     &color.RGBA{R: 51, G: 50, B: 17, A: 255},
 			lightColorANSI: 236,
 		},
 		"odin": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "odin",
 			name:           "Odin",
 			glyph:          md.Null,
+			glyphClass:     md.Class,
+			glyphID:        "null",
 			darkColor:      &color.RGBA{R: 56, G: 130, B: 210, A: 255},
 			darkColorANSI:  32,
 			lightColor:     &color.RGBA{R: 42, G: 97, B: 158, A: 255},
 			lightColorANSI: 25,
 		},
 		"odp": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "odp",
 			name:           "LibreOfficeImpress",
 			glyph:          linux.Libreofficeimpress,
+			glyphClass:     linux.Class,
+			glyphID:        "libreofficeimpress",
 			darkColor:      &color.RGBA{R: 254, G: 156, B: 69, A: 255},
 			darkColorANSI:  215,
 			lightColor:     &color.RGBA{R: 127, G: 78, B: 34, A: 255},
 			lightColorANSI: 94,
 		},
 		"ods": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "ods",
 			name:           "LibreOfficeCalc",
 			glyph:          linux.Libreofficecalc,
+			glyphClass:     linux.Class,
+			glyphID:        "libreofficecalc",
 			darkColor:      &color.RGBA{R: 120, G: 252, B: 78, A: 255},
 			darkColorANSI:  119,
 			lightColor:     &color.RGBA{R: 40, G: 84, B: 26, A: 255},
 			lightColorANSI: 22,
 		},
 		"odt": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "odt",
 			name:           "LibreOfficeWriter",
 			glyph:          linux.Libreofficewriter,
+			glyphClass:     linux.Class,
+			glyphID:        "libreofficewriter",
 			darkColor:      &color.RGBA{R: 44, G: 203, B: 253, A: 255},
 			darkColorANSI:  81,
 			lightColor:     &color.RGBA{R: 22, G: 102, B: 126, A: 255},
 			lightColorANSI: 24,
 		},
 		"oga": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "oga",
 			name:           "OggVorbis",
 			glyph:          fa.Music,
+			glyphClass:     fa.Class,
+			glyphID:        "music",
 			darkColor:      &color.RGBA{R: 0, G: 117, B: 170, A: 255},
 			darkColorANSI:  24,
 			lightColor:     &color.RGBA{R: 0, G: 88, B: 128, A: 255},
 			lightColorANSI: 24,
 		},
 		"ogg": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "ogg",
 			name:           "OggVorbis",
 			glyph:          fa.Music,
+			glyphClass:     fa.Class,
+			glyphID:        "music",
 			darkColor:      &color.RGBA{R: 0, G: 117, B: 170, A: 255},
 			darkColorANSI:  24,
 			lightColor:     &color.RGBA{R: 0, G: 88, B: 128, A: 255},
 			lightColorANSI: 24,
 		},
 		"ogv": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "ogv",
 			name:           "OggVideo",
 			glyph:          seti.Video,
+			glyphClass:     seti.Class,
+			glyphID:        "video",
 			darkColor:      &color.RGBA{R: 253, G: 151, B: 31, A: 255},
 			darkColorANSI:  208,
 			lightColor:     &color.RGBA{R: 126, G: 76, B: 16, A: 255},
 			lightColorANSI: 94,
 		},
 		"ogx": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "ogx",
 			name:           "OggMultiplex",
 			glyph:          seti.Video,
+			glyphClass:     seti.Class,
+			glyphID:        "video",
 			darkColor:      &color.RGBA{R: 253, G: 151, B: 31, A: 255},
 			darkColorANSI:  208,
 			lightColor:     &color.RGBA{R: 126, G: 76, B: 16, A: 255},
 			lightColorANSI: 94,
 		},
 		"opus": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "opus",
 			name:           "OpusAudioFile",
 			glyph:          fa.Music,
+			glyphClass:     fa.Class,
+			glyphID:        "music",
 			darkColor:      &color.RGBA{R: 0, G: 117, B: 170, A: 255},
 			darkColorANSI:  24,
 			lightColor:     &color.RGBA{R: 0, G: 88, B: 128, A: 255},
 			lightColorANSI: 24,
 		},
 		"org": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "org",
 			name:           "OrgMode",
 			glyph:          custom.Orgmode,
+			glyphClass:     custom.Class,
+			glyphID:        "orgmode",
 			darkColor:      &color.RGBA{R: 119, G: 170, B: 153, A: 255},
 			darkColorANSI:  73,
 			lightColor:     &color.RGBA{R: 79, G: 113, B: 102, A: 255},
 			lightColorANSI: 66,
 		},
 		"otf": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "otf",
 			name:           "OpenTypeFont",
 			glyph:          fa.Font,
+			glyphClass:     fa.Class,
+			glyphID:        "font",
 			darkColor:      &color.RGBA{R: 236, G: 236, B: 236, A: 255},
 			darkColorANSI:  255,
 			lightColor:     &color.RGBA{R: 47, G: 47, B: 47, A: 255},
 			lightColorANSI: 236,
 		},
 		"out": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "out",
 			name:           "Out",
 			glyph:          cod.FileBinary,
+			glyphClass:     cod.Class,
+			glyphID:        "file_binary",
 			darkColor:      &color.RGBA{R: 159, G: 5, B: 0, A: 255},
 			darkColorANSI:  124,
 			lightColor:     &color.RGBA{R: 159, G: 5, B: 0, A: 255},
 			lightColorANSI: 124,
 		},
 		"part": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "part",
 			name:           "Part",
 			glyph:          fa.Download,
+			glyphClass:     fa.Class,
+			glyphID:        "download",
 			darkColor:      &color.RGBA{R: 68, G: 205, B: 168, A: 255},
 			darkColorANSI:  43,
 			lightColor:     &color.RGBA{R: 34, G: 102, B: 84, A: 255},
 			lightColorANSI: 23,
 		},
 		"patch": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "patch",
 			name:           "Patch",
 			glyph:          dev.GitCompare,
+			glyphClass:     dev.Class,
+			glyphID:        "git_compare",
 			darkColor:      &color.RGBA{R: 65, G: 83, B: 91, A: 255},
 			darkColorANSI:  239,
 			lightColor:     &color.RGBA{R: 65, G: 83, B: 91, A: 255},
 			lightColorANSI: 239,
 		},
 		"pck": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "pck",
 			name:           "PackedResource",
 			glyph:          oct.Package,
+			glyphClass:     oct.Class,
+			glyphID:        "package",
 			darkColor:      &color.RGBA{R: 109, G: 128, B: 134, A: 255},
 			darkColorANSI:  66,
 			lightColor:     &color.RGBA{R: 81, G: 96, B: 100, A: 255},
 			lightColorANSI: 59,
 		},
 		"pcm": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "pcm",
 			name:           "PulseCodeModulation",
 			glyph:          fa.Music,
+			glyphClass:     fa.Class,
+			glyphID:        "music",
 			darkColor:      &color.RGBA{R: 0, G: 117, B: 170, A: 255},
 			darkColorANSI:  24,
 			lightColor:     &color.RGBA{R: 0, G: 88, B: 128, A: 255},
 			lightColorANSI: 24,
 		},
 		"pdf": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "pdf",
 			name:           "Pdf",
 			glyph:          cod.FilePdf,
+			glyphClass:     cod.Class,
+			glyphID:        "file_pdf",
 			darkColor:      &color.RGBA{R: 179, G: 11, B: 0, A: 255},
 			darkColorANSI:  124,
 			lightColor:     &color.RGBA{R: 179, G: 11, B: 0, A: 255},
 			lightColorANSI: 124,
 		},
 		"php": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "php",
 			name:           "Php",
 			glyph:          seti.Php,
+			glyphClass:     seti.Class,
+			glyphID:        "php",
 			darkColor:      &color.RGBA{R: 160, G: 116, B: 195, A: 255},
 			darkColorANSI:  140,
 			lightColor:     &color.RGBA{R: 107, G: 77, B: 131, A: 255},
 			lightColorANSI: 96,
 		},
 		"pl": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "pl",
 			name:           "Pl",
 			glyph:          dev.Perl,
+			glyphClass:     dev.Class,
+			glyphID:        "perl",
 			darkColor:      &color.RGBA{R: 81, G: 154, B: 186, A: 255},
 			darkColorANSI:  74,
 			lightColor:     &color.RGBA{R: 54, G: 103, B: 124, A: 255},
 			lightColorANSI: 24,
 		},
 		"pls": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "pls",
 			name:           "Pls",
 			glyph:          md.PlaylistMusicOutline,
+			glyphClass:     md.Class,
+			glyphID:        "playlist_music_outline",
 			darkColor:      &color.RGBA{R: 237, G: 149, B: 174, A: 255},
 			darkColorANSI:  211,
 			lightColor:     &color.RGBA{R: 118, G: 73, B: 87, A: 255},
 			lightColorANSI: 95,
 		},
 		"ply": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "ply",
 			name:           "3DObjectFile",
 			glyph:          md.CubeOutline,
+			glyphClass:     md.Class,
+			glyphID:        "cube_outline",
 			darkColor:      &color.RGBA{R: 136, G: 136, B: 136, A: 255},
 			darkColorANSI:  102,
 			lightColor:     &color.RGBA{R: 91, G: 91, B: 91, A: 255},
 			lightColorANSI: 240,
 		},
 		"pm": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "pm",
 			name:           "Pm",
 			glyph:          dev.Perl,
+			glyphClass:     dev.Class,
+			glyphID:        "perl",
 			darkColor:      &color.RGBA{R: 81, G: 154, B: 186, A: 255},
 			darkColorANSI:  74,
 			lightColor:     &color.RGBA{R: 54, G: 103, B: 124, A: 255},
 			lightColorANSI: 24,
 		},
 		"png": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "png",
 			name:           "Png",
 			glyph:          seti.Image,
+			glyphClass:     seti.Class,
+			glyphID:        "image",
 			darkColor:      &color.RGBA{R: 160, G: 116, B: 195, A: 255},
 			darkColorANSI:  140,
 			lightColor:     &color.RGBA{R: 107, G: 77, B: 131, A: 255},
 			lightColorANSI: 96,
 		},
 		"po": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "po",
 			name:           "Localization",
 			glyph:          fa.Language,
+			glyphClass:     fa.Class,
+			glyphID:        "language",
 			darkColor:      &color.RGBA{R: 36, G: 150, B: 190, A: 255},
 			darkColorANSI:  31,
 			lightColor:     &color.RGBA{R: 28, G: 112, B: 142, A: 255},
 			lightColorANSI: 24,
 		},
 		"pot": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "pot",
 			name:           "Localization",
 			glyph:          fa.Language,
+			glyphClass:     fa.Class,
+			glyphID:        "language",
 			darkColor:      &color.RGBA{R: 36, G: 150, B: 190, A: 255},
 			darkColorANSI:  31,
 			lightColor:     &color.RGBA{R: 28, G: 112, B: 142, A: 255},
 			lightColorANSI: 24,
 		},
 		"pp": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "pp",
 			name:           "Pp",
 			glyph:          custom.Puppet,
+			glyphClass:     custom.Class,
+			glyphID:        "puppet",
 			darkColor:      &color.RGBA{R: 255, G: 166, B: 26, A: 255},
 			darkColorANSI:  214,
 			lightColor:     &color.RGBA{R: 128, G: 83, B: 13, A: 255},
 			lightColorANSI: 94,
 		},
 		"ppt": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "ppt",
 			name:           "Ppt",
 			glyph:          md.FilePowerpoint,
+			glyphClass:     md.Class,
+			glyphID:        "file_powerpoint",
 			darkColor:      &color.RGBA{R: 203, G: 73, B: 50, A: 255},
 			darkColorANSI:  160,
 			lightColor:     &color.RGBA{R: 152, G: 56, B: 38, A: 255},
 			lightColorANSI: 124,
 		},
 		"pptx": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "pptx",
 			name:           "Pptx",
 			glyph:          md.FilePowerpoint,
+			glyphClass:     md.Class,
+			glyphID:        "file_powerpoint",
 			darkColor:      &color.RGBA{R: 203, G: 73, B: 50, A: 255},
 			darkColorANSI:  160,
 			lightColor:     &color.RGBA{R: 152, G: 56, B: 38, A: 255},
 			lightColorANSI: 124,
 		},
 		"prisma": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "prisma",
 			name:           "Prisma",
 			glyph:          seti.Prisma,
+			glyphClass:     seti.Class,
+			glyphID:        "prisma",
 			darkColor:      &color.RGBA{R: 89, G: 103, B: 216, A: 255},
 			darkColorANSI:  62,
 			lightColor:     &color.RGBA{R: 68, G: 77, B: 162, A: 255},
 			lightColorANSI: 61,
 		},
 		"pro": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "pro",
 			name:           "Prolog",
 			glyph:          dev.Prolog,
+			glyphClass:     dev.Class,
+			glyphID:        "prolog",
 			darkColor:      &color.RGBA{R: 227, G: 184, B: 84, A: 255},
 			darkColorANSI:  179,
 			lightColor:     &color.RGBA{R: 113, G: 92, B: 42, A: 255},
 			lightColorANSI: 94,
 		},
 		"ps1": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "ps1",
 			name:           "PsScriptfile",
 			glyph:          md.Powershell,
+			glyphClass:     md.Class,
+			glyphID:        "powershell",
 			darkColor:      &color.RGBA{R: 65, G: 115, B: 202, A: 255},
 			darkColorANSI:  68,
 			lightColor:     &color.RGBA{R: 50, G: 86, B: 152, A: 255},
 			lightColorANSI: 25,
 		},
 		"psb": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "psb",
 			name:           "Psb",
 			glyph:          dev.Photoshop,
+			glyphClass:     dev.Class,
+			glyphID:        "photoshop",
 			darkColor:      &color.RGBA{R: 81, G: 154, B: 186, A: 255},
 			darkColorANSI:  74,
 			lightColor:     &color.RGBA{R: 54, G: 103, B: 124, A: 255},
 			lightColorANSI: 24,
 		},
 		"psd1": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "psd1",
 			name:           "PsManifestfile",
 			glyph:          md.Powershell,
+			glyphClass:     md.Class,
+			glyphID:        "powershell",
 			darkColor:      &color.RGBA{R: 105, G: 117, B: 195, A: 255},
 			darkColorANSI:  68,
 			lightColor:     &color.RGBA{R: 79, G: 88, B: 147, A: 255},
 			lightColorANSI: 60,
 		},
 		"psd": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "psd",
 			name:           "Psd",
 			glyph:          dev.Photoshop,
+			glyphClass:     dev.Class,
+			glyphID:        "photoshop",
 			darkColor:      &color.RGBA{R: 81, G: 154, B: 186, A: 255},
 			darkColorANSI:  74,
 			lightColor:     &color.RGBA{R: 54, G: 103, B: 124, A: 255},
 			lightColorANSI: 24,
 		},
 		"psm1": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "psm1",
 			name:           "PsScriptModulefile",
 			glyph:          md.Powershell,
+			glyphClass:     md.Class,
+			glyphID:        "powershell",
 			darkColor:      &color.RGBA{R: 105, G: 117, B: 195, A: 255},
 			darkColorANSI:  68,
 			lightColor:     &color.RGBA{R: 79, G: 88, B: 147, A: 255},
 			lightColorANSI: 60,
 		},
 		"pub": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "pub",
 			name:           "Pub",
 			glyph:          md.KeyOutline,
+			glyphClass:     md.Class,
+			glyphID:        "key_outline",
 			darkColor:      &color.RGBA{R: 227, G: 197, B: 142, A: 255},
 			darkColorANSI:  222,
 			lightColor:     &color.RGBA{R: 76, G: 65, B: 47, A: 255},
 			lightColorANSI: 238,
 		},
 		"pxd": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "pxd",
 			name:           "Pxd",
 			glyph:          seti.Python,
+			glyphClass:     seti.Class,
+			glyphID:        "python",
 			darkColor:      &color.RGBA{R: 89, G: 167, B: 227, A: 255},
 			darkColorANSI:  39,
 			lightColor:     &color.RGBA{R: 60, G: 111, B: 152, A: 255},
 			lightColorANSI: 24,
 		},
 		"pxi": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "pxi",
 			name:           "Pxi",
 			glyph:          seti.Python,
+			glyphClass:     seti.Class,
+			glyphID:        "python",
 			darkColor:      &color.RGBA{R: 89, G: 167, B: 227, A: 255},
 			darkColorANSI:  39,
 			lightColor:     &color.RGBA{R: 60, G: 111, B: 152, A: 255},
 			lightColorANSI: 24,
 		},
 		"py": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "py",
 			name:           "Py",
 			glyph:          seti.Python,
+			glyphClass:     seti.Class,
+			glyphID:        "python",
 			darkColor:      &color.RGBA{R: 255, G: 188, B: 3, A: 255},
 			darkColorANSI:  214,
 			lightColor:     &color.RGBA{R: 128, G: 94, B: 2, A: 255},
 			lightColorANSI: 94,
 		},
 		"pyc": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "pyc",
 			name:           "Pyc",
 			glyph:          seti.Python,
+			glyphClass:     seti.Class,
+			glyphID:        "python",
 			darkColor:      &color.RGBA{R: 255, G: 226, B: 145, A: 255},
 			darkColorANSI:  222,
 			lightColor:     &color.RGBA{R: 51, G: 44, B: 29, A: 255},
 			lightColorANSI: 236,
 		},
 		"pyd": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "pyd",
 			name:           "Pyd",
 			glyph:          seti.Python,
+			glyphClass:     seti.Class,
+			glyphID:        "python",
 			darkColor:      &color.RGBA{R: 255, G: 226, B: 145, A: 255},
 			darkColorANSI:  222,
 			lightColor:     &color.RGBA{R: 51, G: 44, B: 29, A: 255},
 			lightColorANSI: 236,
 		},
 		"pyi": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "pyi",
 			name:           "Pyi",
 			glyph:          seti.Python,
+			glyphClass:     seti.Class,
+			glyphID:        "python",
 			darkColor:      &color.RGBA{R: 255, G: 188, B: 3, A: 255},
 			darkColorANSI:  214,
 			lightColor:     &color.RGBA{R: 128, G: 94, B: 2, A: 255},
 			lightColorANSI: 94,
 		},
 		"pyo": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "pyo",
 			name:           "Pyo",
 			glyph:          seti.Python,
+			glyphClass:     seti.Class,
+			glyphID:        "python",
 			darkColor:      &color.RGBA{R: 255, G: 226, B: 145, A: 255},
 			darkColorANSI:  222,
 			lightColor:     &color.RGBA{R: 51, G: 44, B: 29, A: 255},
 			lightColorANSI: 236,
 		},
 		"pyw": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "pyw",
 			name:           "Pyw",
 			glyph:          seti.Python,
+			glyphClass:     seti.Class,
+			glyphID:        "python",
 			darkColor:      &color.RGBA{R: 89, G: 167, B: 227, A: 255},
 			darkColorANSI:  39,
 			lightColor:     &color.RGBA{R: 60, G: 111, B: 152, A: 255},
 			lightColorANSI: 24,
 		},
 		"pyx": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "pyx",
 			name:           "Pyx",
 			glyph:          seti.Python,
+			glyphClass:     seti.Class,
+			glyphID:        "python",
 			darkColor:      &color.RGBA{R: 89, G: 167, B: 227, A: 255},
 			darkColorANSI:  39,
 			lightColor:     &color.RGBA{R: 60, G: 111, B: 152, A: 255},
 			lightColorANSI: 24,
 		},
 		"qm": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "qm",
 			name:           "Localization",
 			glyph:          fa.Language,
+			glyphClass:     fa.Class,
+			glyphID:        "language",
 			darkColor:      &color.RGBA{R: 36, G: 150, B: 190, A: 255},
 			darkColorANSI:  31,
 			lightColor:     &color.RGBA{R: 28, G: 112, B: 142, A: 255},
 			lightColorANSI: 24,
 		},
 		"qml": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "qml",
 			name:           "Qt",
 			glyph:          linux.Qt,
+			glyphClass:     linux.Class,
+			glyphID:        "qt",
 			darkColor:      &color.RGBA{R: 64, G: 205, B: 81, A: 255},
 			darkColorANSI:  77,
 			lightColor:     &color.RGBA{R: 43, G: 137, B: 55, A: 255},
 			lightColorANSI: 28,
 		},
 		"qrc": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "qrc",
 			name:           "Qt",
 			glyph:          linux.Qt,
+			glyphClass:     linux.Class,
+			glyphID:        "qt",
 			darkColor:      &color.RGBA{R: 64, G: 205, B: 81, A: 255},
 			darkColorANSI:  77,
 			lightColor:     &color.RGBA{R: 43, G: 137, B: 55, A: 255},
 			lightColorANSI: 28,
 		},
 		"qss": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "qss",
 			name:           "Qt",
 			glyph:          linux.Qt,
+			glyphClass:     linux.Class,
+			glyphID:        "qt",
 			darkColor:      &color.RGBA{R: 64, G: 205, B: 81, A: 255},
 			darkColorANSI:  77,
 			lightColor:     &color.RGBA{R: 43, G: 137, B: 55, A: 255},
 			lightColorANSI: 28,
 		},
 		"query": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "query",
 			name:           "Query",
 			glyph:          fae.Tree,
+			glyphClass:     fae.Class,
+			glyphID:        "tree",
 			darkColor:      &color.RGBA{R: 144, G: 168, B: 80, A: 255},
 			darkColorANSI:  107,
 			lightColor:     &color.RGBA{R: 96, G: 112, B: 52, A: 255},
 			lightColorANSI: 58,
 		},
 		"r": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "r",
 			name:           "R",
 			glyph:          md.LanguageR,
+			glyphClass:     md.Class,
+			glyphID:        "language_r",
 			darkColor:      &color.RGBA{R: 34, G: 102, B: 186, A: 255},
 			darkColorANSI:  25,
 			lightColor:     &color.RGBA{R: 26, G: 76, B: 140, A: 255},
 			lightColorANSI: 25,
 		},
 		"rake": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "rake",
 			name:           "Rake",
 			glyph:          dev.RubyRough,
+			glyphClass:     dev.Class,
+			glyphID:        "ruby_rough",
 			darkColor:      &color.RGBA{R: 112, G: 21, B: 22, A: 255},
 			darkColorANSI:  52,
 			lightColor:     &color.RGBA{R: 112, G: 21, B: 22, A: 255},
 			lightColorANSI: 52,
 		},
 		"rar": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "rar",
 			name:           "Rar",
 			glyph:          oct.FileZip,
+			glyphClass:     oct.Class,
+			glyphID:        "file_zip",
 			darkColor:      &color.RGBA{R: 236, G: 165, B: 23, A: 255},
 			darkColorANSI:  214,
 			lightColor:     &color.RGBA{R: 118, G: 81, B: 12, A: 255},
 			lightColorANSI: 94,
 		},
 		"rasi": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "rasi",
 			name:           "Rasi",
 			glyph:          cod.LayoutMenubar,
+			glyphClass:     cod.Class,
+			glyphID:        "layout_menubar",
 			darkColor:      &color.RGBA{R: 203, G: 203, B: 65, A: 255},
 			darkColorANSI:  185,
 			lightColor:     &color.RGBA{R: 102, G: 102, B: 32, A: 255},
 			lightColorANSI: 58,
 		},
 		"razor": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "razor",
 			name:           "RazorPage",
 			glyph:          md.RazorSingleEdge,
+			glyphClass:     md.Class,
+			glyphID:        "razor_single_edge",
 			darkColor:      &color.RGBA{R: 81, G: 43, B: 211, A: 255},
 			darkColorANSI:  56,
 			lightColor:     &color.RGBA{R: 81, G: 43, B: 211, A: 255},
 			lightColorANSI: 56,
 		},
 		"rb": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "rb",
 			name:           "Rb",
 			glyph:          dev.RubyRough,
+			glyphClass:     dev.Class,
+			glyphID:        "ruby_rough",
 			darkColor:      &color.RGBA{R: 112, G: 21, B: 22, A: 255},
 			darkColorANSI:  52,
 			lightColor:     &color.RGBA{R: 112, G: 21, B: 22, A: 255},
 			lightColorANSI: 52,
 		},
 		"res": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "res",
 			name:           "ReScript",
 			glyph:          seti.Rescript,
+			glyphClass:     seti.Class,
+			glyphID:        "rescript",
 			darkColor:      &color.RGBA{R: 204, G: 62, B: 68, A: 255},
 			darkColorANSI:  167,
 			lightColor:     &color.RGBA{R: 153, G: 46, B: 51, A: 255},
 			lightColorANSI: 88,
 		},
 		"resi": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "resi",
 			name:           "ReScriptInterface",
 			glyph:          seti.Rescript,
+			glyphClass:     seti.Class,
+			glyphID:        "rescript",
 			darkColor:      &color.RGBA{R: 245, G: 83, B: 133, A: 255},
 			darkColorANSI:  204,
 			lightColor:     &color.RGBA{R: 163, G: 55, B: 89, A: 255},
 			lightColorANSI: 125,
 		},
 		"rkt": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "rkt",
 			name:           "Racket",
 			glyph:          md.Lambda,
+			glyphClass:     md.Class,
+			glyphID:        "lambda",
 			darkColor:      &color.RGBA{R: 159, G: 29, B: 32, A: 255},
 			darkColorANSI:  124,
 			lightColor:     &color.RGBA{R: 159, G: 29, B: 32, A: 255},
 			lightColorANSI: 124,
 		},
 		"rlib": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "rlib",
 			name:           "Rlib",
 			glyph:          dev.Rust,
+			glyphClass:     dev.Class,
+			glyphID:        "rust",
 			darkColor:      &color.RGBA{R: 222, G: 165, B: 131, A: 255},
 			darkColorANSI:  216,
 			lightColor:     &color.RGBA{R: 111, G: 81, B: 65, A: 255},
 			lightColorANSI: 95,
 		},
 		"rmd": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "rmd",
 			name:           "Rmd",
 			glyph:          seti.Markdown,
+			glyphClass:     seti.Class,
+			glyphID:        "markdown",
 			darkColor:      &color.RGBA{R: 81, G: 154, B: 186, A: 255},
 			darkColorANSI:  74,
 			lightColor:     &color.RGBA{R: 54, G: 103, B: 124, A: 255},
 			lightColorANSI: 24,
 		},
 		"rproj": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "rproj",
 			name:           "Rproj",
 			glyph:          md.VectorRectangle,
+			glyphClass:     md.Class,
+			glyphID:        "vector_rectangle",
 			darkColor:      &color.RGBA{R: 52, G: 138, B: 91, A: 255},
 			darkColorANSI:  29,
 			lightColor:     &color.RGBA{R: 40, G: 104, B: 68, A: 255},
 			lightColorANSI: 29,
 		},
 		"rs": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "rs",
 			name:           "Rs",
 			glyph:          seti.Rust,
+			glyphClass:     seti.Class,
+			glyphID:        "rust",
 			darkColor:      &color.RGBA{R: 222, G: 165, B: 131, A: 255},
 			darkColorANSI:  216,
 			lightColor:     &color.RGBA{R: 111, G: 81, B: 65, A: 255},
 			lightColorANSI: 95,
 		},
 		"rss": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "rss",
 			name:           "Rss",
 			glyph:          seti.Xml,
+			glyphClass:     seti.Class,
+			glyphID:        "xml",
 			darkColor:      &color.RGBA{R: 251, G: 157, B: 59, A: 255},
 			darkColorANSI:  215,
 			lightColor:     &color.RGBA{R: 126, G: 78, B: 30, A: 255},
 			lightColorANSI: 94,
 		},
 		"s": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "s",
 			name:           "ASM",
 			glyph:          seti.Asm,
+			glyphClass:     seti.Class,
+			glyphID:        "asm",
 			darkColor:      &color.RGBA{R: 0, G: 113, B: 197, A: 255},
 			darkColorANSI:  25,
 			lightColor:     &color.RGBA{R: 0, G: 85, B: 147, A: 255},
 			lightColorANSI: 25,
 		},
 		"sass": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "sass",
 			name:           "Sass",
 			glyph:          seti.Sass,
+			glyphClass:     seti.Class,
+			glyphID:        "sass",
 			darkColor:      &color.RGBA{R: 245, G: 83, B: 133, A: 255},
 			darkColorANSI:  204,
 			lightColor:     &color.RGBA{R: 163, G: 55, B: 89, A: 255},
 			lightColorANSI: 125,
 		},
 		"sbt": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "sbt",
 			name:           "sbt",
 			glyph:          dev.Scala,
+			glyphClass:     dev.Class,
+			glyphID:        "scala",
 			darkColor:      &color.RGBA{R: 204, G: 62, B: 68, A: 255},
 			darkColorANSI:  167,
 			lightColor:     &color.RGBA{R: 153, G: 46, B: 51, A: 255},
 			lightColorANSI: 88,
 		},
 		"sc": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "sc",
 			name:           "ScalaScript",
 			glyph:          dev.Scala,
+			glyphClass:     dev.Class,
+			glyphID:        "scala",
 			darkColor:      &color.RGBA{R: 204, G: 62, B: 68, A: 255},
 			darkColorANSI:  167,
 			lightColor:     &color.RGBA{R: 153, G: 46, B: 51, A: 255},
 			lightColorANSI: 88,
 		},
 		"scad": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "scad",
 			name:           "OpenSCAD",
 			glyph:          linux.Openscad,
+			glyphClass:     linux.Class,
+			glyphID:        "openscad",
 			darkColor:      &color.RGBA{R: 249, G: 215, B: 44, A: 255},
 			darkColorANSI:  220,
 			lightColor:     &color.RGBA{R: 83, G: 72, B: 15, A: 255},
 			lightColorANSI: 58,
 		},
 		"scala": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "scala",
 			name:           "Scala",
 			glyph:          dev.Scala,
+			glyphClass:     dev.Class,
+			glyphID:        "scala",
 			darkColor:      &color.RGBA{R: 204, G: 62, B: 68, A: 255},
 			darkColorANSI:  167,
 			lightColor:     &color.RGBA{R: 153, G: 46, B: 51, A: 255},
 			lightColorANSI: 88,
 		},
 		"scm": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "scm",
 			name:           "Scheme",
 			glyph:          md.Lambda,
+			glyphClass:     md.Class,
+			glyphID:        "lambda",
 			darkColor:      &color.RGBA{R: 238, G: 238, B: 238, A: 255},
 			darkColorANSI:  255,
 			lightColor:     &color.RGBA{R: 48, G: 48, B: 48, A: 255},
 			lightColorANSI: 236,
 		},
 		"scss": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "scss",
 			name:           "Scss",
 			glyph:          seti.Sass,
+			glyphClass:     seti.Class,
+			glyphID:        "sass",
 			darkColor:      &color.RGBA{R: 245, G: 83, B: 133, A: 255},
 			darkColorANSI:  204,
 			lightColor:     &color.RGBA{R: 163, G: 55, B: 89, A: 255},
 			lightColorANSI: 125,
 		},
 		"sh": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "sh",
 			name:           "Sh",
 			glyph:          dev.Terminal,
+			glyphClass:     dev.Class,
+			glyphID:        "terminal",
 			darkColor:      &color.RGBA{R: 77, G: 89, B: 94, A: 255},
 			darkColorANSI:  240,
 			lightColor:     &color.RGBA{R: 58, G: 68, B: 70, A: 255},
 			lightColorANSI: 238,
 		},
 		"sha1": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "sha1",
 			name:           "Sha1",
 			glyph:          md.ShieldCheck,
+			glyphClass:     md.Class,
+			glyphID:        "shield_check",
 			darkColor:      &color.RGBA{R: 140, G: 134, B: 175, A: 255},
 			darkColorANSI:  103,
 			lightColor:     &color.RGBA{R: 93, G: 89, B: 117, A: 255},
 			lightColorANSI: 60,
 		},
 		"sha224": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "sha224",
 			name:           "Sha224",
 			glyph:          md.ShieldCheck,
+			glyphClass:     md.Class,
+			glyphID:        "shield_check",
 			darkColor:      &color.RGBA{R: 140, G: 134, B: 175, A: 255},
 			darkColorANSI:  103,
 			lightColor:     &color.RGBA{R: 93, G: 89, B: 117, A: 255},
 			lightColorANSI: 60,
 		},
 		"sha256": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "sha256",
 			name:           "Sha256",
 			glyph:          md.ShieldCheck,
+			glyphClass:     md.Class,
+			glyphID:        "shield_check",
 			darkColor:      &color.RGBA{R: 140, G: 134, B: 175, A: 255},
 			darkColorANSI:  103,
 			lightColor:     &color.RGBA{R: 93, G: 89, B: 117, A: 255},
 			lightColorANSI: 60,
 		},
 		"sha384": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "sha384",
 			name:           "Sha384",
 			glyph:          md.ShieldCheck,
+			glyphClass:     md.Class,
+			glyphID:        "shield_check",
 			darkColor:      &color.RGBA{R: 140, G: 134, B: 175, A: 255},
 			darkColorANSI:  103,
 			lightColor:     &color.RGBA{R: 93, G: 89, B: 117, A: 255},
 			lightColorANSI: 60,
 		},
 		"sha512": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "sha512",
 			name:           "Sha512",
 			glyph:          md.ShieldCheck,
+			glyphClass:     md.Class,
+			glyphID:        "shield_check",
 			darkColor:      &color.RGBA{R: 140, G: 134, B: 175, A: 255},
 			darkColorANSI:  103,
 			lightColor:     &color.RGBA{R: 93, G: 89, B: 117, A: 255},
 			lightColorANSI: 60,
 		},
 		"sig": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "sig",
 			name:           "Sig",
 			glyph:          md.Lambda,
+			glyphClass:     md.Class,
+			glyphID:        "lambda",
 			darkColor:      &color.RGBA{R: 227, G: 121, B: 51, A: 255},
 			darkColorANSI:  166,
 			lightColor:     &color.RGBA{R: 151, G: 81, B: 34, A: 255},
 			lightColorANSI: 130,
 		},
 		"signature": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "signature",
 			name:           "Signature",
 			glyph:          md.Lambda,
+			glyphClass:     md.Class,
+			glyphID:        "lambda",
 			darkColor:      &color.RGBA{R: 227, G: 121, B: 51, A: 255},
 			darkColorANSI:  166,
 			lightColor:     &color.RGBA{R: 151, G: 81, B: 34, A: 255},
 			lightColorANSI: 130,
 		},
 		"skp": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "skp",
 			name:           "SketchUp",
 			glyph:          md.FileCad,
+			glyphClass:     md.Class,
+			glyphID:        "file_cad",
 			darkColor:      &color.RGBA{R: 131, G: 147, B: 99, A: 255},
 			darkColorANSI:  101,
 			lightColor:     &color.RGBA{R: 87, G: 99, B: 65, A: 255},
 			lightColorANSI: 58,
 		},
 		"sldasm": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "sldasm",
 			name:           "SolidWorksAsm",
 			glyph:          md.FileCad,
+			glyphClass:     md.Class,
+			glyphID:        "file_cad",
 			darkColor:      &color.RGBA{R: 131, G: 147, B: 99, A: 255},
 			darkColorANSI:  101,
 			lightColor:     &color.RGBA{R: 87, G: 99, B: 65, A: 255},
 			lightColorANSI: 58,
 		},
 		"sldprt": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "sldprt",
 			name:           "SolidWorksPrt",
 			glyph:          md.FileCad,
+			glyphClass:     md.Class,
+			glyphID:        "file_cad",
 			darkColor:      &color.RGBA{R: 131, G: 147, B: 99, A: 255},
 			darkColorANSI:  101,
 			lightColor:     &color.RGBA{R: 87, G: 99, B: 65, A: 255},
 			lightColorANSI: 58,
 		},
 		"slim": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "slim",
 			name:           "Slim",
 			glyph:          seti.Html,
+			glyphClass:     seti.Class,
+			glyphID:        "html",
 			darkColor:      &color.RGBA{R: 227, G: 76, B: 38, A: 255},
 			darkColorANSI:  196,
 			lightColor:     &color.RGBA{R: 170, G: 56, B: 28, A: 255},
 			lightColorANSI: 124,
 		},
 		"sln": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "sln",
 			name:           "Sln",
 			glyph:          dev.Visualstudio,
+			glyphClass:     dev.Class,
+			glyphID:        "visualstudio",
 			darkColor:      &color.RGBA{R: 133, G: 76, B: 199, A: 255},
 			darkColorANSI:  98,
 			lightColor:     &color.RGBA{R: 100, G: 56, B: 149, A: 255},
 			lightColorANSI: 91,
 		},
 		"slnx": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "slnx",
 			name:           "Slnx",
 			glyph:          dev.Visualstudio,
+			glyphClass:     dev.Class,
+			glyphID:        "visualstudio",
 			darkColor:      &color.RGBA{R: 133, G: 76, B: 199, A: 255},
 			darkColorANSI:  98,
 			lightColor:     &color.RGBA{R: 100, G: 56, B: 149, A: 255},
 			lightColorANSI: 91,
 		},
 		"slvs": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "slvs",
 			name:           "SolveSpace",
 			glyph:          md.FileCad,
+			glyphClass:     md.Class,
+			glyphID:        "file_cad",
 			darkColor:      &color.RGBA{R: 131, G: 147, B: 99, A: 255},
 			darkColorANSI:  101,
 			lightColor:     &color.RGBA{R: 87, G: 99, B: 65, A: 255},
 			lightColorANSI: 58,
 		},
 		"sml": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "sml",
 			name:           "Sml",
 			glyph:          md.Lambda,
+			glyphClass:     md.Class,
+			glyphID:        "lambda",
 			darkColor:      &color.RGBA{R: 227, G: 121, B: 51, A: 255},
 			darkColorANSI:  166,
 			lightColor:     &color.RGBA{R: 151, G: 81, B: 34, A: 255},
 			lightColorANSI: 130,
 		},
 		"so": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "so",
 			name:           "SharedObject",
 			glyph:          cod.Library,
+			glyphClass:     cod.Class,
+			glyphID:        "library",
 			darkColor:      &color.RGBA{R: 220, G: 221, B: 214, A: 255},
 			darkColorANSI:  253,
 			lightColor:     &color.RGBA{R: 73, G: 73, B: 71, A: 255},
 			lightColorANSI: 239,
 		},
 		"sol": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "sol",
 			name:           "Solidity",
 			glyph:          seti.Ethereum,
+			glyphClass:     seti.Class,
+			glyphID:        "ethereum",
 			darkColor:      &color.RGBA{R: 81, G: 154, B: 186, A: 255},
 			darkColorANSI:  74,
 			lightColor:     &color.RGBA{R: 54, G: 103, B: 124, A: 255},
 			lightColorANSI: 24,
 		},
 		"spec.js": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "spec.js",
 			name:           "SpecJs",
 			glyph:          oct.Beaker,
+			glyphClass:     oct.Class,
+			glyphID:        "beaker",
 			darkColor:      &color.RGBA{R: 203, G: 203, B: 65, A: 255},
 			darkColorANSI:  185,
 			lightColor:     &color.RGBA{R: 102, G: 102, B: 32, A: 255},
 			lightColorANSI: 58,
 		},
 		"spec.jsx": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "spec.jsx",
 			name:           "JavaScriptReactSpec",
 			glyph:          oct.Beaker,
+			glyphClass:     oct.Class,
+			glyphID:        "beaker",
 			darkColor:      &color.RGBA{R: 32, G: 194, B: 227, A: 255},
 			darkColorANSI:  45,
 			lightColor:     &color.RGBA{R: 21, G: 129, B: 151, A: 255},
 			lightColorANSI: 31,
 		},
 		"spec.ts": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "spec.ts",
 			name:           "SpecTs",
 			glyph:          oct.Beaker,
+			glyphClass:     oct.Class,
+			glyphID:        "beaker",
 			darkColor:      &color.RGBA{R: 81, G: 154, B: 186, A: 255},
 			darkColorANSI:  74,
 			lightColor:     &color.RGBA{R: 54, G: 103, B: 124, A: 255},
 			lightColorANSI: 24,
 		},
 		"spec.tsx": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "spec.tsx",
 			name:           "TypeScriptReactSpec",
 			glyph:          oct.Beaker,
+			glyphClass:     oct.Class,
+			glyphID:        "beaker",
 			darkColor:      &color.RGBA{R: 19, G: 84, B: 191, A: 255},
 			darkColorANSI:  26,
 			lightColor:     &color.RGBA{R: 19, G: 84, B: 191, A: 255},
 			lightColorANSI: 26,
 		},
 		"spx": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "spx",
 			name:           "OggSpeexAudio",
 			glyph:          fa.Music,
+			glyphClass:     fa.Class,
+			glyphID:        "music",
 			darkColor:      &color.RGBA{R: 0, G: 117, B: 170, A: 255},
 			darkColorANSI:  24,
 			lightColor:     &color.RGBA{R: 0, G: 88, B: 128, A: 255},
 			lightColorANSI: 24,
 		},
 		"sql": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "sql",
 			name:           "Sql",
 			glyph:          dev.Database,
+			glyphClass:     dev.Class,
+			glyphID:        "database",
 			darkColor:      &color.RGBA{R: 218, G: 216, B: 216, A: 255},
 			darkColorANSI:  188,
 			lightColor:     &color.RGBA{R: 73, G: 72, B: 72, A: 255},
 			lightColorANSI: 238,
 		},
 		"sqlite3": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "sqlite3",
 			name:           "Sql",
 			glyph:          dev.Database,
+			glyphClass:     dev.Class,
+			glyphID:        "database",
 			darkColor:      &color.RGBA{R: 218, G: 216, B: 216, A: 255},
 			darkColorANSI:  188,
 			lightColor:     &color.RGBA{R: 73, G: 72, B: 72, A: 255},
 			lightColorANSI: 238,
 		},
 		"sqlite": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "sqlite",
 			name:           "Sql",
 			glyph:          dev.Database,
+			glyphClass:     dev.Class,
+			glyphID:        "database",
 			darkColor:      &color.RGBA{R: 218, G: 216, B: 216, A: 255},
 			darkColorANSI:  188,
 			lightColor:     &color.RGBA{R: 73, G: 72, B: 72, A: 255},
 			lightColorANSI: 238,
 		},
 		"srt": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "srt",
 			name:           "Srt",
 			glyph:          md.Subtitles,
+			glyphClass:     md.Class,
+			glyphID:        "subtitles",
 			darkColor:      &color.RGBA{R: 255, G: 183, B: 19, A: 255},
 			darkColorANSI:  214,
 			lightColor:     &color.RGBA{R: 128, G: 92, B: 10, A: 255},
 			lightColorANSI: 94,
 		},
 		"ssa": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "ssa",
 			name:           "Ssa",
 			glyph:          md.Subtitles,
+			glyphClass:     md.Class,
+			glyphID:        "subtitles",
 			darkColor:      &color.RGBA{R: 255, G: 183, B: 19, A: 255},
 			darkColorANSI:  214,
 			lightColor:     &color.RGBA{R: 128, G: 92, B: 10, A: 255},
 			lightColorANSI: 94,
 		},
 		"ste": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "ste",
 			name:           "Ste",
 			glyph:          md.FileCad,
+			glyphClass:     md.Class,
+			glyphID:        "file_cad",
 			darkColor:      &color.RGBA{R: 131, G: 147, B: 99, A: 255},
 			darkColorANSI:  101,
 			lightColor:     &color.RGBA{R: 87, G: 99, B: 65, A: 255},
 			lightColorANSI: 58,
 		},
 		"step": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "step",
 			name:           "Step",
 			glyph:          md.FileCad,
+			glyphClass:     md.Class,
+			glyphID:        "file_cad",
 			darkColor:      &color.RGBA{R: 131, G: 147, B: 99, A: 255},
 			darkColorANSI:  101,
 			lightColor:     &color.RGBA{R: 87, G: 99, B: 65, A: 255},
 			lightColorANSI: 58,
 		},
 		"stl": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "stl",
 			name:           "3DObjectFile",
 			glyph:          md.CubeOutline,
+			glyphClass:     md.Class,
+			glyphID:        "cube_outline",
 			darkColor:      &color.RGBA{R: 136, G: 136, B: 136, A: 255},
 			darkColorANSI:  102,
 			lightColor:     &color.RGBA{R: 91, G: 91, B: 91, A: 255},
 			lightColorANSI: 240,
 		},
 		"stories.js": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "stories.js",
 			name:           "StorybookJavaScript",
 			glyph:          dev.Storybook,
+			glyphClass:     dev.Class,
+			glyphID:        "storybook",
 			darkColor:      &color.RGBA{R: 255, G: 71, B: 133, A: 255},
 			darkColorANSI:  204,
 			lightColor:     &color.RGBA{R: 170, G: 47, B: 89, A: 255},
 			lightColorANSI: 125,
 		},
 		"stories.jsx": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "stories.jsx",
 			name:           "StorybookJsx",
 			glyph:          dev.Storybook,
+			glyphClass:     dev.Class,
+			glyphID:        "storybook",
 			darkColor:      &color.RGBA{R: 255, G: 71, B: 133, A: 255},
 			darkColorANSI:  204,
 			lightColor:     &color.RGBA{R: 170, G: 47, B: 89, A: 255},
 			lightColorANSI: 125,
 		},
 		"stories.mjs": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "stories.mjs",
 			name:           "StorybookMjs",
 			glyph:          dev.Storybook,
+			glyphClass:     dev.Class,
+			glyphID:        "storybook",
 			darkColor:      &color.RGBA{R: 255, G: 71, B: 133, A: 255},
 			darkColorANSI:  204,
 			lightColor:     &color.RGBA{R: 170, G: 47, B: 89, A: 255},
 			lightColorANSI: 125,
 		},
 		"stories.svelte": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "stories.svelte",
 			name:           "StorybookSvelte",
 			glyph:          dev.Storybook,
+			glyphClass:     dev.Class,
+			glyphID:        "storybook",
 			darkColor:      &color.RGBA{R: 255, G: 71, B: 133, A: 255},
 			darkColorANSI:  204,
 			lightColor:     &color.RGBA{R: 170, G: 47, B: 89, A: 255},
 			lightColorANSI: 125,
 		},
 		"stories.ts": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "stories.ts",
 			name:           "StorybookTypeScript",
 			glyph:          dev.Storybook,
+			glyphClass:     dev.Class,
+			glyphID:        "storybook",
 			darkColor:      &color.RGBA{R: 255, G: 71, B: 133, A: 255},
 			darkColorANSI:  204,
 			lightColor:     &color.RGBA{R: 170, G: 47, B: 89, A: 255},
 			lightColorANSI: 125,
 		},
 		"stories.tsx": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "stories.tsx",
 			name:           "StorybookTsx",
 			glyph:          dev.Storybook,
+			glyphClass:     dev.Class,
+			glyphID:        "storybook",
 			darkColor:      &color.RGBA{R: 255, G: 71, B: 133, A: 255},
 			darkColorANSI:  204,
 			lightColor:     &color.RGBA{R: 170, G: 47, B: 89, A: 255},
 			lightColorANSI: 125,
 		},
 		"stories.vue": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "stories.vue",
 			name:           "StorybookVue",
 			glyph:          dev.Storybook,
+			glyphClass:     dev.Class,
+			glyphID:        "storybook",
 			darkColor:      &color.RGBA{R: 255, G: 71, B: 133, A: 255},
 			darkColorANSI:  204,
 			lightColor:     &color.RGBA{R: 170, G: 47, B: 89, A: 255},
 			lightColorANSI: 125,
 		},
 		"stp": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "stp",
 			name:           "Stp",
 			glyph:          md.FileCad,
+			glyphClass:     md.Class,
+			glyphID:        "file_cad",
 			darkColor:      &color.RGBA{R: 131, G: 147, B: 99, A: 255},
 			darkColorANSI:  101,
 			lightColor:     &color.RGBA{R: 87, G: 99, B: 65, A: 255},
 			lightColorANSI: 58,
 		},
 		"strings": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "strings",
 			name:           "Localization",
 			glyph:          fa.Language,
+			glyphClass:     fa.Class,
+			glyphID:        "language",
 			darkColor:      &color.RGBA{R: 36, G: 150, B: 190, A: 255},
 			darkColorANSI:  31,
 			lightColor:     &color.RGBA{R: 28, G: 112, B: 142, A: 255},
 			lightColorANSI: 24,
 		},
 		"styl": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "styl",
 			name:           "Styl",
 			glyph:          seti.Stylus,
+			glyphClass:     seti.Class,
+			glyphID:        "stylus",
 			darkColor:      &color.RGBA{R: 141, G: 193, B: 73, A: 255},
 			darkColorANSI:  113,
 			lightColor:     &color.RGBA{R: 70, G: 96, B: 36, A: 255},
 			lightColorANSI: 22,
 		},
 		"sub": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "sub",
 			name:           "Sub",
 			glyph:          md.Subtitles,
+			glyphClass:     md.Class,
+			glyphID:        "subtitles",
 			darkColor:      &color.RGBA{R: 255, G: 183, B: 19, A: 255},
 			darkColorANSI:  214,
 			lightColor:     &color.RGBA{R: 128, G: 92, B: 10, A: 255},
 			lightColorANSI: 94,
 		},
 		"sublime": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "sublime",
 			name:           "Sublime",
 			glyph:          dev.Sublime,
+			glyphClass:     dev.Class,
+			glyphID:        "sublime",
 			darkColor:      &color.RGBA{R: 227, G: 121, B: 51, A: 255},
 			darkColorANSI:  166,
 			lightColor:     &color.RGBA{R: 151, G: 81, B: 34, A: 255},
 			lightColorANSI: 130,
 		},
 		"suo": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "suo",
 			name:           "Suo",
 			glyph:          dev.Visualstudio,
+			glyphClass:     dev.Class,
+			glyphID:        "visualstudio",
 			darkColor:      &color.RGBA{R: 133, G: 76, B: 199, A: 255},
 			darkColorANSI:  98,
 			lightColor:     &color.RGBA{R: 100, G: 56, B: 149, A: 255},
 			lightColorANSI: 91,
 		},
 		"sv": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "sv",
 			name:           "SystemVerilog",
 			glyph:          md.Memory,
+			glyphClass:     md.Class,
+			glyphID:        "memory",
 			darkColor:      &color.RGBA{R: 1, G: 152, B: 51, A: 255},
 			darkColorANSI:  28,
 			lightColor:     &color.RGBA{R: 1, G: 113, B: 38, A: 255},
 			lightColorANSI: 22,
 		},
 		"svelte": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "svelte",
 			name:           "Svelte",
 			glyph:          seti.Svelte,
+			glyphClass:     seti.Class,
+			glyphID:        "svelte",
 			darkColor:      &color.RGBA{R: 255, G: 62, B: 0, A: 255},
 			darkColorANSI:  196,
 			lightColor:     &color.RGBA{R: 191, G: 46, B: 0, A: 255},
 			lightColorANSI: 160,
 		},
 		"svg": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "svg",
 			name:           "Svg",
 			glyph:          md.Svg,
+			glyphClass:     md.Class,
+			glyphID:        "svg",
 			darkColor:      &color.RGBA{R: 255, G: 177, B: 59, A: 255},
 			darkColorANSI:  214,
 			lightColor:     &color.RGBA{R: 128, G: 88, B: 30, A: 255},
 			lightColorANSI: 94,
 		},
 		"svgz": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "svgz",
 			name:           "Svgz",
 			glyph:          md.Svg,
+			glyphClass:     md.Class,
+			glyphID:        "svg",
 			darkColor:      &color.RGBA{R: 255, G: 177, B: 59, A: 255},
 			darkColorANSI:  214,
 			lightColor:     &color.RGBA{R: 128, G: 88, B: 30, A: 255},
 			lightColorANSI: 94,
 		},
 		"svh": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "svh",
 			name:           "SystemVerilog",
 			glyph:          md.Memory,
+			glyphClass:     md.Class,
+			glyphID:        "memory",
 			darkColor:      &color.RGBA{R: 1, G: 152, B: 51, A: 255},
 			darkColorANSI:  28,
 			lightColor:     &color.RGBA{R: 1, G: 113, B: 38, A: 255},
 			lightColorANSI: 22,
 		},
 		"svx": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "svx",
 			name:           "SvelteMarkdown",
 			glyph:          seti.Svelte,
+			glyphClass:     seti.Class,
+			glyphID:        "svelte",
 			darkColor:      &color.RGBA{R: 255, G: 0, B: 65, A: 255},
 			darkColorANSI:  197,
 			lightColor:     &color.RGBA{R: 255, G: 0, B: 65, A: 255},
 			lightColorANSI: 197,
 		},
 		"swift": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "swift",
 			name:           "Swift",
 			glyph:          dev.Swift,
+			glyphClass:     dev.Class,
+			glyphID:        "swift",
 			darkColor:      &color.RGBA{R: 227, G: 121, B: 51, A: 255},
 			darkColorANSI:  166,
 			lightColor:     &color.RGBA{R: 151, G: 81, B: 34, A: 255},
 			lightColorANSI: 130,
 		},
 		"t": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "t",
 			name:           "Tor",
 			glyph:          dev.Perl,
+			glyphClass:     dev.Class,
+			glyphID:        "perl",
 			darkColor:      &color.RGBA{R: 81, G: 154, B: 186, A: 255},
 			darkColorANSI:  74,
 			lightColor:     &color.RGBA{R: 54, G: 103, B: 124, A: 255},
 			lightColorANSI: 24,
 		},
 		"tbc": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "tbc",
 			name:           "Tcl",
 			glyph:          md.Feather,
+			glyphClass:     md.Class,
+			glyphID:        "feather",
 			darkColor:      &color.RGBA{R: 30, G: 92, B: 179, A: 255},
 			darkColorANSI:  25,
 			lightColor:     &color.RGBA{R: 30, G: 92, B: 179, A: 255},
 			lightColorANSI: 25,
 		},
 		"tcl": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "tcl",
 			name:           "Tcl",
 			glyph:          md.Feather,
+			glyphClass:     md.Class,
+			glyphID:        "feather",
 			darkColor:      &color.RGBA{R: 30, G: 92, B: 179, A: 255},
 			darkColorANSI:  25,
 			lightColor:     &color.RGBA{R: 30, G: 92, B: 179, A: 255},
 			lightColorANSI: 25,
 		},
 		"templ": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "templ",
 			name:           "Templ",
 			glyph:          cod.Code,
+			glyphClass:     cod.Class,
+			glyphID:        "code",
 			darkColor:      &color.RGBA{R: 219, G: 189, B: 48, A: 255},
 			darkColorANSI:  178,
 			lightColor:     &color.RGBA{R: 110, G: 94, B: 24, A: 255},
 			lightColorANSI: 58,
 		},
 		"terminal": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "terminal",
 			name:           "Terminal",
 			glyph:          oct.Terminal,
+			glyphClass:     oct.Class,
+			glyphID:        "terminal",
 			darkColor:      &color.RGBA{R: 48, G: 181, B: 62, A: 255},
 			darkColorANSI:  34,
 			lightColor:     &color.RGBA{R: 32, G: 121, B: 40, A: 255},
 			lightColorANSI: 28,
 		},
 		"test.js": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "test.js",
 			name:           "TestJs",
 			glyph:          oct.Beaker,
+			glyphClass:     oct.Class,
+			glyphID:        "beaker",
 			darkColor:      &color.RGBA{R: 203, G: 203, B: 65, A: 255},
 			darkColorANSI:  185,
 			lightColor:     &color.RGBA{R: 102, G: 102, B: 32, A: 255},
 			lightColorANSI: 58,
 		},
 		"test.jsx": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "test.jsx",
 			name:           "JavaScriptReactTest",
 			glyph:          oct.Beaker,
+			glyphClass:     oct.Class,
+			glyphID:        "beaker",
 			darkColor:      &color.RGBA{R: 32, G: 194, B: 227, A: 255},
 			darkColorANSI:  45,
 			lightColor:     &color.RGBA{R: 21, G: 129, B: 151, A: 255},
 			lightColorANSI: 31,
 		},
 		"test.ts": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "test.ts",
 			name:           "TestTs",
 			glyph:          oct.Beaker,
+			glyphClass:     oct.Class,
+			glyphID:        "beaker",
 			darkColor:      &color.RGBA{R: 81, G: 154, B: 186, A: 255},
 			darkColorANSI:  74,
 			lightColor:     &color.RGBA{R: 54, G: 103, B: 124, A: 255},
 			lightColorANSI: 24,
 		},
 		"test.tsx": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "test.tsx",
 			name:           "TypeScriptReactTest",
 			glyph:          oct.Beaker,
+			glyphClass:     oct.Class,
+			glyphID:        "beaker",
 			darkColor:      &color.RGBA{R: 19, G: 84, B: 191, A: 255},
 			darkColorANSI:  26,
 			lightColor:     &color.RGBA{R: 19, G: 84, B: 191, A: 255},
 			lightColorANSI: 26,
 		},
 		"tex": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "tex",
 			name:           "Tex",
 			glyph:          seti.Tex,
+			glyphClass:     seti.Class,
+			glyphID:        "tex",
 			darkColor:      &color.RGBA{R: 60, G: 97, B: 23, A: 255},
 			darkColorANSI:  22,
 			lightColor:     &color.RGBA{R: 60, G: 97, B: 23, A: 255},
 			lightColorANSI: 22,
 		},
 		"tf": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "tf",
 			name:           "Terraform",
 			glyph:          seti.Terraform,
+			glyphClass:     seti.Class,
+			glyphID:        "terraform",
 			darkColor:      &color.RGBA{R: 95, G: 67, B: 233, A: 255},
 			darkColorANSI:  93,
 			lightColor:     &color.RGBA{R: 71, G: 50, B: 175, A: 255},
 			lightColorANSI: 55,
 		},
 		"tfvars": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "tfvars",
 			name:           "TFVars",
 			glyph:          fa.File,
+			glyphClass:     fa.Class,
+			glyphID:        "file",
 			darkColor:      &color.RGBA{R: 95, G: 67, B: 233, A: 255},
 			darkColorANSI:  93,
 			lightColor:     &color.RGBA{R: 71, G: 50, B: 175, A: 255},
 			lightColorANSI: 55,
 		},
 		"tgz": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "tgz",
 			name:           "Tgz",
 			glyph:          oct.FileZip,
+			glyphClass:     oct.Class,
+			glyphID:        "file_zip",
 			darkColor:      &color.RGBA{R: 236, G: 165, B: 23, A: 255},
 			darkColorANSI:  214,
 			lightColor:     &color.RGBA{R: 118, G: 81, B: 12, A: 255},
 			lightColorANSI: 94,
 		},
 		"tmpl": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "tmpl",
 			name:           "Template",
 			glyph:          cod.Code,
+			glyphClass:     cod.Class,
+			glyphID:        "code",
 			darkColor:      &color.RGBA{R: 219, G: 189, B: 48, A: 255},
 			darkColorANSI:  178,
 			lightColor:     &color.RGBA{R: 110, G: 94, B: 24, A: 255},
 			lightColorANSI: 58,
 		},
 		"tmux": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "tmux",
 			name:           "Tmux",
 			glyph:          cod.TerminalTmux,
+			glyphClass:     cod.Class,
+			glyphID:        "terminal_tmux",
 			darkColor:      &color.RGBA{R: 20, G: 186, B: 25, A: 255},
 			darkColorANSI:  34,
 			lightColor:     &color.RGBA{R: 15, G: 140, B: 19, A: 255},
 			lightColorANSI: 28,
 		},
 		"toml": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "toml",
 			name:           "Toml",
 			glyph:          custom.Toml,
+			glyphClass:     custom.Class,
+			glyphID:        "toml",
 			darkColor:      &color.RGBA{R: 156, G: 65, B: 32, A: 255},
 			darkColorANSI:  124,
 			lightColor:     &color.RGBA{R: 117, G: 50, B: 25, A: 255},
 			lightColorANSI: 88,
 		},
 		"torrent": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "torrent",
 			name:           "Torrent",
 			glyph:          fa.Download,
+			glyphClass:     fa.Class,
+			glyphID:        "download",
 			darkColor:      &color.RGBA{R: 68, G: 205, B: 168, A: 255},
 			darkColorANSI:  43,
 			lightColor:     &color.RGBA{R: 34, G: 102, B: 84, A: 255},
 			lightColorANSI: 23,
 		},
 		"tres": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "tres",
 			name:           "GodotTextResource",
 			glyph:          seti.Godot,
+			glyphClass:     seti.Class,
+			glyphID:        "godot",
 			darkColor:      &color.RGBA{R: 109, G: 128, B: 134, A: 255},
 			darkColorANSI:  66,
 			lightColor:     &color.RGBA{R: 81, G: 96, B: 100, A: 255},
 			lightColorANSI: 59,
 		},
 		"ts": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "ts",
 			name:           "TypeScript",
 			glyph:          seti.Typescript,
+			glyphClass:     seti.Class,
+			glyphID:        "typescript",
 			darkColor:      &color.RGBA{R: 81, G: 154, B: 186, A: 255},
 			darkColorANSI:  74,
 			lightColor:     &color.RGBA{R: 54, G: 103, B: 124, A: 255},
 			lightColorANSI: 24,
 		},
 		"tscn": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "tscn",
 			name:           "GodotTextScene",
 			glyph:          seti.Godot,
+			glyphClass:     seti.Class,
+			glyphID:        "godot",
 			darkColor:      &color.RGBA{R: 109, G: 128, B: 134, A: 255},
 			darkColorANSI:  66,
 			lightColor:     &color.RGBA{R: 81, G: 96, B: 100, A: 255},
 			lightColorANSI: 59,
 		},
 		"tsconfig": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "tsconfig",
 			name:           "TypoScriptConfig",
 			glyph:          dev.Typo3,
+			glyphClass:     dev.Class,
+			glyphID:        "typo3",
 			darkColor:      &color.RGBA{R: 255, G: 135, B: 0, A: 255},
 			darkColorANSI:  208,
 			lightColor:     &color.RGBA{R: 170, G: 89, B: 0, A: 255},
 			lightColorANSI: 130,
 		},
 		"tsx": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "tsx",
 			name:           "Tsx",
 			glyph:          dev.React,
+			glyphClass:     dev.Class,
+			glyphID:        "react",
 			darkColor:      &color.RGBA{R: 19, G: 84, B: 191, A: 255},
 			darkColorANSI:  26,
 			lightColor:     &color.RGBA{R: 19, G: 84, B: 191, A: 255},
 			lightColorANSI: 26,
 		},
 		"ttf": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "ttf",
 			name:           "TrueTypeFont",
 			glyph:          fa.Font,
+			glyphClass:     fa.Class,
+			glyphID:        "font",
 			darkColor:      &color.RGBA{R: 236, G: 236, B: 236, A: 255},
 			darkColorANSI:  255,
 			lightColor:     &color.RGBA{R: 47, G: 47, B: 47, A: 255},
 			lightColorANSI: 236,
 		},
 		"twig": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "twig",
 			name:           "Twig",
 			glyph:          seti.Twig,
+			glyphClass:     seti.Class,
+			glyphID:        "twig",
 			darkColor:      &color.RGBA{R: 141, G: 193, B: 73, A: 255},
 			darkColorANSI:  113,
 			lightColor:     &color.RGBA{R: 70, G: 96, B: 36, A: 255},
 			lightColorANSI: 22,
 		},
 		"txt": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "txt",
 			name:           "Txt",
 			glyph:          md.FileDocument,
+			glyphClass:     md.Class,
+			glyphID:        "file_document",
 			darkColor:      &color.RGBA{R: 137, G: 224, B: 81, A: 255},
 			darkColorANSI:  113,
 			lightColor:     &color.RGBA{R: 68, G: 112, B: 40, A: 255},
 			lightColorANSI: 22,
 		},
 		"txz": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "txz",
 			name:           "Txz",
 			glyph:          oct.FileZip,
+			glyphClass:     oct.Class,
+			glyphID:        "file_zip",
 			darkColor:      &color.RGBA{R: 236, G: 165, B: 23, A: 255},
 			darkColorANSI:  214,
 			lightColor:     &color.RGBA{R: 118, G: 81, B: 12, A: 255},
 			lightColorANSI: 94,
 		},
 		"typ": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "typ",
 			name:           "Typst",
 			glyph:          linux.Typst,
+			glyphClass:     linux.Class,
+			glyphID:        "typst",
 			darkColor:      &color.RGBA{R: 13, G: 188, B: 192, A: 255},
 			darkColorANSI:  37,
 			lightColor:     &color.RGBA{R: 9, G: 125, B: 128, A: 255},
 			lightColorANSI: 30,
 		},
 		"typoscript": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "typoscript",
 			name:           "TypoScript",
 			glyph:          dev.Typo3,
+			glyphClass:     dev.Class,
+			glyphID:        "typo3",
 			darkColor:      &color.RGBA{R: 255, G: 135, B: 0, A: 255},
 			darkColorANSI:  208,
 			lightColor:     &color.RGBA{R: 170, G: 89, B: 0, A: 255},
 			lightColorANSI: 130,
 		},
 		"ui": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "ui",
 			name:           "UI",
 			glyph:          fa.WindowMaximize,
+			glyphClass:     fa.Class,
+			glyphID:        "window_maximize",
 			darkColor:      &color.RGBA{R: 1, G: 91, B: 240, A: 255},
 			darkColorANSI:  27,
 			lightColor:     &color.RGBA{R: 1, G: 91, B: 240, A: 255},
 			lightColorANSI: 27,
 		},
 		"v": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "v",
 			name:           "Verilog",
 			glyph:          md.Memory,
+			glyphClass:     md.Class,
+			glyphID:        "memory",
 			darkColor:      &color.RGBA{R: 1, G: 152, B: 51, A: 255},
 			darkColorANSI:  28,
 			lightColor:     &color.RGBA{R: 1, G: 113, B: 38, A: 255},
 			lightColorANSI: 22,
 		},
 		"vala": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "vala",
 			name:           "Vala",
 			glyph:          dev.Vala,
+			glyphClass:     dev.Class,
+			glyphID:        "vala",
 			darkColor:      &color.RGBA{R: 123, G: 60, B: 185, A: 255},
 			darkColorANSI:  91,
 			lightColor:     &color.RGBA{R: 92, G: 46, B: 139, A: 255},
 			lightColorANSI: 54,
 		},
 		"vert": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "vert",
 			name:           "VertexShader",
 			glyph:          dev.Opengl,
+			glyphClass:     dev.Class,
+			glyphID:        "opengl",
 			darkColor:      &color.RGBA{R: 85, G: 134, B: 166, A: 255},
 			darkColorANSI:  67,
 			lightColor:     &color.RGBA{R: 64, G: 100, B: 124, A: 255},
 			lightColorANSI: 24,
 		},
 		"vh": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "vh",
 			name:           "Verilog",
 			glyph:          md.Memory,
+			glyphClass:     md.Class,
+			glyphID:        "memory",
 			darkColor:      &color.RGBA{R: 1, G: 152, B: 51, A: 255},
 			darkColorANSI:  28,
 			lightColor:     &color.RGBA{R: 1, G: 113, B: 38, A: 255},
 			lightColorANSI: 22,
 		},
 		"vhd": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "vhd",
 			name:           "VHDL",
 			glyph:          md.Memory,
+			glyphClass:     md.Class,
+			glyphID:        "memory",
 			darkColor:      &color.RGBA{R: 1, G: 152, B: 51, A: 255},
 			darkColorANSI:  28,
 			lightColor:     &color.RGBA{R: 1, G: 113, B: 38, A: 255},
 			lightColorANSI: 22,
 		},
 		"vhdl": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "vhdl",
 			name:           "VHDL",
 			glyph:          md.Memory,
+			glyphClass:     md.Class,
+			glyphID:        "memory",
 			darkColor:      &color.RGBA{R: 1, G: 152, B: 51, A: 255},
 			darkColorANSI:  28,
 			lightColor:     &color.RGBA{R: 1, G: 113, B: 38, A: 255},
 			lightColorANSI: 22,
 		},
 		"vi": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "vi",
 			name:           "LabView",
 			glyph:          dev.Labview,
+			glyphClass:     dev.Class,
+			glyphID:        "labview",
 			darkColor:      &color.RGBA{R: 254, G: 198, B: 10, A: 255},
 			darkColorANSI:  220,
 			lightColor:     &color.RGBA{R: 85, G: 65, B: 3, A: 255},
 			lightColorANSI: 58,
 		},
 		"vim": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "vim",
 			name:           "Vim",
 			glyph:          custom.Vim,
+			glyphClass:     custom.Class,
+			glyphID:        "vim",
 			darkColor:      &color.RGBA{R: 1, G: 152, B: 51, A: 255},
 			darkColorANSI:  28,
 			lightColor:     &color.RGBA{R: 1, G: 113, B: 38, A: 255},
 			lightColorANSI: 22,
 		},
 		"vsh": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "vsh",
 			name:           "Vlang",
 			glyph:          custom.VLang,
+			glyphClass:     custom.Class,
+			glyphID:        "v_lang",
 			darkColor:      &color.RGBA{R: 93, G: 135, B: 191, A: 255},
 			darkColorANSI:  67,
 			lightColor:     &color.RGBA{R: 62, G: 89, B: 127, A: 255},
 			lightColorANSI: 24,
 		},
 		"vsix": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "vsix",
 			name:           "Vsix",
 			glyph:          dev.Visualstudio,
+			glyphClass:     dev.Class,
+			glyphID:        "visualstudio",
 			darkColor:      &color.RGBA{R: 133, G: 76, B: 199, A: 255},
 			darkColorANSI:  98,
 			lightColor:     &color.RGBA{R: 100, G: 56, B: 149, A: 255},
 			lightColorANSI: 91,
 		},
 		"vue": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "vue",
 			name:           "Vue",
 			glyph:          seti.Vue,
+			glyphClass:     seti.Class,
+			glyphID:        "vue",
 			darkColor:      &color.RGBA{R: 141, G: 193, B: 73, A: 255},
 			darkColorANSI:  113,
 			lightColor:     &color.RGBA{R: 70, G: 96, B: 36, A: 255},
 			lightColorANSI: 22,
 		},
 		"wasm": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "wasm",
 			name:           "Wasm",
 			glyph:          seti.Wasm,
+			glyphClass:     seti.Class,
+			glyphID:        "wasm",
 			darkColor:      &color.RGBA{R: 92, G: 76, B: 219, A: 255},
 			darkColorANSI:  62,
 			lightColor:     &color.RGBA{R: 69, G: 56, B: 163, A: 255},
 			lightColorANSI: 55,
 		},
 		"wav": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "wav",
 			name:           "WaveformAudioFile",
 			glyph:          fa.Music,
+			glyphClass:     fa.Class,
+			glyphID:        "music",
 			darkColor:      &color.RGBA{R: 0, G: 175, B: 255, A: 255},
 			darkColorANSI:  39,
 			lightColor:     &color.RGBA{R: 0, G: 117, B: 170, A: 255},
 			lightColorANSI: 24,
 		},
 		"webm": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "webm",
 			name:           "Webm",
 			glyph:          seti.Video,
+			glyphClass:     seti.Class,
+			glyphID:        "video",
 			darkColor:      &color.RGBA{R: 253, G: 151, B: 31, A: 255},
 			darkColorANSI:  208,
 			lightColor:     &color.RGBA{R: 126, G: 76, B: 16, A: 255},
 			lightColorANSI: 94,
 		},
 		"webmanifest": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "webmanifest",
 			name:           "Webmanifest",
 			glyph:          seti.Json,
+			glyphClass:     seti.Class,
+			glyphID:        "json",
 			darkColor:      &color.RGBA{R: 241, G: 224, B: 89, A: 255},
 			darkColorANSI:  185,
 			lightColor:     &color.RGBA{R: 80, G: 75, B: 30, A: 255},
 			lightColorANSI: 58,
 		},
 		"webp": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "webp",
 			name:           "Webp",
 			glyph:          seti.Image,
+			glyphClass:     seti.Class,
+			glyphID:        "image",
 			darkColor:      &color.RGBA{R: 160, G: 116, B: 195, A: 255},
 			darkColorANSI:  140,
 			lightColor:     &color.RGBA{R: 107, G: 77, B: 131, A: 255},
 			lightColorANSI: 96,
 		},
 		"webpack": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "webpack",
 			name:           "Webpack",
 			glyph:          md.Webpack,
+			glyphClass:     md.Class,
+			glyphID:        "webpack",
 			darkColor:      &color.RGBA{R: 81, G: 154, B: 186, A: 255},
 			darkColorANSI:  74,
 			lightColor:     &color.RGBA{R: 54, G: 103, B: 124, A: 255},
 			lightColorANSI: 24,
 		},
 		"wma": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "wma",
 			name:           "WindowsMediaAudio",
 			glyph:          fa.Music,
+			glyphClass:     fa.Class,
+			glyphID:        "music",
 			darkColor:      &color.RGBA{R: 0, G: 175, B: 255, A: 255},
 			darkColorANSI:  39,
 			lightColor:     &color.RGBA{R: 0, G: 117, B: 170, A: 255},
 			lightColorANSI: 24,
 		},
 		"wmv": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "wmv",
 			name:           "WindowsMediaVideo",
 			glyph:          seti.Video,
+			glyphClass:     seti.Class,
+			glyphID:        "video",
 			darkColor:      &color.RGBA{R: 253, G: 151, B: 31, A: 255},
 			darkColorANSI:  208,
 			lightColor:     &color.RGBA{R: 126, G: 76, B: 16, A: 255},
 			lightColorANSI: 94,
 		},
 		"woff2": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "woff2",
 			name:           "WebOpenFontFormat",
 			glyph:          fa.Font,
+			glyphClass:     fa.Class,
+			glyphID:        "font",
 			darkColor:      &color.RGBA{R: 236, G: 236, B: 236, A: 255},
 			darkColorANSI:  255,
 			lightColor:     &color.RGBA{R: 47, G: 47, B: 47, A: 255},
 			lightColorANSI: 236,
 		},
 		"woff": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "woff",
 			name:           "WebOpenFontFormat",
 			glyph:          fa.Font,
+			glyphClass:     fa.Class,
+			glyphID:        "font",
 			darkColor:      &color.RGBA{R: 236, G: 236, B: 236, A: 255},
 			darkColorANSI:  255,
 			lightColor:     &color.RGBA{R: 47, G: 47, B: 47, A: 255},
 			lightColorANSI: 236,
 		},
 		"wrl": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "wrl",
 			name:           "VRML",
 			glyph:          md.CubeOutline,
+			glyphClass:     md.Class,
+			glyphID:        "cube_outline",
 			darkColor:      &color.RGBA{R: 136, G: 136, B: 136, A: 255},
 			darkColorANSI:  102,
 			lightColor:     &color.RGBA{R: 91, G: 91, B: 91, A: 255},
 			lightColorANSI: 240,
 		},
 		"wrz": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "wrz",
 			name:           "VRML",
 			glyph:          md.CubeOutline,
+			glyphClass:     md.Class,
+			glyphID:        "cube_outline",
 			darkColor:      &color.RGBA{R: 136, G: 136, B: 136, A: 255},
 			darkColorANSI:  102,
 			lightColor:     &color.RGBA{R: 91, G: 91, B: 91, A: 255},
 			lightColorANSI: 240,
 		},
 		"wv": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "wv",
 			name:           "WavPack",
 			glyph:          fa.Music,
+			glyphClass:     fa.Class,
+			glyphID:        "music",
 			darkColor:      &color.RGBA{R: 0, G: 175, B: 255, A: 255},
 			darkColorANSI:  39,
 			lightColor:     &color.RGBA{R: 0, G: 117, B: 170, A: 255},
 			lightColorANSI: 24,
 		},
 		"wvc": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "wvc",
 			name:           "WavPackCorrection",
 			glyph:          fa.Music,
+			glyphClass:     fa.Class,
+			glyphID:        "music",
 			darkColor:      &color.RGBA{R: 0, G: 175, B: 255, A: 255},
 			darkColorANSI:  39,
 			lightColor:     &color.RGBA{R: 0, G: 117, B: 170, A: 255},
 			lightColorANSI: 24,
 		},
 		"x": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "x",
 			name:           "Logos",
 			glyph:          seti.Shell,
+			glyphClass:     seti.Class,
+			glyphID:        "shell",
 			darkColor:      &color.RGBA{R: 89, G: 158, B: 255, A: 255},
 			darkColorANSI:  111,
 			lightColor:     &color.RGBA{R: 59, G: 105, B: 170, A: 255},
 			lightColorANSI: 25,
 		},
 		"xaml": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "xaml",
 			name:           "Xaml",
 			glyph:          md.LanguageXaml,
+			glyphClass:     md.Class,
+			glyphID:        "language_xaml",
 			darkColor:      &color.RGBA{R: 81, G: 43, B: 211, A: 255},
 			darkColorANSI:  56,
 			lightColor:     &color.RGBA{R: 81, G: 43, B: 211, A: 255},
 			lightColorANSI: 56,
 		},
 		"xcf": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "xcf",
 			name:           "GIMP",
 			glyph:          linux.Gimp,
+			glyphClass:     linux.Class,
+			glyphID:        "gimp",
 			darkColor:      &color.RGBA{R: 99, G: 91, B: 70, A: 255},
 			darkColorANSI:  240,
 			lightColor:     &color.RGBA{R: 73, G: 68, B: 52, A: 255},
 			lightColorANSI: 238,
 		},
 		"xcplayground": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "xcplayground",
 			name:           "XcPlayground",
 			glyph:          dev.Swift,
+			glyphClass:     dev.Class,
+			glyphID:        "swift",
 			darkColor:      &color.RGBA{R: 227, G: 121, B: 51, A: 255},
 			darkColorANSI:  166,
 			lightColor:     &color.RGBA{R: 151, G: 81, B: 34, A: 255},
 			lightColorANSI: 130,
 		},
 		"xcstrings": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "xcstrings",
 			name:           "XcLocalization",
 			glyph:          fa.Language,
+			glyphClass:     fa.Class,
+			glyphID:        "language",
 			darkColor:      &color.RGBA{R: 36, G: 150, B: 190, A: 255},
 			darkColorANSI:  31,
 			lightColor:     &color.RGBA{R: 28, G: 112, B: 142, A: 255},
 			lightColorANSI: 24,
 		},
 		"xls": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "xls",
 			name:           "Xls",
 			glyph:          md.FileExcel,
+			glyphClass:     md.Class,
+			glyphID:        "file_excel",
 			darkColor:      &color.RGBA{R: 32, G: 113, B: 69, A: 255},
 			darkColorANSI:  29,
 			lightColor:     &color.RGBA{R: 32, G: 113, B: 69, A: 255},
 			lightColorANSI: 29,
 		},
 		"xlsx": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "xlsx",
 			name:           "Xlsx",
 			glyph:          md.FileExcel,
+			glyphClass:     md.Class,
+			glyphID:        "file_excel",
 			darkColor:      &color.RGBA{R: 32, G: 113, B: 69, A: 255},
 			darkColorANSI:  29,
 			lightColor:     &color.RGBA{R: 32, G: 113, B: 69, A: 255},
 			lightColorANSI: 29,
 		},
 		"xm": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "xm",
 			name:           "Logos",
 			glyph:          seti.Shell,
+			glyphClass:     seti.Class,
+			glyphID:        "shell",
 			darkColor:      &color.RGBA{R: 81, G: 154, B: 186, A: 255},
 			darkColorANSI:  74,
 			lightColor:     &color.RGBA{R: 54, G: 103, B: 124, A: 255},
 			lightColorANSI: 24,
 		},
 		"xml": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "xml",
 			name:           "Xml",
 			glyph:          md.Xml,
+			glyphClass:     md.Class,
+			glyphID:        "xml",
 			darkColor:      &color.RGBA{R: 227, G: 121, B: 51, A: 255},
 			darkColorANSI:  166,
 			lightColor:     &color.RGBA{R: 151, G: 81, B: 34, A: 255},
 			lightColorANSI: 130,
 		},
 		"xpi": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "xpi",
 			name:           "Xpi",
 			glyph:          dev.Mozilla,
+			glyphClass:     dev.Class,
+			glyphID:        "mozilla",
 			darkColor:      &color.RGBA{R: 255, G: 27, B: 1, A: 255},
 			darkColorANSI:  196,
 			lightColor:     &color.RGBA{R: 191, G: 20, B: 1, A: 255},
 			lightColorANSI: 124,
 		},
 		"xslt": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "xslt",
 			name:           "Xslt",
 			glyph:          md.Xml,
+			glyphClass:     md.Class,
+			glyphID:        "xml",
 			darkColor:      &color.RGBA{R: 51, G: 169, B: 220, A: 255},
 			darkColorANSI:  74,
 			lightColor:     &color.RGBA{R: 34, G: 113, B: 147, A: 255},
 			lightColorANSI: 24,
 		},
 		"xul": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "xul",
 			name:           "Xul",
 			glyph:          dev.Firefox,
+			glyphClass:     dev.Class,
+			glyphID:        "firefox",
 			darkColor:      &color.RGBA{R: 227, G: 121, B: 51, A: 255},
 			darkColorANSI:  166,
 			lightColor:     &color.RGBA{R: 151, G: 81, B: 34, A: 255},
 			lightColorANSI: 130,
 		},
 		"xz": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "xz",
 			name:           "Xz",
 			glyph:          oct.FileZip,
+			glyphClass:     oct.Class,
+			glyphID:        "file_zip",
 			darkColor:      &color.RGBA{R: 236, G: 165, B: 23, A: 255},
 			darkColorANSI:  214,
 			lightColor:     &color.RGBA{R: 118, G: 81, B: 12, A: 255},
 			lightColorANSI: 94,
 		},
 		"yaml": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "yaml",
 			name:           "Yaml",
 			glyph:          seti.Config,
+			glyphClass:     seti.Class,
+			glyphID:        "config",
 			darkColor:      &color.RGBA{R: 109, G: 128, B: 134, A: 255},
 			darkColorANSI:  66,
 			lightColor:     &color.RGBA{R: 81, G: 96, B: 100, A: 255},
 			lightColorANSI: 59,
 		},
 		"yml": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "yml",
 			name:           "Yml",
 			glyph:          seti.Config,
+			glyphClass:     seti.Class,
+			glyphID:        "config",
 			darkColor:      &color.RGBA{R: 109, G: 128, B: 134, A: 255},
 			darkColorANSI:  66,
 			lightColor:     &color.RGBA{R: 81, G: 96, B: 100, A: 255},
 			lightColorANSI: 59,
 		},
 		"zig": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "zig",
 			name:           "Zig",
 			glyph:          seti.Zig,
+			glyphClass:     seti.Class,
+			glyphID:        "zig",
 			darkColor:      &color.RGBA{R: 246, G: 154, B: 27, A: 255},
 			darkColorANSI:  172,
 			lightColor:     &color.RGBA{R: 123, G: 77, B: 14, A: 255},
 			lightColorANSI: 94,
 		},
 		"zip": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "zip",
 			name:           "Zip",
 			glyph:          oct.FileZip,
+			glyphClass:     oct.Class,
+			glyphID:        "file_zip",
 			darkColor:      &color.RGBA{R: 236, G: 165, B: 23, A: 255},
 			darkColorANSI:  214,
 			lightColor:     &color.RGBA{R: 118, G: 81, B: 12, A: 255},
 			lightColorANSI: 94,
 		},
 		"zsh": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "zsh",
 			name:           "Zsh",
 			glyph:          dev.Terminal,
+			glyphClass:     dev.Class,
+			glyphID:        "terminal",
 			darkColor:      &color.RGBA{R: 137, G: 224, B: 81, A: 255},
 			darkColorANSI:  113,
 			lightColor:     &color.RGBA{R: 68, G: 112, B: 40, A: 255},
 			lightColorANSI: 22,
 		},
 		"zst": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "zst",
 			name:           "Zst",
 			glyph:          oct.FileZip,
+			glyphClass:     oct.Class,
+			glyphID:        "file_zip",
 			darkColor:      &color.RGBA{R: 236, G: 165, B: 23, A: 255},
 			darkColorANSI:  214,
 			lightColor:     &color.RGBA{R: 118, G: 81, B: 12, A: 255},
 			lightColorANSI: 94,
 		},
 		"🔥": &neoGlyph{
+			category:       CategoryFileExtension,
+			key:            "🔥",
 			name:           "Mojo",
 			glyph:          fa.Fire,
+			glyphClass:     fa.Class,
+			glyphID:        "fire",
 			darkColor:      &color.RGBA{R: 255, G: 76, B: 31, A: 255},
 			darkColorANSI:  196,
 			lightColor:     &color.RGBA{R: 191, G: 56, B: 23, A: 255},
@@ -3989,1728 +5969,2592 @@ var (
 	}
 	filenames = map[string]Result{
 		".SRCINFO": &neoGlyph{
+			category:       CategoryFileName,
+			key:            ".SRCINFO",
 			name:           "SRCINFO",
 			glyph:          md.Arch,
+			glyphClass:     md.Class,
+			glyphID:        "arch",
 			darkColor:      &color.RGBA{R: 15, G: 147, B: 210, A: 255},
 			darkColorANSI:  67,
 			lightColor:     &color.RGBA{R: 11, G: 111, B: 158, A: 255},
 			lightColorANSI: 24,
 		},
 		".Xauthority": &neoGlyph{
+			category:       CategoryFileName,
+			key:            ".Xauthority",
 			name:           "Xauthority",
 			glyph:          linux.Xorg,
+			glyphClass:     linux.Class,
+			glyphID:        "xorg",
 			darkColor:      &color.RGBA{R: 229, G: 77, B: 24, A: 255},
 			darkColorANSI:  196,
 			lightColor:     &color.RGBA{R: 172, G: 58, B: 18, A: 255},
 			lightColorANSI: 124,
 		},
 		".Xresources": &neoGlyph{
+			category:       CategoryFileName,
+			key:            ".Xresources",
 			name:           "Xresources",
 			glyph:          linux.Xorg,
+			glyphClass:     linux.Class,
+			glyphID:        "xorg",
 			darkColor:      &color.RGBA{R: 229, G: 77, B: 24, A: 255},
 			darkColorANSI:  196,
 			lightColor:     &color.RGBA{R: 172, G: 58, B: 18, A: 255},
 			lightColorANSI: 124,
 		},
 		".babelrc": &neoGlyph{
+			category:       CategoryFileName,
+			key:            ".babelrc",
 			name:           "Babelrc",
 			glyph:          seti.Babel,
+			glyphClass:     seti.Class,
+			glyphID:        "babel",
 			darkColor:      &color.RGBA{R: 203, G: 203, B: 65, A: 255},
 			darkColorANSI:  185,
 			lightColor:     &color.RGBA{R: 102, G: 102, B: 32, A: 255},
 			lightColorANSI: 58,
 		},
 		".bash_profile": &neoGlyph{
+			category:       CategoryFileName,
+			key:            ".bash_profile",
 			name:           "BashProfile",
 			glyph:          seti.Config,
+			glyphClass:     seti.Class,
+			glyphID:        "config",
 			darkColor:      &color.RGBA{R: 137, G: 224, B: 81, A: 255},
 			darkColorANSI:  113,
 			lightColor:     &color.RGBA{R: 68, G: 112, B: 40, A: 255},
 			lightColorANSI: 22,
 		},
 		".bashrc": &neoGlyph{
+			category:       CategoryFileName,
+			key:            ".bashrc",
 			name:           "Bashrc",
 			glyph:          seti.Config,
+			glyphClass:     seti.Class,
+			glyphID:        "config",
 			darkColor:      &color.RGBA{R: 137, G: 224, B: 81, A: 255},
 			darkColorANSI:  113,
 			lightColor:     &color.RGBA{R: 68, G: 112, B: 40, A: 255},
 			lightColorANSI: 22,
 		},
 		".clang-format": &neoGlyph{
+			category:       CategoryFileName,
+			key:            ".clang-format",
 			name:           "ClangConfig",
 			glyph:          seti.Config,
+			glyphClass:     seti.Class,
+			glyphID:        "config",
 			darkColor:      &color.RGBA{R: 109, G: 128, B: 134, A: 255},
 			darkColorANSI:  66,
 			lightColor:     &color.RGBA{R: 81, G: 96, B: 100, A: 255},
 			lightColorANSI: 59,
 		},
 		".clang-tidy": &neoGlyph{
+			category:       CategoryFileName,
+			key:            ".clang-tidy",
 			name:           "ClangConfig",
 			glyph:          seti.Config,
+			glyphClass:     seti.Class,
+			glyphID:        "config",
 			darkColor:      &color.RGBA{R: 109, G: 128, B: 134, A: 255},
 			darkColorANSI:  66,
 			lightColor:     &color.RGBA{R: 81, G: 96, B: 100, A: 255},
 			lightColorANSI: 59,
 		},
 		".clangd": &neoGlyph{
+			category:       CategoryFileName,
+			key:            ".clangd",
 			name:           "ClangConfig",
 			glyph:          seti.Config,
+			glyphClass:     seti.Class,
+			glyphID:        "config",
 			darkColor:      &color.RGBA{R: 109, G: 128, B: 134, A: 255},
 			darkColorANSI:  66,
 			lightColor:     &color.RGBA{R: 81, G: 96, B: 100, A: 255},
 			lightColorANSI: 59,
 		},
 		".codespellrc": &neoGlyph{
+			category:       CategoryFileName,
+			key:            ".codespellrc",
 			name:           "Codespell",
 			glyph:          md.Spellcheck,
+			glyphClass:     md.Class,
+			glyphID:        "spellcheck",
 			darkColor:      &color.RGBA{R: 52, G: 218, B: 96, A: 255},
 			darkColorANSI:  41,
 			lightColor:     &color.RGBA{R: 35, G: 145, B: 64, A: 255},
 			lightColorANSI: 28,
 		},
 		".condarc": &neoGlyph{
+			category:       CategoryFileName,
+			key:            ".condarc",
 			name:           "Conda",
 			glyph:          dev.Anaconda,
+			glyphClass:     dev.Class,
+			glyphID:        "anaconda",
 			darkColor:      &color.RGBA{R: 67, G: 176, B: 42, A: 255},
 			darkColorANSI:  34,
 			lightColor:     &color.RGBA{R: 44, G: 117, B: 28, A: 255},
 			lightColorANSI: 28,
 		},
 		".dockerignore": &neoGlyph{
+			category:       CategoryFileName,
+			key:            ".dockerignore",
 			name:           "Dockerfile",
 			glyph:          md.Docker,
+			glyphClass:     md.Class,
+			glyphID:        "docker",
 			darkColor:      &color.RGBA{R: 69, G: 142, B: 230, A: 255},
 			darkColorANSI:  68,
 			lightColor:     &color.RGBA{R: 46, G: 95, B: 153, A: 255},
 			lightColorANSI: 25,
 		},
 		".ds_store": &neoGlyph{
+			category:       CategoryFileName,
+			key:            ".ds_store",
 			name:           "DsStore",
 			glyph:          seti.Config,
+			glyphClass:     seti.Class,
+			glyphID:        "config",
 			darkColor:      &color.RGBA{R: 65, G: 83, B: 91, A: 255},
 			darkColorANSI:  239,
 			lightColor:     &color.RGBA{R: 65, G: 83, B: 91, A: 255},
 			lightColorANSI: 239,
 		},
 		".editorconfig": &neoGlyph{
+			category:       CategoryFileName,
+			key:            ".editorconfig",
 			name:           "EditorConfig",
 			glyph:          seti.Editorconfig,
+			glyphClass:     seti.Class,
+			glyphID:        "editorconfig",
 			darkColor:      &color.RGBA{R: 255, G: 242, B: 242, A: 255},
 			darkColorANSI:  255,
 			lightColor:     &color.RGBA{R: 51, G: 48, B: 48, A: 255},
 			lightColorANSI: 236,
 		},
 		".env": &neoGlyph{
+			category:       CategoryFileName,
+			key:            ".env",
 			name:           "Env",
 			glyph:          oct.Sliders,
+			glyphClass:     oct.Class,
+			glyphID:        "sliders",
 			darkColor:      &color.RGBA{R: 250, G: 247, B: 67, A: 255},
 			darkColorANSI:  227,
 			lightColor:     &color.RGBA{R: 50, G: 48, B: 13, A: 255},
 			lightColorANSI: 236,
 		},
 		".eslintignore": &neoGlyph{
+			category:       CategoryFileName,
+			key:            ".eslintignore",
 			name:           "EslintIgnore",
 			glyph:          seti.Eslint,
+			glyphClass:     seti.Class,
+			glyphID:        "eslint",
 			darkColor:      &color.RGBA{R: 75, G: 50, B: 195, A: 255},
 			darkColorANSI:  56,
 			lightColor:     &color.RGBA{R: 75, G: 50, B: 195, A: 255},
 			lightColorANSI: 56,
 		},
 		".eslintrc": &neoGlyph{
+			category:       CategoryFileName,
+			key:            ".eslintrc",
 			name:           "Eslintrc",
 			glyph:          seti.Eslint,
+			glyphClass:     seti.Class,
+			glyphID:        "eslint",
 			darkColor:      &color.RGBA{R: 75, G: 50, B: 195, A: 255},
 			darkColorANSI:  56,
 			lightColor:     &color.RGBA{R: 75, G: 50, B: 195, A: 255},
 			lightColorANSI: 56,
 		},
 		".git-blame-ignore-revs": &neoGlyph{
+			category:       CategoryFileName,
+			key:            ".git-blame-ignore-revs",
 			name:           "GitBlameIgnore",
 			glyph:          dev.Git,
+			glyphClass:     dev.Class,
+			glyphID:        "git",
 			darkColor:      &color.RGBA{R: 245, G: 77, B: 39, A: 255},
 			darkColorANSI:  196,
 			lightColor:     &color.RGBA{R: 184, G: 58, B: 29, A: 255},
 			lightColorANSI: 160,
 		},
 		".gitattributes": &neoGlyph{
+			category:       CategoryFileName,
+			key:            ".gitattributes",
 			name:           "GitAttributes",
 			glyph:          dev.Git,
+			glyphClass:     dev.Class,
+			glyphID:        "git",
 			darkColor:      &color.RGBA{R: 245, G: 77, B: 39, A: 255},
 			darkColorANSI:  196,
 			lightColor:     &color.RGBA{R: 184, G: 58, B: 29, A: 255},
 			lightColorANSI: 160,
 		},
 		".gitconfig": &neoGlyph{
+			category:       CategoryFileName,
+			key:            ".gitconfig",
 			name:           "GitConfig",
 			glyph:          seti.Config,
+			glyphClass:     seti.Class,
+			glyphID:        "config",
 			darkColor:      &color.RGBA{R: 245, G: 77, B: 39, A: 255},
 			darkColorANSI:  196,
 			lightColor:     &color.RGBA{R: 184, G: 58, B: 29, A: 255},
 			lightColorANSI: 160,
 		},
 		".gitignore": &neoGlyph{
+			category:       CategoryFileName,
+			key:            ".gitignore",
 			name:           "GitIgnore",
 			glyph:          dev.Git,
+			glyphClass:     dev.Class,
+			glyphID:        "git",
 			darkColor:      &color.RGBA{R: 245, G: 77, B: 39, A: 255},
 			darkColorANSI:  196,
 			lightColor:     &color.RGBA{R: 184, G: 58, B: 29, A: 255},
 			lightColorANSI: 160,
 		},
 		".gitlab-ci.yml": &neoGlyph{
+			category:       CategoryFileName,
+			key:            ".gitlab-ci.yml",
 			name:           "GitlabCI",
 			glyph:          fa.Gitlab,
+			glyphClass:     fa.Class,
+			glyphID:        "gitlab",
 			darkColor:      &color.RGBA{R: 226, G: 67, B: 40, A: 255},
 			darkColorANSI:  196,
 			lightColor:     &color.RGBA{R: 170, G: 50, B: 31, A: 255},
 			lightColorANSI: 124,
 		},
 		".gitmodules": &neoGlyph{
+			category:       CategoryFileName,
+			key:            ".gitmodules",
 			name:           "GitModules",
 			glyph:          dev.Git,
+			glyphClass:     dev.Class,
+			glyphID:        "git",
 			darkColor:      &color.RGBA{R: 245, G: 77, B: 39, A: 255},
 			darkColorANSI:  196,
 			lightColor:     &color.RGBA{R: 184, G: 58, B: 29, A: 255},
 			lightColorANSI: 160,
 		},
 		".gtkrc-2.0": &neoGlyph{
+			category:       CategoryFileName,
+			key:            ".gtkrc-2.0",
 			name:           "GTK",
 			glyph:          linux.Gtk,
+			glyphClass:     linux.Class,
+			glyphID:        "gtk",
 			darkColor:      &color.RGBA{R: 255, G: 255, B: 255, A: 255},
 			darkColorANSI:  231,
 			lightColor:     &color.RGBA{R: 51, G: 51, B: 51, A: 255},
 			lightColorANSI: 236,
 		},
 		".gvimrc": &neoGlyph{
+			category:       CategoryFileName,
+			key:            ".gvimrc",
 			name:           "Gvimrc",
 			glyph:          custom.Vim,
+			glyphClass:     custom.Class,
+			glyphID:        "vim",
 			darkColor:      &color.RGBA{R: 1, G: 152, B: 51, A: 255},
 			darkColorANSI:  28,
 			lightColor:     &color.RGBA{R: 1, G: 113, B: 38, A: 255},
 			lightColorANSI: 22,
 		},
 		".justfile": &neoGlyph{
+			category:       CategoryFileName,
+			key:            ".justfile",
 			name:           "Justfile",
 			glyph:          fa.Wrench,
+			glyphClass:     fa.Class,
+			glyphID:        "wrench",
 			darkColor:      &color.RGBA{R: 109, G: 128, B: 134, A: 255},
 			darkColorANSI:  66,
 			lightColor:     &color.RGBA{R: 81, G: 96, B: 100, A: 255},
 			lightColorANSI: 59,
 		},
 		".luacheckrc": &neoGlyph{
+			category:       CategoryFileName,
+			key:            ".luacheckrc",
 			name:           "Luacheckrc",
 			glyph:          seti.Config,
+			glyphClass:     seti.Class,
+			glyphID:        "config",
 			darkColor:      &color.RGBA{R: 0, G: 162, B: 255, A: 255},
 			darkColorANSI:  75,
 			lightColor:     &color.RGBA{R: 0, G: 121, B: 191, A: 255},
 			lightColorANSI: 32,
 		},
 		".luaurc": &neoGlyph{
+			category:       CategoryFileName,
+			key:            ".luaurc",
 			name:           "Luaurc",
 			glyph:          seti.Config,
+			glyphClass:     seti.Class,
+			glyphID:        "config",
 			darkColor:      &color.RGBA{R: 0, G: 162, B: 255, A: 255},
 			darkColorANSI:  75,
 			lightColor:     &color.RGBA{R: 0, G: 121, B: 191, A: 255},
 			lightColorANSI: 32,
 		},
 		".mailmap": &neoGlyph{
+			category:       CategoryFileName,
+			key:            ".mailmap",
 			name:           "Mailmap",
 			glyph:          md.Git,
+			glyphClass:     md.Class,
+			glyphID:        "git",
 			darkColor:      &color.RGBA{R: 245, G: 77, B: 39, A: 255},
 			darkColorANSI:  196,
 			lightColor:     &color.RGBA{R: 184, G: 58, B: 29, A: 255},
 			lightColorANSI: 160,
 		},
 		".nanorc": &neoGlyph{
+			category:       CategoryFileName,
+			key:            ".nanorc",
 			name:           "Nano",
 			glyph:          dev.Nano,
+			glyphClass:     dev.Class,
+			glyphID:        "nano",
 			darkColor:      &color.RGBA{R: 68, G: 0, B: 119, A: 255},
 			darkColorANSI:  54,
 			lightColor:     &color.RGBA{R: 68, G: 0, B: 119, A: 255},
 			lightColorANSI: 54,
 		},
 		".npmignore": &neoGlyph{
+			category:       CategoryFileName,
+			key:            ".npmignore",
 			name:           "NPMIgnore",
 			glyph:          dev.Npm,
+			glyphClass:     dev.Class,
+			glyphID:        "npm",
 			darkColor:      &color.RGBA{R: 232, G: 39, B: 75, A: 255},
 			darkColorANSI:  197,
 			lightColor:     &color.RGBA{R: 174, G: 29, B: 56, A: 255},
 			lightColorANSI: 161,
 		},
 		".npmrc": &neoGlyph{
+			category:       CategoryFileName,
+			key:            ".npmrc",
 			name:           "NPMrc",
 			glyph:          dev.Npm,
+			glyphClass:     dev.Class,
+			glyphID:        "npm",
 			darkColor:      &color.RGBA{R: 232, G: 39, B: 75, A: 255},
 			darkColorANSI:  197,
 			lightColor:     &color.RGBA{R: 174, G: 29, B: 56, A: 255},
 			lightColorANSI: 161,
 		},
 		".nuxtrc": &neoGlyph{
+			category:       CategoryFileName,
+			key:            ".nuxtrc",
 			name:           "NuxtConfig",
 			glyph:          md.Nuxt,
+			glyphClass:     md.Class,
+			glyphID:        "nuxt",
 			darkColor:      &color.RGBA{R: 0, G: 197, B: 142, A: 255},
 			darkColorANSI:  42,
 			lightColor:     &color.RGBA{R: 0, G: 131, B: 95, A: 255},
 			lightColorANSI: 29,
 		},
 		".nvmrc": &neoGlyph{
+			category:       CategoryFileName,
+			key:            ".nvmrc",
 			name:           "node",
 			glyph:          dev.NodejsSmall,
+			glyphClass:     dev.Class,
+			glyphID:        "nodejs_small",
 			darkColor:      &color.RGBA{R: 95, G: 160, B: 78, A: 255},
 			darkColorANSI:  71,
 			lightColor:     &color.RGBA{R: 63, G: 107, B: 52, A: 255},
 			lightColorANSI: 22,
 		},
 		".pnpmfile.cjs": &neoGlyph{
+			category:       CategoryFileName,
+			key:            ".pnpmfile.cjs",
 			name:           "PNPMFile",
 			glyph:          dev.Pnpm,
+			glyphClass:     dev.Class,
+			glyphID:        "pnpm",
 			darkColor:      &color.RGBA{R: 249, G: 173, B: 2, A: 255},
 			darkColorANSI:  214,
 			lightColor:     &color.RGBA{R: 124, G: 86, B: 1, A: 255},
 			lightColorANSI: 94,
 		},
 		".pre-commit-config.yaml": &neoGlyph{
+			category:       CategoryFileName,
+			key:            ".pre-commit-config.yaml",
 			name:           "PreCommitConfig",
 			glyph:          md.Hook,
+			glyphClass:     md.Class,
+			glyphID:        "hook",
 			darkColor:      &color.RGBA{R: 248, G: 179, B: 36, A: 255},
 			darkColorANSI:  214,
 			lightColor:     &color.RGBA{R: 124, G: 89, B: 18, A: 255},
 			lightColorANSI: 94,
 		},
 		".prettierignore": &neoGlyph{
+			category:       CategoryFileName,
+			key:            ".prettierignore",
 			name:           "PrettierIgnore",
 			glyph:          custom.Prettier,
+			glyphClass:     custom.Class,
+			glyphID:        "prettier",
 			darkColor:      &color.RGBA{R: 65, G: 133, B: 243, A: 255},
 			darkColorANSI:  33,
 			lightColor:     &color.RGBA{R: 50, G: 100, B: 183, A: 255},
 			lightColorANSI: 25,
 		},
 		".prettierrc.cjs": &neoGlyph{
+			category:       CategoryFileName,
+			key:            ".prettierrc.cjs",
 			name:           "PrettierConfig",
 			glyph:          custom.Prettier,
+			glyphClass:     custom.Class,
+			glyphID:        "prettier",
 			darkColor:      &color.RGBA{R: 65, G: 133, B: 243, A: 255},
 			darkColorANSI:  33,
 			lightColor:     &color.RGBA{R: 50, G: 100, B: 183, A: 255},
 			lightColorANSI: 25,
 		},
 		".prettierrc.js": &neoGlyph{
+			category:       CategoryFileName,
+			key:            ".prettierrc.js",
 			name:           "PrettierConfig",
 			glyph:          custom.Prettier,
+			glyphClass:     custom.Class,
+			glyphID:        "prettier",
 			darkColor:      &color.RGBA{R: 65, G: 133, B: 243, A: 255},
 			darkColorANSI:  33,
 			lightColor:     &color.RGBA{R: 50, G: 100, B: 183, A: 255},
 			lightColorANSI: 25,
 		},
 		".prettierrc.json5": &neoGlyph{
+			category:       CategoryFileName,
+			key:            ".prettierrc.json5",
 			name:           "PrettierConfig",
 			glyph:          custom.Prettier,
+			glyphClass:     custom.Class,
+			glyphID:        "prettier",
 			darkColor:      &color.RGBA{R: 65, G: 133, B: 243, A: 255},
 			darkColorANSI:  33,
 			lightColor:     &color.RGBA{R: 50, G: 100, B: 183, A: 255},
 			lightColorANSI: 25,
 		},
 		".prettierrc.json": &neoGlyph{
+			category:       CategoryFileName,
+			key:            ".prettierrc.json",
 			name:           "PrettierConfig",
 			glyph:          custom.Prettier,
+			glyphClass:     custom.Class,
+			glyphID:        "prettier",
 			darkColor:      &color.RGBA{R: 65, G: 133, B: 243, A: 255},
 			darkColorANSI:  33,
 			lightColor:     &color.RGBA{R: 50, G: 100, B: 183, A: 255},
 			lightColorANSI: 25,
 		},
 		".prettierrc.mjs": &neoGlyph{
+			category:       CategoryFileName,
+			key:            ".prettierrc.mjs",
 			name:           "PrettierConfig",
 			glyph:          custom.Prettier,
+			glyphClass:     custom.Class,
+			glyphID:        "prettier",
 			darkColor:      &color.RGBA{R: 65, G: 133, B: 243, A: 255},
 			darkColorANSI:  33,
 			lightColor:     &color.RGBA{R: 50, G: 100, B: 183, A: 255},
 			lightColorANSI: 25,
 		},
 		".prettierrc.toml": &neoGlyph{
+			category:       CategoryFileName,
+			key:            ".prettierrc.toml",
 			name:           "PrettierConfig",
 			glyph:          custom.Prettier,
+			glyphClass:     custom.Class,
+			glyphID:        "prettier",
 			darkColor:      &color.RGBA{R: 65, G: 133, B: 243, A: 255},
 			darkColorANSI:  33,
 			lightColor:     &color.RGBA{R: 50, G: 100, B: 183, A: 255},
 			lightColorANSI: 25,
 		},
 		".prettierrc.yaml": &neoGlyph{
+			category:       CategoryFileName,
+			key:            ".prettierrc.yaml",
 			name:           "PrettierConfig",
 			glyph:          custom.Prettier,
+			glyphClass:     custom.Class,
+			glyphID:        "prettier",
 			darkColor:      &color.RGBA{R: 65, G: 133, B: 243, A: 255},
 			darkColorANSI:  33,
 			lightColor:     &color.RGBA{R: 50, G: 100, B: 183, A: 255},
 			lightColorANSI: 25,
 		},
 		".prettierrc.yml": &neoGlyph{
+			category:       CategoryFileName,
+			key:            ".prettierrc.yml",
 			name:           "PrettierConfig",
 			glyph:          custom.Prettier,
+			glyphClass:     custom.Class,
+			glyphID:        "prettier",
 			darkColor:      &color.RGBA{R: 65, G: 133, B: 243, A: 255},
 			darkColorANSI:  33,
 			lightColor:     &color.RGBA{R: 50, G: 100, B: 183, A: 255},
 			lightColorANSI: 25,
 		},
 		".prettierrc": &neoGlyph{
+			category:       CategoryFileName,
+			key:            ".prettierrc",
 			name:           "PrettierConfig",
 			glyph:          custom.Prettier,
+			glyphClass:     custom.Class,
+			glyphID:        "prettier",
 			darkColor:      &color.RGBA{R: 65, G: 133, B: 243, A: 255},
 			darkColorANSI:  33,
 			lightColor:     &color.RGBA{R: 50, G: 100, B: 183, A: 255},
 			lightColorANSI: 25,
 		},
 		".pylintrc": &neoGlyph{
+			category:       CategoryFileName,
+			key:            ".pylintrc",
 			name:           "PyLintConfig",
 			glyph:          seti.Config,
+			glyphClass:     seti.Class,
+			glyphID:        "config",
 			darkColor:      &color.RGBA{R: 109, G: 128, B: 134, A: 255},
 			darkColorANSI:  66,
 			lightColor:     &color.RGBA{R: 81, G: 96, B: 100, A: 255},
 			lightColorANSI: 59,
 		},
 		".settings.json": &neoGlyph{
+			category:       CategoryFileName,
+			key:            ".settings.json",
 			name:           "SettingsJson",
 			glyph:          dev.Visualstudio,
+			glyphClass:     dev.Class,
+			glyphID:        "visualstudio",
 			darkColor:      &color.RGBA{R: 133, G: 76, B: 199, A: 255},
 			darkColorANSI:  98,
 			lightColor:     &color.RGBA{R: 100, G: 56, B: 149, A: 255},
 			lightColorANSI: 91,
 		},
 		".vimrc": &neoGlyph{
+			category:       CategoryFileName,
+			key:            ".vimrc",
 			name:           "Vimrc",
 			glyph:          custom.Vim,
+			glyphClass:     custom.Class,
+			glyphID:        "vim",
 			darkColor:      &color.RGBA{R: 1, G: 152, B: 51, A: 255},
 			darkColorANSI:  28,
 			lightColor:     &color.RGBA{R: 1, G: 113, B: 38, A: 255},
 			lightColorANSI: 22,
 		},
 		".xinitrc": &neoGlyph{
+			category:       CategoryFileName,
+			key:            ".xinitrc",
 			name:           "XInitrc",
 			glyph:          linux.Xorg,
+			glyphClass:     linux.Class,
+			glyphID:        "xorg",
 			darkColor:      &color.RGBA{R: 229, G: 77, B: 24, A: 255},
 			darkColorANSI:  196,
 			lightColor:     &color.RGBA{R: 172, G: 58, B: 18, A: 255},
 			lightColorANSI: 124,
 		},
 		".xsession": &neoGlyph{
+			category:       CategoryFileName,
+			key:            ".xsession",
 			name:           "Xsession",
 			glyph:          linux.Xorg,
+			glyphClass:     linux.Class,
+			glyphID:        "xorg",
 			darkColor:      &color.RGBA{R: 229, G: 77, B: 24, A: 255},
 			darkColorANSI:  196,
 			lightColor:     &color.RGBA{R: 172, G: 58, B: 18, A: 255},
 			lightColorANSI: 124,
 		},
 		".zprofile": &neoGlyph{
+			category:       CategoryFileName,
+			key:            ".zprofile",
 			name:           "Zshprofile",
 			glyph:          seti.Config,
+			glyphClass:     seti.Class,
+			glyphID:        "config",
 			darkColor:      &color.RGBA{R: 137, G: 224, B: 81, A: 255},
 			darkColorANSI:  113,
 			lightColor:     &color.RGBA{R: 68, G: 112, B: 40, A: 255},
 			lightColorANSI: 22,
 		},
 		".zshenv": &neoGlyph{
+			category:       CategoryFileName,
+			key:            ".zshenv",
 			name:           "Zshenv",
 			glyph:          seti.Config,
+			glyphClass:     seti.Class,
+			glyphID:        "config",
 			darkColor:      &color.RGBA{R: 137, G: 224, B: 81, A: 255},
 			darkColorANSI:  113,
 			lightColor:     &color.RGBA{R: 68, G: 112, B: 40, A: 255},
 			lightColorANSI: 22,
 		},
 		".zshrc": &neoGlyph{
+			category:       CategoryFileName,
+			key:            ".zshrc",
 			name:           "Zshrc",
 			glyph:          seti.Config,
+			glyphClass:     seti.Class,
+			glyphID:        "config",
 			darkColor:      &color.RGBA{R: 137, G: 224, B: 81, A: 255},
 			darkColorANSI:  113,
 			lightColor:     &color.RGBA{R: 68, G: 112, B: 40, A: 255},
 			lightColorANSI: 22,
 		},
 		"AUTHORS.txt": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "AUTHORS.txt",
 			name:           "AUTHORS",
 			glyph:          fa.UserGroup,
+			glyphClass:     fa.Class,
+			glyphID:        "user_group",
 			darkColor:      &color.RGBA{R: 161, G: 113, B: 255, A: 255},
 			darkColorANSI:  135,
 			lightColor:     &color.RGBA{R: 107, G: 76, B: 170, A: 255},
 			lightColorANSI: 61,
 		},
 		"AUTHORS": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "AUTHORS",
 			name:           "AUTHORS",
 			glyph:          fa.UserGroup,
+			glyphClass:     fa.Class,
+			glyphID:        "user_group",
 			darkColor:      &color.RGBA{R: 161, G: 113, B: 255, A: 255},
 			darkColorANSI:  135,
 			lightColor:     &color.RGBA{R: 107, G: 76, B: 170, A: 255},
 			lightColorANSI: 61,
 		},
 		"Directory.Build.props": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "Directory.Build.props",
 			name:           "BuildProps",
 			glyph:          seti.Config,
+			glyphClass:     seti.Class,
+			glyphID:        "config",
 			darkColor:      &color.RGBA{R: 0, G: 162, B: 255, A: 255},
 			darkColorANSI:  75,
 			lightColor:     &color.RGBA{R: 0, G: 121, B: 191, A: 255},
 			lightColorANSI: 32,
 		},
 		"Directory.Build.targets": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "Directory.Build.targets",
 			name:           "BuildTargets",
 			glyph:          seti.Config,
+			glyphClass:     seti.Class,
+			glyphID:        "config",
 			darkColor:      &color.RGBA{R: 0, G: 162, B: 255, A: 255},
 			darkColorANSI:  75,
 			lightColor:     &color.RGBA{R: 0, G: 121, B: 191, A: 255},
 			lightColorANSI: 32,
 		},
 		"Directory.Packages.props": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "Directory.Packages.props",
 			name:           "PackagesProps",
 			glyph:          seti.Config,
+			glyphClass:     seti.Class,
+			glyphID:        "config",
 			darkColor:      &color.RGBA{R: 0, G: 162, B: 255, A: 255},
 			darkColorANSI:  75,
 			lightColor:     &color.RGBA{R: 0, G: 121, B: 191, A: 255},
 			lightColorANSI: 32,
 		},
 		"FreeCAD.conf": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "FreeCAD.conf",
 			name:           "FreeCADConfig",
 			glyph:          linux.Freecad,
+			glyphClass:     linux.Class,
+			glyphID:        "freecad",
 			darkColor:      &color.RGBA{R: 203, G: 51, B: 59, A: 255},
 			darkColorANSI:  160,
 			lightColor:     &color.RGBA{R: 152, G: 38, B: 44, A: 255},
 			lightColorANSI: 88,
 		},
 		"Gemfile": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "Gemfile",
 			name:           "Gemfile",
 			glyph:          dev.RubyRough,
+			glyphClass:     dev.Class,
+			glyphID:        "ruby_rough",
 			darkColor:      &color.RGBA{R: 112, G: 21, B: 22, A: 255},
 			darkColorANSI:  52,
 			lightColor:     &color.RGBA{R: 112, G: 21, B: 22, A: 255},
 			lightColorANSI: 52,
 		},
 		"Jenkinsfile": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "Jenkinsfile",
 			name:           "Jenkins",
 			glyph:          fa.Jenkins,
+			glyphClass:     fa.Class,
+			glyphID:        "jenkins",
 			darkColor:      &color.RGBA{R: 211, G: 56, B: 51, A: 255},
 			darkColorANSI:  160,
 			lightColor:     &color.RGBA{R: 158, G: 42, B: 38, A: 255},
 			lightColorANSI: 124,
 		},
 		"PKGBUILD": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "PKGBUILD",
 			name:           "PKGBUILD",
 			glyph:          linux.Archlinux,
+			glyphClass:     linux.Class,
+			glyphID:        "archlinux",
 			darkColor:      &color.RGBA{R: 15, G: 147, B: 210, A: 255},
 			darkColorANSI:  67,
 			lightColor:     &color.RGBA{R: 11, G: 111, B: 158, A: 255},
 			lightColorANSI: 24,
 		},
 		"PrusaSlicer.ini": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "PrusaSlicer.ini",
 			name:           "PrusaSlicer",
 			glyph:          linux.Prusaslicer,
+			glyphClass:     linux.Class,
+			glyphID:        "prusaslicer",
 			darkColor:      &color.RGBA{R: 236, G: 107, B: 35, A: 255},
 			darkColorANSI:  202,
 			lightColor:     &color.RGBA{R: 157, G: 71, B: 23, A: 255},
 			lightColorANSI: 130,
 		},
 		"PrusaSlicerGcodeViewer.ini": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "PrusaSlicerGcodeViewer.ini",
 			name:           "PrusaSlicer",
 			glyph:          linux.Prusaslicer,
+			glyphClass:     linux.Class,
+			glyphID:        "prusaslicer",
 			darkColor:      &color.RGBA{R: 236, G: 107, B: 35, A: 255},
 			darkColorANSI:  202,
 			lightColor:     &color.RGBA{R: 157, G: 71, B: 23, A: 255},
 			lightColorANSI: 130,
 		},
 		"QtProject.conf": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "QtProject.conf",
 			name:           "Qt",
 			glyph:          linux.Qt,
+			glyphClass:     linux.Class,
+			glyphID:        "qt",
 			darkColor:      &color.RGBA{R: 64, G: 205, B: 81, A: 255},
 			darkColorANSI:  77,
 			lightColor:     &color.RGBA{R: 43, G: 137, B: 55, A: 255},
 			lightColorANSI: 28,
 		},
 		"_gvimrc": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "_gvimrc",
 			name:           "Gvimrc",
 			glyph:          custom.Vim,
+			glyphClass:     custom.Class,
+			glyphID:        "vim",
 			darkColor:      &color.RGBA{R: 1, G: 152, B: 51, A: 255},
 			darkColorANSI:  28,
 			lightColor:     &color.RGBA{R: 1, G: 113, B: 38, A: 255},
 			lightColorANSI: 22,
 		},
 		"_vimrc": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "_vimrc",
 			name:           "Vimrc",
 			glyph:          custom.Vim,
+			glyphClass:     custom.Class,
+			glyphID:        "vim",
 			darkColor:      &color.RGBA{R: 1, G: 152, B: 51, A: 255},
 			darkColorANSI:  28,
 			lightColor:     &color.RGBA{R: 1, G: 113, B: 38, A: 255},
 			lightColorANSI: 22,
 		},
 		"brewfile": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "brewfile",
 			name:           "Brewfile",
 			glyph:          dev.RubyRough,
+			glyphClass:     dev.Class,
+			glyphID:        "ruby_rough",
 			darkColor:      &color.RGBA{R: 112, G: 21, B: 22, A: 255},
 			darkColorANSI:  52,
 			lightColor:     &color.RGBA{R: 112, G: 21, B: 22, A: 255},
 			lightColorANSI: 52,
 		},
 		"bspwmrc": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "bspwmrc",
 			name:           "BSPWM",
 			glyph:          linux.Bspwm,
+			glyphClass:     linux.Class,
+			glyphID:        "bspwm",
 			darkColor:      &color.RGBA{R: 47, G: 47, B: 47, A: 255},
 			darkColorANSI:  236,
 			lightColor:     &color.RGBA{R: 47, G: 47, B: 47, A: 255},
 			lightColorANSI: 236,
 		},
 		"build.gradle": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "build.gradle",
 			name:           "GradleBuildScript",
 			glyph:          seti.Gradle,
+			glyphClass:     seti.Class,
+			glyphID:        "gradle",
 			darkColor:      &color.RGBA{R: 0, G: 95, B: 135, A: 255},
 			darkColorANSI:  24,
 			lightColor:     &color.RGBA{R: 0, G: 95, B: 135, A: 255},
 			lightColorANSI: 24,
 		},
 		"build.zig.zon": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "build.zig.zon",
 			name:           "ZigObjectNotation",
 			glyph:          seti.Zig,
+			glyphClass:     seti.Class,
+			glyphID:        "zig",
 			darkColor:      &color.RGBA{R: 246, G: 154, B: 27, A: 255},
 			darkColorANSI:  172,
 			lightColor:     &color.RGBA{R: 123, G: 77, B: 14, A: 255},
 			lightColorANSI: 94,
 		},
 		"build": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "build",
 			name:           "BazelBuild",
 			glyph:          custom.Bazel,
+			glyphClass:     custom.Class,
+			glyphID:        "bazel",
 			darkColor:      &color.RGBA{R: 137, G: 224, B: 81, A: 255},
 			darkColorANSI:  113,
 			lightColor:     &color.RGBA{R: 68, G: 112, B: 40, A: 255},
 			lightColorANSI: 22,
 		},
 		"bun.lock": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "bun.lock",
 			name:           "BunLockfile",
 			glyph:          dev.Bun,
+			glyphClass:     dev.Class,
+			glyphID:        "bun",
 			darkColor:      &color.RGBA{R: 234, G: 220, B: 209, A: 255},
 			darkColorANSI:  253,
 			lightColor:     &color.RGBA{R: 78, G: 73, B: 70, A: 255},
 			lightColorANSI: 239,
 		},
 		"bun.lockb": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "bun.lockb",
 			name:           "BunLockfile",
 			glyph:          dev.Bun,
+			glyphClass:     dev.Class,
+			glyphID:        "bun",
 			darkColor:      &color.RGBA{R: 234, G: 220, B: 209, A: 255},
 			darkColorANSI:  253,
 			lightColor:     &color.RGBA{R: 78, G: 73, B: 70, A: 255},
 			lightColorANSI: 239,
 		},
 		"cantorrc": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "cantorrc",
 			name:           "Cantorrc",
 			glyph:          linux.Kde,
+			glyphClass:     linux.Class,
+			glyphID:        "kde",
 			darkColor:      &color.RGBA{R: 28, G: 153, B: 243, A: 255},
 			darkColorANSI:  32,
 			lightColor:     &color.RGBA{R: 21, G: 115, B: 182, A: 255},
 			lightColorANSI: 32,
 		},
 		"checkhealth": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "checkhealth",
 			name:           "Checkhealth",
 			glyph:          md.Stethoscope,
+			glyphClass:     md.Class,
+			glyphID:        "stethoscope",
 			darkColor:      &color.RGBA{R: 117, G: 179, B: 251, A: 255},
 			darkColorANSI:  75,
 			lightColor:     &color.RGBA{R: 58, G: 89, B: 126, A: 255},
 			lightColorANSI: 24,
 		},
 		"cmakelists.txt": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "cmakelists.txt",
 			name:           "CMakeLists",
 			glyph:          dev.Cmake,
+			glyphClass:     dev.Class,
+			glyphID:        "cmake",
 			darkColor:      &color.RGBA{R: 220, G: 227, B: 235, A: 255},
 			darkColorANSI:  254,
 			lightColor:     &color.RGBA{R: 44, G: 44, B: 47, A: 255},
 			lightColorANSI: 236,
 		},
 		"code_of_conduct.md": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "code_of_conduct.md",
 			name:           "CodeOfConduct",
 			glyph:          oct.CodeOfConduct,
+			glyphClass:     oct.Class,
+			glyphID:        "code_of_conduct",
 			darkColor:      &color.RGBA{R: 227, G: 22, B: 97, A: 255},
 			darkColorANSI:  161,
 			lightColor:     &color.RGBA{R: 171, G: 16, B: 73, A: 255},
 			lightColorANSI: 125,
 		},
 		"code_of_conduct": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "code_of_conduct",
 			name:           "CodeOfConduct",
 			glyph:          oct.CodeOfConduct,
+			glyphClass:     oct.Class,
+			glyphID:        "code_of_conduct",
 			darkColor:      &color.RGBA{R: 227, G: 22, B: 97, A: 255},
 			darkColorANSI:  161,
 			lightColor:     &color.RGBA{R: 171, G: 16, B: 73, A: 255},
 			lightColorANSI: 125,
 		},
 		"commit_editmsg": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "commit_editmsg",
 			name:           "GitCommit",
 			glyph:          dev.Git,
+			glyphClass:     dev.Class,
+			glyphID:        "git",
 			darkColor:      &color.RGBA{R: 245, G: 77, B: 39, A: 255},
 			darkColorANSI:  196,
 			lightColor:     &color.RGBA{R: 184, G: 58, B: 29, A: 255},
 			lightColorANSI: 160,
 		},
 		"commitlint.config.js": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "commitlint.config.js",
 			name:           "CommitlintConfig",
 			glyph:          md.SourceCommit,
+			glyphClass:     md.Class,
+			glyphID:        "source_commit",
 			darkColor:      &color.RGBA{R: 43, G: 150, B: 137, A: 255},
 			darkColorANSI:  30,
 			lightColor:     &color.RGBA{R: 32, G: 112, B: 103, A: 255},
 			lightColorANSI: 23,
 		},
 		"commitlint.config.ts": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "commitlint.config.ts",
 			name:           "CommitlintConfig",
 			glyph:          md.SourceCommit,
+			glyphClass:     md.Class,
+			glyphID:        "source_commit",
 			darkColor:      &color.RGBA{R: 43, G: 150, B: 137, A: 255},
 			darkColorANSI:  30,
 			lightColor:     &color.RGBA{R: 32, G: 112, B: 103, A: 255},
 			lightColorANSI: 23,
 		},
 		"compose.yaml": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "compose.yaml",
 			name:           "Dockerfile",
 			glyph:          md.Docker,
+			glyphClass:     md.Class,
+			glyphID:        "docker",
 			darkColor:      &color.RGBA{R: 69, G: 142, B: 230, A: 255},
 			darkColorANSI:  68,
 			lightColor:     &color.RGBA{R: 46, G: 95, B: 153, A: 255},
 			lightColorANSI: 25,
 		},
 		"compose.yml": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "compose.yml",
 			name:           "Dockerfile",
 			glyph:          md.Docker,
+			glyphClass:     md.Class,
+			glyphID:        "docker",
 			darkColor:      &color.RGBA{R: 69, G: 142, B: 230, A: 255},
 			darkColorANSI:  68,
 			lightColor:     &color.RGBA{R: 46, G: 95, B: 153, A: 255},
 			lightColorANSI: 25,
 		},
 		"config": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "config",
 			name:           "Config",
 			glyph:          seti.Config,
+			glyphClass:     seti.Class,
+			glyphID:        "config",
 			darkColor:      &color.RGBA{R: 109, G: 128, B: 134, A: 255},
 			darkColorANSI:  66,
 			lightColor:     &color.RGBA{R: 81, G: 96, B: 100, A: 255},
 			lightColorANSI: 59,
 		},
 		"containerfile": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "containerfile",
 			name:           "Dockerfile",
 			glyph:          md.Docker,
+			glyphClass:     md.Class,
+			glyphID:        "docker",
 			darkColor:      &color.RGBA{R: 69, G: 142, B: 230, A: 255},
 			darkColorANSI:  68,
 			lightColor:     &color.RGBA{R: 46, G: 95, B: 153, A: 255},
 			lightColorANSI: 25,
 		},
 		"copying.lesser": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "copying.lesser",
 			name:           "License",
 			glyph:          seti.License,
+			glyphClass:     seti.Class,
+			glyphID:        "license",
 			darkColor:      &color.RGBA{R: 203, G: 203, B: 65, A: 255},
 			darkColorANSI:  185,
 			lightColor:     &color.RGBA{R: 102, G: 102, B: 32, A: 255},
 			lightColorANSI: 58,
 		},
 		"copying": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "copying",
 			name:           "License",
 			glyph:          seti.License,
+			glyphClass:     seti.Class,
+			glyphID:        "license",
 			darkColor:      &color.RGBA{R: 203, G: 203, B: 65, A: 255},
 			darkColorANSI:  185,
 			lightColor:     &color.RGBA{R: 102, G: 102, B: 32, A: 255},
 			lightColorANSI: 58,
 		},
 		"docker-compose.yaml": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "docker-compose.yaml",
 			name:           "Dockerfile",
 			glyph:          md.Docker,
+			glyphClass:     md.Class,
+			glyphID:        "docker",
 			darkColor:      &color.RGBA{R: 69, G: 142, B: 230, A: 255},
 			darkColorANSI:  68,
 			lightColor:     &color.RGBA{R: 46, G: 95, B: 153, A: 255},
 			lightColorANSI: 25,
 		},
 		"docker-compose.yml": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "docker-compose.yml",
 			name:           "Dockerfile",
 			glyph:          md.Docker,
+			glyphClass:     md.Class,
+			glyphID:        "docker",
 			darkColor:      &color.RGBA{R: 69, G: 142, B: 230, A: 255},
 			darkColorANSI:  68,
 			lightColor:     &color.RGBA{R: 46, G: 95, B: 153, A: 255},
 			lightColorANSI: 25,
 		},
 		"dockerfile": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "dockerfile",
 			name:           "Dockerfile",
 			glyph:          md.Docker,
+			glyphClass:     md.Class,
+			glyphID:        "docker",
 			darkColor:      &color.RGBA{R: 69, G: 142, B: 230, A: 255},
 			darkColorANSI:  68,
 			lightColor:     &color.RGBA{R: 46, G: 95, B: 153, A: 255},
 			lightColorANSI: 25,
 		},
 		"dune": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "dune",
 			name:           "Dunefile",
 			glyph:          seti.Ocaml,
+			glyphClass:     seti.Class,
+			glyphID:        "ocaml",
 			darkColor:      &color.RGBA{R: 138, G: 65, B: 30, A: 255},
 			darkColorANSI:  124,
 			lightColor:     &color.RGBA{R: 138, G: 65, B: 30, A: 255},
 			lightColorANSI: 124,
 		},
 		"eslint.config.cjs": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "eslint.config.cjs",
 			name:           "Eslintrc",
 			glyph:          seti.Eslint,
+			glyphClass:     seti.Class,
+			glyphID:        "eslint",
 			darkColor:      &color.RGBA{R: 75, G: 50, B: 195, A: 255},
 			darkColorANSI:  56,
 			lightColor:     &color.RGBA{R: 75, G: 50, B: 195, A: 255},
 			lightColorANSI: 56,
 		},
 		"eslint.config.js": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "eslint.config.js",
 			name:           "Eslintrc",
 			glyph:          seti.Eslint,
+			glyphClass:     seti.Class,
+			glyphID:        "eslint",
 			darkColor:      &color.RGBA{R: 75, G: 50, B: 195, A: 255},
 			darkColorANSI:  56,
 			lightColor:     &color.RGBA{R: 75, G: 50, B: 195, A: 255},
 			lightColorANSI: 56,
 		},
 		"eslint.config.mjs": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "eslint.config.mjs",
 			name:           "Eslintrc",
 			glyph:          seti.Eslint,
+			glyphClass:     seti.Class,
+			glyphID:        "eslint",
 			darkColor:      &color.RGBA{R: 75, G: 50, B: 195, A: 255},
 			darkColorANSI:  56,
 			lightColor:     &color.RGBA{R: 75, G: 50, B: 195, A: 255},
 			lightColorANSI: 56,
 		},
 		"eslint.config.ts": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "eslint.config.ts",
 			name:           "Eslintrc",
 			glyph:          seti.Eslint,
+			glyphClass:     seti.Class,
+			glyphID:        "eslint",
 			darkColor:      &color.RGBA{R: 75, G: 50, B: 195, A: 255},
 			darkColorANSI:  56,
 			lightColor:     &color.RGBA{R: 75, G: 50, B: 195, A: 255},
 			lightColorANSI: 56,
 		},
 		"ext_typoscript_setup.txt": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "ext_typoscript_setup.txt",
 			name:           "TypoScriptSetup",
 			glyph:          dev.Typo3,
+			glyphClass:     dev.Class,
+			glyphID:        "typo3",
 			darkColor:      &color.RGBA{R: 255, G: 135, B: 0, A: 255},
 			darkColorANSI:  208,
 			lightColor:     &color.RGBA{R: 170, G: 89, B: 0, A: 255},
 			lightColorANSI: 130,
 		},
 		"favicon.ico": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "favicon.ico",
 			name:           "Favicon",
 			glyph:          seti.Favicon,
+			glyphClass:     seti.Class,
+			glyphID:        "favicon",
 			darkColor:      &color.RGBA{R: 203, G: 203, B: 65, A: 255},
 			darkColorANSI:  185,
 			lightColor:     &color.RGBA{R: 102, G: 102, B: 32, A: 255},
 			lightColorANSI: 58,
 		},
 		"fp-info-cache": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "fp-info-cache",
 			name:           "KiCadCache",
 			glyph:          linux.Kicad,
+			glyphClass:     linux.Class,
+			glyphID:        "kicad",
 			darkColor:      &color.RGBA{R: 255, G: 255, B: 255, A: 255},
 			darkColorANSI:  231,
 			lightColor:     &color.RGBA{R: 51, G: 51, B: 51, A: 255},
 			lightColorANSI: 236,
 		},
 		"fp-lib-table": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "fp-lib-table",
 			name:           "KiCadFootprintTable",
 			glyph:          linux.Kicad,
+			glyphClass:     linux.Class,
+			glyphID:        "kicad",
 			darkColor:      &color.RGBA{R: 255, G: 255, B: 255, A: 255},
 			darkColorANSI:  231,
 			lightColor:     &color.RGBA{R: 51, G: 51, B: 51, A: 255},
 			lightColorANSI: 236,
 		},
 		"gnumakefile": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "gnumakefile",
 			name:           "Makefile",
 			glyph:          dev.Gnu,
+			glyphClass:     dev.Class,
+			glyphID:        "gnu",
 			darkColor:      &color.RGBA{R: 109, G: 128, B: 134, A: 255},
 			darkColorANSI:  66,
 			lightColor:     &color.RGBA{R: 81, G: 96, B: 100, A: 255},
 			lightColorANSI: 59,
 		},
 		"go.mod": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "go.mod",
 			name:           "GoMod",
 			glyph:          seti.Go,
+			glyphClass:     seti.Class,
+			glyphID:        "go",
 			darkColor:      &color.RGBA{R: 0, G: 173, B: 216, A: 255},
 			darkColorANSI:  38,
 			lightColor:     &color.RGBA{R: 0, G: 130, B: 162, A: 255},
 			lightColorANSI: 31,
 		},
 		"go.sum": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "go.sum",
 			name:           "GoSum",
 			glyph:          seti.Go,
+			glyphClass:     seti.Class,
+			glyphID:        "go",
 			darkColor:      &color.RGBA{R: 0, G: 173, B: 216, A: 255},
 			darkColorANSI:  38,
 			lightColor:     &color.RGBA{R: 0, G: 130, B: 162, A: 255},
 			lightColorANSI: 31,
 		},
 		"go.work": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "go.work",
 			name:           "GoWork",
 			glyph:          seti.Go,
+			glyphClass:     seti.Class,
+			glyphID:        "go",
 			darkColor:      &color.RGBA{R: 0, G: 173, B: 216, A: 255},
 			darkColorANSI:  38,
 			lightColor:     &color.RGBA{R: 0, G: 130, B: 162, A: 255},
 			lightColorANSI: 31,
 		},
 		"gradle-wrapper.properties": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "gradle-wrapper.properties",
 			name:           "GradleWrapperProperties",
 			glyph:          seti.Gradle,
+			glyphClass:     seti.Class,
+			glyphID:        "gradle",
 			darkColor:      &color.RGBA{R: 0, G: 95, B: 135, A: 255},
 			darkColorANSI:  24,
 			lightColor:     &color.RGBA{R: 0, G: 95, B: 135, A: 255},
 			lightColorANSI: 24,
 		},
 		"gradle.properties": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "gradle.properties",
 			name:           "GradleProperties",
 			glyph:          seti.Gradle,
+			glyphClass:     seti.Class,
+			glyphID:        "gradle",
 			darkColor:      &color.RGBA{R: 0, G: 95, B: 135, A: 255},
 			darkColorANSI:  24,
 			lightColor:     &color.RGBA{R: 0, G: 95, B: 135, A: 255},
 			lightColorANSI: 24,
 		},
 		"gradlew": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "gradlew",
 			name:           "GradleWrapperScript",
 			glyph:          seti.Gradle,
+			glyphClass:     seti.Class,
+			glyphID:        "gradle",
 			darkColor:      &color.RGBA{R: 0, G: 95, B: 135, A: 255},
 			darkColorANSI:  24,
 			lightColor:     &color.RGBA{R: 0, G: 95, B: 135, A: 255},
 			lightColorANSI: 24,
 		},
 		"groovy": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "groovy",
 			name:           "Groovy",
 			glyph:          dev.Groovy,
+			glyphClass:     dev.Class,
+			glyphID:        "groovy",
 			darkColor:      &color.RGBA{R: 73, G: 104, B: 124, A: 255},
 			darkColorANSI:  24,
 			lightColor:     &color.RGBA{R: 56, G: 78, B: 93, A: 255},
 			lightColorANSI: 239,
 		},
 		"gruntfile.babel.js": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "gruntfile.babel.js",
 			name:           "Gruntfile",
 			glyph:          seti.Grunt,
+			glyphClass:     seti.Class,
+			glyphID:        "grunt",
 			darkColor:      &color.RGBA{R: 227, G: 121, B: 51, A: 255},
 			darkColorANSI:  166,
 			lightColor:     &color.RGBA{R: 151, G: 81, B: 34, A: 255},
 			lightColorANSI: 130,
 		},
 		"gruntfile.coffee": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "gruntfile.coffee",
 			name:           "Gruntfile",
 			glyph:          seti.Grunt,
+			glyphClass:     seti.Class,
+			glyphID:        "grunt",
 			darkColor:      &color.RGBA{R: 227, G: 121, B: 51, A: 255},
 			darkColorANSI:  166,
 			lightColor:     &color.RGBA{R: 151, G: 81, B: 34, A: 255},
 			lightColorANSI: 130,
 		},
 		"gruntfile.js": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "gruntfile.js",
 			name:           "Gruntfile",
 			glyph:          seti.Grunt,
+			glyphClass:     seti.Class,
+			glyphID:        "grunt",
 			darkColor:      &color.RGBA{R: 227, G: 121, B: 51, A: 255},
 			darkColorANSI:  166,
 			lightColor:     &color.RGBA{R: 151, G: 81, B: 34, A: 255},
 			lightColorANSI: 130,
 		},
 		"gruntfile.ts": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "gruntfile.ts",
 			name:           "Gruntfile",
 			glyph:          seti.Grunt,
+			glyphClass:     seti.Class,
+			glyphID:        "grunt",
 			darkColor:      &color.RGBA{R: 227, G: 121, B: 51, A: 255},
 			darkColorANSI:  166,
 			lightColor:     &color.RGBA{R: 151, G: 81, B: 34, A: 255},
 			lightColorANSI: 130,
 		},
 		"gtkrc": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "gtkrc",
 			name:           "GTK",
 			glyph:          linux.Gtk,
+			glyphClass:     linux.Class,
+			glyphID:        "gtk",
 			darkColor:      &color.RGBA{R: 255, G: 255, B: 255, A: 255},
 			darkColorANSI:  231,
 			lightColor:     &color.RGBA{R: 51, G: 51, B: 51, A: 255},
 			lightColorANSI: 236,
 		},
 		"gulpfile.babel.js": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "gulpfile.babel.js",
 			name:           "Gulpfile",
 			glyph:          seti.Gulp,
+			glyphClass:     seti.Class,
+			glyphID:        "gulp",
 			darkColor:      &color.RGBA{R: 204, G: 62, B: 68, A: 255},
 			darkColorANSI:  167,
 			lightColor:     &color.RGBA{R: 153, G: 46, B: 51, A: 255},
 			lightColorANSI: 88,
 		},
 		"gulpfile.coffee": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "gulpfile.coffee",
 			name:           "Gulpfile",
 			glyph:          seti.Gulp,
+			glyphClass:     seti.Class,
+			glyphID:        "gulp",
 			darkColor:      &color.RGBA{R: 204, G: 62, B: 68, A: 255},
 			darkColorANSI:  167,
 			lightColor:     &color.RGBA{R: 153, G: 46, B: 51, A: 255},
 			lightColorANSI: 88,
 		},
 		"gulpfile.js": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "gulpfile.js",
 			name:           "Gulpfile",
 			glyph:          seti.Gulp,
+			glyphClass:     seti.Class,
+			glyphID:        "gulp",
 			darkColor:      &color.RGBA{R: 204, G: 62, B: 68, A: 255},
 			darkColorANSI:  167,
 			lightColor:     &color.RGBA{R: 153, G: 46, B: 51, A: 255},
 			lightColorANSI: 88,
 		},
 		"gulpfile.ts": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "gulpfile.ts",
 			name:           "Gulpfile",
 			glyph:          seti.Gulp,
+			glyphClass:     seti.Class,
+			glyphID:        "gulp",
 			darkColor:      &color.RGBA{R: 204, G: 62, B: 68, A: 255},
 			darkColorANSI:  167,
 			lightColor:     &color.RGBA{R: 153, G: 46, B: 51, A: 255},
 			lightColorANSI: 88,
 		},
 		"hypridle.conf": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "hypridle.conf",
 			name:           "Hypridle",
 			glyph:          linux.Hyprland,
+			glyphClass:     linux.Class,
+			glyphID:        "hyprland",
 			darkColor:      &color.RGBA{R: 0, G: 170, B: 174, A: 255},
 			darkColorANSI:  37,
 			lightColor:     &color.RGBA{R: 0, G: 128, B: 130, A: 255},
 			lightColorANSI: 30,
 		},
 		"hyprland.conf": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "hyprland.conf",
 			name:           "Hyprland",
 			glyph:          linux.Hyprland,
+			glyphClass:     linux.Class,
+			glyphID:        "hyprland",
 			darkColor:      &color.RGBA{R: 0, G: 170, B: 174, A: 255},
 			darkColorANSI:  37,
 			lightColor:     &color.RGBA{R: 0, G: 128, B: 130, A: 255},
 			lightColorANSI: 30,
 		},
 		"hyprlandd.conf": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "hyprlandd.conf",
 			name:           "Hyprlandd",
 			glyph:          linux.Hyprland,
+			glyphClass:     linux.Class,
+			glyphID:        "hyprland",
 			darkColor:      &color.RGBA{R: 0, G: 170, B: 174, A: 255},
 			darkColorANSI:  37,
 			lightColor:     &color.RGBA{R: 0, G: 128, B: 130, A: 255},
 			lightColorANSI: 30,
 		},
 		"hyprlock.conf": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "hyprlock.conf",
 			name:           "Hyprlock",
 			glyph:          linux.Hyprland,
+			glyphClass:     linux.Class,
+			glyphID:        "hyprland",
 			darkColor:      &color.RGBA{R: 0, G: 170, B: 174, A: 255},
 			darkColorANSI:  37,
 			lightColor:     &color.RGBA{R: 0, G: 128, B: 130, A: 255},
 			lightColorANSI: 30,
 		},
 		"hyprpaper.conf": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "hyprpaper.conf",
 			name:           "Hyprpaper",
 			glyph:          linux.Hyprland,
+			glyphClass:     linux.Class,
+			glyphID:        "hyprland",
 			darkColor:      &color.RGBA{R: 0, G: 170, B: 174, A: 255},
 			darkColorANSI:  37,
 			lightColor:     &color.RGBA{R: 0, G: 128, B: 130, A: 255},
 			lightColorANSI: 30,
 		},
 		"hyprsunset.conf": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "hyprsunset.conf",
 			name:           "Hyprsunset",
 			glyph:          linux.Hyprland,
+			glyphClass:     linux.Class,
+			glyphID:        "hyprland",
 			darkColor:      &color.RGBA{R: 0, G: 170, B: 174, A: 255},
 			darkColorANSI:  37,
 			lightColor:     &color.RGBA{R: 0, G: 128, B: 130, A: 255},
 			lightColorANSI: 30,
 		},
 		"i18n.config.js": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "i18n.config.js",
 			name:           "I18nConfig",
 			glyph:          md.Translate,
+			glyphClass:     md.Class,
+			glyphID:        "translate",
 			darkColor:      &color.RGBA{R: 121, G: 134, B: 203, A: 255},
 			darkColorANSI:  104,
 			lightColor:     &color.RGBA{R: 81, G: 89, B: 135, A: 255},
 			lightColorANSI: 60,
 		},
 		"i18n.config.ts": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "i18n.config.ts",
 			name:           "I18nConfig",
 			glyph:          md.Translate,
+			glyphClass:     md.Class,
+			glyphID:        "translate",
 			darkColor:      &color.RGBA{R: 121, G: 134, B: 203, A: 255},
 			darkColorANSI:  104,
 			lightColor:     &color.RGBA{R: 81, G: 89, B: 135, A: 255},
 			lightColorANSI: 60,
 		},
 		"i3blocks.conf": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "i3blocks.conf",
 			name:           "i3",
 			glyph:          linux.I3,
+			glyphClass:     linux.Class,
+			glyphID:        "i3",
 			darkColor:      &color.RGBA{R: 232, G: 235, B: 238, A: 255},
 			darkColorANSI:  255,
 			lightColor:     &color.RGBA{R: 46, G: 47, B: 48, A: 255},
 			lightColorANSI: 236,
 		},
 		"i3status.conf": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "i3status.conf",
 			name:           "i3",
 			glyph:          linux.I3,
+			glyphClass:     linux.Class,
+			glyphID:        "i3",
 			darkColor:      &color.RGBA{R: 232, G: 235, B: 238, A: 255},
 			darkColorANSI:  255,
 			lightColor:     &color.RGBA{R: 46, G: 47, B: 48, A: 255},
 			lightColorANSI: 236,
 		},
 		"index.theme": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "index.theme",
 			name:           "IndexTheme",
 			glyph:          fa.PaintRoller,
+			glyphClass:     fa.Class,
+			glyphID:        "paint_roller",
 			darkColor:      &color.RGBA{R: 44, G: 185, B: 111, A: 255},
 			darkColorANSI:  35,
 			lightColor:     &color.RGBA{R: 30, G: 123, B: 73, A: 255},
 			lightColorANSI: 29,
 		},
 		"ionic.config.json": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "ionic.config.json",
 			name:           "Ionic",
 			glyph:          dev.Ionic,
+			glyphClass:     dev.Class,
+			glyphID:        "ionic",
 			darkColor:      &color.RGBA{R: 79, G: 143, B: 247, A: 255},
 			darkColorANSI:  33,
 			lightColor:     &color.RGBA{R: 52, G: 95, B: 165, A: 255},
 			lightColorANSI: 25,
 		},
 		"justfile": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "justfile",
 			name:           "Justfile",
 			glyph:          fa.Wrench,
+			glyphClass:     fa.Class,
+			glyphID:        "wrench",
 			darkColor:      &color.RGBA{R: 109, G: 128, B: 134, A: 255},
 			darkColorANSI:  66,
 			lightColor:     &color.RGBA{R: 81, G: 96, B: 100, A: 255},
 			lightColorANSI: 59,
 		},
 		"kalgebrarc": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "kalgebrarc",
 			name:           "Kalgebrarc",
 			glyph:          linux.Kde,
+			glyphClass:     linux.Class,
+			glyphID:        "kde",
 			darkColor:      &color.RGBA{R: 28, G: 153, B: 243, A: 255},
 			darkColorANSI:  32,
 			lightColor:     &color.RGBA{R: 21, G: 115, B: 182, A: 255},
 			lightColorANSI: 32,
 		},
 		"kdeglobals": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "kdeglobals",
 			name:           "KDEglobals",
 			glyph:          linux.Kde,
+			glyphClass:     linux.Class,
+			glyphID:        "kde",
 			darkColor:      &color.RGBA{R: 28, G: 153, B: 243, A: 255},
 			darkColorANSI:  32,
 			lightColor:     &color.RGBA{R: 21, G: 115, B: 182, A: 255},
 			lightColorANSI: 32,
 		},
 		"kdenlive-layoutsrc": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "kdenlive-layoutsrc",
 			name:           "KdenliveLayoutsrc",
 			glyph:          linux.Kdenlive,
+			glyphClass:     linux.Class,
+			glyphID:        "kdenlive",
 			darkColor:      &color.RGBA{R: 131, G: 184, B: 242, A: 255},
 			darkColorANSI:  110,
 			lightColor:     &color.RGBA{R: 65, G: 92, B: 121, A: 255},
 			lightColorANSI: 24,
 		},
 		"kdenliverc": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "kdenliverc",
 			name:           "Kdenliverc",
 			glyph:          linux.Kdenlive,
+			glyphClass:     linux.Class,
+			glyphID:        "kdenlive",
 			darkColor:      &color.RGBA{R: 131, G: 184, B: 242, A: 255},
 			darkColorANSI:  110,
 			lightColor:     &color.RGBA{R: 65, G: 92, B: 121, A: 255},
 			lightColorANSI: 24,
 		},
 		"kritadisplayrc": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "kritadisplayrc",
 			name:           "Kritadisplayrc",
 			glyph:          linux.Krita,
+			glyphClass:     linux.Class,
+			glyphID:        "krita",
 			darkColor:      &color.RGBA{R: 242, G: 69, B: 251, A: 255},
 			darkColorANSI:  201,
 			lightColor:     &color.RGBA{R: 161, G: 46, B: 167, A: 255},
 			lightColorANSI: 127,
 		},
 		"kritarc": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "kritarc",
 			name:           "Kritarc",
 			glyph:          linux.Krita,
+			glyphClass:     linux.Class,
+			glyphID:        "krita",
 			darkColor:      &color.RGBA{R: 242, G: 69, B: 251, A: 255},
 			darkColorANSI:  201,
 			lightColor:     &color.RGBA{R: 161, G: 46, B: 167, A: 255},
 			lightColorANSI: 127,
 		},
 		"license.md": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "license.md",
 			name:           "License",
 			glyph:          seti.License,
+			glyphClass:     seti.Class,
+			glyphID:        "license",
 			darkColor:      &color.RGBA{R: 208, G: 191, B: 65, A: 255},
 			darkColorANSI:  185,
 			lightColor:     &color.RGBA{R: 104, G: 96, B: 32, A: 255},
 			lightColorANSI: 58,
 		},
 		"license": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "license",
 			name:           "License",
 			glyph:          seti.License,
+			glyphClass:     seti.Class,
+			glyphID:        "license",
 			darkColor:      &color.RGBA{R: 208, G: 191, B: 65, A: 255},
 			darkColorANSI:  185,
 			lightColor:     &color.RGBA{R: 104, G: 96, B: 32, A: 255},
 			lightColorANSI: 58,
 		},
 		"lxde-rc.xml": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "lxde-rc.xml",
 			name:           "LXDEConfigFile",
 			glyph:          linux.Lxde,
+			glyphClass:     linux.Class,
+			glyphID:        "lxde",
 			darkColor:      &color.RGBA{R: 144, G: 144, B: 144, A: 255},
 			darkColorANSI:  246,
 			lightColor:     &color.RGBA{R: 96, G: 96, B: 96, A: 255},
 			lightColorANSI: 59,
 		},
 		"lxqt.conf": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "lxqt.conf",
 			name:           "LXQtConfigFile",
 			glyph:          linux.Lxqt,
+			glyphClass:     linux.Class,
+			glyphID:        "lxqt",
 			darkColor:      &color.RGBA{R: 1, G: 146, B: 211, A: 255},
 			darkColorANSI:  32,
 			lightColor:     &color.RGBA{R: 1, G: 110, B: 158, A: 255},
 			lightColorANSI: 24,
 		},
 		"makefile": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "makefile",
 			name:           "Makefile",
 			glyph:          dev.Gnu,
+			glyphClass:     dev.Class,
+			glyphID:        "gnu",
 			darkColor:      &color.RGBA{R: 109, G: 128, B: 134, A: 255},
 			darkColorANSI:  66,
 			lightColor:     &color.RGBA{R: 81, G: 96, B: 100, A: 255},
 			lightColorANSI: 59,
 		},
 		"mix.lock": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "mix.lock",
 			name:           "MixLock",
 			glyph:          custom.Elixir,
+			glyphClass:     custom.Class,
+			glyphID:        "elixir",
 			darkColor:      &color.RGBA{R: 160, G: 116, B: 195, A: 255},
 			darkColorANSI:  140,
 			lightColor:     &color.RGBA{R: 107, G: 77, B: 131, A: 255},
 			lightColorANSI: 96,
 		},
 		"mpv.conf": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "mpv.conf",
 			name:           "Mpv",
 			glyph:          linux.Mpv,
+			glyphClass:     linux.Class,
+			glyphID:        "mpv",
 			darkColor:      &color.RGBA{R: 59, G: 19, B: 65, A: 255},
 			darkColorANSI:  53,
 			lightColor:     &color.RGBA{R: 59, G: 19, B: 65, A: 255},
 			lightColorANSI: 53,
 		},
 		"next.config.cjs": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "next.config.cjs",
 			name:           "NextConfigCjs",
 			glyph:          dev.Nextjs,
+			glyphClass:     dev.Class,
+			glyphID:        "nextjs",
 			darkColor:      &color.RGBA{R: 255, G: 255, B: 255, A: 255},
 			darkColorANSI:  231,
 			lightColor:     &color.RGBA{R: 51, G: 51, B: 51, A: 255},
 			lightColorANSI: 236,
 		},
 		"next.config.js": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "next.config.js",
 			name:           "NextConfigJs",
 			glyph:          dev.Nextjs,
+			glyphClass:     dev.Class,
+			glyphID:        "nextjs",
 			darkColor:      &color.RGBA{R: 255, G: 255, B: 255, A: 255},
 			darkColorANSI:  231,
 			lightColor:     &color.RGBA{R: 51, G: 51, B: 51, A: 255},
 			lightColorANSI: 236,
 		},
 		"next.config.ts": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "next.config.ts",
 			name:           "NextConfigTs",
 			glyph:          dev.Nextjs,
+			glyphClass:     dev.Class,
+			glyphID:        "nextjs",
 			darkColor:      &color.RGBA{R: 255, G: 255, B: 255, A: 255},
 			darkColorANSI:  231,
 			lightColor:     &color.RGBA{R: 51, G: 51, B: 51, A: 255},
 			lightColorANSI: 236,
 		},
 		"node_modules": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "node_modules",
 			name:           "NodeModules",
 			glyph:          dev.NodejsSmall,
+			glyphClass:     dev.Class,
+			glyphID:        "nodejs_small",
 			darkColor:      &color.RGBA{R: 232, G: 39, B: 75, A: 255},
 			darkColorANSI:  197,
 			lightColor:     &color.RGBA{R: 174, G: 29, B: 56, A: 255},
 			lightColorANSI: 161,
 		},
 		"nuxt.config.cjs": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "nuxt.config.cjs",
 			name:           "NuxtConfig",
 			glyph:          md.Nuxt,
+			glyphClass:     md.Class,
+			glyphID:        "nuxt",
 			darkColor:      &color.RGBA{R: 0, G: 197, B: 142, A: 255},
 			darkColorANSI:  42,
 			lightColor:     &color.RGBA{R: 0, G: 131, B: 95, A: 255},
 			lightColorANSI: 29,
 		},
 		"nuxt.config.js": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "nuxt.config.js",
 			name:           "NuxtConfig",
 			glyph:          md.Nuxt,
+			glyphClass:     md.Class,
+			glyphID:        "nuxt",
 			darkColor:      &color.RGBA{R: 0, G: 197, B: 142, A: 255},
 			darkColorANSI:  42,
 			lightColor:     &color.RGBA{R: 0, G: 131, B: 95, A: 255},
 			lightColorANSI: 29,
 		},
 		"nuxt.config.mjs": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "nuxt.config.mjs",
 			name:           "NuxtConfig",
 			glyph:          md.Nuxt,
+			glyphClass:     md.Class,
+			glyphID:        "nuxt",
 			darkColor:      &color.RGBA{R: 0, G: 197, B: 142, A: 255},
 			darkColorANSI:  42,
 			lightColor:     &color.RGBA{R: 0, G: 131, B: 95, A: 255},
 			lightColorANSI: 29,
 		},
 		"nuxt.config.ts": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "nuxt.config.ts",
 			name:           "NuxtConfig",
 			glyph:          md.Nuxt,
+			glyphClass:     md.Class,
+			glyphID:        "nuxt",
 			darkColor:      &color.RGBA{R: 0, G: 197, B: 142, A: 255},
 			darkColorANSI:  42,
 			lightColor:     &color.RGBA{R: 0, G: 131, B: 95, A: 255},
 			lightColorANSI: 29,
 		},
 		"package-lock.json": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "package-lock.json",
 			name:           "PackageLockJson",
 			glyph:          dev.Npm,
+			glyphClass:     dev.Class,
+			glyphID:        "npm",
 			darkColor:      &color.RGBA{R: 121, G: 13, B: 32, A: 255},
 			darkColorANSI:  52,
 			lightColor:     &color.RGBA{R: 121, G: 13, B: 32, A: 255},
 			lightColorANSI: 52,
 		},
 		"package.json": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "package.json",
 			name:           "PackageJson",
 			glyph:          dev.Npm,
+			glyphClass:     dev.Class,
+			glyphID:        "npm",
 			darkColor:      &color.RGBA{R: 232, G: 39, B: 75, A: 255},
 			darkColorANSI:  197,
 			lightColor:     &color.RGBA{R: 174, G: 29, B: 56, A: 255},
 			lightColorANSI: 161,
 		},
 		"platformio.ini": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "platformio.ini",
 			name:           "Platformio",
 			glyph:          seti.Platformio,
+			glyphClass:     seti.Class,
+			glyphID:        "platformio",
 			darkColor:      &color.RGBA{R: 246, G: 130, B: 43, A: 255},
 			darkColorANSI:  208,
 			lightColor:     &color.RGBA{R: 163, G: 87, B: 29, A: 255},
 			lightColorANSI: 130,
 		},
 		"playwright.config.cjs": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "playwright.config.cjs",
 			name:           "PlaywrightConfig",
 			glyph:          fa.MasksTheater,
+			glyphClass:     fa.Class,
+			glyphID:        "masks_theater",
 			darkColor:      &color.RGBA{R: 47, G: 173, B: 51, A: 255},
 			darkColorANSI:  34,
 			lightColor:     &color.RGBA{R: 35, G: 130, B: 38, A: 255},
 			lightColorANSI: 28,
 		},
 		"playwright.config.cts": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "playwright.config.cts",
 			name:           "PlaywrightConfig",
 			glyph:          fa.MasksTheater,
+			glyphClass:     fa.Class,
+			glyphID:        "masks_theater",
 			darkColor:      &color.RGBA{R: 47, G: 173, B: 51, A: 255},
 			darkColorANSI:  34,
 			lightColor:     &color.RGBA{R: 35, G: 130, B: 38, A: 255},
 			lightColorANSI: 28,
 		},
 		"playwright.config.js": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "playwright.config.js",
 			name:           "PlaywrightConfig",
 			glyph:          fa.MasksTheater,
+			glyphClass:     fa.Class,
+			glyphID:        "masks_theater",
 			darkColor:      &color.RGBA{R: 47, G: 173, B: 51, A: 255},
 			darkColorANSI:  34,
 			lightColor:     &color.RGBA{R: 35, G: 130, B: 38, A: 255},
 			lightColorANSI: 28,
 		},
 		"playwright.config.mjs": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "playwright.config.mjs",
 			name:           "PlaywrightConfig",
 			glyph:          fa.MasksTheater,
+			glyphClass:     fa.Class,
+			glyphID:        "masks_theater",
 			darkColor:      &color.RGBA{R: 47, G: 173, B: 51, A: 255},
 			darkColorANSI:  34,
 			lightColor:     &color.RGBA{R: 35, G: 130, B: 38, A: 255},
 			lightColorANSI: 28,
 		},
 		"playwright.config.mts": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "playwright.config.mts",
 			name:           "PlaywrightConfig",
 			glyph:          fa.MasksTheater,
+			glyphClass:     fa.Class,
+			glyphID:        "masks_theater",
 			darkColor:      &color.RGBA{R: 47, G: 173, B: 51, A: 255},
 			darkColorANSI:  34,
 			lightColor:     &color.RGBA{R: 35, G: 130, B: 38, A: 255},
 			lightColorANSI: 28,
 		},
 		"playwright.config.ts": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "playwright.config.ts",
 			name:           "PlaywrightConfig",
 			glyph:          fa.MasksTheater,
+			glyphClass:     fa.Class,
+			glyphID:        "masks_theater",
 			darkColor:      &color.RGBA{R: 47, G: 173, B: 51, A: 255},
 			darkColorANSI:  34,
 			lightColor:     &color.RGBA{R: 35, G: 130, B: 38, A: 255},
 			lightColorANSI: 28,
 		},
 		"pnpm-lock.yaml": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "pnpm-lock.yaml",
 			name:           "PNPMLock",
 			glyph:          dev.Pnpm,
+			glyphClass:     dev.Class,
+			glyphID:        "pnpm",
 			darkColor:      &color.RGBA{R: 249, G: 173, B: 2, A: 255},
 			darkColorANSI:  214,
 			lightColor:     &color.RGBA{R: 124, G: 86, B: 1, A: 255},
 			lightColorANSI: 94,
 		},
 		"pnpm-workspace.yaml": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "pnpm-workspace.yaml",
 			name:           "PNPMWorkspace",
 			glyph:          dev.Pnpm,
+			glyphClass:     dev.Class,
+			glyphID:        "pnpm",
 			darkColor:      &color.RGBA{R: 249, G: 173, B: 2, A: 255},
 			darkColorANSI:  214,
 			lightColor:     &color.RGBA{R: 124, G: 86, B: 1, A: 255},
 			lightColorANSI: 94,
 		},
 		"pom.xml": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "pom.xml",
 			name:           "Maven",
 			glyph:          seti.Maven,
+			glyphClass:     seti.Class,
+			glyphID:        "maven",
 			darkColor:      &color.RGBA{R: 121, G: 13, B: 32, A: 255},
 			darkColorANSI:  52,
 			lightColor:     &color.RGBA{R: 121, G: 13, B: 32, A: 255},
 			lightColorANSI: 52,
 		},
 		"prettier.config.cjs": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "prettier.config.cjs",
 			name:           "PrettierConfig",
 			glyph:          custom.Prettier,
+			glyphClass:     custom.Class,
+			glyphID:        "prettier",
 			darkColor:      &color.RGBA{R: 65, G: 133, B: 243, A: 255},
 			darkColorANSI:  33,
 			lightColor:     &color.RGBA{R: 50, G: 100, B: 183, A: 255},
 			lightColorANSI: 25,
 		},
 		"prettier.config.js": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "prettier.config.js",
 			name:           "PrettierConfig",
 			glyph:          custom.Prettier,
+			glyphClass:     custom.Class,
+			glyphID:        "prettier",
 			darkColor:      &color.RGBA{R: 65, G: 133, B: 243, A: 255},
 			darkColorANSI:  33,
 			lightColor:     &color.RGBA{R: 50, G: 100, B: 183, A: 255},
 			lightColorANSI: 25,
 		},
 		"prettier.config.mjs": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "prettier.config.mjs",
 			name:           "PrettierConfig",
 			glyph:          custom.Prettier,
+			glyphClass:     custom.Class,
+			glyphID:        "prettier",
 			darkColor:      &color.RGBA{R: 65, G: 133, B: 243, A: 255},
 			darkColorANSI:  33,
 			lightColor:     &color.RGBA{R: 50, G: 100, B: 183, A: 255},
 			lightColorANSI: 25,
 		},
 		"prettier.config.ts": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "prettier.config.ts",
 			name:           "PrettierConfig",
 			glyph:          custom.Prettier,
+			glyphClass:     custom.Class,
+			glyphID:        "prettier",
 			darkColor:      &color.RGBA{R: 65, G: 133, B: 243, A: 255},
 			darkColorANSI:  33,
 			lightColor:     &color.RGBA{R: 50, G: 100, B: 183, A: 255},
 			lightColorANSI: 25,
 		},
 		"prisma.config.mts": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "prisma.config.mts",
 			name:           "PrismaConfig",
 			glyph:          seti.Prisma,
+			glyphClass:     seti.Class,
+			glyphID:        "prisma",
 			darkColor:      &color.RGBA{R: 89, G: 103, B: 216, A: 255},
 			darkColorANSI:  62,
 			lightColor:     &color.RGBA{R: 68, G: 77, B: 162, A: 255},
 			lightColorANSI: 61,
 		},
 		"prisma.config.ts": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "prisma.config.ts",
 			name:           "PrismaConfig",
 			glyph:          seti.Prisma,
+			glyphClass:     seti.Class,
+			glyphID:        "prisma",
 			darkColor:      &color.RGBA{R: 89, G: 103, B: 216, A: 255},
 			darkColorANSI:  62,
 			lightColor:     &color.RGBA{R: 68, G: 77, B: 162, A: 255},
 			lightColorANSI: 61,
 		},
 		"procfile": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "procfile",
 			name:           "Procfile",
 			glyph:          seti.Heroku,
+			glyphClass:     seti.Class,
+			glyphID:        "heroku",
 			darkColor:      &color.RGBA{R: 160, G: 116, B: 195, A: 255},
 			darkColorANSI:  140,
 			lightColor:     &color.RGBA{R: 107, G: 77, B: 131, A: 255},
 			lightColorANSI: 96,
 		},
 		"py.typed": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "py.typed",
 			name:           "Py.typed",
 			glyph:          seti.Python,
+			glyphClass:     seti.Class,
+			glyphID:        "python",
 			darkColor:      &color.RGBA{R: 255, G: 188, B: 3, A: 255},
 			darkColorANSI:  214,
 			lightColor:     &color.RGBA{R: 128, G: 94, B: 2, A: 255},
 			lightColorANSI: 94,
 		},
 		"rakefile": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "rakefile",
 			name:           "Rakefile",
 			glyph:          dev.RubyRough,
+			glyphClass:     dev.Class,
+			glyphID:        "ruby_rough",
 			darkColor:      &color.RGBA{R: 112, G: 21, B: 22, A: 255},
 			darkColorANSI:  52,
 			lightColor:     &color.RGBA{R: 112, G: 21, B: 22, A: 255},
 			lightColorANSI: 52,
 		},
 		"readme.md": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "readme.md",
 			name:           "Readme",
 			glyph:          md.Book,
+			glyphClass:     md.Class,
+			glyphID:        "book",
 			darkColor:      &color.RGBA{R: 237, G: 237, B: 237, A: 255},
 			darkColorANSI:  255,
 			lightColor:     &color.RGBA{R: 47, G: 47, B: 47, A: 255},
 			lightColorANSI: 236,
 		},
 		"readme": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "readme",
 			name:           "Readme",
 			glyph:          md.Book,
+			glyphClass:     md.Class,
+			glyphID:        "book",
 			darkColor:      &color.RGBA{R: 237, G: 237, B: 237, A: 255},
 			darkColorANSI:  255,
 			lightColor:     &color.RGBA{R: 47, G: 47, B: 47, A: 255},
 			lightColorANSI: 236,
 		},
 		"rmd": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "rmd",
 			name:           "Rmd",
 			glyph:          seti.Markdown,
+			glyphClass:     seti.Class,
+			glyphID:        "markdown",
 			darkColor:      &color.RGBA{R: 81, G: 154, B: 186, A: 255},
 			darkColorANSI:  74,
 			lightColor:     &color.RGBA{R: 54, G: 103, B: 124, A: 255},
 			lightColorANSI: 24,
 		},
 		"robots.txt": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "robots.txt",
 			name:           "RobotsTxt",
 			glyph:          md.Robot,
+			glyphClass:     md.Class,
+			glyphID:        "robot",
 			darkColor:      &color.RGBA{R: 93, G: 112, B: 150, A: 255},
 			darkColorANSI:  60,
 			lightColor:     &color.RGBA{R: 70, G: 84, B: 112, A: 255},
 			lightColorANSI: 60,
 		},
 		"security.md": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "security.md",
 			name:           "Security",
 			glyph:          md.Security,
+			glyphClass:     md.Class,
+			glyphID:        "security",
 			darkColor:      &color.RGBA{R: 190, G: 195, B: 201, A: 255},
 			darkColorANSI:  251,
 			lightColor:     &color.RGBA{R: 63, G: 65, B: 67, A: 255},
 			lightColorANSI: 238,
 		},
 		"security": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "security",
 			name:           "Security",
 			glyph:          md.Security,
+			glyphClass:     md.Class,
+			glyphID:        "security",
 			darkColor:      &color.RGBA{R: 190, G: 195, B: 201, A: 255},
 			darkColorANSI:  251,
 			lightColor:     &color.RGBA{R: 63, G: 65, B: 67, A: 255},
 			lightColorANSI: 238,
 		},
 		"settings.gradle": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "settings.gradle",
 			name:           "GradleSettings",
 			glyph:          seti.Gradle,
+			glyphClass:     seti.Class,
+			glyphID:        "gradle",
 			darkColor:      &color.RGBA{R: 0, G: 95, B: 135, A: 255},
 			darkColorANSI:  24,
 			lightColor:     &color.RGBA{R: 0, G: 95, B: 135, A: 255},
 			lightColorANSI: 24,
 		},
 		"svelte.config.js": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "svelte.config.js",
 			name:           "SvelteConfig",
 			glyph:          seti.Svelte,
+			glyphClass:     seti.Class,
+			glyphID:        "svelte",
 			darkColor:      &color.RGBA{R: 255, G: 62, B: 0, A: 255},
 			darkColorANSI:  196,
 			lightColor:     &color.RGBA{R: 191, G: 46, B: 0, A: 255},
 			lightColorANSI: 160,
 		},
 		"sxhkdrc": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "sxhkdrc",
 			name:           "BSPWM",
 			glyph:          linux.Bspwm,
+			glyphClass:     linux.Class,
+			glyphID:        "bspwm",
 			darkColor:      &color.RGBA{R: 47, G: 47, B: 47, A: 255},
 			darkColorANSI:  236,
 			lightColor:     &color.RGBA{R: 47, G: 47, B: 47, A: 255},
 			lightColorANSI: 236,
 		},
 		"sym-lib-table": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "sym-lib-table",
 			name:           "KiCadSymbolTable",
 			glyph:          linux.Kicad,
+			glyphClass:     linux.Class,
+			glyphID:        "kicad",
 			darkColor:      &color.RGBA{R: 255, G: 255, B: 255, A: 255},
 			darkColorANSI:  231,
 			lightColor:     &color.RGBA{R: 51, G: 51, B: 51, A: 255},
 			lightColorANSI: 236,
 		},
 		"tailwind.config.js": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "tailwind.config.js",
 			name:           "TailwindConfig",
 			glyph:          md.Tailwind,
+			glyphClass:     md.Class,
+			glyphID:        "tailwind",
 			darkColor:      &color.RGBA{R: 32, G: 194, B: 227, A: 255},
 			darkColorANSI:  45,
 			lightColor:     &color.RGBA{R: 21, G: 129, B: 151, A: 255},
 			lightColorANSI: 31,
 		},
 		"tailwind.config.mjs": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "tailwind.config.mjs",
 			name:           "TailwindConfig",
 			glyph:          md.Tailwind,
+			glyphClass:     md.Class,
+			glyphID:        "tailwind",
 			darkColor:      &color.RGBA{R: 32, G: 194, B: 227, A: 255},
 			darkColorANSI:  45,
 			lightColor:     &color.RGBA{R: 21, G: 129, B: 151, A: 255},
 			lightColorANSI: 31,
 		},
 		"tailwind.config.ts": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "tailwind.config.ts",
 			name:           "TailwindConfig",
 			glyph:          md.Tailwind,
+			glyphClass:     md.Class,
+			glyphID:        "tailwind",
 			darkColor:      &color.RGBA{R: 32, G: 194, B: 227, A: 255},
 			darkColorANSI:  45,
 			lightColor:     &color.RGBA{R: 21, G: 129, B: 151, A: 255},
 			lightColorANSI: 31,
 		},
 		"tmux.conf.local": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "tmux.conf.local",
 			name:           "Tmux",
 			glyph:          cod.TerminalTmux,
+			glyphClass:     cod.Class,
+			glyphID:        "terminal_tmux",
 			darkColor:      &color.RGBA{R: 20, G: 186, B: 25, A: 255},
 			darkColorANSI:  34,
 			lightColor:     &color.RGBA{R: 15, G: 140, B: 19, A: 255},
 			lightColorANSI: 28,
 		},
 		"tmux.conf": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "tmux.conf",
 			name:           "Tmux",
 			glyph:          cod.TerminalTmux,
+			glyphClass:     cod.Class,
+			glyphID:        "terminal_tmux",
 			darkColor:      &color.RGBA{R: 20, G: 186, B: 25, A: 255},
 			darkColorANSI:  34,
 			lightColor:     &color.RGBA{R: 15, G: 140, B: 19, A: 255},
 			lightColorANSI: 28,
 		},
 		"tsconfig.json": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "tsconfig.json",
 			name:           "TSConfig",
 			glyph:          seti.Tsconfig,
+			glyphClass:     seti.Class,
+			glyphID:        "tsconfig",
 			darkColor:      &color.RGBA{R: 81, G: 154, B: 186, A: 255},
 			darkColorANSI:  74,
 			lightColor:     &color.RGBA{R: 54, G: 103, B: 124, A: 255},
 			lightColorANSI: 24,
 		},
 		"unlicense": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "unlicense",
 			name:           "License",
 			glyph:          seti.License,
+			glyphClass:     seti.Class,
+			glyphID:        "license",
 			darkColor:      &color.RGBA{R: 208, G: 191, B: 65, A: 255},
 			darkColorANSI:  185,
 			lightColor:     &color.RGBA{R: 104, G: 96, B: 32, A: 255},
 			lightColorANSI: 58,
 		},
 		"vagrantfile": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "vagrantfile",
 			name:           "Vagrantfile",
 			glyph:          fa.Linode,
+			glyphClass:     fa.Class,
+			glyphID:        "linode",
 			darkColor:      &color.RGBA{R: 21, G: 99, B: 255, A: 255},
 			darkColorANSI:  27,
 			lightColor:     &color.RGBA{R: 16, G: 73, B: 191, A: 255},
 			lightColorANSI: 26,
 		},
 		"vercel.json": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "vercel.json",
 			name:           "Vercel",
 			glyph:          dev.Vercel,
+			glyphClass:     dev.Class,
+			glyphID:        "vercel",
 			darkColor:      &color.RGBA{R: 255, G: 255, B: 255, A: 255},
 			darkColorANSI:  231,
 			lightColor:     &color.RGBA{R: 51, G: 51, B: 51, A: 255},
 			lightColorANSI: 236,
 		},
 		"vite.config.cjs": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "vite.config.cjs",
 			name:           "ViteConfig",
 			glyph:          dev.Vitest,
+			glyphClass:     dev.Class,
+			glyphID:        "vitest",
 			darkColor:      &color.RGBA{R: 255, G: 168, B: 0, A: 255},
 			darkColorANSI:  214,
 			lightColor:     &color.RGBA{R: 128, G: 84, B: 0, A: 255},
 			lightColorANSI: 94,
 		},
 		"vite.config.cts": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "vite.config.cts",
 			name:           "ViteConfig",
 			glyph:          dev.Vitest,
+			glyphClass:     dev.Class,
+			glyphID:        "vitest",
 			darkColor:      &color.RGBA{R: 255, G: 168, B: 0, A: 255},
 			darkColorANSI:  214,
 			lightColor:     &color.RGBA{R: 128, G: 84, B: 0, A: 255},
 			lightColorANSI: 94,
 		},
 		"vite.config.js": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "vite.config.js",
 			name:           "ViteConfig",
 			glyph:          dev.Vitest,
+			glyphClass:     dev.Class,
+			glyphID:        "vitest",
 			darkColor:      &color.RGBA{R: 255, G: 168, B: 0, A: 255},
 			darkColorANSI:  214,
 			lightColor:     &color.RGBA{R: 128, G: 84, B: 0, A: 255},
 			lightColorANSI: 94,
 		},
 		"vite.config.mjs": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "vite.config.mjs",
 			name:           "ViteConfig",
 			glyph:          dev.Vitest,
+			glyphClass:     dev.Class,
+			glyphID:        "vitest",
 			darkColor:      &color.RGBA{R: 255, G: 168, B: 0, A: 255},
 			darkColorANSI:  214,
 			lightColor:     &color.RGBA{R: 128, G: 84, B: 0, A: 255},
 			lightColorANSI: 94,
 		},
 		"vite.config.mts": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "vite.config.mts",
 			name:           "ViteConfig",
 			glyph:          dev.Vitest,
+			glyphClass:     dev.Class,
+			glyphID:        "vitest",
 			darkColor:      &color.RGBA{R: 255, G: 168, B: 0, A: 255},
 			darkColorANSI:  214,
 			lightColor:     &color.RGBA{R: 128, G: 84, B: 0, A: 255},
 			lightColorANSI: 94,
 		},
 		"vite.config.ts": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "vite.config.ts",
 			name:           "ViteConfig",
 			glyph:          dev.Vitest,
+			glyphClass:     dev.Class,
+			glyphID:        "vitest",
 			darkColor:      &color.RGBA{R: 255, G: 168, B: 0, A: 255},
 			darkColorANSI:  214,
 			lightColor:     &color.RGBA{R: 128, G: 84, B: 0, A: 255},
 			lightColorANSI: 94,
 		},
 		"vitest.config.cjs": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "vitest.config.cjs",
 			name:           "VitestConfig",
 			glyph:          dev.Vitest,
+			glyphClass:     dev.Class,
+			glyphID:        "vitest",
 			darkColor:      &color.RGBA{R: 115, G: 155, B: 27, A: 255},
 			darkColorANSI:  106,
 			lightColor:     &color.RGBA{R: 77, G: 103, B: 18, A: 255},
 			lightColorANSI: 58,
 		},
 		"vitest.config.cts": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "vitest.config.cts",
 			name:           "VitestConfig",
 			glyph:          dev.Vitest,
+			glyphClass:     dev.Class,
+			glyphID:        "vitest",
 			darkColor:      &color.RGBA{R: 115, G: 155, B: 27, A: 255},
 			darkColorANSI:  106,
 			lightColor:     &color.RGBA{R: 77, G: 103, B: 18, A: 255},
 			lightColorANSI: 58,
 		},
 		"vitest.config.js": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "vitest.config.js",
 			name:           "VitestConfig",
 			glyph:          dev.Vitest,
+			glyphClass:     dev.Class,
+			glyphID:        "vitest",
 			darkColor:      &color.RGBA{R: 115, G: 155, B: 27, A: 255},
 			darkColorANSI:  106,
 			lightColor:     &color.RGBA{R: 77, G: 103, B: 18, A: 255},
 			lightColorANSI: 58,
 		},
 		"vitest.config.mjs": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "vitest.config.mjs",
 			name:           "VitestConfig",
 			glyph:          dev.Vitest,
+			glyphClass:     dev.Class,
+			glyphID:        "vitest",
 			darkColor:      &color.RGBA{R: 115, G: 155, B: 27, A: 255},
 			darkColorANSI:  106,
 			lightColor:     &color.RGBA{R: 77, G: 103, B: 18, A: 255},
 			lightColorANSI: 58,
 		},
 		"vitest.config.mts": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "vitest.config.mts",
 			name:           "VitestConfig",
 			glyph:          dev.Vitest,
+			glyphClass:     dev.Class,
+			glyphID:        "vitest",
 			darkColor:      &color.RGBA{R: 115, G: 155, B: 27, A: 255},
 			darkColorANSI:  106,
 			lightColor:     &color.RGBA{R: 77, G: 103, B: 18, A: 255},
 			lightColorANSI: 58,
 		},
 		"vitest.config.ts": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "vitest.config.ts",
 			name:           "VitestConfig",
 			glyph:          dev.Vitest,
+			glyphClass:     dev.Class,
+			glyphID:        "vitest",
 			darkColor:      &color.RGBA{R: 115, G: 155, B: 27, A: 255},
 			darkColorANSI:  106,
 			lightColor:     &color.RGBA{R: 77, G: 103, B: 18, A: 255},
 			lightColorANSI: 58,
 		},
 		"vlcrc": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "vlcrc",
 			name:           "VLC",
 			glyph:          md.Vlc,
+			glyphClass:     md.Class,
+			glyphID:        "vlc",
 			darkColor:      &color.RGBA{R: 238, G: 121, B: 0, A: 255},
 			darkColorANSI:  208,
 			lightColor:     &color.RGBA{R: 159, G: 81, B: 0, A: 255},
 			lightColorANSI: 130,
 		},
 		"webpack": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "webpack",
 			name:           "Webpack",
 			glyph:          md.Webpack,
+			glyphClass:     md.Class,
+			glyphID:        "webpack",
 			darkColor:      &color.RGBA{R: 81, G: 154, B: 186, A: 255},
 			darkColorANSI:  74,
 			lightColor:     &color.RGBA{R: 54, G: 103, B: 124, A: 255},
 			lightColorANSI: 24,
 		},
 		"weston.ini": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "weston.ini",
 			name:           "Weston",
 			glyph:          linux.Wayland,
+			glyphClass:     linux.Class,
+			glyphID:        "wayland",
 			darkColor:      &color.RGBA{R: 255, G: 187, B: 1, A: 255},
 			darkColorANSI:  214,
 			lightColor:     &color.RGBA{R: 128, G: 94, B: 0, A: 255},
 			lightColorANSI: 94,
 		},
 		"workspace": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "workspace",
 			name:           "BazelWorkspace",
 			glyph:          custom.Bazel,
+			glyphClass:     custom.Class,
+			glyphID:        "bazel",
 			darkColor:      &color.RGBA{R: 137, G: 224, B: 81, A: 255},
 			darkColorANSI:  113,
 			lightColor:     &color.RGBA{R: 68, G: 112, B: 40, A: 255},
 			lightColorANSI: 22,
 		},
 		"wrangler.jsonc": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "wrangler.jsonc",
 			name:           "WranglerConfig",
 			glyph:          dev.Cloudflare,
+			glyphClass:     dev.Class,
+			glyphID:        "cloudflare",
 			darkColor:      &color.RGBA{R: 243, G: 129, B: 32, A: 255},
 			darkColorANSI:  208,
 			lightColor:     &color.RGBA{R: 163, G: 86, B: 21, A: 255},
 			lightColorANSI: 130,
 		},
 		"wrangler.toml": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "wrangler.toml",
 			name:           "WranglerConfig",
 			glyph:          dev.Cloudflare,
+			glyphClass:     dev.Class,
+			glyphID:        "cloudflare",
 			darkColor:      &color.RGBA{R: 243, G: 129, B: 32, A: 255},
 			darkColorANSI:  208,
 			lightColor:     &color.RGBA{R: 163, G: 86, B: 21, A: 255},
 			lightColorANSI: 130,
 		},
 		"xdph.conf": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "xdph.conf",
 			name:           "XDPH",
 			glyph:          linux.Hyprland,
+			glyphClass:     linux.Class,
+			glyphID:        "hyprland",
 			darkColor:      &color.RGBA{R: 0, G: 170, B: 174, A: 255},
 			darkColorANSI:  37,
 			lightColor:     &color.RGBA{R: 0, G: 128, B: 130, A: 255},
 			lightColorANSI: 30,
 		},
 		"xmobarrc.hs": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "xmobarrc.hs",
 			name:           "xmonad",
 			glyph:          linux.Xmonad,
+			glyphClass:     linux.Class,
+			glyphID:        "xmonad",
 			darkColor:      &color.RGBA{R: 253, G: 77, B: 93, A: 255},
 			darkColorANSI:  203,
 			lightColor:     &color.RGBA{R: 169, G: 51, B: 62, A: 255},
 			lightColorANSI: 131,
 		},
 		"xmobarrc": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "xmobarrc",
 			name:           "xmonad",
 			glyph:          linux.Xmonad,
+			glyphClass:     linux.Class,
+			glyphID:        "xmonad",
 			darkColor:      &color.RGBA{R: 253, G: 77, B: 93, A: 255},
 			darkColorANSI:  203,
 			lightColor:     &color.RGBA{R: 169, G: 51, B: 62, A: 255},
 			lightColorANSI: 131,
 		},
 		"xmonad.hs": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "xmonad.hs",
 			name:           "xmonad",
 			glyph:          linux.Xmonad,
+			glyphClass:     linux.Class,
+			glyphID:        "xmonad",
 			darkColor:      &color.RGBA{R: 253, G: 77, B: 93, A: 255},
 			darkColorANSI:  203,
 			lightColor:     &color.RGBA{R: 169, G: 51, B: 62, A: 255},
 			lightColorANSI: 131,
 		},
 		"xorg.conf": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "xorg.conf",
 			name:           "XorgConf",
 			glyph:          linux.Xorg,
+			glyphClass:     linux.Class,
+			glyphID:        "xorg",
 			darkColor:      &color.RGBA{R: 229, G: 77, B: 24, A: 255},
 			darkColorANSI:  196,
 			lightColor:     &color.RGBA{R: 172, G: 58, B: 18, A: 255},
 			lightColorANSI: 124,
 		},
 		"xsettingsd.conf": &neoGlyph{
+			category:       CategoryFileName,
+			key:            "xsettingsd.conf",
 			name:           "XSettingsdConf",
 			glyph:          linux.Xorg,
+			glyphClass:     linux.Class,
+			glyphID:        "xorg",
 			darkColor:      &color.RGBA{R: 229, G: 77, B: 24, A: 255},
 			darkColorANSI:  196,
 			lightColor:     &color.RGBA{R: 172, G: 58, B: 18, A: 255},
@@ -5719,480 +8563,720 @@ var (
 	}
 	operatingSystems = map[string]Result{
 		"alma": &neoGlyph{
+			category:       CategoryOperatingSystem,
+			key:            "alma",
 			name:           "Almalinux",
 			glyph:          linux.Almalinux,
+			glyphClass:     linux.Class,
+			glyphID:        "almalinux",
 			darkColor:      &color.RGBA{R: 255, G: 70, B: 73, A: 255},
 			darkColorANSI:  203,
 			lightColor:     &color.RGBA{R: 191, G: 52, B: 55, A: 255},
 			lightColorANSI: 160,
 		},
 		"alpine": &neoGlyph{
+			category:       CategoryOperatingSystem,
+			key:            "alpine",
 			name:           "Alpine",
 			glyph:          linux.Alpine,
+			glyphClass:     linux.Class,
+			glyphID:        "alpine",
 			darkColor:      &color.RGBA{R: 13, G: 89, B: 127, A: 255},
 			darkColorANSI:  24,
 			lightColor:     &color.RGBA{R: 13, G: 89, B: 127, A: 255},
 			lightColorANSI: 24,
 		},
 		"aosc": &neoGlyph{
+			category:       CategoryOperatingSystem,
+			key:            "aosc",
 			name:           "AOSC",
 			glyph:          linux.Aosc,
+			glyphClass:     linux.Class,
+			glyphID:        "aosc",
 			darkColor:      &color.RGBA{R: 192, G: 0, B: 0, A: 255},
 			darkColorANSI:  124,
 			lightColor:     &color.RGBA{R: 192, G: 0, B: 0, A: 255},
 			lightColorANSI: 124,
 		},
 		"apple": &neoGlyph{
+			category:       CategoryOperatingSystem,
+			key:            "apple",
 			name:           "Apple",
 			glyph:          seti.Apple,
+			glyphClass:     seti.Class,
+			glyphID:        "apple",
 			darkColor:      &color.RGBA{R: 162, G: 170, B: 173, A: 255},
 			darkColorANSI:  248,
 			lightColor:     &color.RGBA{R: 81, G: 85, B: 86, A: 255},
 			lightColorANSI: 240,
 		},
 		"arch": &neoGlyph{
+			category:       CategoryOperatingSystem,
+			key:            "arch",
 			name:           "Arch",
 			glyph:          md.Arch,
+			glyphClass:     md.Class,
+			glyphID:        "arch",
 			darkColor:      &color.RGBA{R: 15, G: 147, B: 210, A: 255},
 			darkColorANSI:  67,
 			lightColor:     &color.RGBA{R: 11, G: 111, B: 158, A: 255},
 			lightColorANSI: 24,
 		},
 		"archcraft": &neoGlyph{
+			category:       CategoryOperatingSystem,
+			key:            "archcraft",
 			name:           "Archcraft",
 			glyph:          linux.Archcraft,
+			glyphClass:     linux.Class,
+			glyphID:        "archcraft",
 			darkColor:      &color.RGBA{R: 134, G: 187, B: 163, A: 255},
 			darkColorANSI:  108,
 			lightColor:     &color.RGBA{R: 67, G: 94, B: 81, A: 255},
 			lightColorANSI: 23,
 		},
 		"archlabs": &neoGlyph{
+			category:       CategoryOperatingSystem,
+			key:            "archlabs",
 			name:           "Archlabs",
 			glyph:          linux.Archlabs,
+			glyphClass:     linux.Class,
+			glyphID:        "archlabs",
 			darkColor:      &color.RGBA{R: 80, G: 63, B: 65, A: 255},
 			darkColorANSI:  238,
 			lightColor:     &color.RGBA{R: 80, G: 63, B: 65, A: 255},
 			lightColorANSI: 238,
 		},
 		"arcolinux": &neoGlyph{
+			category:       CategoryOperatingSystem,
+			key:            "arcolinux",
 			name:           "ArcoLinux",
 			glyph:          linux.Arcolinux,
+			glyphClass:     linux.Class,
+			glyphID:        "arcolinux",
 			darkColor:      &color.RGBA{R: 102, G: 144, B: 235, A: 255},
 			darkColorANSI:  68,
 			lightColor:     &color.RGBA{R: 68, G: 96, B: 157, A: 255},
 			lightColorANSI: 25,
 		},
 		"artix": &neoGlyph{
+			category:       CategoryOperatingSystem,
+			key:            "artix",
 			name:           "Artix",
 			glyph:          linux.Artix,
+			glyphClass:     linux.Class,
+			glyphID:        "artix",
 			darkColor:      &color.RGBA{R: 65, G: 179, B: 215, A: 255},
 			darkColorANSI:  38,
 			lightColor:     &color.RGBA{R: 43, G: 120, B: 143, A: 255},
 			lightColorANSI: 31,
 		},
 		"biglinux": &neoGlyph{
+			category:       CategoryOperatingSystem,
+			key:            "biglinux",
 			name:           "BigLinux",
 			glyph:          linux.Biglinux,
+			glyphClass:     linux.Class,
+			glyphID:        "biglinux",
 			darkColor:      &color.RGBA{R: 24, G: 159, B: 200, A: 255},
 			darkColorANSI:  38,
 			lightColor:     &color.RGBA{R: 18, G: 119, B: 150, A: 255},
 			lightColorANSI: 31,
 		},
 		"centos": &neoGlyph{
+			category:       CategoryOperatingSystem,
+			key:            "centos",
 			name:           "Centos",
 			glyph:          linux.Centos,
+			glyphClass:     linux.Class,
+			glyphID:        "centos",
 			darkColor:      &color.RGBA{R: 162, G: 81, B: 141, A: 255},
 			darkColorANSI:  132,
 			lightColor:     &color.RGBA{R: 121, G: 60, B: 105, A: 255},
 			lightColorANSI: 89,
 		},
 		"crystallinux": &neoGlyph{
+			category:       CategoryOperatingSystem,
+			key:            "crystallinux",
 			name:           "CrystalLinux",
 			glyph:          linux.Crystal,
+			glyphClass:     linux.Class,
+			glyphID:        "crystal",
 			darkColor:      &color.RGBA{R: 169, G: 0, B: 255, A: 255},
 			darkColorANSI:  129,
 			lightColor:     &color.RGBA{R: 169, G: 0, B: 255, A: 255},
 			lightColorANSI: 129,
 		},
 		"debian": &neoGlyph{
+			category:       CategoryOperatingSystem,
+			key:            "debian",
 			name:           "Debian",
 			glyph:          linux.Debian,
+			glyphClass:     linux.Class,
+			glyphID:        "debian",
 			darkColor:      &color.RGBA{R: 168, G: 0, B: 48, A: 255},
 			darkColorANSI:  88,
 			lightColor:     &color.RGBA{R: 168, G: 0, B: 48, A: 255},
 			lightColorANSI: 88,
 		},
 		"deepin": &neoGlyph{
+			category:       CategoryOperatingSystem,
+			key:            "deepin",
 			name:           "Deepin",
 			glyph:          linux.Deepin,
+			glyphClass:     linux.Class,
+			glyphID:        "deepin",
 			darkColor:      &color.RGBA{R: 44, G: 167, B: 248, A: 255},
 			darkColorANSI:  39,
 			lightColor:     &color.RGBA{R: 29, G: 111, B: 165, A: 255},
 			lightColorANSI: 24,
 		},
 		"devuan": &neoGlyph{
+			category:       CategoryOperatingSystem,
+			key:            "devuan",
 			name:           "Devuan",
 			glyph:          linux.Devuan,
+			glyphClass:     linux.Class,
+			glyphID:        "devuan",
 			darkColor:      &color.RGBA{R: 64, G: 73, B: 81, A: 255},
 			darkColorANSI:  238,
 			lightColor:     &color.RGBA{R: 64, G: 73, B: 81, A: 255},
 			lightColorANSI: 238,
 		},
 		"elementary": &neoGlyph{
+			category:       CategoryOperatingSystem,
+			key:            "elementary",
 			name:           "Elementary",
 			glyph:          linux.Elementary,
+			glyphClass:     linux.Class,
+			glyphID:        "elementary",
 			darkColor:      &color.RGBA{R: 88, G: 144, B: 194, A: 255},
 			darkColorANSI:  67,
 			lightColor:     &color.RGBA{R: 59, G: 96, B: 129, A: 255},
 			lightColorANSI: 24,
 		},
 		"endeavour": &neoGlyph{
+			category:       CategoryOperatingSystem,
+			key:            "endeavour",
 			name:           "Endeavour",
 			glyph:          linux.Endeavour,
+			glyphClass:     linux.Class,
+			glyphID:        "endeavour",
 			darkColor:      &color.RGBA{R: 123, G: 60, B: 185, A: 255},
 			darkColorANSI:  91,
 			lightColor:     &color.RGBA{R: 92, G: 46, B: 139, A: 255},
 			lightColorANSI: 54,
 		},
 		"fedora": &neoGlyph{
+			category:       CategoryOperatingSystem,
+			key:            "fedora",
 			name:           "Fedora",
 			glyph:          linux.Fedora,
+			glyphClass:     linux.Class,
+			glyphID:        "fedora",
 			darkColor:      &color.RGBA{R: 7, G: 42, B: 94, A: 255},
 			darkColorANSI:  17,
 			lightColor:     &color.RGBA{R: 7, G: 42, B: 94, A: 255},
 			lightColorANSI: 17,
 		},
 		"freebsd": &neoGlyph{
+			category:       CategoryOperatingSystem,
+			key:            "freebsd",
 			name:           "FreeBSD",
 			glyph:          linux.Freebsd,
+			glyphClass:     linux.Class,
+			glyphID:        "freebsd",
 			darkColor:      &color.RGBA{R: 201, G: 15, B: 2, A: 255},
 			darkColorANSI:  160,
 			lightColor:     &color.RGBA{R: 201, G: 15, B: 2, A: 255},
 			lightColorANSI: 160,
 		},
 		"garuda": &neoGlyph{
+			category:       CategoryOperatingSystem,
+			key:            "garuda",
 			name:           "GarudaLinux",
 			glyph:          linux.Garuda,
+			glyphClass:     linux.Class,
+			glyphID:        "garuda",
 			darkColor:      &color.RGBA{R: 40, G: 116, B: 225, A: 255},
 			darkColorANSI:  33,
 			lightColor:     &color.RGBA{R: 31, G: 87, B: 169, A: 255},
 			lightColorANSI: 25,
 		},
 		"gentoo": &neoGlyph{
+			category:       CategoryOperatingSystem,
+			key:            "gentoo",
 			name:           "Gentoo",
 			glyph:          md.Gentoo,
+			glyphClass:     md.Class,
+			glyphID:        "gentoo",
 			darkColor:      &color.RGBA{R: 177, G: 171, B: 206, A: 255},
 			darkColorANSI:  146,
 			lightColor:     &color.RGBA{R: 88, G: 86, B: 103, A: 255},
 			lightColorANSI: 60,
 		},
 		"guix": &neoGlyph{
+			category:       CategoryOperatingSystem,
+			key:            "guix",
 			name:           "Guix",
 			glyph:          linux.GnuGuix,
+			glyphClass:     linux.Class,
+			glyphID:        "gnu_guix",
 			darkColor:      &color.RGBA{R: 255, G: 204, B: 0, A: 255},
 			darkColorANSI:  220,
 			lightColor:     &color.RGBA{R: 85, G: 68, B: 0, A: 255},
 			lightColorANSI: 58,
 		},
 		"hyperbola": &neoGlyph{
+			category:       CategoryOperatingSystem,
+			key:            "hyperbola",
 			name:           "HyperbolaGNULinuxLibre",
 			glyph:          linux.Hyperbola,
+			glyphClass:     linux.Class,
+			glyphID:        "hyperbola",
 			darkColor:      &color.RGBA{R: 192, G: 192, B: 192, A: 255},
 			darkColorANSI:  250,
 			lightColor:     &color.RGBA{R: 64, G: 64, B: 64, A: 255},
 			lightColorANSI: 238,
 		},
 		"illumos": &neoGlyph{
+			category:       CategoryOperatingSystem,
+			key:            "illumos",
 			name:           "Illumos",
 			glyph:          linux.Illumos,
+			glyphClass:     linux.Class,
+			glyphID:        "illumos",
 			darkColor:      &color.RGBA{R: 255, G: 67, B: 15, A: 255},
 			darkColorANSI:  196,
 			lightColor:     &color.RGBA{R: 191, G: 50, B: 11, A: 255},
 			lightColorANSI: 160,
 		},
 		"kali": &neoGlyph{
+			category:       CategoryOperatingSystem,
+			key:            "kali",
 			name:           "Kali",
 			glyph:          linux.KaliLinux,
+			glyphClass:     linux.Class,
+			glyphID:        "kali_linux",
 			darkColor:      &color.RGBA{R: 39, G: 119, B: 255, A: 255},
 			darkColorANSI:  69,
 			lightColor:     &color.RGBA{R: 29, G: 89, B: 191, A: 255},
 			lightColorANSI: 26,
 		},
 		"kdeneon": &neoGlyph{
+			category:       CategoryOperatingSystem,
+			key:            "kdeneon",
 			name:           "KDEneon",
 			glyph:          linux.KdeNeon,
+			glyphClass:     linux.Class,
+			glyphID:        "kde_neon",
 			darkColor:      &color.RGBA{R: 32, G: 166, B: 163, A: 255},
 			darkColorANSI:  37,
 			lightColor:     &color.RGBA{R: 24, G: 124, B: 123, A: 255},
 			lightColorANSI: 30,
 		},
 		"kubuntu": &neoGlyph{
+			category:       CategoryOperatingSystem,
+			key:            "kubuntu",
 			name:           "Kubuntu",
 			glyph:          linux.Kubuntu,
+			glyphClass:     linux.Class,
+			glyphID:        "kubuntu",
 			darkColor:      &color.RGBA{R: 0, G: 121, B: 194, A: 255},
 			darkColorANSI:  32,
 			lightColor:     &color.RGBA{R: 0, G: 92, B: 146, A: 255},
 			lightColorANSI: 24,
 		},
 		"leap": &neoGlyph{
+			category:       CategoryOperatingSystem,
+			key:            "leap",
 			name:           "Leap",
 			glyph:          linux.Leap,
+			glyphClass:     linux.Class,
+			glyphID:        "leap",
 			darkColor:      &color.RGBA{R: 251, G: 199, B: 93, A: 255},
 			darkColorANSI:  221,
 			lightColor:     &color.RGBA{R: 84, G: 65, B: 31, A: 255},
 			lightColorANSI: 58,
 		},
 		"linux": &neoGlyph{
+			category:       CategoryOperatingSystem,
+			key:            "linux",
 			name:           "Linux",
 			glyph:          cod.TerminalLinux,
+			glyphClass:     cod.Class,
+			glyphID:        "terminal_linux",
 			darkColor:      &color.RGBA{R: 253, G: 253, B: 251, A: 255},
 			darkColorANSI:  231,
 			lightColor:     &color.RGBA{R: 51, G: 51, B: 50, A: 255},
 			lightColorANSI: 236,
 		},
 		"locos": &neoGlyph{
+			category:       CategoryOperatingSystem,
+			key:            "locos",
 			name:           "LocOS",
 			glyph:          linux.Locos,
+			glyphClass:     linux.Class,
+			glyphID:        "locos",
 			darkColor:      &color.RGBA{R: 250, G: 179, B: 2, A: 255},
 			darkColorANSI:  214,
 			lightColor:     &color.RGBA{R: 125, G: 89, B: 1, A: 255},
 			lightColorANSI: 94,
 		},
 		"lxle": &neoGlyph{
+			category:       CategoryOperatingSystem,
+			key:            "lxle",
 			name:           "LXLE",
 			glyph:          linux.Lxle,
+			glyphClass:     linux.Class,
+			glyphID:        "lxle",
 			darkColor:      &color.RGBA{R: 71, G: 71, B: 71, A: 255},
 			darkColorANSI:  238,
 			lightColor:     &color.RGBA{R: 71, G: 71, B: 71, A: 255},
 			lightColorANSI: 238,
 		},
 		"mageia": &neoGlyph{
+			category:       CategoryOperatingSystem,
+			key:            "mageia",
 			name:           "Mageia",
 			glyph:          linux.Mageia,
+			glyphClass:     linux.Class,
+			glyphID:        "mageia",
 			darkColor:      &color.RGBA{R: 35, G: 151, B: 211, A: 255},
 			darkColorANSI:  67,
 			lightColor:     &color.RGBA{R: 26, G: 113, B: 159, A: 255},
 			lightColorANSI: 24,
 		},
 		"manjaro": &neoGlyph{
+			category:       CategoryOperatingSystem,
+			key:            "manjaro",
 			name:           "Manjaro",
 			glyph:          linux.Manjaro,
+			glyphClass:     linux.Class,
+			glyphID:        "manjaro",
 			darkColor:      &color.RGBA{R: 51, G: 185, B: 89, A: 255},
 			darkColorANSI:  35,
 			lightColor:     &color.RGBA{R: 34, G: 123, B: 59, A: 255},
 			lightColorANSI: 29,
 		},
 		"mint": &neoGlyph{
+			category:       CategoryOperatingSystem,
+			key:            "mint",
 			name:           "Mint",
 			glyph:          md.LinuxMint,
+			glyphClass:     md.Class,
+			glyphID:        "linux_mint",
 			darkColor:      &color.RGBA{R: 102, G: 175, B: 60, A: 255},
 			darkColorANSI:  70,
 			lightColor:     &color.RGBA{R: 68, G: 117, B: 40, A: 255},
 			lightColorANSI: 28,
 		},
 		"mxlinux": &neoGlyph{
+			category:       CategoryOperatingSystem,
+			key:            "mxlinux",
 			name:           "MXLinux",
 			glyph:          linux.Mxlinux,
+			glyphClass:     linux.Class,
+			glyphID:        "mxlinux",
 			darkColor:      &color.RGBA{R: 255, G: 255, B: 255, A: 255},
 			darkColorANSI:  231,
 			lightColor:     &color.RGBA{R: 51, G: 51, B: 51, A: 255},
 			lightColorANSI: 236,
 		},
 		"nixos": &neoGlyph{
+			category:       CategoryOperatingSystem,
+			key:            "nixos",
 			name:           "NixOS",
 			glyph:          linux.Nixos,
+			glyphClass:     linux.Class,
+			glyphID:        "nixos",
 			darkColor:      &color.RGBA{R: 121, G: 177, B: 219, A: 255},
 			darkColorANSI:  110,
 			lightColor:     &color.RGBA{R: 60, G: 88, B: 110, A: 255},
 			lightColorANSI: 24,
 		},
 		"nobara": &neoGlyph{
+			category:       CategoryOperatingSystem,
+			key:            "nobara",
 			name:           "NobaraLinux",
 			glyph:          linux.Nobara,
+			glyphClass:     linux.Class,
+			glyphID:        "nobara",
 			darkColor:      &color.RGBA{R: 255, G: 255, B: 255, A: 255},
 			darkColorANSI:  231,
 			lightColor:     &color.RGBA{R: 51, G: 51, B: 51, A: 255},
 			lightColorANSI: 236,
 		},
 		"openbsd": &neoGlyph{
+			category:       CategoryOperatingSystem,
+			key:            "openbsd",
 			name:           "OpenBSD",
 			glyph:          linux.Openbsd,
+			glyphClass:     linux.Class,
+			glyphID:        "openbsd",
 			darkColor:      &color.RGBA{R: 242, G: 202, B: 48, A: 255},
 			darkColorANSI:  220,
 			lightColor:     &color.RGBA{R: 81, G: 67, B: 16, A: 255},
 			lightColorANSI: 58,
 		},
 		"opensuse": &neoGlyph{
+			category:       CategoryOperatingSystem,
+			key:            "opensuse",
 			name:           "openSUSE",
 			glyph:          linux.Opensuse,
+			glyphClass:     linux.Class,
+			glyphID:        "opensuse",
 			darkColor:      &color.RGBA{R: 111, G: 179, B: 36, A: 255},
 			darkColorANSI:  70,
 			lightColor:     &color.RGBA{R: 73, G: 120, B: 24, A: 255},
 			lightColorANSI: 64,
 		},
 		"parabola": &neoGlyph{
+			category:       CategoryOperatingSystem,
+			key:            "parabola",
 			name:           "ParabolaGNULinuxLibre",
 			glyph:          linux.Parabola,
+			glyphClass:     linux.Class,
+			glyphID:        "parabola",
 			darkColor:      &color.RGBA{R: 121, G: 125, B: 172, A: 255},
 			darkColorANSI:  103,
 			lightColor:     &color.RGBA{R: 81, G: 83, B: 115, A: 255},
 			lightColorANSI: 60,
 		},
 		"parrot": &neoGlyph{
+			category:       CategoryOperatingSystem,
+			key:            "parrot",
 			name:           "Parrot",
 			glyph:          linux.Parrot,
+			glyphClass:     linux.Class,
+			glyphID:        "parrot",
 			darkColor:      &color.RGBA{R: 84, G: 222, B: 255, A: 255},
 			darkColorANSI:  45,
 			lightColor:     &color.RGBA{R: 42, G: 111, B: 128, A: 255},
 			lightColorANSI: 23,
 		},
 		"pop_os": &neoGlyph{
+			category:       CategoryOperatingSystem,
+			key:            "pop_os",
 			name:           "Pop_OS",
 			glyph:          linux.PopOs,
+			glyphClass:     linux.Class,
+			glyphID:        "pop_os",
 			darkColor:      &color.RGBA{R: 72, G: 185, B: 199, A: 255},
 			darkColorANSI:  73,
 			lightColor:     &color.RGBA{R: 48, G: 123, B: 133, A: 255},
 			lightColorANSI: 30,
 		},
 		"postmarketos": &neoGlyph{
+			category:       CategoryOperatingSystem,
+			key:            "postmarketos",
 			name:           "postmarketOS",
 			glyph:          linux.Postmarketos,
+			glyphClass:     linux.Class,
+			glyphID:        "postmarketos",
 			darkColor:      &color.RGBA{R: 0, G: 153, B: 0, A: 255},
 			darkColorANSI:  28,
 			lightColor:     &color.RGBA{R: 0, G: 115, B: 0, A: 255},
 			lightColorANSI: 22,
 		},
 		"puppylinux": &neoGlyph{
+			category:       CategoryOperatingSystem,
+			key:            "puppylinux",
 			name:           "PuppyLinux",
 			glyph:          linux.Puppy,
+			glyphClass:     linux.Class,
+			glyphID:        "puppy",
 			darkColor:      &color.RGBA{R: 162, G: 174, B: 185, A: 255},
 			darkColorANSI:  145,
 			lightColor:     &color.RGBA{R: 81, G: 87, B: 92, A: 255},
 			lightColorANSI: 240,
 		},
 		"qubesos": &neoGlyph{
+			category:       CategoryOperatingSystem,
+			key:            "qubesos",
 			name:           "QubesOS",
 			glyph:          linux.Qubesos,
+			glyphClass:     linux.Class,
+			glyphID:        "qubesos",
 			darkColor:      &color.RGBA{R: 55, G: 116, B: 216, A: 255},
 			darkColorANSI:  33,
 			lightColor:     &color.RGBA{R: 40, G: 87, B: 162, A: 255},
 			lightColorANSI: 25,
 		},
 		"raspberry_pi": &neoGlyph{
+			category:       CategoryOperatingSystem,
+			key:            "raspberry_pi",
 			name:           "RaspberryPiOS",
 			glyph:          linux.RaspberryPi,
+			glyphClass:     linux.Class,
+			glyphID:        "raspberry_pi",
 			darkColor:      &color.RGBA{R: 190, G: 24, B: 72, A: 255},
 			darkColorANSI:  161,
 			lightColor:     &color.RGBA{R: 190, G: 24, B: 72, A: 255},
 			lightColorANSI: 161,
 		},
 		"redhat": &neoGlyph{
+			category:       CategoryOperatingSystem,
+			key:            "redhat",
 			name:           "Redhat",
 			glyph:          md.Redhat,
+			glyphClass:     md.Class,
+			glyphID:        "redhat",
 			darkColor:      &color.RGBA{R: 238, G: 0, B: 0, A: 255},
 			darkColorANSI:  196,
 			lightColor:     &color.RGBA{R: 238, G: 0, B: 0, A: 255},
 			lightColorANSI: 196,
 		},
 		"rocky": &neoGlyph{
+			category:       CategoryOperatingSystem,
+			key:            "rocky",
 			name:           "RockyLinux",
 			glyph:          linux.RockyLinux,
+			glyphClass:     linux.Class,
+			glyphID:        "rocky_linux",
 			darkColor:      &color.RGBA{R: 15, G: 179, B: 125, A: 255},
 			darkColorANSI:  36,
 			lightColor:     &color.RGBA{R: 11, G: 134, B: 94, A: 255},
 			lightColorANSI: 29,
 		},
 		"sabayon": &neoGlyph{
+			category:       CategoryOperatingSystem,
+			key:            "sabayon",
 			name:           "Sabayon",
 			glyph:          linux.Sabayon,
+			glyphClass:     linux.Class,
+			glyphID:        "sabayon",
 			darkColor:      &color.RGBA{R: 198, G: 198, B: 198, A: 255},
 			darkColorANSI:  251,
 			lightColor:     &color.RGBA{R: 65, G: 65, B: 65, A: 255},
 			lightColorANSI: 238,
 		},
 		"slackware": &neoGlyph{
+			category:       CategoryOperatingSystem,
+			key:            "slackware",
 			name:           "Slackware",
 			glyph:          linux.Slackware,
+			glyphClass:     linux.Class,
+			glyphID:        "slackware",
 			darkColor:      &color.RGBA{R: 71, G: 95, B: 169, A: 255},
 			darkColorANSI:  61,
 			lightColor:     &color.RGBA{R: 52, G: 71, B: 127, A: 255},
 			lightColorANSI: 25,
 		},
 		"solus": &neoGlyph{
+			category:       CategoryOperatingSystem,
+			key:            "solus",
 			name:           "Solus",
 			glyph:          linux.Solus,
+			glyphClass:     linux.Class,
+			glyphID:        "solus",
 			darkColor:      &color.RGBA{R: 75, G: 81, B: 99, A: 255},
 			darkColorANSI:  239,
 			lightColor:     &color.RGBA{R: 75, G: 81, B: 99, A: 255},
 			lightColorANSI: 239,
 		},
 		"tails": &neoGlyph{
+			category:       CategoryOperatingSystem,
+			key:            "tails",
 			name:           "Tails",
 			glyph:          linux.Tails,
+			glyphClass:     linux.Class,
+			glyphID:        "tails",
 			darkColor:      &color.RGBA{R: 86, G: 52, B: 124, A: 255},
 			darkColorANSI:  54,
 			lightColor:     &color.RGBA{R: 86, G: 52, B: 124, A: 255},
 			lightColorANSI: 54,
 		},
 		"trisquel": &neoGlyph{
+			category:       CategoryOperatingSystem,
+			key:            "trisquel",
 			name:           "TrisquelGNULinux",
 			glyph:          linux.Trisquel,
+			glyphClass:     linux.Class,
+			glyphID:        "trisquel",
 			darkColor:      &color.RGBA{R: 15, G: 88, B: 182, A: 255},
 			darkColorANSI:  25,
 			lightColor:     &color.RGBA{R: 15, G: 88, B: 182, A: 255},
 			lightColorANSI: 25,
 		},
 		"tumbleweed": &neoGlyph{
+			category:       CategoryOperatingSystem,
+			key:            "tumbleweed",
 			name:           "Tumbleweed",
 			glyph:          linux.Tumbleweed,
+			glyphClass:     linux.Class,
+			glyphID:        "tumbleweed",
 			darkColor:      &color.RGBA{R: 52, G: 185, B: 171, A: 255},
 			darkColorANSI:  37,
 			lightColor:     &color.RGBA{R: 35, G: 123, B: 113, A: 255},
 			lightColorANSI: 30,
 		},
 		"ubuntu": &neoGlyph{
+			category:       CategoryOperatingSystem,
+			key:            "ubuntu",
 			name:           "Ubuntu",
 			glyph:          dev.Ubuntu,
+			glyphClass:     dev.Class,
+			glyphID:        "ubuntu",
 			darkColor:      &color.RGBA{R: 221, G: 72, B: 20, A: 255},
 			darkColorANSI:  196,
 			lightColor:     &color.RGBA{R: 166, G: 54, B: 15, A: 255},
 			lightColorANSI: 124,
 		},
 		"vanillaos": &neoGlyph{
+			category:       CategoryOperatingSystem,
+			key:            "vanillaos",
 			name:           "VanillaOS",
 			glyph:          linux.Vanilla,
+			glyphClass:     linux.Class,
+			glyphID:        "vanilla",
 			darkColor:      &color.RGBA{R: 250, G: 189, B: 77, A: 255},
 			darkColorANSI:  214,
 			lightColor:     &color.RGBA{R: 83, G: 63, B: 26, A: 255},
 			lightColorANSI: 58,
 		},
 		"void": &neoGlyph{
+			category:       CategoryOperatingSystem,
+			key:            "void",
 			name:           "Void",
 			glyph:          linux.Void,
+			glyphClass:     linux.Class,
+			glyphID:        "void",
 			darkColor:      &color.RGBA{R: 40, G: 83, B: 64, A: 255},
 			darkColorANSI:  23,
 			lightColor:     &color.RGBA{R: 40, G: 83, B: 64, A: 255},
 			lightColorANSI: 23,
 		},
 		"windows": &neoGlyph{
+			category:       CategoryOperatingSystem,
+			key:            "windows",
 			name:           "Windows",
 			glyph:          fa.Windows,
+			glyphClass:     fa.Class,
+			glyphID:        "windows",
 			darkColor:      &color.RGBA{R: 0, G: 163, B: 239, A: 255},
 			darkColorANSI:  39,
 			lightColor:     &color.RGBA{R: 0, G: 123, B: 179, A: 255},
 			lightColorANSI: 67,
 		},
 		"xerolinux": &neoGlyph{
+			category:       CategoryOperatingSystem,
+			key:            "xerolinux",
 			name:           "XeroLinux",
 			glyph:          linux.Xerolinux,
+			glyphClass:     linux.Class,
+			glyphID:        "xerolinux",
 			darkColor:      &color.RGBA{R: 136, G: 143, B: 226, A: 255},
 			darkColorANSI:  104,
 			lightColor:     &color.RGBA{R: 91, G: 95, B: 151, A: 255},
 			lightColorANSI: 60,
 		},
 		"zorin": &neoGlyph{
+			category:       CategoryOperatingSystem,
+			key:            "zorin",
 			name:           "Zorin",
 			glyph:          linux.Zorin,
+			glyphClass:     linux.Class,
+			glyphID:        "zorin",
 			darkColor:      &color.RGBA{R: 20, G: 161, B: 232, A: 255},
 			darkColorANSI:  39,
 			lightColor:     &color.RGBA{R: 15, G: 121, B: 174, A: 255},
@@ -6201,96 +9285,144 @@ var (
 	}
 	windowManagers = map[string]Result{
 		"awesomewm": &neoGlyph{
+			category:       CategoryWindowManager,
+			key:            "awesomewm",
 			name:           "awesome",
 			glyph:          linux.Awesome,
+			glyphClass:     linux.Class,
+			glyphID:        "awesome",
 			darkColor:      &color.RGBA{R: 83, G: 93, B: 108, A: 255},
 			darkColorANSI:  59,
 			lightColor:     &color.RGBA{R: 62, G: 70, B: 81, A: 255},
 			lightColorANSI: 238,
 		},
 		"bspwm": &neoGlyph{
+			category:       CategoryWindowManager,
+			key:            "bspwm",
 			name:           "BSPWM",
 			glyph:          linux.Bspwm,
+			glyphClass:     linux.Class,
+			glyphID:        "bspwm",
 			darkColor:      &color.RGBA{R: 79, G: 79, B: 79, A: 255},
 			darkColorANSI:  239,
 			lightColor:     &color.RGBA{R: 79, G: 79, B: 79, A: 255},
 			lightColorANSI: 239,
 		},
 		"dwm": &neoGlyph{
+			category:       CategoryWindowManager,
+			key:            "dwm",
 			name:           "dwm",
 			glyph:          linux.Dwm,
+			glyphClass:     linux.Class,
+			glyphID:        "dwm",
 			darkColor:      &color.RGBA{R: 17, G: 119, B: 170, A: 255},
 			darkColorANSI:  31,
 			lightColor:     &color.RGBA{R: 13, G: 89, B: 128, A: 255},
 			lightColorANSI: 24,
 		},
 		"enlightenment": &neoGlyph{
+			category:       CategoryWindowManager,
+			key:            "enlightenment",
 			name:           "Enlightenment",
 			glyph:          linux.Enlightenment,
+			glyphClass:     linux.Class,
+			glyphID:        "enlightenment",
 			darkColor:      &color.RGBA{R: 255, G: 255, B: 255, A: 255},
 			darkColorANSI:  231,
 			lightColor:     &color.RGBA{R: 51, G: 51, B: 51, A: 255},
 			lightColorANSI: 236,
 		},
 		"fluxbox": &neoGlyph{
+			category:       CategoryWindowManager,
+			key:            "fluxbox",
 			name:           "Fluxbox",
 			glyph:          linux.Fluxbox,
+			glyphClass:     linux.Class,
+			glyphID:        "fluxbox",
 			darkColor:      &color.RGBA{R: 85, G: 85, B: 85, A: 255},
 			darkColorANSI:  240,
 			lightColor:     &color.RGBA{R: 64, G: 64, B: 64, A: 255},
 			lightColorANSI: 238,
 		},
 		"hyprland": &neoGlyph{
+			category:       CategoryWindowManager,
+			key:            "hyprland",
 			name:           "Hyprland",
 			glyph:          linux.Hyprland,
+			glyphClass:     linux.Class,
+			glyphID:        "hyprland",
 			darkColor:      &color.RGBA{R: 0, G: 170, B: 174, A: 255},
 			darkColorANSI:  37,
 			lightColor:     &color.RGBA{R: 0, G: 128, B: 130, A: 255},
 			lightColorANSI: 30,
 		},
 		"i3": &neoGlyph{
+			category:       CategoryWindowManager,
+			key:            "i3",
 			name:           "i3",
 			glyph:          linux.I3,
+			glyphClass:     linux.Class,
+			glyphID:        "i3",
 			darkColor:      &color.RGBA{R: 232, G: 235, B: 238, A: 255},
 			darkColorANSI:  255,
 			lightColor:     &color.RGBA{R: 46, G: 47, B: 48, A: 255},
 			lightColorANSI: 236,
 		},
 		"jwm": &neoGlyph{
+			category:       CategoryWindowManager,
+			key:            "jwm",
 			name:           "JWM",
 			glyph:          linux.Jwm,
+			glyphClass:     linux.Class,
+			glyphID:        "jwm",
 			darkColor:      &color.RGBA{R: 0, G: 120, B: 205, A: 255},
 			darkColorANSI:  32,
 			lightColor:     &color.RGBA{R: 0, G: 89, B: 154, A: 255},
 			lightColorANSI: 25,
 		},
 		"qtile": &neoGlyph{
+			category:       CategoryWindowManager,
+			key:            "qtile",
 			name:           "Qtile",
 			glyph:          linux.Qtile,
+			glyphClass:     linux.Class,
+			glyphID:        "qtile",
 			darkColor:      &color.RGBA{R: 255, G: 255, B: 255, A: 255},
 			darkColorANSI:  231,
 			lightColor:     &color.RGBA{R: 51, G: 51, B: 51, A: 255},
 			lightColorANSI: 236,
 		},
 		"river": &neoGlyph{
+			category:       CategoryWindowManager,
+			key:            "river",
 			name:           "river",
 			glyph:          linux.River,
+			glyphClass:     linux.Class,
+			glyphID:        "river",
 			darkColor:      &color.RGBA{R: 0, G: 0, B: 0, A: 255},
 			darkColorANSI:  16,
 			lightColor:     &color.RGBA{R: 0, G: 0, B: 0, A: 255},
 			lightColorANSI: 16,
 		},
 		"sway": &neoGlyph{
+			category:       CategoryWindowManager,
+			key:            "sway",
 			name:           "Sway",
 			glyph:          linux.Sway,
+			glyphClass:     linux.Class,
+			glyphID:        "sway",
 			darkColor:      &color.RGBA{R: 104, G: 117, B: 28, A: 255},
 			darkColorANSI:  64,
 			lightColor:     &color.RGBA{R: 78, G: 88, B: 21, A: 255},
 			lightColorANSI: 58,
 		},
 		"xmonad": &neoGlyph{
+			category:       CategoryWindowManager,
+			key:            "xmonad",
 			name:           "xmonad",
 			glyph:          linux.Xmonad,
+			glyphClass:     linux.Class,
+			glyphID:        "xmonad",
 			darkColor:      &color.RGBA{R: 253, G: 77, B: 93, A: 255},
 			darkColorANSI:  203,
 			lightColor:     &color.RGBA{R: 169, G: 51, B: 62, A: 255},
@@ -10,39 +10,127 @@
 package neo
 
 import (
+	"cmp"
+	"encoding"
+	"encoding/json"
+	"fmt"
 	"image/color"
 	"iter"
 	"maps"
-	"os"
-	"path/filepath"
-	"regexp"
-	"runtime"
+	"slices"
 	"strings"
+	"sync"
 
 	"github.com/lrstanley/go-nf"
 )
 
+// Category is the category of identifier a glyph was resolved through.
+type Category string
+
+// String returns the name of the category.
+func (c Category) String() string {
+	return string(c)
+}
+
+const (
+	// CategoryFileExtension is used for glyphs resolved through a file extension.
+	CategoryFileExtension Category = "file_extension"
+	// CategoryFileName is used for glyphs resolved through a file name.
+	CategoryFileName Category = "filename"
+	// CategoryOperatingSystem is used for glyphs resolved through an operating
+	// system.
+	CategoryOperatingSystem Category = "operating_system"
+	// CategoryWindowManager is used for glyphs resolved through a window manager.
+	CategoryWindowManager Category = "window_manager"
+	// CategoryDesktopEnvironment is used for glyphs resolved through a desktop
+	// environment.
+	CategoryDesktopEnvironment Category = "desktop_environment"
+)
+
 // Result is a glyph that has been resolved through a specific identifier --
 // file name, file extension, operating system, window manager, desktop
 // environment, etc.
+//
+// Results can be encoded as JSON (see [Entry] for the structure), and as text
+// (the glyph itself).
 type Result interface {
 	// Name returns the name of the identified entity.
 	Name() string
+	// Category returns the category of identifier the glyph was resolved
+	// through.
+	Category() Category
+	// Key returns the matcher key (e.g. "go" for file extensions, "dockerfile"
+	// for file names) which the glyph was resolved through.
+	Key() string
 	// Glyph returns the glyph that represents the identified entity.
 	Glyph() nf.Glyph
+	// GlyphClass returns the Nerd Fonts class of the glyph (e.g. "md").
+	GlyphClass() nf.Class
+	// GlyphID returns the Nerd Fonts ID of the glyph, within its class.
+	GlyphID() string
 	// String returns the glyph itself.
 	String() string
 	// Color returns the color of the identified entity, as recommended by
 	// nvim-tree.
 	Color(dark bool) color.Color
+	// ColorHex returns the color of the identified entity as a hex string (e.g.
+	// "#00add8"), or an empty string if there is no color.
+	ColorHex(dark bool) string
 	// ColorANSI returns the fallback ANSI color of the identified entity (when
 	// the terminal doesn't support 256/TrueColor), as recommended by nvim-tree.
 	ColorANSI(dark bool) int
+	// Entry returns a stable, exported representation of the result.
+	Entry() Entry
+
+	json.Marshaler
+	encoding.TextMarshaler
+}
+
+// Entry is a stable, exported representation of a [Result], which can be
+// cached, compared, and encoded (e.g. to send to a web UI). Use [Entry.Result]
+// to convert it back into a [Result].
+type Entry struct {
+	Category       Category `json:"category"`
+	Key            string   `json:"key"`
+	Name           string   `json:"name"`
+	Glyph          nf.Glyph `json:"glyph"`
+	GlyphClass     nf.Class `json:"glyph_class"`
+	GlyphID        string   `json:"glyph_id"`
+	DarkColor      string   `json:"dark_color"`
+	DarkColorANSI  int      `json:"dark_color_ansi"`
+	LightColor     string   `json:"light_color"`
+	LightColorANSI int      `json:"light_color_ansi"`
+}
+
+// Result converts the entry back into a [Result]. Invalid hex colors result in
+// a nil color.
+func (e Entry) Result() Result {
+	g := &neoGlyph{
+		category:       e.Category,
+		key:            e.Key,
+		name:           e.Name,
+		glyph:          e.Glyph,
+		glyphClass:     e.GlyphClass,
+		glyphID:        e.GlyphID,
+		darkColorANSI:  e.DarkColorANSI,
+		lightColorANSI: e.LightColorANSI,
+	}
+	if c, err := parseHexColor(e.DarkColor); err == nil {
+		g.darkColor = c
+	}
+	if c, err := parseHexColor(e.LightColor); err == nil {
+		g.lightColor = c
+	}
+	return g
 }
 
 type neoGlyph struct {
+	category       Category
+	key            string
 	name           string
 	glyph          nf.Glyph
+	glyphClass     nf.Class
+	glyphID        string
 	darkColor      color.Color
 	darkColorANSI  int
 	lightColor     color.Color
@@ -53,10 +141,26 @@ func (g *neoGlyph) Name() string {
 	return g.name
 }
 
+func (g *neoGlyph) Category() Category {
+	return g.category
+}
+
+func (g *neoGlyph) Key() string {
+	return g.key
+}
+
 func (g *neoGlyph) Glyph() nf.Glyph {
 	return g.glyph
 }
 
+func (g *neoGlyph) GlyphClass() nf.Class {
+	return g.glyphClass
+}
+
+func (g *neoGlyph) GlyphID() string {
+	return g.glyphID
+}
+
 func (g *neoGlyph) String() string {
 	return g.glyph.String()
 }
@@ -68,6 +172,10 @@ func (g *neoGlyph) Color(dark bool) color.Color {
 	return g.lightColor
 }
 
+func (g *neoGlyph) ColorHex(dark bool) string {
+	return colorHex(g.Color(dark))
+}
+
 func (g *neoGlyph) ColorANSI(dark bool) int {
 	if dark {
 		return g.darkColorANSI
@@ -75,6 +183,39 @@ func (g *neoGlyph) ColorANSI(dark bool) int {
 	return g.lightColorANSI
 }
 
+func (g *neoGlyph) Entry() Entry {
+	return Entry{
+		Category:       g.category,
+		Key:            g.key,
+		Name:           g.name,
+		Glyph:          g.glyph,
+		GlyphClass:     g.glyphClass,
+		GlyphID:        g.glyphID,
+		DarkColor:      colorHex(g.darkColor),
+		DarkColorANSI:  g.darkColorANSI,
+		LightColor:     colorHex(g.lightColor),
+		LightColorANSI: g.lightColorANSI,
+	}
+}
+
+func (g *neoGlyph) MarshalJSON() ([]byte, error) {
+	return json.Marshal(g.Entry())
+}
+
+func (g *neoGlyph) MarshalText() ([]byte, error) {
+	return []byte(g.glyph), nil
+}
+
+// colorHex returns the hex representation of a color (e.g. "#00add8"), or an
+// empty string if the color is nil.
+func colorHex(c color.Color) string {
+	if c == nil {
+		return ""
+	}
+	r, g, b, _ := c.RGBA()
+	return fmt.Sprintf("#%02x%02x%02x", r>>8, g>>8, b>>8)
+}
+
 // DesktopEnvironments returns an iterator over all the desktop environments in
 // the neo package, in no particular order.
 func DesktopEnvironments() iter.Seq2[string, Result] {
@@ -94,16 +235,9 @@ func FileExtensions() iter.Seq2[string, Result] {
 }
 
 // ByFileExtension resolves a glyph for a file extension, or nil if it is not
-// found.
+// found. Compound extensions (e.g. "spec.ts") are matched as-is.
 func ByFileExtension(ext string) Result {
-	extTrimmed := ext
-	if strings.HasPrefix(extTrimmed, ".") {
-		extTrimmed = extTrimmed[1:]
-	}
-	if v, ok := fileExtensions[extTrimmed]; ok {
-		return v
-	}
-	return fileExtensions[strings.ToLower(extTrimmed)]
+	return lookupFold(fileExtensions, strings.TrimPrefix(ext, "."))
 }
 
 // FileNames returns an iterator over all the file names in the neo package, in no
@@ -112,39 +246,120 @@ func FileNames() iter.Seq2[string, Result] {
 	return maps.All(filenames)
 }
 
-// ByFileName resolves a glyph for a file name, or nil if it is not found.
+// ByFileName resolves a glyph for a file name, or nil if it is not found. If
+// a path is provided, only the base name is used.
 func ByFileName(name string) Result {
-	if v, ok := filenames[name]; ok {
-		return v
+	return lookupFold(filenames, pathBase(name))
+}
+
+// fileMatchersByName indexes all file extension and file name results by their
+// lowercased name, sorted by category and key.
+var fileMatchersByName = sync.OnceValue(func() map[string][]Result {
+	index := make(map[string][]Result)
+	for _, table := range []map[string]Result{fileExtensions, filenames} {
+		for _, r := range table {
+			name := strings.ToLower(r.Name())
+			index[name] = append(index[name], r)
+		}
 	}
-	if v, ok := filenames[filepath.Base(name)]; ok {
-		return v
+	for _, results := range index {
+		slices.SortFunc(results, func(a, b Result) int {
+			return cmp.Or(
+				cmp.Compare(a.Category(), b.Category()),
+				cmp.Compare(a.Key(), b.Key()),
+			)
+		})
 	}
-	nameLower := strings.ToLower(name)
-	if v, ok := filenames[nameLower]; ok {
-		return v
+	return index
+})
+
+// MatchersFor returns all file extension and file name results which resolve to
+// the provided name (case-insensitive, e.g. "Go" or "Dockerfile"), sorted by
+// category, then by key. Use [Result.Category] and [Result.Key] to get the
+// matcher of each result. Returns nil if no results are found.
+func MatchersFor(name string) []Result {
+	return slices.Clone(fileMatchersByName()[strings.ToLower(name)])
+}
+
+// ExtensionsByName returns all file extensions, grouped by the name they resolve
+// to (e.g. "Go" -> ["go"]). Extensions are sorted.
+func ExtensionsByName() map[string][]string {
+	return keysByName(fileExtensions)
+}
+
+// FileNamesByName returns all file names, grouped by the name they resolve to
+// (e.g. "Dockerfile" -> ["compose.yaml", "containerfile", ...]). File names are
+// sorted.
+func FileNamesByName() map[string][]string {
+	return keysByName(filenames)
+}
+
+// keysByName groups the keys of a table by the name of their result.
+func keysByName(table map[string]Result) map[string][]string {
+	grouped := make(map[string][]string)
+	for key, r := range table {
+		grouped[r.Name()] = append(grouped[r.Name()], key)
 	}
-	return filenames[filepath.Base(nameLower)]
+	for _, keys := range grouped {
+		slices.Sort(keys)
+	}
+	return grouped
 }
 
 // ByPath resolves a glyph for a file path (file name, extesion, etc), or nil if
-// it is not found.
+// it is not found. Both "/" and "\" separated paths are supported, as well as
+// URLs (the query and fragment are ignored). Compound extensions are supported,
+// where the longest match wins (e.g. "foo.spec.ts" matches "spec.ts" before
+// "ts"). Use [Explain] to see which matchers were tried, and [Resolver] to cache
+// results.
+//
+// Lookups of ASCII paths do not allocate (except for URLs).
 func ByPath(path string) Result {
-	if v, ok := filenames[path]; ok {
-		return v
-	}
-	base := filepath.Base(path)
-	if v, ok := filenames[base]; ok {
-		return v
-	}
-	pathLower := strings.ToLower(path)
-	if v, ok := filenames[pathLower]; ok {
-		return v
-	}
-	if v, ok := filenames[filepath.Base(pathLower)]; ok {
-		return v
+	r, _ := resolveBase(pathBase(path), nil)
+	return r
+}
+
+// Candidate is a single matcher lookup attempted while resolving a path.
+type Candidate struct {
+	// Category is the category of matchers that was searched.
+	Category Category
+	// Key is the matcher key that was looked up.
+	Key string
+	// Matched is true if the key resolved to a glyph.
+	Matched bool
+}
+
+// Explanation describes how a path was resolved through [ByPath].
+type Explanation struct {
+	// Path is the path that was resolved.
+	Path string
+	// Base is the base name derived from the path, which is used for matching.
+	Base string
+	// Candidates are the lookups that were attempted, in order. Resolution stops
+	// at the first match, which is always the last candidate.
+	Candidates []Candidate
+	// Category is the category of the matched candidate, or empty if nothing
+	// matched.
+	Category Category
+	// Key is the matcher key of the matched candidate, or empty if nothing
+	// matched.
+	Key string
+	// Result is the resolved glyph, or nil if nothing matched. This is always the
+	// same as what [ByPath] returns.
+	Result Result
+}
+
+// Explain resolves a glyph for a file path in the same way as [ByPath], but
+// also returns the ordered list of candidates that were tried, and which one
+// matched (if any). Useful for debugging unexpected results.
+func Explain(path string) *Explanation {
+	e := &Explanation{Path: path, Base: pathBase(path)}
+	e.Result, e.Candidates = resolveBase(e.Base, []Candidate{})
+	if n := len(e.Candidates); n > 0 && e.Candidates[n-1].Matched {
+		e.Category = e.Candidates[n-1].Category
+		e.Key = e.Candidates[n-1].Key
 	}
-	return ByFileExtension(filepath.Ext(path))
+	return e
 }
 
 // OperatingSystems returns an iterator over all the operating systems in the neo
@@ -159,46 +374,6 @@ func ByOperatingSystem(name string) Result {
 	return operatingSystems[strings.ToLower(name)]
 }
 
-var (
-	reOSReleaseID     = regexp.MustCompile(`(?im)^ID=(?P<id>\S+)`)
-	reOSReleaseIDLike = regexp.MustCompile(`(?im)^ID_LIKE=(?P<ids>"([^"]*)"|(\S+))`)
-)
-
-// CurrentOS returns the current operating system glyph, or nil if it is not found.
-func CurrentOS() Result {
-	if runtime.GOOS == "linux" {
-		// Parse /etc/os-release, grabbing the ID key, and use it, if it exists in
-		// the operatingSystems map.
-		content, err := os.ReadFile("/etc/os-release")
-		if err == nil {
-			matches := reOSReleaseID.FindStringSubmatch(string(content))
-			if len(matches) > 1 {
-				if os, ok := operatingSystems[strings.ToLower(matches[1])]; ok {
-					return os
-				}
-			}
-			matches = reOSReleaseIDLike.FindStringSubmatch(string(content))
-			if len(matches) > 1 {
-				for id := range strings.FieldsSeq(matches[1]) {
-					if os, ok := operatingSystems[strings.ToLower(id)]; ok {
-						return os
-					}
-				}
-			}
-		}
-	}
-
-	if v, ok := operatingSystems[runtime.GOOS]; ok {
-		return v
-	}
-
-	if runtime.GOOS == "darwin" {
-		return ByOperatingSystem("apple")
-	}
-
-	return nil
-}
-
 // WindowManagers returns an iterator over all the window managers in the neo package,
 // in no particular order.
 func WindowManagers() iter.Seq2[string, Result] {
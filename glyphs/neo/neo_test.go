@@ -7,6 +7,8 @@
 package neo
 
 import (
+	"encoding/json"
+	"strings"
 	"testing"
 )
 
@@ -160,6 +162,202 @@ func TestByPath(t *testing.T) {
 	}
 }
 
+func TestByPathSeparators(t *testing.T) {
+	t.Parallel()
+
+	want := ByFileName("makefile")
+	if want == nil {
+		t.Fatal("expected result for known file name, got nil")
+	}
+
+	for _, path := range []string{
+		"Makefile",
+		"/some/path/Makefile",
+		`C:\some\path\Makefile`,
+		`some\mixed/path\Makefile`,
+		"https://example.com/some/path/Makefile?ref=main#L10",
+		"/some/path/Makefile/",
+	} {
+		if r := ByPath(path); r != want {
+			t.Errorf("ByPath(%q): expected %v, got %v", path, want, r)
+		}
+	}
+}
+
+func TestExplain(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		path       string
+		category   Category
+		key        string
+		candidates int
+	}{
+		{"/some/path/Makefile", CategoryFileName, "makefile", 2},
+		{`C:\src\main.go`, CategoryFileExtension, "go", 2},
+		{"/src/MAIN.GO", CategoryFileExtension, "go", 4},
+		{"https://example.com/file.3gp?raw=1", CategoryFileExtension, "3gp", 2},
+		{"/some/path/file.nonexistent", "", "", 2},
+		{"/some/path/", "", "", 1},
+		{"", "", "", 0},
+	}
+
+	for _, tt := range tests {
+		e := Explain(tt.path)
+
+		if e.Category != tt.category || e.Key != tt.key {
+			t.Errorf("Explain(%q): expected %q/%q, got %q/%q", tt.path, tt.category, tt.key, e.Category, e.Key)
+		}
+		if len(e.Candidates) != tt.candidates {
+			t.Errorf("Explain(%q): expected %d candidates, got %d: %v", tt.path, tt.candidates, len(e.Candidates), e.Candidates)
+		}
+		if e.Result != ByPath(tt.path) {
+			t.Errorf("Explain(%q): expected result to match ByPath", tt.path)
+		}
+		if (e.Result != nil) != (tt.key != "") {
+			t.Errorf("Explain(%q): unexpected result %v", tt.path, e.Result)
+		}
+	}
+}
+
+func TestResultMetadata(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		r        Result
+		category Category
+		key      string
+	}{
+		{ByFileExtension("3gp"), CategoryFileExtension, "3gp"},
+		{ByFileName("makefile"), CategoryFileName, "makefile"},
+		{ByOperatingSystem("alma"), CategoryOperatingSystem, "alma"},
+		{ByWindowManager("awesomewm"), CategoryWindowManager, "awesomewm"},
+		{ByDesktopEnvironment("budgie"), CategoryDesktopEnvironment, "budgie"},
+	}
+
+	for _, tt := range tests {
+		if tt.r == nil {
+			t.Fatalf("expected result for %q, got nil", tt.key)
+		}
+		if tt.r.Category() != tt.category {
+			t.Errorf("expected category %q, got %q", tt.category, tt.r.Category())
+		}
+		if tt.r.Key() != tt.key {
+			t.Errorf("expected key %q, got %q", tt.key, tt.r.Key())
+		}
+		if tt.r.GlyphClass() == "" || tt.r.GlyphID() == "" {
+			t.Errorf("expected non-empty glyph class and ID for %q", tt.key)
+		}
+		if !strings.HasPrefix(tt.r.ColorHex(true), "#") || len(tt.r.ColorHex(true)) != 7 {
+			t.Errorf("expected hex color for %q, got %q", tt.key, tt.r.ColorHex(true))
+		}
+		if text, err := tt.r.MarshalText(); err != nil || string(text) != tt.r.String() {
+			t.Errorf("expected text encoding to be the glyph, got %q (err: %v)", text, err)
+		}
+	}
+}
+
+func TestEntryJSON(t *testing.T) {
+	t.Parallel()
+
+	r := ByFileExtension("go")
+	if r == nil {
+		t.Fatal("expected result for known file extension, got nil")
+	}
+
+	b, err := json.Marshal(r)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var e Entry
+	if err = json.Unmarshal(b, &e); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if e != r.Entry() {
+		t.Errorf("expected decoded entry %+v to equal %+v", e, r.Entry())
+	}
+
+	rr := e.Result()
+	if rr.Entry() != r.Entry() {
+		t.Errorf("expected round-tripped entry %+v to equal %+v", rr.Entry(), r.Entry())
+	}
+	if rr.ColorHex(false) != r.ColorHex(false) || rr.ColorANSI(false) != r.ColorANSI(false) {
+		t.Errorf("expected round-tripped colors to match")
+	}
+
+	remapped := PaletteByName("nord").Remap(r)
+	b, err = json.Marshal(remapped)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err = json.Unmarshal(b, &e); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if e.DarkColor != remapped.ColorHex(true) {
+		t.Errorf("expected remapped dark color %q, got %q", remapped.ColorHex(true), e.DarkColor)
+	}
+}
+
+func TestMatchersFor(t *testing.T) {
+	t.Parallel()
+
+	results := MatchersFor("dockerfile")
+	if len(results) < 2 {
+		t.Fatalf("expected multiple results for known name, got %d", len(results))
+	}
+
+	var hasExt, hasName bool
+	for i, r := range results {
+		if r.Name() != "Dockerfile" {
+			t.Errorf("expected name %q, got %q", "Dockerfile", r.Name())
+		}
+		switch r.Category() { //nolint:exhaustive
+		case CategoryFileExtension:
+			hasExt = true
+		case CategoryFileName:
+			hasName = true
+		}
+		if i > 0 && results[i-1].Category() == r.Category() && results[i-1].Key() >= r.Key() {
+			t.Errorf("expected results to be sorted, got %q before %q", results[i-1].Key(), r.Key())
+		}
+	}
+	if !hasExt || !hasName {
+		t.Errorf("expected both extension and file name results, got ext=%t name=%t", hasExt, hasName)
+	}
+
+	if r := MatchersFor("nonexistent"); r != nil {
+		t.Errorf("expected nil for nonexistent name, got %v", r)
+	}
+}
+
+func TestKeysByName(t *testing.T) {
+	t.Parallel()
+
+	exts := ExtensionsByName()
+	n := 0
+	for name, keys := range exts {
+		for _, key := range keys {
+			if r := ByFileExtension(key); r == nil || r.Name() != name {
+				t.Errorf("expected extension %q to resolve to %q", key, name)
+			}
+		}
+		n += len(keys)
+	}
+	if n != fileExtCount {
+		t.Errorf("expected %d grouped extensions, got %d", fileExtCount, n)
+	}
+
+	names := FileNamesByName()
+	n = 0
+	for _, keys := range names {
+		n += len(keys)
+	}
+	if n != filenameCount {
+		t.Errorf("expected %d grouped file names, got %d", filenameCount, n)
+	}
+}
+
 func TestOperatingSystems(t *testing.T) {
 	t.Parallel()
 
@@ -8,361 +8,708 @@ package oct
 
 import (
 	"iter"
-	"maps"
+	"slices"
 	"strings"
 
 	"github.com/lrstanley/go-nf"
 )
 
-var (
-	allGlyphs = map[string]nf.Glyph{
-		"accessibility":            Accessibility,
-		"accessibility_inset":      AccessibilityInset,
-		"alert":                    Alert,
-		"alert_fill":               AlertFill,
-		"apps":                     Apps,
-		"archive":                  Archive,
-		"arrow_both":               ArrowBoth,
-		"arrow_down":               ArrowDown,
-		"arrow_down_left":          ArrowDownLeft,
-		"arrow_down_right":         ArrowDownRight,
-		"arrow_left":               ArrowLeft,
-		"arrow_right":              ArrowRight,
-		"arrow_switch":             ArrowSwitch,
-		"arrow_up":                 ArrowUp,
-		"arrow_up_left":            ArrowUpLeft,
-		"arrow_up_right":           ArrowUpRight,
-		"beaker":                   Beaker,
-		"bell":                     Bell,
-		"bell_fill":                BellFill,
-		"bell_slash":               BellSlash,
-		"blocked":                  Blocked,
-		"bold":                     Bold,
-		"book":                     Book,
-		"bookmark":                 Bookmark,
-		"bookmark_fill":            BookmarkFill,
-		"bookmark_slash":           BookmarkSlash,
-		"bookmark_slash_fill":      BookmarkSlashFill,
-		"briefcase":                Briefcase,
-		"broadcast":                Broadcast,
-		"browser":                  Browser,
-		"bug":                      Bug,
-		"cache":                    Cache,
-		"calendar":                 Calendar,
-		"check":                    Check,
-		"check_circle":             CheckCircle,
-		"check_circle_fill":        CheckCircleFill,
-		"checkbox":                 Checkbox,
-		"checklist":                Checklist,
-		"chevron_down":             ChevronDown,
-		"chevron_left":             ChevronLeft,
-		"chevron_right":            ChevronRight,
-		"chevron_up":               ChevronUp,
-		"circle":                   Circle,
-		"circle_slash":             CircleSlash,
-		"clock":                    Clock,
-		"clock_fill":               ClockFill,
-		"cloud":                    Cloud,
-		"cloud_offline":            CloudOffline,
-		"code":                     Code,
-		"code_of_conduct":          CodeOfConduct,
-		"code_review":              CodeReview,
-		"code_square":              CodeSquare,
-		"codescan":                 Codescan,
-		"codescan_checkmark":       CodescanCheckmark,
-		"codespaces":               Codespaces,
-		"columns":                  Columns,
-		"command_palette":          CommandPalette,
-		"comment":                  Comment,
-		"comment_discussion":       CommentDiscussion,
-		"commit":                   Commit,
-		"container":                Container,
-		"copilot":                  Copilot,
-		"copilot_error":            CopilotError,
-		"copilot_warning":          CopilotWarning,
-		"copy":                     Copy,
-		"cpu":                      Cpu,
-		"credit_card":              CreditCard,
-		"cross_reference":          CrossReference,
-		"dash":                     Dash,
-		"database":                 Database,
-		"dependabot":               Dependabot,
-		"desktop_download":         DesktopDownload,
-		"device_camera":            DeviceCamera,
-		"device_camera_video":      DeviceCameraVideo,
-		"device_desktop":           DeviceDesktop,
-		"device_mobile":            DeviceMobile,
-		"diamond":                  Diamond,
-		"diff":                     Diff,
-		"diff_added":               DiffAdded,
-		"diff_ignored":             DiffIgnored,
-		"diff_modified":            DiffModified,
-		"diff_removed":             DiffRemoved,
-		"diff_renamed":             DiffRenamed,
-		"discussion_closed":        DiscussionClosed,
-		"discussion_duplicate":     DiscussionDuplicate,
-		"discussion_outdated":      DiscussionOutdated,
-		"dot":                      Dot,
-		"dot_fill":                 DotFill,
-		"download":                 Download,
-		"duplicate":                Duplicate,
-		"ellipsis":                 Ellipsis,
-		"eye":                      Eye,
-		"eye_closed":               EyeClosed,
-		"feed_discussion":          FeedDiscussion,
-		"feed_forked":              FeedForked,
-		"feed_heart":               FeedHeart,
-		"feed_merged":              FeedMerged,
-		"feed_person":              FeedPerson,
-		"feed_repo":                FeedRepo,
-		"feed_rocket":              FeedRocket,
-		"feed_star":                FeedStar,
-		"feed_tag":                 FeedTag,
-		"feed_trophy":              FeedTrophy,
-		"file":                     File,
-		"file_added":               FileAdded,
-		"file_badge":               FileBadge,
-		"file_binary":              FileBinary,
-		"file_code":                FileCode,
-		"file_diff":                FileDiff,
-		"file_directory":           FileDirectory,
-		"file_directory_fill":      FileDirectoryFill,
-		"file_directory_open_fill": FileDirectoryOpenFill,
-		"file_media":               FileMedia,
-		"file_moved":               FileMoved,
-		"file_removed":             FileRemoved,
-		"file_submodule":           FileSubmodule,
-		"file_symlink_directory":   FileSymlinkDirectory,
-		"file_symlink_file":        FileSymlinkFile,
-		"file_zip":                 FileZip,
-		"filter":                   Filter,
-		"fiscal_host":              FiscalHost,
-		"flame":                    Flame,
-		"fold":                     Fold,
-		"fold_down":                FoldDown,
-		"fold_up":                  FoldUp,
-		"gear":                     Gear,
-		"gift":                     Gift,
-		"git_branch":               GitBranch,
-		"git_commit":               GitCommit,
-		"git_compare":              GitCompare,
-		"git_merge":                GitMerge,
-		"git_merge_queue":          GitMergeQueue,
-		"git_pull_request":         GitPullRequest,
-		"git_pull_request_closed":  GitPullRequestClosed,
-		"git_pull_request_draft":   GitPullRequestDraft,
-		"globe":                    Globe,
-		"goal":                     Goal,
-		"grabber":                  Grabber,
-		"graph":                    Graph,
-		"hash":                     Hash,
-		"heading":                  Heading,
-		"heart":                    Heart,
-		"heart_fill":               HeartFill,
-		"history":                  History,
-		"home":                     Home,
-		"home_fill":                HomeFill,
-		"horizontal_rule":          HorizontalRule,
-		"hourglass":                Hourglass,
-		"hubot":                    Hubot,
-		"id_badge":                 IdBadge,
-		"image":                    Image,
-		"inbox":                    Inbox,
-		"infinity":                 Infinity,
-		"info":                     Info,
-		"issue_closed":             IssueClosed,
-		"issue_draft":              IssueDraft,
-		"issue_opened":             IssueOpened,
-		"issue_reopened":           IssueReopened,
-		"issue_tracked_by":         IssueTrackedBy,
-		"issue_tracks":             IssueTracks,
-		"italic":                   Italic,
-		"iterations":               Iterations,
-		"kebab_horizontal":         KebabHorizontal,
-		"key":                      Key,
-		"key_asterisk":             KeyAsterisk,
-		"law":                      Law,
-		"light_bulb":               LightBulb,
-		"link":                     Link,
-		"link_external":            LinkExternal,
-		"list_ordered":             ListOrdered,
-		"list_unordered":           ListUnordered,
-		"location":                 Location,
-		"lock":                     Lock,
-		"log":                      Log,
-		"logo_gist":                LogoGist,
-		"logo_github":              LogoGithub,
-		"mail":                     Mail,
-		"mark_github":              MarkGithub,
-		"markdown":                 Markdown,
-		"megaphone":                Megaphone,
-		"mention":                  Mention,
-		"meter":                    Meter,
-		"milestone":                Milestone,
-		"mirror":                   Mirror,
-		"moon":                     Moon,
-		"mortar_board":             MortarBoard,
-		"move_to_bottom":           MoveToBottom,
-		"move_to_end":              MoveToEnd,
-		"move_to_start":            MoveToStart,
-		"move_to_top":              MoveToTop,
-		"multi_select":             MultiSelect,
-		"mute":                     Mute,
-		"no_entry":                 NoEntry,
-		"north_star":               NorthStar,
-		"note":                     Note,
-		"number":                   Number,
-		"organization":             Organization,
-		"package":                  Package,
-		"package_dependencies":     PackageDependencies,
-		"package_dependents":       PackageDependents,
-		"paintbrush":               Paintbrush,
-		"paper_airplane":           PaperAirplane,
-		"paperclip":                Paperclip,
-		"passkey_fill":             PasskeyFill,
-		"paste":                    Paste,
-		"pencil":                   Pencil,
-		"people":                   People,
-		"person":                   Person,
-		"person_add":               PersonAdd,
-		"person_fill":              PersonFill,
-		"pin":                      Pin,
-		"play":                     Play,
-		"plug":                     Plug,
-		"plus":                     Plus,
-		"plus_circle":              PlusCircle,
-		"project":                  Project,
-		"project_roadmap":          ProjectRoadmap,
-		"project_symlink":          ProjectSymlink,
-		"project_template":         ProjectTemplate,
-		"pulse":                    Pulse,
-		"question":                 Question,
-		"quote":                    Quote,
-		"read":                     Read,
-		"rel_file_path":            RelFilePath,
-		"reply":                    Reply,
-		"repo":                     Repo,
-		"repo_clone":               RepoClone,
-		"repo_deleted":             RepoDeleted,
-		"repo_forked":              RepoForked,
-		"repo_locked":              RepoLocked,
-		"repo_pull":                RepoPull,
-		"repo_push":                RepoPush,
-		"repo_template":            RepoTemplate,
-		"report":                   Report,
-		"rocket":                   Rocket,
-		"rows":                     Rows,
-		"rss":                      Rss,
-		"ruby":                     Ruby,
-		"screen_full":              ScreenFull,
-		"screen_normal":            ScreenNormal,
-		"search":                   Search,
-		"server":                   Server,
-		"share":                    Share,
-		"share_android":            ShareAndroid,
-		"shield":                   Shield,
-		"shield_check":             ShieldCheck,
-		"shield_lock":              ShieldLock,
-		"shield_slash":             ShieldSlash,
-		"shield_x":                 ShieldX,
-		"sidebar_collapse":         SidebarCollapse,
-		"sidebar_expand":           SidebarExpand,
-		"sign_in":                  SignIn,
-		"sign_out":                 SignOut,
-		"single_select":            SingleSelect,
-		"skip":                     Skip,
-		"skip_fill":                SkipFill,
-		"sliders":                  Sliders,
-		"smiley":                   Smiley,
-		"sort_asc":                 SortAsc,
-		"sort_desc":                SortDesc,
-		"sparkle_fill":             SparkleFill,
-		"sponsor_tiers":            SponsorTiers,
-		"square":                   Square,
-		"square_fill":              SquareFill,
-		"squirrel":                 Squirrel,
-		"stack":                    Stack,
-		"star":                     Star,
-		"star_fill":                StarFill,
-		"stop":                     Stop,
-		"stopwatch":                Stopwatch,
-		"strikethrough":            Strikethrough,
-		"sun":                      Sun,
-		"sync":                     Sync,
-		"tab":                      Tab,
-		"tab_external":             TabExternal,
-		"table":                    Table,
-		"tag":                      Tag,
-		"tasklist":                 Tasklist,
-		"telescope":                Telescope,
-		"telescope_fill":           TelescopeFill,
-		"terminal":                 Terminal,
-		"three_bars":               ThreeBars,
-		"thumbsdown":               Thumbsdown,
-		"thumbsup":                 Thumbsup,
-		"tools":                    Tools,
-		"trash":                    Trash,
-		"triangle_down":            TriangleDown,
-		"triangle_left":            TriangleLeft,
-		"triangle_right":           TriangleRight,
-		"triangle_up":              TriangleUp,
-		"trophy":                   Trophy,
-		"typography":               Typography,
-		"unfold":                   Unfold,
-		"unlink":                   Unlink,
-		"unlock":                   Unlock,
-		"unmute":                   Unmute,
-		"unread":                   Unread,
-		"unverified":               Unverified,
-		"upload":                   Upload,
-		"verified":                 Verified,
-		"versions":                 Versions,
-		"video":                    Video,
-		"webhook":                  Webhook,
-		"workflow":                 Workflow,
-		"x":                        X,
-		"x_circle":                 XCircle,
-		"x_circle_fill":            XCircleFill,
-		"zap":                      Zap,
-		"zoom_in":                  ZoomIn,
-		"zoom_out":                 ZoomOut,
-	}
-)
+// glyphIDs are the IDs of all glyphs in the class, sorted, such that they can be
+// binary searched. The glyph for each ID is at the same index in glyphValues.
+//
+// Arrays of constants are laid out statically by the compiler, unlike maps,
+// which are built at init time.
+var glyphIDs = [...]string{
+	"accessibility",
+	"accessibility_inset",
+	"alert",
+	"alert_fill",
+	"apps",
+	"archive",
+	"arrow_both",
+	"arrow_down",
+	"arrow_down_left",
+	"arrow_down_right",
+	"arrow_left",
+	"arrow_right",
+	"arrow_switch",
+	"arrow_up",
+	"arrow_up_left",
+	"arrow_up_right",
+	"beaker",
+	"bell",
+	"bell_fill",
+	"bell_slash",
+	"blocked",
+	"bold",
+	"book",
+	"bookmark",
+	"bookmark_fill",
+	"bookmark_slash",
+	"bookmark_slash_fill",
+	"briefcase",
+	"broadcast",
+	"browser",
+	"bug",
+	"cache",
+	"calendar",
+	"check",
+	"check_circle",
+	"check_circle_fill",
+	"checkbox",
+	"checklist",
+	"chevron_down",
+	"chevron_left",
+	"chevron_right",
+	"chevron_up",
+	"circle",
+	"circle_slash",
+	"clock",
+	"clock_fill",
+	"cloud",
+	"cloud_offline",
+	"code",
+	"code_of_conduct",
+	"code_review",
+	"code_square",
+	"codescan",
+	"codescan_checkmark",
+	"codespaces",
+	"columns",
+	"command_palette",
+	"comment",
+	"comment_discussion",
+	"commit",
+	"container",
+	"copilot",
+	"copilot_error",
+	"copilot_warning",
+	"copy",
+	"cpu",
+	"credit_card",
+	"cross_reference",
+	"dash",
+	"database",
+	"dependabot",
+	"desktop_download",
+	"device_camera",
+	"device_camera_video",
+	"device_desktop",
+	"device_mobile",
+	"diamond",
+	"diff",
+	"diff_added",
+	"diff_ignored",
+	"diff_modified",
+	"diff_removed",
+	"diff_renamed",
+	"discussion_closed",
+	"discussion_duplicate",
+	"discussion_outdated",
+	"dot",
+	"dot_fill",
+	"download",
+	"duplicate",
+	"ellipsis",
+	"eye",
+	"eye_closed",
+	"feed_discussion",
+	"feed_forked",
+	"feed_heart",
+	"feed_merged",
+	"feed_person",
+	"feed_repo",
+	"feed_rocket",
+	"feed_star",
+	"feed_tag",
+	"feed_trophy",
+	"file",
+	"file_added",
+	"file_badge",
+	"file_binary",
+	"file_code",
+	"file_diff",
+	"file_directory",
+	"file_directory_fill",
+	"file_directory_open_fill",
+	"file_media",
+	"file_moved",
+	"file_removed",
+	"file_submodule",
+	"file_symlink_directory",
+	"file_symlink_file",
+	"file_zip",
+	"filter",
+	"fiscal_host",
+	"flame",
+	"fold",
+	"fold_down",
+	"fold_up",
+	"gear",
+	"gift",
+	"git_branch",
+	"git_commit",
+	"git_compare",
+	"git_merge",
+	"git_merge_queue",
+	"git_pull_request",
+	"git_pull_request_closed",
+	"git_pull_request_draft",
+	"globe",
+	"goal",
+	"grabber",
+	"graph",
+	"hash",
+	"heading",
+	"heart",
+	"heart_fill",
+	"history",
+	"home",
+	"home_fill",
+	"horizontal_rule",
+	"hourglass",
+	"hubot",
+	"id_badge",
+	"image",
+	"inbox",
+	"infinity",
+	"info",
+	"issue_closed",
+	"issue_draft",
+	"issue_opened",
+	"issue_reopened",
+	"issue_tracked_by",
+	"issue_tracks",
+	"italic",
+	"iterations",
+	"kebab_horizontal",
+	"key",
+	"key_asterisk",
+	"law",
+	"light_bulb",
+	"link",
+	"link_external",
+	"list_ordered",
+	"list_unordered",
+	"location",
+	"lock",
+	"log",
+	"logo_gist",
+	"logo_github",
+	"mail",
+	"mark_github",
+	"markdown",
+	"megaphone",
+	"mention",
+	"meter",
+	"milestone",
+	"mirror",
+	"moon",
+	"mortar_board",
+	"move_to_bottom",
+	"move_to_end",
+	"move_to_start",
+	"move_to_top",
+	"multi_select",
+	"mute",
+	"no_entry",
+	"north_star",
+	"note",
+	"number",
+	"organization",
+	"package",
+	"package_dependencies",
+	"package_dependents",
+	"paintbrush",
+	"paper_airplane",
+	"paperclip",
+	"passkey_fill",
+	"paste",
+	"pencil",
+	"people",
+	"person",
+	"person_add",
+	"person_fill",
+	"pin",
+	"play",
+	"plug",
+	"plus",
+	"plus_circle",
+	"project",
+	"project_roadmap",
+	"project_symlink",
+	"project_template",
+	"pulse",
+	"question",
+	"quote",
+	"read",
+	"rel_file_path",
+	"reply",
+	"repo",
+	"repo_clone",
+	"repo_deleted",
+	"repo_forked",
+	"repo_locked",
+	"repo_pull",
+	"repo_push",
+	"repo_template",
+	"report",
+	"rocket",
+	"rows",
+	"rss",
+	"ruby",
+	"screen_full",
+	"screen_normal",
+	"search",
+	"server",
+	"share",
+	"share_android",
+	"shield",
+	"shield_check",
+	"shield_lock",
+	"shield_slash",
+	"shield_x",
+	"sidebar_collapse",
+	"sidebar_expand",
+	"sign_in",
+	"sign_out",
+	"single_select",
+	"skip",
+	"skip_fill",
+	"sliders",
+	"smiley",
+	"sort_asc",
+	"sort_desc",
+	"sparkle_fill",
+	"sponsor_tiers",
+	"square",
+	"square_fill",
+	"squirrel",
+	"stack",
+	"star",
+	"star_fill",
+	"stop",
+	"stopwatch",
+	"strikethrough",
+	"sun",
+	"sync",
+	"tab",
+	"tab_external",
+	"table",
+	"tag",
+	"tasklist",
+	"telescope",
+	"telescope_fill",
+	"terminal",
+	"three_bars",
+	"thumbsdown",
+	"thumbsup",
+	"tools",
+	"trash",
+	"triangle_down",
+	"triangle_left",
+	"triangle_right",
+	"triangle_up",
+	"trophy",
+	"typography",
+	"unfold",
+	"unlink",
+	"unlock",
+	"unmute",
+	"unread",
+	"unverified",
+	"upload",
+	"verified",
+	"versions",
+	"video",
+	"webhook",
+	"workflow",
+	"x",
+	"x_circle",
+	"x_circle_fill",
+	"zap",
+	"zoom_in",
+	"zoom_out",
+}
+
+// glyphValues are the glyphs in the class, in the same order as glyphIDs.
+var glyphValues = [len(glyphIDs)]nf.Glyph{
+	Accessibility,
+	AccessibilityInset,
+	Alert,
+	AlertFill,
+	Apps,
+	Archive,
+	ArrowBoth,
+	ArrowDown,
+	ArrowDownLeft,
+	ArrowDownRight,
+	ArrowLeft,
+	ArrowRight,
+	ArrowSwitch,
+	ArrowUp,
+	ArrowUpLeft,
+	ArrowUpRight,
+	Beaker,
+	Bell,
+	BellFill,
+	BellSlash,
+	Blocked,
+	Bold,
+	Book,
+	Bookmark,
+	BookmarkFill,
+	BookmarkSlash,
+	BookmarkSlashFill,
+	Briefcase,
+	Broadcast,
+	Browser,
+	Bug,
+	Cache,
+	Calendar,
+	Check,
+	CheckCircle,
+	CheckCircleFill,
+	Checkbox,
+	Checklist,
+	ChevronDown,
+	ChevronLeft,
+	ChevronRight,
+	ChevronUp,
+	Circle,
+	CircleSlash,
+	Clock,
+	ClockFill,
+	Cloud,
+	CloudOffline,
+	Code,
+	CodeOfConduct,
+	CodeReview,
+	CodeSquare,
+	Codescan,
+	CodescanCheckmark,
+	Codespaces,
+	Columns,
+	CommandPalette,
+	Comment,
+	CommentDiscussion,
+	Commit,
+	Container,
+	Copilot,
+	CopilotError,
+	CopilotWarning,
+	Copy,
+	Cpu,
+	CreditCard,
+	CrossReference,
+	Dash,
+	Database,
+	Dependabot,
+	DesktopDownload,
+	DeviceCamera,
+	DeviceCameraVideo,
+	DeviceDesktop,
+	DeviceMobile,
+	Diamond,
+	Diff,
+	DiffAdded,
+	DiffIgnored,
+	DiffModified,
+	DiffRemoved,
+	DiffRenamed,
+	DiscussionClosed,
+	DiscussionDuplicate,
+	DiscussionOutdated,
+	Dot,
+	DotFill,
+	Download,
+	Duplicate,
+	Ellipsis,
+	Eye,
+	EyeClosed,
+	FeedDiscussion,
+	FeedForked,
+	FeedHeart,
+	FeedMerged,
+	FeedPerson,
+	FeedRepo,
+	FeedRocket,
+	FeedStar,
+	FeedTag,
+	FeedTrophy,
+	File,
+	FileAdded,
+	FileBadge,
+	FileBinary,
+	FileCode,
+	FileDiff,
+	FileDirectory,
+	FileDirectoryFill,
+	FileDirectoryOpenFill,
+	FileMedia,
+	FileMoved,
+	FileRemoved,
+	FileSubmodule,
+	FileSymlinkDirectory,
+	FileSymlinkFile,
+	FileZip,
+	Filter,
+	FiscalHost,
+	Flame,
+	Fold,
+	FoldDown,
+	FoldUp,
+	Gear,
+	Gift,
+	GitBranch,
+	GitCommit,
+	GitCompare,
+	GitMerge,
+	GitMergeQueue,
+	GitPullRequest,
+	GitPullRequestClosed,
+	GitPullRequestDraft,
+	Globe,
+	Goal,
+	Grabber,
+	Graph,
+	Hash,
+	Heading,
+	Heart,
+	HeartFill,
+	History,
+	Home,
+	HomeFill,
+	HorizontalRule,
+	Hourglass,
+	Hubot,
+	IdBadge,
+	Image,
+	Inbox,
+	Infinity,
+	Info,
+	IssueClosed,
+	IssueDraft,
+	IssueOpened,
+	IssueReopened,
+	IssueTrackedBy,
+	IssueTracks,
+	Italic,
+	Iterations,
+	KebabHorizontal,
+	Key,
+	KeyAsterisk,
+	Law,
+	LightBulb,
+	Link,
+	LinkExternal,
+	ListOrdered,
+	ListUnordered,
+	Location,
+	Lock,
+	Log,
+	LogoGist,
+	LogoGithub,
+	Mail,
+	MarkGithub,
+	Markdown,
+	Megaphone,
+	Mention,
+	Meter,
+	Milestone,
+	Mirror,
+	Moon,
+	MortarBoard,
+	MoveToBottom,
+	MoveToEnd,
+	MoveToStart,
+	MoveToTop,
+	MultiSelect,
+	Mute,
+	NoEntry,
+	NorthStar,
+	Note,
+	Number,
+	Organization,
+	Package,
+	PackageDependencies,
+	PackageDependents,
+	Paintbrush,
+	PaperAirplane,
+	Paperclip,
+	PasskeyFill,
+	Paste,
+	Pencil,
+	People,
+	Person,
+	PersonAdd,
+	PersonFill,
+	Pin,
+	Play,
+	Plug,
+	Plus,
+	PlusCircle,
+	Project,
+	ProjectRoadmap,
+	ProjectSymlink,
+	ProjectTemplate,
+	Pulse,
+	Question,
+	Quote,
+	Read,
+	RelFilePath,
+	Reply,
+	Repo,
+	RepoClone,
+	RepoDeleted,
+	RepoForked,
+	RepoLocked,
+	RepoPull,
+	RepoPush,
+	RepoTemplate,
+	Report,
+	Rocket,
+	Rows,
+	Rss,
+	Ruby,
+	ScreenFull,
+	ScreenNormal,
+	Search,
+	Server,
+	Share,
+	ShareAndroid,
+	Shield,
+	ShieldCheck,
+	ShieldLock,
+	ShieldSlash,
+	ShieldX,
+	SidebarCollapse,
+	SidebarExpand,
+	SignIn,
+	SignOut,
+	SingleSelect,
+	Skip,
+	SkipFill,
+	Sliders,
+	Smiley,
+	SortAsc,
+	SortDesc,
+	SparkleFill,
+	SponsorTiers,
+	Square,
+	SquareFill,
+	Squirrel,
+	Stack,
+	Star,
+	StarFill,
+	Stop,
+	Stopwatch,
+	Strikethrough,
+	Sun,
+	Sync,
+	Tab,
+	TabExternal,
+	Table,
+	Tag,
+	Tasklist,
+	Telescope,
+	TelescopeFill,
+	Terminal,
+	ThreeBars,
+	Thumbsdown,
+	Thumbsup,
+	Tools,
+	Trash,
+	TriangleDown,
+	TriangleLeft,
+	TriangleRight,
+	TriangleUp,
+	Trophy,
+	Typography,
+	Unfold,
+	Unlink,
+	Unlock,
+	Unmute,
+	Unread,
+	Unverified,
+	Upload,
+	Verified,
+	Versions,
+	Video,
+	Webhook,
+	Workflow,
+	X,
+	XCircle,
+	XCircleFill,
+	Zap,
+	ZoomIn,
+	ZoomOut,
+}
 
 // AllGlyphs returns an iterator over all the glyphs in the oct class,
-// returned in no particular order.
+// sorted by ID.
 func AllGlyphs() iter.Seq[nf.Glyph] {
-	return maps.Values(allGlyphs)
+	return slices.Values(glyphValues[:])
 }
 
 // ByID finds a glyph by its short or full ID within the class, or an empty string
-// if the glyph is not found.
+// if the glyph is not found. Lookups do not allocate.
 func ByID(id string) nf.Glyph {
-	if glyph, ok := allGlyphs[id]; ok {
+	if glyph, ok := lookup(id); ok {
 		return glyph
 	}
 	if _, stripped, ok := strings.Cut(id, string(Class)+"-"); ok {
-		if glyph, gok := allGlyphs[stripped]; gok {
+		if glyph, gok := lookup(stripped); gok {
 			return glyph
 		}
 	}
 	return ""
 }
 
+// lookup binary searches for a glyph by its short ID.
+func lookup(id string) (nf.Glyph, bool) {
+	if i, ok := slices.BinarySearch(glyphIDs[:], id); ok {
+		return glyphValues[i], true
+	}
+	return "", false
+}
+
 // AllGlyphIDs returns an iterator over all the IDs of the glyphs in the class,
-// returned in no particular order.
+// sorted.
 func AllGlyphIDs() iter.Seq[string] {
-	return maps.Keys(allGlyphs)
+	return slices.Values(glyphIDs[:])
 }
 
 // AllGlyphFullIDs returns an iterator over all the full IDs of the glyphs in
-// the class, returned in no particular order.
+// the class, sorted.
 func AllGlyphFullIDs() iter.Seq[string] {
 	return func(yield func(string) bool) {
-		for id := range allGlyphs {
+		for _, id := range glyphIDs {
 			if !yield(string(Class) + "-" + id) {
 				return
 			}
 		}
 	}
 }
+
+// glyphSet implements [nf.GlyphSet] for the class.
+type glyphSet struct{}
+
+// Lookup returns the glyph with the given short ID, and whether it was found.
+func (glyphSet) Lookup(id string) (nf.Glyph, bool) {
+	return lookup(id)
+}
+
+// All returns an iterator over all short IDs and their glyphs, sorted by ID.
+func (glyphSet) All() iter.Seq2[string, nf.Glyph] {
+	return func(yield func(string, nf.Glyph) bool) {
+		for i, id := range glyphIDs {
+			if !yield(id, glyphValues[i]) {
+				return
+			}
+		}
+	}
+}
+
+// The class is registered with [nf.RegisterClass] on import.
+var _ = nf.MustRegisterClass(Class, glyphSet{})
@@ -8,85 +8,156 @@ package ple
 
 import (
 	"iter"
-	"maps"
+	"slices"
 	"strings"
 
 	"github.com/lrstanley/go-nf"
 )
 
-var (
-	allGlyphs = map[string]nf.Glyph{
-		"backslash_separator":              BackslashSeparator,
-		"backslash_separator_redundant":    BackslashSeparatorRedundant,
-		"column_number":                    ColumnNumber,
-		"current_column":                   CurrentColumn,
-		"flame_thick":                      FlameThick,
-		"flame_thick_mirrored":             FlameThickMirrored,
-		"flame_thin":                       FlameThin,
-		"flame_thin_mirrored":              FlameThinMirrored,
-		"forwardslash_separator":           ForwardslashSeparator,
-		"forwardslash_separator_redundant": ForwardslashSeparatorRedundant,
-		"honeycomb":                        Honeycomb,
-		"honeycomb_outline":                HoneycombOutline,
-		"ice_waveform":                     IceWaveform,
-		"ice_waveform_mirrored":            IceWaveformMirrored,
-		"left_half_circle_thick":           LeftHalfCircleThick,
-		"left_half_circle_thin":            LeftHalfCircleThin,
-		"left_hard_divider_inverse":        LeftHardDividerInverse,
-		"lego_block_facing":                LegoBlockFacing,
-		"lego_block_sideways":              LegoBlockSideways,
-		"lego_separator":                   LegoSeparator,
-		"lego_separator_thin":              LegoSeparatorThin,
-		"lower_left_triangle":              LowerLeftTriangle,
-		"lower_right_triangle":             LowerRightTriangle,
-		"pixelated_squares_big":            PixelatedSquaresBig,
-		"pixelated_squares_big_mirrored":   PixelatedSquaresBigMirrored,
-		"pixelated_squares_small":          PixelatedSquaresSmall,
-		"pixelated_squares_small_mirrored": PixelatedSquaresSmallMirrored,
-		"right_half_circle_thick":          RightHalfCircleThick,
-		"right_half_circle_thin":           RightHalfCircleThin,
-		"right_hard_divider_inverse":       RightHardDividerInverse,
-		"trapezoid_top_bottom":             TrapezoidTopBottom,
-		"trapezoid_top_bottom_mirrored":    TrapezoidTopBottomMirrored,
-		"upper_left_triangle":              UpperLeftTriangle,
-		"upper_right_triangle":             UpperRightTriangle,
-	}
-)
+// glyphIDs are the IDs of all glyphs in the class, sorted, such that they can be
+// binary searched. The glyph for each ID is at the same index in glyphValues.
+//
+// Arrays of constants are laid out statically by the compiler, unlike maps,
+// which are built at init time.
+var glyphIDs = [...]string{
+	"backslash_separator",
+	"backslash_separator_redundant",
+	"column_number",
+	"current_column",
+	"flame_thick",
+	"flame_thick_mirrored",
+	"flame_thin",
+	"flame_thin_mirrored",
+	"forwardslash_separator",
+	"forwardslash_separator_redundant",
+	"honeycomb",
+	"honeycomb_outline",
+	"ice_waveform",
+	"ice_waveform_mirrored",
+	"left_half_circle_thick",
+	"left_half_circle_thin",
+	"left_hard_divider_inverse",
+	"lego_block_facing",
+	"lego_block_sideways",
+	"lego_separator",
+	"lego_separator_thin",
+	"lower_left_triangle",
+	"lower_right_triangle",
+	"pixelated_squares_big",
+	"pixelated_squares_big_mirrored",
+	"pixelated_squares_small",
+	"pixelated_squares_small_mirrored",
+	"right_half_circle_thick",
+	"right_half_circle_thin",
+	"right_hard_divider_inverse",
+	"trapezoid_top_bottom",
+	"trapezoid_top_bottom_mirrored",
+	"upper_left_triangle",
+	"upper_right_triangle",
+}
+
+// glyphValues are the glyphs in the class, in the same order as glyphIDs.
+var glyphValues = [len(glyphIDs)]nf.Glyph{
+	BackslashSeparator,
+	BackslashSeparatorRedundant,
+	ColumnNumber,
+	CurrentColumn,
+	FlameThick,
+	FlameThickMirrored,
+	FlameThin,
+	FlameThinMirrored,
+	ForwardslashSeparator,
+	ForwardslashSeparatorRedundant,
+	Honeycomb,
+	HoneycombOutline,
+	IceWaveform,
+	IceWaveformMirrored,
+	LeftHalfCircleThick,
+	LeftHalfCircleThin,
+	LeftHardDividerInverse,
+	LegoBlockFacing,
+	LegoBlockSideways,
+	LegoSeparator,
+	LegoSeparatorThin,
+	LowerLeftTriangle,
+	LowerRightTriangle,
+	PixelatedSquaresBig,
+	PixelatedSquaresBigMirrored,
+	PixelatedSquaresSmall,
+	PixelatedSquaresSmallMirrored,
+	RightHalfCircleThick,
+	RightHalfCircleThin,
+	RightHardDividerInverse,
+	TrapezoidTopBottom,
+	TrapezoidTopBottomMirrored,
+	UpperLeftTriangle,
+	UpperRightTriangle,
+}
 
 // AllGlyphs returns an iterator over all the glyphs in the ple class,
-// returned in no particular order.
+// sorted by ID.
 func AllGlyphs() iter.Seq[nf.Glyph] {
-	return maps.Values(allGlyphs)
+	return slices.Values(glyphValues[:])
 }
 
 // ByID finds a glyph by its short or full ID within the class, or an empty string
-// if the glyph is not found.
+// if the glyph is not found. Lookups do not allocate.
 func ByID(id string) nf.Glyph {
-	if glyph, ok := allGlyphs[id]; ok {
+	if glyph, ok := lookup(id); ok {
 		return glyph
 	}
 	if _, stripped, ok := strings.Cut(id, string(Class)+"-"); ok {
-		if glyph, gok := allGlyphs[stripped]; gok {
+		if glyph, gok := lookup(stripped); gok {
 			return glyph
 		}
 	}
 	return ""
 }
 
+// lookup binary searches for a glyph by its short ID.
+func lookup(id string) (nf.Glyph, bool) {
+	if i, ok := slices.BinarySearch(glyphIDs[:], id); ok {
+		return glyphValues[i], true
+	}
+	return "", false
+}
+
 // AllGlyphIDs returns an iterator over all the IDs of the glyphs in the class,
-// returned in no particular order.
+// sorted.
 func AllGlyphIDs() iter.Seq[string] {
-	return maps.Keys(allGlyphs)
+	return slices.Values(glyphIDs[:])
 }
 
 // AllGlyphFullIDs returns an iterator over all the full IDs of the glyphs in
-// the class, returned in no particular order.
+// the class, sorted.
 func AllGlyphFullIDs() iter.Seq[string] {
 	return func(yield func(string) bool) {
-		for id := range allGlyphs {
+		for _, id := range glyphIDs {
 			if !yield(string(Class) + "-" + id) {
 				return
 			}
 		}
 	}
 }
+
+// glyphSet implements [nf.GlyphSet] for the class.
+type glyphSet struct{}
+
+// Lookup returns the glyph with the given short ID, and whether it was found.
+func (glyphSet) Lookup(id string) (nf.Glyph, bool) {
+	return lookup(id)
+}
+
+// All returns an iterator over all short IDs and their glyphs, sorted by ID.
+func (glyphSet) All() iter.Seq2[string, nf.Glyph] {
+	return func(yield func(string, nf.Glyph) bool) {
+		for i, id := range glyphIDs {
+			if !yield(id, glyphValues[i]) {
+				return
+			}
+		}
+	}
+}
+
+// The class is registered with [nf.RegisterClass] on import.
+var _ = nf.MustRegisterClass(Class, glyphSet{})
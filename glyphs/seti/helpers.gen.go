@@ -8,218 +8,422 @@ package seti
 
 import (
 	"iter"
-	"maps"
+	"slices"
 	"strings"
 
 	"github.com/lrstanley/go-nf"
 )
 
-var (
-	allGlyphs = map[string]nf.Glyph{
-		"apple":              Apple,
-		"argdown":            Argdown,
-		"asm":                Asm,
-		"audio":              Audio,
-		"babel":              Babel,
-		"bazel":              Bazel,
-		"bicep":              Bicep,
-		"bower":              Bower,
-		"bsl":                Bsl,
-		"c":                  C,
-		"c_sharp":            CSharp,
-		"cake":               Cake,
-		"cake_php":           CakePhp,
-		"checkbox":           Checkbox,
-		"checkbox_unchecked": CheckboxUnchecked,
-		"cjsx":               Cjsx,
-		"clock":              Clock,
-		"clojure":            Clojure,
-		"code_climate":       CodeClimate,
-		"code_search":        CodeSearch,
-		"coffee":             Coffee,
-		"coldfusion":         Coldfusion,
-		"config":             Config,
-		"cpp":                Cpp,
-		"crystal":            Crystal,
-		"crystal_embedded":   CrystalEmbedded,
-		"css":                Css,
-		"csv":                Csv,
-		"cu":                 Cu,
-		"d":                  D,
-		"dart":               Dart,
-		"db":                 Db,
-		"default":            Default,
-		"deprecation_cop":    DeprecationCop,
-		"docker":             Docker,
-		"editorconfig":       Editorconfig,
-		"ejs":                Ejs,
-		"elixir":             Elixir,
-		"elixir_script":      ElixirScript,
-		"elm":                Elm,
-		"error":              Error,
-		"eslint":             Eslint,
-		"ethereum":           Ethereum,
-		"f_sharp":            FSharp,
-		"favicon":            Favicon,
-		"firebase":           Firebase,
-		"firefox":            Firefox,
-		"folder":             Folder,
-		"font":               Font,
-		"git":                Git,
-		"git_folder":         GitFolder,
-		"git_ignore":         GitIgnore,
-		"github":             Github,
-		"gitlab":             Gitlab,
-		"go":                 Go,
-		"go2":                Go2,
-		"godot":              Godot,
-		"gradle":             Gradle,
-		"grails":             Grails,
-		"graphql":            Graphql,
-		"grunt":              Grunt,
-		"gulp":               Gulp,
-		"hacklang":           Hacklang,
-		"haml":               Haml,
-		"happenings":         Happenings,
-		"haskell":            Haskell,
-		"haxe":               Haxe,
-		"heroku":             Heroku,
-		"hex":                Hex,
-		"home":               Home,
-		"html":               Html,
-		"ignored":            Ignored,
-		"illustrator":        Illustrator,
-		"image":              Image,
-		"info":               Info,
-		"ionic":              Ionic,
-		"jade":               Jade,
-		"java":               Java,
-		"javascript":         Javascript,
-		"jenkins":            Jenkins,
-		"jinja":              Jinja,
-		"json":               Json,
-		"julia":              Julia,
-		"karma":              Karma,
-		"kotlin":             Kotlin,
-		"less":               Less,
-		"license":            License,
-		"liquid":             Liquid,
-		"livescript":         Livescript,
-		"lock":               Lock,
-		"lua":                Lua,
-		"makefile":           Makefile,
-		"markdown":           Markdown,
-		"maven":              Maven,
-		"mdo":                Mdo,
-		"mustache":           Mustache,
-		"new_file":           NewFile,
-		"nim":                Nim,
-		"notebook":           Notebook,
-		"npm":                Npm,
-		"npm_ignored":        NpmIgnored,
-		"nunjucks":           Nunjucks,
-		"ocaml":              Ocaml,
-		"odata":              Odata,
-		"pddl":               Pddl,
-		"pdf":                Pdf,
-		"perl":               Perl,
-		"photoshop":          Photoshop,
-		"php":                Php,
-		"pipeline":           Pipeline,
-		"plan":               Plan,
-		"platformio":         Platformio,
-		"play_arrow":         PlayArrow,
-		"powershell":         Powershell,
-		"prisma":             Prisma,
-		"project":            Project,
-		"prolog":             Prolog,
-		"pug":                Pug,
-		"puppet":             Puppet,
-		"purescript":         Purescript,
-		"python":             Python,
-		"r":                  R,
-		"rails":              Rails,
-		"react":              React,
-		"reasonml":           Reasonml,
-		"rescript":           Rescript,
-		"rollup":             Rollup,
-		"ruby":               Ruby,
-		"rust":               Rust,
-		"salesforce":         Salesforce,
-		"sass":               Sass,
-		"sbt":                Sbt,
-		"scala":              Scala,
-		"search":             Search,
-		"settings":           Settings,
-		"shell":              Shell,
-		"slim":               Slim,
-		"smarty":             Smarty,
-		"spring":             Spring,
-		"stylelint":          Stylelint,
-		"stylus":             Stylus,
-		"sublime":            Sublime,
-		"svelte":             Svelte,
-		"svg":                Svg,
-		"swift":              Swift,
-		"terraform":          Terraform,
-		"tex":                Tex,
-		"text":               Text,
-		"time_cop":           TimeCop,
-		"todo":               Todo,
-		"tsconfig":           Tsconfig,
-		"twig":               Twig,
-		"typescript":         Typescript,
-		"vala":               Vala,
-		"video":              Video,
-		"vue":                Vue,
-		"wasm":               Wasm,
-		"wat":                Wat,
-		"webpack":            Webpack,
-		"wgt":                Wgt,
-		"word":               Word,
-		"xls":                Xls,
-		"xml":                Xml,
-		"yarn":               Yarn,
-		"yml":                Yml,
-		"zig":                Zig,
-		"zip":                Zip,
-	}
-)
+// glyphIDs are the IDs of all glyphs in the class, sorted, such that they can be
+// binary searched. The glyph for each ID is at the same index in glyphValues.
+//
+// Arrays of constants are laid out statically by the compiler, unlike maps,
+// which are built at init time.
+var glyphIDs = [...]string{
+	"apple",
+	"argdown",
+	"asm",
+	"audio",
+	"babel",
+	"bazel",
+	"bicep",
+	"bower",
+	"bsl",
+	"c",
+	"c_sharp",
+	"cake",
+	"cake_php",
+	"checkbox",
+	"checkbox_unchecked",
+	"cjsx",
+	"clock",
+	"clojure",
+	"code_climate",
+	"code_search",
+	"coffee",
+	"coldfusion",
+	"config",
+	"cpp",
+	"crystal",
+	"crystal_embedded",
+	"css",
+	"csv",
+	"cu",
+	"d",
+	"dart",
+	"db",
+	"default",
+	"deprecation_cop",
+	"docker",
+	"editorconfig",
+	"ejs",
+	"elixir",
+	"elixir_script",
+	"elm",
+	"error",
+	"eslint",
+	"ethereum",
+	"f_sharp",
+	"favicon",
+	"firebase",
+	"firefox",
+	"folder",
+	"font",
+	"git",
+	"git_folder",
+	"git_ignore",
+	"github",
+	"gitlab",
+	"go",
+	"go2",
+	"godot",
+	"gradle",
+	"grails",
+	"graphql",
+	"grunt",
+	"gulp",
+	"hacklang",
+	"haml",
+	"happenings",
+	"haskell",
+	"haxe",
+	"heroku",
+	"hex",
+	"home",
+	"html",
+	"ignored",
+	"illustrator",
+	"image",
+	"info",
+	"ionic",
+	"jade",
+	"java",
+	"javascript",
+	"jenkins",
+	"jinja",
+	"json",
+	"julia",
+	"karma",
+	"kotlin",
+	"less",
+	"license",
+	"liquid",
+	"livescript",
+	"lock",
+	"lua",
+	"makefile",
+	"markdown",
+	"maven",
+	"mdo",
+	"mustache",
+	"new_file",
+	"nim",
+	"notebook",
+	"npm",
+	"npm_ignored",
+	"nunjucks",
+	"ocaml",
+	"odata",
+	"pddl",
+	"pdf",
+	"perl",
+	"photoshop",
+	"php",
+	"pipeline",
+	"plan",
+	"platformio",
+	"play_arrow",
+	"powershell",
+	"prisma",
+	"project",
+	"prolog",
+	"pug",
+	"puppet",
+	"purescript",
+	"python",
+	"r",
+	"rails",
+	"react",
+	"reasonml",
+	"rescript",
+	"rollup",
+	"ruby",
+	"rust",
+	"salesforce",
+	"sass",
+	"sbt",
+	"scala",
+	"search",
+	"settings",
+	"shell",
+	"slim",
+	"smarty",
+	"spring",
+	"stylelint",
+	"stylus",
+	"sublime",
+	"svelte",
+	"svg",
+	"swift",
+	"terraform",
+	"tex",
+	"text",
+	"time_cop",
+	"todo",
+	"tsconfig",
+	"twig",
+	"typescript",
+	"vala",
+	"video",
+	"vue",
+	"wasm",
+	"wat",
+	"webpack",
+	"wgt",
+	"word",
+	"xls",
+	"xml",
+	"yarn",
+	"yml",
+	"zig",
+	"zip",
+}
+
+// glyphValues are the glyphs in the class, in the same order as glyphIDs.
+var glyphValues = [len(glyphIDs)]nf.Glyph{
+	Apple,
+	Argdown,
+	Asm,
+	Audio,
+	Babel,
+	Bazel,
+	Bicep,
+	Bower,
+	Bsl,
+	C,
+	CSharp,
+	Cake,
+	CakePhp,
+	Checkbox,
+	CheckboxUnchecked,
+	Cjsx,
+	Clock,
+	Clojure,
+	CodeClimate,
+	CodeSearch,
+	Coffee,
+	Coldfusion,
+	Config,
+	Cpp,
+	Crystal,
+	CrystalEmbedded,
+	Css,
+	Csv,
+	Cu,
+	D,
+	Dart,
+	Db,
+	Default,
+	DeprecationCop,
+	Docker,
+	Editorconfig,
+	Ejs,
+	Elixir,
+	ElixirScript,
+	Elm,
+	Error,
+	Eslint,
+	Ethereum,
+	FSharp,
+	Favicon,
+	Firebase,
+	Firefox,
+	Folder,
+	Font,
+	Git,
+	GitFolder,
+	GitIgnore,
+	Github,
+	Gitlab,
+	Go,
+	Go2,
+	Godot,
+	Gradle,
+	Grails,
+	Graphql,
+	Grunt,
+	Gulp,
+	Hacklang,
+	Haml,
+	Happenings,
+	Haskell,
+	Haxe,
+	Heroku,
+	Hex,
+	Home,
+	Html,
+	Ignored,
+	Illustrator,
+	Image,
+	Info,
+	Ionic,
+	Jade,
+	Java,
+	Javascript,
+	Jenkins,
+	Jinja,
+	Json,
+	Julia,
+	Karma,
+	Kotlin,
+	Less,
+	License,
+	Liquid,
+	Livescript,
+	Lock,
+	Lua,
+	Makefile,
+	Markdown,
+	Maven,
+	Mdo,
+	Mustache,
+	NewFile,
+	Nim,
+	Notebook,
+	Npm,
+	NpmIgnored,
+	Nunjucks,
+	Ocaml,
+	Odata,
+	Pddl,
+	Pdf,
+	Perl,
+	Photoshop,
+	Php,
+	Pipeline,
+	Plan,
+	Platformio,
+	PlayArrow,
+	Powershell,
+	Prisma,
+	Project,
+	Prolog,
+	Pug,
+	Puppet,
+	Purescript,
+	Python,
+	R,
+	Rails,
+	React,
+	Reasonml,
+	Rescript,
+	Rollup,
+	Ruby,
+	Rust,
+	Salesforce,
+	Sass,
+	Sbt,
+	Scala,
+	Search,
+	Settings,
+	Shell,
+	Slim,
+	Smarty,
+	Spring,
+	Stylelint,
+	Stylus,
+	Sublime,
+	Svelte,
+	Svg,
+	Swift,
+	Terraform,
+	Tex,
+	Text,
+	TimeCop,
+	Todo,
+	Tsconfig,
+	Twig,
+	Typescript,
+	Vala,
+	Video,
+	Vue,
+	Wasm,
+	Wat,
+	Webpack,
+	Wgt,
+	Word,
+	Xls,
+	Xml,
+	Yarn,
+	Yml,
+	Zig,
+	Zip,
+}
 
 // AllGlyphs returns an iterator over all the glyphs in the seti class,
-// returned in no particular order.
+// sorted by ID.
 func AllGlyphs() iter.Seq[nf.Glyph] {
-	return maps.Values(allGlyphs)
+	return slices.Values(glyphValues[:])
 }
 
 // ByID finds a glyph by its short or full ID within the class, or an empty string
-// if the glyph is not found.
+// if the glyph is not found. Lookups do not allocate.
 func ByID(id string) nf.Glyph {
-	if glyph, ok := allGlyphs[id]; ok {
+	if glyph, ok := lookup(id); ok {
 		return glyph
 	}
 	if _, stripped, ok := strings.Cut(id, string(Class)+"-"); ok {
-		if glyph, gok := allGlyphs[stripped]; gok {
+		if glyph, gok := lookup(stripped); gok {
 			return glyph
 		}
 	}
 	return ""
 }
 
+// lookup binary searches for a glyph by its short ID.
+func lookup(id string) (nf.Glyph, bool) {
+	if i, ok := slices.BinarySearch(glyphIDs[:], id); ok {
+		return glyphValues[i], true
+	}
+	return "", false
+}
+
 // AllGlyphIDs returns an iterator over all the IDs of the glyphs in the class,
-// returned in no particular order.
+// sorted.
 func AllGlyphIDs() iter.Seq[string] {
-	return maps.Keys(allGlyphs)
+	return slices.Values(glyphIDs[:])
 }
 
 // AllGlyphFullIDs returns an iterator over all the full IDs of the glyphs in
-// the class, returned in no particular order.
+// the class, sorted.
 func AllGlyphFullIDs() iter.Seq[string] {
 	return func(yield func(string) bool) {
-		for id := range allGlyphs {
+		for _, id := range glyphIDs {
 			if !yield(string(Class) + "-" + id) {
 				return
 			}
 		}
 	}
 }
+
+// glyphSet implements [nf.GlyphSet] for the class.
+type glyphSet struct{}
+
+// Lookup returns the glyph with the given short ID, and whether it was found.
+func (glyphSet) Lookup(id string) (nf.Glyph, bool) {
+	return lookup(id)
+}
+
+// All returns an iterator over all short IDs and their glyphs, sorted by ID.
+func (glyphSet) All() iter.Seq2[string, nf.Glyph] {
+	return func(yield func(string, nf.Glyph) bool) {
+		for i, id := range glyphIDs {
+			if !yield(id, glyphValues[i]) {
+				return
+			}
+		}
+	}
+}
+
+// The class is registered with [nf.RegisterClass] on import.
+var _ = nf.MustRegisterClass(Class, glyphSet{})
@@ -8,279 +8,544 @@ package weather
 
 import (
 	"iter"
-	"maps"
+	"slices"
 	"strings"
 
 	"github.com/lrstanley/go-nf"
 )
 
-var (
-	allGlyphs = map[string]nf.Glyph{
-		"alien":                       Alien,
-		"aliens":                      Aliens,
-		"barometer":                   Barometer,
-		"celsius":                     Celsius,
-		"cloud":                       Cloud,
-		"cloud_down":                  CloudDown,
-		"cloud_refresh":               CloudRefresh,
-		"cloud_up":                    CloudUp,
-		"cloudy":                      Cloudy,
-		"cloudy_gusts":                CloudyGusts,
-		"cloudy_windy":                CloudyWindy,
-		"day_cloudy":                  DayCloudy,
-		"day_cloudy_gusts":            DayCloudyGusts,
-		"day_cloudy_high":             DayCloudyHigh,
-		"day_cloudy_windy":            DayCloudyWindy,
-		"day_fog":                     DayFog,
-		"day_hail":                    DayHail,
-		"day_haze":                    DayHaze,
-		"day_light_wind":              DayLightWind,
-		"day_lightning":               DayLightning,
-		"day_rain":                    DayRain,
-		"day_rain_mix":                DayRainMix,
-		"day_rain_wind":               DayRainWind,
-		"day_showers":                 DayShowers,
-		"day_sleet":                   DaySleet,
-		"day_sleet_storm":             DaySleetStorm,
-		"day_snow":                    DaySnow,
-		"day_snow_thunderstorm":       DaySnowThunderstorm,
-		"day_snow_wind":               DaySnowWind,
-		"day_sprinkle":                DaySprinkle,
-		"day_storm_showers":           DayStormShowers,
-		"day_sunny":                   DaySunny,
-		"day_sunny_overcast":          DaySunnyOvercast,
-		"day_thunderstorm":            DayThunderstorm,
-		"day_windy":                   DayWindy,
-		"degrees":                     Degrees,
-		"direction_down":              DirectionDown,
-		"direction_down_left":         DirectionDownLeft,
-		"direction_down_right":        DirectionDownRight,
-		"direction_left":              DirectionLeft,
-		"direction_right":             DirectionRight,
-		"direction_up":                DirectionUp,
-		"direction_up_left":           DirectionUpLeft,
-		"direction_up_right":          DirectionUpRight,
-		"dust":                        Dust,
-		"earthquake":                  Earthquake,
-		"fahrenheit":                  Fahrenheit,
-		"fire":                        Fire,
-		"flood":                       Flood,
-		"fog":                         Fog,
-		"gale_warning":                GaleWarning,
-		"hail":                        Hail,
-		"horizon":                     Horizon,
-		"horizon_alt":                 HorizonAlt,
-		"hot":                         Hot,
-		"humidity":                    Humidity,
-		"hurricane":                   Hurricane,
-		"hurricane_warning":           HurricaneWarning,
-		"lightning":                   Lightning,
-		"lunar_eclipse":               LunarEclipse,
-		"meteor":                      Meteor,
-		"moon_alt_first_quarter":      MoonAltFirstQuarter,
-		"moon_alt_full":               MoonAltFull,
-		"moon_alt_new":                MoonAltNew,
-		"moon_alt_third_quarter":      MoonAltThirdQuarter,
-		"moon_alt_waning_crescent_1":  MoonAltWaningCrescent1,
-		"moon_alt_waning_crescent_2":  MoonAltWaningCrescent2,
-		"moon_alt_waning_crescent_3":  MoonAltWaningCrescent3,
-		"moon_alt_waning_crescent_4":  MoonAltWaningCrescent4,
-		"moon_alt_waning_crescent_5":  MoonAltWaningCrescent5,
-		"moon_alt_waning_crescent_6":  MoonAltWaningCrescent6,
-		"moon_alt_waning_gibbous_1":   MoonAltWaningGibbous1,
-		"moon_alt_waning_gibbous_2":   MoonAltWaningGibbous2,
-		"moon_alt_waning_gibbous_3":   MoonAltWaningGibbous3,
-		"moon_alt_waning_gibbous_4":   MoonAltWaningGibbous4,
-		"moon_alt_waning_gibbous_5":   MoonAltWaningGibbous5,
-		"moon_alt_waning_gibbous_6":   MoonAltWaningGibbous6,
-		"moon_alt_waxing_crescent_1":  MoonAltWaxingCrescent1,
-		"moon_alt_waxing_crescent_2":  MoonAltWaxingCrescent2,
-		"moon_alt_waxing_crescent_3":  MoonAltWaxingCrescent3,
-		"moon_alt_waxing_crescent_4":  MoonAltWaxingCrescent4,
-		"moon_alt_waxing_crescent_5":  MoonAltWaxingCrescent5,
-		"moon_alt_waxing_crescent_6":  MoonAltWaxingCrescent6,
-		"moon_alt_waxing_gibbous_1":   MoonAltWaxingGibbous1,
-		"moon_alt_waxing_gibbous_2":   MoonAltWaxingGibbous2,
-		"moon_alt_waxing_gibbous_3":   MoonAltWaxingGibbous3,
-		"moon_alt_waxing_gibbous_4":   MoonAltWaxingGibbous4,
-		"moon_alt_waxing_gibbous_5":   MoonAltWaxingGibbous5,
-		"moon_alt_waxing_gibbous_6":   MoonAltWaxingGibbous6,
-		"moon_first_quarter":          MoonFirstQuarter,
-		"moon_full":                   MoonFull,
-		"moon_new":                    MoonNew,
-		"moon_third_quarter":          MoonThirdQuarter,
-		"moon_waning_crescent_1":      MoonWaningCrescent1,
-		"moon_waning_crescent_2":      MoonWaningCrescent2,
-		"moon_waning_crescent_3":      MoonWaningCrescent3,
-		"moon_waning_crescent_4":      MoonWaningCrescent4,
-		"moon_waning_crescent_5":      MoonWaningCrescent5,
-		"moon_waning_crescent_6":      MoonWaningCrescent6,
-		"moon_waning_gibbous_1":       MoonWaningGibbous1,
-		"moon_waning_gibbous_2":       MoonWaningGibbous2,
-		"moon_waning_gibbous_3":       MoonWaningGibbous3,
-		"moon_waning_gibbous_4":       MoonWaningGibbous4,
-		"moon_waning_gibbous_5":       MoonWaningGibbous5,
-		"moon_waning_gibbous_6":       MoonWaningGibbous6,
-		"moon_waxing_crescent_1":      MoonWaxingCrescent1,
-		"moon_waxing_crescent_2":      MoonWaxingCrescent2,
-		"moon_waxing_crescent_3":      MoonWaxingCrescent3,
-		"moon_waxing_crescent_4":      MoonWaxingCrescent4,
-		"moon_waxing_crescent_5":      MoonWaxingCrescent5,
-		"moon_waxing_crescent_6":      MoonWaxingCrescent6,
-		"moon_waxing_gibbous_1":       MoonWaxingGibbous1,
-		"moon_waxing_gibbous_2":       MoonWaxingGibbous2,
-		"moon_waxing_gibbous_3":       MoonWaxingGibbous3,
-		"moon_waxing_gibbous_4":       MoonWaxingGibbous4,
-		"moon_waxing_gibbous_5":       MoonWaxingGibbous5,
-		"moon_waxing_gibbous_6":       MoonWaxingGibbous6,
-		"moonrise":                    Moonrise,
-		"moonset":                     Moonset,
-		"na":                          Na,
-		"night_alt_cloudy":            NightAltCloudy,
-		"night_alt_cloudy_gusts":      NightAltCloudyGusts,
-		"night_alt_cloudy_high":       NightAltCloudyHigh,
-		"night_alt_cloudy_windy":      NightAltCloudyWindy,
-		"night_alt_hail":              NightAltHail,
-		"night_alt_lightning":         NightAltLightning,
-		"night_alt_partly_cloudy":     NightAltPartlyCloudy,
-		"night_alt_rain":              NightAltRain,
-		"night_alt_rain_mix":          NightAltRainMix,
-		"night_alt_rain_wind":         NightAltRainWind,
-		"night_alt_showers":           NightAltShowers,
-		"night_alt_sleet":             NightAltSleet,
-		"night_alt_sleet_storm":       NightAltSleetStorm,
-		"night_alt_snow":              NightAltSnow,
-		"night_alt_snow_thunderstorm": NightAltSnowThunderstorm,
-		"night_alt_snow_wind":         NightAltSnowWind,
-		"night_alt_sprinkle":          NightAltSprinkle,
-		"night_alt_storm_showers":     NightAltStormShowers,
-		"night_alt_thunderstorm":      NightAltThunderstorm,
-		"night_clear":                 NightClear,
-		"night_cloudy":                NightCloudy,
-		"night_cloudy_gusts":          NightCloudyGusts,
-		"night_cloudy_high":           NightCloudyHigh,
-		"night_cloudy_windy":          NightCloudyWindy,
-		"night_fog":                   NightFog,
-		"night_hail":                  NightHail,
-		"night_lightning":             NightLightning,
-		"night_partly_cloudy":         NightPartlyCloudy,
-		"night_rain":                  NightRain,
-		"night_rain_mix":              NightRainMix,
-		"night_rain_wind":             NightRainWind,
-		"night_showers":               NightShowers,
-		"night_sleet":                 NightSleet,
-		"night_sleet_storm":           NightSleetStorm,
-		"night_snow":                  NightSnow,
-		"night_snow_thunderstorm":     NightSnowThunderstorm,
-		"night_snow_wind":             NightSnowWind,
-		"night_sprinkle":              NightSprinkle,
-		"night_storm_showers":         NightStormShowers,
-		"night_thunderstorm":          NightThunderstorm,
-		"rain":                        Rain,
-		"rain_mix":                    RainMix,
-		"rain_wind":                   RainWind,
-		"raindrop":                    Raindrop,
-		"raindrops":                   Raindrops,
-		"refresh":                     Refresh,
-		"refresh_alt":                 RefreshAlt,
-		"sandstorm":                   Sandstorm,
-		"showers":                     Showers,
-		"sleet":                       Sleet,
-		"small_craft_advisory":        SmallCraftAdvisory,
-		"smog":                        Smog,
-		"smoke":                       Smoke,
-		"snow":                        Snow,
-		"snow_wind":                   SnowWind,
-		"snowflake_cold":              SnowflakeCold,
-		"solar_eclipse":               SolarEclipse,
-		"sprinkle":                    Sprinkle,
-		"stars":                       Stars,
-		"storm_showers":               StormShowers,
-		"storm_warning":               StormWarning,
-		"strong_wind":                 StrongWind,
-		"sunrise":                     Sunrise,
-		"sunset":                      Sunset,
-		"thermometer":                 Thermometer,
-		"thermometer_exterior":        ThermometerExterior,
-		"thermometer_internal":        ThermometerInternal,
-		"thunderstorm":                Thunderstorm,
-		"time_1":                      Time1,
-		"time_10":                     Time10,
-		"time_11":                     Time11,
-		"time_12":                     Time12,
-		"time_2":                      Time2,
-		"time_3":                      Time3,
-		"time_4":                      Time4,
-		"time_5":                      Time5,
-		"time_6":                      Time6,
-		"time_7":                      Time7,
-		"time_8":                      Time8,
-		"time_9":                      Time9,
-		"tornado":                     Tornado,
-		"train":                       Train,
-		"tsunami":                     Tsunami,
-		"umbrella":                    Umbrella,
-		"volcano":                     Volcano,
-		"wind_beaufort_0":             WindBeaufort0,
-		"wind_beaufort_1":             WindBeaufort1,
-		"wind_beaufort_10":            WindBeaufort10,
-		"wind_beaufort_11":            WindBeaufort11,
-		"wind_beaufort_12":            WindBeaufort12,
-		"wind_beaufort_2":             WindBeaufort2,
-		"wind_beaufort_3":             WindBeaufort3,
-		"wind_beaufort_4":             WindBeaufort4,
-		"wind_beaufort_5":             WindBeaufort5,
-		"wind_beaufort_6":             WindBeaufort6,
-		"wind_beaufort_7":             WindBeaufort7,
-		"wind_beaufort_8":             WindBeaufort8,
-		"wind_beaufort_9":             WindBeaufort9,
-		"wind_direction":              WindDirection,
-		"wind_east":                   WindEast,
-		"wind_north":                  WindNorth,
-		"wind_north_east":             WindNorthEast,
-		"wind_north_west":             WindNorthWest,
-		"wind_south":                  WindSouth,
-		"wind_south_east":             WindSouthEast,
-		"wind_south_west":             WindSouthWest,
-		"wind_west":                   WindWest,
-		"windy":                       Windy,
-	}
-)
+// glyphIDs are the IDs of all glyphs in the class, sorted, such that they can be
+// binary searched. The glyph for each ID is at the same index in glyphValues.
+//
+// Arrays of constants are laid out statically by the compiler, unlike maps,
+// which are built at init time.
+var glyphIDs = [...]string{
+	"alien",
+	"aliens",
+	"barometer",
+	"celsius",
+	"cloud",
+	"cloud_down",
+	"cloud_refresh",
+	"cloud_up",
+	"cloudy",
+	"cloudy_gusts",
+	"cloudy_windy",
+	"day_cloudy",
+	"day_cloudy_gusts",
+	"day_cloudy_high",
+	"day_cloudy_windy",
+	"day_fog",
+	"day_hail",
+	"day_haze",
+	"day_light_wind",
+	"day_lightning",
+	"day_rain",
+	"day_rain_mix",
+	"day_rain_wind",
+	"day_showers",
+	"day_sleet",
+	"day_sleet_storm",
+	"day_snow",
+	"day_snow_thunderstorm",
+	"day_snow_wind",
+	"day_sprinkle",
+	"day_storm_showers",
+	"day_sunny",
+	"day_sunny_overcast",
+	"day_thunderstorm",
+	"day_windy",
+	"degrees",
+	"direction_down",
+	"direction_down_left",
+	"direction_down_right",
+	"direction_left",
+	"direction_right",
+	"direction_up",
+	"direction_up_left",
+	"direction_up_right",
+	"dust",
+	"earthquake",
+	"fahrenheit",
+	"fire",
+	"flood",
+	"fog",
+	"gale_warning",
+	"hail",
+	"horizon",
+	"horizon_alt",
+	"hot",
+	"humidity",
+	"hurricane",
+	"hurricane_warning",
+	"lightning",
+	"lunar_eclipse",
+	"meteor",
+	"moon_alt_first_quarter",
+	"moon_alt_full",
+	"moon_alt_new",
+	"moon_alt_third_quarter",
+	"moon_alt_waning_crescent_1",
+	"moon_alt_waning_crescent_2",
+	"moon_alt_waning_crescent_3",
+	"moon_alt_waning_crescent_4",
+	"moon_alt_waning_crescent_5",
+	"moon_alt_waning_crescent_6",
+	"moon_alt_waning_gibbous_1",
+	"moon_alt_waning_gibbous_2",
+	"moon_alt_waning_gibbous_3",
+	"moon_alt_waning_gibbous_4",
+	"moon_alt_waning_gibbous_5",
+	"moon_alt_waning_gibbous_6",
+	"moon_alt_waxing_crescent_1",
+	"moon_alt_waxing_crescent_2",
+	"moon_alt_waxing_crescent_3",
+	"moon_alt_waxing_crescent_4",
+	"moon_alt_waxing_crescent_5",
+	"moon_alt_waxing_crescent_6",
+	"moon_alt_waxing_gibbous_1",
+	"moon_alt_waxing_gibbous_2",
+	"moon_alt_waxing_gibbous_3",
+	"moon_alt_waxing_gibbous_4",
+	"moon_alt_waxing_gibbous_5",
+	"moon_alt_waxing_gibbous_6",
+	"moon_first_quarter",
+	"moon_full",
+	"moon_new",
+	"moon_third_quarter",
+	"moon_waning_crescent_1",
+	"moon_waning_crescent_2",
+	"moon_waning_crescent_3",
+	"moon_waning_crescent_4",
+	"moon_waning_crescent_5",
+	"moon_waning_crescent_6",
+	"moon_waning_gibbous_1",
+	"moon_waning_gibbous_2",
+	"moon_waning_gibbous_3",
+	"moon_waning_gibbous_4",
+	"moon_waning_gibbous_5",
+	"moon_waning_gibbous_6",
+	"moon_waxing_crescent_1",
+	"moon_waxing_crescent_2",
+	"moon_waxing_crescent_3",
+	"moon_waxing_crescent_4",
+	"moon_waxing_crescent_5",
+	"moon_waxing_crescent_6",
+	"moon_waxing_gibbous_1",
+	"moon_waxing_gibbous_2",
+	"moon_waxing_gibbous_3",
+	"moon_waxing_gibbous_4",
+	"moon_waxing_gibbous_5",
+	"moon_waxing_gibbous_6",
+	"moonrise",
+	"moonset",
+	"na",
+	"night_alt_cloudy",
+	"night_alt_cloudy_gusts",
+	"night_alt_cloudy_high",
+	"night_alt_cloudy_windy",
+	"night_alt_hail",
+	"night_alt_lightning",
+	"night_alt_partly_cloudy",
+	"night_alt_rain",
+	"night_alt_rain_mix",
+	"night_alt_rain_wind",
+	"night_alt_showers",
+	"night_alt_sleet",
+	"night_alt_sleet_storm",
+	"night_alt_snow",
+	"night_alt_snow_thunderstorm",
+	"night_alt_snow_wind",
+	"night_alt_sprinkle",
+	"night_alt_storm_showers",
+	"night_alt_thunderstorm",
+	"night_clear",
+	"night_cloudy",
+	"night_cloudy_gusts",
+	"night_cloudy_high",
+	"night_cloudy_windy",
+	"night_fog",
+	"night_hail",
+	"night_lightning",
+	"night_partly_cloudy",
+	"night_rain",
+	"night_rain_mix",
+	"night_rain_wind",
+	"night_showers",
+	"night_sleet",
+	"night_sleet_storm",
+	"night_snow",
+	"night_snow_thunderstorm",
+	"night_snow_wind",
+	"night_sprinkle",
+	"night_storm_showers",
+	"night_thunderstorm",
+	"rain",
+	"rain_mix",
+	"rain_wind",
+	"raindrop",
+	"raindrops",
+	"refresh",
+	"refresh_alt",
+	"sandstorm",
+	"showers",
+	"sleet",
+	"small_craft_advisory",
+	"smog",
+	"smoke",
+	"snow",
+	"snow_wind",
+	"snowflake_cold",
+	"solar_eclipse",
+	"sprinkle",
+	"stars",
+	"storm_showers",
+	"storm_warning",
+	"strong_wind",
+	"sunrise",
+	"sunset",
+	"thermometer",
+	"thermometer_exterior",
+	"thermometer_internal",
+	"thunderstorm",
+	"time_1",
+	"time_10",
+	"time_11",
+	"time_12",
+	"time_2",
+	"time_3",
+	"time_4",
+	"time_5",
+	"time_6",
+	"time_7",
+	"time_8",
+	"time_9",
+	"tornado",
+	"train",
+	"tsunami",
+	"umbrella",
+	"volcano",
+	"wind_beaufort_0",
+	"wind_beaufort_1",
+	"wind_beaufort_10",
+	"wind_beaufort_11",
+	"wind_beaufort_12",
+	"wind_beaufort_2",
+	"wind_beaufort_3",
+	"wind_beaufort_4",
+	"wind_beaufort_5",
+	"wind_beaufort_6",
+	"wind_beaufort_7",
+	"wind_beaufort_8",
+	"wind_beaufort_9",
+	"wind_direction",
+	"wind_east",
+	"wind_north",
+	"wind_north_east",
+	"wind_north_west",
+	"wind_south",
+	"wind_south_east",
+	"wind_south_west",
+	"wind_west",
+	"windy",
+}
+
+// glyphValues are the glyphs in the class, in the same order as glyphIDs.
+var glyphValues = [len(glyphIDs)]nf.Glyph{
+	Alien,
+	Aliens,
+	Barometer,
+	Celsius,
+	Cloud,
+	CloudDown,
+	CloudRefresh,
+	CloudUp,
+	Cloudy,
+	CloudyGusts,
+	CloudyWindy,
+	DayCloudy,
+	DayCloudyGusts,
+	DayCloudyHigh,
+	DayCloudyWindy,
+	DayFog,
+	DayHail,
+	DayHaze,
+	DayLightWind,
+	DayLightning,
+	DayRain,
+	DayRainMix,
+	DayRainWind,
+	DayShowers,
+	DaySleet,
+	DaySleetStorm,
+	DaySnow,
+	DaySnowThunderstorm,
+	DaySnowWind,
+	DaySprinkle,
+	DayStormShowers,
+	DaySunny,
+	DaySunnyOvercast,
+	DayThunderstorm,
+	DayWindy,
+	Degrees,
+	DirectionDown,
+	DirectionDownLeft,
+	DirectionDownRight,
+	DirectionLeft,
+	DirectionRight,
+	DirectionUp,
+	DirectionUpLeft,
+	DirectionUpRight,
+	Dust,
+	Earthquake,
+	Fahrenheit,
+	Fire,
+	Flood,
+	Fog,
+	GaleWarning,
+	Hail,
+	Horizon,
+	HorizonAlt,
+	Hot,
+	Humidity,
+	Hurricane,
+	HurricaneWarning,
+	Lightning,
+	LunarEclipse,
+	Meteor,
+	MoonAltFirstQuarter,
+	MoonAltFull,
+	MoonAltNew,
+	MoonAltThirdQuarter,
+	MoonAltWaningCrescent1,
+	MoonAltWaningCrescent2,
+	MoonAltWaningCrescent3,
+	MoonAltWaningCrescent4,
+	MoonAltWaningCrescent5,
+	MoonAltWaningCrescent6,
+	MoonAltWaningGibbous1,
+	MoonAltWaningGibbous2,
+	MoonAltWaningGibbous3,
+	MoonAltWaningGibbous4,
+	MoonAltWaningGibbous5,
+	MoonAltWaningGibbous6,
+	MoonAltWaxingCrescent1,
+	MoonAltWaxingCrescent2,
+	MoonAltWaxingCrescent3,
+	MoonAltWaxingCrescent4,
+	MoonAltWaxingCrescent5,
+	MoonAltWaxingCrescent6,
+	MoonAltWaxingGibbous1,
+	MoonAltWaxingGibbous2,
+	MoonAltWaxingGibbous3,
+	MoonAltWaxingGibbous4,
+	MoonAltWaxingGibbous5,
+	MoonAltWaxingGibbous6,
+	MoonFirstQuarter,
+	MoonFull,
+	MoonNew,
+	MoonThirdQuarter,
+	MoonWaningCrescent1,
+	MoonWaningCrescent2,
+	MoonWaningCrescent3,
+	MoonWaningCrescent4,
+	MoonWaningCrescent5,
+	MoonWaningCrescent6,
+	MoonWaningGibbous1,
+	MoonWaningGibbous2,
+	MoonWaningGibbous3,
+	MoonWaningGibbous4,
+	MoonWaningGibbous5,
+	MoonWaningGibbous6,
+	MoonWaxingCrescent1,
+	MoonWaxingCrescent2,
+	MoonWaxingCrescent3,
+	MoonWaxingCrescent4,
+	MoonWaxingCrescent5,
+	MoonWaxingCrescent6,
+	MoonWaxingGibbous1,
+	MoonWaxingGibbous2,
+	MoonWaxingGibbous3,
+	MoonWaxingGibbous4,
+	MoonWaxingGibbous5,
+	MoonWaxingGibbous6,
+	Moonrise,
+	Moonset,
+	Na,
+	NightAltCloudy,
+	NightAltCloudyGusts,
+	NightAltCloudyHigh,
+	NightAltCloudyWindy,
+	NightAltHail,
+	NightAltLightning,
+	NightAltPartlyCloudy,
+	NightAltRain,
+	NightAltRainMix,
+	NightAltRainWind,
+	NightAltShowers,
+	NightAltSleet,
+	NightAltSleetStorm,
+	NightAltSnow,
+	NightAltSnowThunderstorm,
+	NightAltSnowWind,
+	NightAltSprinkle,
+	NightAltStormShowers,
+	NightAltThunderstorm,
+	NightClear,
+	NightCloudy,
+	NightCloudyGusts,
+	NightCloudyHigh,
+	NightCloudyWindy,
+	NightFog,
+	NightHail,
+	NightLightning,
+	NightPartlyCloudy,
+	NightRain,
+	NightRainMix,
+	NightRainWind,
+	NightShowers,
+	NightSleet,
+	NightSleetStorm,
+	NightSnow,
+	NightSnowThunderstorm,
+	NightSnowWind,
+	NightSprinkle,
+	NightStormShowers,
+	NightThunderstorm,
+	Rain,
+	RainMix,
+	RainWind,
+	Raindrop,
+	Raindrops,
+	Refresh,
+	RefreshAlt,
+	Sandstorm,
+	Showers,
+	Sleet,
+	SmallCraftAdvisory,
+	Smog,
+	Smoke,
+	Snow,
+	SnowWind,
+	SnowflakeCold,
+	SolarEclipse,
+	Sprinkle,
+	Stars,
+	StormShowers,
+	StormWarning,
+	StrongWind,
+	Sunrise,
+	Sunset,
+	Thermometer,
+	ThermometerExterior,
+	ThermometerInternal,
+	Thunderstorm,
+	Time1,
+	Time10,
+	Time11,
+	Time12,
+	Time2,
+	Time3,
+	Time4,
+	Time5,
+	Time6,
+	Time7,
+	Time8,
+	Time9,
+	Tornado,
+	Train,
+	Tsunami,
+	Umbrella,
+	Volcano,
+	WindBeaufort0,
+	WindBeaufort1,
+	WindBeaufort10,
+	WindBeaufort11,
+	WindBeaufort12,
+	WindBeaufort2,
+	WindBeaufort3,
+	WindBeaufort4,
+	WindBeaufort5,
+	WindBeaufort6,
+	WindBeaufort7,
+	WindBeaufort8,
+	WindBeaufort9,
+	WindDirection,
+	WindEast,
+	WindNorth,
+	WindNorthEast,
+	WindNorthWest,
+	WindSouth,
+	WindSouthEast,
+	WindSouthWest,
+	WindWest,
+	Windy,
+}
 
 // AllGlyphs returns an iterator over all the glyphs in the weather class,
-// returned in no particular order.
+// sorted by ID.
 func AllGlyphs() iter.Seq[nf.Glyph] {
-	return maps.Values(allGlyphs)
+	return slices.Values(glyphValues[:])
 }
 
 // ByID finds a glyph by its short or full ID within the class, or an empty string
-// if the glyph is not found.
+// if the glyph is not found. Lookups do not allocate.
 func ByID(id string) nf.Glyph {
-	if glyph, ok := allGlyphs[id]; ok {
+	if glyph, ok := lookup(id); ok {
 		return glyph
 	}
 	if _, stripped, ok := strings.Cut(id, string(Class)+"-"); ok {
-		if glyph, gok := allGlyphs[stripped]; gok {
+		if glyph, gok := lookup(stripped); gok {
 			return glyph
 		}
 	}
 	return ""
 }
 
+// lookup binary searches for a glyph by its short ID.
+func lookup(id string) (nf.Glyph, bool) {
+	if i, ok := slices.BinarySearch(glyphIDs[:], id); ok {
+		return glyphValues[i], true
+	}
+	return "", false
+}
+
 // AllGlyphIDs returns an iterator over all the IDs of the glyphs in the class,
-// returned in no particular order.
+// sorted.
 func AllGlyphIDs() iter.Seq[string] {
-	return maps.Keys(allGlyphs)
+	return slices.Values(glyphIDs[:])
 }
 
 // AllGlyphFullIDs returns an iterator over all the full IDs of the glyphs in
-// the class, returned in no particular order.
+// the class, sorted.
 func AllGlyphFullIDs() iter.Seq[string] {
 	return func(yield func(string) bool) {
-		for id := range allGlyphs {
+		for _, id := range glyphIDs {
 			if !yield(string(Class) + "-" + id) {
 				return
 			}
 		}
 	}
 }
+
+// glyphSet implements [nf.GlyphSet] for the class.
+type glyphSet struct{}
+
+// Lookup returns the glyph with the given short ID, and whether it was found.
+func (glyphSet) Lookup(id string) (nf.Glyph, bool) {
+	return lookup(id)
+}
+
+// All returns an iterator over all short IDs and their glyphs, sorted by ID.
+func (glyphSet) All() iter.Seq2[string, nf.Glyph] {
+	return func(yield func(string, nf.Glyph) bool) {
+		for i, id := range glyphIDs {
+			if !yield(id, glyphValues[i]) {
+				return
+			}
+		}
+	}
+}
+
+// The class is registered with [nf.RegisterClass] on import.
+var _ = nf.MustRegisterClass(Class, glyphSet{})
@@ -0,0 +1,147 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+// Package nftest provides fake environments for testing code which depends on
+// Nerd Font detection, such as whether an application shows icons, without
+// depending on the terminal, fonts, or config of the machine running the tests.
+// See [nf.ContextWithEnv]. For example:
+//
+//	ctx := nftest.Kitty("JetBrainsMono Nerd Font").Context(t.Context())
+//	status, err := nf.DetectInstalled(ctx) // nf.StatusEnabled
+//
+//	ctx = nftest.LinuxConsole().Context(t.Context())
+//	status, err = nf.DetectInstalled(ctx) // nf.StatusNotInstalled
+package nftest
+
+import (
+	"context"
+	"os/exec"
+	"path"
+	"strings"
+	"testing/fstest"
+
+	nf "github.com/lrstanley/go-nf"
+)
+
+// Home is the home directory of fake environments created with [New].
+const Home = "/home/user"
+
+// Env is a fake environment, which can be used with the built-in detectors
+// through [Env.Context]. Fields can be modified directly, or with the helper
+// methods, which return the environment to allow chaining.
+type Env struct {
+	// Vars are the environment variables.
+	Vars map[string]string
+	// Files are the files in the environment, rooted at "/" (e.g.
+	// "home/user/.config/kitty/kitty.conf").
+	Files fstest.MapFS
+	// Commands maps command lines (the name and arguments, separated by spaces,
+	// e.g. "fc-list") to their output. Other commands aren't installed.
+	Commands map[string]string
+	// Home is the home directory of the user.
+	Home string
+	// GOOS is the operating system.
+	GOOS string
+}
+
+// New returns an empty Linux environment, with only the HOME and USER
+// environment variables set, no files, and no commands.
+func New() *Env {
+	return &Env{
+		Vars:     map[string]string{"HOME": Home, "USER": path.Base(Home)},
+		Files:    fstest.MapFS{},
+		Commands: map[string]string{},
+		Home:     Home,
+		GOOS:     "linux",
+	}
+}
+
+// Kitty returns an environment running in kitty, configured to use the font
+// family (e.g. "JetBrainsMono Nerd Font"), which is also installed (see
+// [Env.InstallFont]).
+func Kitty(font string) *Env {
+	return New().
+		Setenv("TERM", "xterm-kitty").
+		Setenv("KITTY_WINDOW_ID", "1").
+		WriteFile("~/.config/kitty/kitty.conf", "font_family "+font+"\n").
+		InstallFont(font)
+}
+
+// WezTerm returns an environment running in WezTerm, which bundles the Nerd
+// Font symbols, without a config file.
+func WezTerm() *Env {
+	return New().
+		Setenv("TERM", "xterm-256color").
+		Setenv("TERM_PROGRAM", "WezTerm")
+}
+
+// LinuxConsole returns an environment running in the Linux console, without
+// any fonts installed.
+func LinuxConsole() *Env {
+	return New().Setenv("TERM", "linux")
+}
+
+// Setenv sets the environment variable key to value.
+func (e *Env) Setenv(key, value string) *Env {
+	e.Vars[key] = value
+	return e
+}
+
+// Unsetenv unsets the environment variable key.
+func (e *Env) Unsetenv(key string) *Env {
+	delete(e.Vars, key)
+	return e
+}
+
+// WriteFile creates the file at the absolute path p (or relative to the home
+// directory, if it starts with "~/"), with the provided content.
+func (e *Env) WriteFile(p, content string) *Env {
+	e.Files[e.fsPath(p)] = &fstest.MapFile{Data: []byte(content)}
+	return e
+}
+
+// InstallFont installs a font file for the font family (e.g. "Hack Nerd
+// Font") in the user font directory ("~/.local/share/fonts"). The file only
+// contains placeholder data, and is matched by its name.
+func (e *Env) InstallFont(family string) *Env {
+	return e.WriteFile("~/.local/share/fonts/"+family+"-Regular.ttf", "font")
+}
+
+// SetCommand sets the output of the command with the provided name and
+// arguments, which is otherwise not installed.
+func (e *Env) SetCommand(output, name string, args ...string) *Env {
+	e.Commands[strings.Join(append([]string{name}, args...), " ")] = output
+	return e
+}
+
+// Env returns the environment as an [nf.Env]. Changes to e are reflected in
+// the returned environment.
+func (e *Env) Env() *nf.Env {
+	return &nf.Env{
+		Getenv: func(key string) string { return e.Vars[key] },
+		Home:   e.Home,
+		FS:     e.Files,
+		Run: func(_ context.Context, name string, args ...string) ([]byte, error) {
+			out, ok := e.Commands[strings.Join(append([]string{name}, args...), " ")]
+			if !ok {
+				return nil, &exec.Error{Name: name, Err: exec.ErrNotFound}
+			}
+			return []byte(out), nil
+		},
+		GOOS: e.GOOS,
+	}
+}
+
+// Context returns a context which makes the built-in detectors use the
+// environment, see [nf.ContextWithEnv].
+func (e *Env) Context(ctx context.Context) context.Context {
+	return nf.ContextWithEnv(ctx, e.Env())
+}
+
+func (e *Env) fsPath(p string) string {
+	if strings.HasPrefix(p, "~/") {
+		p = path.Join(e.Home, p[2:])
+	}
+	return strings.TrimPrefix(path.Clean(p), "/")
+}
@@ -0,0 +1,97 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package nftest
+
+import (
+	"errors"
+	"os/exec"
+	"testing"
+
+	nf "github.com/lrstanley/go-nf"
+)
+
+func TestScenarios(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		env       *Env
+		want      nf.InstallStatus
+		decidedBy string
+	}{
+		{name: "kitty", env: Kitty("JetBrainsMono Nerd Font"), want: nf.StatusEnabled, decidedBy: "terminal-config"},
+		{name: "kitty-plain-font", env: Kitty("JetBrains Mono"), want: nf.StatusNotInstalled},
+		{name: "wezterm", env: WezTerm(), want: nf.StatusEnabled, decidedBy: "terminal-config"},
+		{name: "console", env: LinuxConsole(), want: nf.StatusNotInstalled},
+		{name: "console-env-var", env: LinuxConsole().Setenv("NERD_FONTS", "1"), want: nf.StatusEnabled, decidedBy: "env:NERD_FONTS"},
+		{name: "disabled", env: Kitty("JetBrainsMono Nerd Font").Setenv("NERD_FONTS", "0"), want: nf.StatusDisabled, decidedBy: "env:NERD_FONTS"},
+		{name: "installed", env: LinuxConsole().InstallFont("Hack Nerd Font"), want: nf.StatusInstalled, decidedBy: "filesystem"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := tt.env.Context(t.Context())
+
+			status, err := nf.DetectInstalled(ctx)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if status != tt.want {
+				t.Errorf("expected status %q, got %q", tt.want, status)
+			}
+
+			if report := nf.DetectReport(ctx); report.DecidedBy != tt.decidedBy {
+				t.Errorf("expected status decided by %q, got %q:\n%s", tt.decidedBy, report.DecidedBy, report)
+			}
+		})
+	}
+}
+
+func TestEnv(t *testing.T) {
+	t.Parallel()
+
+	e := New().
+		WriteFile("/etc/fonts/fonts.conf", "<fontconfig/>").
+		SetCommand("JetBrainsMono Nerd Font\n", "fc-list", ":", "family")
+	env := e.Env()
+
+	if got := env.Getenv("HOME"); got != Home {
+		t.Errorf("expected HOME %q, got %q", Home, got)
+	}
+	e.Setenv("TERM", "xterm-kitty")
+	if got := env.Getenv("TERM"); got != "xterm-kitty" {
+		t.Errorf("expected changes to be reflected, got TERM %q", got)
+	}
+	if got := nf.TerminalFrom(env.Getenv); got != nf.TerminalKitty {
+		t.Errorf("expected kitty, got %q", got)
+	}
+	e.Unsetenv("TERM")
+	if got := env.Getenv("TERM"); got != "" {
+		t.Errorf("expected TERM to be unset, got %q", got)
+	}
+
+	if data, err := env.ReadFile("/etc/fonts/fonts.conf"); err != nil || string(data) != "<fontconfig/>" {
+		t.Errorf("unexpected file: %q, %v", data, err)
+	}
+
+	out, err := env.Run(t.Context(), "fc-list", ":", "family")
+	if err != nil || string(out) != "JetBrainsMono Nerd Font\n" {
+		t.Errorf("unexpected command output: %q, %v", out, err)
+	}
+	if _, err = env.Run(t.Context(), "fc-match"); !errors.Is(err, exec.ErrNotFound) {
+		t.Errorf("expected not found error, got %v", err)
+	}
+
+	// Unset fields default to the host.
+	host := nf.EnvFrom(t.Context())
+	if host.Getenv == nil || host.FS == nil || host.Run == nil || host.GOOS == "" {
+		t.Errorf("expected host defaults, got %+v", host)
+	}
+	if got := nf.EnvFrom(nf.ContextWithEnv(t.Context(), &nf.Env{Home: "/custom"})); got.Home != "/custom" || got.FS == nil {
+		t.Errorf("expected partial environment with host defaults, got %+v", got)
+	}
+}
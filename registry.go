@@ -0,0 +1,240 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package nf
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+)
+
+var (
+	// ErrClassExists is returned by [RegisterClass] when a class with the same
+	// name is already registered.
+	ErrClassExists = errors.New("class already registered")
+
+	// ErrInvalidClass is returned by [RegisterClass] when the class name or glyph
+	// set is invalid.
+	ErrInvalidClass = errors.New("invalid class")
+)
+
+// GlyphSet is a set of glyphs within a class, keyed by their short ID (i.e. the
+// ID without the class prefix).
+type GlyphSet interface {
+	// Lookup returns the glyph with the given short ID, and whether it was found.
+	Lookup(id string) (Glyph, bool)
+
+	// All returns an iterator over all short IDs and their glyphs, sorted by ID.
+	All() iter.Seq2[string, Glyph]
+}
+
+// GlyphMap is a [GlyphSet] backed by a map of short IDs to glyphs. Useful for
+// registering custom classes, e.g. glyphs from a custom patched font, with
+// [RegisterClass]. A GlyphMap must not be modified after it is registered.
+type GlyphMap map[string]Glyph
+
+// Lookup returns the glyph with the given short ID, and whether it was found.
+func (m GlyphMap) Lookup(id string) (Glyph, bool) {
+	g, ok := m[id]
+	return g, ok
+}
+
+// All returns an iterator over all short IDs and their glyphs, sorted by ID.
+func (m GlyphMap) All() iter.Seq2[string, Glyph] {
+	return func(yield func(string, Glyph) bool) {
+		for _, id := range slices.Sorted(maps.Keys(m)) {
+			if !yield(id, m[id]) {
+				return
+			}
+		}
+	}
+}
+
+type registeredClass struct {
+	class  Class
+	glyphs GlyphSet
+}
+
+// registry is a set of classes, sorted by name. The zero value is ready to use,
+// and it is safe for concurrent use.
+type registry struct {
+	mu sync.RWMutex
+	// classes is copied on write, such that it can be read (and glyph sets,
+	// which may be user-provided, can be used) without holding the lock.
+	classes []registeredClass
+
+	// byGlyph is the reverse index of glyphs to full IDs. It is built on first
+	// use, and reset when a class is registered.
+	byGlyph map[Glyph][]string
+}
+
+// classRegistry is the global registry, which all generated class packages
+// register themselves with on import.
+var classRegistry registry
+
+func (r *registry) register(class Class, glyphs GlyphSet) error {
+	if class == "" || strings.ContainsAny(string(class), "- \t\r\n") {
+		return fmt.Errorf("%w: %q: name must be non-empty, and must not contain dashes or whitespace", ErrInvalidClass, class)
+	}
+	if glyphs == nil {
+		return fmt.Errorf("%w: %q: nil glyph set", ErrInvalidClass, class)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	i, found := slices.BinarySearchFunc(r.classes, class, compareClass)
+	if found {
+		return fmt.Errorf("%w: %q", ErrClassExists, class)
+	}
+
+	r.classes = slices.Insert(slices.Clip(r.classes), i, registeredClass{class: class, glyphs: glyphs})
+	r.byGlyph = nil
+	return nil
+}
+
+// snapshot returns the registered classes, so they can be iterated without
+// holding the lock (as glyph sets may be user-provided). The returned slice must
+// not be modified.
+func (r *registry) snapshot() []registeredClass {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.classes
+}
+
+func (r *registry) lookup(class Class) (GlyphSet, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if i, found := slices.BinarySearchFunc(r.classes, class, compareClass); found {
+		return r.classes[i].glyphs, true
+	}
+	return nil, false
+}
+
+func (r *registry) byID(id string) Glyph {
+	classes := r.snapshot()
+
+	// Full IDs are prefixed with their class, so they can be resolved directly.
+	if class, short, ok := strings.Cut(id, "-"); ok {
+		if i, found := slices.BinarySearchFunc(classes, Class(class), compareClass); found {
+			if glyph, gok := classes[i].glyphs.Lookup(short); gok {
+				return glyph
+			}
+		}
+	}
+
+	for _, c := range classes {
+		if glyph, ok := c.glyphs.Lookup(id); ok {
+			return glyph
+		}
+	}
+	return ""
+}
+
+func (r *registry) idsByGlyph(glyph Glyph) []string {
+	r.mu.RLock()
+	index, classes := r.byGlyph, r.classes
+	r.mu.RUnlock()
+
+	if index == nil {
+		// The index is built without holding the lock, as glyph sets may be
+		// user-provided.
+		index = make(map[Glyph][]string)
+		for _, c := range classes {
+			for id, g := range c.glyphs.All() {
+				index[g] = append(index[g], string(c.class)+"-"+id)
+			}
+		}
+
+		// Classes can only be added, so the index is only stored if none were
+		// registered in the meantime.
+		r.mu.Lock()
+		if r.byGlyph == nil && len(r.classes) == len(classes) {
+			r.byGlyph = index
+		}
+		r.mu.Unlock()
+	}
+
+	return slices.Clone(index[glyph])
+}
+
+func compareClass(c registeredClass, class Class) int {
+	return strings.Compare(string(c.class), string(class))
+}
+
+// RegisterClass registers a class of glyphs, such that it can be used with
+// [ByID], [Classes], [Glyphs], and [IDsByGlyph] (and the helpers in the
+// "glyphs/all" package), alongside the built-in classes. All generated class
+// packages (e.g. "glyphs/md") register themselves when imported.
+//
+// Class names must be non-empty, and must not contain dashes or whitespace, as
+// full IDs are in the form "<class>-<id>". Returns [ErrClassExists] if a class
+// with the same name is already registered, or [ErrInvalidClass] if the name or
+// glyph set is invalid. RegisterClass is safe for concurrent use.
+func RegisterClass(class Class, glyphs GlyphSet) error {
+	return classRegistry.register(class, glyphs)
+}
+
+// MustRegisterClass is like [RegisterClass], but panics if the class cannot be
+// registered. It returns the class, such that it can be used in package-level
+// variable declarations.
+func MustRegisterClass(class Class, glyphs GlyphSet) Class {
+	if err := RegisterClass(class, glyphs); err != nil {
+		panic(err)
+	}
+	return class
+}
+
+// Classes returns an iterator over all registered classes, sorted by name.
+func Classes() iter.Seq[Class] {
+	return func(yield func(Class) bool) {
+		for _, c := range classRegistry.snapshot() {
+			if !yield(c.class) {
+				return
+			}
+		}
+	}
+}
+
+// ClassGlyphs returns the glyph set of a registered class, and whether the class
+// is registered.
+func ClassGlyphs(class Class) (GlyphSet, bool) {
+	return classRegistry.lookup(class)
+}
+
+// Glyphs returns an iterator over the full IDs and glyphs of all registered
+// classes, sorted by class, then by ID.
+func Glyphs() iter.Seq2[string, Glyph] {
+	return func(yield func(string, Glyph) bool) {
+		for _, c := range classRegistry.snapshot() {
+			for id, glyph := range c.glyphs.All() {
+				if !yield(string(c.class)+"-"+id, glyph) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// ByID finds a glyph by its full ID (e.g. "md-account"), or its short ID (e.g.
+// "account") across all registered classes, or an empty string if the glyph is
+// not found. Short IDs are resolved using the first class (sorted by name) which
+// contains the ID.
+func ByID(id string) Glyph {
+	return classRegistry.byID(id)
+}
+
+// IDsByGlyph returns the full IDs of all glyphs across all registered classes
+// which match the given glyph, sorted by class, then by ID. Multiple IDs may
+// match the same glyph, e.g. aliases, or the same codepoint being present in
+// multiple classes. Returns nil if no glyphs match.
+func IDsByGlyph(glyph Glyph) []string {
+	return classRegistry.idsByGlyph(glyph)
+}
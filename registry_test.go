@@ -0,0 +1,196 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package nf
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"slices"
+	"sync"
+	"testing"
+)
+
+func TestRegisterClass(t *testing.T) {
+	t.Parallel()
+
+	var r registry
+
+	if err := r.register("acme", GlyphMap{"logo": "\ue000", "mark": "\ue001"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := r.register("other", GlyphMap{"logo": "\ue100", "dup": "\ue000"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, tt := range []struct {
+		class  Class
+		glyphs GlyphSet
+		want   error
+	}{
+		{"acme", GlyphMap{}, ErrClassExists},
+		{"", GlyphMap{}, ErrInvalidClass},
+		{"has-dash", GlyphMap{}, ErrInvalidClass},
+		{"has space", GlyphMap{}, ErrInvalidClass},
+		{"nilset", nil, ErrInvalidClass},
+	} {
+		if err := r.register(tt.class, tt.glyphs); !errors.Is(err, tt.want) {
+			t.Errorf("register(%q): expected %v, got %v", tt.class, tt.want, err)
+		}
+	}
+
+	for _, tt := range []struct {
+		id   string
+		want Glyph
+	}{
+		{"acme-logo", "\ue000"},
+		{"other-logo", "\ue100"},
+		{"logo", "\ue000"}, // first class (sorted by name) wins.
+		{"dup", "\ue000"},
+		{"acme-dup", ""},
+		{"nonexistent-logo", ""},
+		{"nonexistent", ""},
+	} {
+		if got := r.byID(tt.id); got != tt.want {
+			t.Errorf("byID(%q): expected %q, got %q", tt.id, tt.want, got)
+		}
+	}
+
+	if got, want := r.idsByGlyph("\ue000"), []string{"acme-logo", "other-dup"}; !slices.Equal(got, want) {
+		t.Errorf("expected reverse lookup %q, got %q", want, got)
+	}
+	if got := r.idsByGlyph("x"); got != nil {
+		t.Errorf("expected nil reverse lookup for unknown glyph, got %q", got)
+	}
+
+	// Registering a class must invalidate the reverse index.
+	if err := r.register("late", GlyphMap{"logo": "\ue000"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, want := r.idsByGlyph("\ue000"), []string{"acme-logo", "late-logo", "other-dup"}; !slices.Equal(got, want) {
+		t.Errorf("expected reverse lookup %q, got %q", want, got)
+	}
+
+	var classes []Class
+	for _, c := range r.snapshot() {
+		classes = append(classes, c.class)
+	}
+	if want := []Class{"acme", "late", "other"}; !slices.Equal(classes, want) {
+		t.Errorf("expected classes %q, got %q", want, classes)
+	}
+}
+
+func TestRegistryConcurrent(t *testing.T) {
+	t.Parallel()
+
+	var r registry
+	var wg sync.WaitGroup
+
+	for i := range 50 {
+		wg.Go(func() {
+			class := Class(fmt.Sprintf("c%02d", i))
+			if err := r.register(class, GlyphMap{"id": Glyph(rune(0xe000 + i))}); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			_ = r.byID(string(class) + "-id")
+			_ = r.idsByGlyph(Glyph(rune(0xe000 + i)))
+			_ = r.snapshot()
+		})
+		wg.Go(func() {
+			// Only one of the duplicate registrations may succeed.
+			_ = r.register("shared", GlyphMap{})
+		})
+	}
+	wg.Wait()
+
+	if n := len(r.snapshot()); n != 51 {
+		t.Errorf("expected 51 classes, got %d", n)
+	}
+	for i := range 50 {
+		if got := r.byID(fmt.Sprintf("c%02d-id", i)); got != Glyph(rune(0xe000+i)) {
+			t.Errorf("expected glyph for class c%02d, got %q", i, got)
+		}
+	}
+}
+
+// reentrantSet is a [GlyphSet] which uses the registry it is registered in.
+type reentrantSet struct {
+	GlyphMap
+	r *registry
+}
+
+func (s reentrantSet) Lookup(id string) (Glyph, bool) {
+	_ = s.r.register(Class("lookup"+id), GlyphMap{})
+	return s.GlyphMap.Lookup(id)
+}
+
+func (s reentrantSet) All() iter.Seq2[string, Glyph] {
+	_ = s.r.register("all", GlyphMap{})
+	return s.GlyphMap.All()
+}
+
+func TestRegistryReentrant(t *testing.T) {
+	t.Parallel()
+
+	// Glyph sets may be user-provided, so they must be used without holding the
+	// registry lock.
+	var r registry
+	if err := r.register("acme", reentrantSet{GlyphMap: GlyphMap{"logo": "\ue000"}, r: &r}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := r.byID("acme-logo"); got != "\ue000" {
+		t.Errorf("expected glyph by full ID, got %q", got)
+	}
+	if got := r.byID("logo"); got != "\ue000" {
+		t.Errorf("expected glyph by short ID, got %q", got)
+	}
+	if got := r.idsByGlyph("\ue000"); !slices.Equal(got, []string{"acme-logo"}) {
+		t.Errorf("expected reverse lookup, got %q", got)
+	}
+	if _, ok := r.lookup("lookuplogo"); !ok {
+		t.Errorf("expected class registered from Lookup")
+	}
+}
+
+func TestGlobalRegistry(t *testing.T) {
+	t.Parallel()
+
+	glyphs := GlyphMap{"logo": "\U000f0001", "wordmark": "\U000f0002"}
+	if c := MustRegisterClass("nftestglobal", glyphs); c != "nftestglobal" {
+		t.Errorf("expected class to be returned, got %q", c)
+	}
+
+	if !slices.Contains(slices.Collect(Classes()), "nftestglobal") {
+		t.Errorf("expected registered class in Classes()")
+	}
+	if set, ok := ClassGlyphs("nftestglobal"); !ok || set == nil {
+		t.Errorf("expected registered class to be found")
+	}
+	if got := ByID("nftestglobal-wordmark"); got != "\U000f0002" {
+		t.Errorf("expected glyph by full ID, got %q", got)
+	}
+	if got := IDsByGlyph("\U000f0001"); !slices.Equal(got, []string{"nftestglobal-logo"}) {
+		t.Errorf("expected reverse lookup, got %q", got)
+	}
+
+	var ids []string
+	for id, glyph := range Glyphs() {
+		if glyph == "" {
+			t.Errorf("expected non-empty glyph for %q", id)
+		}
+		ids = append(ids, id)
+	}
+	if !slices.Contains(ids, "nftestglobal-logo") {
+		t.Errorf("expected full ID in Glyphs(), got %q", ids)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("expected panic when registering duplicate class")
+		}
+	}()
+	MustRegisterClass("nftestglobal", glyphs)
+}
@@ -0,0 +1,251 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package nf
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"reflect"
+	"regexp"
+	"runtime"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// EvidenceKind is the kind of [Evidence] found by a detector.
+type EvidenceKind string
+
+const (
+	// EvidenceEnv is an environment variable, e.g. "NERD_FONTS=1".
+	EvidenceEnv EvidenceKind = "env"
+	// EvidenceTerminal is the detected terminal emulator, e.g. "kitty".
+	EvidenceTerminal EvidenceKind = "terminal"
+	// EvidenceFont is a font family, e.g. "JetBrainsMono Nerd Font".
+	EvidenceFont EvidenceKind = "font"
+	// EvidenceFile is a file which was read, e.g. a config or font file.
+	EvidenceFile EvidenceKind = "file"
+	// EvidenceConfig is a setting from a config file, e.g. a fontconfig rule.
+	EvidenceConfig EvidenceKind = "config"
+	// EvidenceCommand is a command which was run, e.g. "fc-list".
+	EvidenceCommand EvidenceKind = "command"
+	// EvidenceCache is a cached result, with the time it was cached, see
+	// [WithCache].
+	EvidenceCache EvidenceKind = "cache"
+)
+
+// Evidence is a piece of information found by a detector, which explains the
+// status it returned, e.g. the font family configured in the terminal, and the
+// config file it was read from.
+type Evidence struct {
+	Kind  EvidenceKind `json:"kind"`
+	Value string       `json:"value"`
+	// Path is the file the evidence was found in, if any.
+	Path string `json:"path,omitempty"`
+}
+
+func (e Evidence) String() string {
+	if e.Path != "" {
+		return fmt.Sprintf("%s: %s (%s)", e.Kind, e.Value, e.Path)
+	}
+	return fmt.Sprintf("%s: %s", e.Kind, e.Value)
+}
+
+// detectorRecord collects the name and evidence of a detector, while it's run
+// by [DetectReport].
+type detectorRecord struct {
+	mu       sync.Mutex
+	name     string
+	evidence []Evidence
+}
+
+type detectorRecordKey struct{}
+
+// AddEvidence records evidence for the detector which is currently running, to
+// be included in the [Report] returned by [DetectReport]. Custom detectors can
+// use this to explain their results. It is a no-op if the detector isn't being
+// run by [DetectReport].
+func AddEvidence(ctx context.Context, evidence ...Evidence) {
+	r, ok := ctx.Value(detectorRecordKey{}).(*detectorRecord)
+	if !ok {
+		return
+	}
+	r.mu.Lock()
+	r.evidence = append(r.evidence, evidence...)
+	r.mu.Unlock()
+}
+
+// Named returns a detector which reports its name to [DetectReport], e.g. to
+// identify custom detectors in the report. If multiple names are set (e.g. a
+// named detector wrapped by another), the outermost name is used.
+func Named(name string, detector InstallDetector) InstallDetector {
+	return func(ctx context.Context) (InstallStatus, error) {
+		setDetectorName(ctx, name)
+		return detector(ctx)
+	}
+}
+
+// setDetectorName sets the name of the detector which is currently running,
+// unless it's already set.
+func setDetectorName(ctx context.Context, name string) {
+	r, ok := ctx.Value(detectorRecordKey{}).(*detectorRecord)
+	if !ok {
+		return
+	}
+	r.mu.Lock()
+	if r.name == "" {
+		r.name = name
+	}
+	r.mu.Unlock()
+}
+
+var reClosureSuffix = regexp.MustCompile(`(\.func\d+)+$`)
+
+// funcName returns the name of the function which created the detector, e.g.
+// "main.myDetector", for detectors which don't report their name.
+func funcName(detector InstallDetector) string {
+	fn := runtime.FuncForPC(reflect.ValueOf(detector).Pointer())
+	if fn == nil {
+		return "unknown"
+	}
+	return reClosureSuffix.ReplaceAllString(path.Base(fn.Name()), "")
+}
+
+// DetectorResult is the outcome of a single detector, as part of a [Report].
+type DetectorResult struct {
+	// Name is the name of the detector, e.g. "terminal-config". Detectors which
+	// don't report a name use the name of the function which created them.
+	Name     string
+	Status   InstallStatus
+	Err      error
+	Duration time.Duration
+	Evidence []Evidence
+}
+
+// MarshalJSON encodes the result, with the status and error as strings, and the
+// duration in a human readable form (e.g. "1.5ms").
+func (r DetectorResult) MarshalJSON() ([]byte, error) {
+	v := struct {
+		Name     string        `json:"name"`
+		Status   InstallStatus `json:"status"`
+		Error    string        `json:"error,omitempty"`
+		Duration string        `json:"duration"`
+		Evidence []Evidence    `json:"evidence,omitempty"`
+	}{
+		Name:     r.Name,
+		Status:   r.Status,
+		Duration: r.Duration.String(),
+		Evidence: r.Evidence,
+	}
+	if r.Err != nil {
+		v.Error = r.Err.Error()
+	}
+	return json.Marshal(v)
+}
+
+// Report is the result of [DetectReport], with the outcome of each detector.
+type Report struct {
+	// Status is the overall status, which is the same as [DetectInstalled] would
+	// return.
+	Status InstallStatus `json:"status"`
+	// DecidedBy is the name of the detector which determined the status, or
+	// empty if no detector returned a status other than [StatusNotInstalled].
+	DecidedBy string           `json:"decided_by,omitempty"`
+	Results   []DetectorResult `json:"results"`
+}
+
+// Err returns the errors of all detectors joined together, or nil if the status
+// was decided by a detector, like [DetectInstalled].
+func (r *Report) Err() error {
+	if r.DecidedBy != "" {
+		return nil
+	}
+	var errs []error
+	for _, res := range r.Results {
+		if res.Err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", res.Name, res.Err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// String renders the report as human readable text, with one line per detector,
+// followed by its evidence and error, e.g. for a "doctor" command.
+func (r *Report) String() string {
+	var b strings.Builder
+	b.WriteString("status: " + r.Status.String())
+	if r.DecidedBy != "" {
+		b.WriteString(" (decided by " + r.DecidedBy + ")")
+	}
+	b.WriteString("\n\n")
+
+	var width int
+	for _, res := range r.Results {
+		width = max(width, len(res.Name))
+	}
+
+	for _, res := range r.Results {
+		fmt.Fprintf(&b, "%-*s  %-13s  %s\n", width, res.Name, res.Status, res.Duration.Round(time.Microsecond))
+		for _, e := range res.Evidence {
+			fmt.Fprintf(&b, "  %s\n", e)
+		}
+		if res.Err != nil {
+			fmt.Fprintf(&b, "  error: %s\n", strings.ReplaceAll(res.Err.Error(), "\n", "; "))
+		}
+	}
+	return b.String()
+}
+
+// DetectReport runs the provided detectors (or [DefaultDetectors] if none are
+// provided), and returns a report of the outcome, timing, and evidence of each
+// detector, to help debug why Nerd Fonts were (or weren't) detected.
+//
+// Unlike [DetectInstalled], all detectors are run, even after one has
+// determined the status. [Report.Status] is the status [DetectInstalled] would
+// have returned. Use [AddEvidence] to record evidence from custom detectors.
+func DetectReport(ctx context.Context, detectors ...InstallDetector) *Report {
+	if len(detectors) == 0 {
+		detectors = DefaultDetectors()
+	}
+
+	report := &Report{Status: StatusNotInstalled}
+	for _, detector := range detectors {
+		res := runDetector(ctx, detector)
+		report.Results = append(report.Results, res)
+
+		if res.Err == nil && report.DecidedBy == "" && isDecisive(res.Status) {
+			report.Status = res.Status
+			report.DecidedBy = res.Name
+		}
+	}
+	return report
+}
+
+// runDetector runs the detector, recording its name, evidence, and duration.
+func runDetector(ctx context.Context, detector InstallDetector) DetectorResult {
+	rec := &detectorRecord{}
+	start := time.Now()
+	status, err := detector(context.WithValue(ctx, detectorRecordKey{}, rec))
+
+	// Detectors which time out (see [WithTimeout]) may still be running.
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+
+	res := DetectorResult{
+		Name:     rec.name,
+		Status:   status,
+		Err:      err,
+		Duration: time.Since(start),
+		Evidence: slices.Clone(rec.evidence),
+	}
+	if res.Name == "" {
+		res.Name = funcName(detector)
+	}
+	return res
+}
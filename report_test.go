@@ -0,0 +1,155 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package nf
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testReportDetector(_ context.Context) (InstallStatus, error) {
+	return StatusNotInstalled, nil
+}
+
+func TestDetectReport(t *testing.T) {
+	t.Setenv("NFTEST_REPORT", "true")
+
+	var ran []string
+	report := DetectReport(
+		t.Context(),
+		DetectorEnvVar("NFTEST_REPORT"),
+		testReportDetector,
+		Named("failing", func(ctx context.Context) (InstallStatus, error) {
+			ran = append(ran, "failing")
+			AddEvidence(ctx, Evidence{Kind: EvidenceFile, Value: "/etc/fonts/fonts.conf"})
+			return StatusNotInstalled, errors.New("permission denied")
+		}),
+		// Outer names take precedence.
+		Named("outer", Named("inner", func(ctx context.Context) (InstallStatus, error) {
+			ran = append(ran, "outer")
+			AddEvidence(ctx,
+				Evidence{Kind: EvidenceTerminal, Value: "kitty"},
+				Evidence{Kind: EvidenceFont, Value: "Hack Nerd Font", Path: "/home/user/.config/kitty/kitty.conf"},
+			)
+			time.Sleep(time.Millisecond)
+			return StatusInstalled, nil
+		})),
+		func(_ context.Context) (InstallStatus, error) {
+			ran = append(ran, "closure")
+			return StatusNotInstalled, nil
+		},
+	)
+
+	// Detectors after the one which decided the status are still run.
+	if !slices.Equal(ran, []string{"failing", "outer", "closure"}) {
+		t.Errorf("expected all detectors to run, got %q", ran)
+	}
+	if report.Status != StatusEnabled || report.DecidedBy != "env:NFTEST_REPORT" {
+		t.Errorf("expected enabled by env var, got %q by %q", report.Status, report.DecidedBy)
+	}
+	if err := report.Err(); err != nil {
+		t.Errorf("expected no error once decided, got %v", err)
+	}
+
+	var names []string
+	for _, res := range report.Results {
+		names = append(names, res.Name)
+	}
+	want := []string{"env:NFTEST_REPORT", "go-nf.testReportDetector", "failing", "outer", "go-nf.TestDetectReport"}
+	if !slices.Equal(names, want) {
+		t.Errorf("expected names %q, got %q", want, names)
+	}
+
+	res := report.Results[0]
+	if res.Status != StatusEnabled || !slices.Equal(res.Evidence, []Evidence{{Kind: EvidenceEnv, Value: "NFTEST_REPORT=true"}}) {
+		t.Errorf("unexpected env var result: %+v", res)
+	}
+	if res := report.Results[2]; res.Err == nil || len(res.Evidence) != 1 {
+		t.Errorf("expected error and evidence, got %+v", res)
+	}
+	if res := report.Results[3]; res.Duration < time.Millisecond || len(res.Evidence) != 2 {
+		t.Errorf("expected duration and evidence, got %+v", res)
+	}
+
+	text := report.String()
+	for _, s := range []string{
+		"status: enabled (decided by env:NFTEST_REPORT)\n",
+		"\nfailing ",
+		"  error: permission denied",
+		"  file: /etc/fonts/fonts.conf",
+		"  font: Hack Nerd Font (/home/user/.config/kitty/kitty.conf)",
+	} {
+		if !strings.Contains(text, s) {
+			t.Errorf("expected text report to contain %q, got:\n%s", s, text)
+		}
+	}
+
+	data, err := json.Marshal(report)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var decoded struct {
+		Status    string `json:"status"`
+		DecidedBy string `json:"decided_by"`
+		Results   []struct {
+			Name     string     `json:"name"`
+			Status   string     `json:"status"`
+			Error    string     `json:"error"`
+			Duration string     `json:"duration"`
+			Evidence []Evidence `json:"evidence"`
+		} `json:"results"`
+	}
+	if err = json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if decoded.Status != "enabled" || decoded.DecidedBy != "env:NFTEST_REPORT" || len(decoded.Results) != 5 {
+		t.Errorf("unexpected JSON report: %s", data)
+	}
+	if r := decoded.Results[2]; r.Status != "not installed" || r.Error != "permission denied" || r.Evidence[0].Value != "/etc/fonts/fonts.conf" {
+		t.Errorf("unexpected JSON result: %+v", r)
+	}
+	if _, err = time.ParseDuration(decoded.Results[3].Duration); err != nil {
+		t.Errorf("expected duration string, got %q", decoded.Results[3].Duration)
+	}
+}
+
+func TestDetectReportErr(t *testing.T) {
+	t.Parallel()
+
+	report := DetectReport(t.Context(),
+		Named("a", func(_ context.Context) (InstallStatus, error) { return StatusNotInstalled, errors.New("first") }),
+		Named("b", func(_ context.Context) (InstallStatus, error) { return StatusInstalled, errors.New("second") }),
+	)
+	// Detectors which return errors don't decide the status, like DetectInstalled.
+	if report.Status != StatusNotInstalled || report.DecidedBy != "" {
+		t.Errorf("expected not installed, got %q by %q", report.Status, report.DecidedBy)
+	}
+	if err := report.Err(); err == nil || err.Error() != "a: first\nb: second" {
+		t.Errorf("expected joined errors, got %v", err)
+	}
+	if !strings.HasPrefix(report.String(), "status: not installed\n") {
+		t.Errorf("unexpected text report:\n%s", report)
+	}
+}
+
+func TestAddEvidenceWithoutReport(t *testing.T) {
+	t.Parallel()
+
+	// No-op outside of DetectReport.
+	AddEvidence(t.Context(), Evidence{Kind: EvidenceEnv, Value: "NERD_FONTS=1"})
+
+	status, err := DetectInstalled(t.Context(), Named("test", func(ctx context.Context) (InstallStatus, error) {
+		AddEvidence(ctx, Evidence{Kind: EvidenceEnv, Value: "NERD_FONTS=1"})
+		return StatusEnabled, nil
+	}))
+	if status != StatusEnabled || err != nil {
+		t.Errorf("expected enabled, got %q, %v", status, err)
+	}
+}
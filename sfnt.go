@@ -0,0 +1,420 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package nf
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"unicode"
+	"unicode/utf16"
+)
+
+// ErrUnsupportedFont is returned when reading a font file which isn't in a
+// supported format. WOFF2 files are not supported, as they require a Brotli
+// decoder.
+var ErrUnsupportedFont = errors.New("unsupported font format")
+
+// errMalformedFont is returned when a font file is truncated, or otherwise
+// invalid.
+var errMalformedFont = errors.New("malformed font")
+
+const (
+	sfntTrueType   = 0x00010000
+	sfntAppleTrue  = 0x74727565 // "true"
+	sfntOpenType   = 0x4f54544f // "OTTO"
+	sfntCollection = 0x74746366 // "ttcf"
+	sfntWOFF       = 0x774f4646 // "wOFF"
+	sfntWOFF2      = 0x774f4632 // "wOF2"
+
+	// sfntMaxFonts is the maximum number of fonts in a collection.
+	sfntMaxFonts = 256
+
+	// sfntMaxTableSize is the maximum size of a table which will be read.
+	sfntMaxTableSize = 64 << 20
+)
+
+// sfntTable is an entry in the table directory of a font.
+type sfntTable struct {
+	offset     uint32
+	length     uint32
+	compLength uint32 // Compressed length (WOFF), or length if uncompressed.
+}
+
+// sfntFont is a single font within a font file (TrueType, OpenType, or WOFF),
+// from which tables can be read.
+type sfntFont struct {
+	r      io.ReaderAt
+	tables map[string]sfntTable
+}
+
+// readSFNT reads the table directory of each font in the font file (a
+// collection may contain multiple fonts).
+func readSFNT(r io.ReaderAt, size int64) ([]*sfntFont, error) {
+	hdr, err := readAt(r, size, 0, 12)
+	if err != nil {
+		return nil, err
+	}
+
+	switch binary.BigEndian.Uint32(hdr) {
+	case sfntTrueType, sfntAppleTrue, sfntOpenType:
+		f, err := readSFNTDirectory(r, size, 0)
+		if err != nil {
+			return nil, err
+		}
+		return []*sfntFont{f}, nil
+	case sfntCollection:
+		n := binary.BigEndian.Uint32(hdr[8:])
+		if n == 0 || n > sfntMaxFonts {
+			return nil, fmt.Errorf("%w: invalid number of fonts in collection: %d", errMalformedFont, n)
+		}
+		offsets, err := readAt(r, size, 12, int64(n)*4)
+		if err != nil {
+			return nil, err
+		}
+		fonts := make([]*sfntFont, 0, n)
+		for i := range int(n) {
+			f, err := readSFNTDirectory(r, size, int64(binary.BigEndian.Uint32(offsets[i*4:])))
+			if err != nil {
+				return nil, err
+			}
+			fonts = append(fonts, f)
+		}
+		return fonts, nil
+	case sfntWOFF:
+		f, err := readWOFFDirectory(r, size)
+		if err != nil {
+			return nil, err
+		}
+		return []*sfntFont{f}, nil
+	case sfntWOFF2:
+		return nil, fmt.Errorf("%w: woff2", ErrUnsupportedFont)
+	default:
+		return nil, ErrUnsupportedFont
+	}
+}
+
+// readSFNTDirectory reads the table directory of a TrueType or OpenType font,
+// starting at offset.
+func readSFNTDirectory(r io.ReaderAt, size, offset int64) (*sfntFont, error) {
+	hdr, err := readAt(r, size, offset, 12)
+	if err != nil {
+		return nil, err
+	}
+	n := int64(binary.BigEndian.Uint16(hdr[4:]))
+
+	dir, err := readAt(r, size, offset+12, n*16)
+	if err != nil {
+		return nil, err
+	}
+
+	f := &sfntFont{r: r, tables: make(map[string]sfntTable, n)}
+	for i := range n {
+		entry := dir[i*16:]
+		t := sfntTable{
+			offset: binary.BigEndian.Uint32(entry[8:]),
+			length: binary.BigEndian.Uint32(entry[12:]),
+		}
+		t.compLength = t.length
+		if int64(t.offset)+int64(t.length) > size {
+			return nil, fmt.Errorf("%w: table %q out of bounds", errMalformedFont, entry[:4])
+		}
+		f.tables[string(entry[:4])] = t
+	}
+	return f, nil
+}
+
+// readWOFFDirectory reads the table directory of a WOFF font, where tables may
+// be zlib compressed.
+func readWOFFDirectory(r io.ReaderAt, size int64) (*sfntFont, error) {
+	hdr, err := readAt(r, size, 0, 44)
+	if err != nil {
+		return nil, err
+	}
+	n := int64(binary.BigEndian.Uint16(hdr[12:]))
+
+	dir, err := readAt(r, size, 44, n*20)
+	if err != nil {
+		return nil, err
+	}
+
+	f := &sfntFont{r: r, tables: make(map[string]sfntTable, n)}
+	for i := range n {
+		entry := dir[i*20:]
+		t := sfntTable{
+			offset:     binary.BigEndian.Uint32(entry[4:]),
+			compLength: binary.BigEndian.Uint32(entry[8:]),
+			length:     binary.BigEndian.Uint32(entry[12:]),
+		}
+		if t.compLength > t.length || int64(t.offset)+int64(t.compLength) > size {
+			return nil, fmt.Errorf("%w: table %q out of bounds", errMalformedFont, entry[:4])
+		}
+		f.tables[string(entry[:4])] = t
+	}
+	return f, nil
+}
+
+// table returns the (decompressed) contents of the table with the provided tag.
+// ok is false if the font doesn't have the table.
+func (f *sfntFont) table(tag string) (data []byte, ok bool, err error) {
+	t, ok := f.tables[tag]
+	if !ok {
+		return nil, false, nil
+	}
+	if t.length > sfntMaxTableSize {
+		return nil, true, fmt.Errorf("%w: table %q too large: %d bytes", errMalformedFont, tag, t.length)
+	}
+
+	data = make([]byte, t.compLength)
+	if _, err = f.r.ReadAt(data, int64(t.offset)); err != nil {
+		return nil, true, fmt.Errorf("%w: reading table %q: %w", errMalformedFont, tag, err)
+	}
+	if t.compLength == t.length {
+		return data, true, nil
+	}
+
+	zr, err := zlib.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, true, fmt.Errorf("%w: decompressing table %q: %w", errMalformedFont, tag, err)
+	}
+	data, err = io.ReadAll(io.LimitReader(zr, int64(t.length)+1))
+	if err != nil || len(data) != int(t.length) {
+		return nil, true, fmt.Errorf("%w: decompressing table %q", errMalformedFont, tag)
+	}
+	return data, true, nil
+}
+
+// readAt reads n bytes at offset, returning errMalformedFont if the file is too
+// short.
+func readAt(r io.ReaderAt, size, offset, n int64) ([]byte, error) {
+	if offset < 0 || n < 0 || offset+n > size {
+		return nil, fmt.Errorf("%w: unexpected end of file", errMalformedFont)
+	}
+	b := make([]byte, n)
+	if _, err := r.ReadAt(b, offset); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// FontInfo contains the names of a font, from the "name" table of the font file.
+type FontInfo struct {
+	// Family is the typographic family name (e.g. "JetBrainsMono Nerd Font"),
+	// falling back to the legacy family name, which may include the style for
+	// fonts with more than four styles (e.g. "JetBrainsMono NF ExtraBold").
+	Family string
+	// Subfamily is the style name (e.g. "Regular", "Bold Italic").
+	Subfamily string
+	// FullName is the full name of the font (e.g. "JetBrainsMono Nerd Font Bold").
+	FullName string
+	// Version is the version string of the font (e.g. "Version 2.304;Nerd Fonts
+	// 3.4.0"). The Nerd Fonts patcher appends its own version.
+	Version string
+}
+
+// IsNerdFont returns true if the family or full name of the font looks like a
+// Nerd Font (see [IsNerdFontName]), or the version has been set by the Nerd
+// Fonts patcher.
+func (f FontInfo) IsNerdFont() bool {
+	return IsNerdFontName(f.Family) || IsNerdFontName(f.FullName) || IsNerdFontName(f.Version)
+}
+
+// Name IDs from the "name" table.
+const (
+	nameFamily            = 1
+	nameSubfamily         = 2
+	nameFullName          = 4
+	nameVersion           = 5
+	nameTypographicFamily = 16
+)
+
+// ReadFontInfo reads the names of the fonts in the font file at path. TrueType
+// (.ttf), OpenType (.otf), collections (.ttc, .otc, which contain multiple
+// fonts), and WOFF (.woff) files are supported. WOFF2 files return
+// [ErrUnsupportedFont].
+func ReadFontInfo(path string) ([]FontInfo, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	fi, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	infos, err := readFontInfo(f, fi.Size())
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %q: %w", path, err)
+	}
+	return infos, nil
+}
+
+// readFontInfoFS is like [ReadFontInfo], but reads the font file from fsys.
+func readFontInfoFS(fsys fs.FS, name string) ([]FontInfo, error) {
+	f, err := fsys.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	fi, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	r, ok := f.(io.ReaderAt)
+	if !ok {
+		data, err := io.ReadAll(f)
+		if err != nil {
+			return nil, err
+		}
+		r = bytes.NewReader(data)
+	}
+
+	infos, err := readFontInfo(r, fi.Size())
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %q: %w", name, err)
+	}
+	return infos, nil
+}
+
+func readFontInfo(r io.ReaderAt, size int64) ([]FontInfo, error) {
+	fonts, err := readSFNT(r, size)
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]FontInfo, 0, len(fonts))
+	for _, font := range fonts {
+		data, ok, err := font.table("name")
+		if err == nil && !ok {
+			err = fmt.Errorf("%w: missing name table", errMalformedFont)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		info, err := parseNameTable(data)
+		if err != nil {
+			return nil, err
+		}
+		infos = append(infos, info)
+	}
+	return infos, nil
+}
+
+// parseNameTable parses the "name" table, preferring English names for Windows,
+// then Unicode, then Macintosh platforms.
+func parseNameTable(data []byte) (FontInfo, error) {
+	if len(data) < 6 {
+		return FontInfo{}, fmt.Errorf("%w: name table too short", errMalformedFont)
+	}
+	count := int(binary.BigEndian.Uint16(data[2:]))
+	storage := int(binary.BigEndian.Uint16(data[4:]))
+	if 6+count*12 > len(data) {
+		return FontInfo{}, fmt.Errorf("%w: name records out of bounds", errMalformedFont)
+	}
+
+	type candidate struct {
+		value string
+		score int
+	}
+	names := make(map[uint16]candidate)
+
+	for i := range count {
+		rec := data[6+i*12:]
+		platform := binary.BigEndian.Uint16(rec)
+		encoding := binary.BigEndian.Uint16(rec[2:])
+		language := binary.BigEndian.Uint16(rec[4:])
+		id := binary.BigEndian.Uint16(rec[6:])
+		start := storage + int(binary.BigEndian.Uint16(rec[10:]))
+		end := start + int(binary.BigEndian.Uint16(rec[8:]))
+
+		if !slices.Contains([]uint16{nameFamily, nameSubfamily, nameFullName, nameVersion, nameTypographicFamily}, id) {
+			continue
+		}
+		if end > len(data) {
+			continue
+		}
+
+		value, score, ok := decodeName(platform, encoding, language, data[start:end])
+		if !ok || value == "" {
+			continue
+		}
+		if c, exists := names[id]; !exists || score > c.score {
+			names[id] = candidate{value: value, score: score}
+		}
+	}
+
+	info := FontInfo{
+		Family:    names[nameTypographicFamily].value,
+		Subfamily: names[nameSubfamily].value,
+		FullName:  names[nameFullName].value,
+		Version:   names[nameVersion].value,
+	}
+	if info.Family == "" {
+		info.Family = names[nameFamily].value
+	}
+	return info, nil
+}
+
+// decodeName decodes a name record, returning a score for how preferable the
+// record is, or false if the encoding isn't supported.
+func decodeName(platform, encoding, language uint16, b []byte) (string, int, bool) {
+	switch platform {
+	case 0: // Unicode.
+		return decodeUTF16BE(b), 2, true
+	case 1: // Macintosh.
+		if encoding != 0 {
+			return "", 0, false
+		}
+		// Mac Roman, which only matches ASCII. Other characters are replaced.
+		r := make([]rune, len(b))
+		for i, c := range b {
+			r[i] = rune(c)
+			if c >= 0x80 {
+				r[i] = unicode.ReplacementChar
+			}
+		}
+		return string(r), 1, true
+	case 3: // Windows.
+		if encoding != 0 && encoding != 1 && encoding != 10 {
+			return "", 0, false
+		}
+		if language == 0x0409 { // English (United States).
+			return decodeUTF16BE(b), 4, true
+		}
+		return decodeUTF16BE(b), 3, true
+	}
+	return "", 0, false
+}
+
+func decodeUTF16BE(b []byte) string {
+	u := make([]uint16, len(b)/2)
+	for i := range u {
+		u[i] = binary.BigEndian.Uint16(b[i*2:])
+	}
+	return string(utf16.Decode(u))
+}
+
+// MatchNerdFontFile is the default [FontFileMatcher], which returns true if any
+// of the fonts in the file is a Nerd Font (see [FontInfo.IsNerdFont]). If the
+// file couldn't be read (e.g. WOFF2, or a malformed file), the file name is
+// matched instead.
+func MatchNerdFontFile(path string, fonts []FontInfo) bool {
+	if fonts == nil {
+		return IsNerdFontName(filepath.Base(path))
+	}
+	return slices.ContainsFunc(fonts, FontInfo.IsNerdFont)
+}
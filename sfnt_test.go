@@ -0,0 +1,261 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package nf
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"errors"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"unicode/utf16"
+)
+
+type testNameRecord struct {
+	platform, encoding, language, id uint16
+	value                            string
+}
+
+// testNameTable builds a "name" table from the records.
+func testNameTable(records ...testNameRecord) []byte {
+	var storage []byte
+	b := binary.BigEndian.AppendUint16(nil, 0)
+	b = binary.BigEndian.AppendUint16(b, uint16(len(records)))
+	b = binary.BigEndian.AppendUint16(b, uint16(6+len(records)*12))
+
+	for _, r := range records {
+		var value []byte
+		if r.platform == 1 {
+			value = []byte(r.value)
+		} else {
+			for _, u := range utf16.Encode([]rune(r.value)) {
+				value = binary.BigEndian.AppendUint16(value, u)
+			}
+		}
+		for _, v := range []uint16{r.platform, r.encoding, r.language, r.id, uint16(len(value)), uint16(len(storage))} {
+			b = binary.BigEndian.AppendUint16(b, v)
+		}
+		storage = append(storage, value...)
+	}
+	return append(b, storage...)
+}
+
+// testFontNames returns the tables of a font with the provided family, using
+// the Windows platform.
+func testFontNames(family, version string) map[string][]byte {
+	return map[string][]byte{
+		"name": testNameTable(
+			testNameRecord{3, 1, 0x0409, nameFamily, family},
+			testNameRecord{3, 1, 0x0409, nameSubfamily, "Regular"},
+			testNameRecord{3, 1, 0x0409, nameFullName, family + " Regular"},
+			testNameRecord{3, 1, 0x0409, nameVersion, version},
+		),
+		"head": make([]byte, 54),
+	}
+}
+
+// testSFNT builds the table directory and tables of a font, with offsets
+// relative to base.
+func testSFNT(tables map[string][]byte, base int) []byte {
+	tags := slices.Sorted(maps.Keys(tables))
+
+	b := binary.BigEndian.AppendUint32(nil, sfntTrueType)
+	b = binary.BigEndian.AppendUint16(b, uint16(len(tags)))
+	b = append(b, make([]byte, 6)...)
+
+	var data []byte
+	offset := base + 12 + len(tags)*16
+	for _, tag := range tags {
+		b = append(b, tag...)
+		b = binary.BigEndian.AppendUint32(b, 0)
+		b = binary.BigEndian.AppendUint32(b, uint32(offset+len(data)))
+		b = binary.BigEndian.AppendUint32(b, uint32(len(tables[tag])))
+		data = append(data, tables[tag]...)
+		for len(data)%4 != 0 {
+			data = append(data, 0)
+		}
+	}
+	return append(b, data...)
+}
+
+// testTTC builds a font collection.
+func testTTC(fonts ...map[string][]byte) []byte {
+	b := binary.BigEndian.AppendUint32(nil, sfntCollection)
+	b = binary.BigEndian.AppendUint32(b, 0x00010000)
+	b = binary.BigEndian.AppendUint32(b, uint32(len(fonts)))
+
+	var data []byte
+	base := 12 + len(fonts)*4
+	for _, f := range fonts {
+		b = binary.BigEndian.AppendUint32(b, uint32(base+len(data)))
+		data = append(data, testSFNT(f, base+len(data))...)
+	}
+	return append(b, data...)
+}
+
+// testWOFF builds a WOFF font, with zlib compressed tables if compress is true.
+func testWOFF(tables map[string][]byte, compress bool) []byte {
+	tags := slices.Sorted(maps.Keys(tables))
+
+	b := binary.BigEndian.AppendUint32(nil, sfntWOFF)
+	b = binary.BigEndian.AppendUint32(b, sfntTrueType)
+	b = binary.BigEndian.AppendUint32(b, 0) // Length, unused.
+	b = binary.BigEndian.AppendUint16(b, uint16(len(tags)))
+	b = append(b, make([]byte, 44-len(b))...)
+
+	var data []byte
+	offset := 44 + len(tags)*20
+	for _, tag := range tags {
+		table := tables[tag]
+		if compress {
+			var buf bytes.Buffer
+			zw := zlib.NewWriter(&buf)
+			_, _ = zw.Write(table)
+			_ = zw.Close()
+			// Tables are stored uncompressed if compression doesn't help.
+			if buf.Len() < len(table) {
+				table = buf.Bytes()
+			}
+		}
+		b = append(b, tag...)
+		b = binary.BigEndian.AppendUint32(b, uint32(offset+len(data)))
+		b = binary.BigEndian.AppendUint32(b, uint32(len(table)))
+		b = binary.BigEndian.AppendUint32(b, uint32(len(tables[tag])))
+		b = binary.BigEndian.AppendUint32(b, 0)
+		data = append(data, table...)
+	}
+	return append(b, data...)
+}
+
+// writeFont writes data to name in a temporary directory, returning the path.
+func writeFont(t *testing.T, name string, data []byte) string {
+	t.Helper()
+	p := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(p, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+func TestReadFontInfo(t *testing.T) {
+	t.Parallel()
+
+	jbm := map[string][]byte{
+		"name": testNameTable(
+			// Lower precedence platforms, which should be ignored.
+			testNameRecord{1, 0, 0, nameFamily, "Mac Family"},
+			testNameRecord{3, 1, 0x0407, nameFullName, "JetBrainsMono NF ExtraBold (German)"},
+			testNameRecord{3, 1, 0x0409, nameFamily, "JetBrainsMono NF ExtraBold"},
+			testNameRecord{3, 1, 0x0409, nameSubfamily, "Regular"},
+			testNameRecord{3, 1, 0x0409, nameFullName, "JetBrainsMono NF ExtraBold"},
+			testNameRecord{3, 1, 0x0409, nameVersion, "Version 2.304;Nerd Fonts 3.4.0"},
+			testNameRecord{3, 1, 0x0409, nameTypographicFamily, "JetBrainsMono NF"},
+			testNameRecord{3, 1, 0x0409, 6, "JetBrainsMonoNF-ExtraBold"},
+		),
+	}
+	want := FontInfo{
+		Family:    "JetBrainsMono NF",
+		Subfamily: "Regular",
+		FullName:  "JetBrainsMono NF ExtraBold",
+		Version:   "Version 2.304;Nerd Fonts 3.4.0",
+	}
+	mac := map[string][]byte{
+		"name": testNameTable(testNameRecord{1, 0, 0, nameFamily, "Monaco"}),
+	}
+
+	tests := []struct {
+		name string
+		data []byte
+		want []FontInfo
+	}{
+		{name: "font.ttf", data: testSFNT(jbm, 0), want: []FontInfo{want}},
+		{name: "mac.ttf", data: testSFNT(mac, 0), want: []FontInfo{{Family: "Monaco"}}},
+		{
+			name: "collection.ttc",
+			data: testTTC(testFontNames("Iosevka", "1.0"), jbm),
+			want: []FontInfo{
+				{Family: "Iosevka", Subfamily: "Regular", FullName: "Iosevka Regular", Version: "1.0"},
+				want,
+			},
+		},
+		{name: "font.woff", data: testWOFF(jbm, false), want: []FontInfo{want}},
+		{name: "compressed.woff", data: testWOFF(jbm, true), want: []FontInfo{want}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ReadFontInfo(writeFont(t, tt.name, tt.data))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("expected:\n%+v\ngot:\n%+v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestReadFontInfoErrors(t *testing.T) {
+	t.Parallel()
+
+	font := testSFNT(testFontNames("Hack", "1.0"), 0)
+
+	tests := []struct {
+		name string
+		data []byte
+		want error
+	}{
+		{name: "woff2", data: []byte("wOF2\x00\x01\x00\x00\x00\x00\x00\x00"), want: ErrUnsupportedFont},
+		{name: "text", data: []byte("not a font file"), want: ErrUnsupportedFont},
+		{name: "empty", data: nil, want: errMalformedFont},
+		{name: "truncated", data: font[:len(font)-60], want: errMalformedFont},
+		{name: "no-name", data: testSFNT(map[string][]byte{"head": make([]byte, 54)}, 0), want: errMalformedFont},
+		{name: "bad-collection", data: []byte("ttcf\x00\x01\x00\x00\xff\xff\xff\xff"), want: errMalformedFont},
+	}
+
+	for _, tt := range tests {
+		if _, err := ReadFontInfo(writeFont(t, tt.name, tt.data)); !errors.Is(err, tt.want) {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.want, err)
+		}
+	}
+
+	if _, err := ReadFontInfo(filepath.Join(t.TempDir(), "missing.ttf")); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("expected not exist error, got %v", err)
+	}
+}
+
+func TestMatchNerdFontFile(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		data []byte
+		want bool
+	}{
+		// Renamed Nerd Font, which is matched by its family name.
+		{name: "JBM-Regular.ttf", data: testSFNT(testFontNames("JetBrainsMono Nerd Font", "2.304"), 0), want: true},
+		// Renamed Nerd Font with a custom family, matched by the patcher version.
+		{name: "custom.otf", data: testSFNT(testFontNames("Custom Mono", "1.0;Nerd Fonts 3.4.0"), 0), want: true},
+		// File name looks like a Nerd Font, but the font isn't.
+		{name: "something-nf.ttf", data: testSFNT(testFontNames("Something", "1.0"), 0), want: false},
+		// Unreadable files fall back to the file name.
+		{name: "HackNerdFont-Regular.woff2", data: []byte("wOF2"), want: true},
+		{name: "Hack-Regular.woff2", data: []byte("wOF2"), want: false},
+	}
+
+	for _, tt := range tests {
+		path := writeFont(t, tt.name, tt.data)
+		fonts, _ := ReadFontInfo(path)
+		if got := MatchNerdFontFile(path, fonts); got != tt.want {
+			t.Errorf("MatchNerdFontFile(%q) = %t, want %t", tt.name, got, tt.want)
+		}
+	}
+}
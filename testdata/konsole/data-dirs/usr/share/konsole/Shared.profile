@@ -0,0 +1,5 @@
+[Appearance]
+Font=Iosevka NF,11,-1,5,50,0,0,0,0,0
+
+[General]
+Name=Shared
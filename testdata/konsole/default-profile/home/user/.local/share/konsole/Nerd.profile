@@ -0,0 +1,7 @@
+[Appearance]
+ColorScheme=Breeze
+Font=Hack Nerd Font Mono,10,-1,5,50,0,0,0,0,0
+
+[General]
+Name=Nerd
+Parent=FALLBACK/
@@ -0,0 +1,6 @@
+[Appearance]
+Font=Fira Code,10,-1,5,50,0,0,0,0,0
+
+[General]
+Name=Plain
+Parent=FALLBACK/
@@ -0,0 +1,6 @@
+[Appearance]
+ColorScheme=Breeze
+
+[General]
+Name=Plain
+Parent=FALLBACK/
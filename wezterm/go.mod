@@ -0,0 +1,13 @@
+module github.com/lrstanley/go-nf/wezterm
+
+go 1.25.0
+
+// Development only, as consumers ignore replace directives. Releases must
+// require a tagged version of the root module, see release-wezterm in the
+// Makefile.
+replace github.com/lrstanley/go-nf => ../
+
+require (
+	github.com/Shopify/go-lua v0.0.0-20250718183320-1e37f32ad7d0
+	github.com/lrstanley/go-nf v0.0.0-20260225055853-df39e9212550
+)
@@ -0,0 +1,91 @@
+-- Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+-- this source code is governed by the MIT license that can be found in
+-- the LICENSE file.
+
+-- Stub of the "wezterm" module, which is returned when the config requires
+-- "wezterm". Only the functions which affect the font are implemented, the rest
+-- of the API is permissive (any field access, call, or concatenation succeeds),
+-- so unrelated parts of the config (key bindings, events, plugins, etc) don't
+-- prevent the font from being resolved.
+
+local home_dir, config_dir, config_file, target_triple, hostname = ...
+
+-- any is a value which can be indexed, called, and concatenated, always
+-- resulting in itself (or the other operand, when concatenated).
+local any
+any = setmetatable({}, {
+    __index = function() return any end,
+    __newindex = function() end,
+    __call = function() return any end,
+    __concat = function(a, b)
+        if type(a) == "string" or type(a) == "number" then return a end
+        if type(b) == "string" or type(b) == "number" then return b end
+        return ""
+    end,
+    __tostring = function() return "" end,
+    __len = function() return 0 end,
+})
+
+-- family returns the family name from a font spec, which is either a string,
+-- or a table with a "family" field (or the family as the first element).
+local function family(spec)
+    if type(spec) == "string" then
+        return spec
+    end
+    if type(spec) == "table" then
+        local f = rawget(spec, "family") or rawget(spec, 1)
+        if type(f) == "string" then
+            return f
+        end
+    end
+    return nil
+end
+
+local wezterm = {
+    home_dir = home_dir,
+    config_dir = config_dir,
+    config_file = config_file,
+    executable_dir = "",
+    target_triple = target_triple,
+    version = "00000000-000000-00000000",
+    GLOBAL = {},
+}
+
+function wezterm.font(spec)
+    return { __nf_fonts = { family(spec) } }
+end
+
+function wezterm.font_with_fallback(specs)
+    local fonts = {}
+    if type(specs) == "table" then
+        for _, spec in ipairs(specs) do
+            fonts[#fonts + 1] = family(spec)
+        end
+    end
+    return { __nf_fonts = fonts }
+end
+
+function wezterm.config_builder()
+    return {}
+end
+
+function wezterm.hostname()
+    return hostname
+end
+
+function wezterm.glob()
+    return {}
+end
+
+function wezterm.read_dir()
+    return {}
+end
+
+function wezterm.on() end
+function wezterm.log_info() end
+function wezterm.log_warn() end
+function wezterm.log_error() end
+
+return setmetatable(wezterm, {
+    __index = function() return any end,
+})
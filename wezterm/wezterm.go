@@ -0,0 +1,371 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+// Package wezterm reads the font from the WezTerm config, which is a Lua
+// script, and provides an [nf.InstallDetector] for it.
+//
+// This is a separate module from [github.com/lrstanley/go-nf], as evaluating
+// the config requires a Lua interpreter, which the main module doesn't depend
+// on. [nf.DetectorTerminalConfig] already returns [nf.StatusEnabled] when
+// running in WezTerm, as WezTerm bundles the Nerd Font symbols (see
+// [BuiltinFallbackFonts]). Use this module if you need the configured fonts.
+package wezterm
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strings"
+
+	"github.com/Shopify/go-lua"
+	nf "github.com/lrstanley/go-nf"
+)
+
+// BuiltinFallbackFonts are the fonts which WezTerm bundles, and always appends
+// to the configured fonts as fallbacks. "Symbols Nerd Font Mono" provides the
+// Nerd Font glyphs, regardless of the configured font. See
+// https://wezterm.org/config/fonts.html
+var BuiltinFallbackFonts = []string{
+	"JetBrains Mono",
+	"Noto Color Emoji",
+	"Symbols Nerd Font Mono",
+}
+
+// maxInstructions is the maximum number of Lua instructions (approximately)
+// which will be executed when evaluating a config, to guard against configs
+// which never return.
+const maxInstructions = 50_000_000
+
+// hookInterval is the number of Lua instructions between checks of the context
+// and instruction limit.
+const hookInterval = 10_000
+
+//go:embed stub.lua
+var stub string
+
+// ConfigPath returns the path to the WezTerm config file, using the same search
+// order as WezTerm:
+//   - $WEZTERM_CONFIG_FILE (which WezTerm also sets for processes it spawns).
+//   - $XDG_CONFIG_HOME/wezterm/wezterm.lua
+//   - ~/.config/wezterm/wezterm.lua
+//   - ~/.wezterm.lua
+//
+// If no config file exists, an empty string is returned.
+func ConfigPath() string {
+	return configPath(os.Getenv, fileExists)
+}
+
+func configPath(getenv func(string) string, exists func(string) bool) string {
+	if p := getenv("WEZTERM_CONFIG_FILE"); p != "" {
+		if exists(p) {
+			return p
+		}
+		return ""
+	}
+
+	home := getenv("HOME")
+	if home == "" && runtime.GOOS == "windows" {
+		home = getenv("USERPROFILE")
+	}
+
+	var candidates []string
+	if dir := getenv("XDG_CONFIG_HOME"); dir != "" {
+		candidates = append(candidates, filepath.Join(dir, "wezterm", "wezterm.lua"))
+	}
+	if home != "" {
+		candidates = append(
+			candidates,
+			filepath.Join(home, ".config", "wezterm", "wezterm.lua"),
+			filepath.Join(home, ".wezterm.lua"),
+		)
+	}
+
+	for _, p := range candidates {
+		if exists(p) {
+			return p
+		}
+	}
+	return ""
+}
+
+func fileExists(p string) bool {
+	fi, err := os.Stat(p)
+	return err == nil && !fi.IsDir()
+}
+
+// Fonts evaluates the WezTerm config at path, and returns the families of the
+// configured font (set through wezterm.font or wezterm.font_with_fallback), in
+// order of preference. [BuiltinFallbackFonts] are not included. If the config
+// doesn't set a font, nil is returned.
+//
+// The config is evaluated in a sandbox, with a stubbed "wezterm" module. Only
+// the base, string, table, math, bit32, and a subset of the os libraries are
+// available (commands can't be run, and files can't be opened or modified),
+// and modules can only be required from the config directory. Unknown parts of
+// the wezterm API are permissive, such that configs which use them can still
+// be evaluated. Evaluation is stopped when ctx is cancelled.
+//
+// The config, modules, and environment variables are read from the environment
+// returned by [nf.EnvFrom].
+func Fonts(ctx context.Context, path string) ([]string, error) {
+	env := nf.EnvFrom(ctx)
+
+	src, err := env.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read wezterm config: %w", err)
+	}
+
+	l := newState(ctx, env, path)
+
+	if err = lua.LoadBuffer(l, string(src), "@"+path, "t"); err != nil {
+		// The error message (with the position) is left on the stack.
+		msg, _ := l.ToString(-1)
+		return nil, fmt.Errorf("failed to load wezterm config: %w: %s", err, msg)
+	}
+	if err = l.ProtectedCall(0, 1, 0); err != nil {
+		if ctx.Err() != nil {
+			return nil, fmt.Errorf("failed to evaluate wezterm config: %w", ctx.Err())
+		}
+		return nil, fmt.Errorf("failed to evaluate wezterm config: %w", err)
+	}
+
+	return configFonts(l), nil
+}
+
+// configFonts returns the fonts from the config table at the top of the stack.
+func configFonts(l *lua.State) []string {
+	if !l.IsTable(-1) {
+		return nil
+	}
+	l.Field(-1, "font")
+	if !l.IsTable(-1) {
+		return nil
+	}
+	l.Field(-1, "__nf_fonts")
+	if !l.IsTable(-1) {
+		return nil
+	}
+
+	var fonts []string
+	for i := 1; i <= l.RawLength(-1); i++ {
+		l.RawGetInt(-1, i)
+		if s, ok := l.ToString(-1); ok && s != "" {
+			fonts = append(fonts, s)
+		}
+		l.Pop(1)
+	}
+	return fonts
+}
+
+// newState returns a sandboxed Lua state for evaluating the config at path,
+// with the wezterm stub loaded.
+func newState(ctx context.Context, env *nf.Env, path string) *lua.State {
+	l := lua.NewState()
+	lua.Require(l, "_G", lua.BaseOpen, true)
+	lua.Require(l, "string", lua.StringOpen, true)
+	lua.Require(l, "table", lua.TableOpen, true)
+	lua.Require(l, "math", lua.MathOpen, true)
+	lua.Require(l, "bit32", lua.Bit32Open, true)
+	l.SetTop(0)
+
+	// Remove functions which access the filesystem, or write to stdout.
+	for _, name := range []string{"dofile", "loadfile", "print"} {
+		l.PushNil()
+		l.SetGlobal(name)
+	}
+
+	// Only keep the os functions which don't have side effects. getenv is
+	// replaced, as the go-lua implementation returns an empty string rather
+	// than nil for unset variables.
+	lua.Require(l, "os", lua.OSOpen, true)
+	for _, name := range []string{"execute", "exit", "remove", "rename", "tmpname"} {
+		l.PushNil()
+		l.SetField(-2, name)
+	}
+	l.PushGoFunction(func(l *lua.State) int {
+		if v := env.Getenv(lua.CheckString(l, 1)); v != "" {
+			l.PushString(v)
+		} else {
+			l.PushNil()
+		}
+		return 1
+	})
+	l.SetField(-2, "getenv")
+	l.Pop(1)
+
+	lua.NewLibrary(l, []lua.RegistryFunction{
+		{Name: "open", Function: func(l *lua.State) int {
+			l.PushNil()
+			l.PushString("io is not available when evaluating the config")
+			return 2
+		}},
+	})
+	l.SetGlobal("io")
+
+	// Abort evaluation if the context is cancelled, or the config runs for too
+	// long. Once aborted, the hook runs on every instruction, such that the
+	// error can't be indefinitely caught by pcall.
+	var executed int
+	var hook lua.Hook
+	hook = func(l *lua.State, _ lua.Debug) {
+		executed += hookInterval
+		var reason string
+		switch {
+		case ctx.Err() != nil:
+			reason = "evaluation cancelled: " + ctx.Err().Error()
+		case executed > maxInstructions:
+			reason = fmt.Sprintf("evaluation exceeded %d instructions", maxInstructions)
+		default:
+			return
+		}
+		lua.SetDebugHook(l, hook, lua.MaskCount, 1)
+		lua.Errorf(l, "%s", reason)
+	}
+	lua.SetDebugHook(l, hook, lua.MaskCount, hookInterval)
+
+	dir := filepath.Dir(path)
+	home := filepath.FromSlash(env.Home)
+	hostname, _ := os.Hostname()
+
+	// package.path is commonly appended to, but isn't used by require.
+	l.NewTable()
+	l.PushString("")
+	l.SetField(-2, "path")
+	l.PushString("")
+	l.SetField(-2, "cpath")
+	l.SetGlobal("package")
+
+	if err := lua.LoadBuffer(l, stub, "=wezterm", "t"); err != nil {
+		panic(fmt.Sprintf("failed to load wezterm stub: %v", err))
+	}
+	for _, arg := range []string{home, dir, path, targetTriple(), hostname} {
+		l.PushString(arg)
+	}
+	l.Call(5, 1)
+	lua.SubTable(l, lua.RegistryIndex, "_LOADED")
+	l.PushValue(-2)
+	l.SetField(-2, "wezterm")
+	l.SetTop(0)
+
+	l.PushGoFunction(func(l *lua.State) int {
+		return require(l, env, dir)
+	})
+	l.SetGlobal("require")
+
+	return l
+}
+
+// require loads a module, either the wezterm stub, or a Lua module relative to
+// the config directory (e.g. "foo.bar" from "<dir>/foo/bar.lua", or
+// "<dir>/foo/bar/init.lua"). Modules which can't be found (e.g. native
+// modules) resolve to a permissive value, like unknown wezterm APIs.
+func require(l *lua.State, env *nf.Env, dir string) int {
+	name := lua.CheckString(l, 1)
+
+	lua.SubTable(l, lua.RegistryIndex, "_LOADED")
+	loaded := l.Top()
+	l.Field(loaded, name)
+	if l.ToBoolean(-1) {
+		return 1
+	}
+	l.Pop(1)
+
+	rel := filepath.FromSlash(strings.ReplaceAll(name, ".", "/"))
+	for _, p := range []string{
+		filepath.Join(dir, rel+".lua"),
+		filepath.Join(dir, rel, "init.lua"),
+	} {
+		src, err := env.ReadFile(p)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			lua.Errorf(l, "error loading module '%s': %s", name, err.Error())
+		}
+
+		if err = lua.LoadBuffer(l, string(src), "@"+p, "t"); err != nil {
+			msg, _ := l.ToString(-1)
+			lua.Errorf(l, "error loading module '%s': %s", name, msg)
+		}
+		l.PushString(name)
+		l.Call(1, 1)
+		if l.IsNil(-1) {
+			l.Pop(1)
+			l.PushBoolean(true)
+		}
+		l.PushValue(-1)
+		l.SetField(loaded, name)
+		return 1
+	}
+
+	// Resolve unknown modules to the permissive value returned by unknown
+	// wezterm fields.
+	l.Field(loaded, "wezterm")
+	l.Field(-1, "__nf_any")
+	return 1
+}
+
+// targetTriple returns an approximation of wezterm.target_triple, which configs
+// commonly use to detect the OS.
+func targetTriple() string {
+	arch := map[string]string{"amd64": "x86_64", "arm64": "aarch64"}[runtime.GOARCH]
+	if arch == "" {
+		arch = runtime.GOARCH
+	}
+	switch runtime.GOOS {
+	case "windows":
+		return arch + "-pc-windows-msvc"
+	case "darwin":
+		return arch + "-apple-darwin"
+	default:
+		return arch + "-unknown-" + runtime.GOOS + "-gnu"
+	}
+}
+
+// Detector returns an [nf.InstallDetector] which returns [nf.StatusEnabled]
+// when running in WezTerm (see [nf.CurrentTerminal]). The configured fonts are
+// read with [Fonts], though as WezTerm bundles the Nerd Font symbols (see
+// [BuiltinFallbackFonts]), glyphs render regardless of the configured font, and
+// errors evaluating the config are ignored.
+//
+// When not running in WezTerm, [nf.StatusNotInstalled] is returned. The
+// terminal and config are read from the environment returned by [nf.EnvFrom].
+func Detector() nf.InstallDetector {
+	return nf.Named("wezterm", func(ctx context.Context) (nf.InstallStatus, error) {
+		env := nf.EnvFrom(ctx)
+		path := configPath(env.Getenv, func(p string) bool {
+			fi, err := env.Stat(p)
+			return err == nil && !fi.IsDir()
+		})
+		return detect(ctx, nf.TerminalFrom(env.Getenv), path)
+	})
+}
+
+func detect(ctx context.Context, term nf.Terminal, path string) (nf.InstallStatus, error) {
+	if term != nf.TerminalWezTerm {
+		return nf.StatusNotInstalled, nil
+	}
+
+	var fonts []string
+	if path != "" {
+		fonts, _ = Fonts(ctx, path)
+	}
+	for _, font := range fonts {
+		nf.AddEvidence(ctx, nf.Evidence{Kind: nf.EvidenceFont, Value: font, Path: path})
+	}
+	for _, font := range BuiltinFallbackFonts {
+		nf.AddEvidence(ctx, nf.Evidence{Kind: nf.EvidenceFont, Value: font})
+	}
+
+	if slices.ContainsFunc(slices.Concat(fonts, BuiltinFallbackFonts), nf.IsNerdFontName) {
+		return nf.StatusEnabled, nil
+	}
+	return nf.StatusNotInstalled, nil
+}
@@ -0,0 +1,287 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package wezterm
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	nf "github.com/lrstanley/go-nf"
+)
+
+// writeConfig writes files (relative path -> content) to a temporary directory,
+// returning the path to wezterm.lua.
+func writeConfig(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	for name, content := range files {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return filepath.Join(dir, "wezterm.lua")
+}
+
+func TestFonts(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		files   map[string]string
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "font",
+			files: map[string]string{"wezterm.lua": `
+				local wezterm = require 'wezterm'
+				return { font = wezterm.font 'JetBrainsMono Nerd Font' }
+			`},
+			want: []string{"JetBrainsMono Nerd Font"},
+		},
+		{
+			name: "font-table",
+			files: map[string]string{"wezterm.lua": `
+				local wezterm = require 'wezterm'
+				return { font = wezterm.font { family = 'Hack', weight = 'Bold' } }
+			`},
+			want: []string{"Hack"},
+		},
+		{
+			name: "font-with-fallback",
+			files: map[string]string{"wezterm.lua": `
+				local wezterm = require 'wezterm'
+				local config = wezterm.config_builder()
+				config.font = wezterm.font_with_fallback {
+					'Fira Code',
+					{ family = 'Symbols Nerd Font', scale = 0.9 },
+				}
+				return config
+			`},
+			want: []string{"Fira Code", "Symbols Nerd Font"},
+		},
+		{
+			name: "module",
+			files: map[string]string{
+				"wezterm.lua": `
+					local wezterm = require 'wezterm'
+					local config = wezterm.config_builder()
+					require('lib.fonts').apply(config)
+					require('lib.fonts').apply(config) -- cached
+					return config
+				`,
+				"lib/fonts/init.lua": `
+					local wezterm = require 'wezterm'
+					local calls = 0
+					return {
+						apply = function(config)
+							calls = calls + 1
+							config.font = wezterm.font('Iosevka NFM ' .. calls)
+						end,
+					}
+				`,
+			},
+			want: []string{"Iosevka NFM 2"},
+		},
+		{
+			name: "permissive",
+			files: map[string]string{"wezterm.lua": `
+				local wezterm = require 'wezterm'
+				local act = wezterm.action
+				local plugin = wezterm.plugin.require 'https://github.com/example/plugin'
+				local config = wezterm.config_builder()
+
+				wezterm.on('gui-startup', function() end)
+				wezterm.log_info('loading config from ' .. wezterm.config_dir)
+				plugin.apply_to_config(config)
+
+				config.keys = {
+					{ key = 'v', mods = 'CTRL', action = act.PasteFrom 'Clipboard' },
+					{ key = 'x', action = wezterm.action_callback(function() end) },
+				}
+				config.window_frame = { font = wezterm.font 'Roboto' }
+				config.tab_bar_style = { new_tab = wezterm.nerdfonts.cod_add .. ' ' }
+				if wezterm.gui.get_appearance():find 'Dark' then
+					config.color_scheme = 'Builtin Dark'
+				end
+				if wezterm.target_triple:find 'windows' then
+					config.default_prog = { 'pwsh.exe' }
+				end
+				package.path = package.path .. ';' .. wezterm.home_dir .. '/lua/?.lua'
+
+				local f = io.open(wezterm.config_dir .. '/font.txt')
+				config.font = wezterm.font(f and 'unreachable' or 'Hack Nerd Font')
+				return config
+			`},
+			want: []string{"Hack Nerd Font"},
+		},
+		{
+			name:  "no-font",
+			files: map[string]string{"wezterm.lua": `return {}`},
+		},
+		{
+			name:  "no-return",
+			files: map[string]string{"wezterm.lua": `local wezterm = require 'wezterm'`},
+		},
+		{
+			name:    "syntax-error",
+			files:   map[string]string{"wezterm.lua": `return {`},
+			wantErr: true,
+		},
+		{
+			name:    "runtime-error",
+			files:   map[string]string{"wezterm.lua": `error('boom')`},
+			wantErr: true,
+		},
+		{
+			name:    "sandboxed",
+			files:   map[string]string{"wezterm.lua": `os.execute('true')`},
+			wantErr: true,
+		},
+		{
+			name: "infinite-loop",
+			files: map[string]string{"wezterm.lua": `
+				while true do pcall(function() while true do end end) end
+			`},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Fonts(t.Context(), writeConfig(t, tt.files))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error: %t, got %v", tt.wantErr, err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("expected fonts %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestFontsCancelled(t *testing.T) {
+	t.Parallel()
+
+	path := writeConfig(t, map[string]string{"wezterm.lua": `while true do end`})
+
+	ctx, cancel := context.WithTimeout(t.Context(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := Fonts(ctx, path)
+	if err == nil || ctx.Err() == nil {
+		t.Fatalf("expected context error, got %v", err)
+	}
+}
+
+func TestConfigPath(t *testing.T) {
+	t.Parallel()
+
+	existing := map[string]bool{
+		filepath.Join("/xdg", "wezterm", "wezterm.lua"): true,
+		filepath.Join("/home/user", ".wezterm.lua"):     true,
+	}
+	exists := func(p string) bool { return existing[p] }
+
+	tests := []struct {
+		env  map[string]string
+		want string
+	}{
+		{
+			env:  map[string]string{"HOME": "/home/user", "XDG_CONFIG_HOME": "/xdg"},
+			want: filepath.Join("/xdg", "wezterm", "wezterm.lua"),
+		},
+		{
+			env:  map[string]string{"HOME": "/home/user"},
+			want: filepath.Join("/home/user", ".wezterm.lua"),
+		},
+		{
+			env:  map[string]string{"HOME": "/home/user", "WEZTERM_CONFIG_FILE": "/missing.lua"},
+			want: "",
+		},
+		{
+			env:  map[string]string{},
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		got := configPath(func(k string) string { return tt.env[k] }, exists)
+		if got != tt.want {
+			t.Errorf("env %v: expected %q, got %q", tt.env, tt.want, got)
+		}
+	}
+}
+
+func TestDetect(t *testing.T) {
+	t.Parallel()
+
+	plain := writeConfig(t, map[string]string{"wezterm.lua": `return { font = require('wezterm').font 'Fira Code' }`})
+	broken := writeConfig(t, map[string]string{"wezterm.lua": `return {`})
+
+	tests := []struct {
+		term nf.Terminal
+		path string
+		want nf.InstallStatus
+	}{
+		{term: nf.TerminalKitty, path: plain, want: nf.StatusNotInstalled},
+		// The built-in "Symbols Nerd Font Mono" fallback is always available.
+		{term: nf.TerminalWezTerm, path: plain, want: nf.StatusEnabled},
+		{term: nf.TerminalWezTerm, path: broken, want: nf.StatusEnabled},
+		{term: nf.TerminalWezTerm, path: "", want: nf.StatusEnabled},
+	}
+
+	for _, tt := range tests {
+		status, err := detect(t.Context(), tt.term, tt.path)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if status != tt.want {
+			t.Errorf("%s (%q): expected status %q, got %q", tt.term, tt.path, tt.want, status)
+		}
+	}
+}
+
+func TestDetectorEnv(t *testing.T) {
+	t.Parallel()
+
+	env := map[string]string{"HOME": "/home/user", "TERM_PROGRAM": "WezTerm", "FONT": "JetBrains Mono"}
+	ctx := nf.ContextWithEnv(t.Context(), &nf.Env{
+		Getenv: func(key string) string { return env[key] },
+		Home:   "/home/user",
+		FS: fstest.MapFS{
+			"home/user/.wezterm.lua": {Data: []byte(`return { font = require('wezterm').font(require('fonts').family) }`)},
+			"home/user/fonts.lua":    {Data: []byte(`return { family = os.getenv('FONT') }`)},
+		},
+	})
+
+	report := nf.DetectReport(ctx, Detector())
+	res := report.Results[0]
+	if res.Status != nf.StatusEnabled || res.Err != nil {
+		t.Fatalf("expected enabled, got %q, %v", res.Status, res.Err)
+	}
+	want := nf.Evidence{Kind: nf.EvidenceFont, Value: "JetBrains Mono", Path: filepath.Join("/home/user", ".wezterm.lua")}
+	if !slices.Contains(res.Evidence, want) {
+		t.Errorf("expected evidence %+v, got %+v", want, res.Evidence)
+	}
+
+	// Not running in WezTerm.
+	delete(env, "TERM_PROGRAM")
+	if status, _ := Detector()(ctx); status != nf.StatusNotInstalled {
+		t.Errorf("expected not installed, got %q", status)
+	}
+}